@@ -32,10 +32,23 @@ const (
 	ApiContainerService_DownloadFilesArtifact_FullMethodName                      = "/api_container_api.ApiContainerService/DownloadFilesArtifact"
 	ApiContainerService_StoreWebFilesArtifact_FullMethodName                      = "/api_container_api.ApiContainerService/StoreWebFilesArtifact"
 	ApiContainerService_StoreFilesArtifactFromService_FullMethodName              = "/api_container_api.ApiContainerService/StoreFilesArtifactFromService"
+	ApiContainerService_CopyFilesToService_FullMethodName                         = "/api_container_api.ApiContainerService/CopyFilesToService"
+	ApiContainerService_CopyFilesArtifactToService_FullMethodName                 = "/api_container_api.ApiContainerService/CopyFilesArtifactToService"
+	ApiContainerService_CopyFilesFromService_FullMethodName                       = "/api_container_api.ApiContainerService/CopyFilesFromService"
 	ApiContainerService_ListFilesArtifactNamesAndUuids_FullMethodName             = "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids"
 	ApiContainerService_InspectFilesArtifactContents_FullMethodName               = "/api_container_api.ApiContainerService/InspectFilesArtifactContents"
+	ApiContainerService_RemoveFilesArtifact_FullMethodName                        = "/api_container_api.ApiContainerService/RemoveFilesArtifact"
+	ApiContainerService_ListFilesArtifactVersions_FullMethodName                  = "/api_container_api.ApiContainerService/ListFilesArtifactVersions"
 	ApiContainerService_ConnectServices_FullMethodName                            = "/api_container_api.ApiContainerService/ConnectServices"
 	ApiContainerService_GetStarlarkRun_FullMethodName                             = "/api_container_api.ApiContainerService/GetStarlarkRun"
+	ApiContainerService_ExportEnclaveSnapshot_FullMethodName                      = "/api_container_api.ApiContainerService/ExportEnclaveSnapshot"
+	ApiContainerService_ImportEnclaveSnapshot_FullMethodName                      = "/api_container_api.ApiContainerService/ImportEnclaveSnapshot"
+	ApiContainerService_ResumeServices_FullMethodName                             = "/api_container_api.ApiContainerService/ResumeServices"
+	ApiContainerService_GetEnclaveExpiry_FullMethodName                           = "/api_container_api.ApiContainerService/GetEnclaveExpiry"
+	ApiContainerService_SetEnclaveExpiry_FullMethodName                           = "/api_container_api.ApiContainerService/SetEnclaveExpiry"
+	ApiContainerService_GetEnclaveResourceQuotas_FullMethodName                   = "/api_container_api.ApiContainerService/GetEnclaveResourceQuotas"
+	ApiContainerService_GetServicesStats_FullMethodName                           = "/api_container_api.ApiContainerService/GetServicesStats"
+	ApiContainerService_LintStarlark_FullMethodName                               = "/api_container_api.ApiContainerService/LintStarlark"
 )
 
 // ApiContainerServiceClient is the client API for ApiContainerService service.
@@ -66,12 +79,39 @@ type ApiContainerServiceClient interface {
 	StoreWebFilesArtifact(ctx context.Context, in *StoreWebFilesArtifactArgs, opts ...grpc.CallOption) (*StoreWebFilesArtifactResponse, error)
 	// Tells the API container to copy a files artifact from a service to the Kurtosis File System
 	StoreFilesArtifactFromService(ctx context.Context, in *StoreFilesArtifactFromServiceArgs, opts ...grpc.CallOption) (*StoreFilesArtifactFromServiceResponse, error)
+	// Copies the streamed TGZ, packaged like a files artifact, into a directory of a running service
+	CopyFilesToService(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_CopyFilesToServiceClient, error)
+	// Copies the content of a files artifact into a directory of a running service
+	CopyFilesArtifactToService(ctx context.Context, in *CopyFilesArtifactToServiceArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Streams the content at a path of a running service, packaged as a TGZ
+	CopyFilesFromService(ctx context.Context, in *CopyFilesFromServiceArgs, opts ...grpc.CallOption) (ApiContainerService_CopyFilesFromServiceClient, error)
 	ListFilesArtifactNamesAndUuids(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFilesArtifactNamesAndUuidsResponse, error)
 	InspectFilesArtifactContents(ctx context.Context, in *InspectFilesArtifactContentsRequest, opts ...grpc.CallOption) (*InspectFilesArtifactContentsResponse, error)
+	// Removes a files artifact from the Kurtosis File System, refusing to if a running service mounts it
+	RemoveFilesArtifact(ctx context.Context, in *RemoveFilesArtifactArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the versions of a files artifact kept by the Kurtosis File System, from the oldest to the current one
+	ListFilesArtifactVersions(ctx context.Context, in *ListFilesArtifactVersionsArgs, opts ...grpc.CallOption) (*ListFilesArtifactVersionsResponse, error)
 	// User services port forwarding
 	ConnectServices(ctx context.Context, in *ConnectServicesArgs, opts ...grpc.CallOption) (*ConnectServicesResponse, error)
 	// Get last Starlark run
 	GetStarlarkRun(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStarlarkRunResponse, error)
+	// Exports the state of the enclave (services, files artifacts, runtime values and enclave plan) as a single archive
+	ExportEnclaveSnapshot(ctx context.Context, in *ExportEnclaveSnapshotArgs, opts ...grpc.CallOption) (ApiContainerService_ExportEnclaveSnapshotClient, error)
+	// Restores a snapshot produced by ExportEnclaveSnapshot into this enclave, which must not contain any service yet
+	ImportEnclaveSnapshot(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_ImportEnclaveSnapshotClient, error)
+	// Restarts the services whose containers stopped along with the enclave, in the order they were added, and waits for their ready conditions
+	ResumeServices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResumeServicesResponse, error)
+	// Returns the expiry settings of the enclave along with the last time its API was called. Calling it doesn't count as activity
+	GetEnclaveExpiry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EnclaveExpiry, error)
+	// Replaces the expiry settings of the enclave, which are persisted in the enclave database
+	SetEnclaveExpiry(ctx context.Context, in *EnclaveExpiry, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the resource quotas set at the creation of the enclave along with how much of them is currently used
+	GetEnclaveResourceQuotas(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclaveResourceQuotasResponse, error)
+	// Returns the CPU, memory, network and block IO the running services consume, once or, when following, every few
+	// seconds until the client cancels
+	GetServicesStats(ctx context.Context, in *GetServicesStatsArgs, opts ...grpc.CallOption) (ApiContainerService_GetServicesStatsClient, error)
+	// Lints the given Starlark files statically, without interpreting them, and returns the findings
+	LintStarlark(ctx context.Context, in *LintStarlarkArgs, opts ...grpc.CallOption) (*LintStarlarkResponse, error)
 }
 
 type apiContainerServiceClient struct {
@@ -309,6 +349,81 @@ func (c *apiContainerServiceClient) StoreFilesArtifactFromService(ctx context.Co
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) CopyFilesToService(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_CopyFilesToServiceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[5], ApiContainerService_CopyFilesToService_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceCopyFilesToServiceClient{stream}
+	return x, nil
+}
+
+type ApiContainerService_CopyFilesToServiceClient interface {
+	Send(*CopyFilesToServiceChunk) error
+	CloseAndRecv() (*emptypb.Empty, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceCopyFilesToServiceClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceCopyFilesToServiceClient) Send(m *CopyFilesToServiceChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceCopyFilesToServiceClient) CloseAndRecv() (*emptypb.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(emptypb.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) CopyFilesArtifactToService(ctx context.Context, in *CopyFilesArtifactToServiceArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_CopyFilesArtifactToService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) CopyFilesFromService(ctx context.Context, in *CopyFilesFromServiceArgs, opts ...grpc.CallOption) (ApiContainerService_CopyFilesFromServiceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[6], ApiContainerService_CopyFilesFromService_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceCopyFilesFromServiceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_CopyFilesFromServiceClient interface {
+	Recv() (*StreamedDataChunk, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceCopyFilesFromServiceClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceCopyFilesFromServiceClient) Recv() (*StreamedDataChunk, error) {
+	m := new(StreamedDataChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiContainerServiceClient) ListFilesArtifactNamesAndUuids(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFilesArtifactNamesAndUuidsResponse, error) {
 	out := new(ListFilesArtifactNamesAndUuidsResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_ListFilesArtifactNamesAndUuids_FullMethodName, in, out, opts...)
@@ -327,6 +442,24 @@ func (c *apiContainerServiceClient) InspectFilesArtifactContents(ctx context.Con
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) RemoveFilesArtifact(ctx context.Context, in *RemoveFilesArtifactArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_RemoveFilesArtifact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) ListFilesArtifactVersions(ctx context.Context, in *ListFilesArtifactVersionsArgs, opts ...grpc.CallOption) (*ListFilesArtifactVersionsResponse, error) {
+	out := new(ListFilesArtifactVersionsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_ListFilesArtifactVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) ConnectServices(ctx context.Context, in *ConnectServicesArgs, opts ...grpc.CallOption) (*ConnectServicesResponse, error) {
 	out := new(ConnectServicesResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_ConnectServices_FullMethodName, in, out, opts...)
@@ -345,6 +478,149 @@ func (c *apiContainerServiceClient) GetStarlarkRun(ctx context.Context, in *empt
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) ExportEnclaveSnapshot(ctx context.Context, in *ExportEnclaveSnapshotArgs, opts ...grpc.CallOption) (ApiContainerService_ExportEnclaveSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[7], ApiContainerService_ExportEnclaveSnapshot_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceExportEnclaveSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_ExportEnclaveSnapshotClient interface {
+	Recv() (*StreamedDataChunk, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceExportEnclaveSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceExportEnclaveSnapshotClient) Recv() (*StreamedDataChunk, error) {
+	m := new(StreamedDataChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) ImportEnclaveSnapshot(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_ImportEnclaveSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[8], ApiContainerService_ImportEnclaveSnapshot_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceImportEnclaveSnapshotClient{stream}
+	return x, nil
+}
+
+type ApiContainerService_ImportEnclaveSnapshotClient interface {
+	Send(*StreamedDataChunk) error
+	CloseAndRecv() (*emptypb.Empty, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceImportEnclaveSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceImportEnclaveSnapshotClient) Send(m *StreamedDataChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceImportEnclaveSnapshotClient) CloseAndRecv() (*emptypb.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(emptypb.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) ResumeServices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResumeServicesResponse, error) {
+	out := new(ResumeServicesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_ResumeServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetEnclaveExpiry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EnclaveExpiry, error) {
+	out := new(EnclaveExpiry)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetEnclaveExpiry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) SetEnclaveExpiry(ctx context.Context, in *EnclaveExpiry, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_SetEnclaveExpiry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetEnclaveResourceQuotas(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclaveResourceQuotasResponse, error) {
+	out := new(GetEnclaveResourceQuotasResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetEnclaveResourceQuotas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetServicesStats(ctx context.Context, in *GetServicesStatsArgs, opts ...grpc.CallOption) (ApiContainerService_GetServicesStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[9], ApiContainerService_GetServicesStats_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceGetServicesStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_GetServicesStatsClient interface {
+	Recv() (*GetServicesStatsResponse, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceGetServicesStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceGetServicesStatsClient) Recv() (*GetServicesStatsResponse, error) {
+	m := new(GetServicesStatsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) LintStarlark(ctx context.Context, in *LintStarlarkArgs, opts ...grpc.CallOption) (*LintStarlarkResponse, error) {
+	out := new(LintStarlarkResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_LintStarlark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiContainerServiceServer is the server API for ApiContainerService service.
 // All implementations should embed UnimplementedApiContainerServiceServer
 // for forward compatibility
@@ -373,12 +649,39 @@ type ApiContainerServiceServer interface {
 	StoreWebFilesArtifact(context.Context, *StoreWebFilesArtifactArgs) (*StoreWebFilesArtifactResponse, error)
 	// Tells the API container to copy a files artifact from a service to the Kurtosis File System
 	StoreFilesArtifactFromService(context.Context, *StoreFilesArtifactFromServiceArgs) (*StoreFilesArtifactFromServiceResponse, error)
+	// Copies the streamed TGZ, packaged like a files artifact, into a directory of a running service
+	CopyFilesToService(ApiContainerService_CopyFilesToServiceServer) error
+	// Copies the content of a files artifact into a directory of a running service
+	CopyFilesArtifactToService(context.Context, *CopyFilesArtifactToServiceArgs) (*emptypb.Empty, error)
+	// Streams the content at a path of a running service, packaged as a TGZ
+	CopyFilesFromService(*CopyFilesFromServiceArgs, ApiContainerService_CopyFilesFromServiceServer) error
 	ListFilesArtifactNamesAndUuids(context.Context, *emptypb.Empty) (*ListFilesArtifactNamesAndUuidsResponse, error)
 	InspectFilesArtifactContents(context.Context, *InspectFilesArtifactContentsRequest) (*InspectFilesArtifactContentsResponse, error)
+	// Removes a files artifact from the Kurtosis File System, refusing to if a running service mounts it
+	RemoveFilesArtifact(context.Context, *RemoveFilesArtifactArgs) (*emptypb.Empty, error)
+	// Lists the versions of a files artifact kept by the Kurtosis File System, from the oldest to the current one
+	ListFilesArtifactVersions(context.Context, *ListFilesArtifactVersionsArgs) (*ListFilesArtifactVersionsResponse, error)
 	// User services port forwarding
 	ConnectServices(context.Context, *ConnectServicesArgs) (*ConnectServicesResponse, error)
 	// Get last Starlark run
 	GetStarlarkRun(context.Context, *emptypb.Empty) (*GetStarlarkRunResponse, error)
+	// Exports the state of the enclave (services, files artifacts, runtime values and enclave plan) as a single archive
+	ExportEnclaveSnapshot(*ExportEnclaveSnapshotArgs, ApiContainerService_ExportEnclaveSnapshotServer) error
+	// Restores a snapshot produced by ExportEnclaveSnapshot into this enclave, which must not contain any service yet
+	ImportEnclaveSnapshot(ApiContainerService_ImportEnclaveSnapshotServer) error
+	// Restarts the services whose containers stopped along with the enclave, in the order they were added, and waits for their ready conditions
+	ResumeServices(context.Context, *emptypb.Empty) (*ResumeServicesResponse, error)
+	// Returns the expiry settings of the enclave along with the last time its API was called. Calling it doesn't count as activity
+	GetEnclaveExpiry(context.Context, *emptypb.Empty) (*EnclaveExpiry, error)
+	// Replaces the expiry settings of the enclave, which are persisted in the enclave database
+	SetEnclaveExpiry(context.Context, *EnclaveExpiry) (*emptypb.Empty, error)
+	// Returns the resource quotas set at the creation of the enclave along with how much of them is currently used
+	GetEnclaveResourceQuotas(context.Context, *emptypb.Empty) (*GetEnclaveResourceQuotasResponse, error)
+	// Returns the CPU, memory, network and block IO the running services consume, once or, when following, every few
+	// seconds until the client cancels
+	GetServicesStats(*GetServicesStatsArgs, ApiContainerService_GetServicesStatsServer) error
+	// Lints the given Starlark files statically, without interpreting them, and returns the findings
+	LintStarlark(context.Context, *LintStarlarkArgs) (*LintStarlarkResponse, error)
 }
 
 // UnimplementedApiContainerServiceServer should be embedded to have forward compatible implementations.
@@ -421,18 +724,57 @@ func (UnimplementedApiContainerServiceServer) StoreWebFilesArtifact(context.Cont
 func (UnimplementedApiContainerServiceServer) StoreFilesArtifactFromService(context.Context, *StoreFilesArtifactFromServiceArgs) (*StoreFilesArtifactFromServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreFilesArtifactFromService not implemented")
 }
+func (UnimplementedApiContainerServiceServer) CopyFilesToService(ApiContainerService_CopyFilesToServiceServer) error {
+	return status.Errorf(codes.Unimplemented, "method CopyFilesToService not implemented")
+}
+func (UnimplementedApiContainerServiceServer) CopyFilesArtifactToService(context.Context, *CopyFilesArtifactToServiceArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFilesArtifactToService not implemented")
+}
+func (UnimplementedApiContainerServiceServer) CopyFilesFromService(*CopyFilesFromServiceArgs, ApiContainerService_CopyFilesFromServiceServer) error {
+	return status.Errorf(codes.Unimplemented, "method CopyFilesFromService not implemented")
+}
 func (UnimplementedApiContainerServiceServer) ListFilesArtifactNamesAndUuids(context.Context, *emptypb.Empty) (*ListFilesArtifactNamesAndUuidsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFilesArtifactNamesAndUuids not implemented")
 }
 func (UnimplementedApiContainerServiceServer) InspectFilesArtifactContents(context.Context, *InspectFilesArtifactContentsRequest) (*InspectFilesArtifactContentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectFilesArtifactContents not implemented")
 }
+func (UnimplementedApiContainerServiceServer) RemoveFilesArtifact(context.Context, *RemoveFilesArtifactArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFilesArtifact not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ListFilesArtifactVersions(context.Context, *ListFilesArtifactVersionsArgs) (*ListFilesArtifactVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFilesArtifactVersions not implemented")
+}
 func (UnimplementedApiContainerServiceServer) ConnectServices(context.Context, *ConnectServicesArgs) (*ConnectServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectServices not implemented")
 }
 func (UnimplementedApiContainerServiceServer) GetStarlarkRun(context.Context, *emptypb.Empty) (*GetStarlarkRunResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkRun not implemented")
 }
+func (UnimplementedApiContainerServiceServer) ExportEnclaveSnapshot(*ExportEnclaveSnapshotArgs, ApiContainerService_ExportEnclaveSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportEnclaveSnapshot not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ImportEnclaveSnapshot(ApiContainerService_ImportEnclaveSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportEnclaveSnapshot not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ResumeServices(context.Context, *emptypb.Empty) (*ResumeServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeServices not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetEnclaveExpiry(context.Context, *emptypb.Empty) (*EnclaveExpiry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveExpiry not implemented")
+}
+func (UnimplementedApiContainerServiceServer) SetEnclaveExpiry(context.Context, *EnclaveExpiry) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnclaveExpiry not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetEnclaveResourceQuotas(context.Context, *emptypb.Empty) (*GetEnclaveResourceQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveResourceQuotas not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetServicesStats(*GetServicesStatsArgs, ApiContainerService_GetServicesStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetServicesStats not implemented")
+}
+func (UnimplementedApiContainerServiceServer) LintStarlark(context.Context, *LintStarlarkArgs) (*LintStarlarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LintStarlark not implemented")
+}
 
 // UnsafeApiContainerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiContainerServiceServer will
@@ -686,6 +1028,71 @@ func _ApiContainerService_StoreFilesArtifactFromService_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_CopyFilesToService_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApiContainerServiceServer).CopyFilesToService(&apiContainerServiceCopyFilesToServiceServer{stream})
+}
+
+type ApiContainerService_CopyFilesToServiceServer interface {
+	SendAndClose(*emptypb.Empty) error
+	Recv() (*CopyFilesToServiceChunk, error)
+	grpc.ServerStream
+}
+
+type apiContainerServiceCopyFilesToServiceServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceCopyFilesToServiceServer) SendAndClose(m *emptypb.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceCopyFilesToServiceServer) Recv() (*CopyFilesToServiceChunk, error) {
+	m := new(CopyFilesToServiceChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ApiContainerService_CopyFilesArtifactToService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFilesArtifactToServiceArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).CopyFilesArtifactToService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_CopyFilesArtifactToService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).CopyFilesArtifactToService(ctx, req.(*CopyFilesArtifactToServiceArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_CopyFilesFromService_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyFilesFromServiceArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).CopyFilesFromService(m, &apiContainerServiceCopyFilesFromServiceServer{stream})
+}
+
+type ApiContainerService_CopyFilesFromServiceServer interface {
+	Send(*StreamedDataChunk) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceCopyFilesFromServiceServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceCopyFilesFromServiceServer) Send(m *StreamedDataChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ApiContainerService_ListFilesArtifactNamesAndUuids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -722,6 +1129,42 @@ func _ApiContainerService_InspectFilesArtifactContents_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_RemoveFilesArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFilesArtifactArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RemoveFilesArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RemoveFilesArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RemoveFilesArtifact(ctx, req.(*RemoveFilesArtifactArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ListFilesArtifactVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFilesArtifactVersionsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).ListFilesArtifactVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_ListFilesArtifactVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).ListFilesArtifactVersions(ctx, req.(*ListFilesArtifactVersionsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiContainerService_ConnectServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConnectServicesArgs)
 	if err := dec(in); err != nil {
@@ -758,6 +1201,164 @@ func _ApiContainerService_GetStarlarkRun_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_ExportEnclaveSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportEnclaveSnapshotArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).ExportEnclaveSnapshot(m, &apiContainerServiceExportEnclaveSnapshotServer{stream})
+}
+
+type ApiContainerService_ExportEnclaveSnapshotServer interface {
+	Send(*StreamedDataChunk) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceExportEnclaveSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceExportEnclaveSnapshotServer) Send(m *StreamedDataChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_ImportEnclaveSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApiContainerServiceServer).ImportEnclaveSnapshot(&apiContainerServiceImportEnclaveSnapshotServer{stream})
+}
+
+type ApiContainerService_ImportEnclaveSnapshotServer interface {
+	SendAndClose(*emptypb.Empty) error
+	Recv() (*StreamedDataChunk, error)
+	grpc.ServerStream
+}
+
+type apiContainerServiceImportEnclaveSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceImportEnclaveSnapshotServer) SendAndClose(m *emptypb.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceImportEnclaveSnapshotServer) Recv() (*StreamedDataChunk, error) {
+	m := new(StreamedDataChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ApiContainerService_ResumeServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).ResumeServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_ResumeServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).ResumeServices(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetEnclaveExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetEnclaveExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetEnclaveExpiry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetEnclaveExpiry(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_SetEnclaveExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveExpiry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).SetEnclaveExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_SetEnclaveExpiry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).SetEnclaveExpiry(ctx, req.(*EnclaveExpiry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetEnclaveResourceQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetEnclaveResourceQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetEnclaveResourceQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetEnclaveResourceQuotas(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetServicesStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetServicesStatsArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).GetServicesStats(m, &apiContainerServiceGetServicesStatsServer{stream})
+}
+
+type ApiContainerService_GetServicesStatsServer interface {
+	Send(*GetServicesStatsResponse) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceGetServicesStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceGetServicesStatsServer) Send(m *GetServicesStatsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_LintStarlark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LintStarlarkArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).LintStarlark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_LintStarlark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).LintStarlark(ctx, req.(*LintStarlarkArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiContainerService_ServiceDesc is the grpc.ServiceDesc for ApiContainerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -793,6 +1394,10 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StoreFilesArtifactFromService",
 			Handler:    _ApiContainerService_StoreFilesArtifactFromService_Handler,
 		},
+		{
+			MethodName: "CopyFilesArtifactToService",
+			Handler:    _ApiContainerService_CopyFilesArtifactToService_Handler,
+		},
 		{
 			MethodName: "ListFilesArtifactNamesAndUuids",
 			Handler:    _ApiContainerService_ListFilesArtifactNamesAndUuids_Handler,
@@ -801,6 +1406,14 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InspectFilesArtifactContents",
 			Handler:    _ApiContainerService_InspectFilesArtifactContents_Handler,
 		},
+		{
+			MethodName: "RemoveFilesArtifact",
+			Handler:    _ApiContainerService_RemoveFilesArtifact_Handler,
+		},
+		{
+			MethodName: "ListFilesArtifactVersions",
+			Handler:    _ApiContainerService_ListFilesArtifactVersions_Handler,
+		},
 		{
 			MethodName: "ConnectServices",
 			Handler:    _ApiContainerService_ConnectServices_Handler,
@@ -809,6 +1422,26 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStarlarkRun",
 			Handler:    _ApiContainerService_GetStarlarkRun_Handler,
 		},
+		{
+			MethodName: "ResumeServices",
+			Handler:    _ApiContainerService_ResumeServices_Handler,
+		},
+		{
+			MethodName: "GetEnclaveExpiry",
+			Handler:    _ApiContainerService_GetEnclaveExpiry_Handler,
+		},
+		{
+			MethodName: "SetEnclaveExpiry",
+			Handler:    _ApiContainerService_SetEnclaveExpiry_Handler,
+		},
+		{
+			MethodName: "GetEnclaveResourceQuotas",
+			Handler:    _ApiContainerService_GetEnclaveResourceQuotas_Handler,
+		},
+		{
+			MethodName: "LintStarlark",
+			Handler:    _ApiContainerService_LintStarlark_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -836,6 +1469,31 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_DownloadFilesArtifact_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "CopyFilesToService",
+			Handler:       _ApiContainerService_CopyFilesToService_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyFilesFromService",
+			Handler:       _ApiContainerService_CopyFilesFromService_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportEnclaveSnapshot",
+			Handler:       _ApiContainerService_ExportEnclaveSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportEnclaveSnapshot",
+			Handler:       _ApiContainerService_ImportEnclaveSnapshot_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetServicesStats",
+			Handler:       _ApiContainerService_GetServicesStats_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_container_service.proto",
 }
@@ -70,18 +70,57 @@ const (
 	// ApiContainerServiceStoreFilesArtifactFromServiceProcedure is the fully-qualified name of the
 	// ApiContainerService's StoreFilesArtifactFromService RPC.
 	ApiContainerServiceStoreFilesArtifactFromServiceProcedure = "/api_container_api.ApiContainerService/StoreFilesArtifactFromService"
+	// ApiContainerServiceCopyFilesToServiceProcedure is the fully-qualified name of the
+	// ApiContainerService's CopyFilesToService RPC.
+	ApiContainerServiceCopyFilesToServiceProcedure = "/api_container_api.ApiContainerService/CopyFilesToService"
+	// ApiContainerServiceCopyFilesArtifactToServiceProcedure is the fully-qualified name of the
+	// ApiContainerService's CopyFilesArtifactToService RPC.
+	ApiContainerServiceCopyFilesArtifactToServiceProcedure = "/api_container_api.ApiContainerService/CopyFilesArtifactToService"
+	// ApiContainerServiceCopyFilesFromServiceProcedure is the fully-qualified name of the
+	// ApiContainerService's CopyFilesFromService RPC.
+	ApiContainerServiceCopyFilesFromServiceProcedure = "/api_container_api.ApiContainerService/CopyFilesFromService"
 	// ApiContainerServiceListFilesArtifactNamesAndUuidsProcedure is the fully-qualified name of the
 	// ApiContainerService's ListFilesArtifactNamesAndUuids RPC.
 	ApiContainerServiceListFilesArtifactNamesAndUuidsProcedure = "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids"
 	// ApiContainerServiceInspectFilesArtifactContentsProcedure is the fully-qualified name of the
 	// ApiContainerService's InspectFilesArtifactContents RPC.
 	ApiContainerServiceInspectFilesArtifactContentsProcedure = "/api_container_api.ApiContainerService/InspectFilesArtifactContents"
+	// ApiContainerServiceRemoveFilesArtifactProcedure is the fully-qualified name of the
+	// ApiContainerService's RemoveFilesArtifact RPC.
+	ApiContainerServiceRemoveFilesArtifactProcedure = "/api_container_api.ApiContainerService/RemoveFilesArtifact"
+	// ApiContainerServiceListFilesArtifactVersionsProcedure is the fully-qualified name of the
+	// ApiContainerService's ListFilesArtifactVersions RPC.
+	ApiContainerServiceListFilesArtifactVersionsProcedure = "/api_container_api.ApiContainerService/ListFilesArtifactVersions"
 	// ApiContainerServiceConnectServicesProcedure is the fully-qualified name of the
 	// ApiContainerService's ConnectServices RPC.
 	ApiContainerServiceConnectServicesProcedure = "/api_container_api.ApiContainerService/ConnectServices"
 	// ApiContainerServiceGetStarlarkRunProcedure is the fully-qualified name of the
 	// ApiContainerService's GetStarlarkRun RPC.
 	ApiContainerServiceGetStarlarkRunProcedure = "/api_container_api.ApiContainerService/GetStarlarkRun"
+	// ApiContainerServiceExportEnclaveSnapshotProcedure is the fully-qualified name of the
+	// ApiContainerService's ExportEnclaveSnapshot RPC.
+	ApiContainerServiceExportEnclaveSnapshotProcedure = "/api_container_api.ApiContainerService/ExportEnclaveSnapshot"
+	// ApiContainerServiceImportEnclaveSnapshotProcedure is the fully-qualified name of the
+	// ApiContainerService's ImportEnclaveSnapshot RPC.
+	ApiContainerServiceImportEnclaveSnapshotProcedure = "/api_container_api.ApiContainerService/ImportEnclaveSnapshot"
+	// ApiContainerServiceResumeServicesProcedure is the fully-qualified name of the
+	// ApiContainerService's ResumeServices RPC.
+	ApiContainerServiceResumeServicesProcedure = "/api_container_api.ApiContainerService/ResumeServices"
+	// ApiContainerServiceGetEnclaveExpiryProcedure is the fully-qualified name of the
+	// ApiContainerService's GetEnclaveExpiry RPC.
+	ApiContainerServiceGetEnclaveExpiryProcedure = "/api_container_api.ApiContainerService/GetEnclaveExpiry"
+	// ApiContainerServiceSetEnclaveExpiryProcedure is the fully-qualified name of the
+	// ApiContainerService's SetEnclaveExpiry RPC.
+	ApiContainerServiceSetEnclaveExpiryProcedure = "/api_container_api.ApiContainerService/SetEnclaveExpiry"
+	// ApiContainerServiceGetEnclaveResourceQuotasProcedure is the fully-qualified name of the
+	// ApiContainerService's GetEnclaveResourceQuotas RPC.
+	ApiContainerServiceGetEnclaveResourceQuotasProcedure = "/api_container_api.ApiContainerService/GetEnclaveResourceQuotas"
+	// ApiContainerServiceGetServicesStatsProcedure is the fully-qualified name of the
+	// ApiContainerService's GetServicesStats RPC.
+	ApiContainerServiceGetServicesStatsProcedure = "/api_container_api.ApiContainerService/GetServicesStats"
+	// ApiContainerServiceLintStarlarkProcedure is the fully-qualified name of the ApiContainerService's
+	// LintStarlark RPC.
+	ApiContainerServiceLintStarlarkProcedure = "/api_container_api.ApiContainerService/LintStarlark"
 )
 
 // ApiContainerServiceClient is a client for the api_container_api.ApiContainerService service.
@@ -110,12 +149,39 @@ type ApiContainerServiceClient interface {
 	StoreWebFilesArtifact(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse], error)
 	// Tells the API container to copy a files artifact from a service to the Kurtosis File System
 	StoreFilesArtifactFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse], error)
+	// Copies the streamed TGZ, packaged like a files artifact, into a directory of a running service
+	CopyFilesToService(context.Context) *connect.ClientStreamForClient[kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk, emptypb.Empty]
+	// Copies the content of a files artifact into a directory of a running service
+	CopyFilesArtifactToService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs]) (*connect.Response[emptypb.Empty], error)
+	// Streams the content at a path of a running service, packaged as a TGZ
+	CopyFilesFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesFromServiceArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk], error)
 	ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error)
 	InspectFilesArtifactContents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse], error)
+	// Removes a files artifact from the Kurtosis File System, refusing to if a running service mounts it
+	RemoveFilesArtifact(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemoveFilesArtifactArgs]) (*connect.Response[emptypb.Empty], error)
+	// Lists the versions of a files artifact kept by the Kurtosis File System, from the oldest to the current one
+	ListFilesArtifactVersions(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsResponse], error)
 	// User services port forwarding
 	ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error)
 	// Get last Starlark run
 	GetStarlarkRun(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse], error)
+	// Exports the state of the enclave (services, files artifacts, runtime values and enclave plan) as a single archive
+	ExportEnclaveSnapshot(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExportEnclaveSnapshotArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk], error)
+	// Restores a snapshot produced by ExportEnclaveSnapshot into this enclave, which must not contain any service yet
+	ImportEnclaveSnapshot(context.Context) *connect.ClientStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk, emptypb.Empty]
+	// Restarts the services whose containers stopped along with the enclave, in the order they were added, and waits for their ready conditions
+	ResumeServices(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ResumeServicesResponse], error)
+	// Returns the expiry settings of the enclave along with the last time its API was called. Calling it doesn't count as activity
+	GetEnclaveExpiry(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.EnclaveExpiry], error)
+	// Replaces the expiry settings of the enclave, which are persisted in the enclave database
+	SetEnclaveExpiry(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.EnclaveExpiry]) (*connect.Response[emptypb.Empty], error)
+	// Returns the resource quotas set at the creation of the enclave along with how much of them is currently used
+	GetEnclaveResourceQuotas(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveResourceQuotasResponse], error)
+	// Returns the CPU, memory, network and block IO the running services consume, once or, when following, every few
+	// seconds until the client cancels
+	GetServicesStats(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServicesStatsArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.GetServicesStatsResponse], error)
+	// Lints the given Starlark files statically, without interpreting them, and returns the findings
+	LintStarlark(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.LintStarlarkArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.LintStarlarkResponse], error)
 }
 
 // NewApiContainerServiceClient constructs a client for the api_container_api.ApiContainerService
@@ -188,6 +254,21 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceStoreFilesArtifactFromServiceProcedure,
 			opts...,
 		),
+		copyFilesToService: connect.NewClient[kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceCopyFilesToServiceProcedure,
+			opts...,
+		),
+		copyFilesArtifactToService: connect.NewClient[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceCopyFilesArtifactToServiceProcedure,
+			opts...,
+		),
+		copyFilesFromService: connect.NewClient[kurtosis_core_rpc_api_bindings.CopyFilesFromServiceArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk](
+			httpClient,
+			baseURL+ApiContainerServiceCopyFilesFromServiceProcedure,
+			opts...,
+		),
 		listFilesArtifactNamesAndUuids: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse](
 			httpClient,
 			baseURL+ApiContainerServiceListFilesArtifactNamesAndUuidsProcedure,
@@ -198,6 +279,16 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceInspectFilesArtifactContentsProcedure,
 			opts...,
 		),
+		removeFilesArtifact: connect.NewClient[kurtosis_core_rpc_api_bindings.RemoveFilesArtifactArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceRemoveFilesArtifactProcedure,
+			opts...,
+		),
+		listFilesArtifactVersions: connect.NewClient[kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsArgs, kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceListFilesArtifactVersionsProcedure,
+			opts...,
+		),
 		connectServices: connect.NewClient[kurtosis_core_rpc_api_bindings.ConnectServicesArgs, kurtosis_core_rpc_api_bindings.ConnectServicesResponse](
 			httpClient,
 			baseURL+ApiContainerServiceConnectServicesProcedure,
@@ -208,6 +299,46 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceGetStarlarkRunProcedure,
 			opts...,
 		),
+		exportEnclaveSnapshot: connect.NewClient[kurtosis_core_rpc_api_bindings.ExportEnclaveSnapshotArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk](
+			httpClient,
+			baseURL+ApiContainerServiceExportEnclaveSnapshotProcedure,
+			opts...,
+		),
+		importEnclaveSnapshot: connect.NewClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceImportEnclaveSnapshotProcedure,
+			opts...,
+		),
+		resumeServices: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.ResumeServicesResponse](
+			httpClient,
+			baseURL+ApiContainerServiceResumeServicesProcedure,
+			opts...,
+		),
+		getEnclaveExpiry: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.EnclaveExpiry](
+			httpClient,
+			baseURL+ApiContainerServiceGetEnclaveExpiryProcedure,
+			opts...,
+		),
+		setEnclaveExpiry: connect.NewClient[kurtosis_core_rpc_api_bindings.EnclaveExpiry, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceSetEnclaveExpiryProcedure,
+			opts...,
+		),
+		getEnclaveResourceQuotas: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetEnclaveResourceQuotasResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetEnclaveResourceQuotasProcedure,
+			opts...,
+		),
+		getServicesStats: connect.NewClient[kurtosis_core_rpc_api_bindings.GetServicesStatsArgs, kurtosis_core_rpc_api_bindings.GetServicesStatsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetServicesStatsProcedure,
+			opts...,
+		),
+		lintStarlark: connect.NewClient[kurtosis_core_rpc_api_bindings.LintStarlarkArgs, kurtosis_core_rpc_api_bindings.LintStarlarkResponse](
+			httpClient,
+			baseURL+ApiContainerServiceLintStarlarkProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -225,10 +356,23 @@ type apiContainerServiceClient struct {
 	downloadFilesArtifact                      *connect.Client[kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk]
 	storeWebFilesArtifact                      *connect.Client[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs, kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse]
 	storeFilesArtifactFromService              *connect.Client[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs, kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse]
+	copyFilesToService                         *connect.Client[kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk, emptypb.Empty]
+	copyFilesArtifactToService                 *connect.Client[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs, emptypb.Empty]
+	copyFilesFromService                       *connect.Client[kurtosis_core_rpc_api_bindings.CopyFilesFromServiceArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk]
 	listFilesArtifactNamesAndUuids             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse]
 	inspectFilesArtifactContents               *connect.Client[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest, kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse]
+	removeFilesArtifact                        *connect.Client[kurtosis_core_rpc_api_bindings.RemoveFilesArtifactArgs, emptypb.Empty]
+	listFilesArtifactVersions                  *connect.Client[kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsArgs, kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsResponse]
 	connectServices                            *connect.Client[kurtosis_core_rpc_api_bindings.ConnectServicesArgs, kurtosis_core_rpc_api_bindings.ConnectServicesResponse]
 	getStarlarkRun                             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse]
+	exportEnclaveSnapshot                      *connect.Client[kurtosis_core_rpc_api_bindings.ExportEnclaveSnapshotArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk]
+	importEnclaveSnapshot                      *connect.Client[kurtosis_core_rpc_api_bindings.StreamedDataChunk, emptypb.Empty]
+	resumeServices                             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ResumeServicesResponse]
+	getEnclaveExpiry                           *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.EnclaveExpiry]
+	setEnclaveExpiry                           *connect.Client[kurtosis_core_rpc_api_bindings.EnclaveExpiry, emptypb.Empty]
+	getEnclaveResourceQuotas                   *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetEnclaveResourceQuotasResponse]
+	getServicesStats                           *connect.Client[kurtosis_core_rpc_api_bindings.GetServicesStatsArgs, kurtosis_core_rpc_api_bindings.GetServicesStatsResponse]
+	lintStarlark                               *connect.Client[kurtosis_core_rpc_api_bindings.LintStarlarkArgs, kurtosis_core_rpc_api_bindings.LintStarlarkResponse]
 }
 
 // RunStarlarkScript calls api_container_api.ApiContainerService.RunStarlarkScript.
@@ -295,6 +439,22 @@ func (c *apiContainerServiceClient) StoreFilesArtifactFromService(ctx context.Co
 	return c.storeFilesArtifactFromService.CallUnary(ctx, req)
 }
 
+// CopyFilesToService calls api_container_api.ApiContainerService.CopyFilesToService.
+func (c *apiContainerServiceClient) CopyFilesToService(ctx context.Context) *connect.ClientStreamForClient[kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk, emptypb.Empty] {
+	return c.copyFilesToService.CallClientStream(ctx)
+}
+
+// CopyFilesArtifactToService calls
+// api_container_api.ApiContainerService.CopyFilesArtifactToService.
+func (c *apiContainerServiceClient) CopyFilesArtifactToService(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.copyFilesArtifactToService.CallUnary(ctx, req)
+}
+
+// CopyFilesFromService calls api_container_api.ApiContainerService.CopyFilesFromService.
+func (c *apiContainerServiceClient) CopyFilesFromService(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesFromServiceArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk], error) {
+	return c.copyFilesFromService.CallServerStream(ctx, req)
+}
+
 // ListFilesArtifactNamesAndUuids calls
 // api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids.
 func (c *apiContainerServiceClient) ListFilesArtifactNamesAndUuids(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error) {
@@ -307,6 +467,16 @@ func (c *apiContainerServiceClient) InspectFilesArtifactContents(ctx context.Con
 	return c.inspectFilesArtifactContents.CallUnary(ctx, req)
 }
 
+// RemoveFilesArtifact calls api_container_api.ApiContainerService.RemoveFilesArtifact.
+func (c *apiContainerServiceClient) RemoveFilesArtifact(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.RemoveFilesArtifactArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeFilesArtifact.CallUnary(ctx, req)
+}
+
+// ListFilesArtifactVersions calls api_container_api.ApiContainerService.ListFilesArtifactVersions.
+func (c *apiContainerServiceClient) ListFilesArtifactVersions(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsResponse], error) {
+	return c.listFilesArtifactVersions.CallUnary(ctx, req)
+}
+
 // ConnectServices calls api_container_api.ApiContainerService.ConnectServices.
 func (c *apiContainerServiceClient) ConnectServices(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error) {
 	return c.connectServices.CallUnary(ctx, req)
@@ -317,6 +487,46 @@ func (c *apiContainerServiceClient) GetStarlarkRun(ctx context.Context, req *con
 	return c.getStarlarkRun.CallUnary(ctx, req)
 }
 
+// ExportEnclaveSnapshot calls api_container_api.ApiContainerService.ExportEnclaveSnapshot.
+func (c *apiContainerServiceClient) ExportEnclaveSnapshot(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.ExportEnclaveSnapshotArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk], error) {
+	return c.exportEnclaveSnapshot.CallServerStream(ctx, req)
+}
+
+// ImportEnclaveSnapshot calls api_container_api.ApiContainerService.ImportEnclaveSnapshot.
+func (c *apiContainerServiceClient) ImportEnclaveSnapshot(ctx context.Context) *connect.ClientStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk, emptypb.Empty] {
+	return c.importEnclaveSnapshot.CallClientStream(ctx)
+}
+
+// ResumeServices calls api_container_api.ApiContainerService.ResumeServices.
+func (c *apiContainerServiceClient) ResumeServices(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ResumeServicesResponse], error) {
+	return c.resumeServices.CallUnary(ctx, req)
+}
+
+// GetEnclaveExpiry calls api_container_api.ApiContainerService.GetEnclaveExpiry.
+func (c *apiContainerServiceClient) GetEnclaveExpiry(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.EnclaveExpiry], error) {
+	return c.getEnclaveExpiry.CallUnary(ctx, req)
+}
+
+// SetEnclaveExpiry calls api_container_api.ApiContainerService.SetEnclaveExpiry.
+func (c *apiContainerServiceClient) SetEnclaveExpiry(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.EnclaveExpiry]) (*connect.Response[emptypb.Empty], error) {
+	return c.setEnclaveExpiry.CallUnary(ctx, req)
+}
+
+// GetEnclaveResourceQuotas calls api_container_api.ApiContainerService.GetEnclaveResourceQuotas.
+func (c *apiContainerServiceClient) GetEnclaveResourceQuotas(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveResourceQuotasResponse], error) {
+	return c.getEnclaveResourceQuotas.CallUnary(ctx, req)
+}
+
+// GetServicesStats calls api_container_api.ApiContainerService.GetServicesStats.
+func (c *apiContainerServiceClient) GetServicesStats(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetServicesStatsArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.GetServicesStatsResponse], error) {
+	return c.getServicesStats.CallServerStream(ctx, req)
+}
+
+// LintStarlark calls api_container_api.ApiContainerService.LintStarlark.
+func (c *apiContainerServiceClient) LintStarlark(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.LintStarlarkArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.LintStarlarkResponse], error) {
+	return c.lintStarlark.CallUnary(ctx, req)
+}
+
 // ApiContainerServiceHandler is an implementation of the api_container_api.ApiContainerService
 // service.
 type ApiContainerServiceHandler interface {
@@ -344,12 +554,39 @@ type ApiContainerServiceHandler interface {
 	StoreWebFilesArtifact(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse], error)
 	// Tells the API container to copy a files artifact from a service to the Kurtosis File System
 	StoreFilesArtifactFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse], error)
+	// Copies the streamed TGZ, packaged like a files artifact, into a directory of a running service
+	CopyFilesToService(context.Context, *connect.ClientStream[kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk]) (*connect.Response[emptypb.Empty], error)
+	// Copies the content of a files artifact into a directory of a running service
+	CopyFilesArtifactToService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs]) (*connect.Response[emptypb.Empty], error)
+	// Streams the content at a path of a running service, packaged as a TGZ
+	CopyFilesFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesFromServiceArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) error
 	ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error)
 	InspectFilesArtifactContents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse], error)
+	// Removes a files artifact from the Kurtosis File System, refusing to if a running service mounts it
+	RemoveFilesArtifact(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemoveFilesArtifactArgs]) (*connect.Response[emptypb.Empty], error)
+	// Lists the versions of a files artifact kept by the Kurtosis File System, from the oldest to the current one
+	ListFilesArtifactVersions(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsResponse], error)
 	// User services port forwarding
 	ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error)
 	// Get last Starlark run
 	GetStarlarkRun(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse], error)
+	// Exports the state of the enclave (services, files artifacts, runtime values and enclave plan) as a single archive
+	ExportEnclaveSnapshot(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExportEnclaveSnapshotArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) error
+	// Restores a snapshot produced by ExportEnclaveSnapshot into this enclave, which must not contain any service yet
+	ImportEnclaveSnapshot(context.Context, *connect.ClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) (*connect.Response[emptypb.Empty], error)
+	// Restarts the services whose containers stopped along with the enclave, in the order they were added, and waits for their ready conditions
+	ResumeServices(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ResumeServicesResponse], error)
+	// Returns the expiry settings of the enclave along with the last time its API was called. Calling it doesn't count as activity
+	GetEnclaveExpiry(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.EnclaveExpiry], error)
+	// Replaces the expiry settings of the enclave, which are persisted in the enclave database
+	SetEnclaveExpiry(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.EnclaveExpiry]) (*connect.Response[emptypb.Empty], error)
+	// Returns the resource quotas set at the creation of the enclave along with how much of them is currently used
+	GetEnclaveResourceQuotas(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveResourceQuotasResponse], error)
+	// Returns the CPU, memory, network and block IO the running services consume, once or, when following, every few
+	// seconds until the client cancels
+	GetServicesStats(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServicesStatsArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.GetServicesStatsResponse]) error
+	// Lints the given Starlark files statically, without interpreting them, and returns the findings
+	LintStarlark(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.LintStarlarkArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.LintStarlarkResponse], error)
 }
 
 // NewApiContainerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -418,6 +655,21 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.StoreFilesArtifactFromService,
 		opts...,
 	)
+	apiContainerServiceCopyFilesToServiceHandler := connect.NewClientStreamHandler(
+		ApiContainerServiceCopyFilesToServiceProcedure,
+		svc.CopyFilesToService,
+		opts...,
+	)
+	apiContainerServiceCopyFilesArtifactToServiceHandler := connect.NewUnaryHandler(
+		ApiContainerServiceCopyFilesArtifactToServiceProcedure,
+		svc.CopyFilesArtifactToService,
+		opts...,
+	)
+	apiContainerServiceCopyFilesFromServiceHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceCopyFilesFromServiceProcedure,
+		svc.CopyFilesFromService,
+		opts...,
+	)
 	apiContainerServiceListFilesArtifactNamesAndUuidsHandler := connect.NewUnaryHandler(
 		ApiContainerServiceListFilesArtifactNamesAndUuidsProcedure,
 		svc.ListFilesArtifactNamesAndUuids,
@@ -428,6 +680,16 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.InspectFilesArtifactContents,
 		opts...,
 	)
+	apiContainerServiceRemoveFilesArtifactHandler := connect.NewUnaryHandler(
+		ApiContainerServiceRemoveFilesArtifactProcedure,
+		svc.RemoveFilesArtifact,
+		opts...,
+	)
+	apiContainerServiceListFilesArtifactVersionsHandler := connect.NewUnaryHandler(
+		ApiContainerServiceListFilesArtifactVersionsProcedure,
+		svc.ListFilesArtifactVersions,
+		opts...,
+	)
 	apiContainerServiceConnectServicesHandler := connect.NewUnaryHandler(
 		ApiContainerServiceConnectServicesProcedure,
 		svc.ConnectServices,
@@ -438,6 +700,46 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.GetStarlarkRun,
 		opts...,
 	)
+	apiContainerServiceExportEnclaveSnapshotHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceExportEnclaveSnapshotProcedure,
+		svc.ExportEnclaveSnapshot,
+		opts...,
+	)
+	apiContainerServiceImportEnclaveSnapshotHandler := connect.NewClientStreamHandler(
+		ApiContainerServiceImportEnclaveSnapshotProcedure,
+		svc.ImportEnclaveSnapshot,
+		opts...,
+	)
+	apiContainerServiceResumeServicesHandler := connect.NewUnaryHandler(
+		ApiContainerServiceResumeServicesProcedure,
+		svc.ResumeServices,
+		opts...,
+	)
+	apiContainerServiceGetEnclaveExpiryHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetEnclaveExpiryProcedure,
+		svc.GetEnclaveExpiry,
+		opts...,
+	)
+	apiContainerServiceSetEnclaveExpiryHandler := connect.NewUnaryHandler(
+		ApiContainerServiceSetEnclaveExpiryProcedure,
+		svc.SetEnclaveExpiry,
+		opts...,
+	)
+	apiContainerServiceGetEnclaveResourceQuotasHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetEnclaveResourceQuotasProcedure,
+		svc.GetEnclaveResourceQuotas,
+		opts...,
+	)
+	apiContainerServiceGetServicesStatsHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceGetServicesStatsProcedure,
+		svc.GetServicesStats,
+		opts...,
+	)
+	apiContainerServiceLintStarlarkHandler := connect.NewUnaryHandler(
+		ApiContainerServiceLintStarlarkProcedure,
+		svc.LintStarlark,
+		opts...,
+	)
 	return "/api_container_api.ApiContainerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ApiContainerServiceRunStarlarkScriptProcedure:
@@ -464,14 +766,40 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceStoreWebFilesArtifactHandler.ServeHTTP(w, r)
 		case ApiContainerServiceStoreFilesArtifactFromServiceProcedure:
 			apiContainerServiceStoreFilesArtifactFromServiceHandler.ServeHTTP(w, r)
+		case ApiContainerServiceCopyFilesToServiceProcedure:
+			apiContainerServiceCopyFilesToServiceHandler.ServeHTTP(w, r)
+		case ApiContainerServiceCopyFilesArtifactToServiceProcedure:
+			apiContainerServiceCopyFilesArtifactToServiceHandler.ServeHTTP(w, r)
+		case ApiContainerServiceCopyFilesFromServiceProcedure:
+			apiContainerServiceCopyFilesFromServiceHandler.ServeHTTP(w, r)
 		case ApiContainerServiceListFilesArtifactNamesAndUuidsProcedure:
 			apiContainerServiceListFilesArtifactNamesAndUuidsHandler.ServeHTTP(w, r)
 		case ApiContainerServiceInspectFilesArtifactContentsProcedure:
 			apiContainerServiceInspectFilesArtifactContentsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRemoveFilesArtifactProcedure:
+			apiContainerServiceRemoveFilesArtifactHandler.ServeHTTP(w, r)
+		case ApiContainerServiceListFilesArtifactVersionsProcedure:
+			apiContainerServiceListFilesArtifactVersionsHandler.ServeHTTP(w, r)
 		case ApiContainerServiceConnectServicesProcedure:
 			apiContainerServiceConnectServicesHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkRunProcedure:
 			apiContainerServiceGetStarlarkRunHandler.ServeHTTP(w, r)
+		case ApiContainerServiceExportEnclaveSnapshotProcedure:
+			apiContainerServiceExportEnclaveSnapshotHandler.ServeHTTP(w, r)
+		case ApiContainerServiceImportEnclaveSnapshotProcedure:
+			apiContainerServiceImportEnclaveSnapshotHandler.ServeHTTP(w, r)
+		case ApiContainerServiceResumeServicesProcedure:
+			apiContainerServiceResumeServicesHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetEnclaveExpiryProcedure:
+			apiContainerServiceGetEnclaveExpiryHandler.ServeHTTP(w, r)
+		case ApiContainerServiceSetEnclaveExpiryProcedure:
+			apiContainerServiceSetEnclaveExpiryHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetEnclaveResourceQuotasProcedure:
+			apiContainerServiceGetEnclaveResourceQuotasHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetServicesStatsProcedure:
+			apiContainerServiceGetServicesStatsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceLintStarlarkProcedure:
+			apiContainerServiceLintStarlarkHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -529,6 +857,18 @@ func (UnimplementedApiContainerServiceHandler) StoreFilesArtifactFromService(con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.StoreFilesArtifactFromService is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) CopyFilesToService(context.Context, *connect.ClientStream[kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.CopyFilesToService is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) CopyFilesArtifactToService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.CopyFilesArtifactToService is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) CopyFilesFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesFromServiceArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.CopyFilesFromService is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids is not implemented"))
 }
@@ -537,6 +877,14 @@ func (UnimplementedApiContainerServiceHandler) InspectFilesArtifactContents(cont
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.InspectFilesArtifactContents is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) RemoveFilesArtifact(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemoveFilesArtifactArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RemoveFilesArtifact is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) ListFilesArtifactVersions(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ListFilesArtifactVersions is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ConnectServices is not implemented"))
 }
@@ -544,3 +892,35 @@ func (UnimplementedApiContainerServiceHandler) ConnectServices(context.Context,
 func (UnimplementedApiContainerServiceHandler) GetStarlarkRun(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkRun is not implemented"))
 }
+
+func (UnimplementedApiContainerServiceHandler) ExportEnclaveSnapshot(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExportEnclaveSnapshotArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ExportEnclaveSnapshot is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) ImportEnclaveSnapshot(context.Context, *connect.ClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ImportEnclaveSnapshot is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) ResumeServices(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ResumeServicesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ResumeServices is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetEnclaveExpiry(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.EnclaveExpiry], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetEnclaveExpiry is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) SetEnclaveExpiry(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.EnclaveExpiry]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.SetEnclaveExpiry is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetEnclaveResourceQuotas(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveResourceQuotasResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetEnclaveResourceQuotas is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetServicesStats(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServicesStatsArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.GetServicesStatsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetServicesStats is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) LintStarlark(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.LintStarlarkArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.LintStarlarkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.LintStarlark is not implemented"))
+}
@@ -42,6 +42,8 @@ func NewRunStarlarkScriptArgs(
 	cloudInstanceId string,
 	cloudUserId string,
 	imageDownloadMode kurtosis_core_rpc_api_bindings.ImageDownloadMode,
+	gitCredentials []*kurtosis_core_rpc_api_bindings.GitCredential,
+	diff bool,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkScriptArgs {
 	cloudInstanceIdCopy := new(string)
 	*cloudInstanceIdCopy = cloudInstanceId
@@ -61,6 +63,8 @@ func NewRunStarlarkScriptArgs(
 		CloudInstanceId:      cloudInstanceIdCopy,
 		CloudUserId:          cloudUserIdCopy,
 		ImageDownloadMode:    imageDownloadModeCopy,
+		GitCredentials:       gitCredentials,
+		Diff:                 &diff,
 	}
 }
 
@@ -75,6 +79,9 @@ func NewRunStarlarkPackageArgs(
 	cloudInstanceId string,
 	cloudUserId string,
 	imageDownloadMode kurtosis_core_rpc_api_bindings.ImageDownloadMode,
+	gitCredentials []*kurtosis_core_rpc_api_bindings.GitCredential,
+	packageLockMode kurtosis_core_rpc_api_bindings.PackageLockMode,
+	diff bool,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
@@ -84,6 +91,8 @@ func NewRunStarlarkPackageArgs(
 	*cloudUserIdCopy = cloudUserId
 	imageDownloadModeCopy := new(kurtosis_core_rpc_api_bindings.ImageDownloadMode)
 	*imageDownloadModeCopy = imageDownloadMode
+	packageLockModeCopy := new(kurtosis_core_rpc_api_bindings.PackageLockMode)
+	*packageLockModeCopy = packageLockMode
 	clonePackage := false
 	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs{
 		PackageId:              packageId,
@@ -98,6 +107,9 @@ func NewRunStarlarkPackageArgs(
 		CloudInstanceId:        cloudInstanceIdCopy,
 		CloudUserId:            cloudUserIdCopy,
 		ImageDownloadMode:      imageDownloadModeCopy,
+		GitCredentials:         gitCredentials,
+		PackageLockMode:        packageLockModeCopy,
+		Diff:                   &diff,
 	}
 }
 
@@ -112,6 +124,9 @@ func NewRunStarlarkRemotePackageArgs(
 	cloudInstanceId string,
 	cloudUserId string,
 	imageDownloadMode kurtosis_core_rpc_api_bindings.ImageDownloadMode,
+	gitCredentials []*kurtosis_core_rpc_api_bindings.GitCredential,
+	packageLockMode kurtosis_core_rpc_api_bindings.PackageLockMode,
+	diff bool,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
@@ -121,6 +136,8 @@ func NewRunStarlarkRemotePackageArgs(
 	*cloudUserIdCopy = cloudUserId
 	imageDownloadModeCopy := new(kurtosis_core_rpc_api_bindings.ImageDownloadMode)
 	*imageDownloadModeCopy = imageDownloadMode
+	packageLockModeCopy := new(kurtosis_core_rpc_api_bindings.PackageLockMode)
+	*packageLockModeCopy = packageLockMode
 	clonePackage := true
 	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs{
 		PackageId:              packageId,
@@ -135,6 +152,44 @@ func NewRunStarlarkRemotePackageArgs(
 		CloudInstanceId:        cloudInstanceIdCopy,
 		CloudUserId:            cloudUserIdCopy,
 		ImageDownloadMode:      imageDownloadModeCopy,
+		GitCredentials:         gitCredentials,
+		PackageLockMode:        packageLockModeCopy,
+		Diff:                   &diff,
+	}
+}
+
+func NewHttpsTokenGitCredential(host string, username string, token string) *kurtosis_core_rpc_api_bindings.GitCredential {
+	var usernameCopy *string
+	if username != "" {
+		usernameCopy = new(string)
+		*usernameCopy = username
+	}
+	return &kurtosis_core_rpc_api_bindings.GitCredential{
+		Host: host,
+		Credential: &kurtosis_core_rpc_api_bindings.GitCredential_HttpsToken{
+			HttpsToken: &kurtosis_core_rpc_api_bindings.HttpsTokenGitCredential{
+				Username: usernameCopy,
+				Token:    token,
+			},
+		},
+	}
+}
+
+func NewSshKeyGitCredential(host string, privateKey []byte, passphrase string, knownHosts []byte) *kurtosis_core_rpc_api_bindings.GitCredential {
+	var passphraseCopy *string
+	if passphrase != "" {
+		passphraseCopy = new(string)
+		*passphraseCopy = passphrase
+	}
+	return &kurtosis_core_rpc_api_bindings.GitCredential{
+		Host: host,
+		Credential: &kurtosis_core_rpc_api_bindings.GitCredential_SshKey{
+			SshKey: &kurtosis_core_rpc_api_bindings.SshKeyGitCredential{
+				PrivateKey: privateKey,
+				Passphrase: passphraseCopy,
+				KnownHosts: knownHosts,
+			},
+		},
 	}
 }
 
@@ -263,6 +318,37 @@ func NewStarlarkRunResponseLineFromRunSuccessEvent(serializedOutputObject string
 	}
 }
 
+func NewStarlarkRunResponseLineFromPlanDiff(planDiff *kurtosis_core_rpc_api_bindings.StarlarkPlanDiff) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_PlanDiff{
+			PlanDiff: planDiff,
+		},
+	}
+}
+
+func NewStarlarkPlanDiff(serviceChanges []*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange, filesArtifactChanges []*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange, instructionsToExecute []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, skippedInstructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction) *kurtosis_core_rpc_api_bindings.StarlarkPlanDiff {
+	return &kurtosis_core_rpc_api_bindings.StarlarkPlanDiff{
+		ServiceChanges:        serviceChanges,
+		FilesArtifactChanges:  filesArtifactChanges,
+		InstructionsToExecute: instructionsToExecute,
+		SkippedInstructions:   skippedInstructions,
+	}
+}
+
+func NewStarlarkPlanDiffComponentChange(name string, changeType kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType) *kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange {
+	return &kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange{
+		Name:       name,
+		ChangeType: changeType,
+	}
+}
+
+func NewPackageDependency(repository string, commit string) *kurtosis_core_rpc_api_bindings.PackageDependency {
+	return &kurtosis_core_rpc_api_bindings.PackageDependency{
+		Repository: repository,
+		Commit:     commit,
+	}
+}
+
 func NewStarlarkInstruction(position *kurtosis_core_rpc_api_bindings.StarlarkInstructionPosition, name string, executableInstruction string, arguments []*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg, isSkipped bool) *kurtosis_core_rpc_api_bindings.StarlarkInstruction {
 	return &kurtosis_core_rpc_api_bindings.StarlarkInstruction{
 		InstructionName:       name,
@@ -327,6 +413,19 @@ func NewGetServicesArgs(serviceIdentifiers map[string]bool) *kurtosis_core_rpc_a
 	}
 }
 
+func NewGetServicesStatsArgs(serviceIdentifiers map[string]bool, follow bool) *kurtosis_core_rpc_api_bindings.GetServicesStatsArgs {
+	return &kurtosis_core_rpc_api_bindings.GetServicesStatsArgs{
+		ServiceIdentifiers: serviceIdentifiers,
+		Follow:             follow,
+	}
+}
+
+func NewLintStarlarkArgs(starlarkFiles map[string][]byte) *kurtosis_core_rpc_api_bindings.LintStarlarkArgs {
+	return &kurtosis_core_rpc_api_bindings.LintStarlarkArgs{
+		StarlarkFiles: starlarkFiles,
+	}
+}
+
 func NewGetServicesResponse(
 	serviceInfo map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo,
 ) *kurtosis_core_rpc_api_bindings.GetServicesResponse {
@@ -345,6 +444,8 @@ func NewServiceInfo(
 	maybePublicPorts map[string]*kurtosis_core_rpc_api_bindings.Port,
 	serviceStatus kurtosis_core_rpc_api_bindings.ServiceStatus,
 	container *kurtosis_core_rpc_api_bindings.Container,
+	healthStatus kurtosis_core_rpc_api_bindings.ServiceHealthStatus,
+	restartCount uint32,
 ) *kurtosis_core_rpc_api_bindings.ServiceInfo {
 	return &kurtosis_core_rpc_api_bindings.ServiceInfo{
 		ServiceUuid:       uuid,
@@ -356,6 +457,8 @@ func NewServiceInfo(
 		MaybePublicPorts:  maybePublicPorts,
 		ServiceStatus:     serviceStatus,
 		Container:         container,
+		HealthStatus:      healthStatus,
+		RestartCount:      restartCount,
 	}
 }
 
@@ -411,6 +514,59 @@ func NewStoreWebFilesArtifactArgs(url string, name string) *kurtosis_core_rpc_ap
 func DownloadFilesArtifactArgs(fileIdentifier string) *kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs {
 	return &kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs{
 		Identifier: fileIdentifier,
+		Version:    nil,
+	}
+}
+
+func DownloadFilesArtifactVersionArgs(fileIdentifier string, version uint32) *kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs {
+	return &kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs{
+		Identifier: fileIdentifier,
+		Version:    &version,
+	}
+}
+
+// ==============================================================================================
+//
+//	Remove Files Artifact
+//
+// ==============================================================================================
+
+func NewRemoveFilesArtifactArgs(fileIdentifier string) *kurtosis_core_rpc_api_bindings.RemoveFilesArtifactArgs {
+	return &kurtosis_core_rpc_api_bindings.RemoveFilesArtifactArgs{
+		Identifier: fileIdentifier,
+	}
+}
+
+// ==============================================================================================
+//
+//	List Files Artifact Versions
+//
+// ==============================================================================================
+
+func NewListFilesArtifactVersionsArgs(fileIdentifier string) *kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsArgs {
+	return &kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsArgs{
+		Identifier: fileIdentifier,
+	}
+}
+
+// ==============================================================================================
+//
+//	Copy Files To And From Service
+//
+// ==============================================================================================
+
+func NewCopyFilesArtifactToServiceArgs(fileIdentifier string, serviceIdentifier string, destinationPath string) *kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs {
+	return &kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs{
+		FilesArtifactIdentifier: fileIdentifier,
+		ServiceIdentifier:       serviceIdentifier,
+		DestinationPath:         destinationPath,
+	}
+}
+
+func NewCopyFilesFromServiceArgs(serviceIdentifier string, sourcePath string) *kurtosis_core_rpc_api_bindings.CopyFilesFromServiceArgs {
+	return &kurtosis_core_rpc_api_bindings.CopyFilesFromServiceArgs{
+		ServiceIdentifier: serviceIdentifier,
+		SourcePath:        sourcePath,
 	}
 }
 
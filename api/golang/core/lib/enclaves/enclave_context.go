@@ -47,6 +47,12 @@ const (
 	osPathSeparatorString = string(os.PathSeparator)
 
 	dotRelativePathIndicatorString = "."
+
+	followServicesStats      = true
+	doNotFollowServicesStats = false
+
+	// the services stats stream ends after its first error
+	servicesStatsErrChanBufferSize = 1
 )
 
 // Docs available at https://docs.kurtosis.com/sdk/#enclavecontext
@@ -96,7 +102,7 @@ func (enclaveCtx *EnclaveContext) RunStarlarkScript(
 		return nil, nil, stacktrace.Propagate(err, "An error occurred when parsing YAML args for script '%v'", oldSerializedParams)
 	}
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
-	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkScriptArgs(runConfig.MainFunctionName, serializedScript, serializedParams, runConfig.DryRun, runConfig.Parallelism, runConfig.ExperimentalFeatureFlags, runConfig.CloudInstanceId, runConfig.CloudUserId, runConfig.ImageDownload)
+	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkScriptArgs(runConfig.MainFunctionName, serializedScript, serializedParams, runConfig.DryRun, runConfig.Parallelism, runConfig.ExperimentalFeatureFlags, runConfig.CloudInstanceId, runConfig.CloudUserId, runConfig.ImageDownload, runConfig.GitCredentials, runConfig.Diff)
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 
 	stream, err := enclaveCtx.client.RunStarlarkScript(ctxWithCancel, executeStartosisScriptArgs)
@@ -148,7 +154,7 @@ func (enclaveCtx *EnclaveContext) RunStarlarkPackage(
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting Kurtosis yaml file from path '%s'", packageRootPath)
 	}
 
-	executeStartosisPackageArgs, err := enclaveCtx.assembleRunStartosisPackageArg(kurtosisYml, runConfig.RelativePathToMainFile, runConfig.MainFunctionName, serializedParams, runConfig.DryRun, runConfig.Parallelism, runConfig.ExperimentalFeatureFlags, runConfig.CloudInstanceId, runConfig.CloudUserId, runConfig.ImageDownload)
+	executeStartosisPackageArgs, err := enclaveCtx.assembleRunStartosisPackageArg(kurtosisYml, runConfig.RelativePathToMainFile, runConfig.MainFunctionName, serializedParams, runConfig.DryRun, runConfig.Parallelism, runConfig.ExperimentalFeatureFlags, runConfig.CloudInstanceId, runConfig.CloudUserId, runConfig.ImageDownload, runConfig.GitCredentials, runConfig.PackageLockMode, runConfig.Diff)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Error preparing package '%s' for execution", packageRootPath)
 	}
@@ -234,7 +240,7 @@ func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(
 	}()
 
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
-	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, runConfig.RelativePathToMainFile, runConfig.MainFunctionName, serializedParams, runConfig.DryRun, runConfig.Parallelism, runConfig.ExperimentalFeatureFlags, runConfig.CloudInstanceId, runConfig.CloudUserId, runConfig.ImageDownload)
+	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, runConfig.RelativePathToMainFile, runConfig.MainFunctionName, serializedParams, runConfig.DryRun, runConfig.Parallelism, runConfig.ExperimentalFeatureFlags, runConfig.CloudInstanceId, runConfig.CloudUserId, runConfig.ImageDownload, runConfig.GitCredentials, runConfig.PackageLockMode, runConfig.Diff)
 
 	stream, err := enclaveCtx.client.RunStarlarkPackage(ctxWithCancel, executeStartosisScriptArgs)
 	if err != nil {
@@ -378,36 +384,114 @@ func (enclaveCtx *EnclaveContext) StoreWebFiles(ctx context.Context, urlToStoreW
 // Docs available at https://docs.kurtosis.com/sdk#downloadfilesartifact-fileidentifier-string
 func (enclaveCtx *EnclaveContext) DownloadFilesArtifact(ctx context.Context, artifactIdentifier string) ([]byte, error) {
 	args := binding_constructors.DownloadFilesArtifactArgs(artifactIdentifier)
+	fileContent, err := enclaveCtx.downloadFilesArtifact(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", artifactIdentifier)
+	}
+	return fileContent, nil
+}
 
-	client, err := enclaveCtx.client.DownloadFilesArtifact(ctx, args)
+// Docs available at https://docs.kurtosis.com/sdk#downloadfilesartifactversionstring-artifactidentifier-uint32-version
+func (enclaveCtx *EnclaveContext) DownloadFilesArtifactVersion(ctx context.Context, artifactIdentifier string, version uint32) ([]byte, error) {
+	args := binding_constructors.DownloadFilesArtifactVersionArgs(artifactIdentifier, version)
+	fileContent, err := enclaveCtx.downloadFilesArtifact(ctx, args)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred initiating the download of files artifact '%v'", artifactIdentifier)
+		return nil, stacktrace.Propagate(err, "An error occurred downloading version '%d' of files artifact '%v'", version, artifactIdentifier)
+	}
+	return fileContent, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#getfilesartifactversionsstring-artifactidentifier---filesartifactversion-versions
+func (enclaveCtx *EnclaveContext) GetFilesArtifactVersions(ctx context.Context, artifactIdentifier string) ([]*kurtosis_core_rpc_api_bindings.FilesArtifactVersion, error) {
+	args := binding_constructors.NewListFilesArtifactVersionsArgs(artifactIdentifier)
+	response, err := enclaveCtx.client.ListFilesArtifactVersions(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the versions of files artifact '%v'", artifactIdentifier)
+	}
+	return response.GetVersions(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#removefilesartifactstring-artifactidentifier
+func (enclaveCtx *EnclaveContext) RemoveFilesArtifact(ctx context.Context, artifactIdentifier string) error {
+	args := binding_constructors.NewRemoveFilesArtifactArgs(artifactIdentifier)
+	if _, err := enclaveCtx.client.RemoveFilesArtifact(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing files artifact '%v'", artifactIdentifier)
+	}
+	return nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#copyfilestoservicestring-serviceidentifier-string-pathtocopy-string-destinationpath
+func (enclaveCtx *EnclaveContext) CopyFilesToService(ctx context.Context, serviceIdentifier string, pathToCopy string, destinationPath string) error {
+	content, contentSize, _, err := shared_utils.CompressPath(pathToCopy, enforceMaxFileSizeLimit)
+	if err != nil {
+		return stacktrace.Propagate(err, "There was an error compressing '%v' before copying it", pathToCopy)
+	}
+	defer content.Close()
+
+	client, err := enclaveCtx.client.CopyFilesToService(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error was encountered initiating the copy of the files to the API Container.")
+	}
+	clientStream := grpc_file_streaming.NewClientStream[kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk, emptypb.Empty](client)
+	_, err = clientStream.SendData(
+		pathToCopy,
+		content,
+		contentSize,
+		func(previousChunkHash string, contentChunk []byte) (*kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk, error) {
+			return &kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk{
+				Chunk: &kurtosis_core_rpc_api_bindings.StreamedDataChunk{
+					Data:              contentChunk,
+					PreviousChunkHash: previousChunkHash,
+					Metadata: &kurtosis_core_rpc_api_bindings.DataChunkMetadata{
+						Name: pathToCopy,
+					},
+				},
+				ServiceIdentifier: serviceIdentifier,
+				DestinationPath:   destinationPath,
+			}, nil
+		},
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying '%v' to destination '%v' of service '%v'", pathToCopy, destinationPath, serviceIdentifier)
+	}
+	return nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#copyfilesartifacttoservicestring-serviceidentifier-string-artifactidentifier-string-destinationpath
+func (enclaveCtx *EnclaveContext) CopyFilesArtifactToService(ctx context.Context, serviceIdentifier string, artifactIdentifier string, destinationPath string) error {
+	args := binding_constructors.NewCopyFilesArtifactToServiceArgs(artifactIdentifier, serviceIdentifier, destinationPath)
+	if _, err := enclaveCtx.client.CopyFilesArtifactToService(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying files artifact '%v' to destination '%v' of service '%v'", artifactIdentifier, destinationPath, serviceIdentifier)
+	}
+	return nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#copyfilesfromservicestring-serviceidentifier-string-sourcepath---byte-content
+func (enclaveCtx *EnclaveContext) CopyFilesFromService(ctx context.Context, serviceIdentifier string, sourcePath string) ([]byte, error) {
+	args := binding_constructors.NewCopyFilesFromServiceArgs(serviceIdentifier, sourcePath)
+	client, err := enclaveCtx.client.CopyFilesFromService(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred initiating the copy of '%v' from service '%v'", sourcePath, serviceIdentifier)
 	}
 	clientStream := grpc_file_streaming.NewClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](client)
-	fileContent, err := clientStream.ReceiveData(
-		artifactIdentifier,
+	content, err := clientStream.ReceiveData(
+		sourcePath,
 		func(dataChunk *kurtosis_core_rpc_api_bindings.StreamedDataChunk) ([]byte, string, error) {
 			return dataChunk.Data, dataChunk.PreviousChunkHash, nil
 		},
 	)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", artifactIdentifier)
+		return nil, stacktrace.Propagate(err, "An error occurred copying '%v' from service '%v'", sourcePath, serviceIdentifier)
 	}
-	return fileContent, nil
+	return content, nil
 }
 
 func (enclaveCtx *EnclaveContext) InspectFilesArtifact(ctx context.Context, artifactName services.FileArtifactName) (*kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse, error) {
-	// TODO(vcolombo): Add a more intuitive return type to this call instead of returning the RPC response
-	response, err := enclaveCtx.client.InspectFilesArtifactContents(ctx, &kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest{
-		FileNamesAndUuid: &kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{
-			FileName: string(artifactName),
-			FileUuid: "",
-		},
-	})
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred inspecting file artifacts")
-	}
-	return response, nil
+	return enclaveCtx.inspectFilesArtifact(ctx, artifactName, nil)
+}
+
+func (enclaveCtx *EnclaveContext) InspectFilesArtifactVersion(ctx context.Context, artifactName services.FileArtifactName, version uint32) (*kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse, error) {
+	return enclaveCtx.inspectFilesArtifact(ctx, artifactName, &version)
 }
 
 // Docs available at https://docs.kurtosis.com/sdk#getexistingandhistoricalserviceidentifiers---serviceidentifiers-serviceidentifiers
@@ -447,6 +531,59 @@ func (enclaveCtx *EnclaveContext) GetStarlarkRun(ctx context.Context) (*kurtosis
 	return response, nil
 }
 
+// GetResourceQuotas returns the resource quotas set at the creation of the enclave along with their current usage
+func (enclaveCtx *EnclaveContext) GetResourceQuotas(ctx context.Context) (*kurtosis_core_rpc_api_bindings.GetEnclaveResourceQuotasResponse, error) {
+	response, err := enclaveCtx.client.GetEnclaveResourceQuotas(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the resource quotas of the enclave")
+	}
+	return response, nil
+}
+
+// LintStarlark lints the given Starlark files, keyed by path, as the files of a package. The findings are returned
+// along with the rules the linter runs; linting never changes the enclave
+func (enclaveCtx *EnclaveContext) LintStarlark(ctx context.Context, starlarkFiles map[string][]byte) (*kurtosis_core_rpc_api_bindings.LintStarlarkResponse, error) {
+	response, err := enclaveCtx.client.LintStarlark(ctx, binding_constructors.NewLintStarlarkArgs(starlarkFiles))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while linting the Starlark files")
+	}
+	return response, nil
+}
+
+// GetServicesStats returns a single sample of the resource usage of the given services, or of all the running services
+// of the enclave if none is given, keyed by service name, along with the errors of the services that couldn't be sampled
+func (enclaveCtx *EnclaveContext) GetServicesStats(ctx context.Context, serviceIdentifiers []string) (*kurtosis_core_rpc_api_bindings.GetServicesStatsResponse, error) {
+	args := binding_constructors.NewGetServicesStatsArgs(newServiceIdentifiersSet(serviceIdentifiers), doNotFollowServicesStats)
+	stream, err := enclaveCtx.client.GetServicesStats(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred requesting the stats of services '%v'", serviceIdentifiers)
+	}
+	response, err := stream.Recv()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred receiving the stats of services '%v'", serviceIdentifiers)
+	}
+	return response, nil
+}
+
+// StreamServicesStats is like GetServicesStats, but keeps sending a new sample of the resource usage on the returned
+// channel until the context is cancelled. The channel is closed when the stream ends; if it ended because of an error
+// rather than a cancellation, the error is sent on the returned error channel first
+func (enclaveCtx *EnclaveContext) StreamServicesStats(ctx context.Context, serviceIdentifiers []string) (chan *kurtosis_core_rpc_api_bindings.GetServicesStatsResponse, chan error, context.CancelFunc, error) {
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
+	args := binding_constructors.NewGetServicesStatsArgs(newServiceIdentifiersSet(serviceIdentifiers), followServicesStats)
+	servicesStatsChan := make(chan *kurtosis_core_rpc_api_bindings.GetServicesStatsResponse)
+	servicesStatsErrChan := make(chan error, servicesStatsErrChanBufferSize)
+
+	stream, err := enclaveCtx.client.GetServicesStats(ctxWithCancel, args)
+	if err != nil {
+		cancelCtxFunc() // manually call the cancel function as something went wrong
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred requesting the stats of services '%v'", serviceIdentifiers)
+	}
+
+	go runReceiveServicesStatsRoutine(ctxWithCancel, cancelCtxFunc, stream, servicesStatsChan, servicesStatsErrChan)
+	return servicesStatsChan, servicesStatsErrChan, cancelCtxFunc, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -505,6 +642,45 @@ func runReceiveStarlarkResponseLineRoutine(cancelCtxFunc context.CancelFunc, str
 	}
 }
 
+func runReceiveServicesStatsRoutine(
+	ctx context.Context,
+	cancelCtxFunc context.CancelFunc,
+	stream grpc.ClientStream,
+	servicesStatsChan chan *kurtosis_core_rpc_api_bindings.GetServicesStatsResponse,
+	servicesStatsErrChan chan error,
+) {
+	defer func() {
+		close(servicesStatsChan)
+		close(servicesStatsErrChan)
+		cancelCtxFunc()
+	}()
+	for {
+		servicesStats := new(kurtosis_core_rpc_api_bindings.GetServicesStatsResponse)
+		err := stream.RecvMsg(servicesStats)
+		if err == io.EOF {
+			logrus.Debugf("Successfully reached the end of the services stats stream. Closing.")
+			return
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				logrus.Debugf("Stopped reading the services stats stream as the client cancelled it\n%v", err.Error())
+				return
+			}
+			servicesStatsErrChan <- stacktrace.Propagate(err, "An error occurred reading the services stats stream")
+			return
+		}
+		servicesStatsChan <- servicesStats
+	}
+}
+
+func newServiceIdentifiersSet(serviceIdentifiers []string) map[string]bool {
+	serviceIdentifiersSet := map[string]bool{}
+	for _, serviceIdentifier := range serviceIdentifiers {
+		serviceIdentifiersSet[serviceIdentifier] = true
+	}
+	return serviceIdentifiersSet
+}
+
 func getErrFromStarlarkRunResult(result *StarlarkRunResult) error {
 	if result.InterpretationError != nil {
 		return stacktrace.NewError(result.InterpretationError.GetErrorMessage())
@@ -522,6 +698,40 @@ func getErrFromStarlarkRunResult(result *StarlarkRunResult) error {
 	return nil
 }
 
+func (enclaveCtx *EnclaveContext) downloadFilesArtifact(ctx context.Context, args *kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs) ([]byte, error) {
+	artifactIdentifier := args.GetIdentifier()
+	client, err := enclaveCtx.client.DownloadFilesArtifact(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred initiating the download of files artifact '%v'", artifactIdentifier)
+	}
+	clientStream := grpc_file_streaming.NewClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](client)
+	fileContent, err := clientStream.ReceiveData(
+		artifactIdentifier,
+		func(dataChunk *kurtosis_core_rpc_api_bindings.StreamedDataChunk) ([]byte, string, error) {
+			return dataChunk.Data, dataChunk.PreviousChunkHash, nil
+		},
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", artifactIdentifier)
+	}
+	return fileContent, nil
+}
+
+func (enclaveCtx *EnclaveContext) inspectFilesArtifact(ctx context.Context, artifactName services.FileArtifactName, maybeVersion *uint32) (*kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse, error) {
+	// TODO(vcolombo): Add a more intuitive return type to this call instead of returning the RPC response
+	response, err := enclaveCtx.client.InspectFilesArtifactContents(ctx, &kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest{
+		FileNamesAndUuid: &kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{
+			FileName: string(artifactName),
+			FileUuid: "",
+		},
+		Version: maybeVersion,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred inspecting file artifacts")
+	}
+	return response, nil
+}
+
 func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(
 	kurtosisYaml *KurtosisYaml,
 	relativePathToMainFile string,
@@ -533,9 +743,12 @@ func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(
 	cloudInstanceId string,
 	cloudUserId string,
 	imageDownloadMode kurtosis_core_rpc_api_bindings.ImageDownloadMode,
+	gitCredentials []*kurtosis_core_rpc_api_bindings.GitCredential,
+	packageLockMode kurtosis_core_rpc_api_bindings.PackageLockMode,
+	diff bool,
 ) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, error) {
 
-	return binding_constructors.NewRunStarlarkPackageArgs(kurtosisYaml.PackageName, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures, cloudInstanceId, cloudUserId, imageDownloadMode), nil
+	return binding_constructors.NewRunStarlarkPackageArgs(kurtosisYaml.PackageName, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures, cloudInstanceId, cloudUserId, imageDownloadMode, gitCredentials, packageLockMode, diff), nil
 }
 
 func (enclaveCtx *EnclaveContext) uploadStarlarkPackage(packageId string, packageRootPath string) error {
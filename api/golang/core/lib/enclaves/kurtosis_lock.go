@@ -0,0 +1,66 @@
+package enclaves
+
+import (
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+)
+
+const (
+	KurtosisLockFilename = "kurtosis.lock"
+
+	kurtosisLockFilePermissions os.FileMode = 0644
+	kurtosisLockHeader                      = "# This file is generated by 'kurtosis package lock' and 'kurtosis package update'; do not edit it manually.\n" +
+		"# It pins every package this package imports from Git to the commit it resolved to.\n"
+)
+
+// KurtosisLock pins the packages a package imports, keyed by repository, to the commit they resolved to
+// fields are public because it's needed for YAML encoding
+type KurtosisLock struct {
+	Dependencies map[string]string `yaml:"dependencies"`
+}
+
+func NewKurtosisLock(dependencies map[string]string) *KurtosisLock {
+	return &KurtosisLock{Dependencies: dependencies}
+}
+
+func NewKurtosisLockFromPackageDependencies(packageDependencies []*kurtosis_core_rpc_api_bindings.PackageDependency) *KurtosisLock {
+	dependencies := map[string]string{}
+	for _, packageDependency := range packageDependencies {
+		dependencies[packageDependency.GetRepository()] = packageDependency.GetCommit()
+	}
+	return NewKurtosisLock(dependencies)
+}
+
+// ParseKurtosisLock returns a nil lock, and no error, if there's no lock at the given path
+func ParseKurtosisLock(kurtosisLockFilepath string) (*KurtosisLock, error) {
+	kurtosisLockContents, err := os.ReadFile(kurtosisLockFilepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred while reading the '%v' file at '%v'", KurtosisLockFilename, kurtosisLockFilepath)
+	}
+
+	var kurtosisLock KurtosisLock
+	if err = yaml.UnmarshalStrict(kurtosisLockContents, &kurtosisLock); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while parsing the '%v' file at '%v'", KurtosisLockFilename, kurtosisLockFilepath)
+	}
+	if kurtosisLock.Dependencies == nil {
+		kurtosisLock.Dependencies = map[string]string{}
+	}
+	return &kurtosisLock, nil
+}
+
+func (kurtosisLock *KurtosisLock) Write(kurtosisLockFilepath string) error {
+	kurtosisLockContents, err := yaml.Marshal(kurtosisLock)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while serializing the '%v' file", KurtosisLockFilename)
+	}
+	kurtosisLockContents = append([]byte(kurtosisLockHeader), kurtosisLockContents...)
+	if err = os.WriteFile(kurtosisLockFilepath, kurtosisLockContents, kurtosisLockFilePermissions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while writing the '%v' file at '%v'", KurtosisLockFilename, kurtosisLockFilepath)
+	}
+	return nil
+}
@@ -21,15 +21,22 @@ type StarlarkRunResult struct {
 	ValidationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError
 
 	ExecutionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
+
+	ResolvedPackageDependencies []*kurtosis_core_rpc_api_bindings.PackageDependency
+
+	// Only set when the run was a diff
+	PlanDiff *kurtosis_core_rpc_api_bindings.StarlarkPlanDiff
 }
 
-func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError, validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) *StarlarkRunResult {
+func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError, validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError, resolvedPackageDependencies []*kurtosis_core_rpc_api_bindings.PackageDependency, planDiff *kurtosis_core_rpc_api_bindings.StarlarkPlanDiff) *StarlarkRunResult {
 	return &StarlarkRunResult{
-		RunOutput:           runOutput,
-		Instructions:        instructions,
-		InterpretationError: interpretationError,
-		ValidationErrors:    validationErrors,
-		ExecutionError:      executionError,
+		RunOutput:                   runOutput,
+		Instructions:                instructions,
+		InterpretationError:         interpretationError,
+		ValidationErrors:            validationErrors,
+		ExecutionError:              executionError,
+		ResolvedPackageDependencies: resolvedPackageDependencies,
+		PlanDiff:                    planDiff,
 	}
 }
 
@@ -39,6 +46,8 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 	var interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError
 	validationErrors := make([]*kurtosis_core_rpc_api_bindings.StarlarkValidationError, 0)
 	var executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
+	var resolvedPackageDependencies []*kurtosis_core_rpc_api_bindings.PackageDependency
+	var planDiff *kurtosis_core_rpc_api_bindings.StarlarkPlanDiff
 
 	for responseLine := range starlarkRunResponseLines {
 		if responseLine.GetInstruction() != nil {
@@ -54,8 +63,11 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 			} else if responseLine.GetError().GetExecutionError() != nil {
 				executionError = responseLine.GetError().GetExecutionError()
 			}
+		} else if responseLine.GetPlanDiff() != nil {
+			planDiff = responseLine.GetPlanDiff()
 		} else if responseLine.GetRunFinishedEvent() != nil {
 			runFinishedEvent := responseLine.GetRunFinishedEvent()
+			resolvedPackageDependencies = runFinishedEvent.GetResolvedPackageDependencies()
 			if runFinishedEvent.GetIsRunSuccessful() && runFinishedEvent.GetSerializedOutput() != "" {
 				scriptOutput.WriteString(runFinishedEvent.GetSerializedOutput())
 				scriptOutput.WriteString(starlarkRunOutputLinesSplit)
@@ -67,5 +79,7 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 		instructions,
 		interpretationError,
 		validationErrors,
-		executionError)
+		executionError,
+		resolvedPackageDependencies,
+		planDiff)
 }
@@ -0,0 +1,156 @@
+package kubernetes_port_forward
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	k8s_rest "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	localHostIpStr                = "127.0.0.1"
+	portForwardTimeoutDuration    = 5 * time.Second
+	portForwardTimeBetweenRetries = 5 * time.Second
+
+	// A local port of 0 means the host assigns a random local port
+	RandomLocalPortNumber = uint16(0)
+
+	portBindingFormat = "%v:%v"
+	dialerMethod      = "POST"
+)
+
+// PodPortForward forwards local ports to the ports of a pod through the port-forward API of the Kubernetes API server,
+// reconnecting to the pod on the same local ports when the connection to it is lost
+type PodPortForward struct {
+	portforwarder            *portforward.PortForwarder
+	portforwarderStopChannel chan struct{}
+
+	stopChannel chan struct{}
+
+	urlString string
+}
+
+// NewPodPortForward binds the local ports to the remote ports of the pod exposed by the port-forward API at the given
+// URL, and returns once the local ports are bound
+// localPortNumbersByRemotePortNumber is keyed by the remote port numbers, RandomLocalPortNumber binding a random local port
+func NewPodPortForward(kubernetesRestConfig *k8s_rest.Config, podPortForwardEndpointUrl *url.URL, localPortNumbersByRemotePortNumber map[uint16]uint16) (*PodPortForward, error) {
+	var portforwardStdOut bytes.Buffer
+	var portforwardStdErr bytes.Buffer
+	portforwardStopChannel := make(chan struct{}, 1)
+	portforwardReadyChannel := make(chan struct{}, 1)
+	stopChannel := make(chan struct{}, 1)
+	portForwardAddresses := []string{localHostIpStr}
+
+	// Array of strings describing local-port:remote-port bindings
+	portStrings := []string{}
+	for remotePortNumber, localPortNumber := range localPortNumbersByRemotePortNumber {
+		portStrings = append(portStrings, fmt.Sprintf(portBindingFormat, localPortNumber, remotePortNumber))
+	}
+
+	podPortForward := &PodPortForward{
+		portforwarder:            nil,
+		portforwarderStopChannel: portforwardStopChannel,
+		stopChannel:              stopChannel,
+		urlString:                podPortForwardEndpointUrl.String(),
+	}
+
+	// Connection to pod portforwarder endpoint
+	transport, upgrader, err := spdy.RoundTripperFor(kubernetesRestConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a SPDY round-tripper for the Kubernetes REST config")
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{
+		Transport:     transport,
+		CheckRedirect: nil,
+		Jar:           nil,
+		Timeout:       0,
+	}, dialerMethod, podPortForwardEndpointUrl)
+
+	// Start forwarding ports asynchronously with reconnect logic.
+	// The reconnect logic tries to reconnect after a working connection is lost.
+	// The port forward process can be interrupted using the port forwarder stop channel.  There is no retry after that.
+	go func() {
+		retries := 0
+		readyChannel := portforwardReadyChannel
+		for {
+			podPortForward.portforwarder, err = portforward.NewOnAddresses(dialer, portForwardAddresses, portStrings, portforwardStopChannel, readyChannel, &portforwardStdOut, &portforwardStdErr)
+			if err != nil {
+				// Addresses or ports cannot be parsed so there is nothing else to try
+				logrus.Errorf("An error occured parsing the port forwarder addresses or ports:\n%v", err)
+				return
+			} else {
+				logrus.Debugf("Trying to forward ports for pod: %s", podPortForwardEndpointUrl.String())
+				if err = podPortForward.portforwarder.ForwardPorts(); err != nil {
+					if err == portforward.ErrLostConnectionToPod {
+						logrus.Infof("Lost connection to pod: %s", podPortForwardEndpointUrl.String())
+						retries = 0
+						// Copy the port forwarder assigned local ports so we re-use the same local ports when we reconnect
+						ports, err := podPortForward.portforwarder.GetPorts()
+						if err != nil {
+							logrus.Errorf("An error occured retrieving the local ports to remote ports mapping for our portforwarder:\n%v", err)
+							return
+						}
+						portStrings = nil
+						for _, port := range ports {
+							portStrings = append(portStrings, fmt.Sprintf(portBindingFormat, port.Local, port.Remote))
+						}
+					} else {
+						if retries == 0 {
+							// Exit the retry logic if the first try to connect fails
+							logrus.Errorf("Expected to be able to start forwarding local ports to remote ports, instead our portforwarder has returned a non-nil err:\n%v", err)
+							return
+						}
+						logrus.Debugf("Error trying to forward ports:\n%v", err)
+					}
+				} else {
+					// ForwardPorts() returns nil when we close the connection using the stop channel.
+					// Do not try to reconnect.
+					return
+				}
+				select {
+				case <-stopChannel:
+					return
+				default:
+				}
+				time.Sleep(portForwardTimeBetweenRetries)
+				retries += 1
+				logrus.Debugf("Retrying (%d) connection to pod: %s", retries, podPortForwardEndpointUrl.String())
+				readyChannel = make(chan struct{}, 1)
+			}
+		}
+	}()
+
+	// Wait for the portforwarder to be ready with timeout
+	select {
+	case <-portforwardReadyChannel:
+	case <-time.After(portForwardTimeoutDuration):
+		return nil, stacktrace.NewError("Expected Kubernetes portforwarder to open local ports to the pod exposed by the portforward api at URL '%v', instead the Kubernetes portforwarder timed out binding local ports", podPortForwardEndpointUrl)
+	}
+	return podPortForward, nil
+}
+
+// GetLocalPortNumbersByRemotePortNumber returns the local ports bound, keyed by the remote port they forward to
+func (podPortForward *PodPortForward) GetLocalPortNumbersByRemotePortNumber() (map[uint16]uint16, error) {
+	forwardedPorts, err := podPortForward.portforwarder.GetPorts()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get forwarded ports from our running portforwarder, instead a non-nil err was returned")
+	}
+	localPortNumbersByRemotePortNumber := map[uint16]uint16{}
+	for _, forwardedPort := range forwardedPorts {
+		localPortNumbersByRemotePortNumber[forwardedPort.Remote] = forwardedPort.Local
+	}
+	return localPortNumbersByRemotePortNumber, nil
+}
+
+func (podPortForward *PodPortForward) Stop() {
+	logrus.Infof("Closing connection to pod: %s", podPortForward.urlString)
+	close(podPortForward.stopChannel)
+	podPortForward.portforwarder.Close()
+	close(podPortForward.portforwarderStopChannel)
+}
@@ -0,0 +1,77 @@
+package kubernetes_port_forward
+
+import (
+	"context"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	k8s_rest "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"net/url"
+)
+
+const (
+	// These are the labels the Kubernetes backend of container-engine-lib puts on the pods of the user services, which
+	// the SDK can't depend on
+	appIdLabelKey                     = "kurtosistech.com/app-id"
+	appIdLabelValue                   = "kurtosis"
+	resourceTypeLabelKey              = "kurtosistech.com/resource-type"
+	userServiceResourceTypeLabelValue = "user-service"
+	guidLabelKey                      = "kurtosistech.com/guid"
+
+	podsResource           = "pods"
+	portForwardSubResource = "portforward"
+)
+
+// LoadKubernetesRestConfig returns the config of the current context of the kubeconfig, found in the same places as
+// kubectl looks for it
+func LoadKubernetesRestConfig() (*k8s_rest.Config, error) {
+	kubernetesConfigLoader := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		//nolint:exhaustruct
+		&clientcmd.ConfigOverrides{},
+	)
+	kubernetesRestConfig, err := kubernetesConfigLoader.ClientConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the Kubernetes configuration of the current kubeconfig context")
+	}
+	return kubernetesRestConfig, nil
+}
+
+// GetUserServicePodPortForwardEndpointUrl returns the URL of the port-forward API of the running pod of the user service
+// with the given UUID. The pod is looked up in every namespace, as the namespace of its enclave isn't known to a service
+func GetUserServicePodPortForwardEndpointUrl(ctx context.Context, kubernetesClientSet kubernetes.Interface, serviceUuid string) (*url.URL, error) {
+	userServicePod, err := getRunningUserServicePod(ctx, kubernetesClientSet, serviceUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the running pod of service '%v'", serviceUuid)
+	}
+	return kubernetesClientSet.CoreV1().RESTClient().Post().Resource(podsResource).Namespace(userServicePod.Namespace).Name(userServicePod.Name).SubResource(portForwardSubResource).URL(), nil
+}
+
+func getRunningUserServicePod(ctx context.Context, kubernetesClientSet kubernetes.Interface, serviceUuid string) (*apiv1.Pod, error) {
+	userServicePodLabels := map[string]string{
+		appIdLabelKey:        appIdLabelValue,
+		resourceTypeLabelKey: userServiceResourceTypeLabelValue,
+		guidLabelKey:         serviceUuid,
+	}
+	//nolint:exhaustruct
+	podList, err := kubernetesClientSet.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(userServicePodLabels).String(),
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get pods with labels '%+v', instead a non-nil error was returned", userServicePodLabels)
+	}
+	var runningPods []*apiv1.Pod
+	for podIdx := range podList.Items {
+		pod := &podList.Items[podIdx]
+		if pod.Status.Phase == apiv1.PodRunning {
+			runningPods = append(runningPods, pod)
+		}
+	}
+	if len(runningPods) != 1 {
+		return nil, stacktrace.NewError("Expected to find exactly 1 running pod for service '%v', instead found '%v'", serviceUuid, len(runningPods))
+	}
+	return runningPods[0], nil
+}
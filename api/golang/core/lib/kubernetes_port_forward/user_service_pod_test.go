@@ -0,0 +1,54 @@
+package kubernetes_port_forward
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"testing"
+)
+
+const (
+	serviceUuid      = "c5b2b9e8a7f34d30b1b4a4e4f0d43d11"
+	otherServiceUuid = "0a1e3b0d8c4f4ef2a3e6c9b1f7d2e5a4"
+	enclaveNamespace = "kt-test-enclave-2d3c4b5a"
+	serviceName      = "web"
+)
+
+func TestGetRunningUserServicePod_FindsThePodInItsEnclaveNamespace(t *testing.T) {
+	kubernetesClientSet := fake.NewSimpleClientset(
+		newUserServicePod(serviceName, serviceUuid, apiv1.PodRunning),
+		newUserServicePod("db", otherServiceUuid, apiv1.PodRunning),
+	)
+
+	pod, err := getRunningUserServicePod(context.Background(), kubernetesClientSet, serviceUuid)
+	require.NoError(t, err)
+	require.Equal(t, enclaveNamespace, pod.Namespace)
+	require.Equal(t, serviceName, pod.Name)
+}
+
+func TestGetRunningUserServicePod_FailsIfThePodIsNotRunning(t *testing.T) {
+	kubernetesClientSet := fake.NewSimpleClientset(newUserServicePod(serviceName, serviceUuid, apiv1.PodPending))
+
+	_, err := getRunningUserServicePod(context.Background(), kubernetesClientSet, serviceUuid)
+	require.Error(t, err)
+}
+
+func newUserServicePod(name string, uuid string, phase apiv1.PodPhase) *apiv1.Pod {
+	//nolint:exhaustruct
+	return &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: enclaveNamespace,
+			Labels: map[string]string{
+				appIdLabelKey:        appIdLabelValue,
+				resourceTypeLabelKey: userServiceResourceTypeLabelValue,
+				guidLabelKey:         uuid,
+			},
+		},
+		Status: apiv1.PodStatus{
+			Phase: phase,
+		},
+	}
+}
@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/kubernetes_port_forward"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	"k8s.io/client-go/kubernetes"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	localHostIpAddr = "127.0.0.1"
+	tcpNetwork      = "tcp"
+
+	// the remote port is dialed again, refreshing its address from the API container in case the service got restarted
+	// with a different public port, until it accepts the connection or the attempts are exhausted
+	maxRemotePortDialAttempts     = 5
+	timeBetweenRemotePortDials    = 1 * time.Second
+	remotePortDialTimeoutDuration = 5 * time.Second
+)
+
+// PortForward forwards the connections made to a local TCP port to a port of a service, until it's closed
+type PortForward struct {
+	serviceCtx *ServiceContext
+	portId     string
+
+	localPortNumber uint16
+
+	// Nil when the port is forwarded through the port-forward API of Kubernetes, as the services have no public port there
+	listener net.Listener
+
+	// The address of the service port the connections are forwarded to, refreshed when it stops accepting connections
+	remoteAddrMutex *sync.Mutex
+	remoteAddr      string
+
+	cancelFunc      context.CancelFunc
+	connectionsWait *sync.WaitGroup
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#forwardportcontext-ctx-string-portid-uint16-localportnumber---portforward-portforward
+func (service *ServiceContext) ForwardPort(ctx context.Context, portId string, localPortNumber uint16) (*PortForward, error) {
+	publicPort, found := service.publicPorts[portId]
+	if !found {
+		// on Kubernetes the services have no public port unless a Kurtosis gateway is running, so the port of the pod is
+		// forwarded through the Kubernetes API server instead
+		if privatePort, isPrivatePort := service.privatePorts[portId]; isPrivatePort {
+			return service.forwardPodPort(ctx, portId, privatePort, localPortNumber)
+		}
+		return nil, stacktrace.NewError("Service '%v' has no port with ID '%v'", service.serviceName, portId)
+	}
+	if publicPort.GetTransportProtocol() != TransportProtocol_TCP {
+		return nil, stacktrace.NewError(
+			"Port '%v' of service '%v' uses transport protocol '%v', but only '%v' ports can be forwarded",
+			portId,
+			service.serviceName,
+			kurtosis_core_rpc_api_bindings.Port_TransportProtocol(publicPort.GetTransportProtocol()),
+			kurtosis_core_rpc_api_bindings.Port_TCP,
+		)
+	}
+
+	localAddr := net.JoinHostPort(localHostIpAddr, strconv.Itoa(int(localPortNumber)))
+	listener, err := net.Listen(tcpNetwork, localAddr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listening on local address '%v'", localAddr)
+	}
+
+	forwardCtx, cancelFunc := context.WithCancel(ctx)
+	portForward := &PortForward{
+		serviceCtx: service,
+		portId:     portId,
+		//nolint:forcetypeassert
+		localPortNumber: uint16(listener.Addr().(*net.TCPAddr).Port),
+		listener:        listener,
+		remoteAddrMutex: &sync.Mutex{},
+		remoteAddr:      getPortAddr(service.publicIpAddr, publicPort.GetNumber()),
+		cancelFunc:      cancelFunc,
+		connectionsWait: &sync.WaitGroup{},
+	}
+	go portForward.runAcceptConnectionsRoutine(forwardCtx)
+	go func() {
+		// Closing the listener unblocks the accept loop once the forward gets closed or its context cancelled
+		<-forwardCtx.Done()
+		if err := listener.Close(); err != nil {
+			logrus.Debugf("An error occurred closing the listener on local address '%v':\n%v", localAddr, err)
+		}
+	}()
+	return portForward, nil
+}
+
+// GetLocalPortNumber returns the local port the connections to forward are made to, which is useful when the port to
+// listen on was picked by the OS
+func (portForward *PortForward) GetLocalPortNumber() uint16 {
+	return portForward.localPortNumber
+}
+
+// Close stops accepting connections on the local port and waits for the connections being forwarded to end
+func (portForward *PortForward) Close() {
+	portForward.cancelFunc()
+	portForward.connectionsWait.Wait()
+}
+
+// ====================================================================================================
+//
+//	Private helper methods
+//
+// ====================================================================================================
+// forwardPodPort forwards the local port to the port of the service pod through the port-forward API of the Kubernetes
+// API server of the current kubeconfig context, which reconnects to the pod when it gets restarted
+func (service *ServiceContext) forwardPodPort(ctx context.Context, portId string, privatePort *PortSpec, localPortNumber uint16) (*PortForward, error) {
+	if privatePort.GetTransportProtocol() != TransportProtocol_TCP {
+		return nil, stacktrace.NewError(
+			"Port '%v' of service '%v' uses transport protocol '%v', but Kubernetes only supports forwarding '%v' ports",
+			portId,
+			service.serviceName,
+			kurtosis_core_rpc_api_bindings.Port_TransportProtocol(privatePort.GetTransportProtocol()),
+			kurtosis_core_rpc_api_bindings.Port_TCP,
+		)
+	}
+	kubernetesRestConfig, err := kubernetes_port_forward.LoadKubernetesRestConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the Kubernetes configuration to forward port '%v' of service '%v' with, as the service has no public port", portId, service.serviceName)
+	}
+	kubernetesClientSet, err := kubernetes.NewForConfig(kubernetesRestConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes client set")
+	}
+	podPortForwardEndpointUrl, err := kubernetes_port_forward.GetUserServicePodPortForwardEndpointUrl(ctx, kubernetesClientSet, string(service.serviceUuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the port-forward endpoint of the pod of service '%v'", service.serviceName)
+	}
+	podPortForward, err := kubernetes_port_forward.NewPodPortForward(kubernetesRestConfig, podPortForwardEndpointUrl, map[uint16]uint16{
+		privatePort.GetNumber(): localPortNumber,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred forwarding local port '%v' to port '%v' of service '%v'", localPortNumber, portId, service.serviceName)
+	}
+	localPortNumbersByRemotePortNumber, err := podPortForward.GetLocalPortNumbersByRemotePortNumber()
+	if err != nil {
+		podPortForward.Stop()
+		return nil, stacktrace.Propagate(err, "An error occurred getting the local port forwarded to port '%v' of service '%v'", portId, service.serviceName)
+	}
+
+	forwardCtx, cancelFunc := context.WithCancel(ctx)
+	portForward := &PortForward{
+		serviceCtx:      service,
+		portId:          portId,
+		localPortNumber: localPortNumbersByRemotePortNumber[privatePort.GetNumber()],
+		listener:        nil,
+		remoteAddrMutex: &sync.Mutex{},
+		remoteAddr:      "",
+		cancelFunc:      cancelFunc,
+		connectionsWait: &sync.WaitGroup{},
+	}
+	portForward.connectionsWait.Add(1)
+	go func() {
+		defer portForward.connectionsWait.Done()
+		<-forwardCtx.Done()
+		podPortForward.Stop()
+	}()
+	return portForward, nil
+}
+
+func (portForward *PortForward) runAcceptConnectionsRoutine(ctx context.Context) {
+	for {
+		localConn, err := portForward.listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil {
+				logrus.Errorf("An error occurred accepting a connection on local port '%v', no more connections will be forwarded:\n%v", portForward.GetLocalPortNumber(), err)
+			}
+			return
+		}
+		portForward.connectionsWait.Add(1)
+		go func() {
+			defer portForward.connectionsWait.Done()
+			portForward.forwardConnection(ctx, localConn)
+		}()
+	}
+}
+
+func (portForward *PortForward) forwardConnection(ctx context.Context, localConn net.Conn) {
+	defer localConn.Close()
+
+	remoteConn, err := portForward.dialRemotePort(ctx)
+	if err != nil {
+		logrus.Errorf("An error occurred forwarding a connection to port '%v' of service '%v':\n%v", portForward.portId, portForward.serviceCtx.serviceName, err)
+		return
+	}
+	defer remoteConn.Close()
+
+	// Closing both connections once the context is cancelled or either side is done unblocks the other copy
+	connCtx, cancelConnFunc := context.WithCancel(ctx)
+	defer cancelConnFunc()
+	go func() {
+		<-connCtx.Done()
+		localConn.Close()
+		remoteConn.Close()
+	}()
+	copyDoneChan := make(chan struct{}, 2)
+	go func() {
+		//nolint:errcheck
+		io.Copy(remoteConn, localConn)
+		copyDoneChan <- struct{}{}
+	}()
+	go func() {
+		//nolint:errcheck
+		io.Copy(localConn, remoteConn)
+		copyDoneChan <- struct{}{}
+	}()
+	<-copyDoneChan
+}
+
+func (portForward *PortForward) dialRemotePort(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{
+		Timeout: remotePortDialTimeoutDuration,
+	}
+	var lastErr error
+	for attempt := 1; attempt <= maxRemotePortDialAttempts; attempt++ {
+		remoteAddr := portForward.getRemoteAddr()
+		remoteConn, err := dialer.DialContext(ctx, tcpNetwork, remoteAddr)
+		if err == nil {
+			return remoteConn, nil
+		}
+		lastErr = err
+		logrus.Debugf("Attempt %d to connect to '%v' failed:\n%v", attempt, remoteAddr, err)
+
+		select {
+		case <-ctx.Done():
+			return nil, stacktrace.Propagate(ctx.Err(), "The port forward was closed while connecting to '%v'", remoteAddr)
+		case <-time.After(timeBetweenRemotePortDials):
+		}
+		if err := portForward.refreshRemoteAddr(ctx); err != nil {
+			logrus.Debugf("An error occurred refreshing the address of port '%v' of service '%v':\n%v", portForward.portId, portForward.serviceCtx.serviceName, err)
+		}
+	}
+	return nil, stacktrace.Propagate(lastErr, "Couldn't connect to port '%v' of service '%v' after %d attempts", portForward.portId, portForward.serviceCtx.serviceName, maxRemotePortDialAttempts)
+}
+
+// refreshRemoteAddr gets the public address of the forwarded port from the API container again, as it changes when the
+// service gets restarted
+func (portForward *PortForward) refreshRemoteAddr(ctx context.Context) error {
+	serviceName := string(portForward.serviceCtx.serviceName)
+	getServicesArgs := binding_constructors.NewGetServicesArgs(map[string]bool{serviceName: true})
+	response, err := portForward.serviceCtx.client.GetServices(ctx, getServicesArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting info for service '%v'", serviceName)
+	}
+	serviceInfo, found := response.GetServiceInfo()[serviceName]
+	if !found {
+		return stacktrace.NewError("Service '%v' doesn't exist anymore", serviceName)
+	}
+	publicPort, found := serviceInfo.GetMaybePublicPorts()[portForward.portId]
+	if !found {
+		return stacktrace.NewError("Service '%v' doesn't have a public port with ID '%v' anymore", serviceName, portForward.portId)
+	}
+	remoteAddr := getPortAddr(serviceInfo.GetMaybePublicIpAddr(), uint16(publicPort.GetNumber()))
+
+	portForward.remoteAddrMutex.Lock()
+	defer portForward.remoteAddrMutex.Unlock()
+	if remoteAddr != portForward.remoteAddr {
+		logrus.Infof("Port '%v' of service '%v' moved to '%v', forwarding the connections there", portForward.portId, serviceName, remoteAddr)
+		portForward.remoteAddr = remoteAddr
+	}
+	return nil
+}
+
+func (portForward *PortForward) getRemoteAddr() string {
+	portForward.remoteAddrMutex.Lock()
+	defer portForward.remoteAddrMutex.Unlock()
+	return portForward.remoteAddr
+}
+
+func getPortAddr(ipAddr string, portNumber uint16) string {
+	return net.JoinHostPort(ipAddr, fmt.Sprintf("%d", portNumber))
+}
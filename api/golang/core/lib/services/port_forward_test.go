@@ -0,0 +1,110 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"github.com/stretchr/testify/require"
+	"net"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+const (
+	testServiceName = "test-service"
+	testPortId      = "http"
+	anyLocalPort    = uint16(0)
+
+	kubeconfigEnvVar = "KUBECONFIG"
+)
+
+func TestForwardPort_ForwardsConnectionsToThePublicPort(t *testing.T) {
+	remoteListener, err := net.Listen(tcpNetwork, net.JoinHostPort(localHostIpAddr, "0"))
+	require.NoError(t, err)
+	defer remoteListener.Close()
+	go runEchoServer(remoteListener)
+
+	//nolint:forcetypeassert
+	remotePortNumber := uint16(remoteListener.Addr().(*net.TCPAddr).Port)
+	serviceCtx := newTestServiceContext(map[string]*PortSpec{
+		testPortId: NewPortSpec(remotePortNumber, TransportProtocol_TCP, emptyApplicationProtocol),
+	})
+
+	portForward, err := serviceCtx.ForwardPort(context.Background(), testPortId, anyLocalPort)
+	require.NoError(t, err)
+	defer portForward.Close()
+	require.NotEqual(t, anyLocalPort, portForward.GetLocalPortNumber())
+
+	localConn, err := net.Dial(tcpNetwork, net.JoinHostPort(localHostIpAddr, strconv.Itoa(int(portForward.GetLocalPortNumber()))))
+	require.NoError(t, err)
+	defer localConn.Close()
+	_, err = localConn.Write([]byte("hello\n"))
+	require.NoError(t, err)
+	echoedLine, err := bufio.NewReader(localConn).ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "hello\n", echoedLine)
+}
+
+func TestForwardPort_FailsOnUnknownPort(t *testing.T) {
+	serviceCtx := newTestServiceContext(map[string]*PortSpec{})
+
+	_, err := serviceCtx.ForwardPort(context.Background(), testPortId, anyLocalPort)
+	require.Error(t, err)
+}
+
+func TestForwardPort_ForwardsThroughKubernetesWithoutPublicPort(t *testing.T) {
+	// the kubeconfig of the current context is needed to forward the port through the Kubernetes API server
+	t.Setenv(kubeconfigEnvVar, filepath.Join(t.TempDir(), "missing-kubeconfig"))
+	serviceCtx := newTestServiceContextWithoutPublicPorts(map[string]*PortSpec{
+		testPortId: NewPortSpec(80, TransportProtocol_TCP, emptyApplicationProtocol),
+	})
+
+	_, err := serviceCtx.ForwardPort(context.Background(), testPortId, anyLocalPort)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "An error occurred loading the Kubernetes configuration")
+}
+
+func TestForwardPort_FailsOnUdpPortWithoutPublicPort(t *testing.T) {
+	serviceCtx := newTestServiceContextWithoutPublicPorts(map[string]*PortSpec{
+		testPortId: NewPortSpec(53, TransportProtocol_UDP, emptyApplicationProtocol),
+	})
+
+	_, err := serviceCtx.ForwardPort(context.Background(), testPortId, anyLocalPort)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Kubernetes only supports forwarding")
+}
+
+func TestForwardPort_FailsOnUdpPort(t *testing.T) {
+	serviceCtx := newTestServiceContext(map[string]*PortSpec{
+		testPortId: NewPortSpec(53, TransportProtocol_UDP, emptyApplicationProtocol),
+	})
+
+	_, err := serviceCtx.ForwardPort(context.Background(), testPortId, anyLocalPort)
+	require.Error(t, err)
+}
+
+func newTestServiceContext(publicPorts map[string]*PortSpec) *ServiceContext {
+	return NewServiceContext(nil, testServiceName, "", "", map[string]*PortSpec{}, localHostIpAddr, publicPorts)
+}
+
+func newTestServiceContextWithoutPublicPorts(privatePorts map[string]*PortSpec) *ServiceContext {
+	return NewServiceContext(nil, testServiceName, "", "", privatePorts, localHostIpAddr, map[string]*PortSpec{})
+}
+
+func runEchoServer(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			line, err := bufio.NewReader(conn).ReadString('\n')
+			if err != nil {
+				return
+			}
+			//nolint:errcheck
+			conn.Write([]byte(line))
+		}()
+	}
+}
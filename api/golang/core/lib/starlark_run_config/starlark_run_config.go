@@ -11,9 +11,14 @@ const (
 	defaultCloudInstanceId        = ""
 	defaultCloudUserId            = ""
 	defaultImageDownload          = kurtosis_core_rpc_api_bindings.ImageDownloadMode_missing
+	defaultPackageLockMode        = kurtosis_core_rpc_api_bindings.PackageLockMode_ENFORCE_LOCK
+	defaultDiff                   = false
 )
 
-var defaultExperimentalFeatureFlags = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag(nil)
+var (
+	defaultExperimentalFeatureFlags = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag(nil)
+	defaultGitCredentials           = []*kurtosis_core_rpc_api_bindings.GitCredential(nil)
+)
 
 type StarlarkRunConfig struct {
 	RelativePathToMainFile   string
@@ -25,6 +30,9 @@ type StarlarkRunConfig struct {
 	CloudInstanceId          string
 	CloudUserId              string
 	ImageDownload            kurtosis_core_rpc_api_bindings.ImageDownloadMode
+	GitCredentials           []*kurtosis_core_rpc_api_bindings.GitCredential
+	PackageLockMode          kurtosis_core_rpc_api_bindings.PackageLockMode
+	Diff                     bool
 }
 
 type starlarkRunConfigOption func(*StarlarkRunConfig)
@@ -40,6 +48,9 @@ func NewRunStarlarkConfig(opts ...starlarkRunConfigOption) *StarlarkRunConfig {
 		CloudInstanceId:          defaultCloudInstanceId,
 		CloudUserId:              defaultCloudUserId,
 		ImageDownload:            defaultImageDownload,
+		GitCredentials:           defaultGitCredentials,
+		PackageLockMode:          defaultPackageLockMode,
+		Diff:                     defaultDiff,
 	}
 
 	for _, opt := range opts {
@@ -102,3 +113,26 @@ func WithImageDownloadMode(imageDownloadMode kurtosis_core_rpc_api_bindings.Imag
 		config.ImageDownload = imageDownloadMode
 	}
 }
+
+// WithGitCredentials sets the credentials the API container uses to clone packages hosted in private Git repositories
+func WithGitCredentials(gitCredentials []*kurtosis_core_rpc_api_bindings.GitCredential) starlarkRunConfigOption {
+	return func(config *StarlarkRunConfig) {
+		config.GitCredentials = gitCredentials
+	}
+}
+
+// WithPackageLockMode sets how the kurtosis.lock of the package is applied to the packages it imports. It's ignored
+// when running a script
+func WithPackageLockMode(packageLockMode kurtosis_core_rpc_api_bindings.PackageLockMode) starlarkRunConfigOption {
+	return func(config *StarlarkRunConfig) {
+		config.PackageLockMode = packageLockMode
+	}
+}
+
+// WithDiff makes the run only return the changes it would make to the enclave, compared to the instructions already
+// executed in it, without executing anything
+func WithDiff(diff bool) starlarkRunConfigOption {
+	return func(config *StarlarkRunConfig) {
+		config.Diff = diff
+	}
+}
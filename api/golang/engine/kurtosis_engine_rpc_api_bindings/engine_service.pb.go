@@ -22,6 +22,54 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EnclaveExpiryAction int32
+
+const (
+	// The enclave is destroyed, removing all artifacts associated with it
+	EnclaveExpiryAction_EnclaveExpiryAction_DESTROY EnclaveExpiryAction = 0
+	// The enclave is stopped, so it can be inspected or started again
+	EnclaveExpiryAction_EnclaveExpiryAction_STOP EnclaveExpiryAction = 1
+)
+
+// Enum value maps for EnclaveExpiryAction.
+var (
+	EnclaveExpiryAction_name = map[int32]string{
+		0: "EnclaveExpiryAction_DESTROY",
+		1: "EnclaveExpiryAction_STOP",
+	}
+	EnclaveExpiryAction_value = map[string]int32{
+		"EnclaveExpiryAction_DESTROY": 0,
+		"EnclaveExpiryAction_STOP":    1,
+	}
+)
+
+func (x EnclaveExpiryAction) Enum() *EnclaveExpiryAction {
+	p := new(EnclaveExpiryAction)
+	*p = x
+	return p
+}
+
+func (x EnclaveExpiryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnclaveExpiryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_engine_service_proto_enumTypes[0].Descriptor()
+}
+
+func (EnclaveExpiryAction) Type() protoreflect.EnumType {
+	return &file_engine_service_proto_enumTypes[0]
+}
+
+func (x EnclaveExpiryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnclaveExpiryAction.Descriptor instead.
+func (EnclaveExpiryAction) EnumDescriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{0}
+}
+
 type EnclaveMode int32
 
 const (
@@ -52,11 +100,11 @@ func (x EnclaveMode) String() string {
 }
 
 func (EnclaveMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[0].Descriptor()
+	return file_engine_service_proto_enumTypes[1].Descriptor()
 }
 
 func (EnclaveMode) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[0]
+	return &file_engine_service_proto_enumTypes[1]
 }
 
 func (x EnclaveMode) Number() protoreflect.EnumNumber {
@@ -65,7 +113,7 @@ func (x EnclaveMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnclaveMode.Descriptor instead.
 func (EnclaveMode) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{0}
+	return file_engine_service_proto_rawDescGZIP(), []int{1}
 }
 
 // ==============================================================================================
@@ -111,11 +159,11 @@ func (x EnclaveContainersStatus) String() string {
 }
 
 func (EnclaveContainersStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[1].Descriptor()
+	return file_engine_service_proto_enumTypes[2].Descriptor()
 }
 
 func (EnclaveContainersStatus) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[1]
+	return &file_engine_service_proto_enumTypes[2]
 }
 
 func (x EnclaveContainersStatus) Number() protoreflect.EnumNumber {
@@ -124,7 +172,7 @@ func (x EnclaveContainersStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnclaveContainersStatus.Descriptor instead.
 func (EnclaveContainersStatus) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{1}
+	return file_engine_service_proto_rawDescGZIP(), []int{2}
 }
 
 // NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
@@ -168,11 +216,11 @@ func (x EnclaveAPIContainerStatus) String() string {
 }
 
 func (EnclaveAPIContainerStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[2].Descriptor()
+	return file_engine_service_proto_enumTypes[3].Descriptor()
 }
 
 func (EnclaveAPIContainerStatus) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[2]
+	return &file_engine_service_proto_enumTypes[3]
 }
 
 func (x EnclaveAPIContainerStatus) Number() protoreflect.EnumNumber {
@@ -181,7 +229,7 @@ func (x EnclaveAPIContainerStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnclaveAPIContainerStatus.Descriptor instead.
 func (EnclaveAPIContainerStatus) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{2}
+	return file_engine_service_proto_rawDescGZIP(), []int{3}
 }
 
 // The filter operator which can be text or regex type
@@ -222,11 +270,11 @@ func (x LogLineOperator) String() string {
 }
 
 func (LogLineOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[3].Descriptor()
+	return file_engine_service_proto_enumTypes[4].Descriptor()
 }
 
 func (LogLineOperator) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[3]
+	return &file_engine_service_proto_enumTypes[4]
 }
 
 func (x LogLineOperator) Number() protoreflect.EnumNumber {
@@ -235,7 +283,69 @@ func (x LogLineOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LogLineOperator.Descriptor instead.
 func (LogLineOperator) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{3}
+	return file_engine_service_proto_rawDescGZIP(), []int{4}
+}
+
+// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+type LogLineFieldOperator int32
+
+const (
+	LogLineFieldOperator_LogLineFieldOperator_EQUALS                LogLineFieldOperator = 0
+	LogLineFieldOperator_LogLineFieldOperator_NOT_EQUALS            LogLineFieldOperator = 1
+	LogLineFieldOperator_LogLineFieldOperator_MATCHES_REGEX         LogLineFieldOperator = 2
+	LogLineFieldOperator_LogLineFieldOperator_GREATER_THAN          LogLineFieldOperator = 3
+	LogLineFieldOperator_LogLineFieldOperator_GREATER_THAN_OR_EQUAL LogLineFieldOperator = 4
+	LogLineFieldOperator_LogLineFieldOperator_LESS_THAN             LogLineFieldOperator = 5
+	LogLineFieldOperator_LogLineFieldOperator_LESS_THAN_OR_EQUAL    LogLineFieldOperator = 6
+)
+
+// Enum value maps for LogLineFieldOperator.
+var (
+	LogLineFieldOperator_name = map[int32]string{
+		0: "LogLineFieldOperator_EQUALS",
+		1: "LogLineFieldOperator_NOT_EQUALS",
+		2: "LogLineFieldOperator_MATCHES_REGEX",
+		3: "LogLineFieldOperator_GREATER_THAN",
+		4: "LogLineFieldOperator_GREATER_THAN_OR_EQUAL",
+		5: "LogLineFieldOperator_LESS_THAN",
+		6: "LogLineFieldOperator_LESS_THAN_OR_EQUAL",
+	}
+	LogLineFieldOperator_value = map[string]int32{
+		"LogLineFieldOperator_EQUALS":                0,
+		"LogLineFieldOperator_NOT_EQUALS":            1,
+		"LogLineFieldOperator_MATCHES_REGEX":         2,
+		"LogLineFieldOperator_GREATER_THAN":          3,
+		"LogLineFieldOperator_GREATER_THAN_OR_EQUAL": 4,
+		"LogLineFieldOperator_LESS_THAN":             5,
+		"LogLineFieldOperator_LESS_THAN_OR_EQUAL":    6,
+	}
+)
+
+func (x LogLineFieldOperator) Enum() *LogLineFieldOperator {
+	p := new(LogLineFieldOperator)
+	*p = x
+	return p
+}
+
+func (x LogLineFieldOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogLineFieldOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_engine_service_proto_enumTypes[5].Descriptor()
+}
+
+func (LogLineFieldOperator) Type() protoreflect.EnumType {
+	return &file_engine_service_proto_enumTypes[5]
+}
+
+func (x LogLineFieldOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogLineFieldOperator.Descriptor instead.
+func (LogLineFieldOperator) EnumDescriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{5}
 }
 
 // ==============================================================================================
@@ -309,6 +419,22 @@ type CreateEnclaveArgs struct {
 	// The API container log level
 	ApiContainerLogLevel string      `protobuf:"bytes,3,opt,name=api_container_log_level,json=apiContainerLogLevel,proto3" json:"api_container_log_level,omitempty"`
 	Mode                 EnclaveMode `protobuf:"varint,4,opt,name=mode,proto3,enum=engine_api.EnclaveMode" json:"mode,omitempty"`
+	// The maximum number of bytes the files artifacts of the enclave can use, unlimited if not set
+	FilesArtifactsStorageQuota *uint64 `protobuf:"varint,5,opt,name=files_artifacts_storage_quota,json=filesArtifactsStorageQuota,proto3,oneof" json:"files_artifacts_storage_quota,omitempty"`
+	// The number of seconds after which the enclave expires, never if not set
+	TtlSeconds *uint32 `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds,proto3,oneof" json:"ttl_seconds,omitempty"`
+	// The number of seconds without API calls or service activity after which the enclave expires, never if not set
+	IdleTimeoutSeconds *uint32 `protobuf:"varint,7,opt,name=idle_timeout_seconds,json=idleTimeoutSeconds,proto3,oneof" json:"idle_timeout_seconds,omitempty"`
+	// What happens to the enclave when it expires
+	ExpiryAction EnclaveExpiryAction `protobuf:"varint,8,opt,name=expiry_action,json=expiryAction,proto3,enum=engine_api.EnclaveExpiryAction" json:"expiry_action,omitempty"`
+	// Arbitrary user-defined key/value labels attached to the enclave
+	Labels map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The maximum total millicpus the services of the enclave can be allocated through their max_cpu, unlimited if not set
+	CpuQuotaMillicpus *uint64 `protobuf:"varint,10,opt,name=cpu_quota_millicpus,json=cpuQuotaMillicpus,proto3,oneof" json:"cpu_quota_millicpus,omitempty"`
+	// The maximum total megabytes of memory the services of the enclave can be allocated through their max_memory, unlimited if not set
+	MemoryQuotaMegabytes *uint64 `protobuf:"varint,11,opt,name=memory_quota_megabytes,json=memoryQuotaMegabytes,proto3,oneof" json:"memory_quota_megabytes,omitempty"`
+	// The maximum number of services in the enclave, unlimited if not set
+	ServiceCountQuota *uint32 `protobuf:"varint,12,opt,name=service_count_quota,json=serviceCountQuota,proto3,oneof" json:"service_count_quota,omitempty"`
 }
 
 func (x *CreateEnclaveArgs) Reset() {
@@ -371,6 +497,62 @@ func (x *CreateEnclaveArgs) GetMode() EnclaveMode {
 	return EnclaveMode_TEST
 }
 
+func (x *CreateEnclaveArgs) GetFilesArtifactsStorageQuota() uint64 {
+	if x != nil && x.FilesArtifactsStorageQuota != nil {
+		return *x.FilesArtifactsStorageQuota
+	}
+	return 0
+}
+
+func (x *CreateEnclaveArgs) GetTtlSeconds() uint32 {
+	if x != nil && x.TtlSeconds != nil {
+		return *x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CreateEnclaveArgs) GetIdleTimeoutSeconds() uint32 {
+	if x != nil && x.IdleTimeoutSeconds != nil {
+		return *x.IdleTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *CreateEnclaveArgs) GetExpiryAction() EnclaveExpiryAction {
+	if x != nil {
+		return x.ExpiryAction
+	}
+	return EnclaveExpiryAction_EnclaveExpiryAction_DESTROY
+}
+
+func (x *CreateEnclaveArgs) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateEnclaveArgs) GetCpuQuotaMillicpus() uint64 {
+	if x != nil && x.CpuQuotaMillicpus != nil {
+		return *x.CpuQuotaMillicpus
+	}
+	return 0
+}
+
+func (x *CreateEnclaveArgs) GetMemoryQuotaMegabytes() uint64 {
+	if x != nil && x.MemoryQuotaMegabytes != nil {
+		return *x.MemoryQuotaMegabytes
+	}
+	return 0
+}
+
+func (x *CreateEnclaveArgs) GetServiceCountQuota() uint32 {
+	if x != nil && x.ServiceCountQuota != nil {
+		return *x.ServiceCountQuota
+	}
+	return 0
+}
+
 type CreateEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -575,6 +757,12 @@ type EnclaveInfo struct {
 	// The enclave's creation time
 	CreationTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
 	Mode         EnclaveMode            `protobuf:"varint,9,opt,name=mode,proto3,enum=engine_api.EnclaveMode" json:"mode,omitempty"`
+	// The time at which the enclave expires, given its time-to-live and idle timeout; not set if the enclave never expires
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expiration_time,json=expirationTime,proto3,oneof" json:"expiration_time,omitempty"`
+	// What happens to the enclave when it expires
+	ExpiryAction EnclaveExpiryAction `protobuf:"varint,11,opt,name=expiry_action,json=expiryAction,proto3,enum=engine_api.EnclaveExpiryAction" json:"expiry_action,omitempty"`
+	// The user-defined labels of the enclave
+	Labels map[string]string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *EnclaveInfo) Reset() {
@@ -672,6 +860,75 @@ func (x *EnclaveInfo) GetMode() EnclaveMode {
 	return EnclaveMode_TEST
 }
 
+func (x *EnclaveInfo) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *EnclaveInfo) GetExpiryAction() EnclaveExpiryAction {
+	if x != nil {
+		return x.ExpiryAction
+	}
+	return EnclaveExpiryAction_EnclaveExpiryAction_DESTROY
+}
+
+func (x *EnclaveInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type GetEnclavesArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only the enclaves having all of these labels are returned
+	LabelSelector map[string]string `protobuf:"bytes,1,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetEnclavesArgs) Reset() {
+	*x = GetEnclavesArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnclavesArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnclavesArgs) ProtoMessage() {}
+
+func (x *GetEnclavesArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnclavesArgs.ProtoReflect.Descriptor instead.
+func (*GetEnclavesArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetEnclavesArgs) GetLabelSelector() map[string]string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
 type GetEnclavesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -684,7 +941,7 @@ type GetEnclavesResponse struct {
 func (x *GetEnclavesResponse) Reset() {
 	*x = GetEnclavesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[6]
+		mi := &file_engine_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -697,7 +954,7 @@ func (x *GetEnclavesResponse) String() string {
 func (*GetEnclavesResponse) ProtoMessage() {}
 
 func (x *GetEnclavesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[6]
+	mi := &file_engine_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +967,7 @@ func (x *GetEnclavesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnclavesResponse.ProtoReflect.Descriptor instead.
 func (*GetEnclavesResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{6}
+	return file_engine_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetEnclavesResponse) GetEnclaveInfo() map[string]*EnclaveInfo {
@@ -737,7 +994,7 @@ type EnclaveIdentifiers struct {
 func (x *EnclaveIdentifiers) Reset() {
 	*x = EnclaveIdentifiers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[7]
+		mi := &file_engine_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -750,7 +1007,7 @@ func (x *EnclaveIdentifiers) String() string {
 func (*EnclaveIdentifiers) ProtoMessage() {}
 
 func (x *EnclaveIdentifiers) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[7]
+	mi := &file_engine_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,7 +1020,7 @@ func (x *EnclaveIdentifiers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnclaveIdentifiers.ProtoReflect.Descriptor instead.
 func (*EnclaveIdentifiers) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{7}
+	return file_engine_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EnclaveIdentifiers) GetEnclaveUuid() string {
@@ -798,7 +1055,7 @@ type GetExistingAndHistoricalEnclaveIdentifiersResponse struct {
 func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) Reset() {
 	*x = GetExistingAndHistoricalEnclaveIdentifiersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[8]
+		mi := &file_engine_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -811,7 +1068,7 @@ func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) String() string {
 func (*GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoMessage() {}
 
 func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[8]
+	mi := &file_engine_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,7 +1081,7 @@ func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoReflect() prot
 
 // Deprecated: Use GetExistingAndHistoricalEnclaveIdentifiersResponse.ProtoReflect.Descriptor instead.
 func (*GetExistingAndHistoricalEnclaveIdentifiersResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{8}
+	return file_engine_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) GetAllIdentifiers() []*EnclaveIdentifiers {
@@ -851,7 +1108,7 @@ type StopEnclaveArgs struct {
 func (x *StopEnclaveArgs) Reset() {
 	*x = StopEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[9]
+		mi := &file_engine_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -864,7 +1121,7 @@ func (x *StopEnclaveArgs) String() string {
 func (*StopEnclaveArgs) ProtoMessage() {}
 
 func (x *StopEnclaveArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[9]
+	mi := &file_engine_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +1134,7 @@ func (x *StopEnclaveArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopEnclaveArgs.ProtoReflect.Descriptor instead.
 func (*StopEnclaveArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{9}
+	return file_engine_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StopEnclaveArgs) GetEnclaveIdentifier() string {
@@ -889,35 +1146,35 @@ func (x *StopEnclaveArgs) GetEnclaveIdentifier() string {
 
 // ==============================================================================================
 //
-//	Destroy Enclave
+//	Start Enclave
 //
 // ==============================================================================================
-type DestroyEnclaveArgs struct {
+type StartEnclaveArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to destroy
+	//The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to start
 	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
 }
 
-func (x *DestroyEnclaveArgs) Reset() {
-	*x = DestroyEnclaveArgs{}
+func (x *StartEnclaveArgs) Reset() {
+	*x = StartEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[10]
+		mi := &file_engine_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DestroyEnclaveArgs) String() string {
+func (x *StartEnclaveArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DestroyEnclaveArgs) ProtoMessage() {}
+func (*StartEnclaveArgs) ProtoMessage() {}
 
-func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[10]
+func (x *StartEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,49 +1185,44 @@ func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DestroyEnclaveArgs.ProtoReflect.Descriptor instead.
-func (*DestroyEnclaveArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use StartEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*StartEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DestroyEnclaveArgs) GetEnclaveIdentifier() string {
+func (x *StartEnclaveArgs) GetEnclaveIdentifier() string {
 	if x != nil {
 		return x.EnclaveIdentifier
 	}
 	return ""
 }
 
-// ==============================================================================================
-//
-//	Create Enclave
-//
-// ==============================================================================================
-type CleanArgs struct {
+type StartEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// If true, It will clean even the running enclaves
-	ShouldCleanAll bool `protobuf:"varint,1,opt,name=should_clean_all,json=shouldCleanAll,proto3" json:"should_clean_all,omitempty"`
+	// The names of the services that were restarted, in the order they were restarted in
+	ResumedServiceNames []string `protobuf:"bytes,1,rep,name=resumed_service_names,json=resumedServiceNames,proto3" json:"resumed_service_names,omitempty"`
 }
 
-func (x *CleanArgs) Reset() {
-	*x = CleanArgs{}
+func (x *StartEnclaveResponse) Reset() {
+	*x = StartEnclaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[11]
+		mi := &file_engine_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CleanArgs) String() string {
+func (x *StartEnclaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanArgs) ProtoMessage() {}
+func (*StartEnclaveResponse) ProtoMessage() {}
 
-func (x *CleanArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[11]
+func (x *StartEnclaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,44 +1233,51 @@ func (x *CleanArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanArgs.ProtoReflect.Descriptor instead.
-func (*CleanArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use StartEnclaveResponse.ProtoReflect.Descriptor instead.
+func (*StartEnclaveResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CleanArgs) GetShouldCleanAll() bool {
+func (x *StartEnclaveResponse) GetResumedServiceNames() []string {
 	if x != nil {
-		return x.ShouldCleanAll
+		return x.ResumedServiceNames
 	}
-	return false
+	return nil
 }
 
-type EnclaveNameAndUuid struct {
+// ==============================================================================================
+//
+//	Extend Enclave
+//
+// ==============================================================================================
+type ExtendEnclaveArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	//The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to extend
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+	// The number of seconds the time-to-live of the enclave is extended by; extending an enclave also resets its idle timeout
+	ExtensionSeconds uint32 `protobuf:"varint,2,opt,name=extension_seconds,json=extensionSeconds,proto3" json:"extension_seconds,omitempty"`
 }
 
-func (x *EnclaveNameAndUuid) Reset() {
-	*x = EnclaveNameAndUuid{}
+func (x *ExtendEnclaveArgs) Reset() {
+	*x = ExtendEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[12]
+		mi := &file_engine_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnclaveNameAndUuid) String() string {
+func (x *ExtendEnclaveArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnclaveNameAndUuid) ProtoMessage() {}
+func (*ExtendEnclaveArgs) ProtoMessage() {}
 
-func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[12]
+func (x *ExtendEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,38 +1288,266 @@ func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnclaveNameAndUuid.ProtoReflect.Descriptor instead.
-func (*EnclaveNameAndUuid) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExtendEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*ExtendEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *EnclaveNameAndUuid) GetName() string {
+func (x *ExtendEnclaveArgs) GetEnclaveIdentifier() string {
 	if x != nil {
-		return x.Name
+		return x.EnclaveIdentifier
 	}
 	return ""
 }
 
-func (x *EnclaveNameAndUuid) GetUuid() string {
+func (x *ExtendEnclaveArgs) GetExtensionSeconds() uint32 {
 	if x != nil {
-		return x.Uuid
+		return x.ExtensionSeconds
 	}
-	return ""
+	return 0
 }
 
-type CleanResponse struct {
+type ExtendEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// removed enclave name and uuids
-	RemovedEnclaveNameAndUuids []*EnclaveNameAndUuid `protobuf:"bytes,1,rep,name=removed_enclave_name_and_uuids,json=removedEnclaveNameAndUuids,proto3" json:"removed_enclave_name_and_uuids,omitempty"`
+	// The new time at which the enclave expires
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expiration_time,json=expirationTime,proto3,oneof" json:"expiration_time,omitempty"`
+}
+
+func (x *ExtendEnclaveResponse) Reset() {
+	*x = ExtendEnclaveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendEnclaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendEnclaveResponse) ProtoMessage() {}
+
+func (x *ExtendEnclaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendEnclaveResponse.ProtoReflect.Descriptor instead.
+func (*ExtendEnclaveResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExtendEnclaveResponse) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Destroy Enclave
+//
+// ==============================================================================================
+type DestroyEnclaveArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to destroy
+	// If empty, every enclave matching the label selector is destroyed
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+	// If set, the enclave(s) to destroy must have all of these labels
+	LabelSelector map[string]string `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DestroyEnclaveArgs) Reset() {
+	*x = DestroyEnclaveArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DestroyEnclaveArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyEnclaveArgs) ProtoMessage() {}
+
+func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*DestroyEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DestroyEnclaveArgs) GetEnclaveIdentifier() string {
+	if x != nil {
+		return x.EnclaveIdentifier
+	}
+	return ""
+}
+
+func (x *DestroyEnclaveArgs) GetLabelSelector() map[string]string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Create Enclave
+//
+// ==============================================================================================
+type CleanArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If true, It will clean even the running enclaves
+	ShouldCleanAll bool `protobuf:"varint,1,opt,name=should_clean_all,json=shouldCleanAll,proto3" json:"should_clean_all,omitempty"`
+	// If set, only the enclaves having all of these labels are cleaned
+	LabelSelector map[string]string `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *CleanArgs) Reset() {
+	*x = CleanArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanArgs) ProtoMessage() {}
+
+func (x *CleanArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanArgs.ProtoReflect.Descriptor instead.
+func (*CleanArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CleanArgs) GetShouldCleanAll() bool {
+	if x != nil {
+		return x.ShouldCleanAll
+	}
+	return false
+}
+
+func (x *CleanArgs) GetLabelSelector() map[string]string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+type EnclaveNameAndUuid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *EnclaveNameAndUuid) Reset() {
+	*x = EnclaveNameAndUuid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclaveNameAndUuid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclaveNameAndUuid) ProtoMessage() {}
+
+func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclaveNameAndUuid.ProtoReflect.Descriptor instead.
+func (*EnclaveNameAndUuid) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EnclaveNameAndUuid) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnclaveNameAndUuid) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type CleanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// removed enclave name and uuids
+	RemovedEnclaveNameAndUuids []*EnclaveNameAndUuid `protobuf:"bytes,1,rep,name=removed_enclave_name_and_uuids,json=removedEnclaveNameAndUuids,proto3" json:"removed_enclave_name_and_uuids,omitempty"`
 }
 
 func (x *CleanResponse) Reset() {
 	*x = CleanResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[13]
+		mi := &file_engine_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1073,7 +1560,7 @@ func (x *CleanResponse) String() string {
 func (*CleanResponse) ProtoMessage() {}
 
 func (x *CleanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[13]
+	mi := &file_engine_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,7 +1573,7 @@ func (x *CleanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanResponse.ProtoReflect.Descriptor instead.
 func (*CleanResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{13}
+	return file_engine_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CleanResponse) GetRemovedEnclaveNameAndUuids() []*EnclaveNameAndUuid {
@@ -1108,35 +1595,396 @@ type GetServiceLogsArgs struct {
 
 	// The identifier of the user service's Kurtosis Enclave
 	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
-	// "Set" of service UUIDs in the enclave
-	ServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=service_uuid_set,json=serviceUuidSet,proto3" json:"service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	// If true, It will follow the container logs
-	FollowLogs bool `protobuf:"varint,3,opt,name=follow_logs,json=followLogs,proto3" json:"follow_logs,omitempty"`
-	// The conjunctive log lines filters, the first filter is applied over the found log lines, the second filter is applied over the filter one result and so on (like grep)
-	ConjunctiveFilters []*LogLineFilter `protobuf:"bytes,4,rep,name=conjunctive_filters,json=conjunctiveFilters,proto3" json:"conjunctive_filters,omitempty"`
-	// If true, return all log lines
-	ReturnAllLogs bool `protobuf:"varint,5,opt,name=return_all_logs,json=returnAllLogs,proto3" json:"return_all_logs,omitempty"`
-	// If [return_all_logs] is false, return [num_log_lines]
-	NumLogLines uint32 `protobuf:"varint,6,opt,name=num_log_lines,json=numLogLines,proto3" json:"num_log_lines,omitempty"`
+	// "Set" of service UUIDs in the enclave
+	ServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=service_uuid_set,json=serviceUuidSet,proto3" json:"service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// If true, It will follow the container logs
+	FollowLogs bool `protobuf:"varint,3,opt,name=follow_logs,json=followLogs,proto3" json:"follow_logs,omitempty"`
+	// The conjunctive log lines filters, the first filter is applied over the found log lines, the second filter is applied over the filter one result and so on (like grep)
+	ConjunctiveFilters []*LogLineFilter `protobuf:"bytes,4,rep,name=conjunctive_filters,json=conjunctiveFilters,proto3" json:"conjunctive_filters,omitempty"`
+	// If true, return all log lines
+	ReturnAllLogs bool `protobuf:"varint,5,opt,name=return_all_logs,json=returnAllLogs,proto3" json:"return_all_logs,omitempty"`
+	// If [return_all_logs] is false, return [num_log_lines]
+	NumLogLines uint32 `protobuf:"varint,6,opt,name=num_log_lines,json=numLogLines,proto3" json:"num_log_lines,omitempty"`
+	// If set, only the log lines emitted at or after this time are returned
+	Since *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=since,proto3" json:"since,omitempty"`
+	// If set, only the log lines emitted at or before this time are returned
+	Until *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=until,proto3" json:"until,omitempty"`
+	// The conjunctive filters over the fields of the log lines emitted as JSON objects; log lines that aren't JSON objects are never returned if set
+	ConjunctiveFieldFilters []*LogLineFieldFilter `protobuf:"bytes,9,rep,name=conjunctive_field_filters,json=conjunctiveFieldFilters,proto3" json:"conjunctive_field_filters,omitempty"`
+}
+
+func (x *GetServiceLogsArgs) Reset() {
+	*x = GetServiceLogsArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceLogsArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceLogsArgs) ProtoMessage() {}
+
+func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceLogsArgs.ProtoReflect.Descriptor instead.
+func (*GetServiceLogsArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetServiceLogsArgs) GetEnclaveIdentifier() string {
+	if x != nil {
+		return x.EnclaveIdentifier
+	}
+	return ""
+}
+
+func (x *GetServiceLogsArgs) GetServiceUuidSet() map[string]bool {
+	if x != nil {
+		return x.ServiceUuidSet
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetFollowLogs() bool {
+	if x != nil {
+		return x.FollowLogs
+	}
+	return false
+}
+
+func (x *GetServiceLogsArgs) GetConjunctiveFilters() []*LogLineFilter {
+	if x != nil {
+		return x.ConjunctiveFilters
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetReturnAllLogs() bool {
+	if x != nil {
+		return x.ReturnAllLogs
+	}
+	return false
+}
+
+func (x *GetServiceLogsArgs) GetNumLogLines() uint32 {
+	if x != nil {
+		return x.NumLogLines
+	}
+	return 0
+}
+
+func (x *GetServiceLogsArgs) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetConjunctiveFieldFilters() []*LogLineFieldFilter {
+	if x != nil {
+		return x.ConjunctiveFieldFilters
+	}
+	return nil
+}
+
+type GetServiceLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The service log lines grouped by service UUIDs and ordered in forward direction (oldest log line is the first element)
+	ServiceLogsByServiceUuid map[string]*LogLine `protobuf:"bytes,1,rep,name=service_logs_by_service_uuid,json=serviceLogsByServiceUuid,proto3" json:"service_logs_by_service_uuid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// A set of service GUIDs requested by the user that were not found in the logs database, could be related that users send
+	// a wrong GUID or a right GUID for a service that has not sent any logs so far
+	NotFoundServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=not_found_service_uuid_set,json=notFoundServiceUuidSet,proto3" json:"not_found_service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetServiceLogsResponse) Reset() {
+	*x = GetServiceLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceLogsResponse) ProtoMessage() {}
+
+func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceLogsResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetServiceLogsResponse) GetServiceLogsByServiceUuid() map[string]*LogLine {
+	if x != nil {
+		return x.ServiceLogsByServiceUuid
+	}
+	return nil
+}
+
+func (x *GetServiceLogsResponse) GetNotFoundServiceUuidSet() map[string]bool {
+	if x != nil {
+		return x.NotFoundServiceUuidSet
+	}
+	return nil
+}
+
+type LogLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line []string `protobuf:"bytes,1,rep,name=line,proto3" json:"line,omitempty"`
+	// The time each line of [line] was emitted at, in the same order; the Unix epoch if the logs database doesn't store it
+	Timestamp []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The level (trace, debug, info, warn, error or fatal) extracted from each line of [line], in the same order; empty if it couldn't be extracted
+	Level []string `protobuf:"bytes,3,rep,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *LogLine) Reset() {
+	*x = LogLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLine) ProtoMessage() {}
+
+func (x *LogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
+func (*LogLine) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LogLine) GetLine() []string {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+func (x *LogLine) GetTimestamp() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *LogLine) GetLevel() []string {
+	if x != nil {
+		return x.Level
+	}
+	return nil
+}
+
+type LogLineFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator    LogLineOperator `protobuf:"varint,1,opt,name=operator,proto3,enum=engine_api.LogLineOperator" json:"operator,omitempty"`
+	TextPattern string          `protobuf:"bytes,2,opt,name=text_pattern,json=textPattern,proto3" json:"text_pattern,omitempty"`
+}
+
+func (x *LogLineFilter) Reset() {
+	*x = LogLineFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLineFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLineFilter) ProtoMessage() {}
+
+func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLineFilter.ProtoReflect.Descriptor instead.
+func (*LogLineFilter) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LogLineFilter) GetOperator() LogLineOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return LogLineOperator_LogLineOperator_DOES_CONTAIN_TEXT
+}
+
+func (x *LogLineFilter) GetTextPattern() string {
+	if x != nil {
+		return x.TextPattern
+	}
+	return ""
+}
+
+type LogLineFieldFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The JSON path of the field, e.g. "$.request.status", "request.status" or "$.items[0].name"
+	FieldPath string               `protobuf:"bytes,1,opt,name=field_path,json=fieldPath,proto3" json:"field_path,omitempty"`
+	Operator  LogLineFieldOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=engine_api.LogLineFieldOperator" json:"operator,omitempty"`
+	// Numbers are compared by value, everything else by its string representation
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *LogLineFieldFilter) Reset() {
+	*x = LogLineFieldFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLineFieldFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLineFieldFilter) ProtoMessage() {}
+
+func (x *LogLineFieldFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLineFieldFilter.ProtoReflect.Descriptor instead.
+func (*LogLineFieldFilter) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LogLineFieldFilter) GetFieldPath() string {
+	if x != nil {
+		return x.FieldPath
+	}
+	return ""
+}
+
+func (x *LogLineFieldFilter) GetOperator() LogLineFieldOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return LogLineFieldOperator_LogLineFieldOperator_EQUALS
+}
+
+func (x *LogLineFieldFilter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Enclave Snapshots
+//
+// ==============================================================================================
+type SnapshotEnclaveArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+	// Whether the content of the persistent directories should be part of the snapshot
+	IncludePersistentDirectories *bool `protobuf:"varint,2,opt,name=include_persistent_directories,json=includePersistentDirectories,proto3,oneof" json:"include_persistent_directories,omitempty"`
+	// Whether the filesystems of the service containers should be part of the snapshot (Docker only)
+	IncludeContainerFilesystems *bool `protobuf:"varint,3,opt,name=include_container_filesystems,json=includeContainerFilesystems,proto3,oneof" json:"include_container_filesystems,omitempty"`
 }
 
-func (x *GetServiceLogsArgs) Reset() {
-	*x = GetServiceLogsArgs{}
+func (x *SnapshotEnclaveArgs) Reset() {
+	*x = SnapshotEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[14]
+		mi := &file_engine_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetServiceLogsArgs) String() string {
+func (x *SnapshotEnclaveArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceLogsArgs) ProtoMessage() {}
+func (*SnapshotEnclaveArgs) ProtoMessage() {}
 
-func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[14]
+func (x *SnapshotEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,82 +1995,58 @@ func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceLogsArgs.ProtoReflect.Descriptor instead.
-func (*GetServiceLogsArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SnapshotEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*SnapshotEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetServiceLogsArgs) GetEnclaveIdentifier() string {
+func (x *SnapshotEnclaveArgs) GetEnclaveIdentifier() string {
 	if x != nil {
 		return x.EnclaveIdentifier
 	}
 	return ""
 }
 
-func (x *GetServiceLogsArgs) GetServiceUuidSet() map[string]bool {
-	if x != nil {
-		return x.ServiceUuidSet
-	}
-	return nil
-}
-
-func (x *GetServiceLogsArgs) GetFollowLogs() bool {
-	if x != nil {
-		return x.FollowLogs
+func (x *SnapshotEnclaveArgs) GetIncludePersistentDirectories() bool {
+	if x != nil && x.IncludePersistentDirectories != nil {
+		return *x.IncludePersistentDirectories
 	}
 	return false
 }
 
-func (x *GetServiceLogsArgs) GetConjunctiveFilters() []*LogLineFilter {
-	if x != nil {
-		return x.ConjunctiveFilters
-	}
-	return nil
-}
-
-func (x *GetServiceLogsArgs) GetReturnAllLogs() bool {
-	if x != nil {
-		return x.ReturnAllLogs
+func (x *SnapshotEnclaveArgs) GetIncludeContainerFilesystems() bool {
+	if x != nil && x.IncludeContainerFilesystems != nil {
+		return *x.IncludeContainerFilesystems
 	}
 	return false
 }
 
-func (x *GetServiceLogsArgs) GetNumLogLines() uint32 {
-	if x != nil {
-		return x.NumLogLines
-	}
-	return 0
-}
-
-type GetServiceLogsResponse struct {
+type EnclaveSnapshotChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The service log lines grouped by service UUIDs and ordered in forward direction (oldest log line is the first element)
-	ServiceLogsByServiceUuid map[string]*LogLine `protobuf:"bytes,1,rep,name=service_logs_by_service_uuid,json=serviceLogsByServiceUuid,proto3" json:"service_logs_by_service_uuid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// A set of service GUIDs requested by the user that were not found in the logs database, could be related that users send
-	// a wrong GUID or a right GUID for a service that has not sent any logs so far
-	NotFoundServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=not_found_service_uuid_set,json=notFoundServiceUuidSet,proto3" json:"not_found_service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Chunk of the snapshot archive bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *GetServiceLogsResponse) Reset() {
-	*x = GetServiceLogsResponse{}
+func (x *EnclaveSnapshotChunk) Reset() {
+	*x = EnclaveSnapshotChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[15]
+		mi := &file_engine_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetServiceLogsResponse) String() string {
+func (x *EnclaveSnapshotChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceLogsResponse) ProtoMessage() {}
+func (*EnclaveSnapshotChunk) ProtoMessage() {}
 
-func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[15]
+func (x *EnclaveSnapshotChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,51 +2057,46 @@ func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetServiceLogsResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetServiceLogsResponse) GetServiceLogsByServiceUuid() map[string]*LogLine {
-	if x != nil {
-		return x.ServiceLogsByServiceUuid
-	}
-	return nil
+// Deprecated: Use EnclaveSnapshotChunk.ProtoReflect.Descriptor instead.
+func (*EnclaveSnapshotChunk) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetServiceLogsResponse) GetNotFoundServiceUuidSet() map[string]bool {
+func (x *EnclaveSnapshotChunk) GetData() []byte {
 	if x != nil {
-		return x.NotFoundServiceUuidSet
+		return x.Data
 	}
 	return nil
 }
 
-// TODO add timestamp as well, for when we do timestamp-handling on the client side
-type LogLine struct {
+type RestoreEnclaveArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Line []string `protobuf:"bytes,1,rep,name=line,proto3" json:"line,omitempty"`
+	// The arguments used to create the enclave the snapshot is restored into. Only read from the first message of the stream
+	CreateEnclaveArgs *CreateEnclaveArgs `protobuf:"bytes,1,opt,name=create_enclave_args,json=createEnclaveArgs,proto3" json:"create_enclave_args,omitempty"`
+	// Chunk of the snapshot archive bytes
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *LogLine) Reset() {
-	*x = LogLine{}
+func (x *RestoreEnclaveArgs) Reset() {
+	*x = RestoreEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[16]
+		mi := &file_engine_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogLine) String() string {
+func (x *RestoreEnclaveArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogLine) ProtoMessage() {}
+func (*RestoreEnclaveArgs) ProtoMessage() {}
 
-func (x *LogLine) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[16]
+func (x *RestoreEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,44 +2107,51 @@ func (x *LogLine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
-func (*LogLine) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RestoreEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*RestoreEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *LogLine) GetLine() []string {
+func (x *RestoreEnclaveArgs) GetCreateEnclaveArgs() *CreateEnclaveArgs {
 	if x != nil {
-		return x.Line
+		return x.CreateEnclaveArgs
 	}
 	return nil
 }
 
-type LogLineFilter struct {
+func (x *RestoreEnclaveArgs) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RestoreEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Operator    LogLineOperator `protobuf:"varint,1,opt,name=operator,proto3,enum=engine_api.LogLineOperator" json:"operator,omitempty"`
-	TextPattern string          `protobuf:"bytes,2,opt,name=text_pattern,json=textPattern,proto3" json:"text_pattern,omitempty"`
+	// All the information of the enclave the snapshot was restored into
+	EnclaveInfo *EnclaveInfo `protobuf:"bytes,1,opt,name=enclave_info,json=enclaveInfo,proto3" json:"enclave_info,omitempty"`
 }
 
-func (x *LogLineFilter) Reset() {
-	*x = LogLineFilter{}
+func (x *RestoreEnclaveResponse) Reset() {
+	*x = RestoreEnclaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[17]
+		mi := &file_engine_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogLineFilter) String() string {
+func (x *RestoreEnclaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogLineFilter) ProtoMessage() {}
+func (*RestoreEnclaveResponse) ProtoMessage() {}
 
-func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[17]
+func (x *RestoreEnclaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,23 +2162,16 @@ func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogLineFilter.ProtoReflect.Descriptor instead.
-func (*LogLineFilter) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *LogLineFilter) GetOperator() LogLineOperator {
-	if x != nil {
-		return x.Operator
-	}
-	return LogLineOperator_LogLineOperator_DOES_CONTAIN_TEXT
+// Deprecated: Use RestoreEnclaveResponse.ProtoReflect.Descriptor instead.
+func (*RestoreEnclaveResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *LogLineFilter) GetTextPattern() string {
+func (x *RestoreEnclaveResponse) GetEnclaveInfo() *EnclaveInfo {
 	if x != nil {
-		return x.TextPattern
+		return x.EnclaveInfo
 	}
-	return ""
+	return nil
 }
 
 var File_engine_service_proto protoreflect.FileDescriptor
@@ -1368,7 +2187,7 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x67,
 	0x69, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0xd5, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x22, 0xf9, 0x06, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
 	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
 	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e,
 	0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x70, 0x69,
@@ -1381,185 +2200,363 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x6d,
 	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69,
 	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f,
-	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xcd, 0x01,
-	0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11,
-	0x69, 0x70, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x70, 0x49, 0x6e, 0x73, 0x69, 0x64,
-	0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x67, 0x72, 0x70, 0x63,
-	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x63,
-	0x6c, 0x61, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x67, 0x72, 0x70, 0x63,
-	0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8b, 0x01,
-	0x0a, 0x22, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f,
-	0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x69, 0x70, 0x4f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x12, 0x38, 0x0a, 0x19, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6f,
-	0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x6e,
-	0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xcd, 0x04, 0x0a, 0x0b,
-	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x65,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x57, 0x0a, 0x14, 0x61,
-	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50,
-	0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x12, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e,
-	0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x74, 0x0a, 0x1f, 0x61, 0x70, 0x69, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e,
-	0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x1b, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f,
-	0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a,
-	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b,
-	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x6e, 0x63,
-	0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x57, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a, 0x1d, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x1a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x12, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a,
+	0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72,
+	0x67, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x33, 0x0a, 0x13, 0x63, 0x70, 0x75, 0x5f, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x70, 0x75, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x11, 0x63, 0x70, 0x75, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x63, 0x70, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x16, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x6d, 0x65, 0x67, 0x61,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x14, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x4d, 0x65, 0x67, 0x61, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x05, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x88, 0x01, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x74, 0x6c,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x69, 0x64, 0x6c,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x70, 0x75, 0x73, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x53, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0xcd, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x70, 0x49,
+	0x6e, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x37, 0x0a, 0x18,
+	0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65,
+	0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15,
+	0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f,
+	0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x8b, 0x01, 0x0a, 0x22, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x6f,
+	0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x70, 0x4f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x19, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x67, 0x72, 0x70, 0x63, 0x50, 0x6f,
+	0x72, 0x74, 0x4f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22,
+	0xe9, 0x06, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65,
+	0x6e, 0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x50, 0x0a,
+	0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x57, 0x0a, 0x14, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
 	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x72, 0x0a, 0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
-	0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65,
-	0x64, 0x55, 0x75, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x32, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
-	0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x61,
-	0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x73, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x61, 0x70, 0x69, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x74, 0x0a, 0x1f, 0x61,
+	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x1b, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x48, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x0d, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12,
+	0x55, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73,
+	0x41, 0x72, 0x67, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x40, 0x0a, 0x12, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x57, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72,
+	0x0a, 0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75,
+	0x69, 0x64, 0x22, 0x7c, 0x0a, 0x32, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x22, 0x40, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41,
+	0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x22, 0x41, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61,
 	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
 	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x12, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
-	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x09, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x75, 0x6c,
-	0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x6c,
-	0x6c, 0x22, 0x3c, 0x0a, 0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
-	0x73, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x62, 0x0a, 0x1e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x75, 0x75, 0x69,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x52, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55,
-	0x75, 0x69, 0x64, 0x73, 0x22, 0x9d, 0x03, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x10, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73,
-	0x41, 0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64,
-	0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x6f, 0x6e,
-	0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
-	0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x22, 0x0a,
-	0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65,
-	0x73, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64,
-	0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xc4, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x80, 0x01, 0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x73,
-	0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f,
-	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55,
-	0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75,
-	0x69, 0x64, 0x12, 0x7a, 0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f,
-	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
-	0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x1a, 0x60,
-	0x0a, 0x1d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f,
-	0x67, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x49, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x15, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x6f, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0x75, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x12, 0x44, 0x65,
+	0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x58, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x40, 0x0a, 0x12, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1d, 0x0a, 0x07, 0x4c,
-	0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x6b, 0x0a, 0x0d, 0x4c, 0x6f,
-	0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc8, 0x01, 0x0a, 0x09,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x6f,
+	0x75, 0x6c, 0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x41, 0x6c, 0x6c, 0x12, 0x4f, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72,
+	0x67, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x1a, 0x40, 0x0a, 0x12, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3c, 0x0a, 0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x1e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x52, 0x1a, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0xdd, 0x04, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x5c, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x4a,
+	0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x4c, 0x6f,
+	0x67, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x5a, 0x0a, 0x19, 0x63, 0x6f,
+	0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
 	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
-	0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74,
-	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x2a, 0x27, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x6e, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x17, 0x63,
+	0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc4, 0x03, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x7a, 0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x5f, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55,
+	0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6e, 0x6f, 0x74,
+	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64,
+	0x53, 0x65, 0x74, 0x1a, 0x60, 0x0a, 0x1d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f,
+	0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x6d, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
+	0x6b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x37, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x65, 0x78, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x87, 0x01, 0x0a,
+	0x12, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x3c, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9d, 0x02, 0x0a, 0x13, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x49, 0x0a,
+	0x1e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x1c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x1d, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x01, 0x52, 0x1b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x77, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x4d, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x41, 0x72, 0x67, 0x73, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x16, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x2a, 0x54, 0x0a, 0x13, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x01, 0x2a, 0x27, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61,
 	0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x53, 0x54, 0x10, 0x00,
 	0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01,
 	0x2a, 0x86, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74,
@@ -1592,56 +2589,97 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x45, 0x58, 0x10, 0x02, 0x12, 0x30, 0x0a, 0x2c, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f,
 	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54,
 	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52,
-	0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x32, 0xae, 0x05, 0x0a, 0x0d, 0x45, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x2a, 0xac, 0x02, 0x0a, 0x14, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
+	0x6e, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x1b, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x00,
+	0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51, 0x55,
+	0x41, 0x4c, 0x53, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x12, 0x25, 0x0a,
+	0x21, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x48,
+	0x41, 0x4e, 0x10, 0x03, 0x12, 0x2e, 0x0a, 0x2a, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x47, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55,
+	0x41, 0x4c, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x4c, 0x45, 0x53,
+	0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x05, 0x12, 0x2b, 0x0a, 0x27, 0x4c, 0x6f, 0x67, 0x4c,
+	0x69, 0x6e, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51,
+	0x55, 0x41, 0x4c, 0x10, 0x06, 0x32, 0x8e, 0x08, 0x0a, 0x0d, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
 	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01, 0x0a, 0x2a, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63,
-	0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x3e, 0x2e, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63,
-	0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44,
-	0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1b, 0x2e,
-	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x3b, 0x0a, 0x05, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x15, 0x2e, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73,
-	0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12,
-	0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a,
-	0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x56, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74,
-	0x65, 0x63, 0x68, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6b,
-	0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x72,
-	0x70, 0x63, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01, 0x0a, 0x2a, 0x47, 0x65,
+	0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0d, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44,
+	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x05, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x12, 0x15, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x19, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x0e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1e,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x42, 0x56, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74, 0x65,
+	0x63, 0x68, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6b, 0x75,
+	0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x70,
+	0x63, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1656,78 +2694,119 @@ func file_engine_service_proto_rawDescGZIP() []byte {
 	return file_engine_service_proto_rawDescData
 }
 
-var file_engine_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_engine_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_engine_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_engine_service_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_engine_service_proto_goTypes = []interface{}{
-	(EnclaveMode)(0),                                           // 0: engine_api.EnclaveMode
-	(EnclaveContainersStatus)(0),                               // 1: engine_api.EnclaveContainersStatus
-	(EnclaveAPIContainerStatus)(0),                             // 2: engine_api.EnclaveAPIContainerStatus
-	(LogLineOperator)(0),                                       // 3: engine_api.LogLineOperator
-	(*GetEngineInfoResponse)(nil),                              // 4: engine_api.GetEngineInfoResponse
-	(*CreateEnclaveArgs)(nil),                                  // 5: engine_api.CreateEnclaveArgs
-	(*CreateEnclaveResponse)(nil),                              // 6: engine_api.CreateEnclaveResponse
-	(*EnclaveAPIContainerInfo)(nil),                            // 7: engine_api.EnclaveAPIContainerInfo
-	(*EnclaveAPIContainerHostMachineInfo)(nil),                 // 8: engine_api.EnclaveAPIContainerHostMachineInfo
-	(*EnclaveInfo)(nil),                                        // 9: engine_api.EnclaveInfo
-	(*GetEnclavesResponse)(nil),                                // 10: engine_api.GetEnclavesResponse
-	(*EnclaveIdentifiers)(nil),                                 // 11: engine_api.EnclaveIdentifiers
-	(*GetExistingAndHistoricalEnclaveIdentifiersResponse)(nil), // 12: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
-	(*StopEnclaveArgs)(nil),                                    // 13: engine_api.StopEnclaveArgs
-	(*DestroyEnclaveArgs)(nil),                                 // 14: engine_api.DestroyEnclaveArgs
-	(*CleanArgs)(nil),                                          // 15: engine_api.CleanArgs
-	(*EnclaveNameAndUuid)(nil),                                 // 16: engine_api.EnclaveNameAndUuid
-	(*CleanResponse)(nil),                                      // 17: engine_api.CleanResponse
-	(*GetServiceLogsArgs)(nil),                                 // 18: engine_api.GetServiceLogsArgs
-	(*GetServiceLogsResponse)(nil),                             // 19: engine_api.GetServiceLogsResponse
-	(*LogLine)(nil),                                            // 20: engine_api.LogLine
-	(*LogLineFilter)(nil),                                      // 21: engine_api.LogLineFilter
-	nil,                                                        // 22: engine_api.GetEnclavesResponse.EnclaveInfoEntry
-	nil,                                                        // 23: engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
-	nil,                                                        // 24: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
-	nil,                                                        // 25: engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
-	(*timestamppb.Timestamp)(nil),                              // 26: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                                      // 27: google.protobuf.Empty
+	(EnclaveExpiryAction)(0),                                   // 0: engine_api.EnclaveExpiryAction
+	(EnclaveMode)(0),                                           // 1: engine_api.EnclaveMode
+	(EnclaveContainersStatus)(0),                               // 2: engine_api.EnclaveContainersStatus
+	(EnclaveAPIContainerStatus)(0),                             // 3: engine_api.EnclaveAPIContainerStatus
+	(LogLineOperator)(0),                                       // 4: engine_api.LogLineOperator
+	(LogLineFieldOperator)(0),                                  // 5: engine_api.LogLineFieldOperator
+	(*GetEngineInfoResponse)(nil),                              // 6: engine_api.GetEngineInfoResponse
+	(*CreateEnclaveArgs)(nil),                                  // 7: engine_api.CreateEnclaveArgs
+	(*CreateEnclaveResponse)(nil),                              // 8: engine_api.CreateEnclaveResponse
+	(*EnclaveAPIContainerInfo)(nil),                            // 9: engine_api.EnclaveAPIContainerInfo
+	(*EnclaveAPIContainerHostMachineInfo)(nil),                 // 10: engine_api.EnclaveAPIContainerHostMachineInfo
+	(*EnclaveInfo)(nil),                                        // 11: engine_api.EnclaveInfo
+	(*GetEnclavesArgs)(nil),                                    // 12: engine_api.GetEnclavesArgs
+	(*GetEnclavesResponse)(nil),                                // 13: engine_api.GetEnclavesResponse
+	(*EnclaveIdentifiers)(nil),                                 // 14: engine_api.EnclaveIdentifiers
+	(*GetExistingAndHistoricalEnclaveIdentifiersResponse)(nil), // 15: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
+	(*StopEnclaveArgs)(nil),                                    // 16: engine_api.StopEnclaveArgs
+	(*StartEnclaveArgs)(nil),                                   // 17: engine_api.StartEnclaveArgs
+	(*StartEnclaveResponse)(nil),                               // 18: engine_api.StartEnclaveResponse
+	(*ExtendEnclaveArgs)(nil),                                  // 19: engine_api.ExtendEnclaveArgs
+	(*ExtendEnclaveResponse)(nil),                              // 20: engine_api.ExtendEnclaveResponse
+	(*DestroyEnclaveArgs)(nil),                                 // 21: engine_api.DestroyEnclaveArgs
+	(*CleanArgs)(nil),                                          // 22: engine_api.CleanArgs
+	(*EnclaveNameAndUuid)(nil),                                 // 23: engine_api.EnclaveNameAndUuid
+	(*CleanResponse)(nil),                                      // 24: engine_api.CleanResponse
+	(*GetServiceLogsArgs)(nil),                                 // 25: engine_api.GetServiceLogsArgs
+	(*GetServiceLogsResponse)(nil),                             // 26: engine_api.GetServiceLogsResponse
+	(*LogLine)(nil),                                            // 27: engine_api.LogLine
+	(*LogLineFilter)(nil),                                      // 28: engine_api.LogLineFilter
+	(*LogLineFieldFilter)(nil),                                 // 29: engine_api.LogLineFieldFilter
+	(*SnapshotEnclaveArgs)(nil),                                // 30: engine_api.SnapshotEnclaveArgs
+	(*EnclaveSnapshotChunk)(nil),                               // 31: engine_api.EnclaveSnapshotChunk
+	(*RestoreEnclaveArgs)(nil),                                 // 32: engine_api.RestoreEnclaveArgs
+	(*RestoreEnclaveResponse)(nil),                             // 33: engine_api.RestoreEnclaveResponse
+	nil,                                                        // 34: engine_api.CreateEnclaveArgs.LabelsEntry
+	nil,                                                        // 35: engine_api.EnclaveInfo.LabelsEntry
+	nil,                                                        // 36: engine_api.GetEnclavesArgs.LabelSelectorEntry
+	nil,                                                        // 37: engine_api.GetEnclavesResponse.EnclaveInfoEntry
+	nil,                                                        // 38: engine_api.DestroyEnclaveArgs.LabelSelectorEntry
+	nil,                                                        // 39: engine_api.CleanArgs.LabelSelectorEntry
+	nil,                                                        // 40: engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
+	nil,                                                        // 41: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
+	nil,                                                        // 42: engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
+	(*timestamppb.Timestamp)(nil),                              // 43: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                      // 44: google.protobuf.Empty
 }
 var file_engine_service_proto_depIdxs = []int32{
-	0,  // 0: engine_api.CreateEnclaveArgs.mode:type_name -> engine_api.EnclaveMode
-	9,  // 1: engine_api.CreateEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
-	1,  // 2: engine_api.EnclaveInfo.containers_status:type_name -> engine_api.EnclaveContainersStatus
-	2,  // 3: engine_api.EnclaveInfo.api_container_status:type_name -> engine_api.EnclaveAPIContainerStatus
-	7,  // 4: engine_api.EnclaveInfo.api_container_info:type_name -> engine_api.EnclaveAPIContainerInfo
-	8,  // 5: engine_api.EnclaveInfo.api_container_host_machine_info:type_name -> engine_api.EnclaveAPIContainerHostMachineInfo
-	26, // 6: engine_api.EnclaveInfo.creation_time:type_name -> google.protobuf.Timestamp
-	0,  // 7: engine_api.EnclaveInfo.mode:type_name -> engine_api.EnclaveMode
-	22, // 8: engine_api.GetEnclavesResponse.enclave_info:type_name -> engine_api.GetEnclavesResponse.EnclaveInfoEntry
-	11, // 9: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.allIdentifiers:type_name -> engine_api.EnclaveIdentifiers
-	16, // 10: engine_api.CleanResponse.removed_enclave_name_and_uuids:type_name -> engine_api.EnclaveNameAndUuid
-	23, // 11: engine_api.GetServiceLogsArgs.service_uuid_set:type_name -> engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
-	21, // 12: engine_api.GetServiceLogsArgs.conjunctive_filters:type_name -> engine_api.LogLineFilter
-	24, // 13: engine_api.GetServiceLogsResponse.service_logs_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
-	25, // 14: engine_api.GetServiceLogsResponse.not_found_service_uuid_set:type_name -> engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
-	3,  // 15: engine_api.LogLineFilter.operator:type_name -> engine_api.LogLineOperator
-	9,  // 16: engine_api.GetEnclavesResponse.EnclaveInfoEntry.value:type_name -> engine_api.EnclaveInfo
-	20, // 17: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry.value:type_name -> engine_api.LogLine
-	27, // 18: engine_api.EngineService.GetEngineInfo:input_type -> google.protobuf.Empty
-	5,  // 19: engine_api.EngineService.CreateEnclave:input_type -> engine_api.CreateEnclaveArgs
-	27, // 20: engine_api.EngineService.GetEnclaves:input_type -> google.protobuf.Empty
-	27, // 21: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:input_type -> google.protobuf.Empty
-	13, // 22: engine_api.EngineService.StopEnclave:input_type -> engine_api.StopEnclaveArgs
-	14, // 23: engine_api.EngineService.DestroyEnclave:input_type -> engine_api.DestroyEnclaveArgs
-	15, // 24: engine_api.EngineService.Clean:input_type -> engine_api.CleanArgs
-	18, // 25: engine_api.EngineService.GetServiceLogs:input_type -> engine_api.GetServiceLogsArgs
-	4,  // 26: engine_api.EngineService.GetEngineInfo:output_type -> engine_api.GetEngineInfoResponse
-	6,  // 27: engine_api.EngineService.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
-	10, // 28: engine_api.EngineService.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
-	12, // 29: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:output_type -> engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
-	27, // 30: engine_api.EngineService.StopEnclave:output_type -> google.protobuf.Empty
-	27, // 31: engine_api.EngineService.DestroyEnclave:output_type -> google.protobuf.Empty
-	17, // 32: engine_api.EngineService.Clean:output_type -> engine_api.CleanResponse
-	19, // 33: engine_api.EngineService.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
-	26, // [26:34] is the sub-list for method output_type
-	18, // [18:26] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	1,  // 0: engine_api.CreateEnclaveArgs.mode:type_name -> engine_api.EnclaveMode
+	0,  // 1: engine_api.CreateEnclaveArgs.expiry_action:type_name -> engine_api.EnclaveExpiryAction
+	34, // 2: engine_api.CreateEnclaveArgs.labels:type_name -> engine_api.CreateEnclaveArgs.LabelsEntry
+	11, // 3: engine_api.CreateEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
+	2,  // 4: engine_api.EnclaveInfo.containers_status:type_name -> engine_api.EnclaveContainersStatus
+	3,  // 5: engine_api.EnclaveInfo.api_container_status:type_name -> engine_api.EnclaveAPIContainerStatus
+	9,  // 6: engine_api.EnclaveInfo.api_container_info:type_name -> engine_api.EnclaveAPIContainerInfo
+	10, // 7: engine_api.EnclaveInfo.api_container_host_machine_info:type_name -> engine_api.EnclaveAPIContainerHostMachineInfo
+	43, // 8: engine_api.EnclaveInfo.creation_time:type_name -> google.protobuf.Timestamp
+	1,  // 9: engine_api.EnclaveInfo.mode:type_name -> engine_api.EnclaveMode
+	43, // 10: engine_api.EnclaveInfo.expiration_time:type_name -> google.protobuf.Timestamp
+	0,  // 11: engine_api.EnclaveInfo.expiry_action:type_name -> engine_api.EnclaveExpiryAction
+	35, // 12: engine_api.EnclaveInfo.labels:type_name -> engine_api.EnclaveInfo.LabelsEntry
+	36, // 13: engine_api.GetEnclavesArgs.label_selector:type_name -> engine_api.GetEnclavesArgs.LabelSelectorEntry
+	37, // 14: engine_api.GetEnclavesResponse.enclave_info:type_name -> engine_api.GetEnclavesResponse.EnclaveInfoEntry
+	14, // 15: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.allIdentifiers:type_name -> engine_api.EnclaveIdentifiers
+	43, // 16: engine_api.ExtendEnclaveResponse.expiration_time:type_name -> google.protobuf.Timestamp
+	38, // 17: engine_api.DestroyEnclaveArgs.label_selector:type_name -> engine_api.DestroyEnclaveArgs.LabelSelectorEntry
+	39, // 18: engine_api.CleanArgs.label_selector:type_name -> engine_api.CleanArgs.LabelSelectorEntry
+	23, // 19: engine_api.CleanResponse.removed_enclave_name_and_uuids:type_name -> engine_api.EnclaveNameAndUuid
+	40, // 20: engine_api.GetServiceLogsArgs.service_uuid_set:type_name -> engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
+	28, // 21: engine_api.GetServiceLogsArgs.conjunctive_filters:type_name -> engine_api.LogLineFilter
+	43, // 22: engine_api.GetServiceLogsArgs.since:type_name -> google.protobuf.Timestamp
+	43, // 23: engine_api.GetServiceLogsArgs.until:type_name -> google.protobuf.Timestamp
+	29, // 24: engine_api.GetServiceLogsArgs.conjunctive_field_filters:type_name -> engine_api.LogLineFieldFilter
+	41, // 25: engine_api.GetServiceLogsResponse.service_logs_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
+	42, // 26: engine_api.GetServiceLogsResponse.not_found_service_uuid_set:type_name -> engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
+	43, // 27: engine_api.LogLine.timestamp:type_name -> google.protobuf.Timestamp
+	4,  // 28: engine_api.LogLineFilter.operator:type_name -> engine_api.LogLineOperator
+	5,  // 29: engine_api.LogLineFieldFilter.operator:type_name -> engine_api.LogLineFieldOperator
+	7,  // 30: engine_api.RestoreEnclaveArgs.create_enclave_args:type_name -> engine_api.CreateEnclaveArgs
+	11, // 31: engine_api.RestoreEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
+	11, // 32: engine_api.GetEnclavesResponse.EnclaveInfoEntry.value:type_name -> engine_api.EnclaveInfo
+	27, // 33: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry.value:type_name -> engine_api.LogLine
+	44, // 34: engine_api.EngineService.GetEngineInfo:input_type -> google.protobuf.Empty
+	7,  // 35: engine_api.EngineService.CreateEnclave:input_type -> engine_api.CreateEnclaveArgs
+	12, // 36: engine_api.EngineService.GetEnclaves:input_type -> engine_api.GetEnclavesArgs
+	44, // 37: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:input_type -> google.protobuf.Empty
+	16, // 38: engine_api.EngineService.StopEnclave:input_type -> engine_api.StopEnclaveArgs
+	17, // 39: engine_api.EngineService.StartEnclave:input_type -> engine_api.StartEnclaveArgs
+	19, // 40: engine_api.EngineService.ExtendEnclave:input_type -> engine_api.ExtendEnclaveArgs
+	21, // 41: engine_api.EngineService.DestroyEnclave:input_type -> engine_api.DestroyEnclaveArgs
+	22, // 42: engine_api.EngineService.Clean:input_type -> engine_api.CleanArgs
+	25, // 43: engine_api.EngineService.GetServiceLogs:input_type -> engine_api.GetServiceLogsArgs
+	30, // 44: engine_api.EngineService.SnapshotEnclave:input_type -> engine_api.SnapshotEnclaveArgs
+	32, // 45: engine_api.EngineService.RestoreEnclave:input_type -> engine_api.RestoreEnclaveArgs
+	6,  // 46: engine_api.EngineService.GetEngineInfo:output_type -> engine_api.GetEngineInfoResponse
+	8,  // 47: engine_api.EngineService.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
+	13, // 48: engine_api.EngineService.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
+	15, // 49: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:output_type -> engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
+	44, // 50: engine_api.EngineService.StopEnclave:output_type -> google.protobuf.Empty
+	18, // 51: engine_api.EngineService.StartEnclave:output_type -> engine_api.StartEnclaveResponse
+	20, // 52: engine_api.EngineService.ExtendEnclave:output_type -> engine_api.ExtendEnclaveResponse
+	44, // 53: engine_api.EngineService.DestroyEnclave:output_type -> google.protobuf.Empty
+	24, // 54: engine_api.EngineService.Clean:output_type -> engine_api.CleanResponse
+	26, // 55: engine_api.EngineService.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
+	31, // 56: engine_api.EngineService.SnapshotEnclave:output_type -> engine_api.EnclaveSnapshotChunk
+	33, // 57: engine_api.EngineService.RestoreEnclave:output_type -> engine_api.RestoreEnclaveResponse
+	46, // [46:58] is the sub-list for method output_type
+	34, // [34:46] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_engine_service_proto_init() }
@@ -1809,7 +2888,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnclavesResponse); i {
+			switch v := v.(*GetEnclavesArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1821,7 +2900,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveIdentifiers); i {
+			switch v := v.(*GetEnclavesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1833,7 +2912,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExistingAndHistoricalEnclaveIdentifiersResponse); i {
+			switch v := v.(*EnclaveIdentifiers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1845,7 +2924,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopEnclaveArgs); i {
+			switch v := v.(*GetExistingAndHistoricalEnclaveIdentifiersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1857,7 +2936,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyEnclaveArgs); i {
+			switch v := v.(*StopEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1869,7 +2948,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanArgs); i {
+			switch v := v.(*StartEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1881,7 +2960,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveNameAndUuid); i {
+			switch v := v.(*StartEnclaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1893,7 +2972,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanResponse); i {
+			switch v := v.(*ExtendEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1905,7 +2984,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceLogsArgs); i {
+			switch v := v.(*ExtendEnclaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1917,7 +2996,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceLogsResponse); i {
+			switch v := v.(*DestroyEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1929,7 +3008,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogLine); i {
+			switch v := v.(*CleanArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1941,6 +3020,66 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnclaveNameAndUuid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceLogsArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogLineFilter); i {
 			case 0:
 				return &v.state
@@ -1952,14 +3091,78 @@ func file_engine_service_proto_init() {
 				return nil
 			}
 		}
+		file_engine_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLineFieldFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotEnclaveArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnclaveSnapshotChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreEnclaveArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreEnclaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_engine_service_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[24].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_engine_service_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   22,
+			NumEnums:      6,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
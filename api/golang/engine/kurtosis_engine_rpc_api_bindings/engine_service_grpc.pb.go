@@ -25,9 +25,13 @@ const (
 	EngineService_GetEnclaves_FullMethodName                                = "/engine_api.EngineService/GetEnclaves"
 	EngineService_GetExistingAndHistoricalEnclaveIdentifiers_FullMethodName = "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers"
 	EngineService_StopEnclave_FullMethodName                                = "/engine_api.EngineService/StopEnclave"
+	EngineService_StartEnclave_FullMethodName                               = "/engine_api.EngineService/StartEnclave"
+	EngineService_ExtendEnclave_FullMethodName                              = "/engine_api.EngineService/ExtendEnclave"
 	EngineService_DestroyEnclave_FullMethodName                             = "/engine_api.EngineService/DestroyEnclave"
 	EngineService_Clean_FullMethodName                                      = "/engine_api.EngineService/Clean"
 	EngineService_GetServiceLogs_FullMethodName                             = "/engine_api.EngineService/GetServiceLogs"
+	EngineService_SnapshotEnclave_FullMethodName                            = "/engine_api.EngineService/SnapshotEnclave"
+	EngineService_RestoreEnclave_FullMethodName                             = "/engine_api.EngineService/RestoreEnclave"
 )
 
 // EngineServiceClient is the client API for EngineService service.
@@ -43,18 +47,26 @@ type EngineServiceClient interface {
 	// ==============================================================================================
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(ctx context.Context, in *CreateEnclaveArgs, opts ...grpc.CallOption) (*CreateEnclaveResponse, error)
-	// Returns information about the existing enclaves
-	GetEnclaves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavesResponse, error)
+	// Returns information about the existing enclaves, optionally only the ones matching a label selector
+	GetEnclaves(ctx context.Context, in *GetEnclavesArgs, opts ...grpc.CallOption) (*GetEnclavesResponse, error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Starts a stopped enclave again, resuming its API container and its services
+	StartEnclave(ctx context.Context, in *StartEnclaveArgs, opts ...grpc.CallOption) (*StartEnclaveResponse, error)
+	// Pushes back the time at which an enclave expires
+	ExtendEnclave(ctx context.Context, in *ExtendEnclaveArgs, opts ...grpc.CallOption) (*ExtendEnclaveResponse, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Gets rid of old enclaves
 	Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error)
+	// Snapshots an enclave into a single archive, streamed back in chunks
+	SnapshotEnclave(ctx context.Context, in *SnapshotEnclaveArgs, opts ...grpc.CallOption) (EngineService_SnapshotEnclaveClient, error)
+	// Creates a new enclave from an archive produced by SnapshotEnclave, streamed in chunks
+	RestoreEnclave(ctx context.Context, opts ...grpc.CallOption) (EngineService_RestoreEnclaveClient, error)
 }
 
 type engineServiceClient struct {
@@ -83,7 +95,7 @@ func (c *engineServiceClient) CreateEnclave(ctx context.Context, in *CreateEncla
 	return out, nil
 }
 
-func (c *engineServiceClient) GetEnclaves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavesResponse, error) {
+func (c *engineServiceClient) GetEnclaves(ctx context.Context, in *GetEnclavesArgs, opts ...grpc.CallOption) (*GetEnclavesResponse, error) {
 	out := new(GetEnclavesResponse)
 	err := c.cc.Invoke(ctx, EngineService_GetEnclaves_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -110,6 +122,24 @@ func (c *engineServiceClient) StopEnclave(ctx context.Context, in *StopEnclaveAr
 	return out, nil
 }
 
+func (c *engineServiceClient) StartEnclave(ctx context.Context, in *StartEnclaveArgs, opts ...grpc.CallOption) (*StartEnclaveResponse, error) {
+	out := new(StartEnclaveResponse)
+	err := c.cc.Invoke(ctx, EngineService_StartEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ExtendEnclave(ctx context.Context, in *ExtendEnclaveArgs, opts ...grpc.CallOption) (*ExtendEnclaveResponse, error) {
+	out := new(ExtendEnclaveResponse)
+	err := c.cc.Invoke(ctx, EngineService_ExtendEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, EngineService_DestroyEnclave_FullMethodName, in, out, opts...)
@@ -160,6 +190,72 @@ func (x *engineServiceGetServiceLogsClient) Recv() (*GetServiceLogsResponse, err
 	return m, nil
 }
 
+func (c *engineServiceClient) SnapshotEnclave(ctx context.Context, in *SnapshotEnclaveArgs, opts ...grpc.CallOption) (EngineService_SnapshotEnclaveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[1], EngineService_SnapshotEnclave_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceSnapshotEnclaveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EngineService_SnapshotEnclaveClient interface {
+	Recv() (*EnclaveSnapshotChunk, error)
+	grpc.ClientStream
+}
+
+type engineServiceSnapshotEnclaveClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceSnapshotEnclaveClient) Recv() (*EnclaveSnapshotChunk, error) {
+	m := new(EnclaveSnapshotChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *engineServiceClient) RestoreEnclave(ctx context.Context, opts ...grpc.CallOption) (EngineService_RestoreEnclaveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[2], EngineService_RestoreEnclave_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceRestoreEnclaveClient{stream}
+	return x, nil
+}
+
+type EngineService_RestoreEnclaveClient interface {
+	Send(*RestoreEnclaveArgs) error
+	CloseAndRecv() (*RestoreEnclaveResponse, error)
+	grpc.ClientStream
+}
+
+type engineServiceRestoreEnclaveClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceRestoreEnclaveClient) Send(m *RestoreEnclaveArgs) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *engineServiceRestoreEnclaveClient) CloseAndRecv() (*RestoreEnclaveResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RestoreEnclaveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // EngineServiceServer is the server API for EngineService service.
 // All implementations should embed UnimplementedEngineServiceServer
 // for forward compatibility
@@ -173,18 +269,26 @@ type EngineServiceServer interface {
 	// ==============================================================================================
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *CreateEnclaveArgs) (*CreateEnclaveResponse, error)
-	// Returns information about the existing enclaves
-	GetEnclaves(context.Context, *emptypb.Empty) (*GetEnclavesResponse, error)
+	// Returns information about the existing enclaves, optionally only the ones matching a label selector
+	GetEnclaves(context.Context, *GetEnclavesArgs) (*GetEnclavesResponse, error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error)
+	// Starts a stopped enclave again, resuming its API container and its services
+	StartEnclave(context.Context, *StartEnclaveArgs) (*StartEnclaveResponse, error)
+	// Pushes back the time at which an enclave expires
+	ExtendEnclave(context.Context, *ExtendEnclaveArgs) (*ExtendEnclaveResponse, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *CleanArgs) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error
+	// Snapshots an enclave into a single archive, streamed back in chunks
+	SnapshotEnclave(*SnapshotEnclaveArgs, EngineService_SnapshotEnclaveServer) error
+	// Creates a new enclave from an archive produced by SnapshotEnclave, streamed in chunks
+	RestoreEnclave(EngineService_RestoreEnclaveServer) error
 }
 
 // UnimplementedEngineServiceServer should be embedded to have forward compatible implementations.
@@ -197,7 +301,7 @@ func (UnimplementedEngineServiceServer) GetEngineInfo(context.Context, *emptypb.
 func (UnimplementedEngineServiceServer) CreateEnclave(context.Context, *CreateEnclaveArgs) (*CreateEnclaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEnclave not implemented")
 }
-func (UnimplementedEngineServiceServer) GetEnclaves(context.Context, *emptypb.Empty) (*GetEnclavesResponse, error) {
+func (UnimplementedEngineServiceServer) GetEnclaves(context.Context, *GetEnclavesArgs) (*GetEnclavesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaves not implemented")
 }
 func (UnimplementedEngineServiceServer) GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error) {
@@ -206,6 +310,12 @@ func (UnimplementedEngineServiceServer) GetExistingAndHistoricalEnclaveIdentifie
 func (UnimplementedEngineServiceServer) StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopEnclave not implemented")
 }
+func (UnimplementedEngineServiceServer) StartEnclave(context.Context, *StartEnclaveArgs) (*StartEnclaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) ExtendEnclave(context.Context, *ExtendEnclaveArgs) (*ExtendEnclaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendEnclave not implemented")
+}
 func (UnimplementedEngineServiceServer) DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnclave not implemented")
 }
@@ -215,6 +325,12 @@ func (UnimplementedEngineServiceServer) Clean(context.Context, *CleanArgs) (*Cle
 func (UnimplementedEngineServiceServer) GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetServiceLogs not implemented")
 }
+func (UnimplementedEngineServiceServer) SnapshotEnclave(*SnapshotEnclaveArgs, EngineService_SnapshotEnclaveServer) error {
+	return status.Errorf(codes.Unimplemented, "method SnapshotEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) RestoreEnclave(EngineService_RestoreEnclaveServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreEnclave not implemented")
+}
 
 // UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EngineServiceServer will
@@ -264,7 +380,7 @@ func _EngineService_CreateEnclave_Handler(srv interface{}, ctx context.Context,
 }
 
 func _EngineService_GetEnclaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetEnclavesArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -276,7 +392,7 @@ func _EngineService_GetEnclaves_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: EngineService_GetEnclaves_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EngineServiceServer).GetEnclaves(ctx, req.(*emptypb.Empty))
+		return srv.(EngineServiceServer).GetEnclaves(ctx, req.(*GetEnclavesArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -317,6 +433,42 @@ func _EngineService_StopEnclave_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_StartEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).StartEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_StartEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).StartEnclave(ctx, req.(*StartEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ExtendEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ExtendEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ExtendEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ExtendEnclave(ctx, req.(*ExtendEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_DestroyEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DestroyEnclaveArgs)
 	if err := dec(in); err != nil {
@@ -374,6 +526,53 @@ func (x *engineServiceGetServiceLogsServer) Send(m *GetServiceLogsResponse) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EngineService_SnapshotEnclave_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SnapshotEnclaveArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EngineServiceServer).SnapshotEnclave(m, &engineServiceSnapshotEnclaveServer{stream})
+}
+
+type EngineService_SnapshotEnclaveServer interface {
+	Send(*EnclaveSnapshotChunk) error
+	grpc.ServerStream
+}
+
+type engineServiceSnapshotEnclaveServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceSnapshotEnclaveServer) Send(m *EnclaveSnapshotChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EngineService_RestoreEnclave_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EngineServiceServer).RestoreEnclave(&engineServiceRestoreEnclaveServer{stream})
+}
+
+type EngineService_RestoreEnclaveServer interface {
+	SendAndClose(*RestoreEnclaveResponse) error
+	Recv() (*RestoreEnclaveArgs, error)
+	grpc.ServerStream
+}
+
+type engineServiceRestoreEnclaveServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceRestoreEnclaveServer) SendAndClose(m *RestoreEnclaveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *engineServiceRestoreEnclaveServer) Recv() (*RestoreEnclaveArgs, error) {
+	m := new(RestoreEnclaveArgs)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -401,6 +600,14 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopEnclave",
 			Handler:    _EngineService_StopEnclave_Handler,
 		},
+		{
+			MethodName: "StartEnclave",
+			Handler:    _EngineService_StartEnclave_Handler,
+		},
+		{
+			MethodName: "ExtendEnclave",
+			Handler:    _EngineService_ExtendEnclave_Handler,
+		},
 		{
 			MethodName: "DestroyEnclave",
 			Handler:    _EngineService_DestroyEnclave_Handler,
@@ -416,6 +623,16 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _EngineService_GetServiceLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SnapshotEnclave",
+			Handler:       _EngineService_SnapshotEnclave_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreEnclave",
+			Handler:       _EngineService_RestoreEnclave_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "engine_service.proto",
 }
@@ -49,6 +49,12 @@ const (
 	// EngineServiceStopEnclaveProcedure is the fully-qualified name of the EngineService's StopEnclave
 	// RPC.
 	EngineServiceStopEnclaveProcedure = "/engine_api.EngineService/StopEnclave"
+	// EngineServiceStartEnclaveProcedure is the fully-qualified name of the EngineService's
+	// StartEnclave RPC.
+	EngineServiceStartEnclaveProcedure = "/engine_api.EngineService/StartEnclave"
+	// EngineServiceExtendEnclaveProcedure is the fully-qualified name of the EngineService's
+	// ExtendEnclave RPC.
+	EngineServiceExtendEnclaveProcedure = "/engine_api.EngineService/ExtendEnclave"
 	// EngineServiceDestroyEnclaveProcedure is the fully-qualified name of the EngineService's
 	// DestroyEnclave RPC.
 	EngineServiceDestroyEnclaveProcedure = "/engine_api.EngineService/DestroyEnclave"
@@ -57,6 +63,12 @@ const (
 	// EngineServiceGetServiceLogsProcedure is the fully-qualified name of the EngineService's
 	// GetServiceLogs RPC.
 	EngineServiceGetServiceLogsProcedure = "/engine_api.EngineService/GetServiceLogs"
+	// EngineServiceSnapshotEnclaveProcedure is the fully-qualified name of the EngineService's
+	// SnapshotEnclave RPC.
+	EngineServiceSnapshotEnclaveProcedure = "/engine_api.EngineService/SnapshotEnclave"
+	// EngineServiceRestoreEnclaveProcedure is the fully-qualified name of the EngineService's
+	// RestoreEnclave RPC.
+	EngineServiceRestoreEnclaveProcedure = "/engine_api.EngineService/RestoreEnclave"
 )
 
 // EngineServiceClient is a client for the engine_api.EngineService service.
@@ -70,18 +82,26 @@ type EngineServiceClient interface {
 	// ==============================================================================================
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error)
-	// Returns information about the existing enclaves
-	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
+	// Returns information about the existing enclaves, optionally only the ones matching a label selector
+	GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Starts a stopped enclave again, resuming its API container and its services
+	StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.StartEnclaveResponse], error)
+	// Pushes back the time at which an enclave expires
+	ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse], error)
+	// Snapshots an enclave into a single archive, streamed back in chunks
+	SnapshotEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.EnclaveSnapshotChunk], error)
+	// Creates a new enclave from an archive produced by SnapshotEnclave, streamed in chunks
+	RestoreEnclave(context.Context) *connect.ClientStreamForClient[kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs, kurtosis_engine_rpc_api_bindings.RestoreEnclaveResponse]
 }
 
 // NewEngineServiceClient constructs a client for the engine_api.EngineService service. By default,
@@ -104,7 +124,7 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceCreateEnclaveProcedure,
 			opts...,
 		),
-		getEnclaves: connect.NewClient[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse](
+		getEnclaves: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse](
 			httpClient,
 			baseURL+EngineServiceGetEnclavesProcedure,
 			opts...,
@@ -119,6 +139,16 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceStopEnclaveProcedure,
 			opts...,
 		),
+		startEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs, kurtosis_engine_rpc_api_bindings.StartEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceStartEnclaveProcedure,
+			opts...,
+		),
+		extendEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs, kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceExtendEnclaveProcedure,
+			opts...,
+		),
 		destroyEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty](
 			httpClient,
 			baseURL+EngineServiceDestroyEnclaveProcedure,
@@ -134,6 +164,16 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceGetServiceLogsProcedure,
 			opts...,
 		),
+		snapshotEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs, kurtosis_engine_rpc_api_bindings.EnclaveSnapshotChunk](
+			httpClient,
+			baseURL+EngineServiceSnapshotEnclaveProcedure,
+			opts...,
+		),
+		restoreEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs, kurtosis_engine_rpc_api_bindings.RestoreEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceRestoreEnclaveProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -141,12 +181,16 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type engineServiceClient struct {
 	getEngineInfo                              *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse]
 	createEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs, kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse]
-	getEnclaves                                *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
+	getEnclaves                                *connect.Client[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
 	getExistingAndHistoricalEnclaveIdentifiers *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse]
 	stopEnclave                                *connect.Client[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs, emptypb.Empty]
+	startEnclave                               *connect.Client[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs, kurtosis_engine_rpc_api_bindings.StartEnclaveResponse]
+	extendEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs, kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse]
 	destroyEnclave                             *connect.Client[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty]
 	clean                                      *connect.Client[kurtosis_engine_rpc_api_bindings.CleanArgs, kurtosis_engine_rpc_api_bindings.CleanResponse]
 	getServiceLogs                             *connect.Client[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]
+	snapshotEnclave                            *connect.Client[kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs, kurtosis_engine_rpc_api_bindings.EnclaveSnapshotChunk]
+	restoreEnclave                             *connect.Client[kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs, kurtosis_engine_rpc_api_bindings.RestoreEnclaveResponse]
 }
 
 // GetEngineInfo calls engine_api.EngineService.GetEngineInfo.
@@ -160,7 +204,7 @@ func (c *engineServiceClient) CreateEnclave(ctx context.Context, req *connect.Re
 }
 
 // GetEnclaves calls engine_api.EngineService.GetEnclaves.
-func (c *engineServiceClient) GetEnclaves(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+func (c *engineServiceClient) GetEnclaves(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
 	return c.getEnclaves.CallUnary(ctx, req)
 }
 
@@ -175,6 +219,16 @@ func (c *engineServiceClient) StopEnclave(ctx context.Context, req *connect.Requ
 	return c.stopEnclave.CallUnary(ctx, req)
 }
 
+// StartEnclave calls engine_api.EngineService.StartEnclave.
+func (c *engineServiceClient) StartEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.StartEnclaveResponse], error) {
+	return c.startEnclave.CallUnary(ctx, req)
+}
+
+// ExtendEnclave calls engine_api.EngineService.ExtendEnclave.
+func (c *engineServiceClient) ExtendEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	return c.extendEnclave.CallUnary(ctx, req)
+}
+
 // DestroyEnclave calls engine_api.EngineService.DestroyEnclave.
 func (c *engineServiceClient) DestroyEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	return c.destroyEnclave.CallUnary(ctx, req)
@@ -190,6 +244,16 @@ func (c *engineServiceClient) GetServiceLogs(ctx context.Context, req *connect.R
 	return c.getServiceLogs.CallServerStream(ctx, req)
 }
 
+// SnapshotEnclave calls engine_api.EngineService.SnapshotEnclave.
+func (c *engineServiceClient) SnapshotEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.EnclaveSnapshotChunk], error) {
+	return c.snapshotEnclave.CallServerStream(ctx, req)
+}
+
+// RestoreEnclave calls engine_api.EngineService.RestoreEnclave.
+func (c *engineServiceClient) RestoreEnclave(ctx context.Context) *connect.ClientStreamForClient[kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs, kurtosis_engine_rpc_api_bindings.RestoreEnclaveResponse] {
+	return c.restoreEnclave.CallClientStream(ctx)
+}
+
 // EngineServiceHandler is an implementation of the engine_api.EngineService service.
 type EngineServiceHandler interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
@@ -201,18 +265,26 @@ type EngineServiceHandler interface {
 	// ==============================================================================================
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error)
-	// Returns information about the existing enclaves
-	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
+	// Returns information about the existing enclaves, optionally only the ones matching a label selector
+	GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Starts a stopped enclave again, resuming its API container and its services
+	StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.StartEnclaveResponse], error)
+	// Pushes back the time at which an enclave expires
+	ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error
+	// Snapshots an enclave into a single archive, streamed back in chunks
+	SnapshotEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EnclaveSnapshotChunk]) error
+	// Creates a new enclave from an archive produced by SnapshotEnclave, streamed in chunks
+	RestoreEnclave(context.Context, *connect.ClientStream[kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.RestoreEnclaveResponse], error)
 }
 
 // NewEngineServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -246,6 +318,16 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.StopEnclave,
 		opts...,
 	)
+	engineServiceStartEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceStartEnclaveProcedure,
+		svc.StartEnclave,
+		opts...,
+	)
+	engineServiceExtendEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceExtendEnclaveProcedure,
+		svc.ExtendEnclave,
+		opts...,
+	)
 	engineServiceDestroyEnclaveHandler := connect.NewUnaryHandler(
 		EngineServiceDestroyEnclaveProcedure,
 		svc.DestroyEnclave,
@@ -261,6 +343,16 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.GetServiceLogs,
 		opts...,
 	)
+	engineServiceSnapshotEnclaveHandler := connect.NewServerStreamHandler(
+		EngineServiceSnapshotEnclaveProcedure,
+		svc.SnapshotEnclave,
+		opts...,
+	)
+	engineServiceRestoreEnclaveHandler := connect.NewClientStreamHandler(
+		EngineServiceRestoreEnclaveProcedure,
+		svc.RestoreEnclave,
+		opts...,
+	)
 	return "/engine_api.EngineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EngineServiceGetEngineInfoProcedure:
@@ -273,12 +365,20 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 			engineServiceGetExistingAndHistoricalEnclaveIdentifiersHandler.ServeHTTP(w, r)
 		case EngineServiceStopEnclaveProcedure:
 			engineServiceStopEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceStartEnclaveProcedure:
+			engineServiceStartEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceExtendEnclaveProcedure:
+			engineServiceExtendEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceDestroyEnclaveProcedure:
 			engineServiceDestroyEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceCleanProcedure:
 			engineServiceCleanHandler.ServeHTTP(w, r)
 		case EngineServiceGetServiceLogsProcedure:
 			engineServiceGetServiceLogsHandler.ServeHTTP(w, r)
+		case EngineServiceSnapshotEnclaveProcedure:
+			engineServiceSnapshotEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceRestoreEnclaveProcedure:
+			engineServiceRestoreEnclaveHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -296,7 +396,7 @@ func (UnimplementedEngineServiceHandler) CreateEnclave(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.CreateEnclave is not implemented"))
 }
 
-func (UnimplementedEngineServiceHandler) GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+func (UnimplementedEngineServiceHandler) GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetEnclaves is not implemented"))
 }
 
@@ -308,6 +408,14 @@ func (UnimplementedEngineServiceHandler) StopEnclave(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.StopEnclave is not implemented"))
 }
 
+func (UnimplementedEngineServiceHandler) StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.StartEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.StartEnclave is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.ExtendEnclave is not implemented"))
+}
+
 func (UnimplementedEngineServiceHandler) DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.DestroyEnclave is not implemented"))
 }
@@ -319,3 +427,11 @@ func (UnimplementedEngineServiceHandler) Clean(context.Context, *connect.Request
 func (UnimplementedEngineServiceHandler) GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetServiceLogs is not implemented"))
 }
+
+func (UnimplementedEngineServiceHandler) SnapshotEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EnclaveSnapshotChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.SnapshotEnclave is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) RestoreEnclave(context.Context, *connect.ClientStream[kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.RestoreEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.RestoreEnclave is not implemented"))
+}
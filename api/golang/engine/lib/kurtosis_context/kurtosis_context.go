@@ -21,6 +21,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
 const (
@@ -39,6 +41,8 @@ const (
 	validUuidMatchesAllowed = 1
 
 	portalIsRequired = true
+
+	enclaveSnapshotChunkSizeInBytes = 3 * 1024 * 1024
 )
 
 var (
@@ -54,6 +58,10 @@ var (
 
 	ForwardPortWaitUntilReady      = true
 	ForwardPortDoNotWaitUntilReady = false
+
+	// An empty label selector matches every enclave
+	noLabelSelector map[string]string = nil
+	noEnclaveLabels map[string]string = nil
 )
 
 // Docs available at https://docs.kurtosis.com/sdk#kurtosiscontext
@@ -109,12 +117,23 @@ func (kurtosisCtx *KurtosisContext) CreateEnclave(
 	ctx context.Context,
 	enclaveName string,
 ) (*enclaves.EnclaveContext, error) {
+	return kurtosisCtx.CreateEnclaveWithLabels(ctx, enclaveName, noEnclaveLabels)
+}
+
+// CreateEnclaveWithLabels is like CreateEnclave, but the enclave gets the given labels, which can be used to select it
+// in GetEnclavesMatchingLabels, DestroyEnclavesMatchingLabels and CleanEnclavesMatchingLabels
+func (kurtosisCtx *KurtosisContext) CreateEnclaveWithLabels(
+	ctx context.Context,
+	enclaveName string,
+	labels map[string]string,
+) (*enclaves.EnclaveContext, error) {
 
 	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
 		EnclaveName:            enclaveName,
 		ApiContainerVersionTag: defaultApiContainerVersionTag,
 		ApiContainerLogLevel:   apiContainerLogLevel.String(),
 		Mode:                   kurtosis_engine_rpc_api_bindings.EnclaveMode_TEST,
+		Labels:                 labels,
 	}
 
 	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
@@ -173,11 +192,20 @@ func (kurtosisCtx *KurtosisContext) GetEnclaveContext(ctx context.Context, encla
 
 // Docs available at https://docs.kurtosis.com/sdk#getenclaves---enclaves-enclaves
 func (kurtosisCtx *KurtosisContext) GetEnclaves(ctx context.Context) (*Enclaves, error) {
-	response, err := kurtosisCtx.engineClient.GetEnclaves(ctx, &emptypb.Empty{})
+	return kurtosisCtx.GetEnclavesMatchingLabels(ctx, noLabelSelector)
+}
+
+// GetEnclavesMatchingLabels returns the enclaves having all the labels of the selector
+func (kurtosisCtx *KurtosisContext) GetEnclavesMatchingLabels(ctx context.Context, labelSelector map[string]string) (*Enclaves, error) {
+	getEnclavesArgs := &kurtosis_engine_rpc_api_bindings.GetEnclavesArgs{
+		LabelSelector: labelSelector,
+	}
+	response, err := kurtosisCtx.engineClient.GetEnclaves(ctx, getEnclavesArgs)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
-			"An error occurred getting enclaves",
+			"An error occurred getting enclaves matching label selector '%+v'",
+			labelSelector,
 		)
 	}
 
@@ -244,6 +272,37 @@ func (kurtosisCtx *KurtosisContext) StopEnclave(ctx context.Context, enclaveIden
 	return nil
 }
 
+// StartEnclave starts a stopped enclave again, restarting the services that were running when it was stopped, and
+// returns the names of these services
+func (kurtosisCtx *KurtosisContext) StartEnclave(ctx context.Context, enclaveIdentifier string) ([]string, error) {
+	startEnclaveArgs := &kurtosis_engine_rpc_api_bindings.StartEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+	}
+
+	startEnclaveResponse, err := kurtosisCtx.engineClient.StartEnclave(ctx, startEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	return startEnclaveResponse.GetResumedServiceNames(), nil
+}
+
+// ExtendEnclave pushes back the expiration of the enclave by the given duration and resets its idle timeout. It returns
+// the new expiration time of the enclave
+func (kurtosisCtx *KurtosisContext) ExtendEnclave(ctx context.Context, enclaveIdentifier string, extension time.Duration) (time.Time, error) {
+	extendEnclaveArgs := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		ExtensionSeconds:  uint32(extension.Seconds()),
+	}
+
+	extendEnclaveResponse, err := kurtosisCtx.engineClient.ExtendEnclave(ctx, extendEnclaveArgs)
+	if err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred extending the expiration of enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	return extendEnclaveResponse.GetExpirationTime().AsTime(), nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk/#destroyenclavestring-enclaveidentifier
 func (kurtosisCtx *KurtosisContext) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
 	destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{
@@ -257,14 +316,112 @@ func (kurtosisCtx *KurtosisContext) DestroyEnclave(ctx context.Context, enclaveI
 	return nil
 }
 
+// DestroyEnclavesMatchingLabels destroys all the enclaves having all the labels of the selector, which can't be empty
+func (kurtosisCtx *KurtosisContext) DestroyEnclavesMatchingLabels(ctx context.Context, labelSelector map[string]string) error {
+	destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{
+		EnclaveIdentifier: "",
+		LabelSelector:     labelSelector,
+	}
+
+	if _, err := kurtosisCtx.engineClient.DestroyEnclave(ctx, destroyEnclaveArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the enclaves matching label selector '%+v'", labelSelector)
+	}
+
+	return nil
+}
+
+// SnapshotEnclave writes an archive containing the services, files artifacts and, optionally, the persistent
+// directories and the container filesystems of the enclave to the output. The archive can be loaded into a new enclave
+// using RestoreEnclave
+func (kurtosisCtx *KurtosisContext) SnapshotEnclave(ctx context.Context, enclaveIdentifier string, includePersistentDirectories bool, includeContainerFilesystems bool, output io.Writer) error {
+	snapshotEnclaveArgs := &kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs{
+		EnclaveIdentifier:            enclaveIdentifier,
+		IncludePersistentDirectories: &includePersistentDirectories,
+		IncludeContainerFilesystems:  &includeContainerFilesystems,
+	}
+
+	stream, err := kurtosisCtx.engineClient.SnapshotEnclave(ctx, snapshotEnclaveArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred initiating the snapshot of enclave with identifier '%v'", enclaveIdentifier)
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred receiving the snapshot of enclave with identifier '%v'", enclaveIdentifier)
+		}
+		if _, err := output.Write(chunk.GetData()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the snapshot of enclave with identifier '%v'", enclaveIdentifier)
+		}
+	}
+}
+
+// RestoreEnclave creates a new enclave with the content of a snapshot produced by SnapshotEnclave
+func (kurtosisCtx *KurtosisContext) RestoreEnclave(ctx context.Context, enclaveName string, snapshot io.Reader) (*enclaves.EnclaveContext, error) {
+	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
+		EnclaveName:            enclaveName,
+		ApiContainerVersionTag: defaultApiContainerVersionTag,
+		ApiContainerLogLevel:   apiContainerLogLevel.String(),
+		Mode:                   kurtosis_engine_rpc_api_bindings.EnclaveMode_TEST,
+	}
+
+	stream, err := kurtosisCtx.engineClient.RestoreEnclave(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred initiating the restore of the enclave snapshot")
+	}
+	// the enclave creation arguments are sent along with the first chunk only
+	restoreEnclaveArgs := &kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs{
+		CreateEnclaveArgs: createEnclaveArgs,
+		Data:              nil,
+	}
+	chunkBuffer := make([]byte, enclaveSnapshotChunkSizeInBytes)
+	for {
+		numBytesRead, readErr := io.ReadFull(snapshot, chunkBuffer)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return nil, stacktrace.Propagate(readErr, "An error occurred reading the enclave snapshot")
+		}
+		if numBytesRead > 0 || restoreEnclaveArgs.CreateEnclaveArgs != nil {
+			restoreEnclaveArgs.Data = chunkBuffer[:numBytesRead]
+			if err := stream.Send(restoreEnclaveArgs); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred sending the enclave snapshot")
+			}
+			restoreEnclaveArgs = &kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs{
+				CreateEnclaveArgs: nil,
+				Data:              nil,
+			}
+		}
+		if readErr != nil {
+			break
+		}
+	}
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred restoring the enclave snapshot into a new enclave with name '%v'", enclaveName)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.GetEnclaveInfo())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from the restored enclave; this should never happen")
+	}
+	return enclaveContext, nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk#cleanboolean-shouldcleanall---enclavenameanduuid-removedenclavenameanduuids
 func (kurtosisCtx *KurtosisContext) Clean(ctx context.Context, shouldCleanAll bool) ([]*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid, error) {
+	return kurtosisCtx.CleanEnclavesMatchingLabels(ctx, shouldCleanAll, noLabelSelector)
+}
+
+// CleanEnclavesMatchingLabels is like Clean, but only the enclaves having all the labels of the selector are removed
+func (kurtosisCtx *KurtosisContext) CleanEnclavesMatchingLabels(ctx context.Context, shouldCleanAll bool, labelSelector map[string]string) ([]*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid, error) {
 	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{
 		ShouldCleanAll: shouldCleanAll,
+		LabelSelector:  labelSelector,
 	}
 	cleanResponse, err := kurtosisCtx.engineClient.Clean(ctx, cleanArgs)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred when trying to perform a clean with the clean-all arg set to '%v'", shouldCleanAll)
+		return nil, stacktrace.Propagate(err, "An error occurred when trying to perform a clean with the clean-all arg set to '%v' and label selector '%+v'", shouldCleanAll, labelSelector)
 	}
 
 	return cleanResponse.RemovedEnclaveNameAndUuids, nil
@@ -279,6 +436,11 @@ func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	shouldReturnAllLogs bool,
 	numLogLines uint32,
 	logLineFilter *LogLineFilter,
+	// the log lines have to match all of them
+	logLineFieldFilters []*LogLineFieldFilter,
+	// a zero time leaves the time range open on that side; [numLogLines] are the last lines inside the range
+	since time.Time,
+	until time.Time,
 ) (
 	chan *serviceLogsStreamContent,
 	func(),
@@ -297,7 +459,7 @@ func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	//this process could take much time until the next channel pull, so we could be filling the buffer during that time to not let the servers thread idled
 	serviceLogsStreamContentChan := make(chan *serviceLogsStreamContent, serviceLogsStreamContentChanBufferSize)
 
-	getServiceLogsArgs, err := newGetServiceLogsArgs(enclaveIdentifier, userServiceUuids, shouldFollowLogs, shouldReturnAllLogs, numLogLines, logLineFilter)
+	getServiceLogsArgs, err := newGetServiceLogsArgs(enclaveIdentifier, userServiceUuids, shouldFollowLogs, shouldReturnAllLogs, numLogLines, logLineFilter, logLineFieldFilters, since, until)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(
 			err,
@@ -503,6 +665,9 @@ func newGetServiceLogsArgs(
 	shouldReturnAllLogs bool,
 	numLogLines uint32,
 	logLineFilter *LogLineFilter,
+	logLineFieldFilters []*LogLineFieldFilter,
+	since time.Time,
+	until time.Time,
 ) (*kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, error) {
 	userServiceUuuidSet := make(map[string]bool, len(userServiceUUIDs))
 
@@ -516,13 +681,29 @@ func newGetServiceLogsArgs(
 		return nil, stacktrace.Propagate(err, "An error occurred creating the GRPC conjunctive log line filters '%+v'", logLineFilter)
 	}
 
+	grpcConjunctiveFieldFilters, err := newGRPCConjunctiveFieldFilters(logLineFieldFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the GRPC conjunctive log line field filters '%+v'", logLineFieldFilters)
+	}
+
+	var grpcSince, grpcUntil *timestamppb.Timestamp
+	if !since.IsZero() {
+		grpcSince = timestamppb.New(since)
+	}
+	if !until.IsZero() {
+		grpcUntil = timestamppb.New(until)
+	}
+
 	getUserServiceLogsArgs := &kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs{
-		EnclaveIdentifier:  enclaveIdentifier,
-		ServiceUuidSet:     userServiceUuuidSet,
-		FollowLogs:         shouldFollowLogs,
-		ConjunctiveFilters: grpcConjunctiveFilters,
-		ReturnAllLogs:      shouldReturnAllLogs,
-		NumLogLines:        numLogLines,
+		EnclaveIdentifier:       enclaveIdentifier,
+		ServiceUuidSet:          userServiceUuuidSet,
+		FollowLogs:              shouldFollowLogs,
+		ConjunctiveFilters:      grpcConjunctiveFilters,
+		ReturnAllLogs:           shouldReturnAllLogs,
+		NumLogLines:             numLogLines,
+		Since:                   grpcSince,
+		Until:                   grpcUntil,
+		ConjunctiveFieldFilters: grpcConjunctiveFieldFilters,
 	}
 
 	return getUserServiceLogsArgs, nil
@@ -564,6 +745,42 @@ func newGRPCConjunctiveFilters(
 	return grpcLogLineFilters, nil
 }
 
+func newGRPCConjunctiveFieldFilters(
+	logLineFieldFilters []*LogLineFieldFilter,
+) ([]*kurtosis_engine_rpc_api_bindings.LogLineFieldFilter, error) {
+	grpcLogLineFieldFilters := []*kurtosis_engine_rpc_api_bindings.LogLineFieldFilter{}
+
+	for _, logLineFieldFilter := range logLineFieldFilters {
+		var grpcOperator kurtosis_engine_rpc_api_bindings.LogLineFieldOperator
+		switch logLineFieldFilter.operator {
+		case logLineFieldOperator_Equals:
+			grpcOperator = kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_EQUALS
+		case logLineFieldOperator_NotEquals:
+			grpcOperator = kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_NOT_EQUALS
+		case logLineFieldOperator_MatchesRegex:
+			grpcOperator = kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_MATCHES_REGEX
+		case logLineFieldOperator_GreaterThan:
+			grpcOperator = kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_GREATER_THAN
+		case logLineFieldOperator_GreaterThanOrEqual:
+			grpcOperator = kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_GREATER_THAN_OR_EQUAL
+		case logLineFieldOperator_LessThan:
+			grpcOperator = kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_LESS_THAN
+		case logLineFieldOperator_LessThanOrEqual:
+			grpcOperator = kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_LESS_THAN_OR_EQUAL
+		default:
+			return nil, stacktrace.NewError("Unrecognized log line field filter operator '%v' in field filter '%+v'; this is a bug in Kurtosis", logLineFieldFilter.operator, logLineFieldFilter)
+		}
+		grpcLogLineFieldFilter := &kurtosis_engine_rpc_api_bindings.LogLineFieldFilter{
+			FieldPath: logLineFieldFilter.fieldPath,
+			Operator:  grpcOperator,
+			Value:     logLineFieldFilter.value,
+		}
+		grpcLogLineFieldFilters = append(grpcLogLineFieldFilters, grpcLogLineFieldFilter)
+	}
+
+	return grpcLogLineFieldFilters, nil
+}
+
 func newServiceLogsStreamContentFromGrpcStreamResponse(
 	requestedServiceUuids map[services.ServiceUUID]bool,
 	getServiceLogResponse *kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse,
@@ -577,8 +794,20 @@ func newServiceLogsStreamContentFromGrpcStreamResponse(
 		serviceLogs := []*ServiceLog{}
 		serviceLogLine, found := receivedServiceLogsByServiceUuid[serviceUuidStr]
 		if found {
-			for _, logLineContent := range serviceLogLine.Line {
-				serviceLog := newServiceLog(logLineContent)
+			for logLineIdx, logLineContent := range serviceLogLine.GetLine() {
+				// engines older than the log line timestamps and levels don't send them
+				var timestamp time.Time
+				if logLineIdx < len(serviceLogLine.GetTimestamp()) {
+					grpcTimestamp := serviceLogLine.GetTimestamp()[logLineIdx]
+					if grpcTimestamp.GetSeconds() != 0 || grpcTimestamp.GetNanos() != 0 {
+						timestamp = grpcTimestamp.AsTime()
+					}
+				}
+				var level string
+				if logLineIdx < len(serviceLogLine.GetLevel()) {
+					level = serviceLogLine.GetLevel()[logLineIdx]
+				}
+				serviceLog := newServiceLog(logLineContent, timestamp, level)
 				serviceLogs = append(serviceLogs, serviceLog)
 			}
 		}
@@ -0,0 +1,100 @@
+package kurtosis_context
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+)
+
+const (
+	fieldPathIndexOpeningChar = '['
+	fieldPathIndexClosingChar = ']'
+	fieldFilterValueQuotes    = `"'`
+)
+
+// The operators of the field filter expressions; the two-char ones go first so that they're matched before their prefixes
+var fieldFilterExpressionOperators = []struct {
+	symbol   string
+	operator logLineFieldOperator
+}{
+	{"==", logLineFieldOperator_Equals},
+	{"!=", logLineFieldOperator_NotEquals},
+	{"=~", logLineFieldOperator_MatchesRegex},
+	{">=", logLineFieldOperator_GreaterThanOrEqual},
+	{"<=", logLineFieldOperator_LessThanOrEqual},
+	{">", logLineFieldOperator_GreaterThan},
+	{"<", logLineFieldOperator_LessThan},
+	{"=", logLineFieldOperator_Equals},
+}
+
+// LogLineFieldFilter is a predicate over a field of the service log lines emitted as JSON objects; log lines that
+// aren't JSON objects never match it
+// The field is referenced by its JSON path, e.g. "$.request.status", "request.status" or "$.items[0].name"
+// Numbers are compared by value and everything else by its string representation
+type LogLineFieldFilter struct {
+	fieldPath string
+	operator  logLineFieldOperator
+	value     string
+}
+
+func NewFieldEqualsLogLineFieldFilter(fieldPath string, value string) *LogLineFieldFilter {
+	return &LogLineFieldFilter{fieldPath: fieldPath, operator: logLineFieldOperator_Equals, value: value}
+}
+
+func NewFieldNotEqualsLogLineFieldFilter(fieldPath string, value string) *LogLineFieldFilter {
+	return &LogLineFieldFilter{fieldPath: fieldPath, operator: logLineFieldOperator_NotEquals, value: value}
+}
+
+func NewFieldMatchesRegexLogLineFieldFilter(fieldPath string, regex string) *LogLineFieldFilter {
+	return &LogLineFieldFilter{fieldPath: fieldPath, operator: logLineFieldOperator_MatchesRegex, value: regex}
+}
+
+func NewFieldGreaterThanLogLineFieldFilter(fieldPath string, value string) *LogLineFieldFilter {
+	return &LogLineFieldFilter{fieldPath: fieldPath, operator: logLineFieldOperator_GreaterThan, value: value}
+}
+
+func NewFieldGreaterThanOrEqualLogLineFieldFilter(fieldPath string, value string) *LogLineFieldFilter {
+	return &LogLineFieldFilter{fieldPath: fieldPath, operator: logLineFieldOperator_GreaterThanOrEqual, value: value}
+}
+
+func NewFieldLessThanLogLineFieldFilter(fieldPath string, value string) *LogLineFieldFilter {
+	return &LogLineFieldFilter{fieldPath: fieldPath, operator: logLineFieldOperator_LessThan, value: value}
+}
+
+func NewFieldLessThanOrEqualLogLineFieldFilter(fieldPath string, value string) *LogLineFieldFilter {
+	return &LogLineFieldFilter{fieldPath: fieldPath, operator: logLineFieldOperator_LessThanOrEqual, value: value}
+}
+
+// NewLogLineFieldFilterFromExpression parses expressions of the form `<field path> <operator> <value>`, e.g.
+// `$.level == error`, `request.status>=500` or `msg =~ "^timeout"`, where the operator is one of ==, =, !=, =~, >, >=, < and <=
+// The value can be enclosed in quotes, to keep its leading and trailing spaces
+func NewLogLineFieldFilterFromExpression(expression string) (*LogLineFieldFilter, error) {
+	isInsideBrackets := false
+	for charIdx := 0; charIdx < len(expression); charIdx++ {
+		switch expression[charIdx] {
+		case fieldPathIndexOpeningChar:
+			isInsideBrackets = true
+			continue
+		case fieldPathIndexClosingChar:
+			isInsideBrackets = false
+			continue
+		}
+		if isInsideBrackets {
+			continue
+		}
+		for _, expressionOperator := range fieldFilterExpressionOperators {
+			if !strings.HasPrefix(expression[charIdx:], expressionOperator.symbol) {
+				continue
+			}
+			fieldPath := strings.TrimSpace(expression[:charIdx])
+			if fieldPath == "" {
+				return nil, stacktrace.NewError("The log line field filter expression '%v' doesn't have a field path before its operator '%v'", expression, expressionOperator.symbol)
+			}
+			value := strings.TrimSpace(expression[charIdx+len(expressionOperator.symbol):])
+			if len(value) >= 2 && strings.ContainsRune(fieldFilterValueQuotes, rune(value[0])) && value[len(value)-1] == value[0] {
+				value = value[1 : len(value)-1]
+			}
+			return &LogLineFieldFilter{fieldPath: fieldPath, operator: expressionOperator.operator, value: value}, nil
+		}
+	}
+	return nil, stacktrace.NewError("The log line field filter expression '%v' doesn't contain any of the supported operators; it should be of the form '<field path> <operator> <value>', e.g. '$.level == error'", expression)
+}
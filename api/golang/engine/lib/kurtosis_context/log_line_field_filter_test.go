@@ -0,0 +1,37 @@
+package kurtosis_context
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewLogLineFieldFilterFromExpression(t *testing.T) {
+	expectedFieldFiltersByExpression := map[string]*LogLineFieldFilter{
+		"$.level == error":       NewFieldEqualsLogLineFieldFilter("$.level", "error"),
+		"level=error":            NewFieldEqualsLogLineFieldFilter("level", "error"),
+		"$.request.status>=500":  NewFieldGreaterThanOrEqualLogLineFieldFilter("$.request.status", "500"),
+		"$.request.status < 500": NewFieldLessThanLogLineFieldFilter("$.request.status", "500"),
+		"duration_ms > 1000":     NewFieldGreaterThanLogLineFieldFilter("duration_ms", "1000"),
+		"duration_ms <= 10":      NewFieldLessThanOrEqualLogLineFieldFilter("duration_ms", "10"),
+		"$.user != \"admin\"":    NewFieldNotEqualsLogLineFieldFilter("$.user", "admin"),
+		"msg =~ '^timeout '":     NewFieldMatchesRegexLogLineFieldFilter("msg", "^timeout "),
+		`$["a=b"] == c`:          NewFieldEqualsLogLineFieldFilter(`$["a=b"]`, "c"),
+		"$.query == \"a == b\"":  NewFieldEqualsLogLineFieldFilter("$.query", "a == b"),
+		"$.empty == ":            NewFieldEqualsLogLineFieldFilter("$.empty", ""),
+	}
+
+	for expression, expectedFieldFilter := range expectedFieldFiltersByExpression {
+		fieldFilter, err := NewLogLineFieldFilterFromExpression(expression)
+		require.NoError(t, err, "Unexpected error parsing expression '%v'", expression)
+		require.Equal(t, expectedFieldFilter, fieldFilter, "Unexpected field filter for expression '%v'", expression)
+	}
+}
+
+func TestNewLogLineFieldFilterFromExpression_InvalidExpressions(t *testing.T) {
+	invalidExpressions := []string{"", "$.level", "== error", `$["a=b"]`}
+
+	for _, expression := range invalidExpressions {
+		_, err := NewLogLineFieldFilterFromExpression(expression)
+		require.Error(t, err, "Expected expression '%v' to be invalid", expression)
+	}
+}
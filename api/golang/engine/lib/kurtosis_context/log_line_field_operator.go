@@ -0,0 +1,13 @@
+package kurtosis_context
+
+type logLineFieldOperator uint8
+
+const (
+	logLineFieldOperator_Equals logLineFieldOperator = iota
+	logLineFieldOperator_NotEquals
+	logLineFieldOperator_MatchesRegex
+	logLineFieldOperator_GreaterThan
+	logLineFieldOperator_GreaterThanOrEqual
+	logLineFieldOperator_LessThan
+	logLineFieldOperator_LessThanOrEqual
+)
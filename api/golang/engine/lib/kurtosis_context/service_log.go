@@ -1,15 +1,30 @@
 package kurtosis_context
 
+import "time"
+
 // This is an object to represent a simple log line information
 type ServiceLog struct {
-	// lineTime time.Time //TODO add the time from loki logs result
 	content string
+
+	// the zero time if the logs database doesn't store the time the line was emitted at
+	timestamp time.Time
+
+	// one of trace, debug, info, warn, error or fatal; empty if it couldn't be extracted from the content
+	level string
 }
 
-func newServiceLog(content string) *ServiceLog {
-	return &ServiceLog{content: content}
+func newServiceLog(content string, timestamp time.Time, level string) *ServiceLog {
+	return &ServiceLog{content: content, timestamp: timestamp, level: level}
 }
 
 func (serviceLog ServiceLog) GetContent() string {
 	return serviceLog.content
 }
+
+func (serviceLog ServiceLog) GetTimestamp() time.Time {
+	return serviceLog.timestamp
+}
+
+func (serviceLog ServiceLog) GetLevel() string {
+	return serviceLog.level
+}
@@ -20,10 +20,23 @@ interface IApiContainerServiceService extends grpc.ServiceDefinition<grpc.Untype
   downloadFilesArtifact: grpc.MethodDefinition<api_container_service_pb.DownloadFilesArtifactArgs, api_container_service_pb.StreamedDataChunk>;
   storeWebFilesArtifact: grpc.MethodDefinition<api_container_service_pb.StoreWebFilesArtifactArgs, api_container_service_pb.StoreWebFilesArtifactResponse>;
   storeFilesArtifactFromService: grpc.MethodDefinition<api_container_service_pb.StoreFilesArtifactFromServiceArgs, api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
+  copyFilesToService: grpc.MethodDefinition<api_container_service_pb.CopyFilesToServiceChunk, google_protobuf_empty_pb.Empty>;
+  copyFilesArtifactToService: grpc.MethodDefinition<api_container_service_pb.CopyFilesArtifactToServiceArgs, google_protobuf_empty_pb.Empty>;
+  copyFilesFromService: grpc.MethodDefinition<api_container_service_pb.CopyFilesFromServiceArgs, api_container_service_pb.StreamedDataChunk>;
   listFilesArtifactNamesAndUuids: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>;
   inspectFilesArtifactContents: grpc.MethodDefinition<api_container_service_pb.InspectFilesArtifactContentsRequest, api_container_service_pb.InspectFilesArtifactContentsResponse>;
+  removeFilesArtifact: grpc.MethodDefinition<api_container_service_pb.RemoveFilesArtifactArgs, google_protobuf_empty_pb.Empty>;
+  listFilesArtifactVersions: grpc.MethodDefinition<api_container_service_pb.ListFilesArtifactVersionsArgs, api_container_service_pb.ListFilesArtifactVersionsResponse>;
   connectServices: grpc.MethodDefinition<api_container_service_pb.ConnectServicesArgs, api_container_service_pb.ConnectServicesResponse>;
   getStarlarkRun: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetStarlarkRunResponse>;
+  exportEnclaveSnapshot: grpc.MethodDefinition<api_container_service_pb.ExportEnclaveSnapshotArgs, api_container_service_pb.StreamedDataChunk>;
+  importEnclaveSnapshot: grpc.MethodDefinition<api_container_service_pb.StreamedDataChunk, google_protobuf_empty_pb.Empty>;
+  resumeServices: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.ResumeServicesResponse>;
+  getEnclaveExpiry: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.EnclaveExpiry>;
+  setEnclaveExpiry: grpc.MethodDefinition<api_container_service_pb.EnclaveExpiry, google_protobuf_empty_pb.Empty>;
+  getEnclaveResourceQuotas: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetEnclaveResourceQuotasResponse>;
+  getServicesStats: grpc.MethodDefinition<api_container_service_pb.GetServicesStatsArgs, api_container_service_pb.GetServicesStatsResponse>;
+  lintStarlark: grpc.MethodDefinition<api_container_service_pb.LintStarlarkArgs, api_container_service_pb.LintStarlarkResponse>;
 }
 
 export const ApiContainerServiceService: IApiContainerServiceService;
@@ -41,10 +54,23 @@ export interface IApiContainerServiceServer extends grpc.UntypedServiceImplement
   downloadFilesArtifact: grpc.handleServerStreamingCall<api_container_service_pb.DownloadFilesArtifactArgs, api_container_service_pb.StreamedDataChunk>;
   storeWebFilesArtifact: grpc.handleUnaryCall<api_container_service_pb.StoreWebFilesArtifactArgs, api_container_service_pb.StoreWebFilesArtifactResponse>;
   storeFilesArtifactFromService: grpc.handleUnaryCall<api_container_service_pb.StoreFilesArtifactFromServiceArgs, api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
+  copyFilesToService: grpc.handleClientStreamingCall<api_container_service_pb.CopyFilesToServiceChunk, google_protobuf_empty_pb.Empty>;
+  copyFilesArtifactToService: grpc.handleUnaryCall<api_container_service_pb.CopyFilesArtifactToServiceArgs, google_protobuf_empty_pb.Empty>;
+  copyFilesFromService: grpc.handleServerStreamingCall<api_container_service_pb.CopyFilesFromServiceArgs, api_container_service_pb.StreamedDataChunk>;
   listFilesArtifactNamesAndUuids: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>;
   inspectFilesArtifactContents: grpc.handleUnaryCall<api_container_service_pb.InspectFilesArtifactContentsRequest, api_container_service_pb.InspectFilesArtifactContentsResponse>;
+  removeFilesArtifact: grpc.handleUnaryCall<api_container_service_pb.RemoveFilesArtifactArgs, google_protobuf_empty_pb.Empty>;
+  listFilesArtifactVersions: grpc.handleUnaryCall<api_container_service_pb.ListFilesArtifactVersionsArgs, api_container_service_pb.ListFilesArtifactVersionsResponse>;
   connectServices: grpc.handleUnaryCall<api_container_service_pb.ConnectServicesArgs, api_container_service_pb.ConnectServicesResponse>;
   getStarlarkRun: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetStarlarkRunResponse>;
+  exportEnclaveSnapshot: grpc.handleServerStreamingCall<api_container_service_pb.ExportEnclaveSnapshotArgs, api_container_service_pb.StreamedDataChunk>;
+  importEnclaveSnapshot: grpc.handleClientStreamingCall<api_container_service_pb.StreamedDataChunk, google_protobuf_empty_pb.Empty>;
+  resumeServices: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.ResumeServicesResponse>;
+  getEnclaveExpiry: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.EnclaveExpiry>;
+  setEnclaveExpiry: grpc.handleUnaryCall<api_container_service_pb.EnclaveExpiry, google_protobuf_empty_pb.Empty>;
+  getEnclaveResourceQuotas: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetEnclaveResourceQuotasResponse>;
+  getServicesStats: grpc.handleServerStreamingCall<api_container_service_pb.GetServicesStatsArgs, api_container_service_pb.GetServicesStatsResponse>;
+  lintStarlark: grpc.handleUnaryCall<api_container_service_pb.LintStarlarkArgs, api_container_service_pb.LintStarlarkResponse>;
 }
 
 export class ApiContainerServiceClient extends grpc.Client {
@@ -82,16 +108,52 @@ export class ApiContainerServiceClient extends grpc.Client {
   storeFilesArtifactFromService(argument: api_container_service_pb.StoreFilesArtifactFromServiceArgs, callback: grpc.requestCallback<api_container_service_pb.StoreFilesArtifactFromServiceResponse>): grpc.ClientUnaryCall;
   storeFilesArtifactFromService(argument: api_container_service_pb.StoreFilesArtifactFromServiceArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StoreFilesArtifactFromServiceResponse>): grpc.ClientUnaryCall;
   storeFilesArtifactFromService(argument: api_container_service_pb.StoreFilesArtifactFromServiceArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StoreFilesArtifactFromServiceResponse>): grpc.ClientUnaryCall;
+  copyFilesToService(callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientWritableStream<api_container_service_pb.CopyFilesToServiceChunk>;
+  copyFilesToService(metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientWritableStream<api_container_service_pb.CopyFilesToServiceChunk>;
+  copyFilesToService(metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientWritableStream<api_container_service_pb.CopyFilesToServiceChunk>;
+  copyFilesArtifactToService(argument: api_container_service_pb.CopyFilesArtifactToServiceArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  copyFilesArtifactToService(argument: api_container_service_pb.CopyFilesArtifactToServiceArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  copyFilesArtifactToService(argument: api_container_service_pb.CopyFilesArtifactToServiceArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  copyFilesFromService(argument: api_container_service_pb.CopyFilesFromServiceArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+  copyFilesFromService(argument: api_container_service_pb.CopyFilesFromServiceArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
   listFilesArtifactNamesAndUuids(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>): grpc.ClientUnaryCall;
   listFilesArtifactNamesAndUuids(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>): grpc.ClientUnaryCall;
   listFilesArtifactNamesAndUuids(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>): grpc.ClientUnaryCall;
   inspectFilesArtifactContents(argument: api_container_service_pb.InspectFilesArtifactContentsRequest, callback: grpc.requestCallback<api_container_service_pb.InspectFilesArtifactContentsResponse>): grpc.ClientUnaryCall;
   inspectFilesArtifactContents(argument: api_container_service_pb.InspectFilesArtifactContentsRequest, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.InspectFilesArtifactContentsResponse>): grpc.ClientUnaryCall;
   inspectFilesArtifactContents(argument: api_container_service_pb.InspectFilesArtifactContentsRequest, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.InspectFilesArtifactContentsResponse>): grpc.ClientUnaryCall;
+  removeFilesArtifact(argument: api_container_service_pb.RemoveFilesArtifactArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  removeFilesArtifact(argument: api_container_service_pb.RemoveFilesArtifactArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  removeFilesArtifact(argument: api_container_service_pb.RemoveFilesArtifactArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  listFilesArtifactVersions(argument: api_container_service_pb.ListFilesArtifactVersionsArgs, callback: grpc.requestCallback<api_container_service_pb.ListFilesArtifactVersionsResponse>): grpc.ClientUnaryCall;
+  listFilesArtifactVersions(argument: api_container_service_pb.ListFilesArtifactVersionsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ListFilesArtifactVersionsResponse>): grpc.ClientUnaryCall;
+  listFilesArtifactVersions(argument: api_container_service_pb.ListFilesArtifactVersionsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ListFilesArtifactVersionsResponse>): grpc.ClientUnaryCall;
   connectServices(argument: api_container_service_pb.ConnectServicesArgs, callback: grpc.requestCallback<api_container_service_pb.ConnectServicesResponse>): grpc.ClientUnaryCall;
   connectServices(argument: api_container_service_pb.ConnectServicesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ConnectServicesResponse>): grpc.ClientUnaryCall;
   connectServices(argument: api_container_service_pb.ConnectServicesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ConnectServicesResponse>): grpc.ClientUnaryCall;
   getStarlarkRun(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunResponse>): grpc.ClientUnaryCall;
   getStarlarkRun(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunResponse>): grpc.ClientUnaryCall;
   getStarlarkRun(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunResponse>): grpc.ClientUnaryCall;
+  exportEnclaveSnapshot(argument: api_container_service_pb.ExportEnclaveSnapshotArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+  exportEnclaveSnapshot(argument: api_container_service_pb.ExportEnclaveSnapshotArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+  importEnclaveSnapshot(callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientWritableStream<api_container_service_pb.StreamedDataChunk>;
+  importEnclaveSnapshot(metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientWritableStream<api_container_service_pb.StreamedDataChunk>;
+  importEnclaveSnapshot(metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientWritableStream<api_container_service_pb.StreamedDataChunk>;
+  resumeServices(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.ResumeServicesResponse>): grpc.ClientUnaryCall;
+  resumeServices(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ResumeServicesResponse>): grpc.ClientUnaryCall;
+  resumeServices(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ResumeServicesResponse>): grpc.ClientUnaryCall;
+  getEnclaveExpiry(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.EnclaveExpiry>): grpc.ClientUnaryCall;
+  getEnclaveExpiry(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.EnclaveExpiry>): grpc.ClientUnaryCall;
+  getEnclaveExpiry(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.EnclaveExpiry>): grpc.ClientUnaryCall;
+  setEnclaveExpiry(argument: api_container_service_pb.EnclaveExpiry, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  setEnclaveExpiry(argument: api_container_service_pb.EnclaveExpiry, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  setEnclaveExpiry(argument: api_container_service_pb.EnclaveExpiry, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  getEnclaveResourceQuotas(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveResourceQuotasResponse>): grpc.ClientUnaryCall;
+  getEnclaveResourceQuotas(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveResourceQuotasResponse>): grpc.ClientUnaryCall;
+  getEnclaveResourceQuotas(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveResourceQuotasResponse>): grpc.ClientUnaryCall;
+  getServicesStats(argument: api_container_service_pb.GetServicesStatsArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.GetServicesStatsResponse>;
+  getServicesStats(argument: api_container_service_pb.GetServicesStatsArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.GetServicesStatsResponse>;
+  lintStarlark(argument: api_container_service_pb.LintStarlarkArgs, callback: grpc.requestCallback<api_container_service_pb.LintStarlarkResponse>): grpc.ClientUnaryCall;
+  lintStarlark(argument: api_container_service_pb.LintStarlarkArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.LintStarlarkResponse>): grpc.ClientUnaryCall;
+  lintStarlark(argument: api_container_service_pb.LintStarlarkArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.LintStarlarkResponse>): grpc.ClientUnaryCall;
 }
@@ -4,6 +4,7 @@
 var grpc = require('@grpc/grpc-js');
 var api_container_service_pb = require('./api_container_service_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 
 function serialize_api_container_api_ConnectServicesArgs(arg) {
   if (!(arg instanceof api_container_service_pb.ConnectServicesArgs)) {
@@ -27,6 +28,39 @@ function deserialize_api_container_api_ConnectServicesResponse(buffer_arg) {
   return api_container_service_pb.ConnectServicesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_CopyFilesArtifactToServiceArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.CopyFilesArtifactToServiceArgs)) {
+    throw new Error('Expected argument of type api_container_api.CopyFilesArtifactToServiceArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_CopyFilesArtifactToServiceArgs(buffer_arg) {
+  return api_container_service_pb.CopyFilesArtifactToServiceArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_CopyFilesFromServiceArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.CopyFilesFromServiceArgs)) {
+    throw new Error('Expected argument of type api_container_api.CopyFilesFromServiceArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_CopyFilesFromServiceArgs(buffer_arg) {
+  return api_container_service_pb.CopyFilesFromServiceArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_CopyFilesToServiceChunk(arg) {
+  if (!(arg instanceof api_container_service_pb.CopyFilesToServiceChunk)) {
+    throw new Error('Expected argument of type api_container_api.CopyFilesToServiceChunk');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_CopyFilesToServiceChunk(buffer_arg) {
+  return api_container_service_pb.CopyFilesToServiceChunk.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_DownloadFilesArtifactArgs(arg) {
   if (!(arg instanceof api_container_service_pb.DownloadFilesArtifactArgs)) {
     throw new Error('Expected argument of type api_container_api.DownloadFilesArtifactArgs');
@@ -38,6 +72,17 @@ function deserialize_api_container_api_DownloadFilesArtifactArgs(buffer_arg) {
   return api_container_service_pb.DownloadFilesArtifactArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_EnclaveExpiry(arg) {
+  if (!(arg instanceof api_container_service_pb.EnclaveExpiry)) {
+    throw new Error('Expected argument of type api_container_api.EnclaveExpiry');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_EnclaveExpiry(buffer_arg) {
+  return api_container_service_pb.EnclaveExpiry.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_ExecCommandArgs(arg) {
   if (!(arg instanceof api_container_service_pb.ExecCommandArgs)) {
     throw new Error('Expected argument of type api_container_api.ExecCommandArgs');
@@ -60,6 +105,28 @@ function deserialize_api_container_api_ExecCommandResponse(buffer_arg) {
   return api_container_service_pb.ExecCommandResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_ExportEnclaveSnapshotArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.ExportEnclaveSnapshotArgs)) {
+    throw new Error('Expected argument of type api_container_api.ExportEnclaveSnapshotArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ExportEnclaveSnapshotArgs(buffer_arg) {
+  return api_container_service_pb.ExportEnclaveSnapshotArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetEnclaveResourceQuotasResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetEnclaveResourceQuotasResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetEnclaveResourceQuotasResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetEnclaveResourceQuotasResponse(buffer_arg) {
+  return api_container_service_pb.GetEnclaveResourceQuotasResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetExistingAndHistoricalServiceIdentifiersResponse(arg) {
   if (!(arg instanceof api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse)) {
     throw new Error('Expected argument of type api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse');
@@ -93,6 +160,28 @@ function deserialize_api_container_api_GetServicesResponse(buffer_arg) {
   return api_container_service_pb.GetServicesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetServicesStatsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetServicesStatsArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetServicesStatsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetServicesStatsArgs(buffer_arg) {
+  return api_container_service_pb.GetServicesStatsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetServicesStatsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetServicesStatsResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetServicesStatsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetServicesStatsResponse(buffer_arg) {
+  return api_container_service_pb.GetServicesStatsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetStarlarkRunResponse(arg) {
   if (!(arg instanceof api_container_service_pb.GetStarlarkRunResponse)) {
     throw new Error('Expected argument of type api_container_api.GetStarlarkRunResponse');
@@ -126,6 +215,28 @@ function deserialize_api_container_api_InspectFilesArtifactContentsResponse(buff
   return api_container_service_pb.InspectFilesArtifactContentsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_LintStarlarkArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.LintStarlarkArgs)) {
+    throw new Error('Expected argument of type api_container_api.LintStarlarkArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_LintStarlarkArgs(buffer_arg) {
+  return api_container_service_pb.LintStarlarkArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_LintStarlarkResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.LintStarlarkResponse)) {
+    throw new Error('Expected argument of type api_container_api.LintStarlarkResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_LintStarlarkResponse(buffer_arg) {
+  return api_container_service_pb.LintStarlarkResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_ListFilesArtifactNamesAndUuidsResponse(arg) {
   if (!(arg instanceof api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse)) {
     throw new Error('Expected argument of type api_container_api.ListFilesArtifactNamesAndUuidsResponse');
@@ -137,6 +248,50 @@ function deserialize_api_container_api_ListFilesArtifactNamesAndUuidsResponse(bu
   return api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_ListFilesArtifactVersionsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.ListFilesArtifactVersionsArgs)) {
+    throw new Error('Expected argument of type api_container_api.ListFilesArtifactVersionsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ListFilesArtifactVersionsArgs(buffer_arg) {
+  return api_container_service_pb.ListFilesArtifactVersionsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_ListFilesArtifactVersionsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.ListFilesArtifactVersionsResponse)) {
+    throw new Error('Expected argument of type api_container_api.ListFilesArtifactVersionsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ListFilesArtifactVersionsResponse(buffer_arg) {
+  return api_container_service_pb.ListFilesArtifactVersionsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_RemoveFilesArtifactArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.RemoveFilesArtifactArgs)) {
+    throw new Error('Expected argument of type api_container_api.RemoveFilesArtifactArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RemoveFilesArtifactArgs(buffer_arg) {
+  return api_container_service_pb.RemoveFilesArtifactArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_ResumeServicesResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.ResumeServicesResponse)) {
+    throw new Error('Expected argument of type api_container_api.ResumeServicesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ResumeServicesResponse(buffer_arg) {
+  return api_container_service_pb.ResumeServicesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_RunStarlarkPackageArgs(arg) {
   if (!(arg instanceof api_container_service_pb.RunStarlarkPackageArgs)) {
     throw new Error('Expected argument of type api_container_api.RunStarlarkPackageArgs');
@@ -415,6 +570,42 @@ storeFilesArtifactFromService: {
     responseSerialize: serialize_api_container_api_StoreFilesArtifactFromServiceResponse,
     responseDeserialize: deserialize_api_container_api_StoreFilesArtifactFromServiceResponse,
   },
+  // Copies the streamed TGZ, packaged like a files artifact, into a directory of a running service
+copyFilesToService: {
+    path: '/api_container_api.ApiContainerService/CopyFilesToService',
+    requestStream: true,
+    responseStream: false,
+    requestType: api_container_service_pb.CopyFilesToServiceChunk,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_CopyFilesToServiceChunk,
+    requestDeserialize: deserialize_api_container_api_CopyFilesToServiceChunk,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Copies the content of a files artifact into a directory of a running service
+copyFilesArtifactToService: {
+    path: '/api_container_api.ApiContainerService/CopyFilesArtifactToService',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.CopyFilesArtifactToServiceArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_CopyFilesArtifactToServiceArgs,
+    requestDeserialize: deserialize_api_container_api_CopyFilesArtifactToServiceArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Streams the content at a path of a running service, packaged as a TGZ
+copyFilesFromService: {
+    path: '/api_container_api.ApiContainerService/CopyFilesFromService',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.CopyFilesFromServiceArgs,
+    responseType: api_container_service_pb.StreamedDataChunk,
+    requestSerialize: serialize_api_container_api_CopyFilesFromServiceArgs,
+    requestDeserialize: deserialize_api_container_api_CopyFilesFromServiceArgs,
+    responseSerialize: serialize_api_container_api_StreamedDataChunk,
+    responseDeserialize: deserialize_api_container_api_StreamedDataChunk,
+  },
   listFilesArtifactNamesAndUuids: {
     path: '/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids',
     requestStream: false,
@@ -437,6 +628,30 @@ storeFilesArtifactFromService: {
     responseSerialize: serialize_api_container_api_InspectFilesArtifactContentsResponse,
     responseDeserialize: deserialize_api_container_api_InspectFilesArtifactContentsResponse,
   },
+  // Removes a files artifact from the Kurtosis File System, refusing to if a running service mounts it
+removeFilesArtifact: {
+    path: '/api_container_api.ApiContainerService/RemoveFilesArtifact',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.RemoveFilesArtifactArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_RemoveFilesArtifactArgs,
+    requestDeserialize: deserialize_api_container_api_RemoveFilesArtifactArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Lists the versions of a files artifact kept by the Kurtosis File System, from the oldest to the current one
+listFilesArtifactVersions: {
+    path: '/api_container_api.ApiContainerService/ListFilesArtifactVersions',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.ListFilesArtifactVersionsArgs,
+    responseType: api_container_service_pb.ListFilesArtifactVersionsResponse,
+    requestSerialize: serialize_api_container_api_ListFilesArtifactVersionsArgs,
+    requestDeserialize: deserialize_api_container_api_ListFilesArtifactVersionsArgs,
+    responseSerialize: serialize_api_container_api_ListFilesArtifactVersionsResponse,
+    responseDeserialize: deserialize_api_container_api_ListFilesArtifactVersionsResponse,
+  },
   // User services port forwarding
 connectServices: {
     path: '/api_container_api.ApiContainerService/ConnectServices',
@@ -461,6 +676,103 @@ getStarlarkRun: {
     responseSerialize: serialize_api_container_api_GetStarlarkRunResponse,
     responseDeserialize: deserialize_api_container_api_GetStarlarkRunResponse,
   },
+  // Exports the state of the enclave (services, files artifacts, runtime values and enclave plan) as a single archive
+exportEnclaveSnapshot: {
+    path: '/api_container_api.ApiContainerService/ExportEnclaveSnapshot',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.ExportEnclaveSnapshotArgs,
+    responseType: api_container_service_pb.StreamedDataChunk,
+    requestSerialize: serialize_api_container_api_ExportEnclaveSnapshotArgs,
+    requestDeserialize: deserialize_api_container_api_ExportEnclaveSnapshotArgs,
+    responseSerialize: serialize_api_container_api_StreamedDataChunk,
+    responseDeserialize: deserialize_api_container_api_StreamedDataChunk,
+  },
+  // Restores a snapshot produced by ExportEnclaveSnapshot into this enclave, which must not contain any service yet
+importEnclaveSnapshot: {
+    path: '/api_container_api.ApiContainerService/ImportEnclaveSnapshot',
+    requestStream: true,
+    responseStream: false,
+    requestType: api_container_service_pb.StreamedDataChunk,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_StreamedDataChunk,
+    requestDeserialize: deserialize_api_container_api_StreamedDataChunk,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Restarts the services whose containers stopped along with the enclave, in the order they were added, and waits for their ready conditions
+resumeServices: {
+    path: '/api_container_api.ApiContainerService/ResumeServices',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.ResumeServicesResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_ResumeServicesResponse,
+    responseDeserialize: deserialize_api_container_api_ResumeServicesResponse,
+  },
+  // Returns the expiry settings of the enclave along with the last time its API was called. Calling it doesn't count as activity
+getEnclaveExpiry: {
+    path: '/api_container_api.ApiContainerService/GetEnclaveExpiry',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.EnclaveExpiry,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_EnclaveExpiry,
+    responseDeserialize: deserialize_api_container_api_EnclaveExpiry,
+  },
+  // Replaces the expiry settings of the enclave, which are persisted in the enclave database
+setEnclaveExpiry: {
+    path: '/api_container_api.ApiContainerService/SetEnclaveExpiry',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.EnclaveExpiry,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_EnclaveExpiry,
+    requestDeserialize: deserialize_api_container_api_EnclaveExpiry,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Returns the resource quotas set at the creation of the enclave along with how much of them is currently used
+getEnclaveResourceQuotas: {
+    path: '/api_container_api.ApiContainerService/GetEnclaveResourceQuotas',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetEnclaveResourceQuotasResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetEnclaveResourceQuotasResponse,
+    responseDeserialize: deserialize_api_container_api_GetEnclaveResourceQuotasResponse,
+  },
+  // Returns the CPU, memory, network and block IO the running services consume, once or, when following, every few
+// seconds until the client cancels
+getServicesStats: {
+    path: '/api_container_api.ApiContainerService/GetServicesStats',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.GetServicesStatsArgs,
+    responseType: api_container_service_pb.GetServicesStatsResponse,
+    requestSerialize: serialize_api_container_api_GetServicesStatsArgs,
+    requestDeserialize: deserialize_api_container_api_GetServicesStatsArgs,
+    responseSerialize: serialize_api_container_api_GetServicesStatsResponse,
+    responseDeserialize: deserialize_api_container_api_GetServicesStatsResponse,
+  },
+  // Lints the given Starlark files statically, without interpreting them, and returns the findings
+lintStarlark: {
+    path: '/api_container_api.ApiContainerService/LintStarlark',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.LintStarlarkArgs,
+    responseType: api_container_service_pb.LintStarlarkResponse,
+    requestSerialize: serialize_api_container_api_LintStarlarkArgs,
+    requestDeserialize: deserialize_api_container_api_LintStarlarkArgs,
+    responseSerialize: serialize_api_container_api_LintStarlarkResponse,
+    responseDeserialize: deserialize_api_container_api_LintStarlarkResponse,
+  },
 };
 
 exports.ApiContainerServiceClient = grpc.makeGenericClientConstructor(ApiContainerServiceService);
@@ -73,6 +73,18 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.StoreFilesArtifactFromServiceResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
 
+  copyFilesArtifactToService(
+    request: api_container_service_pb.CopyFilesArtifactToServiceArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  copyFilesFromService(
+    request: api_container_service_pb.CopyFilesFromServiceArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+
   listFilesArtifactNamesAndUuids(
     request: google_protobuf_empty_pb.Empty,
     metadata: grpcWeb.Metadata | undefined,
@@ -87,6 +99,20 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.InspectFilesArtifactContentsResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.InspectFilesArtifactContentsResponse>;
 
+  removeFilesArtifact(
+    request: api_container_service_pb.RemoveFilesArtifactArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  listFilesArtifactVersions(
+    request: api_container_service_pb.ListFilesArtifactVersionsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.ListFilesArtifactVersionsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ListFilesArtifactVersionsResponse>;
+
   connectServices(
     request: api_container_service_pb.ConnectServicesArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -101,6 +127,51 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.GetStarlarkRunResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.GetStarlarkRunResponse>;
 
+  exportEnclaveSnapshot(
+    request: api_container_service_pb.ExportEnclaveSnapshotArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+
+  resumeServices(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.ResumeServicesResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ResumeServicesResponse>;
+
+  getEnclaveExpiry(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.EnclaveExpiry) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.EnclaveExpiry>;
+
+  setEnclaveExpiry(
+    request: api_container_service_pb.EnclaveExpiry,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  getEnclaveResourceQuotas(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetEnclaveResourceQuotasResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetEnclaveResourceQuotasResponse>;
+
+  getServicesStats(
+    request: api_container_service_pb.GetServicesStatsArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetServicesStatsResponse>;
+
+  lintStarlark(
+    request: api_container_service_pb.LintStarlarkArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.LintStarlarkResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.LintStarlarkResponse>;
+
 }
 
 export class ApiContainerServicePromiseClient {
@@ -158,6 +229,16 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
 
+  copyFilesArtifactToService(
+    request: api_container_service_pb.CopyFilesArtifactToServiceArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  copyFilesFromService(
+    request: api_container_service_pb.CopyFilesFromServiceArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+
   listFilesArtifactNamesAndUuids(
     request: google_protobuf_empty_pb.Empty,
     metadata?: grpcWeb.Metadata
@@ -168,6 +249,16 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.InspectFilesArtifactContentsResponse>;
 
+  removeFilesArtifact(
+    request: api_container_service_pb.RemoveFilesArtifactArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  listFilesArtifactVersions(
+    request: api_container_service_pb.ListFilesArtifactVersionsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.ListFilesArtifactVersionsResponse>;
+
   connectServices(
     request: api_container_service_pb.ConnectServicesArgs,
     metadata?: grpcWeb.Metadata
@@ -178,5 +269,40 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.GetStarlarkRunResponse>;
 
+  exportEnclaveSnapshot(
+    request: api_container_service_pb.ExportEnclaveSnapshotArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+
+  resumeServices(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.ResumeServicesResponse>;
+
+  getEnclaveExpiry(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.EnclaveExpiry>;
+
+  setEnclaveExpiry(
+    request: api_container_service_pb.EnclaveExpiry,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  getEnclaveResourceQuotas(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetEnclaveResourceQuotasResponse>;
+
+  getServicesStats(
+    request: api_container_service_pb.GetServicesStatsArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetServicesStatsResponse>;
+
+  lintStarlark(
+    request: api_container_service_pb.LintStarlarkArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.LintStarlarkResponse>;
+
 }
 
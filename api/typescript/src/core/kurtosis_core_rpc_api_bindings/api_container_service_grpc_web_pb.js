@@ -21,6 +21,8 @@ grpc.web = require('grpc-web');
 
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.api_container_api = require('./api_container_service_pb.js');
 
@@ -671,6 +673,123 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.storeFilesArt
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.CopyFilesArtifactToServiceArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_CopyFilesArtifactToService = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/CopyFilesArtifactToService',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.CopyFilesArtifactToServiceArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.CopyFilesArtifactToServiceArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.CopyFilesArtifactToServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.copyFilesArtifactToService =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/CopyFilesArtifactToService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CopyFilesArtifactToService,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.CopyFilesArtifactToServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.copyFilesArtifactToService =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/CopyFilesArtifactToService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CopyFilesArtifactToService);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.CopyFilesFromServiceArgs,
+ *   !proto.api_container_api.StreamedDataChunk>}
+ */
+const methodDescriptor_ApiContainerService_CopyFilesFromService = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/CopyFilesFromService',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.CopyFilesFromServiceArgs,
+  proto.api_container_api.StreamedDataChunk,
+  /**
+   * @param {!proto.api_container_api.CopyFilesFromServiceArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StreamedDataChunk.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.CopyFilesFromServiceArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StreamedDataChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.copyFilesFromService =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/CopyFilesFromService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CopyFilesFromService);
+};
+
+
+/**
+ * @param {!proto.api_container_api.CopyFilesFromServiceArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StreamedDataChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.copyFilesFromService =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/CopyFilesFromService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CopyFilesFromService);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -793,6 +912,128 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.inspectFilesA
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.RemoveFilesArtifactArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_RemoveFilesArtifact = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RemoveFilesArtifact',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.RemoveFilesArtifactArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.RemoveFilesArtifactArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.RemoveFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.removeFilesArtifact =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RemoveFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RemoveFilesArtifact,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.RemoveFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.removeFilesArtifact =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RemoveFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RemoveFilesArtifact);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.ListFilesArtifactVersionsArgs,
+ *   !proto.api_container_api.ListFilesArtifactVersionsResponse>}
+ */
+const methodDescriptor_ApiContainerService_ListFilesArtifactVersions = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/ListFilesArtifactVersions',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.ListFilesArtifactVersionsArgs,
+  proto.api_container_api.ListFilesArtifactVersionsResponse,
+  /**
+   * @param {!proto.api_container_api.ListFilesArtifactVersionsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ListFilesArtifactVersionsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.ListFilesArtifactVersionsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.ListFilesArtifactVersionsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ListFilesArtifactVersionsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.listFilesArtifactVersions =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ListFilesArtifactVersions',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ListFilesArtifactVersions,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ListFilesArtifactVersionsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.ListFilesArtifactVersionsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.listFilesArtifactVersions =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ListFilesArtifactVersions',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ListFilesArtifactVersions);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -915,5 +1156,422 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.getStarlarkRu
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.ExportEnclaveSnapshotArgs,
+ *   !proto.api_container_api.StreamedDataChunk>}
+ */
+const methodDescriptor_ApiContainerService_ExportEnclaveSnapshot = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/ExportEnclaveSnapshot',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.ExportEnclaveSnapshotArgs,
+  proto.api_container_api.StreamedDataChunk,
+  /**
+   * @param {!proto.api_container_api.ExportEnclaveSnapshotArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StreamedDataChunk.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.ExportEnclaveSnapshotArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StreamedDataChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.exportEnclaveSnapshot =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/ExportEnclaveSnapshot',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ExportEnclaveSnapshot);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ExportEnclaveSnapshotArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StreamedDataChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.exportEnclaveSnapshot =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/ExportEnclaveSnapshot',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ExportEnclaveSnapshot);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.ResumeServicesResponse>}
+ */
+const methodDescriptor_ApiContainerService_ResumeServices = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/ResumeServices',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.ResumeServicesResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ResumeServicesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.ResumeServicesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ResumeServicesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.resumeServices =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ResumeServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ResumeServices,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.ResumeServicesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.resumeServices =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ResumeServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ResumeServices);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.EnclaveExpiry>}
+ */
+const methodDescriptor_ApiContainerService_GetEnclaveExpiry = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetEnclaveExpiry',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.EnclaveExpiry,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.EnclaveExpiry.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.EnclaveExpiry)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.EnclaveExpiry>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getEnclaveExpiry =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveExpiry',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveExpiry,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.EnclaveExpiry>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getEnclaveExpiry =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveExpiry',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveExpiry);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.EnclaveExpiry,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_SetEnclaveExpiry = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/SetEnclaveExpiry',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.EnclaveExpiry,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.EnclaveExpiry} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.EnclaveExpiry} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.setEnclaveExpiry =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/SetEnclaveExpiry',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_SetEnclaveExpiry,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.EnclaveExpiry} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.setEnclaveExpiry =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/SetEnclaveExpiry',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_SetEnclaveExpiry);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetEnclaveResourceQuotasResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetEnclaveResourceQuotas = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetEnclaveResourceQuotas',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetEnclaveResourceQuotasResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetEnclaveResourceQuotasResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetEnclaveResourceQuotasResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetEnclaveResourceQuotasResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getEnclaveResourceQuotas =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveResourceQuotas',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveResourceQuotas,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetEnclaveResourceQuotasResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getEnclaveResourceQuotas =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveResourceQuotas',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveResourceQuotas);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetServicesStatsArgs,
+ *   !proto.api_container_api.GetServicesStatsResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetServicesStats = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetServicesStats',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.GetServicesStatsArgs,
+  proto.api_container_api.GetServicesStatsResponse,
+  /**
+   * @param {!proto.api_container_api.GetServicesStatsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetServicesStatsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetServicesStatsArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetServicesStatsResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getServicesStats =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetServicesStats',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetServicesStats);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetServicesStatsArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetServicesStatsResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getServicesStats =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetServicesStats',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetServicesStats);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.LintStarlarkArgs,
+ *   !proto.api_container_api.LintStarlarkResponse>}
+ */
+const methodDescriptor_ApiContainerService_LintStarlark = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/LintStarlark',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.LintStarlarkArgs,
+  proto.api_container_api.LintStarlarkResponse,
+  /**
+   * @param {!proto.api_container_api.LintStarlarkArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.LintStarlarkResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.LintStarlarkArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.LintStarlarkResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.LintStarlarkResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.lintStarlark =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/LintStarlark',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_LintStarlark,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.LintStarlarkArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.LintStarlarkResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.lintStarlark =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/LintStarlark',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_LintStarlark);
+};
+
+
 module.exports = proto.api_container_api;
 
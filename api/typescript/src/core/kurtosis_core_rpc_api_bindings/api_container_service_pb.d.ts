@@ -1,6 +1,7 @@
 import * as jspb from 'google-protobuf'
 
 import * as google_protobuf_empty_pb from 'google-protobuf/google/protobuf/empty_pb';
+import * as google_protobuf_timestamp_pb from 'google-protobuf/google/protobuf/timestamp_pb';
 
 
 export class Port extends jspb.Message {
@@ -113,6 +114,12 @@ export class ServiceInfo extends jspb.Message {
   hasContainer(): boolean;
   clearContainer(): ServiceInfo;
 
+  getHealthStatus(): ServiceHealthStatus;
+  setHealthStatus(value: ServiceHealthStatus): ServiceInfo;
+
+  getRestartCount(): number;
+  setRestartCount(value: number): ServiceInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ServiceInfo.AsObject;
   static toObject(includeInstance: boolean, msg: ServiceInfo): ServiceInfo.AsObject;
@@ -132,6 +139,8 @@ export namespace ServiceInfo {
     shortenedUuid: string,
     serviceStatus: ServiceStatus,
     container?: Container.AsObject,
+    healthStatus: ServiceHealthStatus,
+    restartCount: number,
   }
 }
 
@@ -175,6 +184,16 @@ export class RunStarlarkScriptArgs extends jspb.Message {
   hasImageDownloadMode(): boolean;
   clearImageDownloadMode(): RunStarlarkScriptArgs;
 
+  getGitCredentialsList(): Array<GitCredential>;
+  setGitCredentialsList(value: Array<GitCredential>): RunStarlarkScriptArgs;
+  clearGitCredentialsList(): RunStarlarkScriptArgs;
+  addGitCredentials(value?: GitCredential, index?: number): GitCredential;
+
+  getDiff(): boolean;
+  setDiff(value: boolean): RunStarlarkScriptArgs;
+  hasDiff(): boolean;
+  clearDiff(): RunStarlarkScriptArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): RunStarlarkScriptArgs.AsObject;
   static toObject(includeInstance: boolean, msg: RunStarlarkScriptArgs): RunStarlarkScriptArgs.AsObject;
@@ -194,6 +213,8 @@ export namespace RunStarlarkScriptArgs {
     cloudInstanceId?: string,
     cloudUserId?: string,
     imageDownloadMode?: ImageDownloadMode,
+    gitCredentialsList: Array<GitCredential.AsObject>,
+    diff?: boolean,
   }
 
   export enum DryRunCase { 
@@ -220,6 +241,11 @@ export namespace RunStarlarkScriptArgs {
     _IMAGE_DOWNLOAD_MODE_NOT_SET = 0,
     IMAGE_DOWNLOAD_MODE = 9,
   }
+
+  export enum DiffCase { 
+    _DIFF_NOT_SET = 0,
+    DIFF = 11,
+  }
 }
 
 export class RunStarlarkPackageArgs extends jspb.Message {
@@ -278,6 +304,21 @@ export class RunStarlarkPackageArgs extends jspb.Message {
   hasImageDownloadMode(): boolean;
   clearImageDownloadMode(): RunStarlarkPackageArgs;
 
+  getGitCredentialsList(): Array<GitCredential>;
+  setGitCredentialsList(value: Array<GitCredential>): RunStarlarkPackageArgs;
+  clearGitCredentialsList(): RunStarlarkPackageArgs;
+  addGitCredentials(value?: GitCredential, index?: number): GitCredential;
+
+  getPackageLockMode(): PackageLockMode;
+  setPackageLockMode(value: PackageLockMode): RunStarlarkPackageArgs;
+  hasPackageLockMode(): boolean;
+  clearPackageLockMode(): RunStarlarkPackageArgs;
+
+  getDiff(): boolean;
+  setDiff(value: boolean): RunStarlarkPackageArgs;
+  hasDiff(): boolean;
+  clearDiff(): RunStarlarkPackageArgs;
+
   getStarlarkPackageContentCase(): RunStarlarkPackageArgs.StarlarkPackageContentCase;
 
   serializeBinary(): Uint8Array;
@@ -303,6 +344,9 @@ export namespace RunStarlarkPackageArgs {
     cloudInstanceId?: string,
     cloudUserId?: string,
     imageDownloadMode?: ImageDownloadMode,
+    gitCredentialsList: Array<GitCredential.AsObject>,
+    packageLockMode?: PackageLockMode,
+    diff?: boolean,
   }
 
   export enum StarlarkPackageContentCase { 
@@ -340,6 +384,120 @@ export namespace RunStarlarkPackageArgs {
     _IMAGE_DOWNLOAD_MODE_NOT_SET = 0,
     IMAGE_DOWNLOAD_MODE = 14,
   }
+
+  export enum PackageLockModeCase { 
+    _PACKAGE_LOCK_MODE_NOT_SET = 0,
+    PACKAGE_LOCK_MODE = 16,
+  }
+
+  export enum DiffCase { 
+    _DIFF_NOT_SET = 0,
+    DIFF = 17,
+  }
+}
+
+export class GitCredential extends jspb.Message {
+  getHost(): string;
+  setHost(value: string): GitCredential;
+
+  getHttpsToken(): HttpsTokenGitCredential | undefined;
+  setHttpsToken(value?: HttpsTokenGitCredential): GitCredential;
+  hasHttpsToken(): boolean;
+  clearHttpsToken(): GitCredential;
+
+  getSshKey(): SshKeyGitCredential | undefined;
+  setSshKey(value?: SshKeyGitCredential): GitCredential;
+  hasSshKey(): boolean;
+  clearSshKey(): GitCredential;
+
+  getCredentialCase(): GitCredential.CredentialCase;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GitCredential.AsObject;
+  static toObject(includeInstance: boolean, msg: GitCredential): GitCredential.AsObject;
+  static serializeBinaryToWriter(message: GitCredential, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GitCredential;
+  static deserializeBinaryFromReader(message: GitCredential, reader: jspb.BinaryReader): GitCredential;
+}
+
+export namespace GitCredential {
+  export type AsObject = {
+    host: string,
+    httpsToken?: HttpsTokenGitCredential.AsObject,
+    sshKey?: SshKeyGitCredential.AsObject,
+  }
+
+  export enum CredentialCase { 
+    CREDENTIAL_NOT_SET = 0,
+    HTTPS_TOKEN = 2,
+    SSH_KEY = 3,
+  }
+}
+
+export class HttpsTokenGitCredential extends jspb.Message {
+  getUsername(): string;
+  setUsername(value: string): HttpsTokenGitCredential;
+  hasUsername(): boolean;
+  clearUsername(): HttpsTokenGitCredential;
+
+  getToken(): string;
+  setToken(value: string): HttpsTokenGitCredential;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HttpsTokenGitCredential.AsObject;
+  static toObject(includeInstance: boolean, msg: HttpsTokenGitCredential): HttpsTokenGitCredential.AsObject;
+  static serializeBinaryToWriter(message: HttpsTokenGitCredential, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HttpsTokenGitCredential;
+  static deserializeBinaryFromReader(message: HttpsTokenGitCredential, reader: jspb.BinaryReader): HttpsTokenGitCredential;
+}
+
+export namespace HttpsTokenGitCredential {
+  export type AsObject = {
+    username?: string,
+    token: string,
+  }
+
+  export enum UsernameCase { 
+    _USERNAME_NOT_SET = 0,
+    USERNAME = 1,
+  }
+}
+
+export class SshKeyGitCredential extends jspb.Message {
+  getPrivateKey(): Uint8Array | string;
+  getPrivateKey_asU8(): Uint8Array;
+  getPrivateKey_asB64(): string;
+  setPrivateKey(value: Uint8Array | string): SshKeyGitCredential;
+
+  getPassphrase(): string;
+  setPassphrase(value: string): SshKeyGitCredential;
+  hasPassphrase(): boolean;
+  clearPassphrase(): SshKeyGitCredential;
+
+  getKnownHosts(): Uint8Array | string;
+  getKnownHosts_asU8(): Uint8Array;
+  getKnownHosts_asB64(): string;
+  setKnownHosts(value: Uint8Array | string): SshKeyGitCredential;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SshKeyGitCredential.AsObject;
+  static toObject(includeInstance: boolean, msg: SshKeyGitCredential): SshKeyGitCredential.AsObject;
+  static serializeBinaryToWriter(message: SshKeyGitCredential, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SshKeyGitCredential;
+  static deserializeBinaryFromReader(message: SshKeyGitCredential, reader: jspb.BinaryReader): SshKeyGitCredential;
+}
+
+export namespace SshKeyGitCredential {
+  export type AsObject = {
+    privateKey: Uint8Array | string,
+    passphrase?: string,
+    knownHosts: Uint8Array | string,
+  }
+
+  export enum PassphraseCase { 
+    _PASSPHRASE_NOT_SET = 0,
+    PASSPHRASE = 2,
+  }
 }
 
 export class StarlarkRunResponseLine extends jspb.Message {
@@ -378,6 +536,11 @@ export class StarlarkRunResponseLine extends jspb.Message {
   hasInfo(): boolean;
   clearInfo(): StarlarkRunResponseLine;
 
+  getPlanDiff(): StarlarkPlanDiff | undefined;
+  setPlanDiff(value?: StarlarkPlanDiff): StarlarkRunResponseLine;
+  hasPlanDiff(): boolean;
+  clearPlanDiff(): StarlarkRunResponseLine;
+
   getRunResponseLineCase(): StarlarkRunResponseLine.RunResponseLineCase;
 
   serializeBinary(): Uint8Array;
@@ -397,6 +560,7 @@ export namespace StarlarkRunResponseLine {
     runFinishedEvent?: StarlarkRunFinishedEvent.AsObject,
     warning?: StarlarkWarning.AsObject,
     info?: StarlarkInfo.AsObject,
+    planDiff?: StarlarkPlanDiff.AsObject,
   }
 
   export enum RunResponseLineCase { 
@@ -408,6 +572,7 @@ export namespace StarlarkRunResponseLine {
     RUN_FINISHED_EVENT = 5,
     WARNING = 6,
     INFO = 7,
+    PLAN_DIFF = 8,
   }
 }
 
@@ -694,6 +859,11 @@ export class StarlarkRunFinishedEvent extends jspb.Message {
   hasSerializedOutput(): boolean;
   clearSerializedOutput(): StarlarkRunFinishedEvent;
 
+  getResolvedPackageDependenciesList(): Array<PackageDependency>;
+  setResolvedPackageDependenciesList(value: Array<PackageDependency>): StarlarkRunFinishedEvent;
+  clearResolvedPackageDependenciesList(): StarlarkRunFinishedEvent;
+  addResolvedPackageDependencies(value?: PackageDependency, index?: number): PackageDependency;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): StarlarkRunFinishedEvent.AsObject;
   static toObject(includeInstance: boolean, msg: StarlarkRunFinishedEvent): StarlarkRunFinishedEvent.AsObject;
@@ -706,6 +876,7 @@ export namespace StarlarkRunFinishedEvent {
   export type AsObject = {
     isRunSuccessful: boolean,
     serializedOutput?: string,
+    resolvedPackageDependenciesList: Array<PackageDependency.AsObject>,
   }
 
   export enum SerializedOutputCase { 
@@ -714,6 +885,88 @@ export namespace StarlarkRunFinishedEvent {
   }
 }
 
+export class StarlarkPlanDiff extends jspb.Message {
+  getServiceChangesList(): Array<StarlarkPlanDiffComponentChange>;
+  setServiceChangesList(value: Array<StarlarkPlanDiffComponentChange>): StarlarkPlanDiff;
+  clearServiceChangesList(): StarlarkPlanDiff;
+  addServiceChanges(value?: StarlarkPlanDiffComponentChange, index?: number): StarlarkPlanDiffComponentChange;
+
+  getFilesArtifactChangesList(): Array<StarlarkPlanDiffComponentChange>;
+  setFilesArtifactChangesList(value: Array<StarlarkPlanDiffComponentChange>): StarlarkPlanDiff;
+  clearFilesArtifactChangesList(): StarlarkPlanDiff;
+  addFilesArtifactChanges(value?: StarlarkPlanDiffComponentChange, index?: number): StarlarkPlanDiffComponentChange;
+
+  getInstructionsToExecuteList(): Array<StarlarkInstruction>;
+  setInstructionsToExecuteList(value: Array<StarlarkInstruction>): StarlarkPlanDiff;
+  clearInstructionsToExecuteList(): StarlarkPlanDiff;
+  addInstructionsToExecute(value?: StarlarkInstruction, index?: number): StarlarkInstruction;
+
+  getSkippedInstructionsList(): Array<StarlarkInstruction>;
+  setSkippedInstructionsList(value: Array<StarlarkInstruction>): StarlarkPlanDiff;
+  clearSkippedInstructionsList(): StarlarkPlanDiff;
+  addSkippedInstructions(value?: StarlarkInstruction, index?: number): StarlarkInstruction;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkPlanDiff.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkPlanDiff): StarlarkPlanDiff.AsObject;
+  static serializeBinaryToWriter(message: StarlarkPlanDiff, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkPlanDiff;
+  static deserializeBinaryFromReader(message: StarlarkPlanDiff, reader: jspb.BinaryReader): StarlarkPlanDiff;
+}
+
+export namespace StarlarkPlanDiff {
+  export type AsObject = {
+    serviceChangesList: Array<StarlarkPlanDiffComponentChange.AsObject>,
+    filesArtifactChangesList: Array<StarlarkPlanDiffComponentChange.AsObject>,
+    instructionsToExecuteList: Array<StarlarkInstruction.AsObject>,
+    skippedInstructionsList: Array<StarlarkInstruction.AsObject>,
+  }
+}
+
+export class StarlarkPlanDiffComponentChange extends jspb.Message {
+  getName(): string;
+  setName(value: string): StarlarkPlanDiffComponentChange;
+
+  getChangeType(): StarlarkPlanDiffChangeType;
+  setChangeType(value: StarlarkPlanDiffChangeType): StarlarkPlanDiffComponentChange;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkPlanDiffComponentChange.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkPlanDiffComponentChange): StarlarkPlanDiffComponentChange.AsObject;
+  static serializeBinaryToWriter(message: StarlarkPlanDiffComponentChange, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkPlanDiffComponentChange;
+  static deserializeBinaryFromReader(message: StarlarkPlanDiffComponentChange, reader: jspb.BinaryReader): StarlarkPlanDiffComponentChange;
+}
+
+export namespace StarlarkPlanDiffComponentChange {
+  export type AsObject = {
+    name: string,
+    changeType: StarlarkPlanDiffChangeType,
+  }
+}
+
+export class PackageDependency extends jspb.Message {
+  getRepository(): string;
+  setRepository(value: string): PackageDependency;
+
+  getCommit(): string;
+  setCommit(value: string): PackageDependency;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PackageDependency.AsObject;
+  static toObject(includeInstance: boolean, msg: PackageDependency): PackageDependency.AsObject;
+  static serializeBinaryToWriter(message: PackageDependency, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PackageDependency;
+  static deserializeBinaryFromReader(message: PackageDependency, reader: jspb.BinaryReader): PackageDependency;
+}
+
+export namespace PackageDependency {
+  export type AsObject = {
+    repository: string,
+    commit: string,
+  }
+}
+
 export class GetServicesArgs extends jspb.Message {
   getServiceIdentifiersMap(): jspb.Map<string, boolean>;
   clearServiceIdentifiersMap(): GetServicesArgs;
@@ -1004,6 +1257,11 @@ export class DownloadFilesArtifactArgs extends jspb.Message {
   getIdentifier(): string;
   setIdentifier(value: string): DownloadFilesArtifactArgs;
 
+  getVersion(): number;
+  setVersion(value: number): DownloadFilesArtifactArgs;
+  hasVersion(): boolean;
+  clearVersion(): DownloadFilesArtifactArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): DownloadFilesArtifactArgs.AsObject;
   static toObject(includeInstance: boolean, msg: DownloadFilesArtifactArgs): DownloadFilesArtifactArgs.AsObject;
@@ -1015,6 +1273,12 @@ export class DownloadFilesArtifactArgs extends jspb.Message {
 export namespace DownloadFilesArtifactArgs {
   export type AsObject = {
     identifier: string,
+    version?: number,
+  }
+
+  export enum VersionCase { 
+    _VERSION_NOT_SET = 0,
+    VERSION = 2,
   }
 }
 
@@ -1102,6 +1366,82 @@ export namespace StoreFilesArtifactFromServiceResponse {
   }
 }
 
+export class CopyFilesToServiceChunk extends jspb.Message {
+  getChunk(): StreamedDataChunk | undefined;
+  setChunk(value?: StreamedDataChunk): CopyFilesToServiceChunk;
+  hasChunk(): boolean;
+  clearChunk(): CopyFilesToServiceChunk;
+
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): CopyFilesToServiceChunk;
+
+  getDestinationPath(): string;
+  setDestinationPath(value: string): CopyFilesToServiceChunk;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CopyFilesToServiceChunk.AsObject;
+  static toObject(includeInstance: boolean, msg: CopyFilesToServiceChunk): CopyFilesToServiceChunk.AsObject;
+  static serializeBinaryToWriter(message: CopyFilesToServiceChunk, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CopyFilesToServiceChunk;
+  static deserializeBinaryFromReader(message: CopyFilesToServiceChunk, reader: jspb.BinaryReader): CopyFilesToServiceChunk;
+}
+
+export namespace CopyFilesToServiceChunk {
+  export type AsObject = {
+    chunk?: StreamedDataChunk.AsObject,
+    serviceIdentifier: string,
+    destinationPath: string,
+  }
+}
+
+export class CopyFilesArtifactToServiceArgs extends jspb.Message {
+  getFilesArtifactIdentifier(): string;
+  setFilesArtifactIdentifier(value: string): CopyFilesArtifactToServiceArgs;
+
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): CopyFilesArtifactToServiceArgs;
+
+  getDestinationPath(): string;
+  setDestinationPath(value: string): CopyFilesArtifactToServiceArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CopyFilesArtifactToServiceArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: CopyFilesArtifactToServiceArgs): CopyFilesArtifactToServiceArgs.AsObject;
+  static serializeBinaryToWriter(message: CopyFilesArtifactToServiceArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CopyFilesArtifactToServiceArgs;
+  static deserializeBinaryFromReader(message: CopyFilesArtifactToServiceArgs, reader: jspb.BinaryReader): CopyFilesArtifactToServiceArgs;
+}
+
+export namespace CopyFilesArtifactToServiceArgs {
+  export type AsObject = {
+    filesArtifactIdentifier: string,
+    serviceIdentifier: string,
+    destinationPath: string,
+  }
+}
+
+export class CopyFilesFromServiceArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): CopyFilesFromServiceArgs;
+
+  getSourcePath(): string;
+  setSourcePath(value: string): CopyFilesFromServiceArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CopyFilesFromServiceArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: CopyFilesFromServiceArgs): CopyFilesFromServiceArgs.AsObject;
+  static serializeBinaryToWriter(message: CopyFilesFromServiceArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CopyFilesFromServiceArgs;
+  static deserializeBinaryFromReader(message: CopyFilesFromServiceArgs, reader: jspb.BinaryReader): CopyFilesFromServiceArgs;
+}
+
+export namespace CopyFilesFromServiceArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+    sourcePath: string,
+  }
+}
+
 export class FilesArtifactNameAndUuid extends jspb.Message {
   getFilename(): string;
   setFilename(value: string): FilesArtifactNameAndUuid;
@@ -1109,6 +1449,9 @@ export class FilesArtifactNameAndUuid extends jspb.Message {
   getFileuuid(): string;
   setFileuuid(value: string): FilesArtifactNameAndUuid;
 
+  getSize(): number;
+  setSize(value: number): FilesArtifactNameAndUuid;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): FilesArtifactNameAndUuid.AsObject;
   static toObject(includeInstance: boolean, msg: FilesArtifactNameAndUuid): FilesArtifactNameAndUuid.AsObject;
@@ -1121,6 +1464,7 @@ export namespace FilesArtifactNameAndUuid {
   export type AsObject = {
     filename: string,
     fileuuid: string,
+    size: number,
   }
 }
 
@@ -1130,6 +1474,14 @@ export class ListFilesArtifactNamesAndUuidsResponse extends jspb.Message {
   clearFileNamesAndUuidsList(): ListFilesArtifactNamesAndUuidsResponse;
   addFileNamesAndUuids(value?: FilesArtifactNameAndUuid, index?: number): FilesArtifactNameAndUuid;
 
+  getTotalSize(): number;
+  setTotalSize(value: number): ListFilesArtifactNamesAndUuidsResponse;
+
+  getStorageQuota(): number;
+  setStorageQuota(value: number): ListFilesArtifactNamesAndUuidsResponse;
+  hasStorageQuota(): boolean;
+  clearStorageQuota(): ListFilesArtifactNamesAndUuidsResponse;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ListFilesArtifactNamesAndUuidsResponse.AsObject;
   static toObject(includeInstance: boolean, msg: ListFilesArtifactNamesAndUuidsResponse): ListFilesArtifactNamesAndUuidsResponse.AsObject;
@@ -1141,6 +1493,101 @@ export class ListFilesArtifactNamesAndUuidsResponse extends jspb.Message {
 export namespace ListFilesArtifactNamesAndUuidsResponse {
   export type AsObject = {
     fileNamesAndUuidsList: Array<FilesArtifactNameAndUuid.AsObject>,
+    totalSize: number,
+    storageQuota?: number,
+  }
+
+  export enum StorageQuotaCase { 
+    _STORAGE_QUOTA_NOT_SET = 0,
+    STORAGE_QUOTA = 3,
+  }
+}
+
+export class RemoveFilesArtifactArgs extends jspb.Message {
+  getIdentifier(): string;
+  setIdentifier(value: string): RemoveFilesArtifactArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveFilesArtifactArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveFilesArtifactArgs): RemoveFilesArtifactArgs.AsObject;
+  static serializeBinaryToWriter(message: RemoveFilesArtifactArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveFilesArtifactArgs;
+  static deserializeBinaryFromReader(message: RemoveFilesArtifactArgs, reader: jspb.BinaryReader): RemoveFilesArtifactArgs;
+}
+
+export namespace RemoveFilesArtifactArgs {
+  export type AsObject = {
+    identifier: string,
+  }
+}
+
+export class ListFilesArtifactVersionsArgs extends jspb.Message {
+  getIdentifier(): string;
+  setIdentifier(value: string): ListFilesArtifactVersionsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListFilesArtifactVersionsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ListFilesArtifactVersionsArgs): ListFilesArtifactVersionsArgs.AsObject;
+  static serializeBinaryToWriter(message: ListFilesArtifactVersionsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListFilesArtifactVersionsArgs;
+  static deserializeBinaryFromReader(message: ListFilesArtifactVersionsArgs, reader: jspb.BinaryReader): ListFilesArtifactVersionsArgs;
+}
+
+export namespace ListFilesArtifactVersionsArgs {
+  export type AsObject = {
+    identifier: string,
+  }
+}
+
+export class ListFilesArtifactVersionsResponse extends jspb.Message {
+  getVersionsList(): Array<FilesArtifactVersion>;
+  setVersionsList(value: Array<FilesArtifactVersion>): ListFilesArtifactVersionsResponse;
+  clearVersionsList(): ListFilesArtifactVersionsResponse;
+  addVersions(value?: FilesArtifactVersion, index?: number): FilesArtifactVersion;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListFilesArtifactVersionsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ListFilesArtifactVersionsResponse): ListFilesArtifactVersionsResponse.AsObject;
+  static serializeBinaryToWriter(message: ListFilesArtifactVersionsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListFilesArtifactVersionsResponse;
+  static deserializeBinaryFromReader(message: ListFilesArtifactVersionsResponse, reader: jspb.BinaryReader): ListFilesArtifactVersionsResponse;
+}
+
+export namespace ListFilesArtifactVersionsResponse {
+  export type AsObject = {
+    versionsList: Array<FilesArtifactVersion.AsObject>,
+  }
+}
+
+export class FilesArtifactVersion extends jspb.Message {
+  getNumber(): number;
+  setNumber(value: number): FilesArtifactVersion;
+
+  getContentMd5(): string;
+  setContentMd5(value: string): FilesArtifactVersion;
+
+  getCreationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreationTime(value?: google_protobuf_timestamp_pb.Timestamp): FilesArtifactVersion;
+  hasCreationTime(): boolean;
+  clearCreationTime(): FilesArtifactVersion;
+
+  getIsCurrent(): boolean;
+  setIsCurrent(value: boolean): FilesArtifactVersion;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FilesArtifactVersion.AsObject;
+  static toObject(includeInstance: boolean, msg: FilesArtifactVersion): FilesArtifactVersion.AsObject;
+  static serializeBinaryToWriter(message: FilesArtifactVersion, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FilesArtifactVersion;
+  static deserializeBinaryFromReader(message: FilesArtifactVersion, reader: jspb.BinaryReader): FilesArtifactVersion;
+}
+
+export namespace FilesArtifactVersion {
+  export type AsObject = {
+    number: number,
+    contentMd5: string,
+    creationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    isCurrent: boolean,
   }
 }
 
@@ -1150,6 +1597,11 @@ export class InspectFilesArtifactContentsRequest extends jspb.Message {
   hasFileNamesAndUuid(): boolean;
   clearFileNamesAndUuid(): InspectFilesArtifactContentsRequest;
 
+  getVersion(): number;
+  setVersion(value: number): InspectFilesArtifactContentsRequest;
+  hasVersion(): boolean;
+  clearVersion(): InspectFilesArtifactContentsRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): InspectFilesArtifactContentsRequest.AsObject;
   static toObject(includeInstance: boolean, msg: InspectFilesArtifactContentsRequest): InspectFilesArtifactContentsRequest.AsObject;
@@ -1161,6 +1613,12 @@ export class InspectFilesArtifactContentsRequest extends jspb.Message {
 export namespace InspectFilesArtifactContentsRequest {
   export type AsObject = {
     fileNamesAndUuid?: FilesArtifactNameAndUuid.AsObject,
+    version?: number,
+  }
+
+  export enum VersionCase { 
+    _VERSION_NOT_SET = 0,
+    VERSION = 2,
   }
 }
 
@@ -1297,11 +1755,393 @@ export namespace GetStarlarkRunResponse {
   }
 }
 
+export class ExportEnclaveSnapshotArgs extends jspb.Message {
+  getIncludePersistentDirectories(): boolean;
+  setIncludePersistentDirectories(value: boolean): ExportEnclaveSnapshotArgs;
+
+  getIncludeContainerFilesystems(): boolean;
+  setIncludeContainerFilesystems(value: boolean): ExportEnclaveSnapshotArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExportEnclaveSnapshotArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ExportEnclaveSnapshotArgs): ExportEnclaveSnapshotArgs.AsObject;
+  static serializeBinaryToWriter(message: ExportEnclaveSnapshotArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExportEnclaveSnapshotArgs;
+  static deserializeBinaryFromReader(message: ExportEnclaveSnapshotArgs, reader: jspb.BinaryReader): ExportEnclaveSnapshotArgs;
+}
+
+export namespace ExportEnclaveSnapshotArgs {
+  export type AsObject = {
+    includePersistentDirectories: boolean,
+    includeContainerFilesystems: boolean,
+  }
+}
+
+export class ResumeServicesResponse extends jspb.Message {
+  getResumedServiceNamesList(): Array<string>;
+  setResumedServiceNamesList(value: Array<string>): ResumeServicesResponse;
+  clearResumedServiceNamesList(): ResumeServicesResponse;
+  addResumedServiceNames(value: string, index?: number): ResumeServicesResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResumeServicesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ResumeServicesResponse): ResumeServicesResponse.AsObject;
+  static serializeBinaryToWriter(message: ResumeServicesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResumeServicesResponse;
+  static deserializeBinaryFromReader(message: ResumeServicesResponse, reader: jspb.BinaryReader): ResumeServicesResponse;
+}
+
+export namespace ResumeServicesResponse {
+  export type AsObject = {
+    resumedServiceNamesList: Array<string>,
+  }
+}
+
+export class EnclaveExpiry extends jspb.Message {
+  getExpirationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpirationTime(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveExpiry;
+  hasExpirationTime(): boolean;
+  clearExpirationTime(): EnclaveExpiry;
+
+  getIdleTimeoutSeconds(): number;
+  setIdleTimeoutSeconds(value: number): EnclaveExpiry;
+
+  getShouldDestroy(): boolean;
+  setShouldDestroy(value: boolean): EnclaveExpiry;
+
+  getLastActivityTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setLastActivityTime(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveExpiry;
+  hasLastActivityTime(): boolean;
+  clearLastActivityTime(): EnclaveExpiry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveExpiry.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveExpiry): EnclaveExpiry.AsObject;
+  static serializeBinaryToWriter(message: EnclaveExpiry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveExpiry;
+  static deserializeBinaryFromReader(message: EnclaveExpiry, reader: jspb.BinaryReader): EnclaveExpiry;
+}
+
+export namespace EnclaveExpiry {
+  export type AsObject = {
+    expirationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    idleTimeoutSeconds: number,
+    shouldDestroy: boolean,
+    lastActivityTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+
+  export enum ExpirationTimeCase { 
+    _EXPIRATION_TIME_NOT_SET = 0,
+    EXPIRATION_TIME = 1,
+  }
+}
+
+export class ResourceQuota extends jspb.Message {
+  getUsage(): number;
+  setUsage(value: number): ResourceQuota;
+
+  getQuota(): number;
+  setQuota(value: number): ResourceQuota;
+  hasQuota(): boolean;
+  clearQuota(): ResourceQuota;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResourceQuota.AsObject;
+  static toObject(includeInstance: boolean, msg: ResourceQuota): ResourceQuota.AsObject;
+  static serializeBinaryToWriter(message: ResourceQuota, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResourceQuota;
+  static deserializeBinaryFromReader(message: ResourceQuota, reader: jspb.BinaryReader): ResourceQuota;
+}
+
+export namespace ResourceQuota {
+  export type AsObject = {
+    usage: number,
+    quota?: number,
+  }
+
+  export enum QuotaCase { 
+    _QUOTA_NOT_SET = 0,
+    QUOTA = 2,
+  }
+}
+
+export class GetEnclaveResourceQuotasResponse extends jspb.Message {
+  getCpuMillicpus(): ResourceQuota | undefined;
+  setCpuMillicpus(value?: ResourceQuota): GetEnclaveResourceQuotasResponse;
+  hasCpuMillicpus(): boolean;
+  clearCpuMillicpus(): GetEnclaveResourceQuotasResponse;
+
+  getMemoryMegabytes(): ResourceQuota | undefined;
+  setMemoryMegabytes(value?: ResourceQuota): GetEnclaveResourceQuotasResponse;
+  hasMemoryMegabytes(): boolean;
+  clearMemoryMegabytes(): GetEnclaveResourceQuotasResponse;
+
+  getServiceCount(): ResourceQuota | undefined;
+  setServiceCount(value?: ResourceQuota): GetEnclaveResourceQuotasResponse;
+  hasServiceCount(): boolean;
+  clearServiceCount(): GetEnclaveResourceQuotasResponse;
+
+  getFilesArtifactsStorageBytes(): ResourceQuota | undefined;
+  setFilesArtifactsStorageBytes(value?: ResourceQuota): GetEnclaveResourceQuotasResponse;
+  hasFilesArtifactsStorageBytes(): boolean;
+  clearFilesArtifactsStorageBytes(): GetEnclaveResourceQuotasResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveResourceQuotasResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveResourceQuotasResponse): GetEnclaveResourceQuotasResponse.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveResourceQuotasResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveResourceQuotasResponse;
+  static deserializeBinaryFromReader(message: GetEnclaveResourceQuotasResponse, reader: jspb.BinaryReader): GetEnclaveResourceQuotasResponse;
+}
+
+export namespace GetEnclaveResourceQuotasResponse {
+  export type AsObject = {
+    cpuMillicpus?: ResourceQuota.AsObject,
+    memoryMegabytes?: ResourceQuota.AsObject,
+    serviceCount?: ResourceQuota.AsObject,
+    filesArtifactsStorageBytes?: ResourceQuota.AsObject,
+  }
+}
+
+export class GetServicesStatsArgs extends jspb.Message {
+  getServiceIdentifiersMap(): jspb.Map<string, boolean>;
+  clearServiceIdentifiersMap(): GetServicesStatsArgs;
+
+  getFollow(): boolean;
+  setFollow(value: boolean): GetServicesStatsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServicesStatsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServicesStatsArgs): GetServicesStatsArgs.AsObject;
+  static serializeBinaryToWriter(message: GetServicesStatsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServicesStatsArgs;
+  static deserializeBinaryFromReader(message: GetServicesStatsArgs, reader: jspb.BinaryReader): GetServicesStatsArgs;
+}
+
+export namespace GetServicesStatsArgs {
+  export type AsObject = {
+    serviceIdentifiersMap: Array<[string, boolean]>,
+    follow: boolean,
+  }
+}
+
+export class ServiceStats extends jspb.Message {
+  getCpuMillicores(): number;
+  setCpuMillicores(value: number): ServiceStats;
+
+  getMemoryUsageBytes(): number;
+  setMemoryUsageBytes(value: number): ServiceStats;
+
+  getMemoryLimitBytes(): number;
+  setMemoryLimitBytes(value: number): ServiceStats;
+  hasMemoryLimitBytes(): boolean;
+  clearMemoryLimitBytes(): ServiceStats;
+
+  getNetworkReceivedBytes(): number;
+  setNetworkReceivedBytes(value: number): ServiceStats;
+  hasNetworkReceivedBytes(): boolean;
+  clearNetworkReceivedBytes(): ServiceStats;
+
+  getNetworkTransmittedBytes(): number;
+  setNetworkTransmittedBytes(value: number): ServiceStats;
+  hasNetworkTransmittedBytes(): boolean;
+  clearNetworkTransmittedBytes(): ServiceStats;
+
+  getBlockReadBytes(): number;
+  setBlockReadBytes(value: number): ServiceStats;
+  hasBlockReadBytes(): boolean;
+  clearBlockReadBytes(): ServiceStats;
+
+  getBlockWrittenBytes(): number;
+  setBlockWrittenBytes(value: number): ServiceStats;
+  hasBlockWrittenBytes(): boolean;
+  clearBlockWrittenBytes(): ServiceStats;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceStats.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceStats): ServiceStats.AsObject;
+  static serializeBinaryToWriter(message: ServiceStats, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceStats;
+  static deserializeBinaryFromReader(message: ServiceStats, reader: jspb.BinaryReader): ServiceStats;
+}
+
+export namespace ServiceStats {
+  export type AsObject = {
+    cpuMillicores: number,
+    memoryUsageBytes: number,
+    memoryLimitBytes?: number,
+    networkReceivedBytes?: number,
+    networkTransmittedBytes?: number,
+    blockReadBytes?: number,
+    blockWrittenBytes?: number,
+  }
+
+  export enum MemoryLimitBytesCase { 
+    _MEMORY_LIMIT_BYTES_NOT_SET = 0,
+    MEMORY_LIMIT_BYTES = 3,
+  }
+
+  export enum NetworkReceivedBytesCase { 
+    _NETWORK_RECEIVED_BYTES_NOT_SET = 0,
+    NETWORK_RECEIVED_BYTES = 4,
+  }
+
+  export enum NetworkTransmittedBytesCase { 
+    _NETWORK_TRANSMITTED_BYTES_NOT_SET = 0,
+    NETWORK_TRANSMITTED_BYTES = 5,
+  }
+
+  export enum BlockReadBytesCase { 
+    _BLOCK_READ_BYTES_NOT_SET = 0,
+    BLOCK_READ_BYTES = 6,
+  }
+
+  export enum BlockWrittenBytesCase { 
+    _BLOCK_WRITTEN_BYTES_NOT_SET = 0,
+    BLOCK_WRITTEN_BYTES = 7,
+  }
+}
+
+export class GetServicesStatsResponse extends jspb.Message {
+  getServiceStatsMap(): jspb.Map<string, ServiceStats>;
+  clearServiceStatsMap(): GetServicesStatsResponse;
+
+  getServiceErrorsMap(): jspb.Map<string, string>;
+  clearServiceErrorsMap(): GetServicesStatsResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServicesStatsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServicesStatsResponse): GetServicesStatsResponse.AsObject;
+  static serializeBinaryToWriter(message: GetServicesStatsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServicesStatsResponse;
+  static deserializeBinaryFromReader(message: GetServicesStatsResponse, reader: jspb.BinaryReader): GetServicesStatsResponse;
+}
+
+export namespace GetServicesStatsResponse {
+  export type AsObject = {
+    serviceStatsMap: Array<[string, ServiceStats.AsObject]>,
+    serviceErrorsMap: Array<[string, string]>,
+  }
+}
+
+export class LintStarlarkArgs extends jspb.Message {
+  getStarlarkFilesMap(): jspb.Map<string, Uint8Array | string>;
+  clearStarlarkFilesMap(): LintStarlarkArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LintStarlarkArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: LintStarlarkArgs): LintStarlarkArgs.AsObject;
+  static serializeBinaryToWriter(message: LintStarlarkArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LintStarlarkArgs;
+  static deserializeBinaryFromReader(message: LintStarlarkArgs, reader: jspb.BinaryReader): LintStarlarkArgs;
+}
+
+export namespace LintStarlarkArgs {
+  export type AsObject = {
+    starlarkFilesMap: Array<[string, Uint8Array | string]>,
+  }
+}
+
+export class StarlarkLintRule extends jspb.Message {
+  getId(): string;
+  setId(value: string): StarlarkLintRule;
+
+  getSeverity(): string;
+  setSeverity(value: string): StarlarkLintRule;
+
+  getDescription(): string;
+  setDescription(value: string): StarlarkLintRule;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkLintRule.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkLintRule): StarlarkLintRule.AsObject;
+  static serializeBinaryToWriter(message: StarlarkLintRule, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkLintRule;
+  static deserializeBinaryFromReader(message: StarlarkLintRule, reader: jspb.BinaryReader): StarlarkLintRule;
+}
+
+export namespace StarlarkLintRule {
+  export type AsObject = {
+    id: string,
+    severity: string,
+    description: string,
+  }
+}
+
+export class StarlarkLintFinding extends jspb.Message {
+  getRuleId(): string;
+  setRuleId(value: string): StarlarkLintFinding;
+
+  getSeverity(): string;
+  setSeverity(value: string): StarlarkLintFinding;
+
+  getFilepath(): string;
+  setFilepath(value: string): StarlarkLintFinding;
+
+  getLine(): number;
+  setLine(value: number): StarlarkLintFinding;
+
+  getColumn(): number;
+  setColumn(value: number): StarlarkLintFinding;
+
+  getMessage(): string;
+  setMessage(value: string): StarlarkLintFinding;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkLintFinding.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkLintFinding): StarlarkLintFinding.AsObject;
+  static serializeBinaryToWriter(message: StarlarkLintFinding, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkLintFinding;
+  static deserializeBinaryFromReader(message: StarlarkLintFinding, reader: jspb.BinaryReader): StarlarkLintFinding;
+}
+
+export namespace StarlarkLintFinding {
+  export type AsObject = {
+    ruleId: string,
+    severity: string,
+    filepath: string,
+    line: number,
+    column: number,
+    message: string,
+  }
+}
+
+export class LintStarlarkResponse extends jspb.Message {
+  getFindingsList(): Array<StarlarkLintFinding>;
+  setFindingsList(value: Array<StarlarkLintFinding>): LintStarlarkResponse;
+  clearFindingsList(): LintStarlarkResponse;
+  addFindings(value?: StarlarkLintFinding, index?: number): StarlarkLintFinding;
+
+  getRulesList(): Array<StarlarkLintRule>;
+  setRulesList(value: Array<StarlarkLintRule>): LintStarlarkResponse;
+  clearRulesList(): LintStarlarkResponse;
+  addRules(value?: StarlarkLintRule, index?: number): StarlarkLintRule;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LintStarlarkResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: LintStarlarkResponse): LintStarlarkResponse.AsObject;
+  static serializeBinaryToWriter(message: LintStarlarkResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LintStarlarkResponse;
+  static deserializeBinaryFromReader(message: LintStarlarkResponse, reader: jspb.BinaryReader): LintStarlarkResponse;
+}
+
+export namespace LintStarlarkResponse {
+  export type AsObject = {
+    findingsList: Array<StarlarkLintFinding.AsObject>,
+    rulesList: Array<StarlarkLintRule.AsObject>,
+  }
+}
+
 export enum ServiceStatus { 
   STOPPED = 0,
   RUNNING = 1,
   UNKNOWN = 2,
 }
+export enum ServiceHealthStatus { 
+  HEALTH_UNKNOWN = 0,
+  HEALTHY = 1,
+  UNHEALTHY = 2,
+}
 export enum ImageDownloadMode { 
   ALWAYS = 0,
   MISSING = 1,
@@ -1310,9 +2150,19 @@ export enum Connect {
   CONNECT = 0,
   NO_CONNECT = 1,
 }
+export enum PackageLockMode { 
+  ENFORCE_LOCK = 0,
+  EXTEND_LOCK = 1,
+  IGNORE_LOCK = 2,
+}
 export enum KurtosisFeatureFlag { 
   NO_INSTRUCTIONS_CACHING = 0,
 }
+export enum StarlarkPlanDiffChangeType { 
+  COMPONENT_ADDED = 0,
+  COMPONENT_UPDATED = 1,
+  COMPONENT_REMOVED = 2,
+}
 export enum RestartPolicy { 
   NEVER = 0,
   ALWAYS = 1,
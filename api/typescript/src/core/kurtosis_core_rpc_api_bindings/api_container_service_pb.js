@@ -23,35 +23,61 @@ var global = (function() {
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 goog.object.extend(proto, google_protobuf_empty_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
 goog.exportSymbol('proto.api_container_api.Connect', null, global);
 goog.exportSymbol('proto.api_container_api.ConnectServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ConnectServicesResponse', null, global);
 goog.exportSymbol('proto.api_container_api.Container', null, global);
 goog.exportSymbol('proto.api_container_api.Container.Status', null, global);
+goog.exportSymbol('proto.api_container_api.CopyFilesArtifactToServiceArgs', null, global);
+goog.exportSymbol('proto.api_container_api.CopyFilesFromServiceArgs', null, global);
+goog.exportSymbol('proto.api_container_api.CopyFilesToServiceChunk', null, global);
 goog.exportSymbol('proto.api_container_api.DataChunkMetadata', null, global);
 goog.exportSymbol('proto.api_container_api.DownloadFilesArtifactArgs', null, global);
+goog.exportSymbol('proto.api_container_api.EnclaveExpiry', null, global);
 goog.exportSymbol('proto.api_container_api.ExecCommandArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ExecCommandResponse', null, global);
+goog.exportSymbol('proto.api_container_api.ExportEnclaveSnapshotArgs', null, global);
 goog.exportSymbol('proto.api_container_api.FileArtifactContentsFileDescription', null, global);
 goog.exportSymbol('proto.api_container_api.FilesArtifactNameAndUuid', null, global);
+goog.exportSymbol('proto.api_container_api.FilesArtifactVersion', null, global);
+goog.exportSymbol('proto.api_container_api.GetEnclaveResourceQuotasResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetServicesStatsArgs', null, global);
+goog.exportSymbol('proto.api_container_api.GetServicesStatsResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetStarlarkRunResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GitCredential', null, global);
+goog.exportSymbol('proto.api_container_api.GitCredential.CredentialCase', null, global);
+goog.exportSymbol('proto.api_container_api.HttpsTokenGitCredential', null, global);
 goog.exportSymbol('proto.api_container_api.ImageDownloadMode', null, global);
 goog.exportSymbol('proto.api_container_api.InspectFilesArtifactContentsRequest', null, global);
 goog.exportSymbol('proto.api_container_api.InspectFilesArtifactContentsResponse', null, global);
 goog.exportSymbol('proto.api_container_api.KurtosisFeatureFlag', null, global);
+goog.exportSymbol('proto.api_container_api.LintStarlarkArgs', null, global);
+goog.exportSymbol('proto.api_container_api.LintStarlarkResponse', null, global);
 goog.exportSymbol('proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.ListFilesArtifactVersionsArgs', null, global);
+goog.exportSymbol('proto.api_container_api.ListFilesArtifactVersionsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.PackageDependency', null, global);
+goog.exportSymbol('proto.api_container_api.PackageLockMode', null, global);
 goog.exportSymbol('proto.api_container_api.Port', null, global);
 goog.exportSymbol('proto.api_container_api.Port.TransportProtocol', null, global);
+goog.exportSymbol('proto.api_container_api.RemoveFilesArtifactArgs', null, global);
+goog.exportSymbol('proto.api_container_api.ResourceQuota', null, global);
 goog.exportSymbol('proto.api_container_api.RestartPolicy', null, global);
+goog.exportSymbol('proto.api_container_api.ResumeServicesResponse', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkScriptArgs', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceHealthStatus', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceIdentifiers', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceInfo', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceStats', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceStatus', null, global);
+goog.exportSymbol('proto.api_container_api.SshKeyGitCredential', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError.ErrorCase', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkExecutionError', null, global);
@@ -61,6 +87,11 @@ goog.exportSymbol('proto.api_container_api.StarlarkInstructionArg', null, global
 goog.exportSymbol('proto.api_container_api.StarlarkInstructionPosition', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInstructionResult', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInterpretationError', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkLintFinding', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkLintRule', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkPlanDiff', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkPlanDiffChangeType', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkPlanDiffComponentChange', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunFinishedEvent', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunProgress', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine', null, global);
@@ -180,6 +211,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.RunStarlarkPackageArgs.displayName = 'proto.api_container_api.RunStarlarkPackageArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GitCredential = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.api_container_api.GitCredential.oneofGroups_);
+};
+goog.inherits(proto.api_container_api.GitCredential, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GitCredential.displayName = 'proto.api_container_api.GitCredential';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.HttpsTokenGitCredential = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.HttpsTokenGitCredential, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.HttpsTokenGitCredential.displayName = 'proto.api_container_api.HttpsTokenGitCredential';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.SshKeyGitCredential = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.SshKeyGitCredential, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.SshKeyGitCredential.displayName = 'proto.api_container_api.SshKeyGitCredential';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -443,7 +537,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.api_container_api.StarlarkRunFinishedEvent = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.StarlarkRunFinishedEvent.repeatedFields_, null);
 };
 goog.inherits(proto.api_container_api.StarlarkRunFinishedEvent, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -453,6 +547,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StarlarkRunFinishedEvent.displayName = 'proto.api_container_api.StarlarkRunFinishedEvent';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkPlanDiff = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.StarlarkPlanDiff.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.StarlarkPlanDiff, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkPlanDiff.displayName = 'proto.api_container_api.StarlarkPlanDiff';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkPlanDiffComponentChange = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkPlanDiffComponentChange, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkPlanDiffComponentChange.displayName = 'proto.api_container_api.StarlarkPlanDiffComponentChange';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.PackageDependency = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.PackageDependency, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.PackageDependency.displayName = 'proto.api_container_api.PackageDependency';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -789,6 +946,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StoreFilesArtifactFromServiceResponse.displayName = 'proto.api_container_api.StoreFilesArtifactFromServiceResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.CopyFilesToServiceChunk = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.CopyFilesToServiceChunk, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.CopyFilesToServiceChunk.displayName = 'proto.api_container_api.CopyFilesToServiceChunk';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.CopyFilesArtifactToServiceArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.CopyFilesArtifactToServiceArgs.displayName = 'proto.api_container_api.CopyFilesArtifactToServiceArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.CopyFilesFromServiceArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.CopyFilesFromServiceArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.CopyFilesFromServiceArgs.displayName = 'proto.api_container_api.CopyFilesFromServiceArgs';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -831,6 +1051,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.displayName = 'proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RemoveFilesArtifactArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.RemoveFilesArtifactArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RemoveFilesArtifactArgs.displayName = 'proto.api_container_api.RemoveFilesArtifactArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ListFilesArtifactVersionsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ListFilesArtifactVersionsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ListFilesArtifactVersionsArgs.displayName = 'proto.api_container_api.ListFilesArtifactVersionsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ListFilesArtifactVersionsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.ListFilesArtifactVersionsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ListFilesArtifactVersionsResponse.displayName = 'proto.api_container_api.ListFilesArtifactVersionsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.FilesArtifactVersion = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.FilesArtifactVersion, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.FilesArtifactVersion.displayName = 'proto.api_container_api.FilesArtifactVersion';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -957,24 +1261,276 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.GetStarlarkRunResponse.displayName = 'proto.api_container_api.GetStarlarkRunResponse';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
+proto.api_container_api.ExportEnclaveSnapshotArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ExportEnclaveSnapshotArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ExportEnclaveSnapshotArgs.displayName = 'proto.api_container_api.ExportEnclaveSnapshotArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ResumeServicesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ResumeServicesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.ResumeServicesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ResumeServicesResponse.displayName = 'proto.api_container_api.ResumeServicesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.EnclaveExpiry = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.EnclaveExpiry, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.EnclaveExpiry.displayName = 'proto.api_container_api.EnclaveExpiry';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ResourceQuota = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ResourceQuota, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ResourceQuota.displayName = 'proto.api_container_api.ResourceQuota';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetEnclaveResourceQuotasResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetEnclaveResourceQuotasResponse.displayName = 'proto.api_container_api.GetEnclaveResourceQuotasResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetServicesStatsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetServicesStatsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetServicesStatsArgs.displayName = 'proto.api_container_api.GetServicesStatsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceStats = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceStats, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceStats.displayName = 'proto.api_container_api.ServiceStats';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetServicesStatsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetServicesStatsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetServicesStatsResponse.displayName = 'proto.api_container_api.GetServicesStatsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.LintStarlarkArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.LintStarlarkArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.LintStarlarkArgs.displayName = 'proto.api_container_api.LintStarlarkArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkLintRule = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkLintRule, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkLintRule.displayName = 'proto.api_container_api.StarlarkLintRule';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkLintFinding = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkLintFinding, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkLintFinding.displayName = 'proto.api_container_api.StarlarkLintFinding';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.LintStarlarkResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.LintStarlarkResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.LintStarlarkResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.LintStarlarkResponse.displayName = 'proto.api_container_api.LintStarlarkResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
 };
 
 
@@ -1533,7 +2089,9 @@ proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
     name: jspb.Message.getFieldWithDefault(msg, 6, ""),
     shortenedUuid: jspb.Message.getFieldWithDefault(msg, 7, ""),
     serviceStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    container: (f = msg.getContainer()) && proto.api_container_api.Container.toObject(includeInstance, f)
+    container: (f = msg.getContainer()) && proto.api_container_api.Container.toObject(includeInstance, f),
+    healthStatus: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    restartCount: jspb.Message.getFieldWithDefault(msg, 11, 0)
   };
 
   if (includeInstance) {
@@ -1611,6 +2169,14 @@ proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg,
       reader.readMessage(value,proto.api_container_api.Container.deserializeBinaryFromReader);
       msg.setContainer(value);
       break;
+    case 10:
+      var value = /** @type {!proto.api_container_api.ServiceHealthStatus} */ (reader.readEnum());
+      msg.setHealthStatus(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRestartCount(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1698,6 +2264,20 @@ proto.api_container_api.ServiceInfo.serializeBinaryToWriter = function(message,
       proto.api_container_api.Container.serializeBinaryToWriter
     );
   }
+  f = message.getHealthStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getRestartCount();
+  if (f !== 0) {
+    writer.writeUint32(
+      11,
+      f
+    );
+  }
 };
 
 
@@ -1890,20 +2470,56 @@ proto.api_container_api.ServiceInfo.prototype.hasContainer = function() {
 };
 
 
+/**
+ * optional ServiceHealthStatus health_status = 10;
+ * @return {!proto.api_container_api.ServiceHealthStatus}
+ */
+proto.api_container_api.ServiceInfo.prototype.getHealthStatus = function() {
+  return /** @type {!proto.api_container_api.ServiceHealthStatus} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {!proto.api_container_api.ServiceHealthStatus} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.repeatedFields_ = [6];
+proto.api_container_api.ServiceInfo.prototype.setHealthStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
+};
 
 
+/**
+ * optional uint32 restart_count = 11;
+ * @return {number}
+ */
+proto.api_container_api.ServiceInfo.prototype.getRestartCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setRestartCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkScriptArgs.repeatedFields_ = [6,10];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
  * Optional fields that are not set will be set to undefined.
  * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
  * For the list of reserved names please see:
@@ -1937,7 +2553,10 @@ proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstanc
     experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
     cloudInstanceId: jspb.Message.getFieldWithDefault(msg, 7, ""),
     cloudUserId: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 9, 0)
+    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    gitCredentialsList: jspb.Message.toObjectList(msg.getGitCredentialsList(),
+    proto.api_container_api.GitCredential.toObject, includeInstance),
+    diff: jspb.Message.getBooleanFieldWithDefault(msg, 11, false)
   };
 
   if (includeInstance) {
@@ -2012,6 +2631,15 @@ proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = func
       var value = /** @type {!proto.api_container_api.ImageDownloadMode} */ (reader.readEnum());
       msg.setImageDownloadMode(value);
       break;
+    case 10:
+      var value = new proto.api_container_api.GitCredential;
+      reader.readMessage(value,proto.api_container_api.GitCredential.deserializeBinaryFromReader);
+      msg.addGitCredentials(value);
+      break;
+    case 11:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDiff(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2104,6 +2732,21 @@ proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function
       f
     );
   }
+  f = message.getGitCredentialsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      10,
+      f,
+      proto.api_container_api.GitCredential.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 11));
+  if (f != null) {
+    writer.writeBool(
+      11,
+      f
+    );
+  }
 };
 
 
@@ -2378,13 +3021,87 @@ proto.api_container_api.RunStarlarkScriptArgs.prototype.hasImageDownloadMode = f
 };
 
 
+/**
+ * repeated GitCredential git_credentials = 10;
+ * @return {!Array<!proto.api_container_api.GitCredential>}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getGitCredentialsList = function() {
+  return /** @type{!Array<!proto.api_container_api.GitCredential>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.GitCredential, 10));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.GitCredential>} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+*/
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setGitCredentialsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 10, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GitCredential=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GitCredential}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.addGitCredentials = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 10, opt_value, proto.api_container_api.GitCredential, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearGitCredentialsList = function() {
+  return this.setGitCredentialsList([]);
+};
+
+
+/**
+ * optional bool diff = 11;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getDiff = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setDiff = function(value) {
+  return jspb.Message.setField(this, 11, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDiff = function() {
+  return jspb.Message.setField(this, 11, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDiff = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.RunStarlarkPackageArgs.repeatedFields_ = [11];
+proto.api_container_api.RunStarlarkPackageArgs.repeatedFields_ = [11,15];
 
 /**
  * Oneof group definitions for this message. Each group defines the field
@@ -2455,7 +3172,11 @@ proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstan
     experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
     cloudInstanceId: jspb.Message.getFieldWithDefault(msg, 12, ""),
     cloudUserId: jspb.Message.getFieldWithDefault(msg, 13, ""),
-    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 14, 0)
+    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    gitCredentialsList: jspb.Message.toObjectList(msg.getGitCredentialsList(),
+    proto.api_container_api.GitCredential.toObject, includeInstance),
+    packageLockMode: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    diff: jspb.Message.getBooleanFieldWithDefault(msg, 17, false)
   };
 
   if (includeInstance) {
@@ -2546,6 +3267,19 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
       var value = /** @type {!proto.api_container_api.ImageDownloadMode} */ (reader.readEnum());
       msg.setImageDownloadMode(value);
       break;
+    case 15:
+      var value = new proto.api_container_api.GitCredential;
+      reader.readMessage(value,proto.api_container_api.GitCredential.deserializeBinaryFromReader);
+      msg.addGitCredentials(value);
+      break;
+    case 16:
+      var value = /** @type {!proto.api_container_api.PackageLockMode} */ (reader.readEnum());
+      msg.setPackageLockMode(value);
+      break;
+    case 17:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDiff(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2666,6 +3400,28 @@ proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = functio
       f
     );
   }
+  f = message.getGitCredentialsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      15,
+      f,
+      proto.api_container_api.GitCredential.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {!proto.api_container_api.PackageLockMode} */ (jspb.Message.getField(message, 16));
+  if (f != null) {
+    writer.writeEnum(
+      16,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 17));
+  if (f != null) {
+    writer.writeBool(
+      17,
+      f
+    );
+  }
 };
 
 
@@ -3090,6 +3846,116 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.hasImageDownloadMode =
 };
 
 
+/**
+ * repeated GitCredential git_credentials = 15;
+ * @return {!Array<!proto.api_container_api.GitCredential>}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getGitCredentialsList = function() {
+  return /** @type{!Array<!proto.api_container_api.GitCredential>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.GitCredential, 15));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.GitCredential>} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+*/
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setGitCredentialsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 15, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GitCredential=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GitCredential}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.addGitCredentials = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 15, opt_value, proto.api_container_api.GitCredential, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearGitCredentialsList = function() {
+  return this.setGitCredentialsList([]);
+};
+
+
+/**
+ * optional PackageLockMode package_lock_mode = 16;
+ * @return {!proto.api_container_api.PackageLockMode}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageLockMode = function() {
+  return /** @type {!proto.api_container_api.PackageLockMode} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.PackageLockMode} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageLockMode = function(value) {
+  return jspb.Message.setField(this, 16, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearPackageLockMode = function() {
+  return jspb.Message.setField(this, 16, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasPackageLockMode = function() {
+  return jspb.Message.getField(this, 16) != null;
+};
+
+
+/**
+ * optional bool diff = 17;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getDiff = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 17, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setDiff = function(value) {
+  return jspb.Message.setField(this, 17, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDiff = function() {
+  return jspb.Message.setField(this, 17, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDiff = function() {
+  return jspb.Message.getField(this, 17) != null;
+};
+
+
 
 /**
  * Oneof group definitions for this message. Each group defines the field
@@ -3099,27 +3965,22 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.hasImageDownloadMode =
  * @private {!Array<!Array<number>>}
  * @const
  */
-proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5,6,7]];
+proto.api_container_api.GitCredential.oneofGroups_ = [[2,3]];
 
 /**
  * @enum {number}
  */
-proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
-  RUN_RESPONSE_LINE_NOT_SET: 0,
-  INSTRUCTION: 1,
-  ERROR: 2,
-  PROGRESS_INFO: 3,
-  INSTRUCTION_RESULT: 4,
-  RUN_FINISHED_EVENT: 5,
-  WARNING: 6,
-  INFO: 7
+proto.api_container_api.GitCredential.CredentialCase = {
+  CREDENTIAL_NOT_SET: 0,
+  HTTPS_TOKEN: 2,
+  SSH_KEY: 3
 };
 
 /**
- * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
+ * @return {proto.api_container_api.GitCredential.CredentialCase}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
-  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
+proto.api_container_api.GitCredential.prototype.getCredentialCase = function() {
+  return /** @type {proto.api_container_api.GitCredential.CredentialCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.GitCredential.oneofGroups_[0]));
 };
 
 
@@ -3137,8 +3998,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+proto.api_container_api.GitCredential.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GitCredential.toObject(opt_includeInstance, this);
 };
 
 
@@ -3147,19 +4008,15 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GitCredential} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+proto.api_container_api.GitCredential.toObject = function(includeInstance, msg) {
   var f, obj = {
-    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
-    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
-    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
-    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
-    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f),
-    warning: (f = msg.getWarning()) && proto.api_container_api.StarlarkWarning.toObject(includeInstance, f),
-    info: (f = msg.getInfo()) && proto.api_container_api.StarlarkInfo.toObject(includeInstance, f)
+    host: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    httpsToken: (f = msg.getHttpsToken()) && proto.api_container_api.HttpsTokenGitCredential.toObject(includeInstance, f),
+    sshKey: (f = msg.getSshKey()) && proto.api_container_api.SshKeyGitCredential.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3173,23 +4030,23 @@ proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.GitCredential}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+proto.api_container_api.GitCredential.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunResponseLine;
-  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GitCredential;
+  return proto.api_container_api.GitCredential.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GitCredential} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.GitCredential}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GitCredential.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3197,39 +4054,18 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstruction;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
-      msg.setInstruction(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHost(value);
       break;
     case 2:
-      var value = new proto.api_container_api.StarlarkError;
-      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
-      msg.setError(value);
+      var value = new proto.api_container_api.HttpsTokenGitCredential;
+      reader.readMessage(value,proto.api_container_api.HttpsTokenGitCredential.deserializeBinaryFromReader);
+      msg.setHttpsToken(value);
       break;
     case 3:
-      var value = new proto.api_container_api.StarlarkRunProgress;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
-      msg.setProgressInfo(value);
-      break;
-    case 4:
-      var value = new proto.api_container_api.StarlarkInstructionResult;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
-      msg.setInstructionResult(value);
-      break;
-    case 5:
-      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
-      msg.setRunFinishedEvent(value);
-      break;
-    case 6:
-      var value = new proto.api_container_api.StarlarkWarning;
-      reader.readMessage(value,proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader);
-      msg.setWarning(value);
-      break;
-    case 7:
-      var value = new proto.api_container_api.StarlarkInfo;
-      reader.readMessage(value,proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader);
-      msg.setInfo(value);
+      var value = new proto.api_container_api.SshKeyGitCredential;
+      reader.readMessage(value,proto.api_container_api.SshKeyGitCredential.deserializeBinaryFromReader);
+      msg.setSshKey(value);
       break;
     default:
       reader.skipField();
@@ -3244,9 +4080,9 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+proto.api_container_api.GitCredential.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GitCredential.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3254,133 +4090,81 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!proto.api_container_api.GitCredential} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GitCredential.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInstruction();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getHost();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getError();
+  f = message.getHttpsToken();
   if (f != null) {
     writer.writeMessage(
       2,
       f,
-      proto.api_container_api.StarlarkError.serializeBinaryToWriter
+      proto.api_container_api.HttpsTokenGitCredential.serializeBinaryToWriter
     );
   }
-  f = message.getProgressInfo();
+  f = message.getSshKey();
   if (f != null) {
     writer.writeMessage(
       3,
       f,
-      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
-    );
-  }
-  f = message.getInstructionResult();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
-    );
-  }
-  f = message.getRunFinishedEvent();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
-    );
-  }
-  f = message.getWarning();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.api_container_api.StarlarkWarning.serializeBinaryToWriter
-    );
-  }
-  f = message.getInfo();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.api_container_api.StarlarkInfo.serializeBinaryToWriter
+      proto.api_container_api.SshKeyGitCredential.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional StarlarkInstruction instruction = 1;
- * @return {?proto.api_container_api.StarlarkInstruction}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * optional string host = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
-  return this.setInstruction(undefined);
+proto.api_container_api.GitCredential.prototype.getHost = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.GitCredential} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.GitCredential.prototype.setHost = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional StarlarkError error = 2;
- * @return {?proto.api_container_api.StarlarkError}
+ * optional HttpsTokenGitCredential https_token = 2;
+ * @return {?proto.api_container_api.HttpsTokenGitCredential}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
-  return /** @type{?proto.api_container_api.StarlarkError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
+proto.api_container_api.GitCredential.prototype.getHttpsToken = function() {
+  return /** @type{?proto.api_container_api.HttpsTokenGitCredential} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.HttpsTokenGitCredential, 2));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkError|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @param {?proto.api_container_api.HttpsTokenGitCredential|undefined} value
+ * @return {!proto.api_container_api.GitCredential} returns this
 */
-proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.GitCredential.prototype.setHttpsToken = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.GitCredential.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @return {!proto.api_container_api.GitCredential} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
-  return this.setError(undefined);
+proto.api_container_api.GitCredential.prototype.clearHttpsToken = function() {
+  return this.setHttpsToken(undefined);
 };
 
 
@@ -3388,36 +4172,36 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function(
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
+proto.api_container_api.GitCredential.prototype.hasHttpsToken = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional StarlarkRunProgress progress_info = 3;
- * @return {?proto.api_container_api.StarlarkRunProgress}
+ * optional SshKeyGitCredential ssh_key = 3;
+ * @return {?proto.api_container_api.SshKeyGitCredential}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+proto.api_container_api.GitCredential.prototype.getSshKey = function() {
+  return /** @type{?proto.api_container_api.SshKeyGitCredential} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.SshKeyGitCredential, 3));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @param {?proto.api_container_api.SshKeyGitCredential|undefined} value
+ * @return {!proto.api_container_api.GitCredential} returns this
 */
-proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.GitCredential.prototype.setSshKey = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.GitCredential.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @return {!proto.api_container_api.GitCredential} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
-  return this.setProgressInfo(undefined);
+proto.api_container_api.GitCredential.prototype.clearSshKey = function() {
+  return this.setSshKey(undefined);
 };
 
 
@@ -3425,159 +4209,11 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = fu
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
+proto.api_container_api.GitCredential.prototype.hasSshKey = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
-/**
- * optional StarlarkInstructionResult instruction_result = 4;
- * @return {?proto.api_container_api.StarlarkInstructionResult}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
-  return this.setInstructionResult(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
-  return jspb.Message.getField(this, 4) != null;
-};
-
-
-/**
- * optional StarlarkRunFinishedEvent run_finished_event = 5;
- * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
-  return this.setRunFinishedEvent(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
-  return jspb.Message.getField(this, 5) != null;
-};
-
-
-/**
- * optional StarlarkWarning warning = 6;
- * @return {?proto.api_container_api.StarlarkWarning}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getWarning = function() {
-  return /** @type{?proto.api_container_api.StarlarkWarning} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkWarning, 6));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkWarning|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setWarning = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 6, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearWarning = function() {
-  return this.setWarning(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasWarning = function() {
-  return jspb.Message.getField(this, 6) != null;
-};
-
-
-/**
- * optional StarlarkInfo info = 7;
- * @return {?proto.api_container_api.StarlarkInfo}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInfo = function() {
-  return /** @type{?proto.api_container_api.StarlarkInfo} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInfo, 7));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInfo|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInfo = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 7, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInfo = function() {
-  return this.setInfo(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInfo = function() {
-  return jspb.Message.getField(this, 7) != null;
-};
-
-
 
 
 
@@ -3594,8 +4230,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInfo.toObject(opt_includeInstance, this);
+proto.api_container_api.HttpsTokenGitCredential.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.HttpsTokenGitCredential.toObject(opt_includeInstance, this);
 };
 
 
@@ -3604,13 +4240,14 @@ proto.api_container_api.StarlarkInfo.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInfo} msg The msg instance to transform.
+ * @param {!proto.api_container_api.HttpsTokenGitCredential} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInfo.toObject = function(includeInstance, msg) {
+proto.api_container_api.HttpsTokenGitCredential.toObject = function(includeInstance, msg) {
   var f, obj = {
-    infoMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    token: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -3624,23 +4261,23 @@ proto.api_container_api.StarlarkInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInfo}
+ * @return {!proto.api_container_api.HttpsTokenGitCredential}
  */
-proto.api_container_api.StarlarkInfo.deserializeBinary = function(bytes) {
+proto.api_container_api.HttpsTokenGitCredential.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInfo;
-  return proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.HttpsTokenGitCredential;
+  return proto.api_container_api.HttpsTokenGitCredential.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInfo} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.HttpsTokenGitCredential} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInfo}
+ * @return {!proto.api_container_api.HttpsTokenGitCredential}
  */
-proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.HttpsTokenGitCredential.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3649,7 +4286,11 @@ proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setInfoMessage(value);
+      msg.setUsername(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToken(value);
       break;
     default:
       reader.skipField();
@@ -3664,9 +4305,9 @@ proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInfo.prototype.serializeBinary = function() {
+proto.api_container_api.HttpsTokenGitCredential.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInfo.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.HttpsTokenGitCredential.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3674,37 +4315,80 @@ proto.api_container_api.StarlarkInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInfo} message
+ * @param {!proto.api_container_api.HttpsTokenGitCredential} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInfo.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.HttpsTokenGitCredential.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInfoMessage();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string info_message = 1;
+ * optional string username = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInfo.prototype.getInfoMessage = function() {
+proto.api_container_api.HttpsTokenGitCredential.prototype.getUsername = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInfo} returns this
+ * @return {!proto.api_container_api.HttpsTokenGitCredential} returns this
  */
-proto.api_container_api.StarlarkInfo.prototype.setInfoMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.HttpsTokenGitCredential.prototype.setUsername = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.HttpsTokenGitCredential} returns this
+ */
+proto.api_container_api.HttpsTokenGitCredential.prototype.clearUsername = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.HttpsTokenGitCredential.prototype.hasUsername = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string token = 2;
+ * @return {string}
+ */
+proto.api_container_api.HttpsTokenGitCredential.prototype.getToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpsTokenGitCredential} returns this
+ */
+proto.api_container_api.HttpsTokenGitCredential.prototype.setToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -3724,8 +4408,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkWarning.toObject(opt_includeInstance, this);
+proto.api_container_api.SshKeyGitCredential.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.SshKeyGitCredential.toObject(opt_includeInstance, this);
 };
 
 
@@ -3734,13 +4418,15 @@ proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkWarning} msg The msg instance to transform.
+ * @param {!proto.api_container_api.SshKeyGitCredential} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg) {
+proto.api_container_api.SshKeyGitCredential.toObject = function(includeInstance, msg) {
   var f, obj = {
-    warningMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    privateKey: msg.getPrivateKey_asB64(),
+    passphrase: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    knownHosts: msg.getKnownHosts_asB64()
   };
 
   if (includeInstance) {
@@ -3754,23 +4440,23 @@ proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkWarning}
+ * @return {!proto.api_container_api.SshKeyGitCredential}
  */
-proto.api_container_api.StarlarkWarning.deserializeBinary = function(bytes) {
+proto.api_container_api.SshKeyGitCredential.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkWarning;
-  return proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.SshKeyGitCredential;
+  return proto.api_container_api.SshKeyGitCredential.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkWarning} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.SshKeyGitCredential} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkWarning}
+ * @return {!proto.api_container_api.SshKeyGitCredential}
  */
-proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.SshKeyGitCredential.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3778,8 +4464,16 @@ proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setPrivateKey(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setWarningMessage(value);
+      msg.setPassphrase(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setKnownHosts(value);
       break;
     default:
       reader.skipField();
@@ -3794,9 +4488,9 @@ proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
+proto.api_container_api.SshKeyGitCredential.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkWarning.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.SshKeyGitCredential.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3804,47 +4498,188 @@ proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkWarning} message
+ * @param {!proto.api_container_api.SshKeyGitCredential} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkWarning.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.SshKeyGitCredential.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWarningMessage();
+  f = message.getPrivateKey_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
   }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getKnownHosts_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string warning_message = 1;
+ * optional bytes private_key = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkWarning.prototype.getWarningMessage = function() {
+proto.api_container_api.SshKeyGitCredential.prototype.getPrivateKey = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
+/**
+ * optional bytes private_key = 1;
+ * This is a type-conversion wrapper around `getPrivateKey()`
+ * @return {string}
+ */
+proto.api_container_api.SshKeyGitCredential.prototype.getPrivateKey_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getPrivateKey()));
+};
+
+
+/**
+ * optional bytes private_key = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getPrivateKey()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.SshKeyGitCredential.prototype.getPrivateKey_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getPrivateKey()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.SshKeyGitCredential} returns this
+ */
+proto.api_container_api.SshKeyGitCredential.prototype.setPrivateKey = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * optional string passphrase = 2;
+ * @return {string}
+ */
+proto.api_container_api.SshKeyGitCredential.prototype.getPassphrase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkWarning} returns this
+ * @return {!proto.api_container_api.SshKeyGitCredential} returns this
  */
-proto.api_container_api.StarlarkWarning.prototype.setWarningMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.SshKeyGitCredential.prototype.setPassphrase = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.SshKeyGitCredential} returns this
+ */
+proto.api_container_api.SshKeyGitCredential.prototype.clearPassphrase = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.SshKeyGitCredential.prototype.hasPassphrase = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bytes known_hosts = 3;
+ * @return {string}
+ */
+proto.api_container_api.SshKeyGitCredential.prototype.getKnownHosts = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes known_hosts = 3;
+ * This is a type-conversion wrapper around `getKnownHosts()`
+ * @return {string}
+ */
+proto.api_container_api.SshKeyGitCredential.prototype.getKnownHosts_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getKnownHosts()));
+};
+
+
+/**
+ * optional bytes known_hosts = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getKnownHosts()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.SshKeyGitCredential.prototype.getKnownHosts_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getKnownHosts()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.SshKeyGitCredential} returns this
+ */
+proto.api_container_api.SshKeyGitCredential.prototype.setKnownHosts = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
  * @const
  */
-proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
+proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5,6,7,8]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
+  RUN_RESPONSE_LINE_NOT_SET: 0,
+  INSTRUCTION: 1,
+  ERROR: 2,
+  PROGRESS_INFO: 3,
+  INSTRUCTION_RESULT: 4,
+  RUN_FINISHED_EVENT: 5,
+  WARNING: 6,
+  INFO: 7,
+  PLAN_DIFF: 8
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
+  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
+};
 
 
 
@@ -3861,8 +4696,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
 };
 
 
@@ -3871,18 +4706,20 @@ proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
   var f, obj = {
-    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
-    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
-    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
-    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    isSkipped: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
+    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
+    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
+    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
+    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f),
+    warning: (f = msg.getWarning()) && proto.api_container_api.StarlarkWarning.toObject(includeInstance, f),
+    info: (f = msg.getInfo()) && proto.api_container_api.StarlarkInfo.toObject(includeInstance, f),
+    planDiff: (f = msg.getPlanDiff()) && proto.api_container_api.StarlarkPlanDiff.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3896,23 +4733,23 @@ proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstruction;
-  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkRunResponseLine;
+  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3920,32 +4757,50 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstructionPosition;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
-      msg.setPosition(value);
+      var value = new proto.api_container_api.StarlarkInstruction;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
+      msg.setInstruction(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setInstructionName(value);
+      var value = new proto.api_container_api.StarlarkError;
+      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
+      msg.setError(value);
       break;
     case 3:
-      var value = new proto.api_container_api.StarlarkInstructionArg;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
-      msg.addArguments(value);
+      var value = new proto.api_container_api.StarlarkRunProgress;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
+      msg.setProgressInfo(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setExecutableInstruction(value);
+      var value = new proto.api_container_api.StarlarkInstructionResult;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
+      msg.setInstructionResult(value);
       break;
     case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsSkipped(value);
-      break;
-    default:
-      reader.skipField();
+      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
+      msg.setRunFinishedEvent(value);
       break;
-    }
-  }
+    case 6:
+      var value = new proto.api_container_api.StarlarkWarning;
+      reader.readMessage(value,proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader);
+      msg.setWarning(value);
+      break;
+    case 7:
+      var value = new proto.api_container_api.StarlarkInfo;
+      reader.readMessage(value,proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader);
+      msg.setInfo(value);
+      break;
+    case 8:
+      var value = new proto.api_container_api.StarlarkPlanDiff;
+      reader.readMessage(value,proto.api_container_api.StarlarkPlanDiff.deserializeBinaryFromReader);
+      msg.setPlanDiff(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
   return msg;
 };
 
@@ -3954,9 +4809,9 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3964,77 +4819,104 @@ proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPosition();
+  f = message.getInstruction();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
+      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
     );
   }
-  f = message.getInstructionName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getError();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      proto.api_container_api.StarlarkError.serializeBinaryToWriter
     );
   }
-  f = message.getArgumentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getProgressInfo();
+  if (f != null) {
+    writer.writeMessage(
       3,
       f,
-      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
+      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
     );
   }
-  f = message.getExecutableInstruction();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getInstructionResult();
+  if (f != null) {
+    writer.writeMessage(
       4,
-      f
+      f,
+      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
     );
   }
-  f = message.getIsSkipped();
-  if (f) {
-    writer.writeBool(
+  f = message.getRunFinishedEvent();
+  if (f != null) {
+    writer.writeMessage(
       5,
-      f
+      f,
+      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getWarning();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.api_container_api.StarlarkWarning.serializeBinaryToWriter
+    );
+  }
+  f = message.getInfo();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.api_container_api.StarlarkInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getPlanDiff();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.api_container_api.StarlarkPlanDiff.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional StarlarkInstructionPosition position = 1;
- * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ * optional StarlarkInstruction instruction = 1;
+ * @return {?proto.api_container_api.StarlarkInstruction}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
 */
-proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
-  return this.setPosition(undefined);
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
+  return this.setInstruction(undefined);
 };
 
 
@@ -4042,136 +4924,303 @@ proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional string instruction_name = 2;
- * @return {string}
+ * optional StarlarkError error = 2;
+ * @return {?proto.api_container_api.StarlarkError}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
+  return /** @type{?proto.api_container_api.StarlarkError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @param {?proto.api_container_api.StarlarkError|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
+  return this.setError(undefined);
 };
 
 
 /**
- * repeated StarlarkInstructionArg arguments = 3;
- * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
-  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * optional StarlarkRunProgress progress_info = 3;
+ * @return {?proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
 */
-proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
 };
 
 
 /**
- * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
+  return this.setProgressInfo(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
-  return this.setArgumentsList([]);
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional string executable_instruction = 4;
- * @return {string}
+ * optional StarlarkInstructionResult instruction_result = 4;
+ * @return {?proto.api_container_api.StarlarkInstructionResult}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
+  return this.setInstructionResult(undefined);
 };
 
 
 /**
- * optional bool is_skipped = 5;
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getIsSkipped = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * optional StarlarkRunFinishedEvent run_finished_event = 5;
+ * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
  */
-proto.api_container_api.StarlarkInstruction.prototype.setIsSkipped = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
 };
 
 
+/**
+ * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
 
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
+  return this.setRunFinishedEvent(undefined);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * Static version of the {@see toObject} method.
+ * optional StarlarkWarning warning = 6;
+ * @return {?proto.api_container_api.StarlarkWarning}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getWarning = function() {
+  return /** @type{?proto.api_container_api.StarlarkWarning} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkWarning, 6));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkWarning|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setWarning = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 6, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearWarning = function() {
+  return this.setWarning(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasWarning = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional StarlarkInfo info = 7;
+ * @return {?proto.api_container_api.StarlarkInfo}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInfo = function() {
+  return /** @type{?proto.api_container_api.StarlarkInfo} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInfo, 7));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInfo|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInfo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 7, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInfo = function() {
+  return this.setInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInfo = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional StarlarkPlanDiff plan_diff = 8;
+ * @return {?proto.api_container_api.StarlarkPlanDiff}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getPlanDiff = function() {
+  return /** @type{?proto.api_container_api.StarlarkPlanDiff} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkPlanDiff, 8));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkPlanDiff|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setPlanDiff = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 8, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearPlanDiff = function() {
+  return this.setPlanDiff(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasPlanDiff = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
+    infoMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4185,23 +5234,23 @@ proto.api_container_api.StarlarkInstructionResult.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * @return {!proto.api_container_api.StarlarkInfo}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionResult;
-  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkInfo;
+  return proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * @return {!proto.api_container_api.StarlarkInfo}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4210,7 +5259,7 @@ proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedInstructionResult(value);
+      msg.setInfoMessage(value);
       break;
     default:
       reader.skipField();
@@ -4225,9 +5274,9 @@ proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4235,13 +5284,13 @@ proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionResult} message
+ * @param {!proto.api_container_api.StarlarkInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedInstructionResult();
+  f = message.getInfoMessage();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -4252,19 +5301,19 @@ proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = func
 
 
 /**
- * optional string serialized_instruction_result = 1;
+ * optional string info_message = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
+proto.api_container_api.StarlarkInfo.prototype.getInfoMessage = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ * @return {!proto.api_container_api.StarlarkInfo} returns this
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
+proto.api_container_api.StarlarkInfo.prototype.setInfoMessage = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -4285,8 +5334,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkWarning.toObject(opt_includeInstance, this);
 };
 
 
@@ -4295,15 +5344,13 @@ proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkWarning} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    warningMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4317,23 +5364,23 @@ proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.StarlarkWarning}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkWarning.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionArg;
-  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkWarning;
+  return proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkWarning} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.StarlarkWarning}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4342,15 +5389,7 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedArgValue(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setArgName(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRepresentative(value);
+      msg.setWarningMessage(value);
       break;
     default:
       reader.skipField();
@@ -4365,9 +5404,9 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkWarning.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4375,112 +5414,51 @@ proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionArg} message
+ * @param {!proto.api_container_api.StarlarkWarning} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkWarning.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedArgValue();
+  f = message.getWarningMessage();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getIsRepresentative();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string serialized_arg_value = 1;
+ * optional string warning_message = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
+proto.api_container_api.StarlarkWarning.prototype.getWarningMessage = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * @return {!proto.api_container_api.StarlarkWarning} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
+proto.api_container_api.StarlarkWarning.prototype.setWarningMessage = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string arg_name = 2;
- * @return {string}
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
-  return jspb.Message.setField(this, 2, value);
-};
-
+proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
 
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
-  return jspb.Message.setField(this, 2, undefined);
-};
 
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional bool is_representative = 3;
- * @return {boolean}
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
  * Field names that are reserved in JavaScript and will be renamed to pb_name.
@@ -4493,8 +5471,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
 };
 
 
@@ -4503,15 +5481,18 @@ proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
   var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
+    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
+    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
+    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    isSkipped: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -4525,23 +5506,23 @@ proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * @return {!proto.api_container_api.StarlarkInstruction}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionPosition;
-  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkInstruction;
+  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * @return {!proto.api_container_api.StarlarkInstruction}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4549,16 +5530,26 @@ proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
+      var value = new proto.api_container_api.StarlarkInstructionPosition;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
+      msg.setPosition(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLine(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInstructionName(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setColumn(value);
+      var value = new proto.api_container_api.StarlarkInstructionArg;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
+      msg.addArguments(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutableInstruction(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsSkipped(value);
       break;
     default:
       reader.skipField();
@@ -4573,9 +5564,9 @@ proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4583,30 +5574,46 @@ proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} message
+ * @param {!proto.api_container_api.StarlarkInstruction} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFilename();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPosition();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
     );
   }
-  f = message.getLine();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getInstructionName();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getColumn();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getArgumentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       3,
+      f,
+      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutableInstruction();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getIsSkipped();
+  if (f) {
+    writer.writeBool(
+      5,
       f
     );
   }
@@ -4614,89 +5621,137 @@ proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = fu
 
 
 /**
- * optional string filename = 1;
+ * optional StarlarkInstructionPosition position = 1;
+ * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
+  return this.setPosition(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string instruction_name = 2;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional int32 line = 2;
- * @return {number}
+ * repeated StarlarkInstructionArg arguments = 3;
+ * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
- */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+ * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * optional int32 column = 3;
- * @return {number}
+ * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
+  return this.setArgumentsList([]);
 };
 
 
+/**
+ * optional string executable_instruction = 4;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
 
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
  */
-proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
+proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
 
 /**
- * @enum {number}
+ * optional bool is_skipped = 5;
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkError.ErrorCase = {
-  ERROR_NOT_SET: 0,
-  INTERPRETATION_ERROR: 1,
-  VALIDATION_ERROR: 2,
-  EXECUTION_ERROR: 3
+proto.api_container_api.StarlarkInstruction.prototype.getIsSkipped = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
+
 /**
- * @return {proto.api_container_api.StarlarkError.ErrorCase}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
  */
-proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
-  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
+proto.api_container_api.StarlarkInstruction.prototype.setIsSkipped = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
 
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -4710,8 +5765,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
 };
 
 
@@ -4720,15 +5775,13 @@ proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
   var f, obj = {
-    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
-    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
-    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
+    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4742,23 +5795,23 @@ proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
  */
-proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkError;
-  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkInstructionResult;
+  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
  */
-proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4766,19 +5819,8 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInterpretationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
-      msg.setInterpretationError(value);
-      break;
-    case 2:
-      var value = new proto.api_container_api.StarlarkValidationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
-      msg.setValidationError(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkExecutionError;
-      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
-      msg.setExecutionError(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedInstructionResult(value);
       break;
     default:
       reader.skipField();
@@ -4793,9 +5835,9 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4803,147 +5845,37 @@ proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkError} message
+ * @param {!proto.api_container_api.StarlarkInstructionResult} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInterpretationError();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getSerializedInstructionResult();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
-    );
-  }
-  f = message.getValidationError();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
-    );
-  }
-  f = message.getExecutionError();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional StarlarkInterpretationError interpretation_error = 1;
- * @return {?proto.api_container_api.StarlarkInterpretationError}
- */
-proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
- */
-proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
-  return this.setInterpretationError(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * optional StarlarkValidationError validation_error = 2;
- * @return {?proto.api_container_api.StarlarkValidationError}
- */
-proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
- */
-proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
-  return this.setValidationError(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional StarlarkExecutionError execution_error = 3;
- * @return {?proto.api_container_api.StarlarkExecutionError}
- */
-proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
-  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * optional string serialized_instruction_result = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
-  return this.setExecutionError(undefined);
+proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
  */
-proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -4963,8 +5895,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
 };
 
 
@@ -4973,13 +5905,15 @@ proto.api_container_api.StarlarkInterpretationError.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -4993,23 +5927,23 @@ proto.api_container_api.StarlarkInterpretationError.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInterpretationError;
-  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkInstructionArg;
+  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5018,7 +5952,15 @@ proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setSerializedArgValue(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setArgName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRepresentative(value);
       break;
     default:
       reader.skipField();
@@ -5033,9 +5975,9 @@ proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5043,40 +5985,108 @@ proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInterpretationError} message
+ * @param {!proto.api_container_api.StarlarkInstructionArg} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getSerializedArgValue();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getIsRepresentative();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string error_message = 1;
+ * optional string serialized_arg_value = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
+proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
+proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string arg_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool is_representative = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
@@ -5093,8 +6103,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
 };
 
 
@@ -5103,13 +6113,15 @@ proto.api_container_api.StarlarkValidationError.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -5123,23 +6135,23 @@ proto.api_container_api.StarlarkValidationError.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkValidationError;
-  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkInstructionPosition;
+  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5148,7 +6160,15 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setFilename(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLine(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setColumn(value);
       break;
     default:
       reader.skipField();
@@ -5163,9 +6183,9 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5173,40 +6193,117 @@ proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getFilename();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getLine();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getColumn();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string error_message = 1;
+ * optional string filename = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkValidationError} returns this
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
  */
-proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
+proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional int32 line = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 column = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkError.ErrorCase = {
+  ERROR_NOT_SET: 0,
+  INTERPRETATION_ERROR: 1,
+  VALIDATION_ERROR: 2,
+  EXECUTION_ERROR: 3
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkError.ErrorCase}
+ */
+proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
+  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
+};
 
 
 
@@ -5223,8 +6320,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
 };
 
 
@@ -5233,13 +6330,15 @@ proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
+    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
+    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5253,23 +6352,23 @@ proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @return {!proto.api_container_api.StarlarkError}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkExecutionError;
-  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkError;
+  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @return {!proto.api_container_api.StarlarkError}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5277,8 +6376,19 @@ proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      var value = new proto.api_container_api.StarlarkInterpretationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
+      msg.setInterpretationError(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkValidationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
+      msg.setValidationError(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkExecutionError;
+      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
+      msg.setExecutionError(value);
       break;
     default:
       reader.skipField();
@@ -5293,9 +6403,9 @@ proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5303,47 +6413,150 @@ proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkExecutionError} message
+ * @param {!proto.api_container_api.StarlarkError} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getInterpretationError();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
+    );
+  }
+  f = message.getValidationError();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutionError();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string error_message = 1;
- * @return {string}
+ * optional StarlarkInterpretationError interpretation_error = 1;
+ * @return {?proto.api_container_api.StarlarkInterpretationError}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
  */
-proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
+  return this.setInterpretationError(undefined);
 };
 
 
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional StarlarkValidationError validation_error = 2;
+ * @return {?proto.api_container_api.StarlarkValidationError}
  */
-proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
+proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
+  return this.setValidationError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional StarlarkExecutionError execution_error = 3;
+ * @return {?proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
+  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
+  return this.setExecutionError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -5360,8 +6573,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
 };
 
 
@@ -5370,15 +6583,13 @@ proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
   var f, obj = {
-    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5392,23 +6603,23 @@ proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunProgress;
-  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkInterpretationError;
+  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5417,15 +6628,7 @@ proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addCurrentStepInfo(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setTotalSteps(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setCurrentStepNumber(value);
+      msg.setErrorMessage(value);
       break;
     default:
       reader.skipField();
@@ -5440,9 +6643,9 @@ proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5450,106 +6653,37 @@ proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunProgress} message
+ * @param {!proto.api_container_api.StarlarkInterpretationError} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCurrentStepInfoList();
+  f = message.getErrorMessage();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getTotalSteps();
-  if (f !== 0) {
-    writer.writeUint32(
-      2,
-      f
-    );
-  }
-  f = message.getCurrentStepNumber();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-};
-
-
-/**
- * repeated string current_step_info = 1;
- * @return {!Array<string>}
- */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * optional string error_message = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
-  return this.setCurrentStepInfoList([]);
-};
-
-
-/**
- * optional uint32 total_steps = 2;
- * @return {number}
- */
-proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional uint32 current_step_number = 3;
- * @return {number}
- */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -5569,8 +6703,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
 };
 
 
@@ -5579,14 +6713,13 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
   var f, obj = {
-    isRunSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5600,23 +6733,23 @@ proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.StarlarkValidationError}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
-  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkValidationError;
+  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.StarlarkValidationError}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5624,12 +6757,8 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRunSuccessful(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedOutput(value);
+      msg.setErrorMessage(value);
       break;
     default:
       reader.skipField();
@@ -5644,9 +6773,9 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5654,23 +6783,16 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
+ * @param {!proto.api_container_api.StarlarkValidationError} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIsRunSuccessful();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
+  f = message.getErrorMessage();
+  if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
@@ -5678,56 +6800,20 @@ proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = funct
 
 
 /**
- * optional bool is_run_successful = 1;
- * @return {boolean}
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsRunSuccessful = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsRunSuccessful = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
-};
-
-
-/**
- * optional string serialized_output = 2;
+ * optional string error_message = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
-  return jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
-  return jspb.Message.setField(this, 2, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.api_container_api.StarlarkValidationError} returns this
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -5747,8 +6833,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
 };
 
 
@@ -5757,13 +6843,13 @@ proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5777,23 +6863,23 @@ proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * @return {!proto.api_container_api.StarlarkExecutionError}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesArgs;
-  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkExecutionError;
+  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * @return {!proto.api_container_api.StarlarkExecutionError}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5801,10 +6887,8 @@ proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceIdentifiersMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
       break;
     default:
       reader.skipField();
@@ -5819,9 +6903,9 @@ proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5829,41 +6913,47 @@ proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesArgs} message
+ * @param {!proto.api_container_api.StarlarkExecutionError} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifiersMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, bool> service_identifiers = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * optional string error_message = 1;
+ * @return {string}
  */
-proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkExecutionError} returns this
  */
-proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
-  this.getServiceIdentifiersMap().clear();
-  return this;};
+proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
 
 
 
@@ -5880,8 +6970,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
 };
 
 
@@ -5890,13 +6980,15 @@ proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
+    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -5910,23 +7002,23 @@ proto.api_container_api.GetServicesResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * @return {!proto.api_container_api.StarlarkRunProgress}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesResponse;
-  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkRunProgress;
+  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * @return {!proto.api_container_api.StarlarkRunProgress}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5934,10 +7026,16 @@ proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCurrentStepInfo(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTotalSteps(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCurrentStepNumber(value);
       break;
     default:
       reader.skipField();
@@ -5952,9 +7050,9 @@ proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5962,49 +7060,124 @@ proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesResponse} message
+ * @param {!proto.api_container_api.StarlarkRunProgress} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  f = message.getCurrentStepInfoList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getTotalSteps();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getCurrentStepNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, ServiceInfo> service_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
+ * repeated string current_step_info = 1;
+ * @return {!Array<string>}
  */
-proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.ServiceInfo));
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesResponse} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
-  this.getServiceInfoMap().clear();
-  return this;};
-
-
-
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
+  return this.setCurrentStepInfoList([]);
+};
+
+
+/**
+ * optional uint32 total_steps = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 current_step_number = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
  * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
  * For the list of reserved names please see:
  *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
@@ -6013,8 +7186,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -6023,15 +7196,16 @@ proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+    isRunSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    resolvedPackageDependenciesList: jspb.Message.toObjectList(msg.getResolvedPackageDependenciesList(),
+    proto.api_container_api.PackageDependency.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6045,23 +7219,23 @@ proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ServiceIdentifiers;
-  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
+  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6069,16 +7243,17 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRunSuccessful(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setSerializedOutput(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      var value = new proto.api_container_api.PackageDependency;
+      reader.readMessage(value,proto.api_container_api.PackageDependency.deserializeBinaryFromReader);
+      msg.addResolvedPackageDependencies(value);
       break;
     default:
       reader.skipField();
@@ -6093,9 +7268,9 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6103,87 +7278,126 @@ proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ServiceIdentifiers} message
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getIsRunSuccessful();
+  if (f) {
+    writer.writeBool(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getShortenedUuid();
+  f = message.getResolvedPackageDependenciesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       3,
-      f
+      f,
+      proto.api_container_api.PackageDependency.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string service_uuid = 1;
- * @return {string}
+ * optional bool is_run_successful = 1;
+ * @return {boolean}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsRunSuccessful = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsRunSuccessful = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string serialized_output = 2;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional string shortened_uuid = 3;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * repeated PackageDependency resolved_package_dependencies = 3;
+ * @return {!Array<!proto.api_container_api.PackageDependency>}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getResolvedPackageDependenciesList = function() {
+  return /** @type{!Array<!proto.api_container_api.PackageDependency>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.PackageDependency, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.PackageDependency>} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+*/
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setResolvedPackageDependenciesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.PackageDependency=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.PackageDependency}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.addResolvedPackageDependencies = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.PackageDependency, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearResolvedPackageDependenciesList = function() {
+  return this.setResolvedPackageDependenciesList([]);
 };
 
 
@@ -6193,7 +7407,7 @@ proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
+proto.api_container_api.StarlarkPlanDiff.repeatedFields_ = [1,2,3,4];
 
 
 
@@ -6210,8 +7424,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkPlanDiff.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkPlanDiff.toObject(opt_includeInstance, this);
 };
 
 
@@ -6220,14 +7434,20 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkPlanDiff} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkPlanDiff.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
-    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
+    serviceChangesList: jspb.Message.toObjectList(msg.getServiceChangesList(),
+    proto.api_container_api.StarlarkPlanDiffComponentChange.toObject, includeInstance),
+    filesArtifactChangesList: jspb.Message.toObjectList(msg.getFilesArtifactChangesList(),
+    proto.api_container_api.StarlarkPlanDiffComponentChange.toObject, includeInstance),
+    instructionsToExecuteList: jspb.Message.toObjectList(msg.getInstructionsToExecuteList(),
+    proto.api_container_api.StarlarkInstruction.toObject, includeInstance),
+    skippedInstructionsList: jspb.Message.toObjectList(msg.getSkippedInstructionsList(),
+    proto.api_container_api.StarlarkInstruction.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6241,23 +7461,23 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObj
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.StarlarkPlanDiff}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkPlanDiff.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkPlanDiff;
+  return proto.api_container_api.StarlarkPlanDiff.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkPlanDiff} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.StarlarkPlanDiff}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkPlanDiff.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6265,9 +7485,24 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.ServiceIdentifiers;
-      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
-      msg.addAllidentifiers(value);
+      var value = new proto.api_container_api.StarlarkPlanDiffComponentChange;
+      reader.readMessage(value,proto.api_container_api.StarlarkPlanDiffComponentChange.deserializeBinaryFromReader);
+      msg.addServiceChanges(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkPlanDiffComponentChange;
+      reader.readMessage(value,proto.api_container_api.StarlarkPlanDiffComponentChange.deserializeBinaryFromReader);
+      msg.addFilesArtifactChanges(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkInstruction;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
+      msg.addInstructionsToExecute(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.StarlarkInstruction;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
+      msg.addSkippedInstructions(value);
       break;
     default:
       reader.skipField();
@@ -6282,9 +7517,9 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkPlanDiff.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkPlanDiff.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6292,68 +7527,199 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
+ * @param {!proto.api_container_api.StarlarkPlanDiff} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkPlanDiff.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllidentifiersList();
+  f = message.getServiceChangesList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
+      proto.api_container_api.StarlarkPlanDiffComponentChange.serializeBinaryToWriter
+    );
+  }
+  f = message.getFilesArtifactChangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkPlanDiffComponentChange.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionsToExecuteList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+    );
+  }
+  f = message.getSkippedInstructionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated ServiceIdentifiers allIdentifiers = 1;
- * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
+ * repeated StarlarkPlanDiffComponentChange service_changes = 1;
+ * @return {!Array<!proto.api_container_api.StarlarkPlanDiffComponentChange>}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
-  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
+proto.api_container_api.StarlarkPlanDiff.prototype.getServiceChangesList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkPlanDiffComponentChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkPlanDiffComponentChange, 1));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ * @param {!Array<!proto.api_container_api.StarlarkPlanDiffComponentChange>} value
+ * @return {!proto.api_container_api.StarlarkPlanDiff} returns this
 */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+proto.api_container_api.StarlarkPlanDiff.prototype.setServiceChangesList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
+ * @param {!proto.api_container_api.StarlarkPlanDiffComponentChange=} opt_value
  * @param {number=} opt_index
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.StarlarkPlanDiffComponentChange}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
+proto.api_container_api.StarlarkPlanDiff.prototype.addServiceChanges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.StarlarkPlanDiffComponentChange, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ * @return {!proto.api_container_api.StarlarkPlanDiff} returns this
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
-  return this.setAllidentifiersList([]);
+proto.api_container_api.StarlarkPlanDiff.prototype.clearServiceChangesList = function() {
+  return this.setServiceChangesList([]);
+};
+
+
+/**
+ * repeated StarlarkPlanDiffComponentChange files_artifact_changes = 2;
+ * @return {!Array<!proto.api_container_api.StarlarkPlanDiffComponentChange>}
+ */
+proto.api_container_api.StarlarkPlanDiff.prototype.getFilesArtifactChangesList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkPlanDiffComponentChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkPlanDiffComponentChange, 2));
 };
 
 
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkPlanDiffComponentChange>} value
+ * @return {!proto.api_container_api.StarlarkPlanDiff} returns this
+*/
+proto.api_container_api.StarlarkPlanDiff.prototype.setFilesArtifactChangesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {!proto.api_container_api.StarlarkPlanDiffComponentChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkPlanDiffComponentChange}
  */
-proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
+proto.api_container_api.StarlarkPlanDiff.prototype.addFilesArtifactChanges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.api_container_api.StarlarkPlanDiffComponentChange, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkPlanDiff} returns this
+ */
+proto.api_container_api.StarlarkPlanDiff.prototype.clearFilesArtifactChangesList = function() {
+  return this.setFilesArtifactChangesList([]);
+};
+
+
+/**
+ * repeated StarlarkInstruction instructions_to_execute = 3;
+ * @return {!Array<!proto.api_container_api.StarlarkInstruction>}
+ */
+proto.api_container_api.StarlarkPlanDiff.prototype.getInstructionsToExecuteList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkInstruction>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstruction, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkInstruction>} value
+ * @return {!proto.api_container_api.StarlarkPlanDiff} returns this
+*/
+proto.api_container_api.StarlarkPlanDiff.prototype.setInstructionsToExecuteList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkInstruction=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkPlanDiff.prototype.addInstructionsToExecute = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstruction, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkPlanDiff} returns this
+ */
+proto.api_container_api.StarlarkPlanDiff.prototype.clearInstructionsToExecuteList = function() {
+  return this.setInstructionsToExecuteList([]);
+};
+
+
+/**
+ * repeated StarlarkInstruction skipped_instructions = 4;
+ * @return {!Array<!proto.api_container_api.StarlarkInstruction>}
+ */
+proto.api_container_api.StarlarkPlanDiff.prototype.getSkippedInstructionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkInstruction>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstruction, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkInstruction>} value
+ * @return {!proto.api_container_api.StarlarkPlanDiff} returns this
+*/
+proto.api_container_api.StarlarkPlanDiff.prototype.setSkippedInstructionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkInstruction=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkPlanDiff.prototype.addSkippedInstructions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.api_container_api.StarlarkInstruction, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkPlanDiff} returns this
+ */
+proto.api_container_api.StarlarkPlanDiff.prototype.clearSkippedInstructionsList = function() {
+  return this.setSkippedInstructionsList([]);
+};
+
+
 
 
 
@@ -6370,8 +7736,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkPlanDiffComponentChange.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkPlanDiffComponentChange.toObject(opt_includeInstance, this);
 };
 
 
@@ -6380,14 +7746,14 @@ proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkPlanDiffComponentChange} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkPlanDiffComponentChange.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    changeType: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -6401,23 +7767,23 @@ proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.StarlarkPlanDiffComponentChange}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkPlanDiffComponentChange.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandArgs;
-  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkPlanDiffComponentChange;
+  return proto.api_container_api.StarlarkPlanDiffComponentChange.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkPlanDiffComponentChange} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.StarlarkPlanDiffComponentChange}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkPlanDiffComponentChange.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6426,11 +7792,11 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setName(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addCommandArgs(value);
+      var value = /** @type {!proto.api_container_api.StarlarkPlanDiffChangeType} */ (reader.readEnum());
+      msg.setChangeType(value);
       break;
     default:
       reader.skipField();
@@ -6445,9 +7811,9 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkPlanDiffComponentChange.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkPlanDiffComponentChange.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6455,22 +7821,22 @@ proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandArgs} message
+ * @param {!proto.api_container_api.StarlarkPlanDiffComponentChange} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkPlanDiffComponentChange.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getCommandArgsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getChangeType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
@@ -6479,57 +7845,38 @@ proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(messa
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.StarlarkPlanDiffComponentChange.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * @return {!proto.api_container_api.StarlarkPlanDiffComponentChange} returns this
  */
-proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.StarlarkPlanDiffComponentChange.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated string command_args = 2;
- * @return {!Array<string>}
- */
-proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
- */
-proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * optional StarlarkPlanDiffChangeType change_type = 2;
+ * @return {!proto.api_container_api.StarlarkPlanDiffChangeType}
  */
-proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.api_container_api.StarlarkPlanDiffComponentChange.prototype.getChangeType = function() {
+  return /** @type {!proto.api_container_api.StarlarkPlanDiffChangeType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * @param {!proto.api_container_api.StarlarkPlanDiffChangeType} value
+ * @return {!proto.api_container_api.StarlarkPlanDiffComponentChange} returns this
  */
-proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
-  return this.setCommandArgsList([]);
+proto.api_container_api.StarlarkPlanDiffComponentChange.prototype.setChangeType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
@@ -6549,8 +7896,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.PackageDependency.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PackageDependency.toObject(opt_includeInstance, this);
 };
 
 
@@ -6559,14 +7906,14 @@ proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PackageDependency} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.PackageDependency.toObject = function(includeInstance, msg) {
   var f, obj = {
-    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    repository: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    commit: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -6580,23 +7927,23 @@ proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * @return {!proto.api_container_api.PackageDependency}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.PackageDependency.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandResponse;
-  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PackageDependency;
+  return proto.api_container_api.PackageDependency.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PackageDependency} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * @return {!proto.api_container_api.PackageDependency}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PackageDependency.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6604,12 +7951,12 @@ proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setExitCode(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRepository(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setLogOutput(value);
+      msg.setCommit(value);
       break;
     default:
       reader.skipField();
@@ -6624,9 +7971,9 @@ proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
+proto.api_container_api.PackageDependency.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PackageDependency.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6634,20 +7981,20 @@ proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandResponse} message
+ * @param {!proto.api_container_api.PackageDependency} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PackageDependency.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getExitCode();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getRepository();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getLogOutput();
+  f = message.getCommit();
   if (f.length > 0) {
     writer.writeString(
       2,
@@ -6658,37 +8005,37 @@ proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(m
 
 
 /**
- * optional int32 exit_code = 1;
- * @return {number}
+ * optional string repository = 1;
+ * @return {string}
  */
-proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.api_container_api.PackageDependency.prototype.getRepository = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.PackageDependency} returns this
  */
-proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.api_container_api.PackageDependency.prototype.setRepository = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string log_output = 2;
+ * optional string commit = 2;
  * @return {string}
  */
-proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
+proto.api_container_api.PackageDependency.prototype.getCommit = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ * @return {!proto.api_container_api.PackageDependency} returns this
  */
-proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
+proto.api_container_api.PackageDependency.prototype.setCommit = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
@@ -6709,8 +8056,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6719,19 +8066,13 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -6745,23 +8086,23 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.GetServicesArgs}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetServicesArgs;
+  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.GetServicesArgs}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6769,32 +8110,10 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
       break;
     default:
       reader.skipField();
@@ -6809,9 +8128,9 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6819,188 +8138,172 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.GetServicesArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
-      2,
-      f
-    );
-  }
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getInitialDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      4,
-      f
-    );
-  }
-  f = message.getRetries();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getRetriesDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = message.getBodyText();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string service_identifier = 1;
- * @return {string}
- */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * optional uint32 port = 2;
- * @return {number}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
+proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
 
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
 
 
-/**
- * optional string path = 3;
- * @return {string}
- */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional uint32 initial_delay_milliseconds = 4;
- * @return {number}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
+proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
+  };
 
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint32 retries = 5;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServicesResponse}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServicesResponse;
+  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServicesResponse}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional uint32 retries_delay_milliseconds = 6;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  }
 };
 
 
 /**
- * optional string body_text = 7;
- * @return {string}
+ * map<string, ServiceInfo> service_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceInfo));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesResponse} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
-};
+proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
+  this.getServiceInfoMap().clear();
+  return this;};
 
 
 
@@ -7019,8 +8322,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
 };
 
 
@@ -7029,20 +8332,15 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObje
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -7056,23 +8354,23 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = funct
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ServiceIdentifiers;
+  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7081,35 +8379,15 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setServiceUuid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
-      break;
-    case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setRequestBody(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
+      msg.setName(value);
       break;
-    case 8:
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      msg.setShortenedUuid(value);
       break;
     default:
       reader.skipField();
@@ -7124,9 +8402,9 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7134,65 +8412,30 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serial
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.ServiceIdentifiers} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getServiceUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
-      2,
-      f
-    );
-  }
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getRequestBody();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getInitialDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getRetries();
-  if (f !== 0) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = message.getRetriesDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      7,
+      2,
       f
     );
   }
-  f = message.getBodyText();
+  f = message.getShortenedUuid();
   if (f.length > 0) {
     writer.writeString(
-      8,
+      3,
       f
     );
   }
@@ -7200,149 +8443,66 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryT
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string service_uuid = 1;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 port = 2;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string path = 3;
+ * optional string name = 2;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string request_body = 4;
+ * optional string shortened_uuid = 3;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional uint32 initial_delay_milliseconds = 5;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
-};
-
-
-/**
- * optional uint32 retries = 6;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
-};
-
-
-/**
- * optional uint32 retries_delay_milliseconds = 7;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
-/**
- * optional string body_text = 8;
- * @return {string}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
-};
-
-
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
 
 
 
@@ -7359,8 +8519,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StreamedDataChunk.toObject(opt_includeInstance, this);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7369,15 +8529,14 @@ proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StreamedDataChunk} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    data: msg.getData_asB64(),
-    previousChunkHash: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    metadata: (f = msg.getMetadata()) && proto.api_container_api.DataChunkMetadata.toObject(includeInstance, f)
+    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
+    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -7391,23 +8550,23 @@ proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StreamedDataChunk}
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
  */
-proto.api_container_api.StreamedDataChunk.deserializeBinary = function(bytes) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StreamedDataChunk;
-  return proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StreamedDataChunk} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StreamedDataChunk}
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
  */
-proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7415,17 +8574,9 @@ proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPreviousChunkHash(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.DataChunkMetadata;
-      reader.readMessage(value,proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader);
-      msg.setMetadata(value);
+      var value = new proto.api_container_api.ServiceIdentifiers;
+      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
+      msg.addAllidentifiers(value);
       break;
     default:
       reader.skipField();
@@ -7440,9 +8591,9 @@ proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function() {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7450,134 +8601,68 @@ proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StreamedDataChunk} message
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getData_asU8();
+  f = message.getAllidentifiersList();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
     );
   }
-  f = message.getPreviousChunkHash();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getMetadata();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional bytes data = 1;
- * @return {string}
- */
-proto.api_container_api.StreamedDataChunk.prototype.getData = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * optional bytes data = 1;
- * This is a type-conversion wrapper around `getData()`
- * @return {string}
- */
-proto.api_container_api.StreamedDataChunk.prototype.getData_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
-};
-
-
-/**
- * optional bytes data = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
- * @return {!Uint8Array}
- */
-proto.api_container_api.StreamedDataChunk.prototype.getData_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ * repeated ServiceIdentifiers allIdentifiers = 1;
+ * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
  */
-proto.api_container_api.StreamedDataChunk.prototype.setData = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
+  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
 };
 
 
 /**
- * optional string previous_chunk_hash = 2;
- * @return {string}
- */
-proto.api_container_api.StreamedDataChunk.prototype.getPreviousChunkHash = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+ * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+*/
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.StreamedDataChunk.prototype.setPreviousChunkHash = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
 };
 
 
 /**
- * optional DataChunkMetadata metadata = 3;
- * @return {?proto.api_container_api.DataChunkMetadata}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
  */
-proto.api_container_api.StreamedDataChunk.prototype.getMetadata = function() {
-  return /** @type{?proto.api_container_api.DataChunkMetadata} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.DataChunkMetadata, 3));
-};
-
-
-/**
- * @param {?proto.api_container_api.DataChunkMetadata|undefined} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
-*/
-proto.api_container_api.StreamedDataChunk.prototype.setMetadata = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
+  return this.setAllidentifiersList([]);
 };
 
 
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
- */
-proto.api_container_api.StreamedDataChunk.prototype.clearMetadata = function() {
-  return this.setMetadata(undefined);
-};
-
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.StreamedDataChunk.prototype.hasMetadata = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
-
-
+proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
 
 
 
@@ -7594,8 +8679,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DataChunkMetadata.toObject(opt_includeInstance, this);
+proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -7604,13 +8689,14 @@ proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DataChunkMetadata} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, msg) {
+proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -7624,23 +8710,23 @@ proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DataChunkMetadata}
+ * @return {!proto.api_container_api.ExecCommandArgs}
  */
-proto.api_container_api.DataChunkMetadata.deserializeBinary = function(bytes) {
+proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DataChunkMetadata;
-  return proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ExecCommandArgs;
+  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.DataChunkMetadata} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DataChunkMetadata}
+ * @return {!proto.api_container_api.ExecCommandArgs}
  */
-proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7649,7 +8735,11 @@ proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCommandArgs(value);
       break;
     default:
       reader.skipField();
@@ -7664,9 +8754,9 @@ proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function() {
+proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7674,40 +8764,84 @@ proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DataChunkMetadata} message
+ * @param {!proto.api_container_api.ExecCommandArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getCommandArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.DataChunkMetadata.prototype.getName = function() {
+proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.DataChunkMetadata} returns this
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
  */
-proto.api_container_api.DataChunkMetadata.prototype.setName = function(value) {
+proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * repeated string command_args = 2;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
+  return this.setCommandArgsList([]);
+};
+
+
 
 
 
@@ -7724,8 +8858,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7734,14 +8868,14 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -7755,23 +8889,23 @@ proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.ExecCommandResponse}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
-  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ExecCommandResponse;
+  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.ExecCommandResponse}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7779,12 +8913,12 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setLogOutput(value);
       break;
     default:
       reader.skipField();
@@ -7799,9 +8933,9 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7809,20 +8943,20 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
+ * @param {!proto.api_container_api.ExecCommandResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt32(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getLogOutput();
   if (f.length > 0) {
     writer.writeString(
       2,
@@ -7833,37 +8967,37 @@ proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = fu
 
 
 /**
- * optional string uuid = 1;
- * @return {string}
- */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+ * optional int32 exit_code = 1;
+ * @return {number}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string log_output = 2;
  * @return {string}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
+proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
+proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
@@ -7884,8 +9018,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -7894,13 +9028,19 @@ proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -7914,23 +9054,23 @@ proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
-  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7939,7 +9079,31 @@ proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setIdentifier(value);
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
       break;
     default:
       reader.skipField();
@@ -7954,9 +9118,9 @@ proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7964,197 +9128,187 @@ proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIdentifier();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getInitialDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getRetries();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getRetriesDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getBodyText();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string identifier = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional uint32 port = 2;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string path = 3;
+ * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
-  };
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * optional uint32 initial_delay_milliseconds = 4;
+ * @return {number}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
-  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional uint32 retries = 5;
+ * @return {number}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUrl();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional string url = 1;
- * @return {string}
+ * optional uint32 retries_delay_milliseconds = 6;
+ * @return {number}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string body_text = 7;
  * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
@@ -8174,8 +9328,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8184,13 +9338,20 @@ proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
   };
 
   if (includeInstance) {
@@ -8204,23 +9365,23 @@ proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
-  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8229,7 +9390,35 @@ proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReade
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequestBody(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
       break;
     default:
       reader.skipField();
@@ -8244,9 +9433,9 @@ proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8254,227 +9443,212 @@ proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getRequestBody();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getInitialDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getRetries();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getRetriesDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getBodyText();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string uuid = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional uint32 port = 2;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
 
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string path = 3;
+ * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 3, "")
-  };
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * optional string request_body = 4;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * optional uint32 initial_delay_milliseconds = 5;
+ * @return {number}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSourcePath(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getServiceIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getSourcePath();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional string service_identifier = 1;
- * @return {string}
+ * optional uint32 retries = 6;
+ * @return {number}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * optional string source_path = 2;
- * @return {string}
+ * optional uint32 retries_delay_milliseconds = 7;
+ * @return {number}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * optional string name = 3;
+ * optional string body_text = 8;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
@@ -8494,8 +9668,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StreamedDataChunk.toObject(opt_includeInstance, this);
 };
 
 
@@ -8504,13 +9678,15 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StreamedDataChunk} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    data: msg.getData_asB64(),
+    previousChunkHash: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    metadata: (f = msg.getMetadata()) && proto.api_container_api.DataChunkMetadata.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -8524,23 +9700,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.StreamedDataChunk}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StreamedDataChunk.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StreamedDataChunk;
+  return proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StreamedDataChunk} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.StreamedDataChunk}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8548,8 +9724,17 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setPreviousChunkHash(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.DataChunkMetadata;
+      reader.readMessage(value,proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
       break;
     default:
       reader.skipField();
@@ -8564,9 +9749,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8574,208 +9759,135 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
+ * @param {!proto.api_container_api.StreamedDataChunk} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getData_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
   }
+  f = message.getPreviousChunkHash();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string uuid = 1;
+ * optional bytes data = 1;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
+proto.api_container_api.StreamedDataChunk.prototype.getData = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.StreamedDataChunk.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
 };
 
 
+/**
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StreamedDataChunk.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.FilesArtifactNameAndUuid.toObject(opt_includeInstance, this);
+proto.api_container_api.StreamedDataChunk.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string previous_chunk_hash = 2;
+ * @return {string}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    fileuuid: jspb.Message.getFieldWithDefault(msg, 2, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.StreamedDataChunk.prototype.getPreviousChunkHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * @param {string} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.FilesArtifactNameAndUuid;
-  return proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.StreamedDataChunk.prototype.setPreviousChunkHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * optional DataChunkMetadata metadata = 3;
+ * @return {?proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFileuuid(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getFilename();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getFileuuid();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string fileName = 1;
- * @return {string}
- */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFilename = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.StreamedDataChunk.prototype.getMetadata = function() {
+  return /** @type{?proto.api_container_api.DataChunkMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.DataChunkMetadata, 3));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
- */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFilename = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {?proto.api_container_api.DataChunkMetadata|undefined} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
+*/
+proto.api_container_api.StreamedDataChunk.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * optional string fileUuid = 2;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFileuuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.StreamedDataChunk.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.StreamedDataChunk.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8791,8 +9903,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DataChunkMetadata.toObject(opt_includeInstance, this);
 };
 
 
@@ -8801,14 +9913,13 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DataChunkMetadata} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileNamesAndUuidsList: jspb.Message.toObjectList(msg.getFileNamesAndUuidsList(),
-    proto.api_container_api.FilesArtifactNameAndUuid.toObject, includeInstance)
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -8822,23 +9933,23 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ * @return {!proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.DataChunkMetadata.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse;
-  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DataChunkMetadata;
+  return proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DataChunkMetadata} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ * @return {!proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8846,9 +9957,8 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
-      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
-      msg.addFileNamesAndUuids(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -8863,9 +9973,9 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.serializeBinary = function() {
+proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8873,58 +9983,37 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} message
+ * @param {!proto.api_container_api.DataChunkMetadata} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileNamesAndUuidsList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated FilesArtifactNameAndUuid file_names_and_uuids = 1;
- * @return {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>}
- */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getFileNamesAndUuidsList = function() {
-  return /** @type{!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
-};
-
-
-/**
- * @param {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} value
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
-*/
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setFileNamesAndUuidsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.addFileNamesAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactNameAndUuid, opt_index);
+proto.api_container_api.DataChunkMetadata.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.DataChunkMetadata} returns this
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearFileNamesAndUuidsList = function() {
-  return this.setFileNamesAndUuidsList([]);
+proto.api_container_api.DataChunkMetadata.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -8944,8 +10033,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.InspectFilesArtifactContentsRequest.toObject(opt_includeInstance, this);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8954,13 +10043,14 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The msg instance to transform.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(includeInstance, msg) {
+proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileNamesAndUuid: (f = msg.getFileNamesAndUuid()) && proto.api_container_api.FilesArtifactNameAndUuid.toObject(includeInstance, f)
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -8974,23 +10064,23 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinary = function(bytes) {
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.InspectFilesArtifactContentsRequest;
-  return proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
+  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8998,9 +10088,12 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFro
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
-      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
-      msg.setFileNamesAndUuid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -9015,9 +10108,9 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeBinary = function() {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9025,68 +10118,66 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} message
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileNamesAndUuid();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * optional FilesArtifactNameAndUuid file_names_and_uuid = 1;
- * @return {?proto.api_container_api.FilesArtifactNameAndUuid}
+ * optional string uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.getFileNamesAndUuid = function() {
-  return /** @type{?proto.api_container_api.FilesArtifactNameAndUuid} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.api_container_api.FilesArtifactNameAndUuid|undefined} value
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
-*/
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.setFileNamesAndUuid = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ */
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+ * optional string name = 2;
+ * @return {string}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.clearFileNamesAndUuid = function() {
-  return this.setFileNamesAndUuid(undefined);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.hasFileNamesAndUuid = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.InspectFilesArtifactContentsResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9102,8 +10193,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.InspectFilesArtifactContentsResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -9112,14 +10203,14 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileDescriptionsList: jspb.Message.toObjectList(msg.getFileDescriptionsList(),
-    proto.api_container_api.FileArtifactContentsFileDescription.toObject, includeInstance)
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -9133,23 +10224,23 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.InspectFilesArtifactContentsResponse;
-  return proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
+  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9157,9 +10248,12 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFr
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FileArtifactContentsFileDescription;
-      reader.readMessage(value,proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader);
-      msg.addFileDescriptions(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setVersion(value);
       break;
     default:
       reader.skipField();
@@ -9174,9 +10268,9 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFr
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serializeBinary = function() {
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9184,58 +10278,5129 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serialize
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} message
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileDescriptionsList();
+  f = message.getIdentifier();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeUint32(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated FileArtifactContentsFileDescription file_descriptions = 1;
- * @return {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>}
+ * optional string identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.getFileDescriptionsList = function() {
-  return /** @type{!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FileArtifactContentsFileDescription, 1));
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} value
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
-*/
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.setFileDescriptionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
+ */
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * optional uint32 version = 2;
+ * @return {number}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.addFileDescriptions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FileArtifactContentsFileDescription, opt_index);
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
+ */
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.setVersion = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
+ */
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.clearVersion = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.hasVersion = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
+  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string url = 1;
+ * @return {string}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ */
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
+  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ */
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string uuid = 1;
+ * @return {string}
+ */
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSourcePath(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSourcePath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string source_path = 2;
+ * @return {string}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string uuid = 1;
+ * @return {string}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.CopyFilesToServiceChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.CopyFilesToServiceChunk.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.CopyFilesToServiceChunk} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.CopyFilesToServiceChunk.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    chunk: (f = msg.getChunk()) && proto.api_container_api.StreamedDataChunk.toObject(includeInstance, f),
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    destinationPath: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.CopyFilesToServiceChunk}
+ */
+proto.api_container_api.CopyFilesToServiceChunk.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.CopyFilesToServiceChunk;
+  return proto.api_container_api.CopyFilesToServiceChunk.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.CopyFilesToServiceChunk} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.CopyFilesToServiceChunk}
+ */
+proto.api_container_api.CopyFilesToServiceChunk.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StreamedDataChunk;
+      reader.readMessage(value,proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader);
+      msg.setChunk(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDestinationPath(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.CopyFilesToServiceChunk.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.CopyFilesToServiceChunk.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.CopyFilesToServiceChunk} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.CopyFilesToServiceChunk.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getChunk();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter
+    );
+  }
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDestinationPath();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional StreamedDataChunk chunk = 1;
+ * @return {?proto.api_container_api.StreamedDataChunk}
+ */
+proto.api_container_api.CopyFilesToServiceChunk.prototype.getChunk = function() {
+  return /** @type{?proto.api_container_api.StreamedDataChunk} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StreamedDataChunk, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StreamedDataChunk|undefined} value
+ * @return {!proto.api_container_api.CopyFilesToServiceChunk} returns this
+*/
+proto.api_container_api.CopyFilesToServiceChunk.prototype.setChunk = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.CopyFilesToServiceChunk} returns this
+ */
+proto.api_container_api.CopyFilesToServiceChunk.prototype.clearChunk = function() {
+  return this.setChunk(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.CopyFilesToServiceChunk.prototype.hasChunk = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string service_identifier = 2;
+ * @return {string}
+ */
+proto.api_container_api.CopyFilesToServiceChunk.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.CopyFilesToServiceChunk} returns this
+ */
+proto.api_container_api.CopyFilesToServiceChunk.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string destination_path = 3;
+ * @return {string}
+ */
+proto.api_container_api.CopyFilesToServiceChunk.prototype.getDestinationPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.CopyFilesToServiceChunk} returns this
+ */
+proto.api_container_api.CopyFilesToServiceChunk.prototype.setDestinationPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.CopyFilesArtifactToServiceArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.CopyFilesArtifactToServiceArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    filesArtifactIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    destinationPath: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.CopyFilesArtifactToServiceArgs}
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.CopyFilesArtifactToServiceArgs;
+  return proto.api_container_api.CopyFilesArtifactToServiceArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.CopyFilesArtifactToServiceArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.CopyFilesArtifactToServiceArgs}
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilesArtifactIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDestinationPath(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.CopyFilesArtifactToServiceArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.CopyFilesArtifactToServiceArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFilesArtifactIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDestinationPath();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string files_artifact_identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.prototype.getFilesArtifactIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.CopyFilesArtifactToServiceArgs} returns this
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.prototype.setFilesArtifactIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string service_identifier = 2;
+ * @return {string}
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.CopyFilesArtifactToServiceArgs} returns this
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string destination_path = 3;
+ * @return {string}
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.prototype.getDestinationPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.CopyFilesArtifactToServiceArgs} returns this
+ */
+proto.api_container_api.CopyFilesArtifactToServiceArgs.prototype.setDestinationPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.CopyFilesFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.CopyFilesFromServiceArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.CopyFilesFromServiceArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.CopyFilesFromServiceArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.CopyFilesFromServiceArgs}
+ */
+proto.api_container_api.CopyFilesFromServiceArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.CopyFilesFromServiceArgs;
+  return proto.api_container_api.CopyFilesFromServiceArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.CopyFilesFromServiceArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.CopyFilesFromServiceArgs}
+ */
+proto.api_container_api.CopyFilesFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSourcePath(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.CopyFilesFromServiceArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.CopyFilesFromServiceArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.CopyFilesFromServiceArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.CopyFilesFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSourcePath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.CopyFilesFromServiceArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.CopyFilesFromServiceArgs} returns this
+ */
+proto.api_container_api.CopyFilesFromServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string source_path = 2;
+ * @return {string}
+ */
+proto.api_container_api.CopyFilesFromServiceArgs.prototype.getSourcePath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.CopyFilesFromServiceArgs} returns this
+ */
+proto.api_container_api.CopyFilesFromServiceArgs.prototype.setSourcePath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FilesArtifactNameAndUuid.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    fileuuid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    size: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.FilesArtifactNameAndUuid;
+  return proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFileuuid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSize(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getFileuuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string fileName = 1;
+ * @return {string}
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string fileUuid = 2;
+ * @return {string}
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFileuuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 size = 3;
+ * @return {number}
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    fileNamesAndUuidsList: jspb.Message.toObjectList(msg.getFileNamesAndUuidsList(),
+    proto.api_container_api.FilesArtifactNameAndUuid.toObject, includeInstance),
+    totalSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    storageQuota: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse;
+  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
+      msg.addFileNamesAndUuids(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTotalSize(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setStorageQuota(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFileNamesAndUuidsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+    );
+  }
+  f = message.getTotalSize();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated FilesArtifactNameAndUuid file_names_and_uuids = 1;
+ * @return {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getFileNamesAndUuidsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} value
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+*/
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setFileNamesAndUuidsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.addFileNamesAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactNameAndUuid, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearFileNamesAndUuidsList = function() {
+  return this.setFileNamesAndUuidsList([]);
+};
+
+
+/**
+ * optional uint64 total_size = 2;
+ * @return {number}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getTotalSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setTotalSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 storage_quota = 3;
+ * @return {number}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getStorageQuota = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setStorageQuota = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearStorageQuota = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.hasStorageQuota = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RemoveFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RemoveFilesArtifactArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RemoveFilesArtifactArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RemoveFilesArtifactArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RemoveFilesArtifactArgs}
+ */
+proto.api_container_api.RemoveFilesArtifactArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RemoveFilesArtifactArgs;
+  return proto.api_container_api.RemoveFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RemoveFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RemoveFilesArtifactArgs}
+ */
+proto.api_container_api.RemoveFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RemoveFilesArtifactArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RemoveFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RemoveFilesArtifactArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RemoveFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.RemoveFilesArtifactArgs.prototype.getIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RemoveFilesArtifactArgs} returns this
+ */
+proto.api_container_api.RemoveFilesArtifactArgs.prototype.setIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ListFilesArtifactVersionsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ListFilesArtifactVersionsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ListFilesArtifactVersionsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ListFilesArtifactVersionsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ListFilesArtifactVersionsArgs}
+ */
+proto.api_container_api.ListFilesArtifactVersionsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ListFilesArtifactVersionsArgs;
+  return proto.api_container_api.ListFilesArtifactVersionsArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ListFilesArtifactVersionsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ListFilesArtifactVersionsArgs}
+ */
+proto.api_container_api.ListFilesArtifactVersionsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ListFilesArtifactVersionsArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ListFilesArtifactVersionsArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ListFilesArtifactVersionsArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ListFilesArtifactVersionsArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.ListFilesArtifactVersionsArgs.prototype.getIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ListFilesArtifactVersionsArgs} returns this
+ */
+proto.api_container_api.ListFilesArtifactVersionsArgs.prototype.setIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ListFilesArtifactVersionsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ListFilesArtifactVersionsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    versionsList: jspb.Message.toObjectList(msg.getVersionsList(),
+    proto.api_container_api.FilesArtifactVersion.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ListFilesArtifactVersionsResponse}
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ListFilesArtifactVersionsResponse;
+  return proto.api_container_api.ListFilesArtifactVersionsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ListFilesArtifactVersionsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ListFilesArtifactVersionsResponse}
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.FilesArtifactVersion;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactVersion.deserializeBinaryFromReader);
+      msg.addVersions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ListFilesArtifactVersionsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ListFilesArtifactVersionsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVersionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.FilesArtifactVersion.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated FilesArtifactVersion versions = 1;
+ * @return {!Array<!proto.api_container_api.FilesArtifactVersion>}
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse.prototype.getVersionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FilesArtifactVersion>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactVersion, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.FilesArtifactVersion>} value
+ * @return {!proto.api_container_api.ListFilesArtifactVersionsResponse} returns this
+*/
+proto.api_container_api.ListFilesArtifactVersionsResponse.prototype.setVersionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.FilesArtifactVersion=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.FilesArtifactVersion}
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse.prototype.addVersions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactVersion, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ListFilesArtifactVersionsResponse} returns this
+ */
+proto.api_container_api.ListFilesArtifactVersionsResponse.prototype.clearVersionsList = function() {
+  return this.setVersionsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FilesArtifactVersion.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.FilesArtifactVersion} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.FilesArtifactVersion.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    number: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    contentMd5: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    isCurrent: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.FilesArtifactVersion}
+ */
+proto.api_container_api.FilesArtifactVersion.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.FilesArtifactVersion;
+  return proto.api_container_api.FilesArtifactVersion.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.FilesArtifactVersion} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.FilesArtifactVersion}
+ */
+proto.api_container_api.FilesArtifactVersion.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumber(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContentMd5(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreationTime(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsCurrent(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.FilesArtifactVersion.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.FilesArtifactVersion} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.FilesArtifactVersion.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getContentMd5();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCreationTime();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getIsCurrent();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 number = 1;
+ * @return {number}
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.getNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.FilesArtifactVersion} returns this
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string content_md5 = 2;
+ * @return {string}
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.getContentMd5 = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactVersion} returns this
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.setContentMd5 = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp creation_time = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.getCreationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.FilesArtifactVersion} returns this
+*/
+proto.api_container_api.FilesArtifactVersion.prototype.setCreationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.FilesArtifactVersion} returns this
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.clearCreationTime = function() {
+  return this.setCreationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.hasCreationTime = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional bool is_current = 4;
+ * @return {boolean}
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.getIsCurrent = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.FilesArtifactVersion} returns this
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.setIsCurrent = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.InspectFilesArtifactContentsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    fileNamesAndUuid: (f = msg.getFileNamesAndUuid()) && proto.api_container_api.FilesArtifactNameAndUuid.toObject(includeInstance, f),
+    version: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.InspectFilesArtifactContentsRequest;
+  return proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
+      msg.setFileNamesAndUuid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFileNamesAndUuid();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional FilesArtifactNameAndUuid file_names_and_uuid = 1;
+ * @return {?proto.api_container_api.FilesArtifactNameAndUuid}
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.getFileNamesAndUuid = function() {
+  return /** @type{?proto.api_container_api.FilesArtifactNameAndUuid} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.FilesArtifactNameAndUuid|undefined} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+*/
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.setFileNamesAndUuid = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.clearFileNamesAndUuid = function() {
+  return this.setFileNamesAndUuid(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.hasFileNamesAndUuid = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional uint32 version = 2;
+ * @return {number}
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.setVersion = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.clearVersion = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.hasVersion = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.InspectFilesArtifactContentsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    fileDescriptionsList: jspb.Message.toObjectList(msg.getFileDescriptionsList(),
+    proto.api_container_api.FileArtifactContentsFileDescription.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.InspectFilesArtifactContentsResponse;
+  return proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.FileArtifactContentsFileDescription;
+      reader.readMessage(value,proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader);
+      msg.addFileDescriptions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFileDescriptionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated FileArtifactContentsFileDescription file_descriptions = 1;
+ * @return {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>}
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.getFileDescriptionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FileArtifactContentsFileDescription, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
+*/
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.setFileDescriptionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.addFileDescriptions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FileArtifactContentsFileDescription, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.clearFileDescriptionsList = function() {
+  return this.setFileDescriptionsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FileArtifactContentsFileDescription.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    path: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    size: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    textPreview: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.FileArtifactContentsFileDescription;
+  return proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSize(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTextPreview(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string path = 1;
+ * @return {string}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 size = 2;
+ * @return {number}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string text_preview = 3;
+ * @return {string}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getTextPreview = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setTextPreview = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.clearTextPreview = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.hasTextPreview = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ConnectServicesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ConnectServicesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    connect: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ConnectServicesArgs}
+ */
+proto.api_container_api.ConnectServicesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ConnectServicesArgs;
+  return proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ConnectServicesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ConnectServicesArgs}
+ */
+proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.api_container_api.Connect} */ (reader.readEnum());
+      msg.setConnect(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ConnectServicesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getConnect();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional Connect connect = 1;
+ * @return {!proto.api_container_api.Connect}
+ */
+proto.api_container_api.ConnectServicesArgs.prototype.getConnect = function() {
+  return /** @type {!proto.api_container_api.Connect} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.Connect} value
+ * @return {!proto.api_container_api.ConnectServicesArgs} returns this
+ */
+proto.api_container_api.ConnectServicesArgs.prototype.setConnect = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ConnectServicesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ConnectServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ConnectServicesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ConnectServicesResponse}
+ */
+proto.api_container_api.ConnectServicesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ConnectServicesResponse;
+  return proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ConnectServicesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ConnectServicesResponse}
+ */
+proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ConnectServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetStarlarkRunResponse.repeatedFields_ = [7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetStarlarkRunResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetStarlarkRunResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
+    restartPolicy: jspb.Message.getFieldWithDefault(msg, 8, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetStarlarkRunResponse}
+ */
+proto.api_container_api.GetStarlarkRunResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetStarlarkRunResponse;
+  return proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetStarlarkRunResponse}
+ */
+proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackageId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedScript(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRelativePathToMainFile(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
+      break;
+    case 7:
+      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addExperimentalFeatures(values[i]);
+      }
+      break;
+    case 8:
+      var value = /** @type {!proto.api_container_api.RestartPolicy} */ (reader.readEnum());
+      msg.setRestartPolicy(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetStarlarkRunResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPackageId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSerializedScript();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSerializedParams();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getParallelism();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getRelativePathToMainFile();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getMainFunctionName();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getExperimentalFeaturesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      7,
+      f
+    );
+  }
+  f = message.getRestartPolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string package_id = 1;
+ * @return {string}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.getPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string serialized_script = 2;
+ * @return {string}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedScript = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedScript = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string serialized_params = 3;
+ * @return {string}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int32 parallelism = 4;
+ * @return {number}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.setParallelism = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string relative_path_to_main_file = 5;
+ * @return {string}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.getRelativePathToMainFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.setRelativePathToMainFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string main_function_name = 6;
+ * @return {string}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * repeated KurtosisFeatureFlag experimental_features = 7;
+ * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.getExperimentalFeaturesList = function() {
+  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.setExperimentalFeaturesList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
+};
+
+
+/**
+ * @param {!proto.api_container_api.KurtosisFeatureFlag} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.addExperimentalFeatures = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.clearExperimentalFeaturesList = function() {
+  return this.setExperimentalFeaturesList([]);
+};
+
+
+/**
+ * optional RestartPolicy restart_policy = 8;
+ * @return {!proto.api_container_api.RestartPolicy}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.getRestartPolicy = function() {
+  return /** @type {!proto.api_container_api.RestartPolicy} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.RestartPolicy} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.setRestartPolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ExportEnclaveSnapshotArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExportEnclaveSnapshotArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ExportEnclaveSnapshotArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ExportEnclaveSnapshotArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    includePersistentDirectories: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    includeContainerFilesystems: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ExportEnclaveSnapshotArgs}
+ */
+proto.api_container_api.ExportEnclaveSnapshotArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ExportEnclaveSnapshotArgs;
+  return proto.api_container_api.ExportEnclaveSnapshotArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ExportEnclaveSnapshotArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ExportEnclaveSnapshotArgs}
+ */
+proto.api_container_api.ExportEnclaveSnapshotArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIncludePersistentDirectories(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIncludeContainerFilesystems(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ExportEnclaveSnapshotArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ExportEnclaveSnapshotArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ExportEnclaveSnapshotArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ExportEnclaveSnapshotArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIncludePersistentDirectories();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getIncludeContainerFilesystems();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool include_persistent_directories = 1;
+ * @return {boolean}
+ */
+proto.api_container_api.ExportEnclaveSnapshotArgs.prototype.getIncludePersistentDirectories = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.ExportEnclaveSnapshotArgs} returns this
+ */
+proto.api_container_api.ExportEnclaveSnapshotArgs.prototype.setIncludePersistentDirectories = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional bool include_container_filesystems = 2;
+ * @return {boolean}
+ */
+proto.api_container_api.ExportEnclaveSnapshotArgs.prototype.getIncludeContainerFilesystems = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.ExportEnclaveSnapshotArgs} returns this
+ */
+proto.api_container_api.ExportEnclaveSnapshotArgs.prototype.setIncludeContainerFilesystems = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ResumeServicesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ResumeServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ResumeServicesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ResumeServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ResumeServicesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resumedServiceNamesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ResumeServicesResponse}
+ */
+proto.api_container_api.ResumeServicesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ResumeServicesResponse;
+  return proto.api_container_api.ResumeServicesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ResumeServicesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ResumeServicesResponse}
+ */
+proto.api_container_api.ResumeServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addResumedServiceNames(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ResumeServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ResumeServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ResumeServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ResumeServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResumedServiceNamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string resumed_service_names = 1;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ResumeServicesResponse.prototype.getResumedServiceNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ResumeServicesResponse} returns this
+ */
+proto.api_container_api.ResumeServicesResponse.prototype.setResumedServiceNamesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ResumeServicesResponse} returns this
+ */
+proto.api_container_api.ResumeServicesResponse.prototype.addResumedServiceNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ResumeServicesResponse} returns this
+ */
+proto.api_container_api.ResumeServicesResponse.prototype.clearResumedServiceNamesList = function() {
+  return this.setResumedServiceNamesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.EnclaveExpiry.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.EnclaveExpiry.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.EnclaveExpiry} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.EnclaveExpiry.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    expirationTime: (f = msg.getExpirationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    idleTimeoutSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    shouldDestroy: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    lastActivityTime: (f = msg.getLastActivityTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.EnclaveExpiry}
+ */
+proto.api_container_api.EnclaveExpiry.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.EnclaveExpiry;
+  return proto.api_container_api.EnclaveExpiry.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.EnclaveExpiry} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.EnclaveExpiry}
+ */
+proto.api_container_api.EnclaveExpiry.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpirationTime(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setIdleTimeoutSeconds(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldDestroy(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastActivityTime(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.EnclaveExpiry.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.EnclaveExpiry.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.EnclaveExpiry} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.EnclaveExpiry.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExpirationTime();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getIdleTimeoutSeconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getShouldDestroy();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getLastActivityTime();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expiration_time = 1;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.api_container_api.EnclaveExpiry.prototype.getExpirationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.EnclaveExpiry} returns this
+*/
+proto.api_container_api.EnclaveExpiry.prototype.setExpirationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.EnclaveExpiry} returns this
+ */
+proto.api_container_api.EnclaveExpiry.prototype.clearExpirationTime = function() {
+  return this.setExpirationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.EnclaveExpiry.prototype.hasExpirationTime = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional uint32 idle_timeout_seconds = 2;
+ * @return {number}
+ */
+proto.api_container_api.EnclaveExpiry.prototype.getIdleTimeoutSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.EnclaveExpiry} returns this
+ */
+proto.api_container_api.EnclaveExpiry.prototype.setIdleTimeoutSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional bool should_destroy = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.EnclaveExpiry.prototype.getShouldDestroy = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.EnclaveExpiry} returns this
+ */
+proto.api_container_api.EnclaveExpiry.prototype.setShouldDestroy = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_activity_time = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.api_container_api.EnclaveExpiry.prototype.getLastActivityTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.EnclaveExpiry} returns this
+*/
+proto.api_container_api.EnclaveExpiry.prototype.setLastActivityTime = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.EnclaveExpiry} returns this
+ */
+proto.api_container_api.EnclaveExpiry.prototype.clearLastActivityTime = function() {
+  return this.setLastActivityTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.EnclaveExpiry.prototype.hasLastActivityTime = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ResourceQuota.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ResourceQuota.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ResourceQuota} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ResourceQuota.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    usage: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    quota: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ResourceQuota}
+ */
+proto.api_container_api.ResourceQuota.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ResourceQuota;
+  return proto.api_container_api.ResourceQuota.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ResourceQuota} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ResourceQuota}
+ */
+proto.api_container_api.ResourceQuota.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setUsage(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setQuota(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ResourceQuota.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ResourceQuota.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ResourceQuota} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ResourceQuota.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsage();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 usage = 1;
+ * @return {number}
+ */
+proto.api_container_api.ResourceQuota.prototype.getUsage = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ResourceQuota} returns this
+ */
+proto.api_container_api.ResourceQuota.prototype.setUsage = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 quota = 2;
+ * @return {number}
+ */
+proto.api_container_api.ResourceQuota.prototype.getQuota = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ResourceQuota} returns this
+ */
+proto.api_container_api.ResourceQuota.prototype.setQuota = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ResourceQuota} returns this
+ */
+proto.api_container_api.ResourceQuota.prototype.clearQuota = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ResourceQuota.prototype.hasQuota = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetEnclaveResourceQuotasResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetEnclaveResourceQuotasResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    cpuMillicpus: (f = msg.getCpuMillicpus()) && proto.api_container_api.ResourceQuota.toObject(includeInstance, f),
+    memoryMegabytes: (f = msg.getMemoryMegabytes()) && proto.api_container_api.ResourceQuota.toObject(includeInstance, f),
+    serviceCount: (f = msg.getServiceCount()) && proto.api_container_api.ResourceQuota.toObject(includeInstance, f),
+    filesArtifactsStorageBytes: (f = msg.getFilesArtifactsStorageBytes()) && proto.api_container_api.ResourceQuota.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetEnclaveResourceQuotasResponse}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetEnclaveResourceQuotasResponse;
+  return proto.api_container_api.GetEnclaveResourceQuotasResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetEnclaveResourceQuotasResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetEnclaveResourceQuotasResponse}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.ResourceQuota;
+      reader.readMessage(value,proto.api_container_api.ResourceQuota.deserializeBinaryFromReader);
+      msg.setCpuMillicpus(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.ResourceQuota;
+      reader.readMessage(value,proto.api_container_api.ResourceQuota.deserializeBinaryFromReader);
+      msg.setMemoryMegabytes(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.ResourceQuota;
+      reader.readMessage(value,proto.api_container_api.ResourceQuota.deserializeBinaryFromReader);
+      msg.setServiceCount(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.ResourceQuota;
+      reader.readMessage(value,proto.api_container_api.ResourceQuota.deserializeBinaryFromReader);
+      msg.setFilesArtifactsStorageBytes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetEnclaveResourceQuotasResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetEnclaveResourceQuotasResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCpuMillicpus();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.ResourceQuota.serializeBinaryToWriter
+    );
+  }
+  f = message.getMemoryMegabytes();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.ResourceQuota.serializeBinaryToWriter
+    );
+  }
+  f = message.getServiceCount();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.ResourceQuota.serializeBinaryToWriter
+    );
+  }
+  f = message.getFilesArtifactsStorageBytes();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.api_container_api.ResourceQuota.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional ResourceQuota cpu_millicpus = 1;
+ * @return {?proto.api_container_api.ResourceQuota}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.getCpuMillicpus = function() {
+  return /** @type{?proto.api_container_api.ResourceQuota} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.ResourceQuota, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.ResourceQuota|undefined} value
+ * @return {!proto.api_container_api.GetEnclaveResourceQuotasResponse} returns this
+*/
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.setCpuMillicpus = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.GetEnclaveResourceQuotasResponse} returns this
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.clearCpuMillicpus = function() {
+  return this.setCpuMillicpus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.hasCpuMillicpus = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional ResourceQuota memory_megabytes = 2;
+ * @return {?proto.api_container_api.ResourceQuota}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.getMemoryMegabytes = function() {
+  return /** @type{?proto.api_container_api.ResourceQuota} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.ResourceQuota, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.ResourceQuota|undefined} value
+ * @return {!proto.api_container_api.GetEnclaveResourceQuotasResponse} returns this
+*/
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.setMemoryMegabytes = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.GetEnclaveResourceQuotasResponse} returns this
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.clearMemoryMegabytes = function() {
+  return this.setMemoryMegabytes(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.hasMemoryMegabytes = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional ResourceQuota service_count = 3;
+ * @return {?proto.api_container_api.ResourceQuota}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.getServiceCount = function() {
+  return /** @type{?proto.api_container_api.ResourceQuota} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.ResourceQuota, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.ResourceQuota|undefined} value
+ * @return {!proto.api_container_api.GetEnclaveResourceQuotasResponse} returns this
+*/
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.setServiceCount = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.GetEnclaveResourceQuotasResponse} returns this
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.clearServiceCount = function() {
+  return this.setServiceCount(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.hasServiceCount = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional ResourceQuota files_artifacts_storage_bytes = 4;
+ * @return {?proto.api_container_api.ResourceQuota}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.getFilesArtifactsStorageBytes = function() {
+  return /** @type{?proto.api_container_api.ResourceQuota} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.ResourceQuota, 4));
+};
+
+
+/**
+ * @param {?proto.api_container_api.ResourceQuota|undefined} value
+ * @return {!proto.api_container_api.GetEnclaveResourceQuotasResponse} returns this
+*/
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.setFilesArtifactsStorageBytes = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.GetEnclaveResourceQuotasResponse} returns this
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.clearFilesArtifactsStorageBytes = function() {
+  return this.setFilesArtifactsStorageBytes(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.GetEnclaveResourceQuotasResponse.prototype.hasFilesArtifactsStorageBytes = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetServicesStatsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesStatsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServicesStatsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesStatsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : [],
+    follow: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServicesStatsArgs}
+ */
+proto.api_container_api.GetServicesStatsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServicesStatsArgs;
+  return proto.api_container_api.GetServicesStatsArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServicesStatsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServicesStatsArgs}
+ */
+proto.api_container_api.GetServicesStatsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFollow(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetServicesStatsArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServicesStatsArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServicesStatsArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesStatsArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getFollow();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.api_container_api.GetServicesStatsArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesStatsArgs} returns this
+ */
+proto.api_container_api.GetServicesStatsArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
+
+
+/**
+ * optional bool follow = 2;
+ * @return {boolean}
+ */
+proto.api_container_api.GetServicesStatsArgs.prototype.getFollow = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetServicesStatsArgs} returns this
+ */
+proto.api_container_api.GetServicesStatsArgs.prototype.setFollow = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ServiceStats.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceStats.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceStats} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceStats.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    cpuMillicores: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    memoryUsageBytes: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    memoryLimitBytes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    networkReceivedBytes: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    networkTransmittedBytes: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    blockReadBytes: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    blockWrittenBytes: jspb.Message.getFieldWithDefault(msg, 7, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceStats}
+ */
+proto.api_container_api.ServiceStats.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceStats;
+  return proto.api_container_api.ServiceStats.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceStats} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceStats}
+ */
+proto.api_container_api.ServiceStats.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCpuMillicores(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryUsageBytes(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryLimitBytes(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNetworkReceivedBytes(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNetworkTransmittedBytes(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setBlockReadBytes(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setBlockWrittenBytes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ServiceStats.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceStats.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceStats} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceStats.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCpuMillicores();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getMemoryUsageBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeUint64(
+      6,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeUint64(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 cpu_millicores = 1;
+ * @return {number}
+ */
+proto.api_container_api.ServiceStats.prototype.getCpuMillicores = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.setCpuMillicores = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 memory_usage_bytes = 2;
+ * @return {number}
+ */
+proto.api_container_api.ServiceStats.prototype.getMemoryUsageBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.setMemoryUsageBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 memory_limit_bytes = 3;
+ * @return {number}
+ */
+proto.api_container_api.ServiceStats.prototype.getMemoryLimitBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.setMemoryLimitBytes = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.clearMemoryLimitBytes = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceStats.prototype.hasMemoryLimitBytes = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional uint64 network_received_bytes = 4;
+ * @return {number}
+ */
+proto.api_container_api.ServiceStats.prototype.getNetworkReceivedBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.setNetworkReceivedBytes = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.clearNetworkReceivedBytes = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceStats.prototype.hasNetworkReceivedBytes = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional uint64 network_transmitted_bytes = 5;
+ * @return {number}
+ */
+proto.api_container_api.ServiceStats.prototype.getNetworkTransmittedBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.setNetworkTransmittedBytes = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.clearNetworkTransmittedBytes = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceStats.prototype.hasNetworkTransmittedBytes = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional uint64 block_read_bytes = 6;
+ * @return {number}
+ */
+proto.api_container_api.ServiceStats.prototype.getBlockReadBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.setBlockReadBytes = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.clearBlockReadBytes = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceStats.prototype.hasBlockReadBytes = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional uint64 block_written_bytes = 7;
+ * @return {number}
+ */
+proto.api_container_api.ServiceStats.prototype.getBlockWrittenBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.setBlockWrittenBytes = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceStats} returns this
+ */
+proto.api_container_api.ServiceStats.prototype.clearBlockWrittenBytes = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.clearFileDescriptionsList = function() {
-  return this.setFileDescriptionsList([]);
+proto.api_container_api.ServiceStats.prototype.hasBlockWrittenBytes = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
@@ -9255,8 +15420,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.FileArtifactContentsFileDescription.toObject(opt_includeInstance, this);
+proto.api_container_api.GetServicesStatsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesStatsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9265,15 +15430,14 @@ proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetServicesStatsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetServicesStatsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    path: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    size: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    textPreview: jspb.Message.getFieldWithDefault(msg, 3, "")
+    serviceStatsMap: (f = msg.getServiceStatsMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceStats.toObject) : [],
+    serviceErrorsMap: (f = msg.getServiceErrorsMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -9287,23 +15451,23 @@ proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * @return {!proto.api_container_api.GetServicesStatsResponse}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinary = function(bytes) {
+proto.api_container_api.GetServicesStatsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.FileArtifactContentsFileDescription;
-  return proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetServicesStatsResponse;
+  return proto.api_container_api.GetServicesStatsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetServicesStatsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * @return {!proto.api_container_api.GetServicesStatsResponse}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetServicesStatsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9311,16 +15475,16 @@ proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFro
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
+      var value = msg.getServiceStatsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceStats.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceStats());
+         });
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setSize(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTextPreview(value);
+      var value = msg.getServiceErrorsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -9335,9 +15499,9 @@ proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeBinary = function() {
+proto.api_container_api.GetServicesStatsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetServicesStatsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9345,106 +15509,65 @@ proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} message
+ * @param {!proto.api_container_api.GetServicesStatsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetServicesStatsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getSize();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
+  f = message.getServiceStatsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceStats.serializeBinaryToWriter);
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeString(
-      3,
-      f
-    );
+  f = message.getServiceErrorsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
 };
 
 
 /**
- * optional string path = 1;
- * @return {string}
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional uint64 size = 2;
- * @return {number}
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string text_preview = 3;
- * @return {string}
+ * map<string, ServiceStats> service_stats = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceStats>}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getTextPreview = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.GetServicesStatsResponse.prototype.getServiceStatsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceStats>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceStats));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesStatsResponse} returns this
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setTextPreview = function(value) {
-  return jspb.Message.setField(this, 3, value);
-};
+proto.api_container_api.GetServicesStatsResponse.prototype.clearServiceStatsMap = function() {
+  this.getServiceStatsMap().clear();
+  return this;};
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ * map<string, string> service_errors = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.clearTextPreview = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.api_container_api.GetServicesStatsResponse.prototype.getServiceErrorsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesStatsResponse} returns this
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.hasTextPreview = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
+proto.api_container_api.GetServicesStatsResponse.prototype.clearServiceErrorsMap = function() {
+  this.getServiceErrorsMap().clear();
+  return this;};
 
 
 
@@ -9463,8 +15586,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ConnectServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.LintStarlarkArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.LintStarlarkArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -9473,13 +15596,13 @@ proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ConnectServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.LintStarlarkArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.LintStarlarkArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    connect: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    starlarkFilesMap: (f = msg.getStarlarkFilesMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -9493,23 +15616,23 @@ proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ConnectServicesArgs}
+ * @return {!proto.api_container_api.LintStarlarkArgs}
  */
-proto.api_container_api.ConnectServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.LintStarlarkArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ConnectServicesArgs;
-  return proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.LintStarlarkArgs;
+  return proto.api_container_api.LintStarlarkArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ConnectServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.LintStarlarkArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ConnectServicesArgs}
+ * @return {!proto.api_container_api.LintStarlarkArgs}
  */
-proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.LintStarlarkArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9517,8 +15640,10 @@ proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.api_container_api.Connect} */ (reader.readEnum());
-      msg.setConnect(value);
+      var value = msg.getStarlarkFilesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBytes, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -9533,9 +15658,9 @@ proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function() {
+proto.api_container_api.LintStarlarkArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.LintStarlarkArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9543,38 +15668,39 @@ proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ConnectServicesArgs} message
+ * @param {!proto.api_container_api.LintStarlarkArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.LintStarlarkArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getConnect();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      1,
-      f
-    );
+  f = message.getStarlarkFilesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBytes);
   }
 };
 
 
 /**
- * optional Connect connect = 1;
- * @return {!proto.api_container_api.Connect}
+ * map<string, bytes> starlark_files = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!(string|Uint8Array)>}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.getConnect = function() {
-  return /** @type {!proto.api_container_api.Connect} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.api_container_api.LintStarlarkArgs.prototype.getStarlarkFilesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!(string|Uint8Array)>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {!proto.api_container_api.Connect} value
- * @return {!proto.api_container_api.ConnectServicesArgs} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.LintStarlarkArgs} returns this
  */
-proto.api_container_api.ConnectServicesArgs.prototype.setConnect = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
-};
+proto.api_container_api.LintStarlarkArgs.prototype.clearStarlarkFilesMap = function() {
+  this.getStarlarkFilesMap().clear();
+  return this;};
 
 
 
@@ -9593,8 +15719,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ConnectServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkLintRule.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkLintRule.toObject(opt_includeInstance, this);
 };
 
 
@@ -9603,13 +15729,15 @@ proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ConnectServicesResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkLintRule} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkLintRule.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    severity: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -9623,29 +15751,41 @@ proto.api_container_api.ConnectServicesResponse.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ConnectServicesResponse}
+ * @return {!proto.api_container_api.StarlarkLintRule}
  */
-proto.api_container_api.ConnectServicesResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkLintRule.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ConnectServicesResponse;
-  return proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkLintRule;
+  return proto.api_container_api.StarlarkLintRule.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ConnectServicesResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkLintRule} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ConnectServicesResponse}
+ * @return {!proto.api_container_api.StarlarkLintRule}
  */
-proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkLintRule.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSeverity(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -9659,9 +15799,9 @@ proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkLintRule.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkLintRule.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9669,22 +15809,90 @@ proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ConnectServicesResponse} message
+ * @param {!proto.api_container_api.StarlarkLintRule} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkLintRule.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSeverity();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkLintRule.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkLintRule} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.repeatedFields_ = [7];
+proto.api_container_api.StarlarkLintRule.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string severity = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkLintRule.prototype.getSeverity = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkLintRule} returns this
+ */
+proto.api_container_api.StarlarkLintRule.prototype.setSeverity = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string description = 3;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkLintRule.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkLintRule} returns this
+ */
+proto.api_container_api.StarlarkLintRule.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 
 
 
@@ -9701,8 +15909,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetStarlarkRunResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkLintFinding.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkLintFinding.toObject(opt_includeInstance, this);
 };
 
 
@@ -9711,20 +15919,18 @@ proto.api_container_api.GetStarlarkRunResponse.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkLintFinding} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetStarlarkRunResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkLintFinding.toObject = function(includeInstance, msg) {
   var f, obj = {
-    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedScript: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
-    restartPolicy: jspb.Message.getFieldWithDefault(msg, 8, 0)
+    ruleId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    severity: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    filepath: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    line: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    column: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    message: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -9738,23 +15944,23 @@ proto.api_container_api.GetStarlarkRunResponse.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetStarlarkRunResponse}
+ * @return {!proto.api_container_api.StarlarkLintFinding}
  */
-proto.api_container_api.GetStarlarkRunResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkLintFinding.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetStarlarkRunResponse;
-  return proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkLintFinding;
+  return proto.api_container_api.StarlarkLintFinding.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkLintFinding} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetStarlarkRunResponse}
+ * @return {!proto.api_container_api.StarlarkLintFinding}
  */
-proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkLintFinding.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9763,37 +15969,27 @@ proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = fun
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPackageId(value);
+      msg.setRuleId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedScript(value);
+      msg.setSeverity(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
+      msg.setFilepath(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
+      msg.setLine(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRelativePathToMainFile(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setColumn(value);
       break;
     case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
-      break;
-    case 7:
-      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addExperimentalFeatures(values[i]);
-      }
-      break;
-    case 8:
-      var value = /** @type {!proto.api_container_api.RestartPolicy} */ (reader.readEnum());
-      msg.setRestartPolicy(value);
+      msg.setMessage(value);
       break;
     default:
       reader.skipField();
@@ -9808,9 +16004,9 @@ proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkLintFinding.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkLintFinding.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9818,231 +16014,375 @@ proto.api_container_api.GetStarlarkRunResponse.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetStarlarkRunResponse} message
+ * @param {!proto.api_container_api.StarlarkLintFinding} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkLintFinding.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPackageId();
+  f = message.getRuleId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getSerializedScript();
+  f = message.getSeverity();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getSerializedParams();
+  f = message.getFilepath();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getParallelism();
+  f = message.getLine();
   if (f !== 0) {
     writer.writeInt32(
       4,
       f
     );
   }
-  f = message.getRelativePathToMainFile();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getColumn();
+  if (f !== 0) {
+    writer.writeInt32(
       5,
       f
     );
   }
-  f = message.getMainFunctionName();
+  f = message.getMessage();
   if (f.length > 0) {
     writer.writeString(
       6,
       f
     );
   }
-  f = message.getExperimentalFeaturesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      7,
-      f
-    );
-  }
-  f = message.getRestartPolicy();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      8,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string package_id = 1;
+ * optional string rule_id = 1;
  * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getPackageId = function() {
+proto.api_container_api.StarlarkLintFinding.prototype.getRuleId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.StarlarkLintFinding} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setPackageId = function(value) {
+proto.api_container_api.StarlarkLintFinding.prototype.setRuleId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string serialized_script = 2;
+ * optional string severity = 2;
  * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedScript = function() {
+proto.api_container_api.StarlarkLintFinding.prototype.getSeverity = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.StarlarkLintFinding} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedScript = function(value) {
+proto.api_container_api.StarlarkLintFinding.prototype.setSeverity = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string serialized_params = 3;
+ * optional string filepath = 3;
  * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedParams = function() {
+proto.api_container_api.StarlarkLintFinding.prototype.getFilepath = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.StarlarkLintFinding} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedParams = function(value) {
+proto.api_container_api.StarlarkLintFinding.prototype.setFilepath = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional int32 parallelism = 4;
+ * optional int32 line = 4;
  * @return {number}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getParallelism = function() {
+proto.api_container_api.StarlarkLintFinding.prototype.getLine = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.StarlarkLintFinding} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setParallelism = function(value) {
+proto.api_container_api.StarlarkLintFinding.prototype.setLine = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional string relative_path_to_main_file = 5;
- * @return {string}
+ * optional int32 column = 5;
+ * @return {number}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getRelativePathToMainFile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.api_container_api.StarlarkLintFinding.prototype.getColumn = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkLintFinding} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setRelativePathToMainFile = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.api_container_api.StarlarkLintFinding.prototype.setColumn = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional string main_function_name = 6;
+ * optional string message = 6;
  * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getMainFunctionName = function() {
+proto.api_container_api.StarlarkLintFinding.prototype.getMessage = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.StarlarkLintFinding} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setMainFunctionName = function(value) {
+proto.api_container_api.StarlarkLintFinding.prototype.setMessage = function(value) {
   return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
+
 /**
- * repeated KurtosisFeatureFlag experimental_features = 7;
- * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getExperimentalFeaturesList = function() {
-  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 7));
+proto.api_container_api.LintStarlarkResponse.repeatedFields_ = [1,2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.LintStarlarkResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.LintStarlarkResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.LintStarlarkResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setExperimentalFeaturesList = function(value) {
-  return jspb.Message.setField(this, 7, value || []);
+proto.api_container_api.LintStarlarkResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    findingsList: jspb.Message.toObjectList(msg.getFindingsList(),
+    proto.api_container_api.StarlarkLintFinding.toObject, includeInstance),
+    rulesList: jspb.Message.toObjectList(msg.getRulesList(),
+    proto.api_container_api.StarlarkLintRule.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!proto.api_container_api.KurtosisFeatureFlag} value
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.LintStarlarkResponse}
+ */
+proto.api_container_api.LintStarlarkResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.LintStarlarkResponse;
+  return proto.api_container_api.LintStarlarkResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.LintStarlarkResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.LintStarlarkResponse}
+ */
+proto.api_container_api.LintStarlarkResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkLintFinding;
+      reader.readMessage(value,proto.api_container_api.StarlarkLintFinding.deserializeBinaryFromReader);
+      msg.addFindings(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkLintRule;
+      reader.readMessage(value,proto.api_container_api.StarlarkLintRule.deserializeBinaryFromReader);
+      msg.addRules(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.LintStarlarkResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.LintStarlarkResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.LintStarlarkResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.LintStarlarkResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFindingsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkLintFinding.serializeBinaryToWriter
+    );
+  }
+  f = message.getRulesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkLintRule.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated StarlarkLintFinding findings = 1;
+ * @return {!Array<!proto.api_container_api.StarlarkLintFinding>}
+ */
+proto.api_container_api.LintStarlarkResponse.prototype.getFindingsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkLintFinding>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkLintFinding, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkLintFinding>} value
+ * @return {!proto.api_container_api.LintStarlarkResponse} returns this
+*/
+proto.api_container_api.LintStarlarkResponse.prototype.setFindingsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkLintFinding=} opt_value
  * @param {number=} opt_index
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.StarlarkLintFinding}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.addExperimentalFeatures = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+proto.api_container_api.LintStarlarkResponse.prototype.addFindings = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.StarlarkLintFinding, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.LintStarlarkResponse} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.clearExperimentalFeaturesList = function() {
-  return this.setExperimentalFeaturesList([]);
+proto.api_container_api.LintStarlarkResponse.prototype.clearFindingsList = function() {
+  return this.setFindingsList([]);
 };
 
 
 /**
- * optional RestartPolicy restart_policy = 8;
- * @return {!proto.api_container_api.RestartPolicy}
+ * repeated StarlarkLintRule rules = 2;
+ * @return {!Array<!proto.api_container_api.StarlarkLintRule>}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getRestartPolicy = function() {
-  return /** @type {!proto.api_container_api.RestartPolicy} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.api_container_api.LintStarlarkResponse.prototype.getRulesList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkLintRule>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkLintRule, 2));
 };
 
 
 /**
- * @param {!proto.api_container_api.RestartPolicy} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @param {!Array<!proto.api_container_api.StarlarkLintRule>} value
+ * @return {!proto.api_container_api.LintStarlarkResponse} returns this
+*/
+proto.api_container_api.LintStarlarkResponse.prototype.setRulesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkLintRule=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkLintRule}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setRestartPolicy = function(value) {
-  return jspb.Message.setProto3EnumField(this, 8, value);
+proto.api_container_api.LintStarlarkResponse.prototype.addRules = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.api_container_api.StarlarkLintRule, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.LintStarlarkResponse} returns this
+ */
+proto.api_container_api.LintStarlarkResponse.prototype.clearRulesList = function() {
+  return this.setRulesList([]);
 };
 
 
@@ -10055,6 +16395,15 @@ proto.api_container_api.ServiceStatus = {
   UNKNOWN: 2
 };
 
+/**
+ * @enum {number}
+ */
+proto.api_container_api.ServiceHealthStatus = {
+  HEALTH_UNKNOWN: 0,
+  HEALTHY: 1,
+  UNHEALTHY: 2
+};
+
 /**
  * @enum {number}
  */
@@ -10071,6 +16420,15 @@ proto.api_container_api.Connect = {
   NO_CONNECT: 1
 };
 
+/**
+ * @enum {number}
+ */
+proto.api_container_api.PackageLockMode = {
+  ENFORCE_LOCK: 0,
+  EXTEND_LOCK: 1,
+  IGNORE_LOCK: 2
+};
+
 /**
  * @enum {number}
  */
@@ -10078,6 +16436,15 @@ proto.api_container_api.KurtosisFeatureFlag = {
   NO_INSTRUCTIONS_CACHING: 0
 };
 
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkPlanDiffChangeType = {
+  COMPONENT_ADDED: 0,
+  COMPONENT_UPDATED: 1,
+  COMPONENT_REMOVED: 2
+};
+
 /**
  * @enum {number}
  */
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkRunResponseLine, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
+import { ConnectServicesArgs, ConnectServicesResponse, CopyFilesArtifactToServiceArgs, CopyFilesFromServiceArgs, CopyFilesToServiceChunk, DownloadFilesArtifactArgs, EnclaveExpiry, ExecCommandArgs, ExecCommandResponse, ExportEnclaveSnapshotArgs, GetEnclaveResourceQuotasResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetServicesStatsArgs, GetServicesStatsResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, LintStarlarkArgs, LintStarlarkResponse, ListFilesArtifactNamesAndUuidsResponse, ListFilesArtifactVersionsArgs, ListFilesArtifactVersionsResponse, RemoveFilesArtifactArgs, ResumeServicesResponse, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkRunResponseLine, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -144,6 +144,39 @@ export declare const ApiContainerService: {
       readonly O: typeof StoreFilesArtifactFromServiceResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Copies the streamed TGZ, packaged like a files artifact, into a directory of a running service
+     *
+     * @generated from rpc api_container_api.ApiContainerService.CopyFilesToService
+     */
+    readonly copyFilesToService: {
+      readonly name: "CopyFilesToService",
+      readonly I: typeof CopyFilesToServiceChunk,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * Copies the content of a files artifact into a directory of a running service
+     *
+     * @generated from rpc api_container_api.ApiContainerService.CopyFilesArtifactToService
+     */
+    readonly copyFilesArtifactToService: {
+      readonly name: "CopyFilesArtifactToService",
+      readonly I: typeof CopyFilesArtifactToServiceArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Streams the content at a path of a running service, packaged as a TGZ
+     *
+     * @generated from rpc api_container_api.ApiContainerService.CopyFilesFromService
+     */
+    readonly copyFilesFromService: {
+      readonly name: "CopyFilesFromService",
+      readonly I: typeof CopyFilesFromServiceArgs,
+      readonly O: typeof StreamedDataChunk,
+      readonly kind: MethodKind.ServerStreaming,
+    },
     /**
      * @generated from rpc api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids
      */
@@ -162,6 +195,28 @@ export declare const ApiContainerService: {
       readonly O: typeof InspectFilesArtifactContentsResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Removes a files artifact from the Kurtosis File System, refusing to if a running service mounts it
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RemoveFilesArtifact
+     */
+    readonly removeFilesArtifact: {
+      readonly name: "RemoveFilesArtifact",
+      readonly I: typeof RemoveFilesArtifactArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Lists the versions of a files artifact kept by the Kurtosis File System, from the oldest to the current one
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ListFilesArtifactVersions
+     */
+    readonly listFilesArtifactVersions: {
+      readonly name: "ListFilesArtifactVersions",
+      readonly I: typeof ListFilesArtifactVersionsArgs,
+      readonly O: typeof ListFilesArtifactVersionsResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * User services port forwarding
      *
@@ -184,6 +239,95 @@ export declare const ApiContainerService: {
       readonly O: typeof GetStarlarkRunResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Exports the state of the enclave (services, files artifacts, runtime values and enclave plan) as a single archive
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ExportEnclaveSnapshot
+     */
+    readonly exportEnclaveSnapshot: {
+      readonly name: "ExportEnclaveSnapshot",
+      readonly I: typeof ExportEnclaveSnapshotArgs,
+      readonly O: typeof StreamedDataChunk,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Restores a snapshot produced by ExportEnclaveSnapshot into this enclave, which must not contain any service yet
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ImportEnclaveSnapshot
+     */
+    readonly importEnclaveSnapshot: {
+      readonly name: "ImportEnclaveSnapshot",
+      readonly I: typeof StreamedDataChunk,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * Restarts the services whose containers stopped along with the enclave, in the order they were added, and waits for their ready conditions
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ResumeServices
+     */
+    readonly resumeServices: {
+      readonly name: "ResumeServices",
+      readonly I: typeof Empty,
+      readonly O: typeof ResumeServicesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the expiry settings of the enclave along with the last time its API was called. Calling it doesn't count as activity
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclaveExpiry
+     */
+    readonly getEnclaveExpiry: {
+      readonly name: "GetEnclaveExpiry",
+      readonly I: typeof Empty,
+      readonly O: typeof EnclaveExpiry,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Replaces the expiry settings of the enclave, which are persisted in the enclave database
+     *
+     * @generated from rpc api_container_api.ApiContainerService.SetEnclaveExpiry
+     */
+    readonly setEnclaveExpiry: {
+      readonly name: "SetEnclaveExpiry",
+      readonly I: typeof EnclaveExpiry,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the resource quotas set at the creation of the enclave along with how much of them is currently used
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclaveResourceQuotas
+     */
+    readonly getEnclaveResourceQuotas: {
+      readonly name: "GetEnclaveResourceQuotas",
+      readonly I: typeof Empty,
+      readonly O: typeof GetEnclaveResourceQuotasResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the CPU, memory, network and block IO the running services consume, once or, when following, every few
+     * seconds until the client cancels
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetServicesStats
+     */
+    readonly getServicesStats: {
+      readonly name: "GetServicesStats",
+      readonly I: typeof GetServicesStatsArgs,
+      readonly O: typeof GetServicesStatsResponse,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Lints the given Starlark files statically, without interpreting them, and returns the findings
+     *
+     * @generated from rpc api_container_api.ApiContainerService.LintStarlark
+     */
+    readonly lintStarlark: {
+      readonly name: "LintStarlark",
+      readonly I: typeof LintStarlarkArgs,
+      readonly O: typeof LintStarlarkResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
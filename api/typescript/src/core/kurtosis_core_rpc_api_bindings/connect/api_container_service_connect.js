@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkRunResponseLine, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
+import { ConnectServicesArgs, ConnectServicesResponse, CopyFilesArtifactToServiceArgs, CopyFilesFromServiceArgs, CopyFilesToServiceChunk, DownloadFilesArtifactArgs, EnclaveExpiry, ExecCommandArgs, ExecCommandResponse, ExportEnclaveSnapshotArgs, GetEnclaveResourceQuotasResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetServicesStatsArgs, GetServicesStatsResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, LintStarlarkArgs, LintStarlarkResponse, ListFilesArtifactNamesAndUuidsResponse, ListFilesArtifactVersionsArgs, ListFilesArtifactVersionsResponse, RemoveFilesArtifactArgs, ResumeServicesResponse, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkRunResponseLine, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -144,6 +144,39 @@ export const ApiContainerService = {
       O: StoreFilesArtifactFromServiceResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Copies the streamed TGZ, packaged like a files artifact, into a directory of a running service
+     *
+     * @generated from rpc api_container_api.ApiContainerService.CopyFilesToService
+     */
+    copyFilesToService: {
+      name: "CopyFilesToService",
+      I: CopyFilesToServiceChunk,
+      O: Empty,
+      kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * Copies the content of a files artifact into a directory of a running service
+     *
+     * @generated from rpc api_container_api.ApiContainerService.CopyFilesArtifactToService
+     */
+    copyFilesArtifactToService: {
+      name: "CopyFilesArtifactToService",
+      I: CopyFilesArtifactToServiceArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Streams the content at a path of a running service, packaged as a TGZ
+     *
+     * @generated from rpc api_container_api.ApiContainerService.CopyFilesFromService
+     */
+    copyFilesFromService: {
+      name: "CopyFilesFromService",
+      I: CopyFilesFromServiceArgs,
+      O: StreamedDataChunk,
+      kind: MethodKind.ServerStreaming,
+    },
     /**
      * @generated from rpc api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids
      */
@@ -162,6 +195,28 @@ export const ApiContainerService = {
       O: InspectFilesArtifactContentsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Removes a files artifact from the Kurtosis File System, refusing to if a running service mounts it
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RemoveFilesArtifact
+     */
+    removeFilesArtifact: {
+      name: "RemoveFilesArtifact",
+      I: RemoveFilesArtifactArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lists the versions of a files artifact kept by the Kurtosis File System, from the oldest to the current one
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ListFilesArtifactVersions
+     */
+    listFilesArtifactVersions: {
+      name: "ListFilesArtifactVersions",
+      I: ListFilesArtifactVersionsArgs,
+      O: ListFilesArtifactVersionsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * User services port forwarding
      *
@@ -184,6 +239,95 @@ export const ApiContainerService = {
       O: GetStarlarkRunResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Exports the state of the enclave (services, files artifacts, runtime values and enclave plan) as a single archive
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ExportEnclaveSnapshot
+     */
+    exportEnclaveSnapshot: {
+      name: "ExportEnclaveSnapshot",
+      I: ExportEnclaveSnapshotArgs,
+      O: StreamedDataChunk,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Restores a snapshot produced by ExportEnclaveSnapshot into this enclave, which must not contain any service yet
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ImportEnclaveSnapshot
+     */
+    importEnclaveSnapshot: {
+      name: "ImportEnclaveSnapshot",
+      I: StreamedDataChunk,
+      O: Empty,
+      kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * Restarts the services whose containers stopped along with the enclave, in the order they were added, and waits for their ready conditions
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ResumeServices
+     */
+    resumeServices: {
+      name: "ResumeServices",
+      I: Empty,
+      O: ResumeServicesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the expiry settings of the enclave along with the last time its API was called. Calling it doesn't count as activity
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclaveExpiry
+     */
+    getEnclaveExpiry: {
+      name: "GetEnclaveExpiry",
+      I: Empty,
+      O: EnclaveExpiry,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Replaces the expiry settings of the enclave, which are persisted in the enclave database
+     *
+     * @generated from rpc api_container_api.ApiContainerService.SetEnclaveExpiry
+     */
+    setEnclaveExpiry: {
+      name: "SetEnclaveExpiry",
+      I: EnclaveExpiry,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the resource quotas set at the creation of the enclave along with how much of them is currently used
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclaveResourceQuotas
+     */
+    getEnclaveResourceQuotas: {
+      name: "GetEnclaveResourceQuotas",
+      I: Empty,
+      O: GetEnclaveResourceQuotasResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the CPU, memory, network and block IO the running services consume, once or, when following, every few
+     * seconds until the client cancels
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetServicesStats
+     */
+    getServicesStats: {
+      name: "GetServicesStats",
+      I: GetServicesStatsArgs,
+      O: GetServicesStatsResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Lints the given Starlark files statically, without interpreting them, and returns the findings
+     *
+     * @generated from rpc api_container_api.ApiContainerService.LintStarlark
+     */
+    lintStarlark: {
+      name: "LintStarlark",
+      I: LintStarlarkArgs,
+      O: LintStarlarkResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
 /**
@@ -26,6 +26,26 @@ export declare enum ServiceStatus {
   UNKNOWN = 2,
 }
 
+/**
+ * @generated from enum api_container_api.ServiceHealthStatus
+ */
+export declare enum ServiceHealthStatus {
+  /**
+   * @generated from enum value: HEALTH_UNKNOWN = 0;
+   */
+  HEALTH_UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: HEALTHY = 1;
+   */
+  HEALTHY = 1,
+
+  /**
+   * @generated from enum value: UNHEALTHY = 2;
+   */
+  UNHEALTHY = 2,
+}
+
 /**
  * @generated from enum api_container_api.ImageDownloadMode
  */
@@ -65,6 +85,32 @@ export declare enum Connect {
   NO_CONNECT = 1,
 }
 
+/**
+ * @generated from enum api_container_api.PackageLockMode
+ */
+export declare enum PackageLockMode {
+  /**
+   * Fails the run if a package dependency isn't in the kurtosis.lock, or resolves to a different commit than the locked one
+   *
+   * @generated from enum value: ENFORCE_LOCK = 0;
+   */
+  ENFORCE_LOCK = 0,
+
+  /**
+   * Pins the package dependencies in the kurtosis.lock to their locked commit and resolves the other ones
+   *
+   * @generated from enum value: EXTEND_LOCK = 1;
+   */
+  EXTEND_LOCK = 1,
+
+  /**
+   * Ignores the kurtosis.lock, resolving every package dependency from its locator
+   *
+   * @generated from enum value: IGNORE_LOCK = 2;
+   */
+  IGNORE_LOCK = 2,
+}
+
 /**
  * @generated from enum api_container_api.KurtosisFeatureFlag
  */
@@ -75,6 +121,26 @@ export declare enum KurtosisFeatureFlag {
   NO_INSTRUCTIONS_CACHING = 0,
 }
 
+/**
+ * @generated from enum api_container_api.StarlarkPlanDiffChangeType
+ */
+export declare enum StarlarkPlanDiffChangeType {
+  /**
+   * @generated from enum value: COMPONENT_ADDED = 0;
+   */
+  COMPONENT_ADDED = 0,
+
+  /**
+   * @generated from enum value: COMPONENT_UPDATED = 1;
+   */
+  COMPONENT_UPDATED = 1,
+
+  /**
+   * @generated from enum value: COMPONENT_REMOVED = 2;
+   */
+  COMPONENT_REMOVED = 2,
+}
+
 /**
  * @generated from enum api_container_api.RestartPolicy
  */
@@ -292,6 +358,21 @@ export declare class ServiceInfo extends Message<ServiceInfo> {
    */
   container?: Container;
 
+  /**
+   * Service health according to its liveness check: unknown, healthy, unhealthy.
+   * Always unknown for services without liveness check
+   *
+   * @generated from field: api_container_api.ServiceHealthStatus health_status = 10;
+   */
+  healthStatus: ServiceHealthStatus;
+
+  /**
+   * Number of times the service was restarted by the API container according to its restart policy
+   *
+   * @generated from field: uint32 restart_count = 11;
+   */
+  restartCount: number;
+
   constructor(data?: PartialMessage<ServiceInfo>);
 
   static readonly runtime: typeof proto3;
@@ -368,6 +449,22 @@ export declare class RunStarlarkScriptArgs extends Message<RunStarlarkScriptArgs
    */
   imageDownloadMode?: ImageDownloadMode;
 
+  /**
+   * Credentials used to clone the private Git repositories imported by the script. They are only kept in memory by
+   * the API container for the duration of the run
+   *
+   * @generated from field: repeated api_container_api.GitCredential git_credentials = 10;
+   */
+  gitCredentials: GitCredential[];
+
+  /**
+   * Defaults to false. If true, the script is interpreted against the plan of the enclave and the changes running it
+   * would make are returned as a StarlarkPlanDiff, without executing anything
+   *
+   * @generated from field: optional bool diff = 11;
+   */
+  diff?: boolean;
+
   constructor(data?: PartialMessage<RunStarlarkScriptArgs>);
 
   static readonly runtime: typeof proto3;
@@ -489,6 +586,29 @@ export declare class RunStarlarkPackageArgs extends Message<RunStarlarkPackageAr
    */
   imageDownloadMode?: ImageDownloadMode;
 
+  /**
+   * Credentials used to clone the package and the packages it imports when they live in private Git repositories.
+   * They are only kept in memory by the API container for the duration of the run
+   *
+   * @generated from field: repeated api_container_api.GitCredential git_credentials = 15;
+   */
+  gitCredentials: GitCredential[];
+
+  /**
+   * How the package kurtosis.lock is applied to the packages it imports. Defaults to enforcing it
+   *
+   * @generated from field: optional api_container_api.PackageLockMode package_lock_mode = 16;
+   */
+  packageLockMode?: PackageLockMode;
+
+  /**
+   * Defaults to false. If true, the package is interpreted against the plan of the enclave and the changes running it
+   * would make are returned as a StarlarkPlanDiff, without executing anything
+   *
+   * @generated from field: optional bool diff = 17;
+   */
+  diff?: boolean;
+
   constructor(data?: PartialMessage<RunStarlarkPackageArgs>);
 
   static readonly runtime: typeof proto3;
@@ -504,6 +624,124 @@ export declare class RunStarlarkPackageArgs extends Message<RunStarlarkPackageAr
   static equals(a: RunStarlarkPackageArgs | PlainMessage<RunStarlarkPackageArgs> | undefined, b: RunStarlarkPackageArgs | PlainMessage<RunStarlarkPackageArgs> | undefined): boolean;
 }
 
+/**
+ * Credentials for a single Git host, e.g. 'github.com'
+ *
+ * @generated from message api_container_api.GitCredential
+ */
+export declare class GitCredential extends Message<GitCredential> {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * @generated from oneof api_container_api.GitCredential.credential
+   */
+  credential: {
+    /**
+     * @generated from field: api_container_api.HttpsTokenGitCredential https_token = 2;
+     */
+    value: HttpsTokenGitCredential;
+    case: "httpsToken";
+  } | {
+    /**
+     * @generated from field: api_container_api.SshKeyGitCredential ssh_key = 3;
+     */
+    value: SshKeyGitCredential;
+    case: "sshKey";
+  } | { case: undefined; value?: undefined };
+
+  constructor(data?: PartialMessage<GitCredential>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GitCredential";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitCredential;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitCredential;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitCredential;
+
+  static equals(a: GitCredential | PlainMessage<GitCredential> | undefined, b: GitCredential | PlainMessage<GitCredential> | undefined): boolean;
+}
+
+/**
+ * A personal access token (or any other token accepted by the host) used to clone over HTTPS
+ *
+ * @generated from message api_container_api.HttpsTokenGitCredential
+ */
+export declare class HttpsTokenGitCredential extends Message<HttpsTokenGitCredential> {
+  /**
+   * Defaults to 'git', which is accepted by most Git hosts when authenticating with a token
+   *
+   * @generated from field: optional string username = 1;
+   */
+  username?: string;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token: string;
+
+  constructor(data?: PartialMessage<HttpsTokenGitCredential>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.HttpsTokenGitCredential";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HttpsTokenGitCredential;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HttpsTokenGitCredential;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HttpsTokenGitCredential;
+
+  static equals(a: HttpsTokenGitCredential | PlainMessage<HttpsTokenGitCredential> | undefined, b: HttpsTokenGitCredential | PlainMessage<HttpsTokenGitCredential> | undefined): boolean;
+}
+
+/**
+ * An SSH private key used to clone over SSH
+ *
+ * @generated from message api_container_api.SshKeyGitCredential
+ */
+export declare class SshKeyGitCredential extends Message<SshKeyGitCredential> {
+  /**
+   * The PEM encoded private key
+   *
+   * @generated from field: bytes private_key = 1;
+   */
+  privateKey: Uint8Array;
+
+  /**
+   * Only required if the private key is encrypted
+   *
+   * @generated from field: optional string passphrase = 2;
+   */
+  passphrase?: string;
+
+  /**
+   * The known_hosts entries used to verify the host key of the Git host
+   *
+   * @generated from field: bytes known_hosts = 3;
+   */
+  knownHosts: Uint8Array;
+
+  constructor(data?: PartialMessage<SshKeyGitCredential>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.SshKeyGitCredential";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SshKeyGitCredential;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SshKeyGitCredential;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SshKeyGitCredential;
+
+  static equals(a: SshKeyGitCredential | PlainMessage<SshKeyGitCredential> | undefined, b: SshKeyGitCredential | PlainMessage<SshKeyGitCredential> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                               Starlark Execution Response
@@ -557,6 +795,12 @@ export declare class StarlarkRunResponseLine extends Message<StarlarkRunResponse
      */
     value: StarlarkInfo;
     case: "info";
+  } | {
+    /**
+     * @generated from field: api_container_api.StarlarkPlanDiff plan_diff = 8;
+     */
+    value: StarlarkPlanDiff;
+    case: "planDiff";
   } | { case: undefined; value?: undefined };
 
   constructor(data?: PartialMessage<StarlarkRunResponseLine>);
@@ -920,6 +1164,13 @@ export declare class StarlarkRunFinishedEvent extends Message<StarlarkRunFinishe
    */
   serializedOutput?: string;
 
+  /**
+   * The commit every package dependency cloned from Git resolved to during the run
+   *
+   * @generated from field: repeated api_container_api.PackageDependency resolved_package_dependencies = 3;
+   */
+  resolvedPackageDependencies: PackageDependency[];
+
   constructor(data?: PartialMessage<StarlarkRunFinishedEvent>);
 
   static readonly runtime: typeof proto3;
@@ -935,6 +1186,111 @@ export declare class StarlarkRunFinishedEvent extends Message<StarlarkRunFinishe
   static equals(a: StarlarkRunFinishedEvent | PlainMessage<StarlarkRunFinishedEvent> | undefined, b: StarlarkRunFinishedEvent | PlainMessage<StarlarkRunFinishedEvent> | undefined): boolean;
 }
 
+/**
+ * The changes a run would make to an enclave, compared to the instructions already executed in it
+ *
+ * @generated from message api_container_api.StarlarkPlanDiff
+ */
+export declare class StarlarkPlanDiff extends Message<StarlarkPlanDiff> {
+  /**
+   * @generated from field: repeated api_container_api.StarlarkPlanDiffComponentChange service_changes = 1;
+   */
+  serviceChanges: StarlarkPlanDiffComponentChange[];
+
+  /**
+   * @generated from field: repeated api_container_api.StarlarkPlanDiffComponentChange files_artifact_changes = 2;
+   */
+  filesArtifactChanges: StarlarkPlanDiffComponentChange[];
+
+  /**
+   * The instructions the run would execute
+   *
+   * @generated from field: repeated api_container_api.StarlarkInstruction instructions_to_execute = 3;
+   */
+  instructionsToExecute: StarlarkInstruction[];
+
+  /**
+   * The instructions already executed in the enclave that the run would skip
+   *
+   * @generated from field: repeated api_container_api.StarlarkInstruction skipped_instructions = 4;
+   */
+  skippedInstructions: StarlarkInstruction[];
+
+  constructor(data?: PartialMessage<StarlarkPlanDiff>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkPlanDiff";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkPlanDiff;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkPlanDiff;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkPlanDiff;
+
+  static equals(a: StarlarkPlanDiff | PlainMessage<StarlarkPlanDiff> | undefined, b: StarlarkPlanDiff | PlainMessage<StarlarkPlanDiff> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.StarlarkPlanDiffComponentChange
+ */
+export declare class StarlarkPlanDiffComponentChange extends Message<StarlarkPlanDiffComponentChange> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: api_container_api.StarlarkPlanDiffChangeType change_type = 2;
+   */
+  changeType: StarlarkPlanDiffChangeType;
+
+  constructor(data?: PartialMessage<StarlarkPlanDiffComponentChange>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkPlanDiffComponentChange";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkPlanDiffComponentChange;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkPlanDiffComponentChange;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkPlanDiffComponentChange;
+
+  static equals(a: StarlarkPlanDiffComponentChange | PlainMessage<StarlarkPlanDiffComponentChange> | undefined, b: StarlarkPlanDiffComponentChange | PlainMessage<StarlarkPlanDiffComponentChange> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.PackageDependency
+ */
+export declare class PackageDependency extends Message<PackageDependency> {
+  /**
+   * The repository the dependency lives in, e.g. 'github.com/kurtosis-tech/prometheus-package'
+   *
+   * @generated from field: string repository = 1;
+   */
+  repository: string;
+
+  /**
+   * @generated from field: string commit = 2;
+   */
+  commit: string;
+
+  constructor(data?: PartialMessage<PackageDependency>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.PackageDependency";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PackageDependency;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PackageDependency;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PackageDependency;
+
+  static equals(a: PackageDependency | PlainMessage<PackageDependency> | undefined, b: PackageDependency | PlainMessage<PackageDependency> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                                          Get Services
@@ -1397,6 +1753,13 @@ export declare class DownloadFilesArtifactArgs extends Message<DownloadFilesArti
    */
   identifier: string;
 
+  /**
+   * Version of the files artifact to get bytes for, the current one if not set
+   *
+   * @generated from field: optional uint32 version = 2;
+   */
+  version?: number;
+
   constructor(data?: PartialMessage<DownloadFilesArtifactArgs>);
 
   static readonly runtime: typeof proto3;
@@ -1542,72 +1905,336 @@ export declare class StoreFilesArtifactFromServiceResponse extends Message<Store
 }
 
 /**
- * @generated from message api_container_api.FilesArtifactNameAndUuid
+ * @generated from message api_container_api.CopyFilesToServiceChunk
  */
-export declare class FilesArtifactNameAndUuid extends Message<FilesArtifactNameAndUuid> {
+export declare class CopyFilesToServiceChunk extends Message<CopyFilesToServiceChunk> {
   /**
-   * A string representing the name of the file
+   * Chunk of the TGZ holding the files to copy
    *
-   * @generated from field: string fileName = 1;
+   * @generated from field: api_container_api.StreamedDataChunk chunk = 1;
    */
-  fileName: string;
+  chunk?: StreamedDataChunk;
 
   /**
-   * A string representing the uuid of the file
+   * Identifier of the service where the files will be copied to
    *
-   * @generated from field: string fileUuid = 2;
+   * @generated from field: string service_identifier = 2;
    */
-  fileUuid: string;
+  serviceIdentifier: string;
 
-  constructor(data?: PartialMessage<FilesArtifactNameAndUuid>);
+  /**
+   * The absolute path of the directory where the files will be copied into, created if it doesn't exist
+   *
+   * @generated from field: string destination_path = 3;
+   */
+  destinationPath: string;
+
+  constructor(data?: PartialMessage<CopyFilesToServiceChunk>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "api_container_api.FilesArtifactNameAndUuid";
+  static readonly typeName = "api_container_api.CopyFilesToServiceChunk";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FilesArtifactNameAndUuid;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CopyFilesToServiceChunk;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FilesArtifactNameAndUuid;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CopyFilesToServiceChunk;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FilesArtifactNameAndUuid;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CopyFilesToServiceChunk;
 
-  static equals(a: FilesArtifactNameAndUuid | PlainMessage<FilesArtifactNameAndUuid> | undefined, b: FilesArtifactNameAndUuid | PlainMessage<FilesArtifactNameAndUuid> | undefined): boolean;
+  static equals(a: CopyFilesToServiceChunk | PlainMessage<CopyFilesToServiceChunk> | undefined, b: CopyFilesToServiceChunk | PlainMessage<CopyFilesToServiceChunk> | undefined): boolean;
 }
 
 /**
- * @generated from message api_container_api.ListFilesArtifactNamesAndUuidsResponse
+ * @generated from message api_container_api.CopyFilesArtifactToServiceArgs
  */
-export declare class ListFilesArtifactNamesAndUuidsResponse extends Message<ListFilesArtifactNamesAndUuidsResponse> {
+export declare class CopyFilesArtifactToServiceArgs extends Message<CopyFilesArtifactToServiceArgs> {
   /**
-   * @generated from field: repeated api_container_api.FilesArtifactNameAndUuid file_names_and_uuids = 1;
+   * Identifier of the files artifact whose content will be copied
+   *
+   * @generated from field: string files_artifact_identifier = 1;
    */
-  fileNamesAndUuids: FilesArtifactNameAndUuid[];
+  filesArtifactIdentifier: string;
 
-  constructor(data?: PartialMessage<ListFilesArtifactNamesAndUuidsResponse>);
+  /**
+   * Identifier of the service where the files will be copied to
+   *
+   * @generated from field: string service_identifier = 2;
+   */
+  serviceIdentifier: string;
+
+  /**
+   * The absolute path of the directory where the files will be copied into, created if it doesn't exist
+   *
+   * @generated from field: string destination_path = 3;
+   */
+  destinationPath: string;
+
+  constructor(data?: PartialMessage<CopyFilesArtifactToServiceArgs>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "api_container_api.ListFilesArtifactNamesAndUuidsResponse";
+  static readonly typeName = "api_container_api.CopyFilesArtifactToServiceArgs";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFilesArtifactNamesAndUuidsResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CopyFilesArtifactToServiceArgs;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFilesArtifactNamesAndUuidsResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CopyFilesArtifactToServiceArgs;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFilesArtifactNamesAndUuidsResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CopyFilesArtifactToServiceArgs;
 
-  static equals(a: ListFilesArtifactNamesAndUuidsResponse | PlainMessage<ListFilesArtifactNamesAndUuidsResponse> | undefined, b: ListFilesArtifactNamesAndUuidsResponse | PlainMessage<ListFilesArtifactNamesAndUuidsResponse> | undefined): boolean;
+  static equals(a: CopyFilesArtifactToServiceArgs | PlainMessage<CopyFilesArtifactToServiceArgs> | undefined, b: CopyFilesArtifactToServiceArgs | PlainMessage<CopyFilesArtifactToServiceArgs> | undefined): boolean;
 }
 
 /**
- * @generated from message api_container_api.InspectFilesArtifactContentsRequest
+ * @generated from message api_container_api.CopyFilesFromServiceArgs
  */
-export declare class InspectFilesArtifactContentsRequest extends Message<InspectFilesArtifactContentsRequest> {
+export declare class CopyFilesFromServiceArgs extends Message<CopyFilesFromServiceArgs> {
   /**
-   * @generated from field: api_container_api.FilesArtifactNameAndUuid file_names_and_uuid = 1;
+   * Identifier of the service where the files will be copied from
+   *
+   * @generated from field: string service_identifier = 1;
    */
-  fileNamesAndUuid?: FilesArtifactNameAndUuid;
-
-  constructor(data?: PartialMessage<InspectFilesArtifactContentsRequest>);
+  serviceIdentifier: string;
+
+  /**
+   * The absolute path of the file or directory that will be copied
+   *
+   * @generated from field: string source_path = 2;
+   */
+  sourcePath: string;
+
+  constructor(data?: PartialMessage<CopyFilesFromServiceArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.CopyFilesFromServiceArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CopyFilesFromServiceArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CopyFilesFromServiceArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CopyFilesFromServiceArgs;
+
+  static equals(a: CopyFilesFromServiceArgs | PlainMessage<CopyFilesFromServiceArgs> | undefined, b: CopyFilesFromServiceArgs | PlainMessage<CopyFilesFromServiceArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.FilesArtifactNameAndUuid
+ */
+export declare class FilesArtifactNameAndUuid extends Message<FilesArtifactNameAndUuid> {
+  /**
+   * A string representing the name of the file
+   *
+   * @generated from field: string fileName = 1;
+   */
+  fileName: string;
+
+  /**
+   * A string representing the uuid of the file
+   *
+   * @generated from field: string fileUuid = 2;
+   */
+  fileUuid: string;
+
+  /**
+   * The size of the stored files artifact archive, in bytes
+   *
+   * @generated from field: uint64 size = 3;
+   */
+  size: bigint;
+
+  constructor(data?: PartialMessage<FilesArtifactNameAndUuid>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.FilesArtifactNameAndUuid";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FilesArtifactNameAndUuid;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FilesArtifactNameAndUuid;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FilesArtifactNameAndUuid;
+
+  static equals(a: FilesArtifactNameAndUuid | PlainMessage<FilesArtifactNameAndUuid> | undefined, b: FilesArtifactNameAndUuid | PlainMessage<FilesArtifactNameAndUuid> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ListFilesArtifactNamesAndUuidsResponse
+ */
+export declare class ListFilesArtifactNamesAndUuidsResponse extends Message<ListFilesArtifactNamesAndUuidsResponse> {
+  /**
+   * @generated from field: repeated api_container_api.FilesArtifactNameAndUuid file_names_and_uuids = 1;
+   */
+  fileNamesAndUuids: FilesArtifactNameAndUuid[];
+
+  /**
+   * The total size of the stored files artifacts, in bytes
+   *
+   * @generated from field: uint64 total_size = 2;
+   */
+  totalSize: bigint;
+
+  /**
+   * The maximum total size of the stored files artifacts, in bytes, if the enclave has a quota
+   *
+   * @generated from field: optional uint64 storage_quota = 3;
+   */
+  storageQuota?: bigint;
+
+  constructor(data?: PartialMessage<ListFilesArtifactNamesAndUuidsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ListFilesArtifactNamesAndUuidsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFilesArtifactNamesAndUuidsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFilesArtifactNamesAndUuidsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFilesArtifactNamesAndUuidsResponse;
+
+  static equals(a: ListFilesArtifactNamesAndUuidsResponse | PlainMessage<ListFilesArtifactNamesAndUuidsResponse> | undefined, b: ListFilesArtifactNamesAndUuidsResponse | PlainMessage<ListFilesArtifactNamesAndUuidsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.RemoveFilesArtifactArgs
+ */
+export declare class RemoveFilesArtifactArgs extends Message<RemoveFilesArtifactArgs> {
+  /**
+   * UUID, shortened UUID or name of the files artifact to remove
+   *
+   * @generated from field: string identifier = 1;
+   */
+  identifier: string;
+
+  constructor(data?: PartialMessage<RemoveFilesArtifactArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RemoveFilesArtifactArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveFilesArtifactArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveFilesArtifactArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveFilesArtifactArgs;
+
+  static equals(a: RemoveFilesArtifactArgs | PlainMessage<RemoveFilesArtifactArgs> | undefined, b: RemoveFilesArtifactArgs | PlainMessage<RemoveFilesArtifactArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ListFilesArtifactVersionsArgs
+ */
+export declare class ListFilesArtifactVersionsArgs extends Message<ListFilesArtifactVersionsArgs> {
+  /**
+   * UUID, shortened UUID or name of the files artifact to list the versions of
+   *
+   * @generated from field: string identifier = 1;
+   */
+  identifier: string;
+
+  constructor(data?: PartialMessage<ListFilesArtifactVersionsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ListFilesArtifactVersionsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFilesArtifactVersionsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFilesArtifactVersionsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFilesArtifactVersionsArgs;
+
+  static equals(a: ListFilesArtifactVersionsArgs | PlainMessage<ListFilesArtifactVersionsArgs> | undefined, b: ListFilesArtifactVersionsArgs | PlainMessage<ListFilesArtifactVersionsArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ListFilesArtifactVersionsResponse
+ */
+export declare class ListFilesArtifactVersionsResponse extends Message<ListFilesArtifactVersionsResponse> {
+  /**
+   * @generated from field: repeated api_container_api.FilesArtifactVersion versions = 1;
+   */
+  versions: FilesArtifactVersion[];
+
+  constructor(data?: PartialMessage<ListFilesArtifactVersionsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ListFilesArtifactVersionsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFilesArtifactVersionsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFilesArtifactVersionsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFilesArtifactVersionsResponse;
+
+  static equals(a: ListFilesArtifactVersionsResponse | PlainMessage<ListFilesArtifactVersionsResponse> | undefined, b: ListFilesArtifactVersionsResponse | PlainMessage<ListFilesArtifactVersionsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.FilesArtifactVersion
+ */
+export declare class FilesArtifactVersion extends Message<FilesArtifactVersion> {
+  /**
+   * Version number, starting at 1 and incremented every time the files artifact content is updated
+   *
+   * @generated from field: uint32 number = 1;
+   */
+  number: number;
+
+  /**
+   * MD5 of the version content, hex encoded
+   *
+   * @generated from field: string content_md5 = 2;
+   */
+  contentMd5: string;
+
+  /**
+   * Time the version was stored, not set if unknown
+   *
+   * @generated from field: google.protobuf.Timestamp creation_time = 3;
+   */
+  creationTime?: Timestamp;
+
+  /**
+   * Whether the version is the current content of the files artifact
+   *
+   * @generated from field: bool is_current = 4;
+   */
+  isCurrent: boolean;
+
+  constructor(data?: PartialMessage<FilesArtifactVersion>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.FilesArtifactVersion";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FilesArtifactVersion;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FilesArtifactVersion;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FilesArtifactVersion;
+
+  static equals(a: FilesArtifactVersion | PlainMessage<FilesArtifactVersion> | undefined, b: FilesArtifactVersion | PlainMessage<FilesArtifactVersion> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.InspectFilesArtifactContentsRequest
+ */
+export declare class InspectFilesArtifactContentsRequest extends Message<InspectFilesArtifactContentsRequest> {
+  /**
+   * @generated from field: api_container_api.FilesArtifactNameAndUuid file_names_and_uuid = 1;
+   */
+  fileNamesAndUuid?: FilesArtifactNameAndUuid;
+
+  /**
+   * Version of the files artifact to inspect, the current one if not set
+   *
+   * @generated from field: optional uint32 version = 2;
+   */
+  version?: number;
+
+  constructor(data?: PartialMessage<InspectFilesArtifactContentsRequest>);
 
   static readonly runtime: typeof proto3;
   static readonly typeName = "api_container_api.InspectFilesArtifactContentsRequest";
@@ -1788,3 +2415,487 @@ export declare class GetStarlarkRunResponse extends Message<GetStarlarkRunRespon
   static equals(a: GetStarlarkRunResponse | PlainMessage<GetStarlarkRunResponse> | undefined, b: GetStarlarkRunResponse | PlainMessage<GetStarlarkRunResponse> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                               Enclave Snapshots
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ExportEnclaveSnapshotArgs
+ */
+export declare class ExportEnclaveSnapshotArgs extends Message<ExportEnclaveSnapshotArgs> {
+  /**
+   * Whether the content of the persistent directories should be part of the snapshot
+   *
+   * @generated from field: bool include_persistent_directories = 1;
+   */
+  includePersistentDirectories: boolean;
+
+  /**
+   * Whether the filesystems of the service containers should be part of the snapshot (Docker only)
+   *
+   * @generated from field: bool include_container_filesystems = 2;
+   */
+  includeContainerFilesystems: boolean;
+
+  constructor(data?: PartialMessage<ExportEnclaveSnapshotArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ExportEnclaveSnapshotArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportEnclaveSnapshotArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportEnclaveSnapshotArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportEnclaveSnapshotArgs;
+
+  static equals(a: ExportEnclaveSnapshotArgs | PlainMessage<ExportEnclaveSnapshotArgs> | undefined, b: ExportEnclaveSnapshotArgs | PlainMessage<ExportEnclaveSnapshotArgs> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                     Resume Services
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ResumeServicesResponse
+ */
+export declare class ResumeServicesResponse extends Message<ResumeServicesResponse> {
+  /**
+   * The names of the services that were restarted, in the order they were restarted in
+   *
+   * @generated from field: repeated string resumed_service_names = 1;
+   */
+  resumedServiceNames: string[];
+
+  constructor(data?: PartialMessage<ResumeServicesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ResumeServicesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeServicesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeServicesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeServicesResponse;
+
+  static equals(a: ResumeServicesResponse | PlainMessage<ResumeServicesResponse> | undefined, b: ResumeServicesResponse | PlainMessage<ResumeServicesResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                     Enclave Expiry
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.EnclaveExpiry
+ */
+export declare class EnclaveExpiry extends Message<EnclaveExpiry> {
+  /**
+   * The time after which the enclave expires, not set if the enclave has no time-to-live
+   *
+   * @generated from field: optional google.protobuf.Timestamp expiration_time = 1;
+   */
+  expirationTime?: Timestamp;
+
+  /**
+   * The number of seconds without activity after which the enclave expires, 0 if it never expires for being idle
+   *
+   * @generated from field: uint32 idle_timeout_seconds = 2;
+   */
+  idleTimeoutSeconds: number;
+
+  /**
+   * Whether the enclave is destroyed rather than stopped when it expires
+   *
+   * @generated from field: bool should_destroy = 3;
+   */
+  shouldDestroy: boolean;
+
+  /**
+   * The last time the API of the enclave was called; ignored by SetEnclaveExpiry
+   *
+   * @generated from field: google.protobuf.Timestamp last_activity_time = 4;
+   */
+  lastActivityTime?: Timestamp;
+
+  constructor(data?: PartialMessage<EnclaveExpiry>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.EnclaveExpiry";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveExpiry;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveExpiry;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveExpiry;
+
+  static equals(a: EnclaveExpiry | PlainMessage<EnclaveExpiry> | undefined, b: EnclaveExpiry | PlainMessage<EnclaveExpiry> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                 Enclave Resource Quotas
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ResourceQuota
+ */
+export declare class ResourceQuota extends Message<ResourceQuota> {
+  /**
+   * How much of the resource the enclave currently uses
+   *
+   * @generated from field: uint64 usage = 1;
+   */
+  usage: bigint;
+
+  /**
+   * How much of the resource the enclave can use, not set if there's no quota
+   *
+   * @generated from field: optional uint64 quota = 2;
+   */
+  quota?: bigint;
+
+  constructor(data?: PartialMessage<ResourceQuota>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ResourceQuota";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceQuota;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceQuota;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceQuota;
+
+  static equals(a: ResourceQuota | PlainMessage<ResourceQuota> | undefined, b: ResourceQuota | PlainMessage<ResourceQuota> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetEnclaveResourceQuotasResponse
+ */
+export declare class GetEnclaveResourceQuotasResponse extends Message<GetEnclaveResourceQuotasResponse> {
+  /**
+   * The millicpus the services are allocated through their max_cpu
+   *
+   * @generated from field: api_container_api.ResourceQuota cpu_millicpus = 1;
+   */
+  cpuMillicpus?: ResourceQuota;
+
+  /**
+   * The megabytes of memory the services are allocated through their max_memory
+   *
+   * @generated from field: api_container_api.ResourceQuota memory_megabytes = 2;
+   */
+  memoryMegabytes?: ResourceQuota;
+
+  /**
+   * The number of services, whether they are running or not
+   *
+   * @generated from field: api_container_api.ResourceQuota service_count = 3;
+   */
+  serviceCount?: ResourceQuota;
+
+  /**
+   * The bytes used by the stored files artifacts
+   *
+   * @generated from field: api_container_api.ResourceQuota files_artifacts_storage_bytes = 4;
+   */
+  filesArtifactsStorageBytes?: ResourceQuota;
+
+  constructor(data?: PartialMessage<GetEnclaveResourceQuotasResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetEnclaveResourceQuotasResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveResourceQuotasResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveResourceQuotasResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveResourceQuotasResponse;
+
+  static equals(a: GetEnclaveResourceQuotasResponse | PlainMessage<GetEnclaveResourceQuotasResponse> | undefined, b: GetEnclaveResourceQuotasResponse | PlainMessage<GetEnclaveResourceQuotasResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                     Services Stats
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetServicesStatsArgs
+ */
+export declare class GetServicesStatsArgs extends Message<GetServicesStatsArgs> {
+  /**
+   * "Set" of identifiers of the running services to get the stats of
+   * If empty, will get the stats of all running services
+   *
+   * @generated from field: map<string, bool> service_identifiers = 1;
+   */
+  serviceIdentifiers: { [key: string]: boolean };
+
+  /**
+   * If true, keeps sending the stats every few seconds until the client cancels
+   *
+   * @generated from field: bool follow = 2;
+   */
+  follow: boolean;
+
+  constructor(data?: PartialMessage<GetServicesStatsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetServicesStatsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetServicesStatsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetServicesStatsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetServicesStatsArgs;
+
+  static equals(a: GetServicesStatsArgs | PlainMessage<GetServicesStatsArgs> | undefined, b: GetServicesStatsArgs | PlainMessage<GetServicesStatsArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ServiceStats
+ */
+export declare class ServiceStats extends Message<ServiceStats> {
+  /**
+   * The CPU the service used over the sampling window, where 1000 millicores is one full CPU
+   *
+   * @generated from field: uint64 cpu_millicores = 1;
+   */
+  cpuMillicores: bigint;
+
+  /**
+   * @generated from field: uint64 memory_usage_bytes = 2;
+   */
+  memoryUsageBytes: bigint;
+
+  /**
+   * Not set if the backend knows no memory limit for the service
+   *
+   * @generated from field: optional uint64 memory_limit_bytes = 3;
+   */
+  memoryLimitBytes?: bigint;
+
+  /**
+   * The network and block IO stats are cumulative since the service started. They are not set on Kubernetes, whose
+   * metrics API only reports CPU and memory
+   *
+   * @generated from field: optional uint64 network_received_bytes = 4;
+   */
+  networkReceivedBytes?: bigint;
+
+  /**
+   * @generated from field: optional uint64 network_transmitted_bytes = 5;
+   */
+  networkTransmittedBytes?: bigint;
+
+  /**
+   * @generated from field: optional uint64 block_read_bytes = 6;
+   */
+  blockReadBytes?: bigint;
+
+  /**
+   * @generated from field: optional uint64 block_written_bytes = 7;
+   */
+  blockWrittenBytes?: bigint;
+
+  constructor(data?: PartialMessage<ServiceStats>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceStats";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceStats;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceStats;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceStats;
+
+  static equals(a: ServiceStats | PlainMessage<ServiceStats> | undefined, b: ServiceStats | PlainMessage<ServiceStats> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetServicesStatsResponse
+ */
+export declare class GetServicesStatsResponse extends Message<GetServicesStatsResponse> {
+  /**
+   * Service name -> stats of the service
+   *
+   * @generated from field: map<string, api_container_api.ServiceStats> service_stats = 1;
+   */
+  serviceStats: { [key: string]: ServiceStats };
+
+  /**
+   * Service name -> why the stats of the service couldn't be sampled, for the services left out of service_stats
+   *
+   * @generated from field: map<string, string> service_errors = 2;
+   */
+  serviceErrors: { [key: string]: string };
+
+  constructor(data?: PartialMessage<GetServicesStatsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetServicesStatsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetServicesStatsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetServicesStatsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetServicesStatsResponse;
+
+  static equals(a: GetServicesStatsResponse | PlainMessage<GetServicesStatsResponse> | undefined, b: GetServicesStatsResponse | PlainMessage<GetServicesStatsResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                     Starlark Lint
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.LintStarlarkArgs
+ */
+export declare class LintStarlarkArgs extends Message<LintStarlarkArgs> {
+  /**
+   * The content of the Starlark files to lint, keyed by path. They are linted together as the files of a package
+   *
+   * @generated from field: map<string, bytes> starlark_files = 1;
+   */
+  starlarkFiles: { [key: string]: Uint8Array };
+
+  constructor(data?: PartialMessage<LintStarlarkArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.LintStarlarkArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LintStarlarkArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LintStarlarkArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LintStarlarkArgs;
+
+  static equals(a: LintStarlarkArgs | PlainMessage<LintStarlarkArgs> | undefined, b: LintStarlarkArgs | PlainMessage<LintStarlarkArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.StarlarkLintRule
+ */
+export declare class StarlarkLintRule extends Message<StarlarkLintRule> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * "error" or "warning", named after the SARIF levels
+   *
+   * @generated from field: string severity = 2;
+   */
+  severity: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  constructor(data?: PartialMessage<StarlarkLintRule>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkLintRule";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkLintRule;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkLintRule;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkLintRule;
+
+  static equals(a: StarlarkLintRule | PlainMessage<StarlarkLintRule> | undefined, b: StarlarkLintRule | PlainMessage<StarlarkLintRule> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.StarlarkLintFinding
+ */
+export declare class StarlarkLintFinding extends Message<StarlarkLintFinding> {
+  /**
+   * @generated from field: string rule_id = 1;
+   */
+  ruleId: string;
+
+  /**
+   * @generated from field: string severity = 2;
+   */
+  severity: string;
+
+  /**
+   * @generated from field: string filepath = 3;
+   */
+  filepath: string;
+
+  /**
+   * 1-based, 0 if the position of the finding isn't known
+   *
+   * @generated from field: int32 line = 4;
+   */
+  line: number;
+
+  /**
+   * @generated from field: int32 column = 5;
+   */
+  column: number;
+
+  /**
+   * @generated from field: string message = 6;
+   */
+  message: string;
+
+  constructor(data?: PartialMessage<StarlarkLintFinding>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkLintFinding";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkLintFinding;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkLintFinding;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkLintFinding;
+
+  static equals(a: StarlarkLintFinding | PlainMessage<StarlarkLintFinding> | undefined, b: StarlarkLintFinding | PlainMessage<StarlarkLintFinding> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.LintStarlarkResponse
+ */
+export declare class LintStarlarkResponse extends Message<LintStarlarkResponse> {
+  /**
+   * Sorted by file and position
+   *
+   * @generated from field: repeated api_container_api.StarlarkLintFinding findings = 1;
+   */
+  findings: StarlarkLintFinding[];
+
+  /**
+   * Every rule the linter runs, in the order they are documented
+   *
+   * @generated from field: repeated api_container_api.StarlarkLintRule rules = 2;
+   */
+  rules: StarlarkLintRule[];
+
+  constructor(data?: PartialMessage<LintStarlarkResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.LintStarlarkResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LintStarlarkResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LintStarlarkResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LintStarlarkResponse;
+
+  static equals(a: LintStarlarkResponse | PlainMessage<LintStarlarkResponse> | undefined, b: LintStarlarkResponse | PlainMessage<LintStarlarkResponse> | undefined): boolean;
+}
+
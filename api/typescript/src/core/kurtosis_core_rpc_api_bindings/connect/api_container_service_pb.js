@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { proto3 } from "@bufbuild/protobuf";
+import { proto3, Timestamp } from "@bufbuild/protobuf";
 
 /**
  * @generated from enum api_container_api.ServiceStatus
@@ -17,6 +17,18 @@ export const ServiceStatus = proto3.makeEnum(
   ],
 );
 
+/**
+ * @generated from enum api_container_api.ServiceHealthStatus
+ */
+export const ServiceHealthStatus = proto3.makeEnum(
+  "api_container_api.ServiceHealthStatus",
+  [
+    {no: 0, name: "HEALTH_UNKNOWN"},
+    {no: 1, name: "HEALTHY"},
+    {no: 2, name: "UNHEALTHY"},
+  ],
+);
+
 /**
  * @generated from enum api_container_api.ImageDownloadMode
  */
@@ -41,6 +53,18 @@ export const Connect = proto3.makeEnum(
   ],
 );
 
+/**
+ * @generated from enum api_container_api.PackageLockMode
+ */
+export const PackageLockMode = proto3.makeEnum(
+  "api_container_api.PackageLockMode",
+  [
+    {no: 0, name: "ENFORCE_LOCK"},
+    {no: 1, name: "EXTEND_LOCK"},
+    {no: 2, name: "IGNORE_LOCK"},
+  ],
+);
+
 /**
  * @generated from enum api_container_api.KurtosisFeatureFlag
  */
@@ -51,6 +75,18 @@ export const KurtosisFeatureFlag = proto3.makeEnum(
   ],
 );
 
+/**
+ * @generated from enum api_container_api.StarlarkPlanDiffChangeType
+ */
+export const StarlarkPlanDiffChangeType = proto3.makeEnum(
+  "api_container_api.StarlarkPlanDiffChangeType",
+  [
+    {no: 0, name: "COMPONENT_ADDED"},
+    {no: 1, name: "COMPONENT_UPDATED"},
+    {no: 2, name: "COMPONENT_REMOVED"},
+  ],
+);
+
 /**
  * @generated from enum api_container_api.RestartPolicy
  */
@@ -132,6 +168,8 @@ export const ServiceInfo = proto3.makeMessageType(
     { no: 7, name: "shortened_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "service_status", kind: "enum", T: proto3.getEnumType(ServiceStatus) },
     { no: 9, name: "container", kind: "message", T: Container },
+    { no: 10, name: "health_status", kind: "enum", T: proto3.getEnumType(ServiceHealthStatus) },
+    { no: 11, name: "restart_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ],
 );
 
@@ -150,6 +188,8 @@ export const RunStarlarkScriptArgs = proto3.makeMessageType(
     { no: 7, name: "cloud_instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 8, name: "cloud_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 9, name: "image_download_mode", kind: "enum", T: proto3.getEnumType(ImageDownloadMode), opt: true },
+    { no: 10, name: "git_credentials", kind: "message", T: GitCredential, repeated: true },
+    { no: 11, name: "diff", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
   ],
 );
 
@@ -172,6 +212,50 @@ export const RunStarlarkPackageArgs = proto3.makeMessageType(
     { no: 12, name: "cloud_instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 13, name: "cloud_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 14, name: "image_download_mode", kind: "enum", T: proto3.getEnumType(ImageDownloadMode), opt: true },
+    { no: 15, name: "git_credentials", kind: "message", T: GitCredential, repeated: true },
+    { no: 16, name: "package_lock_mode", kind: "enum", T: proto3.getEnumType(PackageLockMode), opt: true },
+    { no: 17, name: "diff", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ],
+);
+
+/**
+ * Credentials for a single Git host, e.g. 'github.com'
+ *
+ * @generated from message api_container_api.GitCredential
+ */
+export const GitCredential = proto3.makeMessageType(
+  "api_container_api.GitCredential",
+  () => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "https_token", kind: "message", T: HttpsTokenGitCredential, oneof: "credential" },
+    { no: 3, name: "ssh_key", kind: "message", T: SshKeyGitCredential, oneof: "credential" },
+  ],
+);
+
+/**
+ * A personal access token (or any other token accepted by the host) used to clone over HTTPS
+ *
+ * @generated from message api_container_api.HttpsTokenGitCredential
+ */
+export const HttpsTokenGitCredential = proto3.makeMessageType(
+  "api_container_api.HttpsTokenGitCredential",
+  () => [
+    { no: 1, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * An SSH private key used to clone over SSH
+ *
+ * @generated from message api_container_api.SshKeyGitCredential
+ */
+export const SshKeyGitCredential = proto3.makeMessageType(
+  "api_container_api.SshKeyGitCredential",
+  () => [
+    { no: 1, name: "private_key", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "passphrase", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "known_hosts", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
   ],
 );
 
@@ -192,6 +276,7 @@ export const StarlarkRunResponseLine = proto3.makeMessageType(
     { no: 5, name: "run_finished_event", kind: "message", T: StarlarkRunFinishedEvent, oneof: "run_response_line" },
     { no: 6, name: "warning", kind: "message", T: StarlarkWarning, oneof: "run_response_line" },
     { no: 7, name: "info", kind: "message", T: StarlarkInfo, oneof: "run_response_line" },
+    { no: 8, name: "plan_diff", kind: "message", T: StarlarkPlanDiff, oneof: "run_response_line" },
   ],
 );
 
@@ -325,6 +410,44 @@ export const StarlarkRunFinishedEvent = proto3.makeMessageType(
   () => [
     { no: 1, name: "is_run_successful", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 2, name: "serialized_output", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "resolved_package_dependencies", kind: "message", T: PackageDependency, repeated: true },
+  ],
+);
+
+/**
+ * The changes a run would make to an enclave, compared to the instructions already executed in it
+ *
+ * @generated from message api_container_api.StarlarkPlanDiff
+ */
+export const StarlarkPlanDiff = proto3.makeMessageType(
+  "api_container_api.StarlarkPlanDiff",
+  () => [
+    { no: 1, name: "service_changes", kind: "message", T: StarlarkPlanDiffComponentChange, repeated: true },
+    { no: 2, name: "files_artifact_changes", kind: "message", T: StarlarkPlanDiffComponentChange, repeated: true },
+    { no: 3, name: "instructions_to_execute", kind: "message", T: StarlarkInstruction, repeated: true },
+    { no: 4, name: "skipped_instructions", kind: "message", T: StarlarkInstruction, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.StarlarkPlanDiffComponentChange
+ */
+export const StarlarkPlanDiffComponentChange = proto3.makeMessageType(
+  "api_container_api.StarlarkPlanDiffComponentChange",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "change_type", kind: "enum", T: proto3.getEnumType(StarlarkPlanDiffChangeType) },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.PackageDependency
+ */
+export const PackageDependency = proto3.makeMessageType(
+  "api_container_api.PackageDependency",
+  () => [
+    { no: 1, name: "repository", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "commit", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -495,6 +618,7 @@ export const DownloadFilesArtifactArgs = proto3.makeMessageType(
   "api_container_api.DownloadFilesArtifactArgs",
   () => [
     { no: 1, name: "identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "version", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
   ],
 );
 
@@ -545,6 +669,41 @@ export const StoreFilesArtifactFromServiceResponse = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message api_container_api.CopyFilesToServiceChunk
+ */
+export const CopyFilesToServiceChunk = proto3.makeMessageType(
+  "api_container_api.CopyFilesToServiceChunk",
+  () => [
+    { no: 1, name: "chunk", kind: "message", T: StreamedDataChunk },
+    { no: 2, name: "service_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "destination_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.CopyFilesArtifactToServiceArgs
+ */
+export const CopyFilesArtifactToServiceArgs = proto3.makeMessageType(
+  "api_container_api.CopyFilesArtifactToServiceArgs",
+  () => [
+    { no: 1, name: "files_artifact_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "destination_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.CopyFilesFromServiceArgs
+ */
+export const CopyFilesFromServiceArgs = proto3.makeMessageType(
+  "api_container_api.CopyFilesFromServiceArgs",
+  () => [
+    { no: 1, name: "service_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "source_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
 /**
  * @generated from message api_container_api.FilesArtifactNameAndUuid
  */
@@ -553,6 +712,7 @@ export const FilesArtifactNameAndUuid = proto3.makeMessageType(
   () => [
     { no: 1, name: "fileName", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "fileUuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "size", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
   ],
 );
 
@@ -563,6 +723,51 @@ export const ListFilesArtifactNamesAndUuidsResponse = proto3.makeMessageType(
   "api_container_api.ListFilesArtifactNamesAndUuidsResponse",
   () => [
     { no: 1, name: "file_names_and_uuids", kind: "message", T: FilesArtifactNameAndUuid, repeated: true },
+    { no: 2, name: "total_size", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "storage_quota", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.RemoveFilesArtifactArgs
+ */
+export const RemoveFilesArtifactArgs = proto3.makeMessageType(
+  "api_container_api.RemoveFilesArtifactArgs",
+  () => [
+    { no: 1, name: "identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ListFilesArtifactVersionsArgs
+ */
+export const ListFilesArtifactVersionsArgs = proto3.makeMessageType(
+  "api_container_api.ListFilesArtifactVersionsArgs",
+  () => [
+    { no: 1, name: "identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ListFilesArtifactVersionsResponse
+ */
+export const ListFilesArtifactVersionsResponse = proto3.makeMessageType(
+  "api_container_api.ListFilesArtifactVersionsResponse",
+  () => [
+    { no: 1, name: "versions", kind: "message", T: FilesArtifactVersion, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.FilesArtifactVersion
+ */
+export const FilesArtifactVersion = proto3.makeMessageType(
+  "api_container_api.FilesArtifactVersion",
+  () => [
+    { no: 1, name: "number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "content_md5", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "creation_time", kind: "message", T: Timestamp },
+    { no: 4, name: "is_current", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
@@ -573,6 +778,7 @@ export const InspectFilesArtifactContentsRequest = proto3.makeMessageType(
   "api_container_api.InspectFilesArtifactContentsRequest",
   () => [
     { no: 1, name: "file_names_and_uuid", kind: "message", T: FilesArtifactNameAndUuid },
+    { no: 2, name: "version", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
   ],
 );
 
@@ -633,3 +839,171 @@ export const GetStarlarkRunResponse = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                               Enclave Snapshots
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ExportEnclaveSnapshotArgs
+ */
+export const ExportEnclaveSnapshotArgs = proto3.makeMessageType(
+  "api_container_api.ExportEnclaveSnapshotArgs",
+  () => [
+    { no: 1, name: "include_persistent_directories", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "include_container_filesystems", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                     Resume Services
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ResumeServicesResponse
+ */
+export const ResumeServicesResponse = proto3.makeMessageType(
+  "api_container_api.ResumeServicesResponse",
+  () => [
+    { no: 1, name: "resumed_service_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                     Enclave Expiry
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.EnclaveExpiry
+ */
+export const EnclaveExpiry = proto3.makeMessageType(
+  "api_container_api.EnclaveExpiry",
+  () => [
+    { no: 1, name: "expiration_time", kind: "message", T: Timestamp, opt: true },
+    { no: 2, name: "idle_timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "should_destroy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "last_activity_time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                 Enclave Resource Quotas
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ResourceQuota
+ */
+export const ResourceQuota = proto3.makeMessageType(
+  "api_container_api.ResourceQuota",
+  () => [
+    { no: 1, name: "usage", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "quota", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetEnclaveResourceQuotasResponse
+ */
+export const GetEnclaveResourceQuotasResponse = proto3.makeMessageType(
+  "api_container_api.GetEnclaveResourceQuotasResponse",
+  () => [
+    { no: 1, name: "cpu_millicpus", kind: "message", T: ResourceQuota },
+    { no: 2, name: "memory_megabytes", kind: "message", T: ResourceQuota },
+    { no: 3, name: "service_count", kind: "message", T: ResourceQuota },
+    { no: 4, name: "files_artifacts_storage_bytes", kind: "message", T: ResourceQuota },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                     Services Stats
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetServicesStatsArgs
+ */
+export const GetServicesStatsArgs = proto3.makeMessageType(
+  "api_container_api.GetServicesStatsArgs",
+  () => [
+    { no: 1, name: "service_identifiers", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 8 /* ScalarType.BOOL */} },
+    { no: 2, name: "follow", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServiceStats
+ */
+export const ServiceStats = proto3.makeMessageType(
+  "api_container_api.ServiceStats",
+  () => [
+    { no: 1, name: "cpu_millicores", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "memory_usage_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "memory_limit_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 4, name: "network_received_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 5, name: "network_transmitted_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 6, name: "block_read_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 7, name: "block_written_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetServicesStatsResponse
+ */
+export const GetServicesStatsResponse = proto3.makeMessageType(
+  "api_container_api.GetServicesStatsResponse",
+  () => [
+    { no: 1, name: "service_stats", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: ServiceStats} },
+    { no: 2, name: "service_errors", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                     Starlark Lint
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.LintStarlarkArgs
+ */
+export const LintStarlarkArgs = proto3.makeMessageType(
+  "api_container_api.LintStarlarkArgs",
+  () => [
+    { no: 1, name: "starlark_files", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 12 /* ScalarType.BYTES */} },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.StarlarkLintRule
+ */
+export const StarlarkLintRule = proto3.makeMessageType(
+  "api_container_api.StarlarkLintRule",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "severity", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.StarlarkLintFinding
+ */
+export const StarlarkLintFinding = proto3.makeMessageType(
+  "api_container_api.StarlarkLintFinding",
+  () => [
+    { no: 1, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "severity", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "filepath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "line", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "column", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.LintStarlarkResponse
+ */
+export const LintStarlarkResponse = proto3.makeMessageType(
+  "api_container_api.LintStarlarkResponse",
+  () => [
+    { no: 1, name: "findings", kind: "message", T: StarlarkLintFinding, repeated: true },
+    { no: 2, name: "rules", kind: "message", T: StarlarkLintRule, repeated: true },
+  ],
+);
+
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, EnclaveSnapshotChunk, ExtendEnclaveArgs, ExtendEnclaveResponse, GetEnclavesArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, RestoreEnclaveArgs, RestoreEnclaveResponse, SnapshotEnclaveArgs, StartEnclaveArgs, StartEnclaveResponse, StopEnclaveArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -38,13 +38,13 @@ export declare const EngineService: {
       readonly kind: MethodKind.Unary,
     },
     /**
-     * Returns information about the existing enclaves
+     * Returns information about the existing enclaves, optionally only the ones matching a label selector
      *
      * @generated from rpc engine_api.EngineService.GetEnclaves
      */
     readonly getEnclaves: {
       readonly name: "GetEnclaves",
-      readonly I: typeof Empty,
+      readonly I: typeof GetEnclavesArgs,
       readonly O: typeof GetEnclavesResponse,
       readonly kind: MethodKind.Unary,
     },
@@ -70,6 +70,28 @@ export declare const EngineService: {
       readonly O: typeof Empty,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Starts a stopped enclave again, resuming its API container and its services
+     *
+     * @generated from rpc engine_api.EngineService.StartEnclave
+     */
+    readonly startEnclave: {
+      readonly name: "StartEnclave",
+      readonly I: typeof StartEnclaveArgs,
+      readonly O: typeof StartEnclaveResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Pushes back the time at which an enclave expires
+     *
+     * @generated from rpc engine_api.EngineService.ExtendEnclave
+     */
+    readonly extendEnclave: {
+      readonly name: "ExtendEnclave",
+      readonly I: typeof ExtendEnclaveArgs,
+      readonly O: typeof ExtendEnclaveResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * Destroys an enclave, removing all artifacts associated with it
      *
@@ -103,6 +125,28 @@ export declare const EngineService: {
       readonly O: typeof GetServiceLogsResponse,
       readonly kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Snapshots an enclave into a single archive, streamed back in chunks
+     *
+     * @generated from rpc engine_api.EngineService.SnapshotEnclave
+     */
+    readonly snapshotEnclave: {
+      readonly name: "SnapshotEnclave",
+      readonly I: typeof SnapshotEnclaveArgs,
+      readonly O: typeof EnclaveSnapshotChunk,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Creates a new enclave from an archive produced by SnapshotEnclave, streamed in chunks
+     *
+     * @generated from rpc engine_api.EngineService.RestoreEnclave
+     */
+    readonly restoreEnclave: {
+      readonly name: "RestoreEnclave",
+      readonly I: typeof RestoreEnclaveArgs,
+      readonly O: typeof RestoreEnclaveResponse,
+      readonly kind: MethodKind.ClientStreaming,
+    },
   }
 };
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, EnclaveSnapshotChunk, ExtendEnclaveArgs, ExtendEnclaveResponse, GetEnclavesArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, RestoreEnclaveArgs, RestoreEnclaveResponse, SnapshotEnclaveArgs, StartEnclaveArgs, StartEnclaveResponse, StopEnclaveArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -38,13 +38,13 @@ export const EngineService = {
       kind: MethodKind.Unary,
     },
     /**
-     * Returns information about the existing enclaves
+     * Returns information about the existing enclaves, optionally only the ones matching a label selector
      *
      * @generated from rpc engine_api.EngineService.GetEnclaves
      */
     getEnclaves: {
       name: "GetEnclaves",
-      I: Empty,
+      I: GetEnclavesArgs,
       O: GetEnclavesResponse,
       kind: MethodKind.Unary,
     },
@@ -70,6 +70,28 @@ export const EngineService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * Starts a stopped enclave again, resuming its API container and its services
+     *
+     * @generated from rpc engine_api.EngineService.StartEnclave
+     */
+    startEnclave: {
+      name: "StartEnclave",
+      I: StartEnclaveArgs,
+      O: StartEnclaveResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Pushes back the time at which an enclave expires
+     *
+     * @generated from rpc engine_api.EngineService.ExtendEnclave
+     */
+    extendEnclave: {
+      name: "ExtendEnclave",
+      I: ExtendEnclaveArgs,
+      O: ExtendEnclaveResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Destroys an enclave, removing all artifacts associated with it
      *
@@ -103,6 +125,28 @@ export const EngineService = {
       O: GetServiceLogsResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Snapshots an enclave into a single archive, streamed back in chunks
+     *
+     * @generated from rpc engine_api.EngineService.SnapshotEnclave
+     */
+    snapshotEnclave: {
+      name: "SnapshotEnclave",
+      I: SnapshotEnclaveArgs,
+      O: EnclaveSnapshotChunk,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Creates a new enclave from an archive produced by SnapshotEnclave, streamed in chunks
+     *
+     * @generated from rpc engine_api.EngineService.RestoreEnclave
+     */
+    restoreEnclave: {
+      name: "RestoreEnclave",
+      I: RestoreEnclaveArgs,
+      O: RestoreEnclaveResponse,
+      kind: MethodKind.ClientStreaming,
+    },
   }
 };
 
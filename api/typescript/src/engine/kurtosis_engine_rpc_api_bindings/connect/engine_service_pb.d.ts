@@ -6,6 +6,25 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
+/**
+ * @generated from enum engine_api.EnclaveExpiryAction
+ */
+export declare enum EnclaveExpiryAction {
+  /**
+   * The enclave is destroyed, removing all artifacts associated with it
+   *
+   * @generated from enum value: EnclaveExpiryAction_DESTROY = 0;
+   */
+  EnclaveExpiryAction_DESTROY = 0,
+
+  /**
+   * The enclave is stopped, so it can be inspected or started again
+   *
+   * @generated from enum value: EnclaveExpiryAction_STOP = 1;
+   */
+  EnclaveExpiryAction_STOP = 1,
+}
+
 /**
  * @generated from enum engine_api.EnclaveMode
  */
@@ -112,6 +131,48 @@ export declare enum LogLineOperator {
   LogLineOperator_DOES_NOT_CONTAIN_MATCH_REGEX = 3,
 }
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.LogLineFieldOperator
+ */
+export declare enum LogLineFieldOperator {
+  /**
+   * @generated from enum value: LogLineFieldOperator_EQUALS = 0;
+   */
+  LogLineFieldOperator_EQUALS = 0,
+
+  /**
+   * @generated from enum value: LogLineFieldOperator_NOT_EQUALS = 1;
+   */
+  LogLineFieldOperator_NOT_EQUALS = 1,
+
+  /**
+   * @generated from enum value: LogLineFieldOperator_MATCHES_REGEX = 2;
+   */
+  LogLineFieldOperator_MATCHES_REGEX = 2,
+
+  /**
+   * @generated from enum value: LogLineFieldOperator_GREATER_THAN = 3;
+   */
+  LogLineFieldOperator_GREATER_THAN = 3,
+
+  /**
+   * @generated from enum value: LogLineFieldOperator_GREATER_THAN_OR_EQUAL = 4;
+   */
+  LogLineFieldOperator_GREATER_THAN_OR_EQUAL = 4,
+
+  /**
+   * @generated from enum value: LogLineFieldOperator_LESS_THAN = 5;
+   */
+  LogLineFieldOperator_LESS_THAN = 5,
+
+  /**
+   * @generated from enum value: LogLineFieldOperator_LESS_THAN_OR_EQUAL = 6;
+   */
+  LogLineFieldOperator_LESS_THAN_OR_EQUAL = 6,
+}
+
 /**
  * ==============================================================================================
  *                                        Get Engine Info
@@ -177,6 +238,62 @@ export declare class CreateEnclaveArgs extends Message<CreateEnclaveArgs> {
    */
   mode: EnclaveMode;
 
+  /**
+   * The maximum number of bytes the files artifacts of the enclave can use, unlimited if not set
+   *
+   * @generated from field: optional uint64 files_artifacts_storage_quota = 5;
+   */
+  filesArtifactsStorageQuota?: bigint;
+
+  /**
+   * The number of seconds after which the enclave expires, never if not set
+   *
+   * @generated from field: optional uint32 ttl_seconds = 6;
+   */
+  ttlSeconds?: number;
+
+  /**
+   * The number of seconds without API calls or service activity after which the enclave expires, never if not set
+   *
+   * @generated from field: optional uint32 idle_timeout_seconds = 7;
+   */
+  idleTimeoutSeconds?: number;
+
+  /**
+   * What happens to the enclave when it expires
+   *
+   * @generated from field: engine_api.EnclaveExpiryAction expiry_action = 8;
+   */
+  expiryAction: EnclaveExpiryAction;
+
+  /**
+   * Arbitrary user-defined key/value labels attached to the enclave
+   *
+   * @generated from field: map<string, string> labels = 9;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * The maximum total millicpus the services of the enclave can be allocated through their max_cpu, unlimited if not set
+   *
+   * @generated from field: optional uint64 cpu_quota_millicpus = 10;
+   */
+  cpuQuotaMillicpus?: bigint;
+
+  /**
+   * The maximum total megabytes of memory the services of the enclave can be allocated through their max_memory, unlimited if not set
+   *
+   * @generated from field: optional uint64 memory_quota_megabytes = 11;
+   */
+  memoryQuotaMegabytes?: bigint;
+
+  /**
+   * The maximum number of services in the enclave, unlimited if not set
+   *
+   * @generated from field: optional uint32 service_count_quota = 12;
+   */
+  serviceCountQuota?: number;
+
   constructor(data?: PartialMessage<CreateEnclaveArgs>);
 
   static readonly runtime: typeof proto3;
@@ -367,6 +484,27 @@ export declare class EnclaveInfo extends Message<EnclaveInfo> {
    */
   mode: EnclaveMode;
 
+  /**
+   * The time at which the enclave expires, given its time-to-live and idle timeout; not set if the enclave never expires
+   *
+   * @generated from field: optional google.protobuf.Timestamp expiration_time = 10;
+   */
+  expirationTime?: Timestamp;
+
+  /**
+   * What happens to the enclave when it expires
+   *
+   * @generated from field: engine_api.EnclaveExpiryAction expiry_action = 11;
+   */
+  expiryAction: EnclaveExpiryAction;
+
+  /**
+   * The user-defined labels of the enclave
+   *
+   * @generated from field: map<string, string> labels = 12;
+   */
+  labels: { [key: string]: string };
+
   constructor(data?: PartialMessage<EnclaveInfo>);
 
   static readonly runtime: typeof proto3;
@@ -382,6 +520,32 @@ export declare class EnclaveInfo extends Message<EnclaveInfo> {
   static equals(a: EnclaveInfo | PlainMessage<EnclaveInfo> | undefined, b: EnclaveInfo | PlainMessage<EnclaveInfo> | undefined): boolean;
 }
 
+/**
+ * @generated from message engine_api.GetEnclavesArgs
+ */
+export declare class GetEnclavesArgs extends Message<GetEnclavesArgs> {
+  /**
+   * If set, only the enclaves having all of these labels are returned
+   *
+   * @generated from field: map<string, string> label_selector = 1;
+   */
+  labelSelector: { [key: string]: string };
+
+  constructor(data?: PartialMessage<GetEnclavesArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetEnclavesArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclavesArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclavesArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclavesArgs;
+
+  static equals(a: GetEnclavesArgs | PlainMessage<GetEnclavesArgs> | undefined, b: GetEnclavesArgs | PlainMessage<GetEnclavesArgs> | undefined): boolean;
+}
+
 /**
  * @generated from message engine_api.GetEnclavesResponse
  */
@@ -504,6 +668,125 @@ export declare class StopEnclaveArgs extends Message<StopEnclaveArgs> {
   static equals(a: StopEnclaveArgs | PlainMessage<StopEnclaveArgs> | undefined, b: StopEnclaveArgs | PlainMessage<StopEnclaveArgs> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                       Start Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.StartEnclaveArgs
+ */
+export declare class StartEnclaveArgs extends Message<StartEnclaveArgs> {
+  /**
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to start
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  constructor(data?: PartialMessage<StartEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.StartEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartEnclaveArgs;
+
+  static equals(a: StartEnclaveArgs | PlainMessage<StartEnclaveArgs> | undefined, b: StartEnclaveArgs | PlainMessage<StartEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.StartEnclaveResponse
+ */
+export declare class StartEnclaveResponse extends Message<StartEnclaveResponse> {
+  /**
+   * The names of the services that were restarted, in the order they were restarted in
+   *
+   * @generated from field: repeated string resumed_service_names = 1;
+   */
+  resumedServiceNames: string[];
+
+  constructor(data?: PartialMessage<StartEnclaveResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.StartEnclaveResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartEnclaveResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartEnclaveResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartEnclaveResponse;
+
+  static equals(a: StartEnclaveResponse | PlainMessage<StartEnclaveResponse> | undefined, b: StartEnclaveResponse | PlainMessage<StartEnclaveResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Extend Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.ExtendEnclaveArgs
+ */
+export declare class ExtendEnclaveArgs extends Message<ExtendEnclaveArgs> {
+  /**
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to extend
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  /**
+   * The number of seconds the time-to-live of the enclave is extended by; extending an enclave also resets its idle timeout
+   *
+   * @generated from field: uint32 extension_seconds = 2;
+   */
+  extensionSeconds: number;
+
+  constructor(data?: PartialMessage<ExtendEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ExtendEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExtendEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExtendEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExtendEnclaveArgs;
+
+  static equals(a: ExtendEnclaveArgs | PlainMessage<ExtendEnclaveArgs> | undefined, b: ExtendEnclaveArgs | PlainMessage<ExtendEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ExtendEnclaveResponse
+ */
+export declare class ExtendEnclaveResponse extends Message<ExtendEnclaveResponse> {
+  /**
+   * The new time at which the enclave expires
+   *
+   * @generated from field: optional google.protobuf.Timestamp expiration_time = 1;
+   */
+  expirationTime?: Timestamp;
+
+  constructor(data?: PartialMessage<ExtendEnclaveResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ExtendEnclaveResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExtendEnclaveResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExtendEnclaveResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExtendEnclaveResponse;
+
+  static equals(a: ExtendEnclaveResponse | PlainMessage<ExtendEnclaveResponse> | undefined, b: ExtendEnclaveResponse | PlainMessage<ExtendEnclaveResponse> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                                       Destroy Enclave
@@ -514,11 +797,19 @@ export declare class StopEnclaveArgs extends Message<StopEnclaveArgs> {
 export declare class DestroyEnclaveArgs extends Message<DestroyEnclaveArgs> {
   /**
    * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to destroy
+   * If empty, every enclave matching the label selector is destroyed
    *
    * @generated from field: string enclave_identifier = 1;
    */
   enclaveIdentifier: string;
 
+  /**
+   * If set, the enclave(s) to destroy must have all of these labels
+   *
+   * @generated from field: map<string, string> label_selector = 2;
+   */
+  labelSelector: { [key: string]: string };
+
   constructor(data?: PartialMessage<DestroyEnclaveArgs>);
 
   static readonly runtime: typeof proto3;
@@ -549,6 +840,13 @@ export declare class CleanArgs extends Message<CleanArgs> {
    */
   shouldCleanAll: boolean;
 
+  /**
+   * If set, only the enclaves having all of these labels are cleaned
+   *
+   * @generated from field: map<string, string> label_selector = 2;
+   */
+  labelSelector: { [key: string]: string };
+
   constructor(data?: PartialMessage<CleanArgs>);
 
   static readonly runtime: typeof proto3;
@@ -669,6 +967,27 @@ export declare class GetServiceLogsArgs extends Message<GetServiceLogsArgs> {
    */
   numLogLines: number;
 
+  /**
+   * If set, only the log lines emitted at or after this time are returned
+   *
+   * @generated from field: google.protobuf.Timestamp since = 7;
+   */
+  since?: Timestamp;
+
+  /**
+   * If set, only the log lines emitted at or before this time are returned
+   *
+   * @generated from field: google.protobuf.Timestamp until = 8;
+   */
+  until?: Timestamp;
+
+  /**
+   * The conjunctive filters over the fields of the log lines emitted as JSON objects; log lines that aren't JSON objects are never returned if set
+   *
+   * @generated from field: repeated engine_api.LogLineFieldFilter conjunctive_field_filters = 9;
+   */
+  conjunctiveFieldFilters: LogLineFieldFilter[];
+
   constructor(data?: PartialMessage<GetServiceLogsArgs>);
 
   static readonly runtime: typeof proto3;
@@ -719,8 +1038,6 @@ export declare class GetServiceLogsResponse extends Message<GetServiceLogsRespon
 }
 
 /**
- * TODO add timestamp as well, for when we do timestamp-handling on the client side
- *
  * @generated from message engine_api.LogLine
  */
 export declare class LogLine extends Message<LogLine> {
@@ -729,6 +1046,20 @@ export declare class LogLine extends Message<LogLine> {
    */
   line: string[];
 
+  /**
+   * The time each line of [line] was emitted at, in the same order; the Unix epoch if the logs database doesn't store it
+   *
+   * @generated from field: repeated google.protobuf.Timestamp timestamp = 2;
+   */
+  timestamp: Timestamp[];
+
+  /**
+   * The level (trace, debug, info, warn, error or fatal) extracted from each line of [line], in the same order; empty if it couldn't be extracted
+   *
+   * @generated from field: repeated string level = 3;
+   */
+  level: string[];
+
   constructor(data?: PartialMessage<LogLine>);
 
   static readonly runtime: typeof proto3;
@@ -773,3 +1104,168 @@ export declare class LogLineFilter extends Message<LogLineFilter> {
   static equals(a: LogLineFilter | PlainMessage<LogLineFilter> | undefined, b: LogLineFilter | PlainMessage<LogLineFilter> | undefined): boolean;
 }
 
+/**
+ * @generated from message engine_api.LogLineFieldFilter
+ */
+export declare class LogLineFieldFilter extends Message<LogLineFieldFilter> {
+  /**
+   * The JSON path of the field, e.g. "$.request.status", "request.status" or "$.items[0].name"
+   *
+   * @generated from field: string field_path = 1;
+   */
+  fieldPath: string;
+
+  /**
+   * @generated from field: engine_api.LogLineFieldOperator operator = 2;
+   */
+  operator: LogLineFieldOperator;
+
+  /**
+   * Numbers are compared by value, everything else by its string representation
+   *
+   * @generated from field: string value = 3;
+   */
+  value: string;
+
+  constructor(data?: PartialMessage<LogLineFieldFilter>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.LogLineFieldFilter";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LogLineFieldFilter;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LogLineFieldFilter;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LogLineFieldFilter;
+
+  static equals(a: LogLineFieldFilter | PlainMessage<LogLineFieldFilter> | undefined, b: LogLineFieldFilter | PlainMessage<LogLineFieldFilter> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                   Enclave Snapshots
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.SnapshotEnclaveArgs
+ */
+export declare class SnapshotEnclaveArgs extends Message<SnapshotEnclaveArgs> {
+  /**
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  /**
+   * Whether the content of the persistent directories should be part of the snapshot
+   *
+   * @generated from field: optional bool include_persistent_directories = 2;
+   */
+  includePersistentDirectories?: boolean;
+
+  /**
+   * Whether the filesystems of the service containers should be part of the snapshot (Docker only)
+   *
+   * @generated from field: optional bool include_container_filesystems = 3;
+   */
+  includeContainerFilesystems?: boolean;
+
+  constructor(data?: PartialMessage<SnapshotEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.SnapshotEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SnapshotEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SnapshotEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SnapshotEnclaveArgs;
+
+  static equals(a: SnapshotEnclaveArgs | PlainMessage<SnapshotEnclaveArgs> | undefined, b: SnapshotEnclaveArgs | PlainMessage<SnapshotEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.EnclaveSnapshotChunk
+ */
+export declare class EnclaveSnapshotChunk extends Message<EnclaveSnapshotChunk> {
+  /**
+   * Chunk of the snapshot archive bytes
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  constructor(data?: PartialMessage<EnclaveSnapshotChunk>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.EnclaveSnapshotChunk";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveSnapshotChunk;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveSnapshotChunk;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveSnapshotChunk;
+
+  static equals(a: EnclaveSnapshotChunk | PlainMessage<EnclaveSnapshotChunk> | undefined, b: EnclaveSnapshotChunk | PlainMessage<EnclaveSnapshotChunk> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.RestoreEnclaveArgs
+ */
+export declare class RestoreEnclaveArgs extends Message<RestoreEnclaveArgs> {
+  /**
+   * The arguments used to create the enclave the snapshot is restored into. Only read from the first message of the stream
+   *
+   * @generated from field: engine_api.CreateEnclaveArgs create_enclave_args = 1;
+   */
+  createEnclaveArgs?: CreateEnclaveArgs;
+
+  /**
+   * Chunk of the snapshot archive bytes
+   *
+   * @generated from field: bytes data = 2;
+   */
+  data: Uint8Array;
+
+  constructor(data?: PartialMessage<RestoreEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.RestoreEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreEnclaveArgs;
+
+  static equals(a: RestoreEnclaveArgs | PlainMessage<RestoreEnclaveArgs> | undefined, b: RestoreEnclaveArgs | PlainMessage<RestoreEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.RestoreEnclaveResponse
+ */
+export declare class RestoreEnclaveResponse extends Message<RestoreEnclaveResponse> {
+  /**
+   * All the information of the enclave the snapshot was restored into
+   *
+   * @generated from field: engine_api.EnclaveInfo enclave_info = 1;
+   */
+  enclaveInfo?: EnclaveInfo;
+
+  constructor(data?: PartialMessage<RestoreEnclaveResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.RestoreEnclaveResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreEnclaveResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreEnclaveResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreEnclaveResponse;
+
+  static equals(a: RestoreEnclaveResponse | PlainMessage<RestoreEnclaveResponse> | undefined, b: RestoreEnclaveResponse | PlainMessage<RestoreEnclaveResponse> | undefined): boolean;
+}
+
@@ -5,6 +5,17 @@
 
 import { proto3, Timestamp } from "@bufbuild/protobuf";
 
+/**
+ * @generated from enum engine_api.EnclaveExpiryAction
+ */
+export const EnclaveExpiryAction = proto3.makeEnum(
+  "engine_api.EnclaveExpiryAction",
+  [
+    {no: 0, name: "EnclaveExpiryAction_DESTROY"},
+    {no: 1, name: "EnclaveExpiryAction_STOP"},
+  ],
+);
+
 /**
  * @generated from enum engine_api.EnclaveMode
  */
@@ -64,6 +75,24 @@ export const LogLineOperator = proto3.makeEnum(
   ],
 );
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.LogLineFieldOperator
+ */
+export const LogLineFieldOperator = proto3.makeEnum(
+  "engine_api.LogLineFieldOperator",
+  [
+    {no: 0, name: "LogLineFieldOperator_EQUALS"},
+    {no: 1, name: "LogLineFieldOperator_NOT_EQUALS"},
+    {no: 2, name: "LogLineFieldOperator_MATCHES_REGEX"},
+    {no: 3, name: "LogLineFieldOperator_GREATER_THAN"},
+    {no: 4, name: "LogLineFieldOperator_GREATER_THAN_OR_EQUAL"},
+    {no: 5, name: "LogLineFieldOperator_LESS_THAN"},
+    {no: 6, name: "LogLineFieldOperator_LESS_THAN_OR_EQUAL"},
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                        Get Engine Info
@@ -92,6 +121,14 @@ export const CreateEnclaveArgs = proto3.makeMessageType(
     { no: 2, name: "api_container_version_tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "api_container_log_level", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode) },
+    { no: 5, name: "files_artifacts_storage_quota", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 6, name: "ttl_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 7, name: "idle_timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 8, name: "expiry_action", kind: "enum", T: proto3.getEnumType(EnclaveExpiryAction) },
+    { no: 9, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 10, name: "cpu_quota_millicpus", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 11, name: "memory_quota_megabytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 12, name: "service_count_quota", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
   ],
 );
 
@@ -148,6 +185,19 @@ export const EnclaveInfo = proto3.makeMessageType(
     { no: 7, name: "api_container_host_machine_info", kind: "message", T: EnclaveAPIContainerHostMachineInfo },
     { no: 8, name: "creation_time", kind: "message", T: Timestamp },
     { no: 9, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode) },
+    { no: 10, name: "expiration_time", kind: "message", T: Timestamp, opt: true },
+    { no: 11, name: "expiry_action", kind: "enum", T: proto3.getEnumType(EnclaveExpiryAction) },
+    { no: 12, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetEnclavesArgs
+ */
+export const GetEnclavesArgs = proto3.makeMessageType(
+  "engine_api.GetEnclavesArgs",
+  () => [
+    { no: 1, name: "label_selector", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ],
 );
 
@@ -199,6 +249,55 @@ export const StopEnclaveArgs = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                       Start Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.StartEnclaveArgs
+ */
+export const StartEnclaveArgs = proto3.makeMessageType(
+  "engine_api.StartEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.StartEnclaveResponse
+ */
+export const StartEnclaveResponse = proto3.makeMessageType(
+  "engine_api.StartEnclaveResponse",
+  () => [
+    { no: 1, name: "resumed_service_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Extend Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.ExtendEnclaveArgs
+ */
+export const ExtendEnclaveArgs = proto3.makeMessageType(
+  "engine_api.ExtendEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "extension_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ExtendEnclaveResponse
+ */
+export const ExtendEnclaveResponse = proto3.makeMessageType(
+  "engine_api.ExtendEnclaveResponse",
+  () => [
+    { no: 1, name: "expiration_time", kind: "message", T: Timestamp, opt: true },
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                       Destroy Enclave
@@ -210,6 +309,7 @@ export const DestroyEnclaveArgs = proto3.makeMessageType(
   "engine_api.DestroyEnclaveArgs",
   () => [
     { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "label_selector", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ],
 );
 
@@ -224,6 +324,7 @@ export const CleanArgs = proto3.makeMessageType(
   "engine_api.CleanArgs",
   () => [
     { no: 1, name: "should_clean_all", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "label_selector", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ],
 );
 
@@ -264,6 +365,9 @@ export const GetServiceLogsArgs = proto3.makeMessageType(
     { no: 4, name: "conjunctive_filters", kind: "message", T: LogLineFilter, repeated: true },
     { no: 5, name: "return_all_logs", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 6, name: "num_log_lines", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "since", kind: "message", T: Timestamp },
+    { no: 8, name: "until", kind: "message", T: Timestamp },
+    { no: 9, name: "conjunctive_field_filters", kind: "message", T: LogLineFieldFilter, repeated: true },
   ],
 );
 
@@ -279,14 +383,14 @@ export const GetServiceLogsResponse = proto3.makeMessageType(
 );
 
 /**
- * TODO add timestamp as well, for when we do timestamp-handling on the client side
- *
  * @generated from message engine_api.LogLine
  */
 export const LogLine = proto3.makeMessageType(
   "engine_api.LogLine",
   () => [
     { no: 1, name: "line", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "timestamp", kind: "message", T: Timestamp, repeated: true },
+    { no: 3, name: "level", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ],
 );
 
@@ -301,3 +405,62 @@ export const LogLineFilter = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message engine_api.LogLineFieldFilter
+ */
+export const LogLineFieldFilter = proto3.makeMessageType(
+  "engine_api.LogLineFieldFilter",
+  () => [
+    { no: 1, name: "field_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "operator", kind: "enum", T: proto3.getEnumType(LogLineFieldOperator) },
+    { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                   Enclave Snapshots
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.SnapshotEnclaveArgs
+ */
+export const SnapshotEnclaveArgs = proto3.makeMessageType(
+  "engine_api.SnapshotEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "include_persistent_directories", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 3, name: "include_container_filesystems", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.EnclaveSnapshotChunk
+ */
+export const EnclaveSnapshotChunk = proto3.makeMessageType(
+  "engine_api.EnclaveSnapshotChunk",
+  () => [
+    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.RestoreEnclaveArgs
+ */
+export const RestoreEnclaveArgs = proto3.makeMessageType(
+  "engine_api.RestoreEnclaveArgs",
+  () => [
+    { no: 1, name: "create_enclave_args", kind: "message", T: CreateEnclaveArgs },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.RestoreEnclaveResponse
+ */
+export const RestoreEnclaveResponse = proto3.makeMessageType(
+  "engine_api.RestoreEnclaveResponse",
+  () => [
+    { no: 1, name: "enclave_info", kind: "message", T: EnclaveInfo },
+  ],
+);
+
@@ -10,12 +10,16 @@ import * as grpc from "@grpc/grpc-js";
 interface IEngineServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
   getEngineInfo: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEngineInfoResponse>;
   createEnclave: grpc.MethodDefinition<engine_service_pb.CreateEnclaveArgs, engine_service_pb.CreateEnclaveResponse>;
-  getEnclaves: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
+  getEnclaves: grpc.MethodDefinition<engine_service_pb.GetEnclavesArgs, engine_service_pb.GetEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.MethodDefinition<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  startEnclave: grpc.MethodDefinition<engine_service_pb.StartEnclaveArgs, engine_service_pb.StartEnclaveResponse>;
+  extendEnclave: grpc.MethodDefinition<engine_service_pb.ExtendEnclaveArgs, engine_service_pb.ExtendEnclaveResponse>;
   destroyEnclave: grpc.MethodDefinition<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
   clean: grpc.MethodDefinition<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
   getServiceLogs: grpc.MethodDefinition<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  snapshotEnclave: grpc.MethodDefinition<engine_service_pb.SnapshotEnclaveArgs, engine_service_pb.EnclaveSnapshotChunk>;
+  restoreEnclave: grpc.MethodDefinition<engine_service_pb.RestoreEnclaveArgs, engine_service_pb.RestoreEnclaveResponse>;
 }
 
 export const EngineServiceService: IEngineServiceService;
@@ -23,12 +27,16 @@ export const EngineServiceService: IEngineServiceService;
 export interface IEngineServiceServer extends grpc.UntypedServiceImplementation {
   getEngineInfo: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEngineInfoResponse>;
   createEnclave: grpc.handleUnaryCall<engine_service_pb.CreateEnclaveArgs, engine_service_pb.CreateEnclaveResponse>;
-  getEnclaves: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
+  getEnclaves: grpc.handleUnaryCall<engine_service_pb.GetEnclavesArgs, engine_service_pb.GetEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.handleUnaryCall<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  startEnclave: grpc.handleUnaryCall<engine_service_pb.StartEnclaveArgs, engine_service_pb.StartEnclaveResponse>;
+  extendEnclave: grpc.handleUnaryCall<engine_service_pb.ExtendEnclaveArgs, engine_service_pb.ExtendEnclaveResponse>;
   destroyEnclave: grpc.handleUnaryCall<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
   clean: grpc.handleUnaryCall<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
   getServiceLogs: grpc.handleServerStreamingCall<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  snapshotEnclave: grpc.handleServerStreamingCall<engine_service_pb.SnapshotEnclaveArgs, engine_service_pb.EnclaveSnapshotChunk>;
+  restoreEnclave: grpc.handleClientStreamingCall<engine_service_pb.RestoreEnclaveArgs, engine_service_pb.RestoreEnclaveResponse>;
 }
 
 export class EngineServiceClient extends grpc.Client {
@@ -39,15 +47,21 @@ export class EngineServiceClient extends grpc.Client {
   createEnclave(argument: engine_service_pb.CreateEnclaveArgs, callback: grpc.requestCallback<engine_service_pb.CreateEnclaveResponse>): grpc.ClientUnaryCall;
   createEnclave(argument: engine_service_pb.CreateEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CreateEnclaveResponse>): grpc.ClientUnaryCall;
   createEnclave(argument: engine_service_pb.CreateEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CreateEnclaveResponse>): grpc.ClientUnaryCall;
-  getEnclaves(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
-  getEnclaves(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
-  getEnclaves(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
+  getEnclaves(argument: engine_service_pb.GetEnclavesArgs, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
+  getEnclaves(argument: engine_service_pb.GetEnclavesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
+  getEnclaves(argument: engine_service_pb.GetEnclavesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalEnclaveIdentifiers(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalEnclaveIdentifiers(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalEnclaveIdentifiers(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>): grpc.ClientUnaryCall;
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  startEnclave(argument: engine_service_pb.StartEnclaveArgs, callback: grpc.requestCallback<engine_service_pb.StartEnclaveResponse>): grpc.ClientUnaryCall;
+  startEnclave(argument: engine_service_pb.StartEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.StartEnclaveResponse>): grpc.ClientUnaryCall;
+  startEnclave(argument: engine_service_pb.StartEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.StartEnclaveResponse>): grpc.ClientUnaryCall;
+  extendEnclave(argument: engine_service_pb.ExtendEnclaveArgs, callback: grpc.requestCallback<engine_service_pb.ExtendEnclaveResponse>): grpc.ClientUnaryCall;
+  extendEnclave(argument: engine_service_pb.ExtendEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.ExtendEnclaveResponse>): grpc.ClientUnaryCall;
+  extendEnclave(argument: engine_service_pb.ExtendEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.ExtendEnclaveResponse>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
@@ -56,4 +70,9 @@ export class EngineServiceClient extends grpc.Client {
   clean(argument: engine_service_pb.CleanArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
+  snapshotEnclave(argument: engine_service_pb.SnapshotEnclaveArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveSnapshotChunk>;
+  snapshotEnclave(argument: engine_service_pb.SnapshotEnclaveArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveSnapshotChunk>;
+  restoreEnclave(callback: grpc.requestCallback<engine_service_pb.RestoreEnclaveResponse>): grpc.ClientWritableStream<engine_service_pb.RestoreEnclaveArgs>;
+  restoreEnclave(metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.RestoreEnclaveResponse>): grpc.ClientWritableStream<engine_service_pb.RestoreEnclaveArgs>;
+  restoreEnclave(metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.RestoreEnclaveResponse>): grpc.ClientWritableStream<engine_service_pb.RestoreEnclaveArgs>;
 }
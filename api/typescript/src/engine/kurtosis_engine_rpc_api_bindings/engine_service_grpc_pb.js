@@ -61,6 +61,50 @@ function deserialize_engine_api_DestroyEnclaveArgs(buffer_arg) {
   return engine_service_pb.DestroyEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_EnclaveSnapshotChunk(arg) {
+  if (!(arg instanceof engine_service_pb.EnclaveSnapshotChunk)) {
+    throw new Error('Expected argument of type engine_api.EnclaveSnapshotChunk');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_EnclaveSnapshotChunk(buffer_arg) {
+  return engine_service_pb.EnclaveSnapshotChunk.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ExtendEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.ExtendEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.ExtendEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ExtendEnclaveArgs(buffer_arg) {
+  return engine_service_pb.ExtendEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ExtendEnclaveResponse(arg) {
+  if (!(arg instanceof engine_service_pb.ExtendEnclaveResponse)) {
+    throw new Error('Expected argument of type engine_api.ExtendEnclaveResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ExtendEnclaveResponse(buffer_arg) {
+  return engine_service_pb.ExtendEnclaveResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetEnclavesArgs(arg) {
+  if (!(arg instanceof engine_service_pb.GetEnclavesArgs)) {
+    throw new Error('Expected argument of type engine_api.GetEnclavesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetEnclavesArgs(buffer_arg) {
+  return engine_service_pb.GetEnclavesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetEnclavesResponse(arg) {
   if (!(arg instanceof engine_service_pb.GetEnclavesResponse)) {
     throw new Error('Expected argument of type engine_api.GetEnclavesResponse');
@@ -116,6 +160,61 @@ function deserialize_engine_api_GetServiceLogsResponse(buffer_arg) {
   return engine_service_pb.GetServiceLogsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_RestoreEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.RestoreEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.RestoreEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_RestoreEnclaveArgs(buffer_arg) {
+  return engine_service_pb.RestoreEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_RestoreEnclaveResponse(arg) {
+  if (!(arg instanceof engine_service_pb.RestoreEnclaveResponse)) {
+    throw new Error('Expected argument of type engine_api.RestoreEnclaveResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_RestoreEnclaveResponse(buffer_arg) {
+  return engine_service_pb.RestoreEnclaveResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_SnapshotEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.SnapshotEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.SnapshotEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_SnapshotEnclaveArgs(buffer_arg) {
+  return engine_service_pb.SnapshotEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_StartEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.StartEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.StartEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_StartEnclaveArgs(buffer_arg) {
+  return engine_service_pb.StartEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_StartEnclaveResponse(arg) {
+  if (!(arg instanceof engine_service_pb.StartEnclaveResponse)) {
+    throw new Error('Expected argument of type engine_api.StartEnclaveResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_StartEnclaveResponse(buffer_arg) {
+  return engine_service_pb.StartEnclaveResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_StopEnclaveArgs(arg) {
   if (!(arg instanceof engine_service_pb.StopEnclaveArgs)) {
     throw new Error('Expected argument of type engine_api.StopEnclaveArgs');
@@ -167,15 +266,15 @@ createEnclave: {
     responseSerialize: serialize_engine_api_CreateEnclaveResponse,
     responseDeserialize: deserialize_engine_api_CreateEnclaveResponse,
   },
-  // Returns information about the existing enclaves
+  // Returns information about the existing enclaves, optionally only the ones matching a label selector
 getEnclaves: {
     path: '/engine_api.EngineService/GetEnclaves',
     requestStream: false,
     responseStream: false,
-    requestType: google_protobuf_empty_pb.Empty,
+    requestType: engine_service_pb.GetEnclavesArgs,
     responseType: engine_service_pb.GetEnclavesResponse,
-    requestSerialize: serialize_google_protobuf_Empty,
-    requestDeserialize: deserialize_google_protobuf_Empty,
+    requestSerialize: serialize_engine_api_GetEnclavesArgs,
+    requestDeserialize: deserialize_engine_api_GetEnclavesArgs,
     responseSerialize: serialize_engine_api_GetEnclavesResponse,
     responseDeserialize: deserialize_engine_api_GetEnclavesResponse,
   },
@@ -203,6 +302,30 @@ stopEnclave: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Starts a stopped enclave again, resuming its API container and its services
+startEnclave: {
+    path: '/engine_api.EngineService/StartEnclave',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.StartEnclaveArgs,
+    responseType: engine_service_pb.StartEnclaveResponse,
+    requestSerialize: serialize_engine_api_StartEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_StartEnclaveArgs,
+    responseSerialize: serialize_engine_api_StartEnclaveResponse,
+    responseDeserialize: deserialize_engine_api_StartEnclaveResponse,
+  },
+  // Pushes back the time at which an enclave expires
+extendEnclave: {
+    path: '/engine_api.EngineService/ExtendEnclave',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.ExtendEnclaveArgs,
+    responseType: engine_service_pb.ExtendEnclaveResponse,
+    requestSerialize: serialize_engine_api_ExtendEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_ExtendEnclaveArgs,
+    responseSerialize: serialize_engine_api_ExtendEnclaveResponse,
+    responseDeserialize: deserialize_engine_api_ExtendEnclaveResponse,
+  },
   // Destroys an enclave, removing all artifacts associated with it
 destroyEnclave: {
     path: '/engine_api.EngineService/DestroyEnclave',
@@ -239,6 +362,30 @@ getServiceLogs: {
     responseSerialize: serialize_engine_api_GetServiceLogsResponse,
     responseDeserialize: deserialize_engine_api_GetServiceLogsResponse,
   },
+  // Snapshots an enclave into a single archive, streamed back in chunks
+snapshotEnclave: {
+    path: '/engine_api.EngineService/SnapshotEnclave',
+    requestStream: false,
+    responseStream: true,
+    requestType: engine_service_pb.SnapshotEnclaveArgs,
+    responseType: engine_service_pb.EnclaveSnapshotChunk,
+    requestSerialize: serialize_engine_api_SnapshotEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_SnapshotEnclaveArgs,
+    responseSerialize: serialize_engine_api_EnclaveSnapshotChunk,
+    responseDeserialize: deserialize_engine_api_EnclaveSnapshotChunk,
+  },
+  // Creates a new enclave from an archive produced by SnapshotEnclave, streamed in chunks
+restoreEnclave: {
+    path: '/engine_api.EngineService/RestoreEnclave',
+    requestStream: true,
+    responseStream: false,
+    requestType: engine_service_pb.RestoreEnclaveArgs,
+    responseType: engine_service_pb.RestoreEnclaveResponse,
+    requestSerialize: serialize_engine_api_RestoreEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_RestoreEnclaveArgs,
+    responseSerialize: serialize_engine_api_RestoreEnclaveResponse,
+    responseDeserialize: deserialize_engine_api_RestoreEnclaveResponse,
+  },
 };
 
 exports.EngineServiceClient = grpc.makeGenericClientConstructor(EngineServiceService);
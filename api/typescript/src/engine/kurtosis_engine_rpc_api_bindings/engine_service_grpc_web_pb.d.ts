@@ -24,7 +24,7 @@ export class EngineServiceClient {
   ): grpcWeb.ClientReadableStream<engine_service_pb.CreateEnclaveResponse>;
 
   getEnclaves(
-    request: google_protobuf_empty_pb.Empty,
+    request: engine_service_pb.GetEnclavesArgs,
     metadata: grpcWeb.Metadata | undefined,
     callback: (err: grpcWeb.RpcError,
                response: engine_service_pb.GetEnclavesResponse) => void
@@ -44,6 +44,20 @@ export class EngineServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  startEnclave(
+    request: engine_service_pb.StartEnclaveArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.StartEnclaveResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.StartEnclaveResponse>;
+
+  extendEnclave(
+    request: engine_service_pb.ExtendEnclaveArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.ExtendEnclaveResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.ExtendEnclaveResponse>;
+
   destroyEnclave(
     request: engine_service_pb.DestroyEnclaveArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -63,6 +77,11 @@ export class EngineServiceClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  snapshotEnclave(
+    request: engine_service_pb.SnapshotEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveSnapshotChunk>;
+
 }
 
 export class EngineServicePromiseClient {
@@ -81,7 +100,7 @@ export class EngineServicePromiseClient {
   ): Promise<engine_service_pb.CreateEnclaveResponse>;
 
   getEnclaves(
-    request: google_protobuf_empty_pb.Empty,
+    request: engine_service_pb.GetEnclavesArgs,
     metadata?: grpcWeb.Metadata
   ): Promise<engine_service_pb.GetEnclavesResponse>;
 
@@ -95,6 +114,16 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  startEnclave(
+    request: engine_service_pb.StartEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.StartEnclaveResponse>;
+
+  extendEnclave(
+    request: engine_service_pb.ExtendEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.ExtendEnclaveResponse>;
+
   destroyEnclave(
     request: engine_service_pb.DestroyEnclaveArgs,
     metadata?: grpcWeb.Metadata
@@ -110,5 +139,10 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  snapshotEnclave(
+    request: engine_service_pb.SnapshotEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveSnapshotChunk>;
+
 }
 
@@ -203,16 +203,16 @@ proto.engine_api.EngineServicePromiseClient.prototype.createEnclave =
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.GetEnclavesArgs,
  *   !proto.engine_api.GetEnclavesResponse>}
  */
 const methodDescriptor_EngineService_GetEnclaves = new grpc.web.MethodDescriptor(
   '/engine_api.EngineService/GetEnclaves',
   grpc.web.MethodType.UNARY,
-  google_protobuf_empty_pb.Empty,
+  proto.engine_api.GetEnclavesArgs,
   proto.engine_api.GetEnclavesResponse,
   /**
-   * @param {!proto.google.protobuf.Empty} request
+   * @param {!proto.engine_api.GetEnclavesArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
@@ -223,7 +223,7 @@ const methodDescriptor_EngineService_GetEnclaves = new grpc.web.MethodDescriptor
 
 
 /**
- * @param {!proto.google.protobuf.Empty} request The
+ * @param {!proto.engine_api.GetEnclavesArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
@@ -244,7 +244,7 @@ proto.engine_api.EngineServiceClient.prototype.getEnclaves =
 
 
 /**
- * @param {!proto.google.protobuf.Empty} request The
+ * @param {!proto.engine_api.GetEnclavesArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
@@ -383,6 +383,128 @@ proto.engine_api.EngineServicePromiseClient.prototype.stopEnclave =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.StartEnclaveArgs,
+ *   !proto.engine_api.StartEnclaveResponse>}
+ */
+const methodDescriptor_EngineService_StartEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/StartEnclave',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.StartEnclaveArgs,
+  proto.engine_api.StartEnclaveResponse,
+  /**
+   * @param {!proto.engine_api.StartEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.StartEnclaveResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.StartEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.StartEnclaveResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.StartEnclaveResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.startEnclave =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/StartEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StartEnclave,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.StartEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.StartEnclaveResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.startEnclave =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/StartEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StartEnclave);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.ExtendEnclaveArgs,
+ *   !proto.engine_api.ExtendEnclaveResponse>}
+ */
+const methodDescriptor_EngineService_ExtendEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/ExtendEnclave',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.ExtendEnclaveArgs,
+  proto.engine_api.ExtendEnclaveResponse,
+  /**
+   * @param {!proto.engine_api.ExtendEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.ExtendEnclaveResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.ExtendEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.ExtendEnclaveResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.ExtendEnclaveResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.extendEnclave =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/ExtendEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ExtendEnclave,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.ExtendEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.ExtendEnclaveResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.extendEnclave =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/ExtendEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ExtendEnclave);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -561,5 +683,61 @@ proto.engine_api.EngineServicePromiseClient.prototype.getServiceLogs =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.SnapshotEnclaveArgs,
+ *   !proto.engine_api.EnclaveSnapshotChunk>}
+ */
+const methodDescriptor_EngineService_SnapshotEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/SnapshotEnclave',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.engine_api.SnapshotEnclaveArgs,
+  proto.engine_api.EnclaveSnapshotChunk,
+  /**
+   * @param {!proto.engine_api.SnapshotEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.EnclaveSnapshotChunk.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.SnapshotEnclaveArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveSnapshotChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.snapshotEnclave =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/SnapshotEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_SnapshotEnclave);
+};
+
+
+/**
+ * @param {!proto.engine_api.SnapshotEnclaveArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveSnapshotChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.snapshotEnclave =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/SnapshotEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_SnapshotEnclave);
+};
+
+
 module.exports = proto.engine_api;
 
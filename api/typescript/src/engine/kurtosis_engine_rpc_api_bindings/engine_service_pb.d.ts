@@ -35,6 +35,42 @@ export class CreateEnclaveArgs extends jspb.Message {
   getMode(): EnclaveMode;
   setMode(value: EnclaveMode): CreateEnclaveArgs;
 
+  getFilesArtifactsStorageQuota(): number;
+  setFilesArtifactsStorageQuota(value: number): CreateEnclaveArgs;
+  hasFilesArtifactsStorageQuota(): boolean;
+  clearFilesArtifactsStorageQuota(): CreateEnclaveArgs;
+
+  getTtlSeconds(): number;
+  setTtlSeconds(value: number): CreateEnclaveArgs;
+  hasTtlSeconds(): boolean;
+  clearTtlSeconds(): CreateEnclaveArgs;
+
+  getIdleTimeoutSeconds(): number;
+  setIdleTimeoutSeconds(value: number): CreateEnclaveArgs;
+  hasIdleTimeoutSeconds(): boolean;
+  clearIdleTimeoutSeconds(): CreateEnclaveArgs;
+
+  getExpiryAction(): EnclaveExpiryAction;
+  setExpiryAction(value: EnclaveExpiryAction): CreateEnclaveArgs;
+
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): CreateEnclaveArgs;
+
+  getCpuQuotaMillicpus(): number;
+  setCpuQuotaMillicpus(value: number): CreateEnclaveArgs;
+  hasCpuQuotaMillicpus(): boolean;
+  clearCpuQuotaMillicpus(): CreateEnclaveArgs;
+
+  getMemoryQuotaMegabytes(): number;
+  setMemoryQuotaMegabytes(value: number): CreateEnclaveArgs;
+  hasMemoryQuotaMegabytes(): boolean;
+  clearMemoryQuotaMegabytes(): CreateEnclaveArgs;
+
+  getServiceCountQuota(): number;
+  setServiceCountQuota(value: number): CreateEnclaveArgs;
+  hasServiceCountQuota(): boolean;
+  clearServiceCountQuota(): CreateEnclaveArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CreateEnclaveArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CreateEnclaveArgs): CreateEnclaveArgs.AsObject;
@@ -49,6 +85,44 @@ export namespace CreateEnclaveArgs {
     apiContainerVersionTag: string,
     apiContainerLogLevel: string,
     mode: EnclaveMode,
+    filesArtifactsStorageQuota?: number,
+    ttlSeconds?: number,
+    idleTimeoutSeconds?: number,
+    expiryAction: EnclaveExpiryAction,
+    labelsMap: Array<[string, string]>,
+    cpuQuotaMillicpus?: number,
+    memoryQuotaMegabytes?: number,
+    serviceCountQuota?: number,
+  }
+
+  export enum FilesArtifactsStorageQuotaCase { 
+    _FILES_ARTIFACTS_STORAGE_QUOTA_NOT_SET = 0,
+    FILES_ARTIFACTS_STORAGE_QUOTA = 5,
+  }
+
+  export enum TtlSecondsCase { 
+    _TTL_SECONDS_NOT_SET = 0,
+    TTL_SECONDS = 6,
+  }
+
+  export enum IdleTimeoutSecondsCase { 
+    _IDLE_TIMEOUT_SECONDS_NOT_SET = 0,
+    IDLE_TIMEOUT_SECONDS = 7,
+  }
+
+  export enum CpuQuotaMillicpusCase { 
+    _CPU_QUOTA_MILLICPUS_NOT_SET = 0,
+    CPU_QUOTA_MILLICPUS = 10,
+  }
+
+  export enum MemoryQuotaMegabytesCase { 
+    _MEMORY_QUOTA_MEGABYTES_NOT_SET = 0,
+    MEMORY_QUOTA_MEGABYTES = 11,
+  }
+
+  export enum ServiceCountQuotaCase { 
+    _SERVICE_COUNT_QUOTA_NOT_SET = 0,
+    SERVICE_COUNT_QUOTA = 12,
   }
 }
 
@@ -158,6 +232,17 @@ export class EnclaveInfo extends jspb.Message {
   getMode(): EnclaveMode;
   setMode(value: EnclaveMode): EnclaveInfo;
 
+  getExpirationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpirationTime(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveInfo;
+  hasExpirationTime(): boolean;
+  clearExpirationTime(): EnclaveInfo;
+
+  getExpiryAction(): EnclaveExpiryAction;
+  setExpiryAction(value: EnclaveExpiryAction): EnclaveInfo;
+
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): EnclaveInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): EnclaveInfo.AsObject;
   static toObject(includeInstance: boolean, msg: EnclaveInfo): EnclaveInfo.AsObject;
@@ -177,6 +262,32 @@ export namespace EnclaveInfo {
     apiContainerHostMachineInfo?: EnclaveAPIContainerHostMachineInfo.AsObject,
     creationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     mode: EnclaveMode,
+    expirationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    expiryAction: EnclaveExpiryAction,
+    labelsMap: Array<[string, string]>,
+  }
+
+  export enum ExpirationTimeCase { 
+    _EXPIRATION_TIME_NOT_SET = 0,
+    EXPIRATION_TIME = 10,
+  }
+}
+
+export class GetEnclavesArgs extends jspb.Message {
+  getLabelSelectorMap(): jspb.Map<string, string>;
+  clearLabelSelectorMap(): GetEnclavesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclavesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclavesArgs): GetEnclavesArgs.AsObject;
+  static serializeBinaryToWriter(message: GetEnclavesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclavesArgs;
+  static deserializeBinaryFromReader(message: GetEnclavesArgs, reader: jspb.BinaryReader): GetEnclavesArgs;
+}
+
+export namespace GetEnclavesArgs {
+  export type AsObject = {
+    labelSelectorMap: Array<[string, string]>,
   }
 }
 
@@ -262,10 +373,98 @@ export namespace StopEnclaveArgs {
   }
 }
 
+export class StartEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): StartEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StartEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: StartEnclaveArgs): StartEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: StartEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StartEnclaveArgs;
+  static deserializeBinaryFromReader(message: StartEnclaveArgs, reader: jspb.BinaryReader): StartEnclaveArgs;
+}
+
+export namespace StartEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+  }
+}
+
+export class StartEnclaveResponse extends jspb.Message {
+  getResumedServiceNamesList(): Array<string>;
+  setResumedServiceNamesList(value: Array<string>): StartEnclaveResponse;
+  clearResumedServiceNamesList(): StartEnclaveResponse;
+  addResumedServiceNames(value: string, index?: number): StartEnclaveResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StartEnclaveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StartEnclaveResponse): StartEnclaveResponse.AsObject;
+  static serializeBinaryToWriter(message: StartEnclaveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StartEnclaveResponse;
+  static deserializeBinaryFromReader(message: StartEnclaveResponse, reader: jspb.BinaryReader): StartEnclaveResponse;
+}
+
+export namespace StartEnclaveResponse {
+  export type AsObject = {
+    resumedServiceNamesList: Array<string>,
+  }
+}
+
+export class ExtendEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): ExtendEnclaveArgs;
+
+  getExtensionSeconds(): number;
+  setExtensionSeconds(value: number): ExtendEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExtendEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ExtendEnclaveArgs): ExtendEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: ExtendEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExtendEnclaveArgs;
+  static deserializeBinaryFromReader(message: ExtendEnclaveArgs, reader: jspb.BinaryReader): ExtendEnclaveArgs;
+}
+
+export namespace ExtendEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    extensionSeconds: number,
+  }
+}
+
+export class ExtendEnclaveResponse extends jspb.Message {
+  getExpirationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpirationTime(value?: google_protobuf_timestamp_pb.Timestamp): ExtendEnclaveResponse;
+  hasExpirationTime(): boolean;
+  clearExpirationTime(): ExtendEnclaveResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExtendEnclaveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ExtendEnclaveResponse): ExtendEnclaveResponse.AsObject;
+  static serializeBinaryToWriter(message: ExtendEnclaveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExtendEnclaveResponse;
+  static deserializeBinaryFromReader(message: ExtendEnclaveResponse, reader: jspb.BinaryReader): ExtendEnclaveResponse;
+}
+
+export namespace ExtendEnclaveResponse {
+  export type AsObject = {
+    expirationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+
+  export enum ExpirationTimeCase { 
+    _EXPIRATION_TIME_NOT_SET = 0,
+    EXPIRATION_TIME = 1,
+  }
+}
+
 export class DestroyEnclaveArgs extends jspb.Message {
   getEnclaveIdentifier(): string;
   setEnclaveIdentifier(value: string): DestroyEnclaveArgs;
 
+  getLabelSelectorMap(): jspb.Map<string, string>;
+  clearLabelSelectorMap(): DestroyEnclaveArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): DestroyEnclaveArgs.AsObject;
   static toObject(includeInstance: boolean, msg: DestroyEnclaveArgs): DestroyEnclaveArgs.AsObject;
@@ -277,6 +476,7 @@ export class DestroyEnclaveArgs extends jspb.Message {
 export namespace DestroyEnclaveArgs {
   export type AsObject = {
     enclaveIdentifier: string,
+    labelSelectorMap: Array<[string, string]>,
   }
 }
 
@@ -284,6 +484,9 @@ export class CleanArgs extends jspb.Message {
   getShouldCleanAll(): boolean;
   setShouldCleanAll(value: boolean): CleanArgs;
 
+  getLabelSelectorMap(): jspb.Map<string, string>;
+  clearLabelSelectorMap(): CleanArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CleanArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CleanArgs): CleanArgs.AsObject;
@@ -295,6 +498,7 @@ export class CleanArgs extends jspb.Message {
 export namespace CleanArgs {
   export type AsObject = {
     shouldCleanAll: boolean,
+    labelSelectorMap: Array<[string, string]>,
   }
 }
 
@@ -361,6 +565,21 @@ export class GetServiceLogsArgs extends jspb.Message {
   getNumLogLines(): number;
   setNumLogLines(value: number): GetServiceLogsArgs;
 
+  getSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setSince(value?: google_protobuf_timestamp_pb.Timestamp): GetServiceLogsArgs;
+  hasSince(): boolean;
+  clearSince(): GetServiceLogsArgs;
+
+  getUntil(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setUntil(value?: google_protobuf_timestamp_pb.Timestamp): GetServiceLogsArgs;
+  hasUntil(): boolean;
+  clearUntil(): GetServiceLogsArgs;
+
+  getConjunctiveFieldFiltersList(): Array<LogLineFieldFilter>;
+  setConjunctiveFieldFiltersList(value: Array<LogLineFieldFilter>): GetServiceLogsArgs;
+  clearConjunctiveFieldFiltersList(): GetServiceLogsArgs;
+  addConjunctiveFieldFilters(value?: LogLineFieldFilter, index?: number): LogLineFieldFilter;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetServiceLogsArgs.AsObject;
   static toObject(includeInstance: boolean, msg: GetServiceLogsArgs): GetServiceLogsArgs.AsObject;
@@ -377,6 +596,9 @@ export namespace GetServiceLogsArgs {
     conjunctiveFiltersList: Array<LogLineFilter.AsObject>,
     returnAllLogs: boolean,
     numLogLines: number,
+    since?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    until?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    conjunctiveFieldFiltersList: Array<LogLineFieldFilter.AsObject>,
   }
 }
 
@@ -408,6 +630,16 @@ export class LogLine extends jspb.Message {
   clearLineList(): LogLine;
   addLine(value: string, index?: number): LogLine;
 
+  getTimestampList(): Array<google_protobuf_timestamp_pb.Timestamp>;
+  setTimestampList(value: Array<google_protobuf_timestamp_pb.Timestamp>): LogLine;
+  clearTimestampList(): LogLine;
+  addTimestamp(value?: google_protobuf_timestamp_pb.Timestamp, index?: number): google_protobuf_timestamp_pb.Timestamp;
+
+  getLevelList(): Array<string>;
+  setLevelList(value: Array<string>): LogLine;
+  clearLevelList(): LogLine;
+  addLevel(value: string, index?: number): LogLine;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): LogLine.AsObject;
   static toObject(includeInstance: boolean, msg: LogLine): LogLine.AsObject;
@@ -419,6 +651,8 @@ export class LogLine extends jspb.Message {
 export namespace LogLine {
   export type AsObject = {
     lineList: Array<string>,
+    timestampList: Array<google_protobuf_timestamp_pb.Timestamp.AsObject>,
+    levelList: Array<string>,
   }
 }
 
@@ -444,6 +678,142 @@ export namespace LogLineFilter {
   }
 }
 
+export class LogLineFieldFilter extends jspb.Message {
+  getFieldPath(): string;
+  setFieldPath(value: string): LogLineFieldFilter;
+
+  getOperator(): LogLineFieldOperator;
+  setOperator(value: LogLineFieldOperator): LogLineFieldFilter;
+
+  getValue(): string;
+  setValue(value: string): LogLineFieldFilter;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LogLineFieldFilter.AsObject;
+  static toObject(includeInstance: boolean, msg: LogLineFieldFilter): LogLineFieldFilter.AsObject;
+  static serializeBinaryToWriter(message: LogLineFieldFilter, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LogLineFieldFilter;
+  static deserializeBinaryFromReader(message: LogLineFieldFilter, reader: jspb.BinaryReader): LogLineFieldFilter;
+}
+
+export namespace LogLineFieldFilter {
+  export type AsObject = {
+    fieldPath: string,
+    operator: LogLineFieldOperator,
+    value: string,
+  }
+}
+
+export class SnapshotEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): SnapshotEnclaveArgs;
+
+  getIncludePersistentDirectories(): boolean;
+  setIncludePersistentDirectories(value: boolean): SnapshotEnclaveArgs;
+  hasIncludePersistentDirectories(): boolean;
+  clearIncludePersistentDirectories(): SnapshotEnclaveArgs;
+
+  getIncludeContainerFilesystems(): boolean;
+  setIncludeContainerFilesystems(value: boolean): SnapshotEnclaveArgs;
+  hasIncludeContainerFilesystems(): boolean;
+  clearIncludeContainerFilesystems(): SnapshotEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SnapshotEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: SnapshotEnclaveArgs): SnapshotEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: SnapshotEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SnapshotEnclaveArgs;
+  static deserializeBinaryFromReader(message: SnapshotEnclaveArgs, reader: jspb.BinaryReader): SnapshotEnclaveArgs;
+}
+
+export namespace SnapshotEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    includePersistentDirectories?: boolean,
+    includeContainerFilesystems?: boolean,
+  }
+
+  export enum IncludePersistentDirectoriesCase { 
+    _INCLUDE_PERSISTENT_DIRECTORIES_NOT_SET = 0,
+    INCLUDE_PERSISTENT_DIRECTORIES = 2,
+  }
+
+  export enum IncludeContainerFilesystemsCase { 
+    _INCLUDE_CONTAINER_FILESYSTEMS_NOT_SET = 0,
+    INCLUDE_CONTAINER_FILESYSTEMS = 3,
+  }
+}
+
+export class EnclaveSnapshotChunk extends jspb.Message {
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): EnclaveSnapshotChunk;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveSnapshotChunk.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveSnapshotChunk): EnclaveSnapshotChunk.AsObject;
+  static serializeBinaryToWriter(message: EnclaveSnapshotChunk, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveSnapshotChunk;
+  static deserializeBinaryFromReader(message: EnclaveSnapshotChunk, reader: jspb.BinaryReader): EnclaveSnapshotChunk;
+}
+
+export namespace EnclaveSnapshotChunk {
+  export type AsObject = {
+    data: Uint8Array | string,
+  }
+}
+
+export class RestoreEnclaveArgs extends jspb.Message {
+  getCreateEnclaveArgs(): CreateEnclaveArgs | undefined;
+  setCreateEnclaveArgs(value?: CreateEnclaveArgs): RestoreEnclaveArgs;
+  hasCreateEnclaveArgs(): boolean;
+  clearCreateEnclaveArgs(): RestoreEnclaveArgs;
+
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): RestoreEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RestoreEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RestoreEnclaveArgs): RestoreEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: RestoreEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RestoreEnclaveArgs;
+  static deserializeBinaryFromReader(message: RestoreEnclaveArgs, reader: jspb.BinaryReader): RestoreEnclaveArgs;
+}
+
+export namespace RestoreEnclaveArgs {
+  export type AsObject = {
+    createEnclaveArgs?: CreateEnclaveArgs.AsObject,
+    data: Uint8Array | string,
+  }
+}
+
+export class RestoreEnclaveResponse extends jspb.Message {
+  getEnclaveInfo(): EnclaveInfo | undefined;
+  setEnclaveInfo(value?: EnclaveInfo): RestoreEnclaveResponse;
+  hasEnclaveInfo(): boolean;
+  clearEnclaveInfo(): RestoreEnclaveResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RestoreEnclaveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RestoreEnclaveResponse): RestoreEnclaveResponse.AsObject;
+  static serializeBinaryToWriter(message: RestoreEnclaveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RestoreEnclaveResponse;
+  static deserializeBinaryFromReader(message: RestoreEnclaveResponse, reader: jspb.BinaryReader): RestoreEnclaveResponse;
+}
+
+export namespace RestoreEnclaveResponse {
+  export type AsObject = {
+    enclaveInfo?: EnclaveInfo.AsObject,
+  }
+}
+
+export enum EnclaveExpiryAction { 
+  ENCLAVEEXPIRYACTION_DESTROY = 0,
+  ENCLAVEEXPIRYACTION_STOP = 1,
+}
 export enum EnclaveMode { 
   TEST = 0,
   PRODUCTION = 1,
@@ -464,3 +834,12 @@ export enum LogLineOperator {
   LOGLINEOPERATOR_DOES_CONTAIN_MATCH_REGEX = 2,
   LOGLINEOPERATOR_DOES_NOT_CONTAIN_MATCH_REGEX = 3,
 }
+export enum LogLineFieldOperator { 
+  LOGLINEFIELDOPERATOR_EQUALS = 0,
+  LOGLINEFIELDOPERATOR_NOT_EQUALS = 1,
+  LOGLINEFIELDOPERATOR_MATCHES_REGEX = 2,
+  LOGLINEFIELDOPERATOR_GREATER_THAN = 3,
+  LOGLINEFIELDOPERATOR_GREATER_THAN_OR_EQUAL = 4,
+  LOGLINEFIELDOPERATOR_LESS_THAN = 5,
+  LOGLINEFIELDOPERATOR_LESS_THAN_OR_EQUAL = 6,
+}
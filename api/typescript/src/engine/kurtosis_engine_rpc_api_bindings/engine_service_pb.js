@@ -34,18 +34,30 @@ goog.exportSymbol('proto.engine_api.EnclaveAPIContainerHostMachineInfo', null, g
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerStatus', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveContainersStatus', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveExpiryAction', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveIdentifiers', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveMode', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveNameAndUuid', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveSnapshotChunk', null, global);
+goog.exportSymbol('proto.engine_api.ExtendEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.ExtendEnclaveResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetEnclavesArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetEnclavesResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetEngineInfoResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsResponse', null, global);
 goog.exportSymbol('proto.engine_api.LogLine', null, global);
+goog.exportSymbol('proto.engine_api.LogLineFieldFilter', null, global);
+goog.exportSymbol('proto.engine_api.LogLineFieldOperator', null, global);
 goog.exportSymbol('proto.engine_api.LogLineFilter', null, global);
 goog.exportSymbol('proto.engine_api.LogLineOperator', null, global);
+goog.exportSymbol('proto.engine_api.RestoreEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.RestoreEnclaveResponse', null, global);
+goog.exportSymbol('proto.engine_api.SnapshotEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.StartEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.StartEnclaveResponse', null, global);
 goog.exportSymbol('proto.engine_api.StopEnclaveArgs', null, global);
 /**
  * Generated by JsPbCodeGenerator.
@@ -173,6 +185,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.EnclaveInfo.displayName = 'proto.engine_api.EnclaveInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetEnclavesArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetEnclavesArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetEnclavesArgs.displayName = 'proto.engine_api.GetEnclavesArgs';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -257,6 +290,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.StopEnclaveArgs.displayName = 'proto.engine_api.StopEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.StartEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.StartEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.StartEnclaveArgs.displayName = 'proto.engine_api.StartEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.StartEnclaveResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.StartEnclaveResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.StartEnclaveResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.StartEnclaveResponse.displayName = 'proto.engine_api.StartEnclaveResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ExtendEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ExtendEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ExtendEnclaveArgs.displayName = 'proto.engine_api.ExtendEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ExtendEnclaveResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ExtendEnclaveResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ExtendEnclaveResponse.displayName = 'proto.engine_api.ExtendEnclaveResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -425,6 +542,111 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.LogLineFilter.displayName = 'proto.engine_api.LogLineFilter';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.LogLineFieldFilter = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.LogLineFieldFilter, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.LogLineFieldFilter.displayName = 'proto.engine_api.LogLineFieldFilter';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.SnapshotEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.SnapshotEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.SnapshotEnclaveArgs.displayName = 'proto.engine_api.SnapshotEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EnclaveSnapshotChunk = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.EnclaveSnapshotChunk, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveSnapshotChunk.displayName = 'proto.engine_api.EnclaveSnapshotChunk';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.RestoreEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.RestoreEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.RestoreEnclaveArgs.displayName = 'proto.engine_api.RestoreEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.RestoreEnclaveResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.RestoreEnclaveResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.RestoreEnclaveResponse.displayName = 'proto.engine_api.RestoreEnclaveResponse';
+}
 
 
 
@@ -590,7 +812,15 @@ proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
     enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
     apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
     apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    mode: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    mode: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    filesArtifactsStorageQuota: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    ttlSeconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    idleTimeoutSeconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    expiryAction: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    cpuQuotaMillicpus: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    memoryQuotaMegabytes: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    serviceCountQuota: jspb.Message.getFieldWithDefault(msg, 12, 0)
   };
 
   if (includeInstance) {
@@ -643,6 +873,40 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
       msg.setMode(value);
       break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setFilesArtifactsStorageQuota(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTtlSeconds(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setIdleTimeoutSeconds(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.engine_api.EnclaveExpiryAction} */ (reader.readEnum());
+      msg.setExpiryAction(value);
+      break;
+    case 9:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCpuQuotaMillicpus(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryQuotaMegabytes(value);
+      break;
+    case 12:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setServiceCountQuota(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -700,6 +964,59 @@ proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getExpiryAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(9, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 10));
+  if (f != null) {
+    writer.writeUint64(
+      10,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 11));
+  if (f != null) {
+    writer.writeUint64(
+      11,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 12));
+  if (f != null) {
+    writer.writeUint32(
+      12,
+      f
+    );
+  }
 };
 
 
@@ -775,24 +1092,280 @@ proto.engine_api.CreateEnclaveArgs.prototype.setMode = function(value) {
 };
 
 
+/**
+ * optional uint64 files_artifacts_storage_quota = 5;
+ * @return {number}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getFilesArtifactsStorageQuota = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+proto.engine_api.CreateEnclaveArgs.prototype.setFilesArtifactsStorageQuota = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearFilesArtifactsStorageQuota = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasFilesArtifactsStorageQuota = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional uint32 ttl_seconds = 6;
+ * @return {number}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getTtlSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setTtlSeconds = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearTtlSeconds = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasTtlSeconds = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional uint32 idle_timeout_seconds = 7;
+ * @return {number}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getIdleTimeoutSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setIdleTimeoutSeconds = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearIdleTimeoutSeconds = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasIdleTimeoutSeconds = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional EnclaveExpiryAction expiry_action = 8;
+ * @return {!proto.engine_api.EnclaveExpiryAction}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getExpiryAction = function() {
+  return /** @type {!proto.engine_api.EnclaveExpiryAction} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveExpiryAction} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setExpiryAction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
+};
+
+
+/**
+ * map<string, string> labels = 9;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 9, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
+
+
+/**
+ * optional uint64 cpu_quota_millicpus = 10;
+ * @return {number}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getCpuQuotaMillicpus = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setCpuQuotaMillicpus = function(value) {
+  return jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearCpuQuotaMillicpus = function() {
+  return jspb.Message.setField(this, 10, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasCpuQuotaMillicpus = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional uint64 memory_quota_megabytes = 11;
+ * @return {number}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getMemoryQuotaMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setMemoryQuotaMegabytes = function(value) {
+  return jspb.Message.setField(this, 11, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearMemoryQuotaMegabytes = function() {
+  return jspb.Message.setField(this, 11, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasMemoryQuotaMegabytes = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+/**
+ * optional uint32 service_count_quota = 12;
+ * @return {number}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getServiceCountQuota = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setServiceCountQuota = function(value) {
+  return jspb.Message.setField(this, 12, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearServiceCountQuota = function() {
+  return jspb.Message.setField(this, 12, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasServiceCountQuota = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1346,7 +1919,10 @@ proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
     apiContainerInfo: (f = msg.getApiContainerInfo()) && proto.engine_api.EnclaveAPIContainerInfo.toObject(includeInstance, f),
     apiContainerHostMachineInfo: (f = msg.getApiContainerHostMachineInfo()) && proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(includeInstance, f),
     creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    mode: jspb.Message.getFieldWithDefault(msg, 9, 0)
+    mode: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    expirationTime: (f = msg.getExpirationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    expiryAction: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -1422,6 +1998,21 @@ proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader)
       var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
       msg.setMode(value);
       break;
+    case 10:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpirationTime(value);
+      break;
+    case 11:
+      var value = /** @type {!proto.engine_api.EnclaveExpiryAction} */ (reader.readEnum());
+      msg.setExpiryAction(value);
+      break;
+    case 12:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
     default:
       reader.skipField();
       break;
@@ -1517,6 +2108,25 @@ proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer)
       f
     );
   }
+  f = message.getExpirationTime();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getExpiryAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      11,
+      f
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(12, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
@@ -1739,51 +2349,261 @@ proto.engine_api.EnclaveInfo.prototype.setMode = function(value) {
 };
 
 
+/**
+ * optional google.protobuf.Timestamp expiration_time = 10;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveInfo.prototype.getExpirationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 10));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetEnclavesResponse.toObject(opt_includeInstance, this);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setExpirationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetEnclavesResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
  */
-proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    enclaveInfoMap: (f = msg.getEnclaveInfoMap()) ? f.toObject(includeInstance, proto.engine_api.EnclaveInfo.toObject) : []
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.engine_api.EnclaveInfo.prototype.clearExpirationTime = function() {
+  return this.setExpirationTime(undefined);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasExpirationTime = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional EnclaveExpiryAction expiry_action = 11;
+ * @return {!proto.engine_api.EnclaveExpiryAction}
+ */
+proto.engine_api.EnclaveInfo.prototype.getExpiryAction = function() {
+  return /** @type {!proto.engine_api.EnclaveExpiryAction} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveExpiryAction} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setExpiryAction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 11, value);
+};
+
+
+/**
+ * map<string, string> labels = 12;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.EnclaveInfo.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 12, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclavesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclavesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    labelSelectorMap: (f = msg.getLabelSelectorMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEnclavesArgs}
+ */
+proto.engine_api.GetEnclavesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetEnclavesArgs;
+  return proto.engine_api.GetEnclavesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetEnclavesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetEnclavesArgs}
+ */
+proto.engine_api.GetEnclavesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getLabelSelectorMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetEnclavesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetEnclavesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLabelSelectorMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * map<string, string> label_selector = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.getLabelSelectorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetEnclavesArgs} returns this
+ */
+proto.engine_api.GetEnclavesArgs.prototype.clearLabelSelectorMap = function() {
+  this.getLabelSelectorMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclavesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclavesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfoMap: (f = msg.getEnclaveInfoMap()) ? f.toObject(includeInstance, proto.engine_api.EnclaveInfo.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
  * @return {!proto.engine_api.GetEnclavesResponse}
  */
@@ -2368,8 +3188,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.StartEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.StartEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2378,11 +3198,11 @@ proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.StartEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.StartEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
     enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
@@ -2398,23 +3218,23 @@ proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.StartEnclaveArgs}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.StartEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.DestroyEnclaveArgs;
-  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.StartEnclaveArgs;
+  return proto.engine_api.StartEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.StartEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.StartEnclaveArgs}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.StartEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2438,9 +3258,9 @@ proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.StartEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.StartEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2448,11 +3268,11 @@ proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!proto.engine_api.StartEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.StartEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getEnclaveIdentifier();
   if (f.length > 0) {
@@ -2468,21 +3288,28 @@ proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message,
  * optional string enclave_identifier = 1;
  * @return {string}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+proto.engine_api.StartEnclaveArgs.prototype.getEnclaveIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ * @return {!proto.engine_api.StartEnclaveArgs} returns this
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+proto.engine_api.StartEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.StartEnclaveResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2498,8 +3325,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
+proto.engine_api.StartEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.StartEnclaveResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2508,13 +3335,13 @@ proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.StartEnclaveResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.StartEnclaveResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    resumedServiceNamesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2528,23 +3355,23 @@ proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanArgs}
+ * @return {!proto.engine_api.StartEnclaveResponse}
  */
-proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
+proto.engine_api.StartEnclaveResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanArgs;
-  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.StartEnclaveResponse;
+  return proto.engine_api.StartEnclaveResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.StartEnclaveResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanArgs}
+ * @return {!proto.engine_api.StartEnclaveResponse}
  */
-proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.StartEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2552,8 +3379,8 @@ proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setShouldCleanAll(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addResumedServiceNames(value);
       break;
     default:
       reader.skipField();
@@ -2568,9 +3395,9 @@ proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
+proto.engine_api.StartEnclaveResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.StartEnclaveResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2578,15 +3405,15 @@ proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanArgs} message
+ * @param {!proto.engine_api.StartEnclaveResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.StartEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getShouldCleanAll();
-  if (f) {
-    writer.writeBool(
+  f = message.getResumedServiceNamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       1,
       f
     );
@@ -2595,20 +3422,39 @@ proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional bool should_clean_all = 1;
- * @return {boolean}
+ * repeated string resumed_service_names = 1;
+ * @return {!Array<string>}
  */
-proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.engine_api.StartEnclaveResponse.prototype.getResumedServiceNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.CleanArgs} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.StartEnclaveResponse} returns this
  */
-proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.engine_api.StartEnclaveResponse.prototype.setResumedServiceNamesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.StartEnclaveResponse} returns this
+ */
+proto.engine_api.StartEnclaveResponse.prototype.addResumedServiceNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.StartEnclaveResponse} returns this
+ */
+proto.engine_api.StartEnclaveResponse.prototype.clearResumedServiceNamesList = function() {
+  return this.setResumedServiceNamesList([]);
 };
 
 
@@ -2628,8 +3474,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
+proto.engine_api.ExtendEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ExtendEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2638,14 +3484,14 @@ proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
+ * @param {!proto.engine_api.ExtendEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
+proto.engine_api.ExtendEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extensionSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -2659,23 +3505,23 @@ proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.ExtendEnclaveArgs}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
+proto.engine_api.ExtendEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveNameAndUuid;
-  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.ExtendEnclaveArgs;
+  return proto.engine_api.ExtendEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
+ * @param {!proto.engine_api.ExtendEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.ExtendEnclaveArgs}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.ExtendEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2684,11 +3530,11 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setEnclaveIdentifier(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setExtensionSeconds(value);
       break;
     default:
       reader.skipField();
@@ -2703,9 +3549,9 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
+proto.engine_api.ExtendEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
+  proto.engine_api.ExtendEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2713,22 +3559,22 @@ proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveNameAndUuid} message
+ * @param {!proto.engine_api.ExtendEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.ExtendEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getEnclaveIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getExtensionSeconds();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
       f
     );
@@ -2737,49 +3583,42 @@ proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message,
 
 
 /**
- * optional string name = 1;
+ * optional string enclave_identifier = 1;
  * @return {string}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
+proto.engine_api.ExtendEnclaveArgs.prototype.getEnclaveIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ * @return {!proto.engine_api.ExtendEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
+proto.engine_api.ExtendEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string uuid = 2;
- * @return {string}
+ * optional uint32 extension_seconds = 2;
+ * @return {number}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.ExtendEnclaveArgs.prototype.getExtensionSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.ExtendEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.ExtendEnclaveArgs.prototype.setExtensionSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.CleanResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2795,8 +3634,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
+proto.engine_api.ExtendEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ExtendEnclaveResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2805,14 +3644,13 @@ proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.ExtendEnclaveResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.ExtendEnclaveResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
-    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
+    expirationTime: (f = msg.getExpirationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2826,23 +3664,23 @@ proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.ExtendEnclaveResponse}
  */
-proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
+proto.engine_api.ExtendEnclaveResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanResponse;
-  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.ExtendEnclaveResponse;
+  return proto.engine_api.ExtendEnclaveResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.ExtendEnclaveResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.ExtendEnclaveResponse}
  */
-proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.ExtendEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2850,9 +3688,9 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveNameAndUuid;
-      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
-      msg.addRemovedEnclaveNameAndUuids(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpirationTime(value);
       break;
     default:
       reader.skipField();
@@ -2867,9 +3705,9 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
+proto.engine_api.ExtendEnclaveResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.ExtendEnclaveResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2877,69 +3715,61 @@ proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanResponse} message
+ * @param {!proto.engine_api.ExtendEnclaveResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.ExtendEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRemovedEnclaveNameAndUuidsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getExpirationTime();
+  if (f != null) {
+    writer.writeMessage(
       1,
       f,
-      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
- * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
+ * optional google.protobuf.Timestamp expiration_time = 1;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
-  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
+proto.engine_api.ExtendEnclaveResponse.prototype.getExpirationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
- * @return {!proto.engine_api.CleanResponse} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.ExtendEnclaveResponse} returns this
 */
-proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.engine_api.ExtendEnclaveResponse.prototype.setExpirationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.ExtendEnclaveResponse} returns this
  */
-proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+proto.engine_api.ExtendEnclaveResponse.prototype.clearExpirationTime = function() {
+  return this.setExpirationTime(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.CleanResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
-  return this.setRemovedEnclaveNameAndUuidsList([]);
+proto.engine_api.ExtendEnclaveResponse.prototype.hasExpirationTime = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2955,8 +3785,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
+proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2965,19 +3795,14 @@ proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
     enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
-    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
-    proto.engine_api.LogLineFilter.toObject, includeInstance),
-    returnAllLogs: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    numLogLines: jspb.Message.getFieldWithDefault(msg, 6, 0)
+    labelSelectorMap: (f = msg.getLabelSelectorMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -2991,23 +3816,23 @@ proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
+proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsArgs;
-  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.DestroyEnclaveArgs;
+  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3019,28 +3844,11 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
       msg.setEnclaveIdentifier(value);
       break;
     case 2:
-      var value = msg.getServiceUuidSetMap();
+      var value = msg.getLabelSelectorMap();
       reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
          });
       break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setFollowLogs(value);
-      break;
-    case 4:
-      var value = new proto.engine_api.LogLineFilter;
-      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
-      msg.addConjunctiveFilters(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReturnAllLogs(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setNumLogLines(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -3054,9 +3862,9 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
+proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3064,11 +3872,11 @@ proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsArgs} message
+ * @param {!proto.engine_api.DestroyEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getEnclaveIdentifier();
   if (f.length > 0) {
@@ -3077,38 +3885,9 @@ proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message,
       f
     );
   }
-  f = message.getServiceUuidSetMap(true);
+  f = message.getLabelSelectorMap(true);
   if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
-  }
-  f = message.getFollowLogs();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = message.getConjunctiveFiltersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
-      f,
-      proto.engine_api.LogLineFilter.serializeBinaryToWriter
-    );
-  }
-  f = message.getReturnAllLogs();
-  if (f) {
-    writer.writeBool(
-      5,
-      f
-    );
-  }
-  f = message.getNumLogLines();
-  if (f !== 0) {
-    writer.writeUint32(
-      6,
-      f
-    );
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
 };
 
@@ -3117,28 +3896,28 @@ proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message,
  * optional string enclave_identifier = 1;
  * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
+proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
+proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * map<string, bool> service_uuid_set = 2;
+ * map<string, string> label_selector = 2;
  * @param {boolean=} opt_noLazyCreate Do not create the map if
  * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * @return {!jspb.Map<string,string>}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
+proto.engine_api.DestroyEnclaveArgs.prototype.getLabelSelectorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
       jspb.Message.getMapField(this, 2, opt_noLazyCreate,
       null));
 };
@@ -3146,102 +3925,1961 @@ proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(op
 
 /**
  * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
-  this.getServiceUuidSetMap().clear();
+proto.engine_api.DestroyEnclaveArgs.prototype.clearLabelSelectorMap = function() {
+  this.getLabelSelectorMap().clear();
   return this;};
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional bool follow_logs = 3;
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    labelSelectorMap: (f = msg.getLabelSelectorMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated LogLineFilter conjunctive_filters = 4;
- * @return {!Array<!proto.engine_api.LogLineFilter>}
- */
-proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CleanArgs}
+ */
+proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CleanArgs;
+  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CleanArgs}
+ */
+proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldCleanAll(value);
+      break;
+    case 2:
+      var value = msg.getLabelSelectorMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CleanArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getShouldCleanAll();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getLabelSelectorMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional bool should_clean_all = 1;
+ * @return {boolean}
+ */
+proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * map<string, string> label_selector = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.CleanArgs.prototype.getLabelSelectorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.clearLabelSelectorMap = function() {
+  this.getLabelSelectorMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveNameAndUuid;
+  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string uuid = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.CleanResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
+    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CleanResponse}
+ */
+proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CleanResponse;
+  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CleanResponse}
+ */
+proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveNameAndUuid;
+      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
+      msg.addRemovedEnclaveNameAndUuids(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CleanResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRemovedEnclaveNameAndUuidsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
+ * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
+ */
+proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
+ * @return {!proto.engine_api.CleanResponse} returns this
+*/
+proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CleanResponse} returns this
+ */
+proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
+  return this.setRemovedEnclaveNameAndUuidsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4,9];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
+    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
+    proto.engine_api.LogLineFilter.toObject, includeInstance),
+    returnAllLogs: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    numLogLines: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    since: (f = msg.getSince()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    until: (f = msg.getUntil()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    conjunctiveFieldFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFieldFiltersList(),
+    proto.engine_api.LogLineFieldFilter.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetServiceLogsArgs}
+ */
+proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetServiceLogsArgs;
+  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetServiceLogsArgs}
+ */
+proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = msg.getServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFollowLogs(value);
+      break;
+    case 4:
+      var value = new proto.engine_api.LogLineFilter;
+      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
+      msg.addConjunctiveFilters(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnAllLogs(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumLogLines(value);
+      break;
+    case 7:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setSince(value);
+      break;
+    case 8:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setUntil(value);
+      break;
+    case 9:
+      var value = new proto.engine_api.LogLineFieldFilter;
+      reader.readMessage(value,proto.engine_api.LogLineFieldFilter.deserializeBinaryFromReader);
+      msg.addConjunctiveFieldFilters(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetServiceLogsArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getFollowLogs();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getConjunctiveFiltersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.engine_api.LogLineFilter.serializeBinaryToWriter
+    );
+  }
+  f = message.getReturnAllLogs();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getNumLogLines();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getSince();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getUntil();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getConjunctiveFieldFiltersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.engine_api.LogLineFieldFilter.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, bool> service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
+  this.getServiceUuidSetMap().clear();
+  return this;};
+
+
+/**
+ * optional bool follow_logs = 3;
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * repeated LogLineFilter conjunctive_filters = 4;
+ * @return {!Array<!proto.engine_api.LogLineFilter>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
   return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
     jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.LogLineFilter>} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
-*/
-proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+ * @param {!Array<!proto.engine_api.LogLineFilter>} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.LogLineFilter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLineFilter}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
+  return this.setConjunctiveFiltersList([]);
+};
+
+
+/**
+ * optional bool return_all_logs = 5;
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getReturnAllLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setReturnAllLogs = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 num_log_lines = 6;
+ * @return {number}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getNumLogLines = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setNumLogLines = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp since = 7;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getSince = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setSince = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearSince = function() {
+  return this.setSince(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.hasSince = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp until = 8;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getUntil = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setUntil = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearUntil = function() {
+  return this.setUntil(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.hasUntil = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * repeated LogLineFieldFilter conjunctive_field_filters = 9;
+ * @return {!Array<!proto.engine_api.LogLineFieldFilter>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFieldFiltersList = function() {
+  return /** @type{!Array<!proto.engine_api.LogLineFieldFilter>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFieldFilter, 9));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.LogLineFieldFilter>} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFieldFiltersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.LogLineFieldFilter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLineFieldFilter}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFieldFilters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.engine_api.LogLineFieldFilter, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFieldFiltersList = function() {
+  return this.setConjunctiveFieldFiltersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
+    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetServiceLogsResponse}
+ */
+proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetServiceLogsResponse;
+  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetServiceLogsResponse}
+ */
+proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceLogsByServiceUuidMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
+         });
+      break;
+    case 2:
+      var value = msg.getNotFoundServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetServiceLogsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceLogsByServiceUuidMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
+  }
+  f = message.getNotFoundServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+};
+
+
+/**
+ * map<string, LogLine> service_logs_by_service_uuid = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.engine_api.LogLine));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
+  this.getServiceLogsByServiceUuidMap().clear();
+  return this;};
+
+
+/**
+ * map<string, bool> not_found_service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
+  this.getNotFoundServiceUuidSetMap().clear();
+  return this;};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.LogLine.repeatedFields_ = [1,2,3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    timestampList: jspb.Message.toObjectList(msg.getTimestampList(),
+    google_protobuf_timestamp_pb.Timestamp.toObject, includeInstance),
+    levelList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.LogLine}
+ */
+proto.engine_api.LogLine.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.LogLine;
+  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.LogLine}
+ */
+proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLine(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.addTimestamp(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLevel(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.LogLine.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.LogLine} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLineList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getTimestampList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLevelList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string line = 1;
+ * @return {!Array<string>}
+ */
+proto.engine_api.LogLine.prototype.getLineList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.setLineList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.clearLineList = function() {
+  return this.setLineList([]);
+};
+
+
+/**
+ * repeated google.protobuf.Timestamp timestamp = 2;
+ * @return {!Array<!proto.google.protobuf.Timestamp>}
+ */
+proto.engine_api.LogLine.prototype.getTimestampList = function() {
+  return /** @type{!Array<!proto.google.protobuf.Timestamp>} */ (
+    jspb.Message.getRepeatedWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.google.protobuf.Timestamp>} value
+ * @return {!proto.engine_api.LogLine} returns this
+*/
+proto.engine_api.LogLine.prototype.setTimestampList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Timestamp=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.LogLine.prototype.addTimestamp = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.google.protobuf.Timestamp, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.clearTimestampList = function() {
+  return this.setTimestampList([]);
+};
+
+
+/**
+ * repeated string level = 3;
+ * @return {!Array<string>}
+ */
+proto.engine_api.LogLine.prototype.getLevelList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.setLevelList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.addLevel = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.clearLevelList = function() {
+  return this.setLevelList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.LogLineFilter}
+ */
+proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.LogLineFilter;
+  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.LogLineFilter}
+ */
+proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
+      msg.setOperator(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTextPattern(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.LogLineFilter} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOperator();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getTextPattern();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional LogLineOperator operator = 1;
+ * @return {!proto.engine_api.LogLineOperator}
+ */
+proto.engine_api.LogLineFilter.prototype.getOperator = function() {
+  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.LogLineOperator} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
+ */
+proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string text_pattern = 2;
+ * @return {string}
+ */
+proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
+ */
+proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.LogLineFieldFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLineFieldFilter.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.LogLineFieldFilter} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLineFieldFilter.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    fieldPath: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    operator: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    value: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.LogLineFieldFilter}
+ */
+proto.engine_api.LogLineFieldFilter.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.LogLineFieldFilter;
+  return proto.engine_api.LogLineFieldFilter.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.LogLineFieldFilter} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.LogLineFieldFilter}
+ */
+proto.engine_api.LogLineFieldFilter.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFieldPath(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.engine_api.LogLineFieldOperator} */ (reader.readEnum());
+      msg.setOperator(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.engine_api.LogLineFilter=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.LogLineFilter}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
+proto.engine_api.LogLineFieldFilter.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.LogLineFieldFilter.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.LogLineFieldFilter} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLineFieldFilter.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFieldPath();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOperator();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string field_path = 1;
+ * @return {string}
+ */
+proto.engine_api.LogLineFieldFilter.prototype.getFieldPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.LogLineFieldFilter} returns this
+ */
+proto.engine_api.LogLineFieldFilter.prototype.setFieldPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional LogLineFieldOperator operator = 2;
+ * @return {!proto.engine_api.LogLineFieldOperator}
+ */
+proto.engine_api.LogLineFieldFilter.prototype.getOperator = function() {
+  return /** @type {!proto.engine_api.LogLineFieldOperator} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.LogLineFieldOperator} value
+ * @return {!proto.engine_api.LogLineFieldFilter} returns this
+ */
+proto.engine_api.LogLineFieldFilter.prototype.setOperator = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string value = 3;
+ * @return {string}
+ */
+proto.engine_api.LogLineFieldFilter.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.LogLineFieldFilter} returns this
+ */
+proto.engine_api.LogLineFieldFilter.prototype.setValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.SnapshotEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.SnapshotEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.SnapshotEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.SnapshotEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    includePersistentDirectories: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    includeContainerFilesystems: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.SnapshotEnclaveArgs}
+ */
+proto.engine_api.SnapshotEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.SnapshotEnclaveArgs;
+  return proto.engine_api.SnapshotEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.SnapshotEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.SnapshotEnclaveArgs}
+ */
+proto.engine_api.SnapshotEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIncludePersistentDirectories(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIncludeContainerFilesystems(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.SnapshotEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.SnapshotEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.SnapshotEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.SnapshotEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.SnapshotEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.SnapshotEnclaveArgs} returns this
+ */
+proto.engine_api.SnapshotEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool include_persistent_directories = 2;
+ * @return {boolean}
+ */
+proto.engine_api.SnapshotEnclaveArgs.prototype.getIncludePersistentDirectories = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.SnapshotEnclaveArgs} returns this
+ */
+proto.engine_api.SnapshotEnclaveArgs.prototype.setIncludePersistentDirectories = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.SnapshotEnclaveArgs} returns this
+ */
+proto.engine_api.SnapshotEnclaveArgs.prototype.clearIncludePersistentDirectories = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
-  return this.setConjunctiveFiltersList([]);
+proto.engine_api.SnapshotEnclaveArgs.prototype.hasIncludePersistentDirectories = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional bool return_all_logs = 5;
+ * optional bool include_container_filesystems = 3;
  * @return {boolean}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getReturnAllLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.engine_api.SnapshotEnclaveArgs.prototype.getIncludeContainerFilesystems = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @return {!proto.engine_api.SnapshotEnclaveArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setReturnAllLogs = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.engine_api.SnapshotEnclaveArgs.prototype.setIncludeContainerFilesystems = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * optional uint32 num_log_lines = 6;
- * @return {number}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.SnapshotEnclaveArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getNumLogLines = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.engine_api.SnapshotEnclaveArgs.prototype.clearIncludeContainerFilesystems = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setNumLogLines = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.engine_api.SnapshotEnclaveArgs.prototype.hasIncludeContainerFilesystems = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -3261,8 +5899,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveSnapshotChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveSnapshotChunk.toObject(opt_includeInstance, this);
 };
 
 
@@ -3271,14 +5909,13 @@ proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclaveSnapshotChunk} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclaveSnapshotChunk.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
-    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
+    data: msg.getData_asB64()
   };
 
   if (includeInstance) {
@@ -3292,23 +5929,23 @@ proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.EnclaveSnapshotChunk}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
+proto.engine_api.EnclaveSnapshotChunk.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsResponse;
-  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclaveSnapshotChunk;
+  return proto.engine_api.EnclaveSnapshotChunk.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclaveSnapshotChunk} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.EnclaveSnapshotChunk}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclaveSnapshotChunk.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3316,16 +5953,8 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceLogsByServiceUuidMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
-         });
-      break;
-    case 2:
-      var value = msg.getNotFoundServiceUuidSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     default:
       reader.skipField();
@@ -3340,9 +5969,9 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
+proto.engine_api.EnclaveSnapshotChunk.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.EnclaveSnapshotChunk.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3350,74 +5979,64 @@ proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsResponse} message
+ * @param {!proto.engine_api.EnclaveSnapshotChunk} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.EnclaveSnapshotChunk.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceLogsByServiceUuidMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
-  }
-  f = message.getNotFoundServiceUuidSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, LogLine> service_logs_by_service_uuid = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
+ * optional bytes data = 1;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.engine_api.LogLine));
+proto.engine_api.EnclaveSnapshotChunk.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
-  this.getServiceLogsByServiceUuidMap().clear();
-  return this;};
+proto.engine_api.EnclaveSnapshotChunk.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
 
 
 /**
- * map<string, bool> not_found_service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.engine_api.EnclaveSnapshotChunk.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.engine_api.EnclaveSnapshotChunk} returns this
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
-  this.getNotFoundServiceUuidSetMap().clear();
-  return this;};
-
+proto.engine_api.EnclaveSnapshotChunk.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.LogLine.repeatedFields_ = [1];
 
 
 
@@ -3434,8 +6053,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
+proto.engine_api.RestoreEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.RestoreEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3444,13 +6063,14 @@ proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
+ * @param {!proto.engine_api.RestoreEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
+proto.engine_api.RestoreEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    createEnclaveArgs: (f = msg.getCreateEnclaveArgs()) && proto.engine_api.CreateEnclaveArgs.toObject(includeInstance, f),
+    data: msg.getData_asB64()
   };
 
   if (includeInstance) {
@@ -3464,23 +6084,23 @@ proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLine}
+ * @return {!proto.engine_api.RestoreEnclaveArgs}
  */
-proto.engine_api.LogLine.deserializeBinary = function(bytes) {
+proto.engine_api.RestoreEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLine;
-  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.RestoreEnclaveArgs;
+  return proto.engine_api.RestoreEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
+ * @param {!proto.engine_api.RestoreEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLine}
+ * @return {!proto.engine_api.RestoreEnclaveArgs}
  */
-proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.RestoreEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3488,8 +6108,13 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addLine(value);
+      var value = new proto.engine_api.CreateEnclaveArgs;
+      reader.readMessage(value,proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader);
+      msg.setCreateEnclaveArgs(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     default:
       reader.skipField();
@@ -3504,9 +6129,9 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.LogLine.prototype.serializeBinary = function() {
+proto.engine_api.RestoreEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
+  proto.engine_api.RestoreEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3514,16 +6139,24 @@ proto.engine_api.LogLine.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLine} message
+ * @param {!proto.engine_api.RestoreEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.RestoreEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getLineList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getCreateEnclaveArgs();
+  if (f != null) {
+    writer.writeMessage(
       1,
+      f,
+      proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter
+    );
+  }
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
       f
     );
   }
@@ -3531,39 +6164,81 @@ proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * repeated string line = 1;
- * @return {!Array<string>}
+ * optional CreateEnclaveArgs create_enclave_args = 1;
+ * @return {?proto.engine_api.CreateEnclaveArgs}
  */
-proto.engine_api.LogLine.prototype.getLineList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.engine_api.RestoreEnclaveArgs.prototype.getCreateEnclaveArgs = function() {
+  return /** @type{?proto.engine_api.CreateEnclaveArgs} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.CreateEnclaveArgs, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.engine_api.LogLine} returns this
+ * @param {?proto.engine_api.CreateEnclaveArgs|undefined} value
+ * @return {!proto.engine_api.RestoreEnclaveArgs} returns this
+*/
+proto.engine_api.RestoreEnclaveArgs.prototype.setCreateEnclaveArgs = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.RestoreEnclaveArgs} returns this
  */
-proto.engine_api.LogLine.prototype.setLineList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.engine_api.RestoreEnclaveArgs.prototype.clearCreateEnclaveArgs = function() {
+  return this.setCreateEnclaveArgs(undefined);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.engine_api.LogLine} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.engine_api.RestoreEnclaveArgs.prototype.hasCreateEnclaveArgs = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.LogLine} returns this
+ * optional bytes data = 2;
+ * @return {string}
  */
-proto.engine_api.LogLine.prototype.clearLineList = function() {
-  return this.setLineList([]);
+proto.engine_api.RestoreEnclaveArgs.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.engine_api.RestoreEnclaveArgs.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.engine_api.RestoreEnclaveArgs.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.engine_api.RestoreEnclaveArgs} returns this
+ */
+proto.engine_api.RestoreEnclaveArgs.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
@@ -3583,8 +6258,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
+proto.engine_api.RestoreEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.RestoreEnclaveResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3593,14 +6268,13 @@ proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
+ * @param {!proto.engine_api.RestoreEnclaveResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
+proto.engine_api.RestoreEnclaveResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
+    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3614,23 +6288,23 @@ proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.RestoreEnclaveResponse}
  */
-proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
+proto.engine_api.RestoreEnclaveResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLineFilter;
-  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.RestoreEnclaveResponse;
+  return proto.engine_api.RestoreEnclaveResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
+ * @param {!proto.engine_api.RestoreEnclaveResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.RestoreEnclaveResponse}
  */
-proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.RestoreEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3638,12 +6312,9 @@ proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
-      msg.setOperator(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTextPattern(value);
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.setEnclaveInfo(value);
       break;
     default:
       reader.skipField();
@@ -3658,9 +6329,9 @@ proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
+proto.engine_api.RestoreEnclaveResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
+  proto.engine_api.RestoreEnclaveResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3668,65 +6339,68 @@ proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLineFilter} message
+ * @param {!proto.engine_api.RestoreEnclaveResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.RestoreEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOperator();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getEnclaveInfo();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
-    );
-  }
-  f = message.getTextPattern();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
+      f,
+      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional LogLineOperator operator = 1;
- * @return {!proto.engine_api.LogLineOperator}
+ * optional EnclaveInfo enclave_info = 1;
+ * @return {?proto.engine_api.EnclaveInfo}
  */
-proto.engine_api.LogLineFilter.prototype.getOperator = function() {
-  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.engine_api.RestoreEnclaveResponse.prototype.getEnclaveInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
 };
 
 
 /**
- * @param {!proto.engine_api.LogLineOperator} value
- * @return {!proto.engine_api.LogLineFilter} returns this
- */
-proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+ * @param {?proto.engine_api.EnclaveInfo|undefined} value
+ * @return {!proto.engine_api.RestoreEnclaveResponse} returns this
+*/
+proto.engine_api.RestoreEnclaveResponse.prototype.setEnclaveInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string text_pattern = 2;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.RestoreEnclaveResponse} returns this
  */
-proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.RestoreEnclaveResponse.prototype.clearEnclaveInfo = function() {
+  return this.setEnclaveInfo(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.LogLineFilter} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.RestoreEnclaveResponse.prototype.hasEnclaveInfo = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.engine_api.EnclaveExpiryAction = {
+  ENCLAVEEXPIRYACTION_DESTROY: 0,
+  ENCLAVEEXPIRYACTION_STOP: 1
+};
+
 /**
  * @enum {number}
  */
@@ -3763,4 +6437,17 @@ proto.engine_api.LogLineOperator = {
   LOGLINEOPERATOR_DOES_NOT_CONTAIN_MATCH_REGEX: 3
 };
 
+/**
+ * @enum {number}
+ */
+proto.engine_api.LogLineFieldOperator = {
+  LOGLINEFIELDOPERATOR_EQUALS: 0,
+  LOGLINEFIELDOPERATOR_NOT_EQUALS: 1,
+  LOGLINEFIELDOPERATOR_MATCHES_REGEX: 2,
+  LOGLINEFIELDOPERATOR_GREATER_THAN: 3,
+  LOGLINEFIELDOPERATOR_GREATER_THAN_OR_EQUAL: 4,
+  LOGLINEFIELDOPERATOR_LESS_THAN: 5,
+  LOGLINEFIELDOPERATOR_LESS_THAN_OR_EQUAL: 6
+};
+
 goog.object.extend(exports, proto.engine_api);
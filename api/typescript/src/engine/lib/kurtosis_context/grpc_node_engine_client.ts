@@ -5,6 +5,7 @@ import { Readable } from 'stream';
 
 import { ServiceUUID } from '../../../core/lib/services/service';
 import {
+    GetEnclavesArgs,
     GetExistingAndHistoricalEnclaveIdentifiersResponse,
     LogLine
 } from '../../kurtosis_engine_rpc_api_bindings/engine_service_pb';
@@ -162,9 +163,9 @@ export class GrpcNodeEngineClient implements GenericEngineClient {
     }
 
     public async getEnclavesResponse(): Promise<Result<GetEnclavesResponse, Error>> {
-        const emptyArg: google_protobuf_empty_pb.Empty = new google_protobuf_empty_pb.Empty()
+        const getEnclavesArgs: GetEnclavesArgs = new GetEnclavesArgs()
         const getEnclavesPromise: Promise<Result<GetEnclavesResponse, Error>> = new Promise((resolve, _unusedReject) => {
-            this.client.getEnclaves(emptyArg, (error: ServiceError | null, response?: GetEnclavesResponse) => {
+            this.client.getEnclaves(getEnclavesArgs, (error: ServiceError | null, response?: GetEnclavesResponse) => {
                 if (error === null) {
                     if (!response) {
                         resolve(err(new Error(NO_ERROR_ENCOUNTERED_BUT_RESPONSE_FALSY_MSG)));
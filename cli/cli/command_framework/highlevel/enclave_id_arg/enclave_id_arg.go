@@ -30,10 +30,16 @@ func NewEnclaveIdentifierArg(
 
 	validate := getValidationFunc(argKey, engineClientCtxKey, isGreedy)
 
+	// An omitted greedy arg defaults to no enclave identifiers at all
+	var defaultValue interface{} = ""
+	if isGreedy {
+		defaultValue = []string{}
+	}
+
 	return &args.ArgConfig{
 		Key:                   argKey,
 		IsOptional:            isOptional,
-		DefaultValue:          "",
+		DefaultValue:          defaultValue,
 		IsGreedy:              isGreedy,
 		ValidationFunc:        validate,
 		ArgCompletionProvider: args.NewManualCompletionsProvider(getCompletions),
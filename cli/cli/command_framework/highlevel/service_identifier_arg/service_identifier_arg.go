@@ -26,10 +26,16 @@ func NewServiceIdentifierArg(
 
 	validate := getValidationFunc(serviceIdentifierArgKey, isGreedy, enclaveIdentifierArgKey)
 
+	// An optional greedy arg that isn't provided defaults to no service at all
+	var defaultValue interface{} = ""
+	if isGreedy {
+		defaultValue = []string{}
+	}
+
 	return &args.ArgConfig{
 		Key:                   serviceIdentifierArgKey,
 		IsOptional:            isOptional,
-		DefaultValue:          "",
+		DefaultValue:          defaultValue,
 		IsGreedy:              isGreedy,
 		ArgCompletionProvider: args.NewManualCompletionsProvider(getCompletionsOfActiveServices(enclaveIdentifierArgKey)),
 		ValidationFunc:        validate,
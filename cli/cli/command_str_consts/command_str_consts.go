@@ -11,70 +11,90 @@ import (
 var KurtosisCmdStr = path.Base(os.Args[0])
 
 const (
-	Analytics               = "analytics"
-	CleanCmdStr             = "clean"
-	CloudAddCmdStr          = "add"
-	CloudCmdStr             = "cloud"
-	CloudLoadCmdStr         = "load"
-	ClusterCmdStr           = "cluster"
-	ClusterSetCmdStr        = "set"
-	ClusterGetCmdStr        = "get"
-	ClusterLsCmdStr         = "ls"
-	ContextCmdStr           = "context"
-	ContextAddCmdStr        = "add"
-	ContextLsCmdStr         = "ls"
-	ContextRmCmdStr         = "rm"
-	ContextSetCmdStr        = "set"
-	DiscordCmdStr           = "discord"
-	DocsCmdStr              = "docs"
-	EnclaveCmdStr           = "enclave"
-	EnclaveInspectCmdStr    = "inspect"
-	EnclaveLsCmdStr         = "ls"
-	EnclaveAddCmdStr        = "add"
-	EnclaveStopCmdStr       = "stop"
-	EnclaveRmCmdStr         = "rm"
-	EnclaveDumpCmdStr       = "dump"
-	EngineCmdStr            = "engine"
-	EngineLogsCmdStr        = "logs"
-	EngineStartCmdStr       = "start"
-	EngineStatusCmdStr      = "status"
-	EngineStopCmdStr        = "stop"
-	EngineRestartCmdStr     = "restart"
-	FeedbackCmdStr          = "feedback"
-	FilesCmdStr             = "files"
-	FilesUploadCmdStr       = "upload"
-	FilesInspectCmdStr      = "inspect"
-	FilesDownloadCmdStr     = "download"
-	FilesStoreWebCmdStr     = "storeweb"
-	FilesStoreServiceCmdStr = "storeservice"
-	FilesRenderTemplate     = "rendertemplate"
-	KurtosisDumpCmdStr      = "dump"
-	KurtosisLintCmdStr      = "lint"
-	PortalCmdStr            = "portal"
-	PortalStartCmdStr       = "start"
-	PortalStatusCmdStr      = "status"
-	PortalStopCmdStr        = "stop"
-	ServiceCmdStr           = "service"
-	ServiceAddCmdStr        = "add"
-	ServiceExecCmdStr       = "exec"
-	ServiceLogsCmdStr       = "logs"
-	ServiceRmCmdStr         = "rm"
-	ServiceShellCmdStr      = "shell"
-	ServiceStartCmdStr      = "start"
-	ServiceStopCmdStr       = "stop"
-	ServiceInspectCmdStr    = "inspect"
-	StarlarkRunCmdStr       = "run"
-	TwitterCmdStr           = "twitter"
-	ConfigCmdStr            = "config"
-	PathCmdStr              = "path"
-	VersionCmdStr           = "version"
-	ImportCmdStr            = "import"
-	GatewayCmdStr           = "gateway"
-	PackageCmdStr           = "package"
-	InitCmdStr              = "init"
-	PortCmdStr              = "port"
-	PortPrintCmdStr         = "print"
-	WebCmdStr               = "web"
+	Analytics                    = "analytics"
+	CleanCmdStr                  = "clean"
+	CloudAddCmdStr               = "add"
+	CloudCmdStr                  = "cloud"
+	CloudLoadCmdStr              = "load"
+	ClusterCmdStr                = "cluster"
+	ClusterSetCmdStr             = "set"
+	ClusterGetCmdStr             = "get"
+	ClusterLsCmdStr              = "ls"
+	ContextCmdStr                = "context"
+	ContextAddCmdStr             = "add"
+	ContextLsCmdStr              = "ls"
+	ContextRmCmdStr              = "rm"
+	ContextSetCmdStr             = "set"
+	DiscordCmdStr                = "discord"
+	DocsCmdStr                   = "docs"
+	EnclaveCmdStr                = "enclave"
+	EnclaveInspectCmdStr         = "inspect"
+	EnclaveLsCmdStr              = "ls"
+	EnclaveAddCmdStr             = "add"
+	EnclaveStartCmdStr           = "start"
+	EnclaveExtendCmdStr          = "extend"
+	EnclaveStopCmdStr            = "stop"
+	EnclaveRmCmdStr              = "rm"
+	EnclaveDumpCmdStr            = "dump"
+	EnclaveSnapshotCmdStr        = "snapshot"
+	EnclaveRestoreCmdStr         = "restore"
+	EnclaveVolumesCmdStr         = "volumes"
+	EnclaveVolumesLsCmdStr       = "ls"
+	EnclaveVolumesRmCmdStr       = "rm"
+	EnclaveVolumesDownloadCmdStr = "download"
+	EngineCmdStr                 = "engine"
+	EngineLogsCmdStr             = "logs"
+	EngineStartCmdStr            = "start"
+	EngineStatusCmdStr           = "status"
+	EngineStopCmdStr             = "stop"
+	EngineRestartCmdStr          = "restart"
+	FeedbackCmdStr               = "feedback"
+	FilesCmdStr                  = "files"
+	FilesUploadCmdStr            = "upload"
+	FilesInspectCmdStr           = "inspect"
+	FilesDownloadCmdStr          = "download"
+	FilesRmCmdStr                = "rm"
+	FilesDiffCmdStr              = "diff"
+	FilesStoreWebCmdStr          = "storeweb"
+	FilesStoreServiceCmdStr      = "storeservice"
+	FilesRenderTemplate          = "rendertemplate"
+	GitAuthCmdStr                = "git-auth"
+	GitAuthAddCmdStr             = "add"
+	GitAuthLsCmdStr              = "ls"
+	GitAuthRmCmdStr              = "rm"
+	KurtosisDumpCmdStr           = "dump"
+	KurtosisLintCmdStr           = "lint"
+	PortalCmdStr                 = "portal"
+	PortalStartCmdStr            = "start"
+	PortalStatusCmdStr           = "status"
+	PortalStopCmdStr             = "stop"
+	ServiceCmdStr                = "service"
+	ServiceAddCmdStr             = "add"
+	ServiceExecCmdStr            = "exec"
+	ServiceLogsCmdStr            = "logs"
+	ServiceRmCmdStr              = "rm"
+	ServiceShellCmdStr           = "shell"
+	ServiceStartCmdStr           = "start"
+	ServiceStopCmdStr            = "stop"
+	ServiceInspectCmdStr         = "inspect"
+	ServiceCpCmdStr              = "cp"
+	ServiceStatsCmdStr           = "stats"
+	StarlarkRunCmdStr            = "run"
+	TwitterCmdStr                = "twitter"
+	ConfigCmdStr                 = "config"
+	PathCmdStr                   = "path"
+	VersionCmdStr                = "version"
+	ImportCmdStr                 = "import"
+	GatewayCmdStr                = "gateway"
+	PackageCmdStr                = "package"
+	InitCmdStr                   = "init"
+	PackageLockCmdStr            = "lock"
+	PackageUpdateCmdStr          = "update"
+	PackageTestCmdStr            = "test"
+	PortCmdStr                   = "port"
+	PortPrintCmdStr              = "print"
+	PortForwardCmdStr            = "forward"
+	WebCmdStr                    = "web"
 )
 
 // TODO: added constant error message here, can we move to another file later.
@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
@@ -24,6 +25,8 @@ const (
 	shouldCleanRunningEnclavesFlagKey = "all"
 	defaultShouldCleanRunningEnclaves = "false"
 
+	noLabelSelector = ""
+
 	// Titles of the cleaning phases
 	// Should be lowercased as they'll go into a string like "Cleaning XXXXX...."
 	oldEngineCleaningPhaseTitle = "old Kurtosis engine containers"
@@ -55,6 +58,13 @@ var CleanCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand
 			Type:      flags.FlagType_Bool,
 			Default:   defaultShouldCleanRunningEnclaves,
 		},
+		{
+			Key:       enclave_labels.LabelsFlagKey,
+			Usage:     fmt.Sprintf("Only cleans the enclaves having all of these labels, in the form %v", enclave_labels.LabelsFlagFormat),
+			Shorthand: enclave_labels.LabelsFlagShorthand,
+			Type:      flags.FlagType_String,
+			Default:   noLabelSelector,
+		},
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -73,6 +83,15 @@ func run(
 		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", shouldCleanAll)
 	}
 
+	labelSelectorStr, err := flags.GetString(enclave_labels.LabelsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", enclave_labels.LabelsFlagKey)
+	}
+	labelSelector, err := enclave_labels.ParseLabelsStr(labelSelectorStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the label selector '%v'", labelSelectorStr)
+	}
+
 	// Map of cleaning_phase_title -> (successfully_destroyed_object_id, object_destruction_errors, clean_error)
 	cleaningPhaseFunctions := map[string]func() ([]string, []error, error){
 		oldEngineCleaningPhaseTitle: func() ([]string, []error, error) {
@@ -81,7 +100,7 @@ func run(
 		},
 		enclavesCleaningPhaseTitle: func() ([]string, []error, error) {
 			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
-			return cleanEnclaves(ctx, engineClient, shouldCleanAll)
+			return cleanEnclaves(ctx, engineClient, shouldCleanAll, labelSelector)
 		},
 		unusedImagesPhaseTitle: func() ([]string, []error, error) {
 			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
@@ -163,8 +182,11 @@ func cleanStoppedEngineContainers(ctx context.Context, kurtosisBackend backend_i
 	return successfulEngineContainerNames, removeEngineErrors, nil
 }
 
-func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, shouldCleanAll bool) ([]string, []error, error) {
-	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{ShouldCleanAll: shouldCleanAll}
+func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, shouldCleanAll bool, labelSelector map[string]string) ([]string, []error, error) {
+	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{
+		ShouldCleanAll: shouldCleanAll,
+		LabelSelector:  labelSelector,
+	}
 	cleanResp, err := engineClient.Clean(ctx, cleanArgs)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while calling clean")
@@ -3,6 +3,7 @@ package add
 import (
 	"context"
 	"fmt"
+	"github.com/docker/go-units"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	enclave_consts "github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/enclave"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
@@ -10,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
@@ -18,6 +20,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"strings"
+	"time"
 )
 
 const (
@@ -26,6 +29,29 @@ const (
 	enclaveNameFlagKey           = "name"
 	enclaveProductionModeFlagKey = "production"
 
+	filesArtifactsStorageQuotaFlagKey = "files-artifacts-storage-quota"
+	// Signifies that the files artifacts of the enclave can use as much storage as they need
+	noFilesArtifactsStorageQuota = ""
+
+	cpuQuotaFlagKey          = "cpu-quota"
+	memoryQuotaFlagKey       = "memory-quota"
+	serviceCountQuotaFlagKey = "service-count-quota"
+	// Signifies that the services of the enclave can be allocated as much CPU as they need, and that it can have as many
+	// services as it needs
+	noUint32Quota = "0"
+	// Signifies that the services of the enclave can be allocated as much memory as they need
+	noMemoryQuota = ""
+
+	timeToLiveFlagKey    = "ttl"
+	idleTimeoutFlagKey   = "idle-timeout"
+	onExpiryFlagKey      = "on-expiry"
+	onExpiryStopValue    = "stop"
+	onExpiryDestroyValue = "destroy"
+	// Signifies that the enclave doesn't expire
+	noExpiryDuration = ""
+
+	noEnclaveLabels = ""
+
 	// Signifies that an enclave name should be auto-generated
 	autogenerateEnclaveNameKeyword = ""
 
@@ -76,6 +102,55 @@ var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 			Type:      flags.FlagType_Bool,
 			Default:   "false",
 		},
+		{
+			Key:     filesArtifactsStorageQuotaFlagKey,
+			Usage:   "The maximum storage the files artifacts of the enclave can use, as a number of bytes or a size like '512MB' or '2GB' (unlimited if not set)",
+			Type:    flags.FlagType_String,
+			Default: noFilesArtifactsStorageQuota,
+		},
+		{
+			Key:     cpuQuotaFlagKey,
+			Usage:   "The maximum CPU, in millicores, the services of the enclave can be allocated through their max_cpu, which every service then has to set (unlimited if not set)",
+			Type:    flags.FlagType_Uint32,
+			Default: noUint32Quota,
+		},
+		{
+			Key:     memoryQuotaFlagKey,
+			Usage:   "The maximum memory the services of the enclave can be allocated through their max_memory, which every service then has to set, as a size like '512MB' or '2GB' (unlimited if not set)",
+			Type:    flags.FlagType_String,
+			Default: noMemoryQuota,
+		},
+		{
+			Key:     serviceCountQuotaFlagKey,
+			Usage:   "The maximum number of services the enclave can have (unlimited if not set)",
+			Type:    flags.FlagType_Uint32,
+			Default: noUint32Quota,
+		},
+		{
+			Key:     timeToLiveFlagKey,
+			Usage:   "How long the enclave lives before the engine stops or destroys it, as a duration like '30m' or '2h' (never expires if not set)",
+			Type:    flags.FlagType_String,
+			Default: noExpiryDuration,
+		},
+		{
+			Key:     idleTimeoutFlagKey,
+			Usage:   "How long the enclave can go without API calls nor service logs before the engine stops or destroys it, as a duration like '30m' or '2h' (never expires if not set)",
+			Type:    flags.FlagType_String,
+			Default: noExpiryDuration,
+		},
+		{
+			Key:     onExpiryFlagKey,
+			Usage:   fmt.Sprintf("What the engine does with the enclave once it expires (%v|%v)", onExpiryDestroyValue, onExpiryStopValue),
+			Type:    flags.FlagType_String,
+			Default: onExpiryDestroyValue,
+		},
+		{
+			// The '-l' shorthand is already used by the API container log level flag
+			Key:     enclave_labels.LabelsFlagKey,
+			Usage:   fmt.Sprintf("Labels to attach to the enclave, which can later select it in other enclave commands, in the form %v", enclave_labels.LabelsFlagFormat),
+			Type:    flags.FlagType_String,
+			Default: noEnclaveLabels,
+		},
 	},
 }
 
@@ -103,6 +178,85 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred while getting the enclave name using flag with key '%v'; this is a bug in Kurtosis ", enclaveNameFlagKey)
 	}
 
+	filesArtifactsStorageQuotaStr, err := flags.GetString(filesArtifactsStorageQuotaFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the files artifacts storage quota using flag with key '%v'; this is a bug in Kurtosis", filesArtifactsStorageQuotaFlagKey)
+	}
+	var filesArtifactsStorageQuota *uint64
+	if filesArtifactsStorageQuotaStr != noFilesArtifactsStorageQuota {
+		quotaInBytes, err := units.RAMInBytes(filesArtifactsStorageQuotaStr)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred parsing files artifacts storage quota '%v'", filesArtifactsStorageQuotaStr)
+		}
+		if quotaInBytes <= 0 {
+			return stacktrace.NewError("The files artifacts storage quota must be greater than zero but got '%v'", filesArtifactsStorageQuotaStr)
+		}
+		quotaInBytesUint := uint64(quotaInBytes)
+		filesArtifactsStorageQuota = &quotaInBytesUint
+	}
+
+	cpuQuotaMillicpusUint32, err := getUint32QuotaFromFlag(flags, cpuQuotaFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the CPU quota")
+	}
+	var cpuQuotaMillicpus *uint64
+	if cpuQuotaMillicpusUint32 != nil {
+		cpuQuotaMillicpusUint64 := uint64(*cpuQuotaMillicpusUint32)
+		cpuQuotaMillicpus = &cpuQuotaMillicpusUint64
+	}
+	serviceCountQuota, err := getUint32QuotaFromFlag(flags, serviceCountQuotaFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service count quota")
+	}
+	memoryQuotaStr, err := flags.GetString(memoryQuotaFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the memory quota using flag with key '%v'; this is a bug in Kurtosis", memoryQuotaFlagKey)
+	}
+	var memoryQuotaMegabytes *uint64
+	if memoryQuotaStr != noMemoryQuota {
+		quotaInBytes, err := units.RAMInBytes(memoryQuotaStr)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred parsing memory quota '%v'", memoryQuotaStr)
+		}
+		// max_memory is set in megabytes so the quota can't be any finer
+		if quotaInBytes < units.MiB {
+			return stacktrace.NewError("The memory quota must be at least 1MB but got '%v'", memoryQuotaStr)
+		}
+		quotaInMegabytes := uint64(quotaInBytes / units.MiB)
+		memoryQuotaMegabytes = &quotaInMegabytes
+	}
+
+	timeToLiveSeconds, err := getExpiryDurationSecondsFromFlag(flags, timeToLiveFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave time-to-live")
+	}
+	idleTimeoutSeconds, err := getExpiryDurationSecondsFromFlag(flags, idleTimeoutFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave idle timeout")
+	}
+	onExpiry, err := flags.GetString(onExpiryFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave expiry action using flag with key '%v'; this is a bug in Kurtosis", onExpiryFlagKey)
+	}
+	var expiryAction kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction
+	switch onExpiry {
+	case onExpiryDestroyValue:
+		expiryAction = kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY
+	case onExpiryStopValue:
+		expiryAction = kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP
+	default:
+		return stacktrace.NewError("Unrecognized enclave expiry action '%v', it must be either '%v' or '%v'", onExpiry, onExpiryDestroyValue, onExpiryStopValue)
+	}
+
+	labelsStr, err := flags.GetString(enclave_labels.LabelsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave labels using flag with key '%v'; this is a bug in Kurtosis", enclave_labels.LabelsFlagKey)
+	}
+	labels, err := enclave_labels.ParseLabelsStr(labelsStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing enclave labels '%v'", labelsStr)
+	}
+
 	engineManager, err := engine_manager.NewEngineManager(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating an engine manager.")
@@ -135,10 +289,18 @@ func run(
 	}
 
 	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
-		EnclaveName:            enclaveName,
-		ApiContainerVersionTag: apiContainerVersion,
-		ApiContainerLogLevel:   kurtosisLogLevelStr,
-		Mode:                   mode,
+		EnclaveName:                enclaveName,
+		ApiContainerVersionTag:     apiContainerVersion,
+		ApiContainerLogLevel:       kurtosisLogLevelStr,
+		Mode:                       mode,
+		FilesArtifactsStorageQuota: filesArtifactsStorageQuota,
+		CpuQuotaMillicpus:          cpuQuotaMillicpus,
+		MemoryQuotaMegabytes:       memoryQuotaMegabytes,
+		ServiceCountQuota:          serviceCountQuota,
+		TtlSeconds:                 timeToLiveSeconds,
+		IdleTimeoutSeconds:         idleTimeoutSeconds,
+		ExpiryAction:               expiryAction,
+		Labels:                     labels,
 	}
 	createdEnclaveResponse, err := engineClient.CreateEnclave(ctx, createEnclaveArgs)
 	if err != nil {
@@ -152,3 +314,35 @@ func run(
 
 	return nil
 }
+
+// getUint32QuotaFromFlag returns nil if the flag wasn't set
+func getUint32QuotaFromFlag(flags *flags.ParsedFlags, flagKey string) (*uint32, error) {
+	quota, err := flags.GetUint32(flagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the quota using flag with key '%v'; this is a bug in Kurtosis", flagKey)
+	}
+	if quota == 0 {
+		return nil, nil
+	}
+	return &quota, nil
+}
+
+// getExpiryDurationSecondsFromFlag returns nil if the flag wasn't set
+func getExpiryDurationSecondsFromFlag(flags *flags.ParsedFlags, flagKey string) (*uint32, error) {
+	durationStr, err := flags.GetString(flagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the duration using flag with key '%v'; this is a bug in Kurtosis", flagKey)
+	}
+	if durationStr == noExpiryDuration {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing duration '%v' of flag '%v'", durationStr, flagKey)
+	}
+	if duration < time.Second {
+		return nil, stacktrace.NewError("The duration of flag '%v' must be at least one second but got '%v'", flagKey, durationStr)
+	}
+	durationSeconds := uint32(duration.Seconds())
+	return &durationSeconds, nil
+}
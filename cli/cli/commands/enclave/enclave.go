@@ -9,10 +9,15 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/add"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/dump"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/extend"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/restore"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/rm"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/snapshot"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/start"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/stop"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/volumes"
 	"github.com/spf13/cobra"
 )
 
@@ -28,7 +33,12 @@ func init() {
 	EnclaveCmd.AddCommand(ls.EnclaveLsCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(inspect.EnclaveInspectCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(add.EnclaveAddCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(start.EnclaveStartCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(stop.EnclaveStopCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(extend.EnclaveExtendCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(rm.EnclaveRmCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(dump.EnclaveDumpCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(snapshot.EnclaveSnapshotCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(restore.EnclaveRestoreCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(volumes.EnclaveVolumesCmd)
 }
@@ -0,0 +1,95 @@
+package extend
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	extensionFlagKey     = "by"
+	defaultExtensionFlag = "1h"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	expirationTimeFormat = time.RFC1123
+)
+
+var EnclaveExtendCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveExtendCmdStr,
+	ShortDescription: "Postpones the expiration of an enclave",
+	LongDescription: "Pushes back the expiration of an enclave created with a time-to-live or an idle timeout, and resets " +
+		"its idle timeout. The time-to-live is extended from the current expiration time, or from now if the enclave " +
+		"already expired. An enclave without a time-to-live gets one",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     extensionFlagKey,
+			Usage:   "How long to postpone the expiration by, as a duration like '30m' or '2h'",
+			Type:    flags.FlagType_String,
+			Default: defaultExtensionFlag,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	extensionStr, err := flags.GetString(extensionFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the extension using flag key '%v'", extensionFlagKey)
+	}
+	extension, err := time.ParseDuration(extensionStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing extension '%v'", extensionStr)
+	}
+	if extension < time.Second {
+		return stacktrace.NewError("The enclave expiration must be extended by at least one second but got '%v'", extensionStr)
+	}
+
+	extendArgs := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		ExtensionSeconds:  uint32(extension.Seconds()),
+	}
+	extendResponse, err := engineClient.ExtendEnclave(ctx, extendArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred extending the expiration of enclave '%v'", enclaveIdentifier)
+	}
+
+	logrus.Infof("Enclave '%v' now expires at %v", enclaveIdentifier, extendResponse.GetExpirationTime().AsTime().Local().Format(expirationTimeFormat))
+	return nil
+}
@@ -2,6 +2,7 @@ package inspect
 
 import (
 	"context"
+	"github.com/docker/go-units"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
@@ -14,6 +15,7 @@ import (
 const (
 	fileUuidsHeader = "UUID"
 	fileNameHeader  = "Name"
+	fileSizeHeader  = "Size"
 )
 
 func printFilesArtifacts(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, showFullUuids bool, _ bool) error {
@@ -32,6 +34,7 @@ func printFilesArtifacts(ctx context.Context, kurtosisCtx *kurtosis_context.Kurt
 	tablePrinter := output_printers.NewTablePrinter(
 		fileUuidsHeader,
 		fileNameHeader,
+		fileSizeHeader,
 	)
 
 	for _, filesArtifactNameAndUuid := range sortedFilesNamesAndUuids {
@@ -40,7 +43,8 @@ func printFilesArtifacts(ctx context.Context, kurtosisCtx *kurtosis_context.Kurt
 			uuid = uuid_generator.ShortenedUUIDString(uuid)
 		}
 		fileName := filesArtifactNameAndUuid.GetFileName()
-		if err := tablePrinter.AddRow(uuid, fileName); err != nil {
+		fileSize := units.HumanSize(float64(filesArtifactNameAndUuid.GetSize()))
+		if err := tablePrinter.AddRow(uuid, fileName, fileSize); err != nil {
 			return stacktrace.Propagate(err, "An error occurred while adding row with uuid '%v' and file name '%v'; This is a bug in Kurtosis", uuid, fileName)
 		}
 	}
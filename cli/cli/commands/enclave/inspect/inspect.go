@@ -15,6 +15,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
@@ -37,6 +38,8 @@ const (
 	enclaveNameTitleName         = "Name"
 	enclaveStatusTitleName       = "Status"
 	enclaveCreationTimeTitleName = "Creation Time"
+	enclaveExpiresTitleName      = "Expires"
+	enclaveLabelsTitleName       = "Labels"
 
 	fullUuidsFlagKey       = "full-uuids"
 	fullUuidFlagKeyDefault = "false"
@@ -49,11 +52,13 @@ const (
 
 	userServicesArtifactsHeader = "User Services"
 	filesArtifactsHeader        = "Files Artifacts"
+	resourceQuotasHeader        = "Resource Quotas"
 )
 
 var enclaveObjectPrintingFuncs = map[string]func(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, showFullUuid bool, isAPIContainerRunning bool) error{
 	userServicesArtifactsHeader: printUserServices,
 	filesArtifactsHeader:        printFilesArtifacts,
+	resourceQuotasHeader:        printResourceQuotas,
 }
 
 var EnclaveInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -144,6 +149,19 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisBackend backend_interface.
 		keyValuePrinter.AddPair(enclaveCreationTimeTitleName, enclaveCreationTimeStr)
 	}
 
+	if enclaveExpirationTime := enclaveInfo.GetExpirationTime(); enclaveExpirationTime != nil {
+		expiryActionStr := "destroyed"
+		if enclaveInfo.GetExpiryAction() == kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP {
+			expiryActionStr = "stopped"
+		}
+		enclaveExpiresStr := fmt.Sprintf("%v (will be %v)", enclaveExpirationTime.AsTime().Local().Format(time.RFC1123), expiryActionStr)
+		keyValuePrinter.AddPair(enclaveExpiresTitleName, enclaveExpiresStr)
+	}
+
+	if enclaveLabels := enclaveInfo.GetLabels(); len(enclaveLabels) > 0 {
+		keyValuePrinter.AddPair(enclaveLabelsTitleName, enclave_labels.GetLabelsStr(enclaveLabels))
+	}
+
 	isApiContainerRunning := enclaveApiContainerStatus == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING
 
 	keyValuePrinter.Print()
@@ -157,8 +175,8 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisBackend backend_interface.
 
 	headersWithPrintErrs := []string{}
 	for _, header := range sortedEnclaveObjHeaders {
-		if header == filesArtifactsHeader && !isApiContainerRunning {
-			// can't fetch files artifact information if APIC isn't running
+		if (header == filesArtifactsHeader || header == resourceQuotasHeader) && !isApiContainerRunning {
+			// can't fetch files artifact nor resource quotas information if APIC isn't running
 			continue
 		}
 
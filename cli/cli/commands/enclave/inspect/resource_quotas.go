@@ -0,0 +1,86 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-units"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/stacktrace"
+	"strconv"
+)
+
+const (
+	resourceHeader      = "Resource"
+	resourceUsedHeader  = "Used"
+	resourceQuotaHeader = "Quota"
+
+	cpuResourceName                   = "CPU"
+	memoryResourceName                = "Memory"
+	serviceCountResourceName          = "Services"
+	filesArtifactsStorageResourceName = "Files artifacts storage"
+
+	noQuotaStr = "unlimited"
+
+	serviceCountBase = 10
+)
+
+func printResourceQuotas(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, _ bool, _ bool) error {
+	enclaveContext, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveInfo.GetName())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching enclave with name '%v'", enclaveInfo.GetName())
+	}
+
+	resourceQuotas, err := enclaveContext.GetResourceQuotas(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching the resource quotas of enclave '%v'", enclaveContext.GetEnclaveName())
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(
+		resourceHeader,
+		resourceUsedHeader,
+		resourceQuotaHeader,
+	)
+
+	resourceRows := []struct {
+		name          string
+		resourceQuota *kurtosis_core_rpc_api_bindings.ResourceQuota
+		formatFunc    func(uint64) string
+	}{
+		{name: cpuResourceName, resourceQuota: resourceQuotas.GetCpuMillicpus(), formatFunc: formatMillicpus},
+		{name: memoryResourceName, resourceQuota: resourceQuotas.GetMemoryMegabytes(), formatFunc: formatMegabytes},
+		{name: serviceCountResourceName, resourceQuota: resourceQuotas.GetServiceCount(), formatFunc: formatCount},
+		{name: filesArtifactsStorageResourceName, resourceQuota: resourceQuotas.GetFilesArtifactsStorageBytes(), formatFunc: formatBytes},
+	}
+	for _, resourceRow := range resourceRows {
+		usedStr := resourceRow.formatFunc(resourceRow.resourceQuota.GetUsage())
+		quotaStr := noQuotaStr
+		if resourceRow.resourceQuota.Quota != nil {
+			quotaStr = resourceRow.formatFunc(resourceRow.resourceQuota.GetQuota())
+		}
+		if err := tablePrinter.AddRow(resourceRow.name, usedStr, quotaStr); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while adding row for resource '%v'; This is a bug in Kurtosis", resourceRow.name)
+		}
+	}
+
+	tablePrinter.Print()
+	return nil
+}
+
+func formatMillicpus(millicpus uint64) string {
+	return fmt.Sprintf("%vm", millicpus)
+}
+
+func formatMegabytes(megabytes uint64) string {
+	return fmt.Sprintf("%vMB", megabytes)
+}
+
+func formatCount(count uint64) string {
+	return strconv.FormatUint(count, serviceCountBase)
+}
+
+func formatBytes(bytes uint64) string {
+	return units.HumanSize(float64(bytes))
+}
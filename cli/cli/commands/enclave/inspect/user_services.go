@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
 	"github.com/kurtosis-tech/stacktrace"
+	"strconv"
 )
 
 const (
@@ -16,6 +17,11 @@ const (
 	userServiceNameColHeader   = "Name"
 	userServicePortsColHeader  = "Ports"
 	userServiceStatusColHeader = "Status"
+	userServiceHealthColHeader = "Health"
+	// number of times the service was restarted by its restart policy
+	userServiceRestartsColHeader = "Restarts"
+
+	restartCountBase = 10
 )
 
 func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, showFullUuids bool, isAPIContainerRunning bool) error {
@@ -34,6 +40,8 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		userServiceNameColHeader,
 		userServicePortsColHeader,
 		userServiceStatusColHeader,
+		userServiceHealthColHeader,
+		userServiceRestartsColHeader,
 	)
 	sortedUserServices := user_services.GetSortedUserServiceSliceFromUserServiceMap(userServices)
 	for _, userService := range sortedUserServices {
@@ -46,6 +54,8 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 
 		containerStatus := userService.GetContainer().GetStatus()
 		containerStatusStr := container_status_stringifier.ContainerStatusStringifier(containerStatus)
+		healthStatusStr := container_status_stringifier.HealthStatusStringifier(userService.GetHealthStatus())
+		restartCountStr := strconv.FormatUint(uint64(userService.GetRestartCount()), restartCountBase)
 
 		portBindingLines, err := user_services.GetUserServicePortBindingStrings(userService)
 		if err != nil {
@@ -54,7 +64,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		firstPortBindingLine := portBindingLines[0]
 		additionalPortBindingLines := portBindingLines[1:]
 
-		if err := tablePrinter.AddRow(uuidToPrint, serviceIdStr, firstPortBindingLine, containerStatusStr); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, serviceIdStr, firstPortBindingLine, containerStatusStr, healthStatusStr, restartCountStr); err != nil {
 			return stacktrace.Propagate(
 				err,
 				"An error occurred adding row for user service with UUID '%v' to the table printer",
@@ -63,7 +73,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		}
 
 		for _, additionalPortBindingLine := range additionalPortBindingLines {
-			if err := tablePrinter.AddRow("", "", additionalPortBindingLine, ""); err != nil {
+			if err := tablePrinter.AddRow("", "", additionalPortBindingLine, "", "", ""); err != nil {
 				return stacktrace.Propagate(
 					err,
 					"An error occurred adding additional port binding row '%v' for user service with UUID '%v' to the table printer",
@@ -7,12 +7,14 @@ package ls
 
 import (
 	"context"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
@@ -28,6 +30,8 @@ const (
 	enclaveStatusColumnHeader       = "Status"
 	enclaveNameColumnHeader         = "Name"
 	enclaveCreationTimeColumnHeader = "Creation Time"
+	enclaveExpiresColumnHeader      = "Expires"
+	enclaveLabelsColumnHeader       = "Labels"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
@@ -35,7 +39,11 @@ const (
 	fullUuidsFlagKey       = "full-uuids"
 	fullUuidFlagKeyDefault = "false"
 
+	// Signifies that all the enclaves are listed
+	noLabelSelector = ""
+
 	emptyTimeForOldEnclaves = ""
+	emptyTimeForNoExpiry    = ""
 )
 
 var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -51,6 +59,13 @@ var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
+		{
+			Key:       enclave_labels.LabelsFlagKey,
+			Shorthand: enclave_labels.LabelsFlagShorthand,
+			Usage:     fmt.Sprintf("Only lists the enclaves having all of these labels, in the form %v", enclave_labels.LabelsFlagFormat),
+			Type:      flags.FlagType_String,
+			Default:   noLabelSelector,
+		},
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -69,7 +84,16 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
-	enclaves, err := kurtosisCtx.GetEnclaves(ctx)
+	labelSelectorStr, err := flags.GetString(enclave_labels.LabelsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", enclave_labels.LabelsFlagKey)
+	}
+	labelSelector, err := enclave_labels.ParseLabelsStr(labelSelectorStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the label selector '%v'", labelSelectorStr)
+	}
+
+	enclaves, err := kurtosisCtx.GetEnclavesMatchingLabels(ctx, labelSelector)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting enclaves")
 	}
@@ -79,7 +103,7 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
-	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader)
+	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader, enclaveExpiresColumnHeader, enclaveLabelsColumnHeader)
 	orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap := getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(enclaves.GetEnclavesByUuid())
 
 	//TODO remove this iteration after 2023-01-01 when we are sure that there is not any old enclave created without the creation time label
@@ -96,7 +120,7 @@ func run(
 			return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
 		}
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves, emptyTimeForNoExpiry, enclave_labels.GetLabelsStr(enclaveInfo.GetLabels())); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
@@ -118,9 +142,16 @@ func run(
 		// The extra space is a hack till we figure out the table printer color + formatting story
 		enclaveCreationTime := " " + enclaveInfo.CreationTime.AsTime().Local().Format(time.RFC1123)
 
+		enclaveExpirationTime := emptyTimeForNoExpiry
+		if enclaveInfo.GetExpirationTime() != nil {
+			enclaveExpirationTime = " " + enclaveInfo.GetExpirationTime().AsTime().Local().Format(time.RFC1123)
+		}
+
 		enclaveName := enclaveInfo.GetName()
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime); err != nil {
+		enclaveLabels := enclave_labels.GetLabelsStr(enclaveInfo.GetLabels())
+
+		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime, enclaveExpirationTime, enclaveLabels); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
@@ -0,0 +1,104 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	enclave_consts "github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/enclave"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+)
+
+const (
+	snapshotFilepathArgKey        = "snapshot-filepath"
+	isSnapshotFilepathArgOptional = false
+	defaultSnapshotFilepath       = ""
+
+	enclaveNameFlagKey = "name"
+
+	// Signifies that an enclave name should be auto-generated
+	autogenerateEnclaveNameKeyword = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveRestoreCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveRestoreCmdStr,
+	ShortDescription: "Creates an enclave from a snapshot",
+	LongDescription: "Creates a new enclave and loads into it the services, files artifacts and plan saved with the '" +
+		command_str_consts.EnclaveCmdStr + " " + command_str_consts.EnclaveSnapshotCmdStr + "' command. " +
+		"Services get new UUIDs and IP addresses, and restoring persistent directories is only supported on Docker",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       enclaveNameFlagKey,
+			Shorthand: "n",
+			Default:   autogenerateEnclaveNameKeyword,
+			Usage: fmt.Sprintf(
+				"The enclave name to give the restored enclave, which must match regex '%v' "+
+					"(emptystring will autogenerate an enclave name)",
+				enclave_consts.AllowedEnclaveNameCharsRegexStr,
+			),
+			Type: flags.FlagType_String,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewFilepathArg(
+			snapshotFilepathArgKey,
+			isSnapshotFilepathArgOptional,
+			defaultSnapshotFilepath,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	snapshotFilepath, err := args.GetNonGreedyArg(snapshotFilepathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting snapshot filepath using arg key '%v'", snapshotFilepathArgKey)
+	}
+	enclaveName, err := flags.GetString(enclaveNameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave name using flag with key '%v'; this is a bug in Kurtosis ", enclaveNameFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	snapshotFile, err := os.Open(snapshotFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening snapshot file '%v'", snapshotFilepath)
+	}
+	defer snapshotFile.Close()
+
+	logrus.Infof("Restoring enclave from '%v'...", snapshotFilepath)
+	enclaveCtx, err := kurtosisCtx.RestoreEnclave(ctx, enclaveName, snapshotFile)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring an enclave from snapshot file '%v'", snapshotFilepath)
+	}
+
+	defer output_printers.PrintEnclaveName(enclaveCtx.GetEnclaveName())
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -21,12 +22,15 @@ import (
 
 const (
 	enclaveIdentifiersArgKey = "enclaves"
-	isEnclaveIdArgOptional   = false
-	isEnclaveIdArgGreedy     = true
+	// The enclaves can be selected by their labels instead
+	isEnclaveIdArgOptional = true
+	isEnclaveIdArgGreedy   = true
 
 	shouldForceRemoveFlagKey = "force"
 	defaultShouldForceRemove = "false"
 
+	noLabelSelector = ""
+
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 )
@@ -34,7 +38,7 @@ const (
 var EnclaveRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveRmCmdStr,
 	ShortDescription:          "Destroys the specified enclaves",
-	LongDescription:           "Destroys the specified enclaves, removing all resources associated with them",
+	LongDescription:           "Destroys the specified enclaves, or the enclaves matching the label selector, removing all resources associated with them",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -45,6 +49,13 @@ var EnclaveRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:      flags.FlagType_Bool,
 			Default:   defaultShouldForceRemove,
 		},
+		{
+			Key:       enclave_labels.LabelsFlagKey,
+			Usage:     fmt.Sprintf("Only destroys the enclaves having all of these labels, in the form %v; if no enclave is specified, all the enclaves having them are destroyed", enclave_labels.LabelsFlagFormat),
+			Shorthand: enclave_labels.LabelsFlagShorthand,
+			Type:      flags.FlagType_String,
+			Default:   noLabelSelector,
+		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -75,6 +86,19 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the force-removal flag value using key '%v'; this is a bug in Kurtosis!", shouldForceRemoveFlagKey)
 	}
 
+	labelSelectorStr, err := flags.GetString(enclave_labels.LabelsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the label selector flag value using key '%v'; this is a bug in Kurtosis!", enclave_labels.LabelsFlagKey)
+	}
+	labelSelector, err := enclave_labels.ParseLabelsStr(labelSelectorStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the label selector '%v'", labelSelectorStr)
+	}
+
+	if len(enclaveIdentifiers) == 0 && len(labelSelector) == 0 {
+		return stacktrace.NewError("At least one enclave identifier or a label selector using the '%v' flag is required", enclave_labels.LabelsFlagKey)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
@@ -82,6 +106,25 @@ func run(
 
 	logrus.Debugf("inputted enclave UUIDs: %+v", enclaveIdentifiers)
 
+	// Keyed by UUID; stays nil if there is no label selector, as every enclave matches it
+	var enclavesMatchingLabelSelector map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo
+	if len(labelSelector) > 0 {
+		matchingEnclaves, err := kurtosisCtx.GetEnclavesMatchingLabels(ctx, labelSelector)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the enclaves matching label selector '%v'", labelSelectorStr)
+		}
+		enclavesMatchingLabelSelector = matchingEnclaves.GetEnclavesByUuid()
+		if len(enclaveIdentifiers) == 0 {
+			for enclaveUuid := range enclavesMatchingLabelSelector {
+				enclaveIdentifiers = append(enclaveIdentifiers, enclaveUuid)
+			}
+			if len(enclaveIdentifiers) == 0 {
+				logrus.Infof("No enclave matches label selector '%v'", labelSelectorStr)
+				return nil
+			}
+		}
+	}
+
 	// Condense the enclave UUIDs down into a unique set, so we don't try to double-destroy an enclave
 	enclaveIdsToDestroy := getUniqueSortedEnclaveIdentifiers(enclaveIdentifiers)
 
@@ -94,7 +137,7 @@ func run(
 		if err = metricsClient.TrackDestroyEnclave(enclaveId); err != nil {
 			logrus.Warnf("An error occurred while logging the destroy enclave event for enclave '%v'", enclaveId)
 		}
-		if err := destroyEnclave(ctx, kurtosisCtx, enclaveId, shouldForceRemove); err != nil {
+		if err := destroyEnclave(ctx, kurtosisCtx, enclaveId, shouldForceRemove, enclavesMatchingLabelSelector); err != nil {
 			enclaveDestructionErrorStrs = append(
 				enclaveDestructionErrorStrs,
 				fmt.Sprintf(
@@ -143,12 +186,20 @@ func destroyEnclave(
 	kurtosisContext *kurtosis_context.KurtosisContext,
 	enclaveIdentifier string,
 	shouldForceRemove bool,
+	// If not nil, the enclave must be one of these
+	enclavesMatchingLabelSelector map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo,
 ) error {
 	enclaveInfo, err := kurtosisContext.GetEnclave(ctx, enclaveIdentifier)
 	if err != nil {
 		return stacktrace.NewError("No enclave '%v' exists", enclaveIdentifier)
 	}
 
+	if enclavesMatchingLabelSelector != nil {
+		if _, found := enclavesMatchingLabelSelector[enclaveInfo.GetEnclaveUuid()]; !found {
+			return stacktrace.NewError("Refusing to destroy enclave '%v' because it doesn't match the label selector", enclaveIdentifier)
+		}
+	}
+
 	enclaveStatus := enclaveInfo.ContainersStatus
 	var isEnclaveRemovableWithoutForce bool
 	switch enclaveStatus {
@@ -0,0 +1,129 @@
+package snapshot
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	outputFilepathArgKey        = "output-filepath"
+	isOutputFilepathArgOptional = false
+	defaultOutputFilepath       = ""
+
+	includePersistentDirectoriesFlagKey = "include-persistent-directories"
+	includePersistentDirectoriesDefault = "false"
+
+	includeContainerFilesystemsFlagKey = "include-container-filesystems"
+	includeContainerFilesystemsDefault = "false"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	outputFilePermissions = 0644
+)
+
+var EnclaveSnapshotCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveSnapshotCmdStr,
+	ShortDescription: "Saves the content of an enclave to a file",
+	LongDescription: "Saves the services, files artifacts and plan of an enclave to a TGZ file that can be loaded into " +
+		"a new enclave with the '" + command_str_consts.EnclaveCmdStr + " " + command_str_consts.EnclaveRestoreCmdStr + "' command. " +
+		"The content of the persistent directories is included only if the '" + includePersistentDirectoriesFlagKey + "' flag is set, " +
+		"and the rest of the services filesystems only if the '" + includeContainerFilesystemsFlagKey + "' flag is set (Docker only). " +
+		"Services get new UUIDs and IP addresses when restored. The IP addresses are replaced in the services configs and " +
+		"the runtime values, but not in the files artifacts or the filesystems, which break if they contain IP addresses rather than hostnames",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     includePersistentDirectoriesFlagKey,
+			Usage:   "If set, the content of the persistent directories of the enclave is saved along with the rest of the enclave (the services using them are stopped while they are copied)",
+			Type:    flags.FlagType_Bool,
+			Default: includePersistentDirectoriesDefault,
+		},
+		{
+			Key:     includeContainerFilesystemsFlagKey,
+			Usage:   "If set, the filesystems of the service containers are saved along with the rest of the enclave, such that the files the services wrote outside of their persistent directories are restored too (only supported on Docker)",
+			Type:    flags.FlagType_Bool,
+			Default: includeContainerFilesystemsDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		file_system_path_arg.NewFilepathArg(
+			outputFilepathArgKey,
+			isOutputFilepathArgOptional,
+			defaultOutputFilepath,
+			file_system_path_arg.BypassDefaultValidationFunc,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	outputFilepath, err := args.GetNonGreedyArg(outputFilepathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting output filepath using arg key '%v'", outputFilepathArgKey)
+	}
+	includePersistentDirectories, err := flags.GetBool(includePersistentDirectoriesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of the '%v' flag; this is a bug in Kurtosis", includePersistentDirectoriesFlagKey)
+	}
+	includeContainerFilesystems, err := flags.GetBool(includeContainerFilesystemsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of the '%v' flag; this is a bug in Kurtosis", includeContainerFilesystemsFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	outputFile, err := os.OpenFile(outputFilepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, outputFilePermissions)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating output file '%v'", outputFilepath)
+	}
+	defer outputFile.Close()
+
+	logrus.Infof("Saving enclave '%v'...", enclaveIdentifier)
+	if err = kurtosisCtx.SnapshotEnclave(ctx, enclaveIdentifier, includePersistentDirectories, includeContainerFilesystems, outputFile); err != nil {
+		if removeErr := os.Remove(outputFilepath); removeErr != nil {
+			logrus.Warnf("Snapshotting enclave '%v' failed and the partially written file '%v' couldn't be removed", enclaveIdentifier, outputFilepath)
+		}
+		return stacktrace.Propagate(err, "An error occurred snapshotting enclave '%v' to '%v'", enclaveIdentifier, outputFilepath)
+	}
+
+	logrus.Infof("Saved enclave '%v' to file '%v'", enclaveIdentifier, outputFilepath)
+	return nil
+}
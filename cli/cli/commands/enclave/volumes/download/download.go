@@ -0,0 +1,143 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/mholt/archiver"
+	"github.com/sirupsen/logrus"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	volumeNameArgKey = "volume-name"
+
+	destinationPathArgKey        = "destination-path"
+	isDestinationPathArgOptional = true
+	emptyDestinationPathArg      = ""
+
+	volumeArchiveExtension         = ".tar"
+	volumeArchivePermission        = 0o744
+	volumeDestinationDirPermission = 0o777
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	defaultTmpDir = ""
+	tmpDirPattern = "tmp-dir-for-volume-download-*"
+)
+
+var EnclaveVolumesDownloadCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.EnclaveVolumesDownloadCmdStr,
+	ShortDescription:          "Download the contents of a persistent directory volume",
+	LongDescription:           "Download the contents of the given persistent directory volume of the given enclave to your machine",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key: volumeNameArgKey,
+		},
+		file_system_path_arg.NewDirpathArg(
+			destinationPathArgKey,
+			isDestinationPathArgOptional,
+			emptyDestinationPathArg,
+			file_system_path_arg.BypassDefaultValidationFunc,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	volumeName, err := args.GetNonGreedyArg(volumeNameArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the volume name using key '%v'", volumeNameArgKey)
+	}
+
+	destinationPath, err := args.GetNonGreedyArg(destinationPathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the destination path using key '%v'", destinationPathArgKey)
+	}
+	if destinationPath == emptyDestinationPathArg {
+		destinationPath = fmt.Sprintf("./%v", volumeName)
+	}
+	absoluteDestinationPath, err := filepath.Abs(destinationPath)
+	if err != nil {
+		return stacktrace.NewError("An error occurred while getting absolute path for the passed destination path '%v'", destinationPath)
+	}
+	if _, err = os.Stat(absoluteDestinationPath); os.IsNotExist(err) {
+		if err = os.Mkdir(absoluteDestinationPath, volumeDestinationDirPermission); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the target dir")
+		}
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	enclaveUuid := enclave.EnclaveUUID(enclaveCtx.GetEnclaveUuid())
+
+	tmpDirPath, err := os.MkdirTemp(defaultTmpDir, tmpDirPattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while creating a temporary directory to download volume '%v' to", volumeName)
+	}
+	defer os.RemoveAll(tmpDirPath)
+
+	tmpArchiveFilepath := path.Join(tmpDirPath, volumeName+volumeArchiveExtension)
+	tmpArchiveFile, err := os.OpenFile(tmpArchiveFilepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, volumeArchivePermission)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating temporary archive file '%v'", tmpArchiveFilepath)
+	}
+	if err = kurtosisBackend.CopyFilesFromPersistentDirectoryVolume(ctx, enclaveUuid, volumeName, tmpArchiveFile); err != nil {
+		tmpArchiveFile.Close()
+		return stacktrace.Propagate(err, "An error occurred copying the contents of volume '%v' from enclave '%v'", volumeName, enclaveIdentifier)
+	}
+	if err = tmpArchiveFile.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing temporary archive file '%v'", tmpArchiveFilepath)
+	}
+
+	if err = archiver.Unarchive(tmpArchiveFilepath, absoluteDestinationPath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while extracting '%v' to '%v'", tmpArchiveFilepath, absoluteDestinationPath)
+	}
+	logrus.Infof("Persistent directory volume '%v' downloaded to '%v'", volumeName, absoluteDestinationPath)
+	return nil
+}
@@ -0,0 +1,114 @@
+package ls
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/persistent_directory"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	volumeNameColumnHeader    = "Name"
+	persistentKeyColumnHeader = "Persistent Key"
+	sizeColumnHeader          = "Size"
+	ownerColumnHeader         = "Owner"
+
+	sharedVolumeOwner = "shared"
+	defaultSizeStr    = "default"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveVolumesLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.EnclaveVolumesLsCmdStr,
+	ShortDescription:          "Lists persistent directory volumes",
+	LongDescription:           "Lists the persistent directory volumes of the given enclave, including the shared ones",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	enclaveUuid := enclave.EnclaveUUID(enclaveCtx.GetEnclaveUuid())
+
+	volumes, err := kurtosisBackend.GetPersistentDirectoryVolumes(ctx, enclaveUuid, &persistent_directory.PersistentDirectoryVolumeFilters{
+		Names:          nil,
+		PersistentKeys: nil,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent directory volumes of enclave '%v'", enclaveIdentifier)
+	}
+
+	sortedVolumeNames := []string{}
+	for volumeName := range volumes {
+		sortedVolumeNames = append(sortedVolumeNames, volumeName)
+	}
+	sort.Strings(sortedVolumeNames)
+
+	tablePrinter := output_printers.NewTablePrinter(volumeNameColumnHeader, persistentKeyColumnHeader, sizeColumnHeader, ownerColumnHeader)
+	for _, volumeName := range sortedVolumeNames {
+		volume := volumes[volumeName]
+
+		owner := sharedVolumeOwner
+		if !volume.IsShared() {
+			owner = string(volume.GetServiceUUID())
+		}
+
+		sizeStr := defaultSizeStr
+		if volume.GetSize() != 0 {
+			sizeStr = fmt.Sprintf("%dMB", volume.GetSize())
+		}
+
+		if err := tablePrinter.AddRow(volumeName, string(volume.GetPersistentKey()), sizeStr, owner); err != nil {
+			return stacktrace.NewError("An error occurred adding row for volume '%v' to the table printer", volumeName)
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
@@ -0,0 +1,138 @@
+package rm
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/persistent_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	volumeNamesArgKey        = "volume-names"
+	isVolumeNamesArgOptional = true
+	isVolumeNamesArgGreedy   = true
+
+	persistentKeyFlagKey     = "key"
+	defaultPersistentKeyFlag = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveVolumesRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.EnclaveVolumesRmCmdStr,
+	ShortDescription:          "Removes persistent directory volumes",
+	LongDescription:           "Removes the given persistent directory volumes from the given enclave. Volumes can be selected by name or by persistent key; volumes still mounted by a running service can't be removed",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     persistentKeyFlagKey,
+			Usage:   "Removes the volumes backing the persistent directory with this persistent key",
+			Type:    flags.FlagType_String,
+			Default: defaultPersistentKeyFlag,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:          volumeNamesArgKey,
+			IsOptional:   isVolumeNamesArgOptional,
+			IsGreedy:     isVolumeNamesArgGreedy,
+			DefaultValue: []string{},
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	volumeNames, err := args.GetGreedyArg(volumeNamesArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the volume names using key '%v'", volumeNamesArgKey)
+	}
+
+	persistentKey, err := flags.GetString(persistentKeyFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", persistentKeyFlagKey)
+	}
+
+	if len(volumeNames) == 0 && persistentKey == defaultPersistentKeyFlag {
+		return stacktrace.NewError("At least one volume name or the '--%v' flag must be provided to select the volumes to remove", persistentKeyFlagKey)
+	}
+
+	filters := &persistent_directory.PersistentDirectoryVolumeFilters{
+		Names:          nil,
+		PersistentKeys: nil,
+	}
+	if len(volumeNames) > 0 {
+		filters.Names = map[string]bool{}
+		for _, volumeName := range volumeNames {
+			filters.Names[volumeName] = true
+		}
+	}
+	if persistentKey != defaultPersistentKeyFlag {
+		filters.PersistentKeys = map[service_directory.DirectoryPersistentKey]bool{
+			service_directory.DirectoryPersistentKey(persistentKey): true,
+		}
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	enclaveUuid := enclave.EnclaveUUID(enclaveCtx.GetEnclaveUuid())
+
+	successfulVolumeNames, erroredVolumeNames, err := kurtosisBackend.DestroyPersistentDirectoryVolumes(ctx, enclaveUuid, filters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the persistent directory volumes of enclave '%v'", enclaveIdentifier)
+	}
+	for volumeName := range successfulVolumeNames {
+		logrus.Infof("Removed persistent directory volume '%v'", volumeName)
+	}
+	if len(erroredVolumeNames) > 0 {
+		for volumeName, volumeErr := range erroredVolumeNames {
+			logrus.Errorf("An error occurred removing persistent directory volume '%v':\n%v", volumeName, volumeErr)
+		}
+		return stacktrace.NewError("Failed to remove %d persistent directory volume(s); see the errors above", len(erroredVolumeNames))
+	}
+	if len(successfulVolumeNames) == 0 {
+		logrus.Warnf("No persistent directory volume matched the given selection in enclave '%v'", enclaveIdentifier)
+	}
+	return nil
+}
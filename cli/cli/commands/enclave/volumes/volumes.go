@@ -0,0 +1,23 @@
+package volumes
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/volumes/download"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/volumes/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/volumes/rm"
+	"github.com/spf13/cobra"
+)
+
+// EnclaveVolumesCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var EnclaveVolumesCmd = &cobra.Command{
+	Use:   command_str_consts.EnclaveVolumesCmdStr,
+	Short: "Manage the persistent directory volumes of an enclave",
+	RunE:  nil,
+}
+
+func init() {
+	EnclaveVolumesCmd.AddCommand(ls.EnclaveVolumesLsCmd.MustGetCobraCommand())
+	EnclaveVolumesCmd.AddCommand(rm.EnclaveVolumesRmCmd.MustGetCobraCommand())
+	EnclaveVolumesCmd.AddCommand(download.EnclaveVolumesDownloadCmd.MustGetCobraCommand())
+}
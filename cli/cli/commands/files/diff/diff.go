@@ -0,0 +1,232 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/artifact_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"strconv"
+	"strings"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	artifactIdentifierArgKey        = "artifact-identifier"
+	isArtifactIdentifierArgOptional = false
+	isArtifactIdentifierArgGreedy   = false
+
+	fromVersionArgKey = "from-version"
+	toVersionArgKey   = "to-version"
+	// an unset version defaults to the current version for the version diffed to, and to the version preceding the
+	// version diffed to for the version diffed from
+	unsetVersion         = ""
+	isVersionArgOptional = true
+	isVersionArgGreedy   = false
+
+	versionNumberBase    = 10
+	versionNumberBitSize = 32
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var FilesDiffCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.FilesDiffCmdStr,
+	ShortDescription: "Show the changes between two versions of a files artifact",
+	LongDescription: "Show the unified diff of every file that changed between two versions of the given files artifact of the given enclave. " +
+		"The version diffed to defaults to the current one, and the version diffed from to the one preceding it. " +
+		"The versions kept are listed when inspecting the files artifact. The files artifact and enclave are specified by identifier " +
+		"(name, UUID, or shortened UUID). Read more about identifiers here: https://docs.kurtosis.com/reference/resource-identifier",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		artifact_identifier_arg.NewArtifactIdentifierArg(
+			artifactIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isArtifactIdentifierArgOptional,
+			isArtifactIdentifierArgGreedy,
+		),
+		{
+			Key:                   fromVersionArgKey,
+			IsOptional:            isVersionArgOptional,
+			IsGreedy:              isVersionArgGreedy,
+			DefaultValue:          unsetVersion,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        getVersionValidationFunc(fromVersionArgKey),
+		},
+		{
+			Key:                   toVersionArgKey,
+			IsOptional:            isVersionArgOptional,
+			IsGreedy:              isVersionArgGreedy,
+			DefaultValue:          unsetVersion,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        getVersionValidationFunc(toVersionArgKey),
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifact identifier using key '%v'", artifactIdentifierArgKey)
+	}
+	fromVersionStr, err := args.GetNonGreedyArg(fromVersionArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the version to diff from using key '%v'", fromVersionArgKey)
+	}
+	toVersionStr, err := args.GetNonGreedyArg(toVersionArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the version to diff to using key '%v'", toVersionArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	versions, err := enclaveCtx.GetFilesArtifactVersions(ctx, artifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the versions of files artifact '%v' of enclave '%v'", artifactIdentifier, enclaveIdentifier)
+	}
+	fromVersion, toVersion, err := getVersionsToDiff(versions, fromVersionStr, toVersionStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the versions of files artifact '%v' to diff", artifactIdentifier)
+	}
+
+	fromContents, err := getFilesArtifactVersionContents(ctx, enclaveCtx, artifactIdentifier, fromVersion)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the content of version '%d' of files artifact '%v'", fromVersion, artifactIdentifier)
+	}
+	toContents, err := getFilesArtifactVersionContents(ctx, enclaveCtx, artifactIdentifier, toVersion)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the content of version '%d' of files artifact '%v'", toVersion, artifactIdentifier)
+	}
+
+	contentsDiff, err := getFilesArtifactContentsDiff(fromContents, toContents)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred diffing versions '%d' and '%d' of files artifact '%v'", fromVersion, toVersion, artifactIdentifier)
+	}
+	if contentsDiff == "" {
+		out.PrintOutLn(fmt.Sprintf("No differences between versions %d and %d", fromVersion, toVersion))
+		return nil
+	}
+	out.PrintOutLn(strings.TrimSuffix(contentsDiff, "\n"))
+	return nil
+}
+
+// getVersionsToDiff returns the numbers of the versions to diff from and to, defaulting the version diffed to to the
+// current version and the version diffed from to the version preceding the version diffed to
+func getVersionsToDiff(versions []*kurtosis_core_rpc_api_bindings.FilesArtifactVersion, fromVersionStr string, toVersionStr string) (uint32, uint32, error) {
+	if len(versions) == 0 {
+		return 0, 0, stacktrace.NewError("The files artifact has no versions")
+	}
+	toVersionIdx := len(versions) - 1
+	if toVersionStr != unsetVersion {
+		toVersion, err := parseVersion(toVersionStr)
+		if err != nil {
+			return 0, 0, stacktrace.Propagate(err, "An error occurred parsing the version to diff to")
+		}
+		toVersionIdx = getVersionIdx(versions, toVersion)
+		if toVersionIdx < 0 {
+			return 0, 0, stacktrace.NewError("The files artifact has no version '%d', the versions kept being %d to %d", toVersion, versions[0].GetNumber(), versions[len(versions)-1].GetNumber())
+		}
+	}
+
+	if fromVersionStr == unsetVersion {
+		if toVersionIdx == 0 {
+			return 0, 0, stacktrace.NewError("There is no version kept before version '%d' of the files artifact to diff it from", versions[toVersionIdx].GetNumber())
+		}
+		return versions[toVersionIdx-1].GetNumber(), versions[toVersionIdx].GetNumber(), nil
+	}
+	fromVersion, err := parseVersion(fromVersionStr)
+	if err != nil {
+		return 0, 0, stacktrace.Propagate(err, "An error occurred parsing the version to diff from")
+	}
+	if getVersionIdx(versions, fromVersion) < 0 {
+		return 0, 0, stacktrace.NewError("The files artifact has no version '%d', the versions kept being %d to %d", fromVersion, versions[0].GetNumber(), versions[len(versions)-1].GetNumber())
+	}
+	return fromVersion, versions[toVersionIdx].GetNumber(), nil
+}
+
+func getVersionIdx(versions []*kurtosis_core_rpc_api_bindings.FilesArtifactVersion, versionNumber uint32) int {
+	for idx, version := range versions {
+		if version.GetNumber() == versionNumber {
+			return idx
+		}
+	}
+	return -1
+}
+
+func getFilesArtifactVersionContents(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, artifactIdentifier string, version uint32) (map[string][]byte, error) {
+	compressedFilesArtifact, err := enclaveCtx.DownloadFilesArtifactVersion(ctx, artifactIdentifier, version)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred downloading version '%d' of files artifact '%v'", version, artifactIdentifier)
+	}
+	contents, err := getFilesArtifactContents(compressedFilesArtifact)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred extracting version '%d' of files artifact '%v'", version, artifactIdentifier)
+	}
+	return contents, nil
+}
+
+func parseVersion(versionStr string) (uint32, error) {
+	version, err := strconv.ParseUint(versionStr, versionNumberBase, versionNumberBitSize)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Version '%s' isn't a valid version number", versionStr)
+	}
+	return uint32(version), nil
+}
+
+func getVersionValidationFunc(versionArgKey string) func(context.Context, *flags.ParsedFlags, *args.ParsedArgs) error {
+	return func(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+		versionStr, err := args.GetNonGreedyArg(versionArgKey)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the version to validate using key '%v'", versionArgKey)
+		}
+		if versionStr == unsetVersion {
+			return nil
+		}
+		if _, err := parseVersion(versionStr); err != nil {
+			return stacktrace.Propagate(err, "An error occurred validating the version using key '%v'", versionArgKey)
+		}
+		return nil
+	}
+}
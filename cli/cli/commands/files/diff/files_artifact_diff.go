@@ -0,0 +1,125 @@
+package diff
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/pmezard/go-difflib/difflib"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	unifiedDiffContextLines = 3
+
+	nonExistentFilePath = "/dev/null"
+	fromFilePathPrefix  = "a/"
+	toFilePathPrefix    = "b/"
+
+	nullByte = "\x00"
+	newline  = "\n"
+)
+
+// getFilesArtifactContents returns the content of the regular files of the compressed files artifact, by path
+// relative to the files artifact root
+func getFilesArtifactContents(compressedFilesArtifact []byte) (map[string][]byte, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(compressedFilesArtifact))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decompressing the files artifact")
+	}
+	defer gzipReader.Close()
+
+	contents := map[string][]byte{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return contents, nil
+		}
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the files artifact archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading file '%s' of the files artifact archive", header.Name)
+		}
+		contents[path.Clean(header.Name)] = content
+	}
+}
+
+// getFilesArtifactContentsDiff returns the unified diff of every file that differs between the two contents of a
+// files artifact, sorted by path, and an empty string if they are identical
+func getFilesArtifactContentsDiff(fromContents map[string][]byte, toContents map[string][]byte) (string, error) {
+	filePathsSet := map[string]bool{}
+	for filePath := range fromContents {
+		filePathsSet[filePath] = true
+	}
+	for filePath := range toContents {
+		filePathsSet[filePath] = true
+	}
+	filePaths := []string{}
+	for filePath := range filePathsSet {
+		filePaths = append(filePaths, filePath)
+	}
+	sort.Strings(filePaths)
+
+	diffBuilder := strings.Builder{}
+	for _, filePath := range filePaths {
+		fromContent, isInFrom := fromContents[filePath]
+		toContent, isInTo := toContents[filePath]
+		if isInFrom && isInTo && bytes.Equal(fromContent, toContent) {
+			continue
+		}
+		fromFilePath := nonExistentFilePath
+		if isInFrom {
+			fromFilePath = fromFilePathPrefix + filePath
+		}
+		toFilePath := nonExistentFilePath
+		if isInTo {
+			toFilePath = toFilePathPrefix + filePath
+		}
+
+		if isBinary(fromContent) || isBinary(toContent) {
+			diffBuilder.WriteString(fmt.Sprintf("Binary files %s and %s differ\n", fromFilePath, toFilePath))
+			continue
+		}
+		fileDiff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(fromContent),
+			B:        splitLines(toContent),
+			FromFile: fromFilePath,
+			FromDate: "",
+			ToFile:   toFilePath,
+			ToDate:   "",
+			Eol:      "",
+			Context:  unifiedDiffContextLines,
+		})
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred computing the diff of file '%s'", filePath)
+		}
+		diffBuilder.WriteString(fileDiff)
+	}
+	return diffBuilder.String(), nil
+}
+
+func isBinary(content []byte) bool {
+	return !utf8.Valid(content) || bytes.Contains(content, []byte(nullByte))
+}
+
+// splitLines splits the content in lines keeping their trailing newline, the last line getting one if it has none
+// so that the diff stays readable
+func splitLines(content []byte) []string {
+	lines := strings.SplitAfter(string(content), newline)
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += newline
+	return lines
+}
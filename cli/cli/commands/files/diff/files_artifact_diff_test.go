@@ -0,0 +1,95 @@
+package diff
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetFilesArtifactContents(t *testing.T) {
+	compressed := compressFiles(t, map[string]string{
+		"config.yaml":       "port: 80\n",
+		"./nested/data.txt": "some data",
+	})
+
+	contents, err := getFilesArtifactContents(compressed)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{
+		"config.yaml":     []byte("port: 80\n"),
+		"nested/data.txt": []byte("some data"),
+	}, contents)
+}
+
+func TestGetFilesArtifactContentsDiff_IdenticalContents(t *testing.T) {
+	contents := map[string][]byte{
+		"config.yaml": []byte("port: 80\n"),
+	}
+	contentsDiff, err := getFilesArtifactContentsDiff(contents, contents)
+	require.NoError(t, err)
+	require.Empty(t, contentsDiff)
+}
+
+func TestGetFilesArtifactContentsDiff_ModifiedAddedAndRemovedFiles(t *testing.T) {
+	from := map[string][]byte{
+		"config.yaml": []byte("host: localhost\nport: 80\n"),
+		"removed.txt": []byte("gone\n"),
+	}
+	to := map[string][]byte{
+		"added.txt":   []byte("new\n"),
+		"config.yaml": []byte("host: localhost\nport: 8080\n"),
+	}
+
+	contentsDiff, err := getFilesArtifactContentsDiff(from, to)
+	require.NoError(t, err)
+	expectedDiff := `--- /dev/null
++++ b/added.txt
+@@ -0,0 +1 @@
++new
+--- a/config.yaml
++++ b/config.yaml
+@@ -1,2 +1,2 @@
+ host: localhost
+-port: 80
++port: 8080
+--- a/removed.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+`
+	require.Equal(t, expectedDiff, contentsDiff)
+}
+
+func TestGetFilesArtifactContentsDiff_BinaryFiles(t *testing.T) {
+	from := map[string][]byte{
+		"image.bin": {0x00, 0x01},
+	}
+	to := map[string][]byte{
+		"image.bin": {0x00, 0x02},
+	}
+
+	contentsDiff, err := getFilesArtifactContentsDiff(from, to)
+	require.NoError(t, err)
+	require.Equal(t, "Binary files a/image.bin and b/image.bin differ\n", contentsDiff)
+}
+
+func compressFiles(t *testing.T, files map[string]string) []byte {
+	buffer := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		header := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+		}
+		require.NoError(t, tarWriter.WriteHeader(header))
+		_, err := tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return buffer.Bytes()
+}
@@ -2,9 +2,11 @@ package files
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/diff"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/download"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/rendertemplate"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/rm"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/storeservice"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/storeweb"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/upload"
@@ -27,4 +29,6 @@ func init() {
 	FilesCmd.AddCommand(rendertemplate.RenderTemplateCommand.MustGetCobraCommand())
 	FilesCmd.AddCommand(inspect.FilesInspectCmd.MustGetCobraCommand())
 	FilesCmd.AddCommand(download.FilesUploadCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(rm.FilesRmCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(diff.FilesDiffCmd.MustGetCobraCommand())
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -22,6 +23,7 @@ import (
 	"golang.org/x/exp/slices"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 const (
@@ -38,6 +40,17 @@ const (
 	isFilePathArgOptional = true
 	isFilePathArgGreedy   = false
 
+	versionFlagKey = "version"
+	// version numbers start at 1, this one standing for the current version of the files artifact
+	currentVersionFlagValue = "0"
+	currentVersion          = uint32(0)
+
+	versionNumberHeader       = "Version"
+	versionCreationTimeHeader = "Created"
+	versionContentMd5Header   = "MD5"
+	unknownCreationTimeStr    = "unknown"
+	currentVersionMarker      = " (current)"
+
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 	emptyFileStr          = ""
@@ -53,7 +66,14 @@ var FilesInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosis
 	LongDescription:           "Inspect the requested file artifact, returning the file tree, metadata and a preview, if avaliable",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
-	Flags:                     []*flags.FlagConfig{},
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     versionFlagKey,
+			Usage:   "The version of the files artifact to inspect, as listed when inspecting it (the current version if not set)",
+			Type:    flags.FlagType_Uint32,
+			Default: currentVersionFlagValue,
+		},
+	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
 			enclaveIdentifierArgKey,
@@ -111,7 +131,17 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the file path")
 	}
 
-	filesInspectResponse, err := enclaveCtx.InspectFilesArtifact(ctx, services.FileArtifactName(artifactIdentifierName))
+	version, err := flags.GetUint32(versionFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifact version using flag key '%v'", versionFlagKey)
+	}
+
+	var filesInspectResponse *kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse
+	if version == currentVersion {
+		filesInspectResponse, err = enclaveCtx.InspectFilesArtifact(ctx, services.FileArtifactName(artifactIdentifierName))
+	} else {
+		filesInspectResponse, err = enclaveCtx.InspectFilesArtifactVersion(ctx, services.FileArtifactName(artifactIdentifierName), version)
+	}
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred inspecting files from artifact identifier '%v', enclave '%v'", artifactIdentifierName, enclaveIdentifier)
 	}
@@ -119,6 +149,11 @@ func run(
 
 	if filePath == "" {
 		logrus.Infof("Artifact '%v' contents:%v", artifactIdentifierName, buildTree(fileDescriptions))
+		versions, err := enclaveCtx.GetFilesArtifactVersions(ctx, artifactIdentifierName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the versions of files artifact '%v', enclave '%v'", artifactIdentifierName, enclaveIdentifier)
+		}
+		printVersions(versions)
 		return nil
 	}
 	index := slices.IndexFunc(fileDescriptions, func(desc *kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription) bool {
@@ -131,6 +166,24 @@ func run(
 	return nil
 }
 
+func printVersions(versions []*kurtosis_core_rpc_api_bindings.FilesArtifactVersion) {
+	tablePrinter := output_printers.NewTablePrinter(versionNumberHeader, versionCreationTimeHeader, versionContentMd5Header)
+	for _, version := range versions {
+		versionNumber := fmt.Sprint(version.GetNumber())
+		if version.GetIsCurrent() {
+			versionNumber += currentVersionMarker
+		}
+		creationTime := unknownCreationTimeStr
+		if version.GetCreationTime() != nil {
+			creationTime = version.GetCreationTime().AsTime().Local().Format(time.RFC1123)
+		}
+		if err := tablePrinter.AddRow(versionNumber, creationTime, version.GetContentMd5()); err != nil {
+			logrus.Warnf("An error occurred adding version '%v' to the versions table; this is a bug in Kurtosis. Error was:\n%v", versionNumber, err)
+		}
+	}
+	tablePrinter.Print()
+}
+
 // This structure helps assemble a file tree compatible with treeprint lib
 type treeMap struct {
 	internalMap map[string]*treeMap
@@ -0,0 +1,189 @@
+package add
+
+import (
+	"bufio"
+	"context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_git_auth"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	hostArgKey = "host"
+
+	usernameFlagKey = "username"
+	defaultUsername = ""
+
+	tokenFlagKey                = "token"
+	defaultToken                = ""
+	readTokenFromStdinIndicator = "-"
+
+	sshKeyFlagKey = "ssh-key"
+	defaultSshKey = ""
+
+	sshKeyPassphraseFlagKey = "ssh-key-passphrase"
+	defaultSshKeyPassphrase = ""
+
+	knownHostsFlagKey = "known-hosts"
+	defaultKnownHosts = ""
+
+	sshDirname                = ".ssh"
+	defaultKnownHostsFilename = "known_hosts"
+)
+
+var GitAuthAddCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.GitAuthAddCmdStr,
+	ShortDescription: "Add a Git credential",
+	LongDescription: "Adds the credential used to clone packages, and the packages they import, from private Git repositories " +
+		"hosted on the given host (e.g. 'github.com'). Either an HTTPS token or an SSH private key can be used; " +
+		"adding a credential for a host that already has one replaces it. Credentials are sent to the enclave on every run",
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     tokenFlagKey,
+			Usage:   "The token (e.g. a personal access token) to clone with over HTTPS. Use '" + readTokenFromStdinIndicator + "' to read it from stdin instead, which keeps it out of the shell history",
+			Type:    flags.FlagType_String,
+			Default: defaultToken,
+		},
+		{
+			Key:     usernameFlagKey,
+			Usage:   "The username sent alongside the token. Most Git hosts accept any username when authenticating with a token",
+			Type:    flags.FlagType_String,
+			Default: defaultUsername,
+		},
+		{
+			Key:     sshKeyFlagKey,
+			Usage:   "The path to the SSH private key to clone with over SSH",
+			Type:    flags.FlagType_String,
+			Default: defaultSshKey,
+		},
+		{
+			Key:     sshKeyPassphraseFlagKey,
+			Usage:   "The passphrase of the SSH private key, if it is encrypted",
+			Type:    flags.FlagType_String,
+			Default: defaultSshKeyPassphrase,
+		},
+		{
+			Key:     knownHostsFlagKey,
+			Usage:   "The path to the known_hosts file used to verify the host key when cloning over SSH (default: ~/" + sshDirname + "/" + defaultKnownHostsFilename + ")",
+			Type:    flags.FlagType_String,
+			Default: defaultKnownHosts,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key:                   hostArgKey,
+			IsOptional:            false,
+			DefaultValue:          nil,
+			IsGreedy:              false,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	host, err := args.GetNonGreedyArg(hostArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for host arg '%v' but none was found; this is a bug in the Kurtosis CLI!", hostArgKey)
+	}
+	if host == "" || strings.Contains(host, "/") {
+		return stacktrace.NewError("Invalid Git host '%s'; expected a bare host like 'github.com'", host)
+	}
+
+	token, err := flags.GetString(tokenFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", tokenFlagKey)
+	}
+	username, err := flags.GetString(usernameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", usernameFlagKey)
+	}
+	sshKeyFilepath, err := flags.GetString(sshKeyFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", sshKeyFlagKey)
+	}
+	sshKeyPassphrase, err := flags.GetString(sshKeyPassphraseFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", sshKeyPassphraseFlagKey)
+	}
+	knownHostsFilepath, err := flags.GetString(knownHostsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", knownHostsFlagKey)
+	}
+
+	var gitAuth *kurtosis_git_auth.GitAuth
+	switch {
+	case token != defaultToken && sshKeyFilepath != defaultSshKey:
+		return stacktrace.NewError("Only one of the '--%s' and '--%s' flags can be set", tokenFlagKey, sshKeyFlagKey)
+	case token != defaultToken:
+		if token == readTokenFromStdinIndicator {
+			token, err = readTokenFromStdin()
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred reading the token from stdin")
+			}
+		}
+		gitAuth = kurtosis_git_auth.NewHttpsTokenGitAuth(host, username, token)
+	case sshKeyFilepath != defaultSshKey:
+		if knownHostsFilepath == defaultKnownHosts {
+			homeDirpath, err := os.UserHomeDir()
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the home directory to find the default known hosts file; set it explicitly with '--%s'", knownHostsFlagKey)
+			}
+			knownHostsFilepath = path.Join(homeDirpath, sshDirname, defaultKnownHostsFilename)
+		}
+		if sshKeyFilepath, err = getAbsoluteExistingFilepath(sshKeyFilepath); err != nil {
+			return stacktrace.Propagate(err, "Invalid SSH private key path")
+		}
+		if knownHostsFilepath, err = getAbsoluteExistingFilepath(knownHostsFilepath); err != nil {
+			return stacktrace.Propagate(err, "Invalid known hosts path")
+		}
+		gitAuth = kurtosis_git_auth.NewSshKeyGitAuth(host, sshKeyFilepath, sshKeyPassphrase, knownHostsFilepath)
+	default:
+		return stacktrace.NewError("One of the '--%s' and '--%s' flags must be set", tokenFlagKey, sshKeyFlagKey)
+	}
+
+	if err = kurtosis_git_auth.GetGitAuthStore().SetGitAuth(gitAuth); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the Git credential for host '%s'", host)
+	}
+	logrus.Infof("Git %s credential for host '%s' added", gitAuth.GetType(), host)
+	return nil
+}
+
+func readTokenFromStdin() (string, error) {
+	token, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && token == "" {
+		return "", stacktrace.Propagate(err, "An error occurred reading from stdin")
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", stacktrace.NewError("The token read from stdin was empty")
+	}
+	return token, nil
+}
+
+// getAbsoluteExistingFilepath returns the absolute path to the file, as the credential is used from any directory
+func getAbsoluteExistingFilepath(filepathToCheck string) (string, error) {
+	absoluteFilepath, err := filepath.Abs(filepathToCheck)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the absolute path of '%s'", filepathToCheck)
+	}
+	fileInfo, err := os.Stat(absoluteFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred checking file '%s'", absoluteFilepath)
+	}
+	if fileInfo.IsDir() {
+		return "", stacktrace.NewError("Expected '%s' to be a file but it's a directory", absoluteFilepath)
+	}
+	return absoluteFilepath, nil
+}
@@ -0,0 +1,23 @@
+package git_auth
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/git_auth/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/git_auth/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/git_auth/rm"
+	"github.com/spf13/cobra"
+)
+
+// GitAuthCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var GitAuthCmd = &cobra.Command{
+	Use:   command_str_consts.GitAuthCmdStr,
+	Short: "Manage the credentials used to clone packages from private Git repositories",
+	RunE:  nil,
+}
+
+func init() {
+	GitAuthCmd.AddCommand(add.GitAuthAddCmd.MustGetCobraCommand())
+	GitAuthCmd.AddCommand(ls.GitAuthLsCmd.MustGetCobraCommand())
+	GitAuthCmd.AddCommand(rm.GitAuthRmCmd.MustGetCobraCommand())
+}
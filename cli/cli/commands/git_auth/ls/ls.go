@@ -0,0 +1,44 @@
+package ls
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_git_auth"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	hostColumnHeader = "Host"
+	typeColumnHeader = "Type"
+)
+
+var GitAuthLsCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:               command_str_consts.GitAuthLsCmdStr,
+	ShortDescription:         "List Git credentials",
+	LongDescription:          "List the Git hosts a credential is configured for. The credentials themselves are never printed",
+	Flags:                    nil,
+	Args:                     nil,
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, _ *flags.ParsedFlags, _ *args.ParsedArgs) error {
+	gitAuths, err := kurtosis_git_auth.GetGitAuthStore().GetAllGitAuths()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the configured Git credentials")
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(hostColumnHeader, typeColumnHeader)
+	for _, gitAuth := range gitAuths {
+		if err = tablePrinter.AddRow(gitAuth.Host, gitAuth.GetType()); err != nil {
+			return stacktrace.Propagate(err, "Error adding Git credential to the table to be displayed")
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
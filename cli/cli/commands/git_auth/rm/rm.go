@@ -0,0 +1,53 @@
+package rm
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_git_auth"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	hostArgKey = "host"
+)
+
+var GitAuthRmCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.GitAuthRmCmdStr,
+	ShortDescription: "Remove a Git credential",
+	LongDescription:  "Removes the credential configured for the given Git host",
+	Flags:            nil,
+	Args: []*args.ArgConfig{
+		{
+			Key:                   hostArgKey,
+			IsOptional:            false,
+			DefaultValue:          nil,
+			IsGreedy:              false,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	host, err := args.GetNonGreedyArg(hostArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for host arg '%v' but none was found; this is a bug in the Kurtosis CLI!", hostArgKey)
+	}
+
+	removed, err := kurtosis_git_auth.GetGitAuthStore().RemoveGitAuth(host)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the Git credential for host '%s'", host)
+	}
+	if !removed {
+		return stacktrace.NewError("No Git credential is configured for host '%s'", host)
+	}
+	logrus.Infof("Git credential for host '%s' removed", host)
+	return nil
+}
@@ -0,0 +1,665 @@
+package _import
+
+import (
+	"fmt"
+	"github.com/compose-spec/compose-go/types"
+	"github.com/joho/godotenv"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/name_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	starlarkIndent = "    "
+
+	portIdPrefix              = "docker-"
+	exposedPortProtocolSuffix = "/"
+
+	// The first element of a Compose healthcheck test tells how the rest of the test must be run
+	healthcheckTestNone     = "NONE"
+	healthcheckTestCmd      = "CMD"
+	healthcheckTestCmdShell = "CMD-SHELL"
+	healthcheckShellBinary  = "/bin/sh"
+	healthcheckShellFlag    = "-c"
+
+	// The values Docker uses when a healthcheck doesn't set them
+	defaultHealthcheckInterval = 30 * time.Second
+	defaultHealthcheckTimeout  = 30 * time.Second
+	defaultHealthcheckRetries  = 3
+
+	composeRestartNo                  = "no"
+	composeRestartAlways              = "always"
+	composeRestartUnlessStopped       = "unless-stopped"
+	composeRestartOnFailure           = "on-failure"
+	composeRestartMaxRetriesSeparator = ":"
+	// Kurtosis' "always" policy restarts the service when its container exits, which is what all the Compose restart
+	// policies do (Kurtosis' "on-failure" only reacts to liveness checks failures)
+	kurtosisRestartPolicy = "always"
+
+	// The image Compose gives to a service built from a build context, when the service has no image
+	builtImageNameFormat = "%s-%s"
+
+	// Where the bind mounted files and directories are copied to in a package generated from a Compose file
+	packageStaticFilesDirname = "static_files"
+
+	// The network Compose attaches services to when they don't declare any
+	defaultComposeNetworkName = "default"
+)
+
+var persistentKeyDisallowedCharsRegex = regexp.MustCompile("[^a-z0-9-]+")
+
+// composeConversion is the result of the translation of a Compose project into Starlark
+type composeConversion struct {
+	// The generated Starlark script
+	script string
+
+	// Local path of every bind mounted file or directory -> name of the files artifact it's mounted from
+	filesArtifactsToUpload map[string]string
+
+	// The images that must be built from a Compose build context before the script can run
+	imagesToBuild []*composeImageBuild
+
+	// Every Compose key that couldn't be translated to Starlark
+	untranslatedKeys []*untranslatedComposeKey
+}
+
+type composeImageBuild struct {
+	serviceName    string
+	imageName      string
+	contextDirpath string
+	dockerfile     string
+	target         string
+	args           map[string]string
+}
+
+type untranslatedComposeKey struct {
+	// The path to the key in the Compose file, e.g. 'services.web.cap_add'
+	keyPath string
+	reason  string
+}
+
+func (key *untranslatedComposeKey) String() string {
+	return fmt.Sprintf("%s: %s", key.keyPath, key.reason)
+}
+
+// convertComposeProjectToStarlark translates the Compose project into a Starlark script adding its services in
+// dependency order. When shouldUploadFilesInScript is true, the script uploads the bind mounted files itself from the
+// static files directory of the package it's part of; otherwise they must be uploaded before the script runs.
+func convertComposeProjectToStarlark(compose *types.Project, shouldUploadFilesInScript bool) (*composeConversion, error) {
+	conversion := &composeConversion{
+		script:                 "",
+		filesArtifactsToUpload: map[string]string{},
+		imagesToBuild:          []*composeImageBuild{},
+		untranslatedKeys:       []*untranslatedComposeKey{},
+	}
+
+	orderedServices, err := getServicesInDependencyOrder(compose.Services)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred ordering the services by their dependencies")
+	}
+	servicesWithHealthcheck := map[string]bool{}
+	for _, serviceConfig := range compose.Services {
+		if hasHealthcheck(serviceConfig) {
+			servicesWithHealthcheck[serviceConfig.Name] = true
+		}
+	}
+
+	for volumeName, volumeConfig := range compose.Volumes {
+		if volumeConfig.Driver != "" || len(volumeConfig.DriverOpts) > 0 {
+			conversion.addUntranslatedKey(fmt.Sprintf("volumes.%s.driver", volumeName), "volume drivers are not supported, the volume is backed by the default storage of the Kurtosis backend")
+		}
+	}
+
+	addServiceStarlarks := []string{}
+	for _, serviceConfig := range orderedServices {
+		serviceConfigStarlark, err := conversion.convertComposeServiceToStarlark(compose.Name, serviceConfig, servicesWithHealthcheck)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred translating service '%v' to Starlark", serviceConfig.Name)
+		}
+		addServiceStarlarks = append(addServiceStarlarks, fmt.Sprintf(
+			"%splan.add_service(\n%sname = %q,\n%sconfig = %s,\n%s)\n",
+			starlarkIndent,
+			strings.Repeat(starlarkIndent, 2), //nolint:gomnd
+			serviceConfig.Name,
+			strings.Repeat(starlarkIndent, 2), //nolint:gomnd
+			serviceConfigStarlark,
+			starlarkIndent,
+		))
+	}
+
+	script := "def run(plan):\n"
+	if shouldUploadFilesInScript && len(conversion.filesArtifactsToUpload) > 0 {
+		sources := getSortedKeys(conversion.filesArtifactsToUpload)
+		for _, source := range sources {
+			artifactName := conversion.filesArtifactsToUpload[source]
+			script += fmt.Sprintf("%splan.upload_files(src = %q, name = %q)\n", starlarkIndent, getPackageStaticFilePath(source, artifactName), artifactName)
+		}
+		script += "\n"
+	}
+	if len(addServiceStarlarks) == 0 {
+		script += fmt.Sprintf("%spass\n", starlarkIndent)
+	}
+	script += strings.Join(addServiceStarlarks, "\n")
+	conversion.script = script
+	return conversion, nil
+}
+
+// getPackageStaticFilePath returns the path, relative to the root of a package, where a bind mounted file or
+// directory is copied to
+func getPackageStaticFilePath(source string, artifactName string) string {
+	return "./" + path.Join(packageStaticFilesDirname, artifactName, filepath.Base(source))
+}
+
+func (conversion *composeConversion) addUntranslatedKey(keyPath string, reason string) {
+	conversion.untranslatedKeys = append(conversion.untranslatedKeys, &untranslatedComposeKey{
+		keyPath: keyPath,
+		reason:  reason,
+	})
+}
+
+func (conversion *composeConversion) addUntranslatedServiceKey(serviceName string, key string, reason string) {
+	conversion.addUntranslatedKey(fmt.Sprintf("services.%s.%s", serviceName, key), reason)
+}
+
+func (conversion *composeConversion) convertComposeServiceToStarlark(projectName string, serviceConfig types.ServiceConfig, servicesWithHealthcheck map[string]bool) (string, error) {
+	serviceName := serviceConfig.Name
+	fieldIndent := strings.Repeat(starlarkIndent, 3) //nolint:gomnd
+	starlarkFields := []string{}
+
+	image, err := conversion.getImage(projectName, serviceConfig)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the image of service '%v'", serviceName)
+	}
+	starlarkFields = append(starlarkFields, fmt.Sprintf("image = %q", image))
+
+	if portsStarlark := conversion.getPortsStarlark(serviceConfig); portsStarlark != "" {
+		starlarkFields = append(starlarkFields, fmt.Sprintf("ports = {%s}", portsStarlark))
+	}
+	if filesStarlark := conversion.getFilesStarlark(serviceConfig); filesStarlark != "" {
+		starlarkFields = append(starlarkFields, fmt.Sprintf("files = {%s}", filesStarlark))
+	}
+	if len(serviceConfig.Entrypoint) > 0 {
+		starlarkFields = append(starlarkFields, fmt.Sprintf("entrypoint = %s", getStarlarkStringList(serviceConfig.Entrypoint)))
+	}
+	if len(serviceConfig.Command) > 0 {
+		starlarkFields = append(starlarkFields, fmt.Sprintf("cmd = %s", getStarlarkStringList(serviceConfig.Command)))
+	}
+
+	envVars, err := getEnvVars(serviceConfig)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the environment variables of service '%v'", serviceName)
+	}
+	if len(envVars) > 0 {
+		starlarkFields = append(starlarkFields, fmt.Sprintf("env_vars = %s", getStarlarkStringDict(envVars)))
+	}
+
+	if maxCpu := getMilliCpusLimit(serviceConfig); maxCpu != 0 {
+		starlarkFields = append(starlarkFields, fmt.Sprintf("max_cpu = %d", maxCpu))
+	}
+	if minCpu := getMilliCpusReservation(serviceConfig.Deploy); minCpu != 0 {
+		starlarkFields = append(starlarkFields, fmt.Sprintf("min_cpu = %d", minCpu))
+	}
+	if maxMemory := getMemoryMegabytesLimit(serviceConfig); maxMemory != 0 {
+		starlarkFields = append(starlarkFields, fmt.Sprintf("max_memory = %d", maxMemory))
+	}
+	if minMemory := getMemoryMegabytesReservation(serviceConfig.Deploy); minMemory != 0 {
+		starlarkFields = append(starlarkFields, fmt.Sprintf("min_memory = %d", minMemory))
+	}
+
+	if hasHealthcheck(serviceConfig) {
+		readyConditionStarlark, livenessCheckStarlark := getHealthcheckStarlarks(serviceConfig.HealthCheck)
+		starlarkFields = append(starlarkFields, fmt.Sprintf("ready_conditions = %s", readyConditionStarlark))
+		starlarkFields = append(starlarkFields, fmt.Sprintf("liveness_check = %s", livenessCheckStarlark))
+	}
+
+	restartPolicyStarlark, err := getRestartPolicyStarlark(serviceConfig.Restart)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred translating the restart policy of service '%v'", serviceName)
+	}
+	if restartPolicyStarlark != "" {
+		starlarkFields = append(starlarkFields, fmt.Sprintf("restart_policy = %s", restartPolicyStarlark))
+	}
+
+	if len(serviceConfig.Labels) > 0 {
+		if err := service.ValidateServiceConfigLabels(serviceConfig.Labels); err != nil {
+			conversion.addUntranslatedServiceKey(serviceName, "labels", fmt.Sprintf("labels are not valid Kurtosis labels: %v", err))
+		} else {
+			starlarkFields = append(starlarkFields, fmt.Sprintf("labels = %s", getStarlarkStringDict(serviceConfig.Labels)))
+		}
+	}
+
+	conversion.reportUntranslatedDependencies(serviceConfig, servicesWithHealthcheck)
+	conversion.reportUnsupportedServiceKeys(serviceConfig)
+
+	return fmt.Sprintf(
+		"ServiceConfig(\n%s%s,\n%s)",
+		fieldIndent,
+		strings.Join(starlarkFields, ",\n"+fieldIndent),
+		strings.Repeat(starlarkIndent, 2), //nolint:gomnd
+	), nil
+}
+
+// getImage returns the image of the service, registering the build of the image if the service has a build context
+func (conversion *composeConversion) getImage(projectName string, serviceConfig types.ServiceConfig) (string, error) {
+	if serviceConfig.Build == nil {
+		if serviceConfig.Image == "" {
+			return "", stacktrace.NewError("Service '%v' has neither an image nor a build context", serviceConfig.Name)
+		}
+		return serviceConfig.Image, nil
+	}
+
+	imageName := serviceConfig.Image
+	if imageName == "" {
+		imageName = fmt.Sprintf(builtImageNameFormat, projectName, serviceConfig.Name)
+	}
+	buildArgs := map[string]string{}
+	for argName, argValue := range serviceConfig.Build.Args {
+		if argValue == nil {
+			conversion.addUntranslatedServiceKey(serviceConfig.Name, fmt.Sprintf("build.args.%s", argName), "build args without a value are not passed to the build")
+			continue
+		}
+		buildArgs[argName] = *argValue
+	}
+	conversion.imagesToBuild = append(conversion.imagesToBuild, &composeImageBuild{
+		serviceName:    serviceConfig.Name,
+		imageName:      imageName,
+		contextDirpath: serviceConfig.Build.Context,
+		dockerfile:     serviceConfig.Build.Dockerfile,
+		target:         serviceConfig.Build.Target,
+		args:           buildArgs,
+	})
+	return imageName, nil
+}
+
+func (conversion *composeConversion) getPortsStarlark(serviceConfig types.ServiceConfig) string {
+	ports := map[string]string{}
+	for _, port := range serviceConfig.Ports {
+		// TODO(victor.colombo): Have a better UX letting people know ports have been remapped
+		portNumber := port.Published
+		if portNumber == "" {
+			portNumber = strconv.FormatUint(uint64(port.Target), 10)
+		}
+		ports[portIdPrefix+portNumber] = getPortSpecStarlark(port.Target, port.Protocol)
+	}
+	for _, exposedPort := range serviceConfig.Expose {
+		portNumberStr, protocol, _ := strings.Cut(exposedPort, exposedPortProtocolSuffix)
+		portNumber, err := strconv.ParseUint(portNumberStr, 10, 16) //nolint:gomnd
+		if err != nil {
+			conversion.addUntranslatedServiceKey(serviceConfig.Name, "expose", fmt.Sprintf("exposed port '%s' is not a single port number", exposedPort))
+			continue
+		}
+		portId := portIdPrefix + portNumberStr
+		if _, found := ports[portId]; !found {
+			ports[portId] = getPortSpecStarlark(uint32(portNumber), protocol)
+		}
+	}
+
+	portStrings := []string{}
+	for _, portId := range getSortedKeys(ports) {
+		portStrings = append(portStrings, fmt.Sprintf("%q: %s", portId, ports[portId]))
+	}
+	return strings.Join(portStrings, ", ")
+}
+
+func getPortSpecStarlark(portNumber uint32, protocol string) string {
+	if protocol == "" {
+		return fmt.Sprintf("PortSpec(number = %d)", portNumber)
+	}
+	return fmt.Sprintf("PortSpec(number = %d, transport_protocol = %q)", portNumber, strings.ToUpper(protocol))
+}
+
+// getFilesStarlark translates the volumes of the service: bind mounts become files artifacts, named volumes become
+// shared persistent directories and anonymous volumes become persistent directories of the service
+func (conversion *composeConversion) getFilesStarlark(serviceConfig types.ServiceConfig) string {
+	files := map[string]string{}
+	for _, volume := range serviceConfig.Volumes {
+		switch volume.Type {
+		case types.VolumeTypeBind:
+			if _, found := conversion.filesArtifactsToUpload[volume.Source]; !found {
+				conversion.filesArtifactsToUpload[volume.Source] = name_generator.GenerateNatureThemeNameForFileArtifacts()
+			}
+			files[volume.Target] = fmt.Sprintf("%q", conversion.filesArtifactsToUpload[volume.Source])
+			if !volume.ReadOnly {
+				conversion.addUntranslatedServiceKey(serviceConfig.Name, fmt.Sprintf("volumes.%s", volume.Target), "bind mounts are copied into the enclave, changes made by the service are not written back to the host")
+			}
+		case types.VolumeTypeVolume:
+			if volume.Source == "" {
+				persistentKey := getAnonymousVolumePersistentKey(serviceConfig.Name, volume.Target)
+				files[volume.Target] = fmt.Sprintf("Directory(persistent_key = %q)", persistentKey)
+				continue
+			}
+			if volume.ReadOnly {
+				files[volume.Target] = fmt.Sprintf("Directory(persistent_key = %q, shared = True, read_only = True)", volume.Source)
+			} else {
+				files[volume.Target] = fmt.Sprintf("Directory(persistent_key = %q, shared = True)", volume.Source)
+			}
+		default:
+			conversion.addUntranslatedServiceKey(serviceConfig.Name, fmt.Sprintf("volumes.%s", volume.Target), fmt.Sprintf("volumes of type '%s' are not supported", volume.Type))
+		}
+	}
+
+	fileStrings := []string{}
+	for _, target := range getSortedKeys(files) {
+		fileStrings = append(fileStrings, fmt.Sprintf("%q: %s", target, files[target]))
+	}
+	return strings.Join(fileStrings, ", ")
+}
+
+func getAnonymousVolumePersistentKey(serviceName string, target string) string {
+	sanitizedTarget := persistentKeyDisallowedCharsRegex.ReplaceAllString(strings.ToLower(target), "-")
+	return fmt.Sprintf("%s-%s", serviceName, strings.Trim(sanitizedTarget, "-"))
+}
+
+// getEnvVars merges the variables of the env files of the service with its environment, the environment taking
+// precedence like in Compose
+func getEnvVars(serviceConfig types.ServiceConfig) (map[string]string, error) {
+	envVars := map[string]string{}
+	if len(serviceConfig.EnvFile) > 0 {
+		envFileVars, err := godotenv.Read(serviceConfig.EnvFile...)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading env files '%v'", serviceConfig.EnvFile)
+		}
+		for envVarName, envVarValue := range envFileVars {
+			envVars[envVarName] = envVarValue
+		}
+	}
+	for envVarName, envVarValue := range serviceConfig.Environment {
+		if envVarValue == nil {
+			if _, found := envVars[envVarName]; !found {
+				envVars[envVarName] = ""
+			}
+			continue
+		}
+		envVars[envVarName] = *envVarValue
+	}
+	return envVars, nil
+}
+
+func hasHealthcheck(serviceConfig types.ServiceConfig) bool {
+	healthcheck := serviceConfig.HealthCheck
+	if healthcheck == nil || healthcheck.Disable || len(healthcheck.Test) == 0 {
+		return false
+	}
+	return healthcheck.Test[0] != healthcheckTestNone
+}
+
+// getHealthcheckStarlarks translates a Compose healthcheck into a ReadyCondition, so that the service is only
+// considered started once it's healthy, and into a LivenessCheck that keeps checking its health afterwards
+func getHealthcheckStarlarks(healthcheck *types.HealthCheckConfig) (string, string) {
+	command := healthcheck.Test
+	switch command[0] {
+	case healthcheckTestCmd:
+		command = command[1:]
+	case healthcheckTestCmdShell:
+		command = []string{healthcheckShellBinary, healthcheckShellFlag, strings.Join(command[1:], " ")}
+	}
+	recipe := fmt.Sprintf("ExecRecipe(command = %s)", getStarlarkStringList(command))
+
+	interval := defaultHealthcheckInterval
+	if healthcheck.Interval != nil {
+		interval = time.Duration(*healthcheck.Interval)
+	}
+	timeout := defaultHealthcheckTimeout
+	if healthcheck.Timeout != nil {
+		timeout = time.Duration(*healthcheck.Timeout)
+	}
+	retries := uint64(defaultHealthcheckRetries)
+	if healthcheck.Retries != nil {
+		retries = *healthcheck.Retries
+	}
+	startPeriod := time.Duration(0)
+	if healthcheck.StartPeriod != nil {
+		startPeriod = time.Duration(*healthcheck.StartPeriod)
+	}
+	// Compose considers the service unhealthy once all the retries following the start period have failed
+	readyTimeout := startPeriod + time.Duration(retries)*(interval+timeout)
+
+	readyCondition := fmt.Sprintf(
+		"ReadyCondition(recipe = %s, field = \"code\", assertion = \"==\", target_value = 0, interval = %q, timeout = %q)",
+		recipe,
+		interval.String(),
+		readyTimeout.String(),
+	)
+	livenessCheck := fmt.Sprintf(
+		"LivenessCheck(recipe = %s, field = \"code\", assertion = \"==\", target_value = 0, interval = %q, failure_threshold = %d)",
+		recipe,
+		interval.String(),
+		retries,
+	)
+	return readyCondition, livenessCheck
+}
+
+func getRestartPolicyStarlark(restart string) (string, error) {
+	policy, maxRetriesStr, hasMaxRetries := strings.Cut(restart, composeRestartMaxRetriesSeparator)
+	switch policy {
+	case "", composeRestartNo:
+		return "", nil
+	case composeRestartAlways, composeRestartUnlessStopped:
+		return fmt.Sprintf("RestartPolicy(policy = %q)", kurtosisRestartPolicy), nil
+	case composeRestartOnFailure:
+		if !hasMaxRetries {
+			return fmt.Sprintf("RestartPolicy(policy = %q)", kurtosisRestartPolicy), nil
+		}
+		maxRetries, err := strconv.ParseUint(maxRetriesStr, 10, 32) //nolint:gomnd
+		if err != nil {
+			return "", stacktrace.Propagate(err, "Invalid maximum number of retries in restart policy '%v'", restart)
+		}
+		return fmt.Sprintf("RestartPolicy(policy = %q, max_retries = %d)", kurtosisRestartPolicy, maxRetries), nil
+	default:
+		return "", stacktrace.NewError("Unknown restart policy '%v'", restart)
+	}
+}
+
+// reportUntranslatedDependencies reports the dependency conditions that can't be honoured. Services are added in
+// dependency order and adding a service with a healthcheck waits for it to be healthy, which covers both the
+// 'service_started' and the 'service_healthy' conditions.
+func (conversion *composeConversion) reportUntranslatedDependencies(serviceConfig types.ServiceConfig, servicesWithHealthcheck map[string]bool) {
+	for _, dependencyName := range getSortedKeys(serviceConfig.DependsOn) {
+		keyPath := fmt.Sprintf("depends_on.%s.condition", dependencyName)
+		switch condition := serviceConfig.DependsOn[dependencyName].Condition; condition {
+		case types.ServiceConditionHealthy:
+			if !servicesWithHealthcheck[dependencyName] {
+				conversion.addUntranslatedServiceKey(serviceConfig.Name, keyPath, fmt.Sprintf("service '%s' has no healthcheck, only its start is waited for", dependencyName))
+			}
+		case types.ServiceConditionCompletedSuccessfully:
+			conversion.addUntranslatedServiceKey(serviceConfig.Name, keyPath, fmt.Sprintf("waiting for service '%s' to exit is not supported, only its start is waited for", dependencyName))
+		}
+	}
+}
+
+func (conversion *composeConversion) reportUnsupportedServiceKeys(serviceConfig types.ServiceConfig) {
+	if serviceConfig.Deploy != nil && serviceConfig.Deploy.Replicas != nil && *serviceConfig.Deploy.Replicas > 1 {
+		conversion.addUntranslatedServiceKey(serviceConfig.Name, "deploy.replicas", "a single instance of the service is started")
+	}
+	if serviceConfig.Scale > 1 {
+		conversion.addUntranslatedServiceKey(serviceConfig.Name, "scale", "a single instance of the service is started")
+	}
+	if serviceConfig.Deploy != nil && serviceConfig.Deploy.RestartPolicy != nil {
+		conversion.addUntranslatedServiceKey(serviceConfig.Name, "deploy.restart_policy", "use 'restart' instead")
+	}
+
+	unsupportedKeys := []struct {
+		key   string
+		isSet bool
+	}{
+		{key: "cap_add", isSet: len(serviceConfig.CapAdd) > 0},
+		{key: "cap_drop", isSet: len(serviceConfig.CapDrop) > 0},
+		{key: "container_name", isSet: serviceConfig.ContainerName != ""},
+		{key: "devices", isSet: len(serviceConfig.Devices) > 0},
+		{key: "dns", isSet: len(serviceConfig.DNS) > 0},
+		{key: "extra_hosts", isSet: len(serviceConfig.ExtraHosts) > 0},
+		{key: "hostname", isSet: serviceConfig.Hostname != ""},
+		{key: "init", isSet: serviceConfig.Init != nil},
+		{key: "ipc", isSet: serviceConfig.Ipc != ""},
+		{key: "links", isSet: len(serviceConfig.Links) > 0},
+		{key: "logging", isSet: serviceConfig.Logging != nil},
+		{key: "network_mode", isSet: serviceConfig.NetworkMode != ""},
+		{key: "networks", isSet: hasNonDefaultNetwork(serviceConfig)},
+		{key: "pid", isSet: serviceConfig.Pid != ""},
+		{key: "platform", isSet: serviceConfig.Platform != ""},
+		{key: "privileged", isSet: serviceConfig.Privileged},
+		{key: "read_only", isSet: serviceConfig.ReadOnly},
+		{key: "secrets", isSet: len(serviceConfig.Secrets) > 0},
+		{key: "configs", isSet: len(serviceConfig.Configs) > 0},
+		{key: "security_opt", isSet: len(serviceConfig.SecurityOpt) > 0},
+		{key: "shm_size", isSet: serviceConfig.ShmSize != 0},
+		{key: "stdin_open", isSet: serviceConfig.StdinOpen},
+		{key: "stop_grace_period", isSet: serviceConfig.StopGracePeriod != nil},
+		{key: "stop_signal", isSet: serviceConfig.StopSignal != ""},
+		{key: "sysctls", isSet: len(serviceConfig.Sysctls) > 0},
+		{key: "tmpfs", isSet: len(serviceConfig.Tmpfs) > 0},
+		{key: "tty", isSet: serviceConfig.Tty},
+		{key: "ulimits", isSet: len(serviceConfig.Ulimits) > 0},
+		{key: "user", isSet: serviceConfig.User != ""},
+		{key: "volumes_from", isSet: len(serviceConfig.VolumesFrom) > 0},
+		{key: "working_dir", isSet: serviceConfig.WorkingDir != ""},
+	}
+	for _, unsupportedKey := range unsupportedKeys {
+		if unsupportedKey.isSet {
+			conversion.addUntranslatedServiceKey(serviceConfig.Name, unsupportedKey.key, "not supported by Kurtosis")
+		}
+	}
+}
+
+// all the services of an enclave share the same network
+func hasNonDefaultNetwork(serviceConfig types.ServiceConfig) bool {
+	for networkName := range serviceConfig.Networks {
+		if networkName != defaultComposeNetworkName {
+			return true
+		}
+	}
+	return false
+}
+
+// getServicesInDependencyOrder sorts the services so that every service comes after the services it depends on,
+// services without dependencies between them being sorted by name
+func getServicesInDependencyOrder(services types.Services) ([]types.ServiceConfig, error) {
+	servicesByName := map[string]types.ServiceConfig{}
+	for _, serviceConfig := range services {
+		servicesByName[serviceConfig.Name] = serviceConfig
+	}
+
+	orderedServices := []types.ServiceConfig{}
+	isServiceOrdered := map[string]bool{}
+	for len(orderedServices) < len(servicesByName) {
+		wasServiceOrdered := false
+		for _, serviceName := range getSortedKeys(servicesByName) {
+			if isServiceOrdered[serviceName] {
+				continue
+			}
+			areDependenciesOrdered := true
+			for dependencyName := range servicesByName[serviceName].DependsOn {
+				if _, found := servicesByName[dependencyName]; !found {
+					logrus.Debugf("Ignoring dependency of service '%v' on service '%v' which isn't part of the project", serviceName, dependencyName)
+					continue
+				}
+				if !isServiceOrdered[dependencyName] {
+					areDependenciesOrdered = false
+					break
+				}
+			}
+			if areDependenciesOrdered {
+				orderedServices = append(orderedServices, servicesByName[serviceName])
+				isServiceOrdered[serviceName] = true
+				wasServiceOrdered = true
+				break
+			}
+		}
+		if !wasServiceOrdered {
+			remainingServiceNames := []string{}
+			for _, serviceName := range getSortedKeys(servicesByName) {
+				if !isServiceOrdered[serviceName] {
+					remainingServiceNames = append(remainingServiceNames, serviceName)
+				}
+			}
+			return nil, stacktrace.NewError("Services '%v' have circular dependencies", strings.Join(remainingServiceNames, "', '"))
+		}
+	}
+	return orderedServices, nil
+}
+
+func getMemoryMegabytesReservation(deployConfig *types.DeployConfig) int {
+	if deployConfig == nil {
+		return 0
+	}
+	reservation := 0
+	if deployConfig.Resources.Reservations != nil {
+		reservation = int(deployConfig.Resources.Reservations.MemoryBytes) / bytesToMegabytes
+		logrus.Debugf("Converted '%v' bytes to '%v' megabytes", deployConfig.Resources.Reservations.MemoryBytes, reservation)
+	}
+	return reservation
+}
+
+func getMilliCpusReservation(deployConfig *types.DeployConfig) int {
+	if deployConfig == nil {
+		return 0
+	}
+	reservation := 0
+	if deployConfig.Resources.Reservations != nil {
+		reservationParsed, err := strconv.ParseFloat(deployConfig.Resources.Reservations.NanoCPUs, float64BitWidth)
+		if err == nil {
+			// Despite being called 'nano CPUs', they actually refer to a float representing percentage of one CPU
+			reservation = int(reservationParsed * cpuToMilliCpuConstant)
+			logrus.Debugf("Converted '%v' CPUs to '%v' milli CPUs", deployConfig.Resources.Reservations.NanoCPUs, reservation)
+		} else {
+			logrus.Warnf("Could not convert CPU reservation '%v' to integer, limits reservation", deployConfig.Resources.Reservations.NanoCPUs)
+		}
+	}
+	return reservation
+}
+
+// getMemoryMegabytesLimit returns the memory limit of the deploy resources, falling back to 'mem_limit'
+func getMemoryMegabytesLimit(serviceConfig types.ServiceConfig) int {
+	limitBytes := serviceConfig.MemLimit
+	if serviceConfig.Deploy != nil && serviceConfig.Deploy.Resources.Limits != nil && serviceConfig.Deploy.Resources.Limits.MemoryBytes != 0 {
+		limitBytes = serviceConfig.Deploy.Resources.Limits.MemoryBytes
+	}
+	return int(limitBytes) / bytesToMegabytes
+}
+
+// getMilliCpusLimit returns the CPU limit of the deploy resources, falling back to 'cpus'
+func getMilliCpusLimit(serviceConfig types.ServiceConfig) int {
+	limitCpus := float64(serviceConfig.CPUS)
+	if serviceConfig.Deploy != nil && serviceConfig.Deploy.Resources.Limits != nil && serviceConfig.Deploy.Resources.Limits.NanoCPUs != "" {
+		limitParsed, err := strconv.ParseFloat(serviceConfig.Deploy.Resources.Limits.NanoCPUs, float64BitWidth)
+		if err != nil {
+			logrus.Warnf("Could not convert CPU limit '%v' to integer, ignoring limit", serviceConfig.Deploy.Resources.Limits.NanoCPUs)
+		} else {
+			limitCpus = limitParsed
+		}
+	}
+	return int(limitCpus * cpuToMilliCpuConstant)
+}
+
+func getStarlarkStringList(values []string) string {
+	quotedValues := []string{}
+	for _, value := range values {
+		quotedValues = append(quotedValues, fmt.Sprintf("%q", value))
+	}
+	return fmt.Sprintf("[%s]", strings.Join(quotedValues, ", "))
+}
+
+func getStarlarkStringDict(values map[string]string) string {
+	entries := []string{}
+	for _, key := range getSortedKeys(values) {
+		entries = append(entries, fmt.Sprintf("%q: %q", key, values[key]))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(entries, ", "))
+}
+
+func getSortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,168 @@
+package _import
+
+import (
+	"github.com/compose-spec/compose-go/types"
+	"github.com/stretchr/testify/require"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConvertComposeProjectToStarlark_ServicesAreAddedInDependencyOrder(t *testing.T) {
+	interval := types.Duration(2 * time.Second)
+	timeout := types.Duration(time.Second)
+	retries := uint64(5)
+	project := &types.Project{ //nolint:exhaustruct
+		Name: "test",
+		Services: types.Services{
+			{ //nolint:exhaustruct
+				Name:  "worker",
+				Image: "worker-image",
+				DependsOn: types.DependsOnConfig{
+					"web": {Condition: types.ServiceConditionCompletedSuccessfully}, //nolint:exhaustruct
+				},
+			},
+			{ //nolint:exhaustruct
+				Name:  "web",
+				Image: "web-image",
+				DependsOn: types.DependsOnConfig{
+					"db": {Condition: types.ServiceConditionHealthy}, //nolint:exhaustruct
+				},
+			},
+			{ //nolint:exhaustruct
+				Name:  "db",
+				Image: "db-image",
+				HealthCheck: &types.HealthCheckConfig{ //nolint:exhaustruct
+					Test:     types.HealthCheckTest{healthcheckTestCmdShell, "pg_isready -U postgres"},
+					Interval: &interval,
+					Timeout:  &timeout,
+					Retries:  &retries,
+				},
+			},
+		},
+	}
+
+	conversion, err := convertComposeProjectToStarlark(project, uploadFilesBeforeRunning)
+	require.NoError(t, err)
+
+	dbIndex := strings.Index(conversion.script, `name = "db"`)
+	webIndex := strings.Index(conversion.script, `name = "web"`)
+	workerIndex := strings.Index(conversion.script, `name = "worker"`)
+	require.True(t, dbIndex >= 0 && dbIndex < webIndex && webIndex < workerIndex, "Services are not in dependency order:\n%s", conversion.script)
+
+	expectedRecipe := `ExecRecipe(command = ["/bin/sh", "-c", "pg_isready -U postgres"])`
+	require.Contains(t, conversion.script, `ready_conditions = ReadyCondition(recipe = `+expectedRecipe+`, field = "code", assertion = "==", target_value = 0, interval = "2s", timeout = "15s")`)
+	require.Contains(t, conversion.script, `liveness_check = LivenessCheck(recipe = `+expectedRecipe+`, field = "code", assertion = "==", target_value = 0, interval = "2s", failure_threshold = 5)`)
+
+	require.Len(t, conversion.untranslatedKeys, 1)
+	require.Equal(t, "services.worker.depends_on.web.condition", conversion.untranslatedKeys[0].keyPath)
+}
+
+func TestConvertComposeProjectToStarlark_CircularDependenciesFail(t *testing.T) {
+	project := &types.Project{ //nolint:exhaustruct
+		Name: "test",
+		Services: types.Services{
+			{ //nolint:exhaustruct
+				Name:      "a",
+				Image:     "a-image",
+				DependsOn: types.DependsOnConfig{"b": {Condition: types.ServiceConditionStarted}}, //nolint:exhaustruct
+			},
+			{ //nolint:exhaustruct
+				Name:      "b",
+				Image:     "b-image",
+				DependsOn: types.DependsOnConfig{"a": {Condition: types.ServiceConditionStarted}}, //nolint:exhaustruct
+			},
+		},
+	}
+
+	_, err := convertComposeProjectToStarlark(project, uploadFilesBeforeRunning)
+	require.ErrorContains(t, err, "circular dependencies")
+}
+
+func TestConvertComposeProjectToStarlark_Volumes(t *testing.T) {
+	project := &types.Project{ //nolint:exhaustruct
+		Name: "test",
+		Services: types.Services{
+			{ //nolint:exhaustruct
+				Name:  "web",
+				Image: "web-image",
+				Volumes: []types.ServiceVolumeConfig{
+					{Type: types.VolumeTypeBind, Source: "/home/user/config", Target: "/config", ReadOnly: true}, //nolint:exhaustruct
+					{Type: types.VolumeTypeVolume, Source: "data", Target: "/data"},                              //nolint:exhaustruct
+					{Type: types.VolumeTypeVolume, Source: "", Target: "/var/cache"},                             //nolint:exhaustruct
+					{Type: types.VolumeTypeTmpfs, Target: "/tmp"},                                                //nolint:exhaustruct
+				},
+			},
+		},
+	}
+
+	conversion, err := convertComposeProjectToStarlark(project, uploadFilesInScript)
+	require.NoError(t, err)
+
+	artifactName, found := conversion.filesArtifactsToUpload["/home/user/config"]
+	require.True(t, found)
+	require.Contains(t, conversion.script, `plan.upload_files(src = "./static_files/`+artifactName+`/config", name = "`+artifactName+`")`)
+	require.Contains(t, conversion.script, `"/config": "`+artifactName+`"`)
+	require.Contains(t, conversion.script, `"/data": Directory(persistent_key = "data", shared = True)`)
+	require.Contains(t, conversion.script, `"/var/cache": Directory(persistent_key = "web-var-cache")`)
+
+	require.Len(t, conversion.untranslatedKeys, 1)
+	require.Equal(t, "services.web.volumes./tmp", conversion.untranslatedKeys[0].keyPath)
+}
+
+func TestConvertComposeProjectToStarlark_EnvFileAndBuild(t *testing.T) {
+	envFilepath := t.TempDir() + "/web.env"
+	require.NoError(t, os.WriteFile(envFilepath, []byte("FROM_FILE=file\nOVERRIDDEN=file\n"), readWriteEveryone))
+	overriddenValue := "environment"
+	buildArgValue := "1.0"
+	project := &types.Project{ //nolint:exhaustruct
+		Name: "test",
+		Services: types.Services{
+			{ //nolint:exhaustruct
+				Name:        "web",
+				EnvFile:     types.StringList{envFilepath},
+				Environment: types.MappingWithEquals{"OVERRIDDEN": &overriddenValue},
+				Build: &types.BuildConfig{ //nolint:exhaustruct
+					Context:    "/home/user/web",
+					Dockerfile: "build/Dockerfile",
+					Args:       types.MappingWithEquals{"VERSION": &buildArgValue},
+				},
+			},
+		},
+	}
+
+	conversion, err := convertComposeProjectToStarlark(project, uploadFilesBeforeRunning)
+	require.NoError(t, err)
+	require.Contains(t, conversion.script, `image = "test-web"`)
+	require.Contains(t, conversion.script, `env_vars = {"FROM_FILE": "file", "OVERRIDDEN": "environment"}`)
+
+	require.Len(t, conversion.imagesToBuild, 1)
+	require.Equal(t, []string{
+		"build",
+		"--tag",
+		"test-web",
+		"--file",
+		"/home/user/web/build/Dockerfile",
+		"--build-arg",
+		"VERSION=1.0",
+		"/home/user/web",
+	}, getDockerBuildArgs(conversion.imagesToBuild[0]))
+}
+
+func TestGetRestartPolicyStarlark(t *testing.T) {
+	restartPolicy, err := getRestartPolicyStarlark("")
+	require.NoError(t, err)
+	require.Empty(t, restartPolicy)
+
+	restartPolicy, err = getRestartPolicyStarlark("unless-stopped")
+	require.NoError(t, err)
+	require.Equal(t, `RestartPolicy(policy = "always")`, restartPolicy)
+
+	restartPolicy, err = getRestartPolicyStarlark("on-failure:3")
+	require.NoError(t, err)
+	require.Equal(t, `RestartPolicy(policy = "always", max_retries = 3)`, restartPolicy)
+
+	_, err = getRestartPolicyStarlark("sometimes")
+	require.Error(t, err)
+}
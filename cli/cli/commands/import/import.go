@@ -20,15 +20,17 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
 	_run "github.com/kurtosis-tech/kurtosis/cli/cli/commands/run"
-	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_package"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
-	"github.com/kurtosis-tech/kurtosis/name_generator"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"io/fs"
 	"os"
+	"os/exec"
 	"path/filepath"
-	"strconv"
+	"sort"
 	"strings"
 )
 
@@ -38,6 +40,8 @@ const (
 	dotEnvPathFlagKey         = "env"
 	convertOnlyFlagKey        = "convert"
 	convertOnlyDefaultFlag    = false
+	outputDirFlagKey          = "output-dir"
+	defaultOutputDirFlag      = ""
 	isPathArgOptional         = false
 	defaultPathArg            = ""
 	defaultDotEnvPathFlag     = ".env"
@@ -46,6 +50,23 @@ const (
 	bytesToMegabytes          = 1024 * 1024
 	float64BitWidth           = 64
 	readWriteEveryone         = 0666
+	packageDirPermissions     = 0755
+
+	mainStarFilename        = "main.star"
+	packageNameFormat       = "github.com/example-org/%s"
+	doNotCreateMainStarFile = false
+
+	uploadFilesInScript      = true
+	uploadFilesBeforeRunning = false
+	doNotBuildImages         = false
+	buildImagesBeforeRunning = true
+
+	dockerBinary          = "docker"
+	dockerBuildCmd        = "build"
+	dockerBuildTagFlag    = "--tag"
+	dockerBuildFileFlag   = "--file"
+	dockerBuildTargetFlag = "--target"
+	dockerBuildArgFlag    = "--build-arg"
 
 	// Signifies that an enclave name should be auto-generated
 	autogenerateEnclaveNameKeyword = ""
@@ -60,7 +81,7 @@ const (
 var ImportCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.ImportCmdStr,
 	ShortDescription:          "Import external workflows into Kurtosis",
-	LongDescription:           "Import external workflow into Kurtosis (currently only supports Docker Compose)",
+	LongDescription:           "Import external workflow into Kurtosis (currently only supports Docker Compose). The Compose keys that can't be translated to Starlark are listed once the conversion is done",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -86,9 +107,19 @@ var ImportCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisComman
 			Key:       convertOnlyFlagKey,
 			Shorthand: "c",
 			Default:   fmt.Sprintf("%v", convertOnlyDefaultFlag),
-			Usage:     "If enabled, only converts Docker Compose into Starlark without running it",
+			Usage:     "If enabled, only converts Docker Compose into a Starlark package without running it",
 			Type:      flags.FlagType_Bool,
 		},
+		{
+			Key:       outputDirFlagKey,
+			Shorthand: "o",
+			Default:   defaultOutputDirFlag,
+			Usage: fmt.Sprintf(
+				"The directory the package is written to when '--%v' is set (emptystring will use the name of the Compose file without its extension)",
+				convertOnlyFlagKey,
+			),
+			Type: flags.FlagType_String,
+		},
 		// TODO: Add connect flag similar to the run command.
 	},
 	Args: []*args.ArgConfig{
@@ -136,20 +167,36 @@ func run(
 	}
 	logrus.Debugf("Enviroment loaded: %v", dotEnvMap)
 
-	script, artifacts, err := convertComposeFileToStarlark(path, dotEnvMap)
-	if err != nil {
-		return stacktrace.Propagate(err, "Failed to convert compose to starlark")
-	}
-	// TODO(victor.colombo): Make this as pretty as run is
 	if convertOnly {
-		fileBase := filepath.Base(path)
-		fileName := fmt.Sprintf("%s.star", strings.TrimSuffix(fileBase, filepath.Ext(fileBase)))
-		if err := os.WriteFile(fileName, []byte(script), readWriteEveryone); err != nil {
-			return stacktrace.Propagate(err, "failed to write starlark file '%v'", fileName)
+		conversion, err := convertComposeFileToStarlark(path, dotEnvMap, uploadFilesInScript)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to convert compose to starlark")
+		}
+		packageDirpath, err := flags.GetString(outputDirFlagKey)
+		if err != nil {
+			return stacktrace.Propagate(err, "Output directory flag '%v' is missing", outputDirFlagKey)
+		}
+		if packageDirpath == defaultOutputDirFlag {
+			fileBase := filepath.Base(path)
+			packageDirpath = strings.TrimSuffix(fileBase, filepath.Ext(fileBase))
+		}
+		if err := writeStarlarkPackage(packageDirpath, conversion); err != nil {
+			return stacktrace.Propagate(err, "Failed to write the converted package to '%v'", packageDirpath)
 		}
+		out.PrintOutLn(fmt.Sprintf("Package written to '%s'", packageDirpath))
+		printConversionReport(conversion, doNotBuildImages)
 		return nil
 	}
-	logrus.Debugf("Generated starlark:\n%s", script)
+
+	conversion, err := convertComposeFileToStarlark(path, dotEnvMap, uploadFilesBeforeRunning)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to convert compose to starlark")
+	}
+	logrus.Debugf("Generated starlark:\n%s", conversion.script)
+	printConversionReport(conversion, buildImagesBeforeRunning)
+	if err := buildImages(conversion.imagesToBuild); err != nil {
+		return stacktrace.Propagate(err, "Failed to build the images of the services with a build context")
+	}
 
 	enclaveName, err := flags.GetString(enclaveNameFlagKey)
 	if err != nil {
@@ -159,11 +206,11 @@ func run(
 	if err != nil {
 		return stacktrace.Propagate(err, "Couldn't create enclave")
 	}
-	err = uploadArtifacts(enclaveCtx, artifacts)
+	err = uploadArtifacts(enclaveCtx, conversion.filesArtifactsToUpload)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to upload all required artifacts for execution")
 	}
-	err = runStarlark(ctx, enclaveCtx, script)
+	err = runStarlark(ctx, enclaveCtx, conversion.script)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to run generated starlark from compose")
 	}
@@ -173,19 +220,25 @@ func run(
 	return nil
 }
 
-func convertComposeFileToStarlark(path string, dotEnvMap map[string]string) (string, map[string]string, error) {
+func convertComposeFileToStarlark(path string, dotEnvMap map[string]string, shouldUploadFilesInScript bool) (*composeConversion, error) {
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the absolute path of '%v'", path)
+	}
 	project, err := loader.Load(types.ConfigDetails{ //nolint:exhaustruct
-		ConfigFiles: []types.ConfigFile{{Filename: path}},
+		// Relative paths of the compose file (bind mounts, env files, build contexts) are relative to its directory
+		WorkingDir:  filepath.Dir(absolutePath),
+		ConfigFiles: []types.ConfigFile{{Filename: absolutePath}},
 		Environment: dotEnvMap,
 	})
 	if err != nil {
-		return "", nil, stacktrace.Propagate(err, "Error parsing docker compose")
+		return nil, stacktrace.Propagate(err, "Error parsing docker compose")
 	}
-	script, artifacts, err := convertComposeProjectToStarlark(project)
+	conversion, err := convertComposeProjectToStarlark(project, shouldUploadFilesInScript)
 	if err != nil {
-		return "", nil, stacktrace.Propagate(err, "Error translating docker compose to Starlark")
+		return nil, stacktrace.Propagate(err, "Error translating docker compose to Starlark")
 	}
-	return script, artifacts, nil
+	return conversion, nil
 }
 
 func uploadArtifacts(enclaveCtx *enclaves.EnclaveContext, artifactUploadMap map[string]string) error {
@@ -198,91 +251,132 @@ func uploadArtifacts(enclaveCtx *enclaves.EnclaveContext, artifactUploadMap map[
 	return nil
 }
 
-// TODO(victor.colombo): Have a better UX letting people know ports have been remapped
-func convertComposeProjectToStarlark(compose *types.Project) (string, map[string]string, error) {
-	serviceStarlarks := map[string]string{}
-	requiredFileUploads := map[string]string{}
-	for _, serviceConfig := range compose.Services {
-		artifactsPiecesStr := []string{}
-		for _, volume := range serviceConfig.Volumes {
-			if volume.Type != types.VolumeTypeBind {
-				return "", nil, stacktrace.NewError("Volume type '%v' is not supported", volume.Type)
-			}
-			if _, ok := requiredFileUploads[volume.Source]; !ok {
-				requiredFileUploads[volume.Source] = name_generator.GenerateNatureThemeNameForFileArtifacts()
-			}
-			artifactsPiecesStr = append(artifactsPiecesStr, fmt.Sprintf("%s:%s", volume.Target, requiredFileUploads[volume.Source]))
-		}
-		portPiecesStr := []string{}
-		for _, port := range serviceConfig.Ports {
-			portStr := fmt.Sprintf("docker-%s=%d", port.Published, port.Target)
-			if port.Protocol != "" {
-				portStr += fmt.Sprintf("/%s", port.Protocol)
-			}
-			portPiecesStr = append(portPiecesStr, portStr)
-		}
-		envvarsPiecesStr := []string{}
-		for envKey, envValue := range serviceConfig.Environment {
-			envValueStr := ""
-			if envValue != nil {
-				envValueStr = *envValue
-			}
-			envvarsPiecesStr = append(envvarsPiecesStr, fmt.Sprintf("%s=%s", envKey, envValueStr))
-		}
-		memMinLimit := getMemoryMegabytesReservation(serviceConfig.Deploy)
-		cpuMinLimit := getMilliCpusReservation(serviceConfig.Deploy)
-		starlarkConfig, err := add.GetServiceConfigStarlark(
-			serviceConfig.Image,
-			strings.Join(portPiecesStr, ","),
-			serviceConfig.Command,
-			serviceConfig.Entrypoint,
-			strings.Join(envvarsPiecesStr, ","),
-			strings.Join(artifactsPiecesStr, ","),
-			0,
-			0,
-			cpuMinLimit,
-			memMinLimit,
-			emptyPrivateIpPlaceholder)
+// buildImages builds the images of the services that have a build context with the local Docker, where the Docker
+// backend of Kurtosis finds them when starting the services
+func buildImages(imagesToBuild []*composeImageBuild) error {
+	if len(imagesToBuild) == 0 {
+		return nil
+	}
+	if _, err := exec.LookPath(dockerBinary); err != nil {
+		return stacktrace.Propagate(err, "'%v' is needed to build the images of the services with a build context but it couldn't be found in path", dockerBinary)
+	}
+	for _, imageToBuild := range imagesToBuild {
+		logrus.Infof("Building image '%v' of service '%v'", imageToBuild.imageName, imageToBuild.serviceName)
+		cmd := exec.Command(dockerBinary, getDockerBuildArgs(imageToBuild)...)
+		logrus.Debugf("Running command '%v'", cmd.String())
+		cmdOutput, err := cmd.CombinedOutput()
 		if err != nil {
-			return "", nil, stacktrace.Propagate(err, "Error getting service config starlark for '%v'", serviceConfig)
+			logrus.Errorf("Building image '%v' failed with output:\n%s", imageToBuild.imageName, cmdOutput)
+			return stacktrace.Propagate(err, "An error occurred building image '%v' of service '%v'", imageToBuild.imageName, imageToBuild.serviceName)
+		}
+	}
+	return nil
+}
+
+func getDockerBuildArgs(imageToBuild *composeImageBuild) []string {
+	buildArgs := []string{dockerBuildCmd, dockerBuildTagFlag, imageToBuild.imageName}
+	if imageToBuild.dockerfile != "" {
+		dockerfilePath := imageToBuild.dockerfile
+		if !filepath.IsAbs(dockerfilePath) {
+			dockerfilePath = filepath.Join(imageToBuild.contextDirpath, dockerfilePath)
 		}
-		serviceStarlarks[serviceConfig.Name] = starlarkConfig
+		buildArgs = append(buildArgs, dockerBuildFileFlag, dockerfilePath)
 	}
-	script := "def run(plan):\n"
-	for serviceName, serviceConfig := range serviceStarlarks {
-		script += fmt.Sprintf("\tplan.add_service(name = '%s', config = %s)\n", serviceName, serviceConfig)
+	if imageToBuild.target != "" {
+		buildArgs = append(buildArgs, dockerBuildTargetFlag, imageToBuild.target)
 	}
-	return script, requiredFileUploads, nil
+	for _, argName := range getSortedKeys(imageToBuild.args) {
+		buildArgs = append(buildArgs, dockerBuildArgFlag, fmt.Sprintf("%s=%s", argName, imageToBuild.args[argName]))
+	}
+	return append(buildArgs, imageToBuild.contextDirpath)
 }
 
-func getMemoryMegabytesReservation(deployConfig *types.DeployConfig) int {
-	if deployConfig == nil {
-		return 0
+// writeStarlarkPackage writes the converted Compose file as a package: a kurtosis.yml, a main.star and the bind
+// mounted files and directories, which main.star uploads itself
+func writeStarlarkPackage(packageDirpath string, conversion *composeConversion) error {
+	if err := os.MkdirAll(packageDirpath, packageDirPermissions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating package directory '%v'", packageDirpath)
+	}
+	absolutePackageDirpath, err := filepath.Abs(packageDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the absolute path of '%v'", packageDirpath)
+	}
+	packageName := fmt.Sprintf(packageNameFormat, filepath.Base(absolutePackageDirpath))
+	if err := kurtosis_package.InitializeKurtosisPackage(packageDirpath, packageName, doNotCreateMainStarFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred initializing package '%v' in '%v'", packageName, packageDirpath)
 	}
-	reservation := 0
-	if deployConfig.Resources.Reservations != nil {
-		reservation = int(deployConfig.Resources.Reservations.MemoryBytes) / bytesToMegabytes
-		logrus.Debugf("Converted '%v' bytes to '%v' megabytes", deployConfig.Resources.Reservations.MemoryBytes, reservation)
+	mainStarFilepath := filepath.Join(packageDirpath, mainStarFilename)
+	if err := os.WriteFile(mainStarFilepath, []byte(conversion.script), readWriteEveryone); err != nil {
+		return stacktrace.Propagate(err, "failed to write starlark file '%v'", mainStarFilepath)
+	}
+	for source, artifactName := range conversion.filesArtifactsToUpload {
+		destination := filepath.Join(packageDirpath, filepath.FromSlash(getPackageStaticFilePath(source, artifactName)))
+		if err := copyPath(source, destination); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying bind mounted path '%v' into the package", source)
+		}
 	}
-	return reservation
+	return nil
+}
+
+// copyPath copies the file or the directory tree at source to destination
+func copyPath(source string, destination string) error {
+	return filepath.WalkDir(source, func(currentPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred walking '%v'", currentPath)
+		}
+		relativePath, err := filepath.Rel(source, currentPath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to '%v'", currentPath, source)
+		}
+		destinationPath := filepath.Join(destination, relativePath)
+		if entry.IsDir() {
+			if err := os.MkdirAll(destinationPath, packageDirPermissions); err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating directory '%v'", destinationPath)
+			}
+			return nil
+		}
+		if err := os.MkdirAll(filepath.Dir(destinationPath), packageDirPermissions); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating directory '%v'", filepath.Dir(destinationPath))
+		}
+		content, err := os.ReadFile(currentPath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading '%v'", currentPath)
+		}
+		fileInfo, err := entry.Info()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the info of '%v'", currentPath)
+		}
+		if err := os.WriteFile(destinationPath, content, fileInfo.Mode().Perm()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing '%v'", destinationPath)
+		}
+		return nil
+	})
 }
 
-func getMilliCpusReservation(deployConfig *types.DeployConfig) int {
-	if deployConfig == nil {
-		return 0
-	}
-	reservation := 0
-	if deployConfig.Resources.Reservations != nil {
-		reservationParsed, err := strconv.ParseFloat(deployConfig.Resources.Reservations.NanoCPUs, float64BitWidth)
-		if err == nil {
-			// Despite being called 'nano CPUs', they actually refer to a float representing percentage of one CPU
-			reservation = int(reservationParsed * cpuToMilliCpuConstant)
-			logrus.Debugf("Converted '%v' CPUs to '%v' milli CPUs", deployConfig.Resources.Reservations.NanoCPUs, reservation)
-		} else {
-			logrus.Warnf("Could not convert CPU reservation '%v' to integer, limits reservation", deployConfig.Resources.Reservations.NanoCPUs)
+func printConversionReport(conversion *composeConversion, areImagesBuilt bool) {
+	reportLines := []string{}
+	for _, untranslatedKey := range conversion.untranslatedKeys {
+		reportLines = append(reportLines, untranslatedKey.String())
+	}
+	if !areImagesBuilt {
+		for _, imageToBuild := range conversion.imagesToBuild {
+			reportLines = append(reportLines, fmt.Sprintf(
+				"services.%s.build: image '%s' must be built before running the package with 'docker %s'",
+				imageToBuild.serviceName,
+				imageToBuild.imageName,
+				strings.Join(getDockerBuildArgs(imageToBuild), " "),
+			))
 		}
 	}
-	return reservation
+	if len(reportLines) == 0 {
+		out.PrintOutLn("All the Compose keys were translated to Starlark")
+		return
+	}
+	sort.Strings(reportLines)
+	out.PrintOutLn("The following Compose keys could not be translated to Starlark:")
+	for _, reportLine := range reportLines {
+		out.PrintOutLn(fmt.Sprintf("  - %s", reportLine))
+	}
 }
 
 func createEnclave(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveName string) (*enclaves.EnclaveContext, error) {
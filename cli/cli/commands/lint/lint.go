@@ -8,11 +8,17 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_package"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
+	"strings"
 )
 
 const (
@@ -24,6 +30,21 @@ const (
 	formatFlagShortKey     = "f"
 	formatFlagDefaultValue = "false"
 
+	checkFormatFlagKey          = "check-format"
+	checkFormatFlagDefaultValue = "true"
+
+	skipFormatCheckFlagKey          = "skip-format-check"
+	skipFormatCheckFlagDefaultValue = "false"
+
+	outputFormatFlagKey          = "output-format"
+	outputFormatFlagDefaultValue = textOutputFormat
+	textOutputFormat             = "text"
+	jsonOutputFormat             = "json"
+	sarifOutputFormat            = "sarif"
+
+	starlarkFileExtension = ".star"
+	hiddenDirectoryPrefix = "."
+
 	pyBlackDockerImage      = "pyfound/black:23.9.1"
 	dockerRunCmd            = "run"
 	removeContainerOnExit   = "--rm"
@@ -53,7 +74,11 @@ var dockerRunSuffix = []string{dockerWorkDirFlag, lintVolumeName, pyBlackDockerI
 var LintCmd = &lowlevel.LowlevelKurtosisCommand{
 	CommandStr:       command_str_consts.KurtosisLintCmdStr,
 	ShortDescription: "Lints the Kurtosis package or file",
-	LongDescription:  "Lints the Kurtosis package or file",
+	LongDescription: "Lints the Starlark files of a Kurtosis package, or the given files, in a temporary enclave of the " +
+		"local engine, which is destroyed afterwards: calls to Kurtosis instructions and types are checked against " +
+		"their arguments, and unused imports, undefined services and unreachable parameters of the main function are " +
+		"reported. The files are never run. The formatting of the files is checked too, " +
+		"unless '--" + skipFormatCheckFlagKey + "' is set; formatting the files, or checking their formatting, relies on the '" + pyBlackDockerImage + "' Docker image",
 
 	Args: []*args.ArgConfig{
 		{
@@ -68,16 +93,35 @@ var LintCmd = &lowlevel.LowlevelKurtosisCommand{
 	Flags: []*flags.FlagConfig{
 		{
 			Key:       formatFlagKey,
-			Usage:     "Use this flag to edit files in place instead of just verifying whether the formatting is correct",
+			Usage:     "Use this flag to format the files in place before linting them. This requires Docker",
 			Shorthand: formatFlagShortKey,
 			Type:      flags.FlagType_Bool,
 			Default:   formatFlagDefaultValue,
 		},
+		{
+			Key:     checkFormatFlagKey,
+			Usage:   "Whether to also verify that the formatting of the files is correct, which is done by default. This requires Docker",
+			Type:    flags.FlagType_Bool,
+			Default: checkFormatFlagDefaultValue,
+		},
+		{
+			Key:     skipFormatCheckFlagKey,
+			Usage:   fmt.Sprintf("Use this flag to skip verifying the formatting of the files, so that linting doesn't require Docker. Takes precedence over '--%s'", checkFormatFlagKey),
+			Type:    flags.FlagType_Bool,
+			Default: skipFormatCheckFlagDefaultValue,
+		},
+		{
+			Key: outputFormatFlagKey,
+			Usage: fmt.Sprintf("The format the lint findings are printed in, one of '%s'. '%s' is meant for code scanning tools",
+				strings.Join([]string{textOutputFormat, jsonOutputFormat, sarifOutputFormat}, "', '"), sarifOutputFormat),
+			Type:    flags.FlagType_String,
+			Default: outputFormatFlagDefaultValue,
+		},
 	},
 	RunFunc: run,
 }
 
-func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
 	fileOrDirToLintArg, err := args.GetGreedyArg(fileOrDirToLintArgKey)
 	if err != nil {
 		return stacktrace.Propagate(err, "an error occurred getting the value of argument with key '%v'", fileOrDirToLintArgKey)
@@ -85,9 +129,76 @@ func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) err
 
 	formatFlag, err := flags.GetBool(formatFlagKey)
 	if err != nil {
-		return stacktrace.Propagate(err, "an error occurred getting the value of flag '%v'", formatFlag)
+		return stacktrace.Propagate(err, "an error occurred getting the value of flag '%v'", formatFlagKey)
+	}
+	checkFormatFlag, err := flags.GetBool(checkFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred getting the value of flag '%v'", checkFormatFlagKey)
+	}
+	skipFormatCheckFlag, err := flags.GetBool(skipFormatCheckFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred getting the value of flag '%v'", skipFormatCheckFlagKey)
+	}
+	shouldCheckFormat := checkFormatFlag && !skipFormatCheckFlag
+	outputFormat, err := flags.GetString(outputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred getting the value of flag '%v'", outputFormatFlagKey)
+	}
+	if outputFormat != textOutputFormat && outputFormat != jsonOutputFormat && outputFormat != sarifOutputFormat {
+		return stacktrace.NewError("Unsupported output format '%v'; it must be one of '%v', '%v' or '%v'", outputFormat, textOutputFormat, jsonOutputFormat, sarifOutputFormat)
+	}
+
+	if formatFlag || shouldCheckFormat {
+		if err := runPyBlack(fileOrDirToLintArg, formatFlag); err != nil {
+			return err
+		}
 	}
-	if !formatFlag {
+
+	starlarkFiles, err := readStarlarkFiles(fileOrDirToLintArg)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred reading the Starlark files to lint")
+	}
+	lintResponse, err := kurtosis_package.LintStarlarkFiles(ctx, starlarkFiles)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred linting the Starlark files")
+	}
+	findings := lintResponse.GetFindings()
+
+	numErrors := 0
+	for _, finding := range findings {
+		if finding.GetSeverity() == errorSeverity {
+			numErrors++
+		}
+	}
+	switch outputFormat {
+	case jsonOutputFormat:
+		serializedFindings, err := serializeLintFindingsToJson(findings)
+		if err != nil {
+			return stacktrace.Propagate(err, "an error occurred serializing the lint findings")
+		}
+		out.PrintOutLn(string(serializedFindings))
+	case sarifOutputFormat:
+		serializedLog, err := serializeLintFindingsToSarif(findings, lintResponse.GetRules(), kurtosis_version.KurtosisVersion)
+		if err != nil {
+			return stacktrace.Propagate(err, "an error occurred serializing the lint findings")
+		}
+		out.PrintOutLn(string(serializedLog))
+	default:
+		for _, finding := range findings {
+			out.PrintOutLn(formatLintFinding(finding))
+		}
+		logrus.Infof("Linted %d Starlark file(s): %d error(s), %d warning(s)", len(starlarkFiles), numErrors, len(findings)-numErrors)
+	}
+
+	if numErrors > 0 {
+		return stacktrace.NewError("linting failed, %d error(s) were found in the Starlark files", numErrors)
+	}
+	return nil
+}
+
+// runPyBlack formats the files in place, or verifies whether their formatting is correct, using the black formatter
+func runPyBlack(fileOrDirToLintArg []string, formatInPlace bool) error {
+	if !formatInPlace {
 		dockerRunSuffix = append(dockerRunSuffix, checkFlagForBlack)
 	}
 
@@ -103,7 +214,7 @@ func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) err
 	}
 
 	for _, fileOrDirToLint := range fileOrDirToLintArg {
-		logrus.Infof("Linting '%v'", fileOrDirToLint)
+		logrus.Infof("Formatting '%v'", fileOrDirToLint)
 		volumeToMount, pathToLint, err := getVolumeToMountAndPathToLint(fileOrDirToLint)
 		if err != nil {
 			return stacktrace.Propagate(err, "an error occurred while attempting to parse the volume to mount and file to lint for path '%v'", fileOrDirToLint)
@@ -130,10 +241,41 @@ func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) err
 		}
 		fmt.Println(string(cmdOutput))
 	}
-
 	return nil
 }
 
+// readStarlarkFiles returns the content of the given files and of the Starlark files found in the given directories,
+// hidden directories excepted, keyed by path
+func readStarlarkFiles(fileOrDirToLintArg []string) (map[string][]byte, error) {
+	starlarkFiles := map[string][]byte{}
+	for _, fileOrDirToLint := range fileOrDirToLintArg {
+		err := filepath.WalkDir(fileOrDirToLint, func(filePath string, dirEntry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if dirEntry.IsDir() {
+				if filePath != fileOrDirToLint && strings.HasPrefix(dirEntry.Name(), hiddenDirectoryPrefix) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if filePath != fileOrDirToLint && filepath.Ext(filePath) != starlarkFileExtension {
+				return nil
+			}
+			content, err := os.ReadFile(filePath)
+			if err != nil {
+				return stacktrace.Propagate(err, "an error occurred reading file '%v'", filePath)
+			}
+			starlarkFiles[filePath] = content
+			return nil
+		})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "an error occurred walking '%v'", fileOrDirToLint)
+		}
+	}
+	return starlarkFiles, nil
+}
+
 func validateFileOrDirToLintArg(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
 	fileOrDirToLintArg, err := args.GetGreedyArg(fileOrDirToLintArgKey)
 	if err != nil {
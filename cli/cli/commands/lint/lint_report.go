@@ -0,0 +1,186 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"path/filepath"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+
+	sarifToolName           = "kurtosis-lint"
+	sarifToolInformationUri = "https://docs.kurtosis.com/lint"
+
+	// SARIF lines are 1-based, a finding without a known line is reported without region
+	unknownLine = 0
+
+	reportIndent = "  "
+	noPrefix     = ""
+
+	errorSeverity = "error"
+)
+
+// lintFinding is how a finding is reported in JSON; unlike the API binding, it keeps the fields with a zero value
+type lintFinding struct {
+	RuleId   string `json:"rule_id"`
+	Severity string `json:"severity"`
+	Filepath string `json:"filepath"`
+	Line     int32  `json:"line"`
+	Column   int32  `json:"column"`
+	Message  string `json:"message"`
+}
+
+func formatLintFinding(finding *kurtosis_core_rpc_api_bindings.StarlarkLintFinding) string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s [%s]", finding.GetFilepath(), finding.GetLine(), finding.GetColumn(), finding.GetSeverity(), finding.GetMessage(), finding.GetRuleId())
+}
+
+// serializeLintFindingsToJson returns the findings as a JSON array
+func serializeLintFindingsToJson(findings []*kurtosis_core_rpc_api_bindings.StarlarkLintFinding) ([]byte, error) {
+	jsonFindings := []*lintFinding{}
+	for _, finding := range findings {
+		jsonFindings = append(jsonFindings, &lintFinding{
+			RuleId:   finding.GetRuleId(),
+			Severity: finding.GetSeverity(),
+			Filepath: finding.GetFilepath(),
+			Line:     finding.GetLine(),
+			Column:   finding.GetColumn(),
+			Message:  finding.GetMessage(),
+		})
+	}
+	serializedFindings, err := json.MarshalIndent(jsonFindings, noPrefix, reportIndent)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the lint findings to JSON")
+	}
+	return serializedFindings, nil
+}
+
+// serializeLintFindingsToSarif returns the findings as a SARIF 2.1.0 log, the format code scanning tools ingest, with a
+// single run attributed to the given version of the linter, whose rules are the given ones
+func serializeLintFindingsToSarif(
+	findings []*kurtosis_core_rpc_api_bindings.StarlarkLintFinding,
+	rules []*kurtosis_core_rpc_api_bindings.StarlarkLintRule,
+	toolVersion string,
+) ([]byte, error) {
+	ruleIndexes := map[string]int{}
+	sarifRules := []*sarifRule{}
+	for ruleIdx, rule := range rules {
+		ruleIndexes[rule.GetId()] = ruleIdx
+		sarifRules = append(sarifRules, &sarifRule{
+			Id:                   rule.GetId(),
+			ShortDescription:     sarifMessage{Text: rule.GetDescription()},
+			DefaultConfiguration: sarifRuleConfiguration{Level: rule.GetSeverity()},
+		})
+	}
+
+	sarifResults := []*sarifResult{}
+	for _, finding := range findings {
+		physicalLocation := sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{Uri: filepath.ToSlash(finding.GetFilepath())},
+			Region:           nil,
+		}
+		if finding.GetLine() != unknownLine {
+			physicalLocation.Region = &sarifRegion{
+				StartLine:   finding.GetLine(),
+				StartColumn: finding.GetColumn(),
+			}
+		}
+		sarifResults = append(sarifResults, &sarifResult{
+			RuleId:    finding.GetRuleId(),
+			RuleIndex: ruleIndexes[finding.GetRuleId()],
+			Level:     finding.GetSeverity(),
+			Message:   sarifMessage{Text: finding.GetMessage()},
+			Locations: []*sarifLocation{{PhysicalLocation: physicalLocation}},
+		})
+	}
+
+	log := &sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []*sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           sarifToolName,
+						Version:        toolVersion,
+						InformationUri: sarifToolInformationUri,
+						Rules:          sarifRules,
+					},
+				},
+				Results: sarifResults,
+			},
+		},
+	}
+	serializedLog, err := json.MarshalIndent(log, noPrefix, reportIndent)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the lint findings to SARIF")
+	}
+	return serializedLog, nil
+}
+
+// The subset of the SARIF 2.1.0 object model needed to report lint findings
+// See https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarifLog struct {
+	Schema  string      `json:"$schema"`
+	Version string      `json:"version"`
+	Runs    []*sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool      `json:"tool"`
+	Results []*sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string       `json:"name"`
+	Version        string       `json:"version"`
+	InformationUri string       `json:"informationUri"`
+	Rules          []*sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	Id                   string                 `json:"id"`
+	ShortDescription     sarifMessage           `json:"shortDescription"`
+	DefaultConfiguration sarifRuleConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleId    string           `json:"ruleId"`
+	RuleIndex int              `json:"ruleIndex"`
+	Level     string           `json:"level"`
+	Message   sarifMessage     `json:"message"`
+	Locations []*sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	Uri string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int32 `json:"startLine"`
+	StartColumn int32 `json:"startColumn,omitempty"`
+}
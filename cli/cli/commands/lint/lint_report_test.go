@@ -0,0 +1,107 @@
+package lint
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testToolVersion = "1.0.0"
+
+	undefinedServiceRuleId = "undefined-service"
+	syntaxErrorRuleId      = "syntax-error"
+	warningSeverity        = "warning"
+
+	mainFilepath = "main.star"
+)
+
+var testLintRules = []*kurtosis_core_rpc_api_bindings.StarlarkLintRule{
+	{
+		Id:          syntaxErrorRuleId,
+		Severity:    errorSeverity,
+		Description: "The file isn't valid Starlark",
+	},
+	{
+		Id:          undefinedServiceRuleId,
+		Severity:    warningSeverity,
+		Description: "A service is referenced by a name no service of the package is added with",
+	},
+}
+
+func TestSerializeLintFindingsToSarif(t *testing.T) {
+	findings := []*kurtosis_core_rpc_api_bindings.StarlarkLintFinding{
+		{
+			RuleId:   undefinedServiceRuleId,
+			Severity: warningSeverity,
+			Filepath: mainFilepath,
+			Line:     2,
+			Column:   5,
+			Message:  "Service 'db' is never added",
+		},
+	}
+	serializedLog, err := serializeLintFindingsToSarif(findings, testLintRules, testToolVersion)
+	require.Nil(t, err)
+
+	var log sarifLog
+	require.Nil(t, json.Unmarshal(serializedLog, &log))
+	require.Equal(t, sarifVersion, log.Version)
+	require.Len(t, log.Runs, 1)
+	require.Equal(t, testToolVersion, log.Runs[0].Tool.Driver.Version)
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, len(testLintRules))
+	require.Len(t, log.Runs[0].Results, 1)
+
+	result := log.Runs[0].Results[0]
+	require.Equal(t, undefinedServiceRuleId, result.RuleId)
+	require.Equal(t, undefinedServiceRuleId, log.Runs[0].Tool.Driver.Rules[result.RuleIndex].Id)
+	require.Equal(t, warningSeverity, result.Level)
+	require.Equal(t, mainFilepath, result.Locations[0].PhysicalLocation.ArtifactLocation.Uri)
+	require.Equal(t, int32(2), result.Locations[0].PhysicalLocation.Region.StartLine)
+}
+
+func TestSerializeLintFindingsToSarif_FindingWithoutPositionHasNoRegion(t *testing.T) {
+	findings := []*kurtosis_core_rpc_api_bindings.StarlarkLintFinding{
+		{
+			RuleId:   syntaxErrorRuleId,
+			Severity: errorSeverity,
+			Filepath: mainFilepath,
+			Line:     unknownLine,
+			Column:   0,
+			Message:  "The file couldn't be read",
+		},
+	}
+	serializedLog, err := serializeLintFindingsToSarif(findings, testLintRules, testToolVersion)
+	require.Nil(t, err)
+
+	var log sarifLog
+	require.Nil(t, json.Unmarshal(serializedLog, &log))
+	require.Nil(t, log.Runs[0].Results[0].Locations[0].PhysicalLocation.Region)
+}
+
+func TestSerializeLintFindingsToJson_NoFinding(t *testing.T) {
+	serializedFindings, err := serializeLintFindingsToJson(nil)
+	require.Nil(t, err)
+	require.Equal(t, "[]", string(serializedFindings))
+}
+
+func TestSerializeLintFindingsToJson_KeepsZeroPosition(t *testing.T) {
+	findings := []*kurtosis_core_rpc_api_bindings.StarlarkLintFinding{
+		{
+			RuleId:   syntaxErrorRuleId,
+			Severity: errorSeverity,
+			Filepath: mainFilepath,
+			Line:     unknownLine,
+			Column:   0,
+			Message:  "The file couldn't be read",
+		},
+	}
+	serializedFindings, err := serializeLintFindingsToJson(findings)
+	require.Nil(t, err)
+
+	var deserializedFindings []map[string]interface{}
+	require.Nil(t, json.Unmarshal(serializedFindings, &deserializedFindings))
+	require.Len(t, deserializedFindings, 1)
+	require.Equal(t, float64(unknownLine), deserializedFindings[0]["line"])
+	require.Equal(t, syntaxErrorRuleId, deserializedFindings[0]["rule_id"])
+}
@@ -0,0 +1,74 @@
+package common
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_package"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	packageDirpathArgKey          = "package-dir"
+	packageDirpathArgDefaultValue = "."
+	packageDirpathArgIsOptional   = true
+
+	argsFlagKey      = "args"
+	argsDefaultValue = "{}"
+)
+
+// NewPackageLockCmd returns a command resolving the dependencies of the package in the given directory and writing
+// them to its kurtosis.lock. The commands only differ by how they treat the dependencies already in the lock, which
+// is decided by the packageLockMode
+func NewPackageLockCmd(
+	commandStr string,
+	shortDescription string,
+	longDescription string,
+	packageLockMode kurtosis_core_rpc_api_bindings.PackageLockMode,
+) *lowlevel.LowlevelKurtosisCommand {
+	return &lowlevel.LowlevelKurtosisCommand{
+		CommandStr:       commandStr,
+		ShortDescription: shortDescription,
+		LongDescription:  longDescription,
+		Flags: []*flags.FlagConfig{
+			{
+				Key:     argsFlagKey,
+				Usage:   "The args to interpret the package with, as JSON or YAML; dependencies only imported for other args won't be locked",
+				Type:    flags.FlagType_String,
+				Default: argsDefaultValue,
+			},
+		},
+		Args: []*args.ArgConfig{
+			file_system_path_arg.NewDirpathArg(
+				packageDirpathArgKey,
+				packageDirpathArgIsOptional,
+				packageDirpathArgDefaultValue,
+				file_system_path_arg.DefaultValidationFunc,
+			),
+		},
+		PreValidationAndRunFunc: nil,
+		RunFunc: func(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+			return run(ctx, flags, args, packageLockMode)
+		},
+		PostValidationAndRunFunc: nil,
+	}
+}
+
+func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs, packageLockMode kurtosis_core_rpc_api_bindings.PackageLockMode) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument with key '%v'", packageDirpathArgKey)
+	}
+	serializedParams, err := flags.GetString(argsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", argsFlagKey)
+	}
+
+	if err = kurtosis_package.LockKurtosisPackage(ctx, packageDirpath, serializedParams, packageLockMode); err != nil {
+		return stacktrace.Propagate(err, "An error occurred locking the dependencies of the package at '%s'", packageDirpath)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package lock
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/common"
+)
+
+var LockCmd = common.NewPackageLockCmd(
+	command_str_consts.PackageLockCmdStr,
+	"Locks the dependencies of a Kurtosis package",
+	"Resolves the packages the Kurtosis package in the given directory imports, by interpreting it in a temporary enclave, "+
+		"and records the commit each of them resolved to in its 'kurtosis.lock'. Dependencies already in the lock keep their commit; "+
+		"use 'kurtosis package update' to resolve them again. Runs of the package fail if a dependency isn't in the lock or resolves to another commit",
+	kurtosis_core_rpc_api_bindings.PackageLockMode_EXTEND_LOCK,
+)
@@ -3,6 +3,9 @@ package _package
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/init_cmd"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/lock"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/test"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/update"
 	"github.com/spf13/cobra"
 )
 
@@ -16,4 +19,7 @@ var PackageCmd = &cobra.Command{
 
 func init() {
 	PackageCmd.AddCommand(init_cmd.InitCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(lock.LockCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(test.TestCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(update.UpdateCmd.MustGetCobraCommand())
 }
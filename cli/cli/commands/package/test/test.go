@@ -0,0 +1,162 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_package"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const (
+	packageDirpathArgKey          = "package-dir"
+	packageDirpathArgDefaultValue = "."
+	packageDirpathArgIsOptional   = true
+
+	dryRunFlagKey      = "dry-run"
+	defaultDryRun      = "false"
+	runFlagKey         = "run"
+	defaultRun         = ""
+	junitOutputFlagKey = "junit-output"
+	defaultJunitOutput = ""
+
+	junitReportFilePermissions os.FileMode = 0644
+
+	passedTestStatus   = "PASS"
+	failedTestStatus   = "FAIL"
+	skippedTestStatus  = "SKIP"
+	testResultFormat   = "%s  %s (%.2fs)"
+	failureIndentation = "    "
+	newline            = "\n"
+)
+
+// TestCmd we only fill in the required struct fields, hence the others remain nil
+// nolint: exhaustruct
+var TestCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.PackageTestCmdStr,
+	ShortDescription: "Runs the tests of a Kurtosis package",
+	LongDescription: "Runs the `test_*` functions defined in the `*_test.star` files of the Kurtosis package in the given directory. " +
+		"Each test runs in a new enclave, destroyed afterwards, and fails if it can't be interpreted, if its plan doesn't validate or if " +
+		"one of its instructions fails, `plan.verify` assertions included. With --dry-run the tests are only interpreted and validated, " +
+		"which doesn't start any service but still needs a running engine; the tests with `plan.verify` assertions are then reported as skipped " +
+		"as these are only checked on execution. Reports pass/fail/skip per test, optionally as a JUnit XML report for CI",
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     dryRunFlagKey,
+			Usage:   "If true, the tests are only interpreted and validated, in a single enclave, and their instructions aren't executed. The tests with `verify` assertions are reported as skipped",
+			Type:    flags.FlagType_Bool,
+			Default: defaultDryRun,
+		},
+		{
+			Key:     runFlagKey,
+			Usage:   "Only run the tests whose function name matches this regular expression",
+			Type:    flags.FlagType_String,
+			Default: defaultRun,
+		},
+		{
+			Key:     junitOutputFlagKey,
+			Usage:   "The path of a file to write the test results to, as a JUnit XML report",
+			Type:    flags.FlagType_String,
+			Default: defaultJunitOutput,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewDirpathArg(
+			packageDirpathArgKey,
+			packageDirpathArgIsOptional,
+			packageDirpathArgDefaultValue,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument with key '%v'", packageDirpathArgKey)
+	}
+	dryRun, err := flags.GetBool(dryRunFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", dryRunFlagKey)
+	}
+	testFunctionNamePattern, err := flags.GetString(runFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", runFlagKey)
+	}
+	junitOutputFilepath, err := flags.GetString(junitOutputFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", junitOutputFlagKey)
+	}
+
+	var testFunctionNameFilter *regexp.Regexp
+	if testFunctionNamePattern != defaultRun {
+		testFunctionNameFilter, err = regexp.Compile(testFunctionNamePattern)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred compiling the '%v' flag value '%s' as a regular expression", runFlagKey, testFunctionNamePattern)
+		}
+	}
+
+	tests, err := kurtosis_package.DiscoverStarlarkTests(packageDirpath, testFunctionNameFilter)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred finding the tests of the package at '%s'", packageDirpath)
+	}
+	if len(tests) == 0 {
+		out.PrintOutLn(fmt.Sprintf("No test found: tests are '%s*' functions in '*%s' files", kurtosis_package.StarlarkTestFunctionPrefix, kurtosis_package.StarlarkTestFileSuffix))
+		return nil
+	}
+
+	results, err := kurtosis_package.RunStarlarkTests(ctx, packageDirpath, tests, dryRun, printTestResult)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the tests of the package at '%s'", packageDirpath)
+	}
+
+	if junitOutputFilepath != defaultJunitOutput {
+		junitReport, err := kurtosis_package.SerializeStarlarkTestResultsToJUnit(results)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred generating the JUnit report of the tests")
+		}
+		if err = os.WriteFile(junitOutputFilepath, junitReport, junitReportFilePermissions); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the JUnit report of the tests to '%s'", junitOutputFilepath)
+		}
+	}
+
+	failedTestsCount := 0
+	skippedTestsCount := 0
+	for _, result := range results {
+		if result.IsFailed() {
+			failedTestsCount++
+		} else if result.IsSkipped() {
+			skippedTestsCount++
+		}
+	}
+	out.PrintOutLn(fmt.Sprintf("%d passed, %d failed, %d skipped", len(results)-failedTestsCount-skippedTestsCount, failedTestsCount, skippedTestsCount))
+	if failedTestsCount > 0 {
+		return stacktrace.NewError("%d of the %d tests of the package at '%s' failed", failedTestsCount, len(results), packageDirpath)
+	}
+	return nil
+}
+
+func printTestResult(result *kurtosis_package.StarlarkTestResult) {
+	if result.IsPassed() {
+		out.PrintOutLn(fmt.Sprintf(testResultFormat, passedTestStatus, result.Test, result.Duration.Seconds()))
+		return
+	}
+	if result.IsSkipped() {
+		out.PrintOutLn(fmt.Sprintf(testResultFormat, skippedTestStatus, result.Test, result.Duration.Seconds()))
+		out.PrintOutLn(failureIndentation + result.SkipMessage)
+		return
+	}
+	out.PrintOutLn(fmt.Sprintf(testResultFormat, failedTestStatus, result.Test, result.Duration.Seconds()))
+	out.PrintOutLn(failureIndentation + strings.ReplaceAll(result.FailureMessage, newline, newline+failureIndentation))
+}
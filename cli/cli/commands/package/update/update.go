@@ -0,0 +1,16 @@
+package update
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/common"
+)
+
+var UpdateCmd = common.NewPackageLockCmd(
+	command_str_consts.PackageUpdateCmdStr,
+	"Updates the dependencies of a Kurtosis package",
+	"Resolves again every package the Kurtosis package in the given directory imports, by interpreting it in a temporary enclave, "+
+		"and rewrites its 'kurtosis.lock' with the commit each of them resolved to. Dependencies without a version in their locator move to the "+
+		"latest commit of their default branch",
+	kurtosis_core_rpc_api_bindings.PackageLockMode_IGNORE_LOCK,
+)
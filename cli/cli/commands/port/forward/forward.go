@@ -0,0 +1,297 @@
+package forward
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"k8s.io/client-go/tools/clientcmd"
+	"os"
+	"os/signal"
+	"path"
+	"strconv"
+)
+
+const (
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey        = "service"
+	isServiceIdentifierArgOptional = false
+	isServiceIdentifierArgGreedy   = false
+
+	portIdentifierArgKey        = "port_id"
+	isPortIdentifierArgOptional = false
+	isPortIdentifierArgGreedy   = false
+
+	localPortArgKey        = "local_port"
+	isLocalPortArgOptional = true
+	isLocalPortArgGreedy   = false
+	// an unset local port defaults to the number of the forwarded port inside the enclave
+	unsetLocalPort = ""
+
+	portNumberBase    = 10
+	portNumberBitSize = 16
+
+	localHostIpAddr         = "127.0.0.1"
+	interruptChanBufferSize = 1
+
+	emptyConfigMasterUrl = ""
+)
+
+var PortForwardCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.PortForwardCmdStr,
+	ShortDescription: "Forward a local port to a service port",
+	LongDescription: "Forward a local port to the port with the given ID of the given service, until interrupted. " +
+		"The local port defaults to the number of the port inside the enclave. On Docker the connections are forwarded to the public port of the service, " +
+		"on Kubernetes through the port-forward API of the Kubernetes API server so no gateway is needed. Only TCP ports can be forwarded, " +
+		"and the forward keeps going across service restarts and lost connections.",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewHistoricalEnclaveIdentifiersArgWithValidationDisabled(
+			enclaveIdentifierArgKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewHistoricalServiceIdentifierArgWithValidationDisabled(
+			serviceIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isServiceIdentifierArgOptional,
+			isServiceIdentifierArgGreedy,
+		),
+		{
+			Key:        portIdentifierArgKey,
+			IsOptional: isPortIdentifierArgOptional,
+			IsGreedy:   isPortIdentifierArgGreedy,
+		},
+		{
+			Key:                   localPortArgKey,
+			IsOptional:            isLocalPortArgOptional,
+			IsGreedy:              isLocalPortArgGreedy,
+			DefaultValue:          unsetLocalPort,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        validateLocalPort,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier using arg key '%v'", serviceIdentifierArgKey)
+	}
+	portIdentifier, err := args.GetNonGreedyArg(portIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the port identifier using arg key '%v'", portIdentifierArgKey)
+	}
+	localPortStr, err := args.GetNonGreedyArg(localPortArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the local port using arg key '%v'", localPortArgKey)
+	}
+
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis cluster config")
+	}
+	if clusterConfig.GetClusterType() == resolved_config.KurtosisClusterType_Kubernetes {
+		if err := forwardKubernetesServicePort(ctx, kurtosisBackend, enclaveIdentifier, serviceIdentifier, portIdentifier, localPortStr); err != nil {
+			return stacktrace.Propagate(err, "An error occurred forwarding port '%v' of service '%v' in enclave '%v'", portIdentifier, serviceIdentifier, enclaveIdentifier)
+		}
+		return nil
+	}
+	if err := forwardDockerServicePort(ctx, enclaveIdentifier, serviceIdentifier, portIdentifier, localPortStr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred forwarding port '%v' of service '%v' in enclave '%v'", portIdentifier, serviceIdentifier, enclaveIdentifier)
+	}
+	return nil
+}
+
+// forwardDockerServicePort forwards the local port to the public port of the service, which Docker binds on the host
+func forwardDockerServicePort(ctx context.Context, enclaveIdentifier string, serviceIdentifier string, portIdentifier string, localPortStr string) error {
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	serviceCtx, err := enclaveCtx.GetServiceContext(serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service context for service '%v'", serviceIdentifier)
+	}
+	privatePort, found := serviceCtx.GetPrivatePorts()[portIdentifier]
+	if !found {
+		return stacktrace.NewError("Port Identifier: '%v' is not found for service: '%v' in enclave '%v'", portIdentifier, serviceIdentifier, enclaveIdentifier)
+	}
+	localPortNumber, err := getLocalPortNumber(localPortStr, privatePort.GetNumber())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the local port to forward")
+	}
+
+	portForward, err := serviceCtx.ForwardPort(ctx, portIdentifier, localPortNumber)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred forwarding local port '%d' to port '%v' of service '%v'", localPortNumber, portIdentifier, serviceIdentifier)
+	}
+	defer portForward.Close()
+
+	printForwardingAndWaitForInterrupt(portForward.GetLocalPortNumber(), portIdentifier, serviceIdentifier, enclaveIdentifier)
+	return nil
+}
+
+// forwardKubernetesServicePort forwards the local port to the port of the service pod through the port-forward API of
+// the Kubernetes API server, looking up the enclave and service with the backend so that no gateway needs to be running
+func forwardKubernetesServicePort(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend, enclaveIdentifier string, serviceIdentifier string, portIdentifier string, localPortStr string) error {
+	enclaveUuid, err := getEnclaveUuid(ctx, kurtosisBackend, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", enclaveIdentifier)
+	}
+	userService, err := getRunningUserService(ctx, kurtosisBackend, enclaveUuid, serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%v' of enclave '%v'", serviceIdentifier, enclaveIdentifier)
+	}
+	privatePort, found := userService.GetPrivatePorts()[portIdentifier]
+	if !found {
+		return stacktrace.NewError("Port Identifier: '%v' is not found for service: '%v' in enclave '%v'", portIdentifier, serviceIdentifier, enclaveIdentifier)
+	}
+	if privatePort.GetTransportProtocol() != port_spec.TransportProtocol_TCP {
+		return stacktrace.NewError("Port '%v' of service '%v' uses transport protocol '%v', but Kubernetes only supports forwarding '%v' ports", portIdentifier, serviceIdentifier, privatePort.GetTransportProtocol(), port_spec.TransportProtocol_TCP)
+	}
+	localPortNumber, err := getLocalPortNumber(localPortStr, privatePort.GetNumber())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the local port to forward")
+	}
+
+	// TODO Store kube config path in configuration and read from there
+	kubeConfigPath := path.Join(os.Getenv("HOME"), ".kube", "config")
+	kubernetesConfig, err := clientcmd.BuildConfigFromFlags(emptyConfigMasterUrl, kubeConfigPath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kubernetes configuration from flags in file '%v'", kubeConfigPath)
+	}
+	connectionProvider, err := connection.NewGatewayConnectionProvider(ctx, kubernetesConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to instantiate a gateway connection provider, instead a non-nil error was returned")
+	}
+	serviceName := string(userService.GetRegistration().GetName())
+	portConnection, err := connectionProvider.ForUserServicePort(string(enclaveUuid), serviceName, portIdentifier, privatePort, localPortNumber)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred forwarding local port '%d' to port '%v' of service '%v'", localPortNumber, portIdentifier, serviceName)
+	}
+	defer portConnection.Stop()
+	localPort, found := portConnection.GetLocalPorts()[portIdentifier]
+	if !found {
+		return stacktrace.NewError("Expected a local port to be forwarded to port '%v' of service '%v', but none was", portIdentifier, serviceName)
+	}
+
+	printForwardingAndWaitForInterrupt(localPort.GetNumber(), portIdentifier, serviceIdentifier, enclaveIdentifier)
+	return nil
+}
+
+func getEnclaveUuid(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend, enclaveIdentifier string) (enclave.EnclaveUUID, error) {
+	enclaves, err := kurtosisBackend.GetEnclaves(ctx, &enclave.EnclaveFilters{
+		UUIDs:    nil,
+		Statuses: nil,
+		Labels:   nil,
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	for enclaveUuid, enclaveObj := range enclaves {
+		if string(enclaveUuid) == enclaveIdentifier || uuid_generator.ShortenedUUIDString(string(enclaveUuid)) == enclaveIdentifier || enclaveObj.GetName() == enclaveIdentifier {
+			return enclaveUuid, nil
+		}
+	}
+	return "", stacktrace.NewError("No enclave matches identifier '%v'", enclaveIdentifier)
+}
+
+func getRunningUserService(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend, enclaveUuid enclave.EnclaveUUID, serviceIdentifier string) (*service.Service, error) {
+	userServices, err := kurtosisBackend.GetUserServices(ctx, enclaveUuid, &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    nil,
+		Statuses: nil,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", enclaveUuid)
+	}
+	for serviceUuid, userService := range userServices {
+		if string(serviceUuid) != serviceIdentifier && uuid_generator.ShortenedUUIDString(string(serviceUuid)) != serviceIdentifier && string(userService.GetRegistration().GetName()) != serviceIdentifier {
+			continue
+		}
+		if userService.GetContainer() == nil || userService.GetContainer().GetStatus() != container.ContainerStatus_Running {
+			return nil, stacktrace.NewError("Service '%v' isn't running, only the ports of running services can be forwarded", serviceIdentifier)
+		}
+		return userService, nil
+	}
+	return nil, stacktrace.NewError("No service of enclave '%v' matches identifier '%v'", enclaveUuid, serviceIdentifier)
+}
+
+func printForwardingAndWaitForInterrupt(localPortNumber uint16, portIdentifier string, serviceIdentifier string, enclaveIdentifier string) {
+	out.PrintOutLn(fmt.Sprintf(
+		"Forwarding %v:%d to port '%v' of service '%v' in enclave '%v', press Ctrl+C to stop",
+		localHostIpAddr,
+		localPortNumber,
+		portIdentifier,
+		serviceIdentifier,
+		enclaveIdentifier,
+	))
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+	<-interruptChan
+}
+
+func getLocalPortNumber(localPortStr string, defaultLocalPortNumber uint16) (uint16, error) {
+	if localPortStr == unsetLocalPort {
+		return defaultLocalPortNumber, nil
+	}
+	localPortNumber, err := strconv.ParseUint(localPortStr, portNumberBase, portNumberBitSize)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Local port '%v' isn't a valid port number", localPortStr)
+	}
+	return uint16(localPortNumber), nil
+}
+
+func validateLocalPort(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	localPortStr, err := args.GetNonGreedyArg(localPortArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the local port using arg key '%v'", localPortArgKey)
+	}
+	if _, err := getLocalPortNumber(localPortStr, 0); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the local port")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package port
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port/forward"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port/print"
 	"github.com/spf13/cobra"
 )
@@ -16,4 +17,5 @@ var PortCmd = &cobra.Command{
 
 func init() {
 	PortCmd.AddCommand(print.PortPrintCmd.MustGetCobraCommand())
+	PortCmd.AddCommand(forward.PortForwardCmd.MustGetCobraCommand())
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/feedback"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/gateway"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/git_auth"
 	_import "github.com/kurtosis-tech/kurtosis/cli/cli/commands/import"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/lint"
@@ -121,6 +122,7 @@ func init() {
 	RootCmd.AddCommand(feedback.FeedbackCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(files.FilesCmd)
 	RootCmd.AddCommand(gateway.GatewayCmd)
+	RootCmd.AddCommand(git_auth.GitAuthCmd)
 	RootCmd.AddCommand(lsp.NewLspCommand())
 	RootCmd.AddCommand(lint.LintCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(port.PortCmd)
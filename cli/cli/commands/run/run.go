@@ -31,6 +31,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_git_auth"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/user_support_constants"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
@@ -54,6 +55,9 @@ const (
 	dryRunFlagKey = "dry-run"
 	defaultDryRun = "false"
 
+	diffFlagKey = "diff"
+	defaultDiff = "false"
+
 	fullUuidsFlagKey       = "full-uuids"
 	fullUuidFlagKeyDefault = "false"
 
@@ -125,6 +129,14 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:    flags.FlagType_Bool,
 			Default: defaultDryRun,
 		},
+		{
+			Key: diffFlagKey,
+			Usage: "If true, nothing is executed and the changes the run would make to the existing enclave passed with the '" +
+				enclaveIdentifierFlagKey + "' flag are printed instead: services and files artifacts added, updated or removed, " +
+				"instructions executed and instructions skipped as they already ran in the enclave",
+			Type:    flags.FlagType_Bool,
+			Default: defaultDiff,
+		},
 		{
 			Key: enclaveIdentifierFlagKey,
 			Usage: "The enclave identifier of the enclave in which the script or package will be ran. " +
@@ -253,6 +265,14 @@ func run(
 		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", dryRunFlagKey)
 	}
 
+	diff, err := flags.GetBool(diffFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", diffFlagKey)
+	}
+	if diff && userRequestedEnclaveIdentifier == autogenerateEnclaveIdentifierKeyword {
+		return stacktrace.NewError("The '%s' flag requires the existing enclave to diff against to be passed with the '%s' flag", diffFlagKey, enclaveIdentifierFlagKey)
+	}
+
 	parallelism, err := flags.GetUint32(parallelismFlagKey)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected a integer flag with key '%v' but none was found; this is an error in Kurtosis!", parallelismFlagKey)
@@ -334,8 +354,14 @@ func run(
 		}
 	}
 
+	gitCredentials, err := kurtosis_git_auth.GetGitAuthStore().GetGitCredentialsForPackage(starlarkScriptOrPackagePath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Git credentials to clone private packages with; run '%s %s' to check them", command_str_consts.GitAuthCmdStr, command_str_consts.GitAuthLsCmdStr)
+	}
+
 	starlarkRunConfig := starlark_run_config.NewRunStarlarkConfig(
 		starlark_run_config.WithDryRun(dryRun),
+		starlark_run_config.WithDiff(diff),
 		starlark_run_config.WithParallelism(castedParallelism),
 		starlark_run_config.WithExperimentalFeatureFlags(experimentalFlags),
 		starlark_run_config.WithMainFunctionName(mainFunctionName),
@@ -344,6 +370,7 @@ func run(
 		starlark_run_config.WithCloudUserId(cloudUserId),
 		starlark_run_config.WithCloudInstanceId(cloudInstanceId),
 		starlark_run_config.WithImageDownloadMode(*imageDownload),
+		starlark_run_config.WithGitCredentials(gitCredentials),
 	)
 
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
@@ -351,9 +378,19 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
 	}
 
-	enclaveCtx, isNewEnclave, err := getOrCreateEnclaveContext(ctx, userRequestedEnclaveIdentifier, kurtosisCtx, metricsClient, isProduction)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", userRequestedEnclaveIdentifier)
+	var enclaveCtx *enclaves.EnclaveContext
+	var isNewEnclave bool
+	if diff {
+		// a diff never creates the enclave, there would be nothing to diff against
+		enclaveCtx, err = kurtosisCtx.GetEnclaveContext(ctx, userRequestedEnclaveIdentifier)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'; the '%s' flag requires an existing enclave", userRequestedEnclaveIdentifier, diffFlagKey)
+		}
+	} else {
+		enclaveCtx, isNewEnclave, err = getOrCreateEnclaveContext(ctx, userRequestedEnclaveIdentifier, kurtosisCtx, metricsClient, isProduction)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", userRequestedEnclaveIdentifier)
+		}
 	}
 
 	if showEnclaveInspect {
@@ -408,7 +445,11 @@ func run(
 		return stacktrace.Propagate(errRunningKurtosis, "An error starting the Kurtosis code execution '%v'", starlarkScriptOrPackagePath)
 	}
 
-	errRunningKurtosis = ReadAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, verbosity, dryRun)
+	errRunningKurtosis = ReadAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, verbosity, dryRun || diff)
+	if diff {
+		// nothing changed in the enclave, there's no service to connect to nor run to track
+		return errRunningKurtosis
+	}
 	var runStatusForMetrics bool
 	if errRunningKurtosis != nil {
 		runStatusForMetrics = runFailed
@@ -0,0 +1,260 @@
+package cp
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/mholt/archiver"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	sourceArgKey        = "source"
+	isSourceArgOptional = false
+	isSourceArgGreedy   = false
+
+	destinationArgKey        = "destination"
+	isDestinationArgOptional = false
+	isDestinationArgGreedy   = false
+
+	fromFilesArtifactFlagKey          = "from-files-artifact"
+	fromFilesArtifactFlagDefaultValue = "false"
+
+	serviceLocationSeparator   = ":"
+	serviceLocationExplanation = "a service location is written as SERVICE:PATH, e.g. 'my-service:/tmp/data'"
+
+	copiedFilesFilename      = "service-files.tgz"
+	copiedFilesPermission    = 0o644
+	destinationDirPermission = 0o777
+
+	defaultTmpDir = ""
+	tmpDirPattern = "tmp-dir-for-service-cp-*"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var ServiceCpCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServiceCpCmdStr,
+	ShortDescription: "Copy files to or from a service",
+	LongDescription: fmt.Sprintf(
+		"Copy a file or directory from your machine into a running service, or from a running service to your machine. "+
+			"Exactly one of the source and the destination must be a service location; %v. "+
+			"With the '%v' flag the source is the identifier of a files artifact of the enclave, which gets copied into the service destination. "+
+			"Files copied to a service are placed inside the destination directory, which is created if it doesn't exist.",
+		serviceLocationExplanation,
+		fromFilesArtifactFlagKey,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     fromFilesArtifactFlagKey,
+			Usage:   "If true, the source is the identifier (name, UUID or shortened UUID) of a files artifact of the enclave instead of a local path. Default false.",
+			Type:    flags.FlagType_Bool,
+			Default: fromFilesArtifactFlagDefaultValue,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:        sourceArgKey,
+			IsOptional: isSourceArgOptional,
+			IsGreedy:   isSourceArgGreedy,
+		},
+		{
+			Key:        destinationArgKey,
+			IsOptional: isDestinationArgOptional,
+			IsGreedy:   isDestinationArgGreedy,
+		},
+	},
+	RunFunc: run,
+}
+
+// copyLocation is one end of a copy, either a path on the local machine or a path inside a service
+type copyLocation struct {
+	// Empty when the location is on the local machine
+	serviceIdentifier string
+	path              string
+}
+
+func (location *copyLocation) isInService() bool {
+	return location.serviceIdentifier != ""
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	sourceStr, err := args.GetNonGreedyArg(sourceArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the source using arg key '%v'", sourceArgKey)
+	}
+
+	destinationStr, err := args.GetNonGreedyArg(destinationArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the destination using arg key '%v'", destinationArgKey)
+	}
+
+	isSourceFilesArtifact, err := flags.GetBool(fromFilesArtifactFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of the '%v' flag", fromFilesArtifactFlagKey)
+	}
+
+	source, destination, err := parseCopyLocations(sourceStr, destinationStr, isSourceFilesArtifact)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the source '%v' and destination '%v' of the copy", sourceStr, destinationStr)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	if isSourceFilesArtifact {
+		if err = enclaveCtx.CopyFilesArtifactToService(ctx, destination.serviceIdentifier, source.path, destination.path); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying files artifact '%v' to '%v' in service '%v'", source.path, destination.path, destination.serviceIdentifier)
+		}
+		out.PrintOutLn(fmt.Sprintf("Files artifact '%v' copied to '%v' in service '%v'", source.path, destination.path, destination.serviceIdentifier))
+		return nil
+	}
+
+	if destination.isInService() {
+		if err = enclaveCtx.CopyFilesToService(ctx, destination.serviceIdentifier, source.path, destination.path); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying '%v' to '%v' in service '%v'", source.path, destination.path, destination.serviceIdentifier)
+		}
+		out.PrintOutLn(fmt.Sprintf("'%v' copied to '%v' in service '%v'", source.path, destination.path, destination.serviceIdentifier))
+		return nil
+	}
+
+	if err = copyFilesFromService(ctx, enclaveCtx, source, destination.path); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying '%v' from service '%v' to '%v'", source.path, source.serviceIdentifier, destination.path)
+	}
+	out.PrintOutLn(fmt.Sprintf("'%v' in service '%v' copied to '%v'", source.path, source.serviceIdentifier, destination.path))
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func parseCopyLocations(sourceStr string, destinationStr string, isSourceFilesArtifact bool) (*copyLocation, *copyLocation, error) {
+	destination, err := parseCopyLocation(destinationStr)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Invalid destination '%v'", destinationStr)
+	}
+
+	if isSourceFilesArtifact {
+		if !destination.isInService() {
+			return nil, nil, stacktrace.NewError("Files artifacts can only be copied to a service, but destination '%v' isn't a service location; %v", destinationStr, serviceLocationExplanation)
+		}
+		if sourceStr == "" {
+			return nil, nil, stacktrace.NewError("The files artifact identifier to copy can't be empty")
+		}
+		source := &copyLocation{
+			serviceIdentifier: "",
+			path:              sourceStr,
+		}
+		return source, destination, nil
+	}
+
+	source, err := parseCopyLocation(sourceStr)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Invalid source '%v'", sourceStr)
+	}
+	if source.isInService() == destination.isInService() {
+		return nil, nil, stacktrace.NewError("Exactly one of source '%v' and destination '%v' must be a service location; %v", sourceStr, destinationStr, serviceLocationExplanation)
+	}
+	return source, destination, nil
+}
+
+// parseCopyLocation treats the string as a SERVICE:PATH location when it has a separator that isn't preceded by a path
+// separator, so local paths containing ':' can still be passed as './some:path'
+func parseCopyLocation(locationStr string) (*copyLocation, error) {
+	serviceIdentifier, locationPath, hasSeparator := strings.Cut(locationStr, serviceLocationSeparator)
+	if !hasSeparator || strings.Contains(serviceIdentifier, string(filepath.Separator)) {
+		if locationStr == "" {
+			return nil, stacktrace.NewError("The path can't be empty")
+		}
+		return &copyLocation{
+			serviceIdentifier: "",
+			path:              locationStr,
+		}, nil
+	}
+	if serviceIdentifier == "" {
+		return nil, stacktrace.NewError("The service identifier of the location can't be empty; %v", serviceLocationExplanation)
+	}
+	if !path.IsAbs(locationPath) {
+		return nil, stacktrace.NewError("The path '%v' in service '%v' must be absolute", locationPath, serviceIdentifier)
+	}
+	return &copyLocation{
+		serviceIdentifier: serviceIdentifier,
+		path:              locationPath,
+	}, nil
+}
+
+func copyFilesFromService(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, source *copyLocation, destinationPath string) error {
+	content, err := enclaveCtx.CopyFilesFromService(ctx, source.serviceIdentifier, source.path)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the files out of the service")
+	}
+
+	absoluteDestinationPath, err := filepath.Abs(destinationPath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the absolute path of destination '%v'", destinationPath)
+	}
+	if err = os.MkdirAll(absoluteDestinationPath, destinationDirPermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating destination directory '%v'", absoluteDestinationPath)
+	}
+
+	tmpDirPath, err := os.MkdirTemp(defaultTmpDir, tmpDirPattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary directory to write the copied files to")
+	}
+	defer os.RemoveAll(tmpDirPath)
+	tmpFilepath := path.Join(tmpDirPath, copiedFilesFilename)
+	if err = os.WriteFile(tmpFilepath, content, copiedFilesPermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the copied files to '%v'", tmpFilepath)
+	}
+	if err = archiver.Unarchive(tmpFilepath, absoluteDestinationPath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred extracting the copied files to '%v'", absoluteDestinationPath)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package cp
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseCopyLocations_LocalToService(t *testing.T) {
+	source, destination, err := parseCopyLocations("./data", "my-service:/tmp/data", false)
+	require.NoError(t, err)
+	require.False(t, source.isInService())
+	require.Equal(t, "./data", source.path)
+	require.Equal(t, "my-service", destination.serviceIdentifier)
+	require.Equal(t, "/tmp/data", destination.path)
+}
+
+func TestParseCopyLocations_ServiceToLocal(t *testing.T) {
+	source, destination, err := parseCopyLocations("my-service:/tmp/data", "./some:dir", false)
+	require.NoError(t, err)
+	require.Equal(t, "my-service", source.serviceIdentifier)
+	require.Equal(t, "/tmp/data", source.path)
+	require.False(t, destination.isInService())
+	require.Equal(t, "./some:dir", destination.path)
+}
+
+func TestParseCopyLocations_FilesArtifactToService(t *testing.T) {
+	source, destination, err := parseCopyLocations("my-artifact", "my-service:/tmp", true)
+	require.NoError(t, err)
+	require.Equal(t, "my-artifact", source.path)
+	require.Equal(t, "my-service", destination.serviceIdentifier)
+}
+
+func TestParseCopyLocations_InvalidCombinations(t *testing.T) {
+	_, _, err := parseCopyLocations("./data", "./other", false)
+	require.Error(t, err)
+
+	_, _, err = parseCopyLocations("a-service:/tmp", "b-service:/tmp", false)
+	require.Error(t, err)
+
+	_, _, err = parseCopyLocations("my-artifact", "./data", true)
+	require.Error(t, err)
+
+	_, _, err = parseCopyLocations("./data", "my-service:relative/path", false)
+	require.Error(t, err)
+
+	_, _, err = parseCopyLocations("./data", ":/tmp", false)
+	require.Error(t, err)
+}
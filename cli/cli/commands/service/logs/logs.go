@@ -26,6 +26,8 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
+	"time"
 )
 
 const (
@@ -43,8 +45,15 @@ const (
 	matchTextFilterFlagKey   = "match"
 	matchRegexFilterFlagKey  = "regex-match"
 	invertMatchFilterFlagKey = "invert-match"
+	sinceFlagKey             = "since"
+	untilFlagKey             = "until"
+	whereFlagKey             = "where"
 
 	defaultMatchTextOrRegexFilterFlagValue = ""
+	defaultTimeBoundFlagValue              = ""
+	defaultWhereFlagValue                  = ""
+
+	whereExpressionsSeparator = "&&"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
@@ -57,6 +66,8 @@ const (
 
 var doNotFilterLogLines *kurtosis_context.LogLineFilter = nil
 
+var noLogLinesTimeBound = time.Time{}
+
 var defaultShouldFollowLogs = strconv.FormatBool(false)
 var defaultInvertMatchFilterFlagValue = strconv.FormatBool(false)
 
@@ -121,6 +132,26 @@ var ServiceLogsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:      flags.FlagType_Bool,
 			Default:   defaultInvertMatchFilterFlagValue,
 		},
+		{
+			Key:     sinceFlagKey,
+			Usage:   "Only return the log lines emitted at or after this time, either an RFC3339 timestamp (e.g. 2023-08-14T14:57:49Z) or a duration relative to now (e.g. 10m or 1h30m). When combined with '" + returnNumLogsFlagKey + "', the last log lines from this time are returned.",
+			Default: defaultTimeBoundFlagValue,
+		},
+		{
+			Key:     untilFlagKey,
+			Usage:   "Only return the log lines emitted at or before this time, either an RFC3339 timestamp (e.g. 2023-08-14T14:57:49Z) or a duration relative to now (e.g. 10m or 1h30m).",
+			Default: defaultTimeBoundFlagValue,
+		},
+		{
+			Key: whereFlagKey,
+			Usage: fmt.Sprintf(
+				"Only return the log lines emitted as JSON objects whose fields match these predicates, joined with '%s'. Each predicate is of the form '<JSON path> <operator> <value>' where the operator is one of ==, !=, =~ (regex match), >, >=, < and <= (e.g. --%s '$.level == error %s $.request.status >= 500')",
+				whereExpressionsSeparator,
+				whereFlagKey,
+				whereExpressionsSeparator,
+			),
+			Default: defaultWhereFlagValue,
+		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewHistoricalEnclaveIdentifiersArgWithValidationDisabled(
@@ -186,6 +217,36 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the invert match flag using key '%v'", invertMatchFilterFlagKey)
 	}
 
+	sinceStr, err := flags.GetString(sinceFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the since flag using key '%v'", sinceFlagKey)
+	}
+
+	untilStr, err := flags.GetString(untilFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the until flag using key '%v'", untilFlagKey)
+	}
+
+	whereStr, err := flags.GetString(whereFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the where flag using key '%v'", whereFlagKey)
+	}
+
+	now := time.Now()
+	since, err := getTimeFromTimeBoundFlagValue(sinceStr, now)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the '%s' flag value '%s'", sinceFlagKey, sinceStr)
+	}
+	until, err := getTimeFromTimeBoundFlagValue(untilStr, now)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the '%s' flag value '%s'", untilFlagKey, untilStr)
+	}
+
+	logLineFieldFilters, err := getLogLineFieldFiltersFromWhereFlagValue(whereStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the log line field filters from the '%s' flag value '%s'", whereFlagKey, whereStr)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
@@ -202,7 +263,7 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the log line filter using these filter flag values '%s=%s', '%s=%s', '%s=%v'", matchTextFilterFlagKey, matchTextStr, matchRegexFilterFlagKey, matchRegexStr, invertMatchFilterFlagKey, invertMatch)
 	}
 
-	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, enclaveIdentifier, userServiceUuids, shouldFollowLogs, shouldReturnAllLogs, numLogLines, logLineFilter)
+	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, enclaveIdentifier, userServiceUuids, shouldFollowLogs, shouldReturnAllLogs, numLogLines, logLineFilter, logLineFieldFilters, since, until)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting user service logs from user services with UUIDs '%+v' in enclave '%v' and with follow logs value '%v'", userServiceUuids, enclaveIdentifier, shouldFollowLogs)
 	}
@@ -279,6 +340,42 @@ func getLogLineFilterFromFilterFlagValues(matchTextStr string, matchRegexStr str
 	)
 }
 
+// The value is either an RFC3339 timestamp or a duration before [now]; an empty value leaves the time range unbounded
+func getTimeFromTimeBoundFlagValue(timeBoundStr string, now time.Time) (time.Time, error) {
+	if timeBoundStr == defaultTimeBoundFlagValue {
+		return noLogLinesTimeBound, nil
+	}
+
+	if timestamp, err := time.Parse(time.RFC3339, timeBoundStr); err == nil {
+		return timestamp, nil
+	}
+
+	durationBeforeNow, err := time.ParseDuration(timeBoundStr)
+	if err != nil {
+		return noLogLinesTimeBound, stacktrace.NewError("'%s' is neither an RFC3339 timestamp, e.g. 2023-08-14T14:57:49Z, nor a duration, e.g. 10m or 1h30m", timeBoundStr)
+	}
+	if durationBeforeNow < 0 {
+		return noLogLinesTimeBound, stacktrace.NewError("The duration '%s' is negative, but it should be the time before now", timeBoundStr)
+	}
+	return now.Add(-durationBeforeNow), nil
+}
+
+func getLogLineFieldFiltersFromWhereFlagValue(whereStr string) ([]*kurtosis_context.LogLineFieldFilter, error) {
+	logLineFieldFilters := []*kurtosis_context.LogLineFieldFilter{}
+	if strings.TrimSpace(whereStr) == defaultWhereFlagValue {
+		return logLineFieldFilters, nil
+	}
+
+	for _, expression := range strings.Split(whereStr, whereExpressionsSeparator) {
+		logLineFieldFilter, err := kurtosis_context.NewLogLineFieldFilterFromExpression(expression)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the field filter expression '%s'", expression)
+		}
+		logLineFieldFilters = append(logLineFieldFilters, logLineFieldFilter)
+	}
+	return logLineFieldFilters, nil
+}
+
 // This function works makes the best effort to get the most accurate enclave uuid and service uuid for the passed values
 // defaults to assuming the passed value are uuids
 // this function will be a lot cleaner after the object ids are stored in a database
@@ -4,6 +4,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestDefiningLogLineFilterFromFlags_doNotFilter(t *testing.T) {
@@ -75,3 +76,42 @@ func TestDefiningLogLineFilterFromFlags_validInvertMatchRegex(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedLogLineFilter, logLineFilter)
 }
+
+func TestGetTimeFromTimeBoundFlagValue(t *testing.T) {
+	now := time.Date(2023, time.August, 14, 15, 0, 0, 0, time.UTC)
+
+	timeBound, err := getTimeFromTimeBoundFlagValue("", now)
+	require.NoError(t, err)
+	require.True(t, timeBound.IsZero())
+
+	timeBound, err = getTimeFromTimeBoundFlagValue("2023-08-14T14:57:49Z", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, time.August, 14, 14, 57, 49, 0, time.UTC), timeBound)
+
+	timeBound, err = getTimeFromTimeBoundFlagValue("1h30m", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, time.August, 14, 13, 30, 0, 0, time.UTC), timeBound)
+
+	_, err = getTimeFromTimeBoundFlagValue("-10m", now)
+	require.Error(t, err)
+
+	_, err = getTimeFromTimeBoundFlagValue("yesterday", now)
+	require.Error(t, err)
+}
+
+func TestGetLogLineFieldFiltersFromWhereFlagValue(t *testing.T) {
+	logLineFieldFilters, err := getLogLineFieldFiltersFromWhereFlagValue("")
+	require.NoError(t, err)
+	require.Empty(t, logLineFieldFilters)
+
+	expectedLogLineFieldFilters := []*kurtosis_context.LogLineFieldFilter{
+		kurtosis_context.NewFieldEqualsLogLineFieldFilter("$.level", "error"),
+		kurtosis_context.NewFieldGreaterThanOrEqualLogLineFieldFilter("$.request.status", "500"),
+	}
+	logLineFieldFilters, err = getLogLineFieldFiltersFromWhereFlagValue("$.level == error && $.request.status >= 500")
+	require.NoError(t, err)
+	require.Equal(t, expectedLogLineFieldFilters, logLineFieldFilters)
+
+	_, err = getLogLineFieldFiltersFromWhereFlagValue("$.level == error && $.request.status")
+	require.Error(t, err)
+}
@@ -8,12 +8,14 @@ package service
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/cp"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/exec"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/logs"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/rm"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/shell"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/start"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/stats"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/stop"
 	"github.com/spf13/cobra"
 )
@@ -35,4 +37,6 @@ func init() {
 	ServiceCmd.AddCommand(start.ServiceStartCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(stop.ServiceStopCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(inspect.ServiceInspectCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(cp.ServiceCpCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(stats.ServiceStatsCmd.MustGetCobraCommand())
 }
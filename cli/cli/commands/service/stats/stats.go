@@ -0,0 +1,262 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/docker/go-units"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey        = "service"
+	isServiceIdentifierArgOptional = true
+	isServiceIdentifierArgGreedy   = true
+
+	noStreamFlagKey = "no-stream"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	serviceNameHeader = "Name"
+	cpuHeader         = "CPU %"
+	memoryUsageHeader = "Mem usage / limit"
+	memoryShareHeader = "Mem %"
+	networkIOHeader   = "Net I/O"
+	blockIOHeader     = "Block I/O"
+
+	unavailableStatStr = "-"
+	percentFormat      = "%.2f%%"
+	ioFormat           = "%v / %v"
+
+	// Moves the cursor to the top left corner of the terminal and clears it, so each sample replaces the previous one
+	clearScreenSequence = "\033[H\033[2J"
+
+	millicoresPerCpu  = 1000
+	percentMultiplier = 100
+
+	interruptChanBufferSize = 5
+)
+
+var defaultNoStream = strconv.FormatBool(false)
+
+var ServiceStatsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServiceStatsCmdStr,
+	ShortDescription: "Show the resource usage of services",
+	LongDescription: fmt.Sprintf(
+		"Show a live table of the CPU, memory, network and block IO usage of the given services in the enclave, or of all its running services if none is given. "+
+			"The table refreshes until stopped, unless the '%v' flag is set. "+
+			"The network and block IO are cumulative since the service started, and aren't available on Kubernetes.",
+		noStreamFlagKey,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isServiceIdentifierArgOptional,
+			isServiceIdentifierArgGreedy,
+		),
+	},
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     noStreamFlagKey,
+			Usage:   "Prints a single sample of the resource usage instead of refreshing it until stopped",
+			Type:    flags.FlagType_Bool,
+			Default: defaultNoStream,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifiers, err := args.GetGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier values using key '%v'", serviceIdentifierArgKey)
+	}
+
+	noStream, err := flags.GetBool(noStreamFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the no-stream flag using key '%v'", noStreamFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+
+	if noStream {
+		servicesStats, err := enclaveCtx.GetServicesStats(ctx, serviceIdentifiers)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the stats of the services in enclave '%v'", enclaveIdentifier)
+		}
+		if err := printServicesStats(servicesStats); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the stats of the services in enclave '%v'", enclaveIdentifier)
+		}
+		return nil
+	}
+
+	servicesStatsChan, servicesStatsErrChan, cancelStreamServicesStatsFunc, err := enclaveCtx.StreamServicesStats(ctx, serviceIdentifiers)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred streaming the stats of the services in enclave '%v'", enclaveIdentifier)
+	}
+	defer cancelStreamServicesStatsFunc()
+
+	// This channel will receive a signal when the user presses an interrupt
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+
+	for {
+		select {
+		case servicesStatsResponse, isChanOpen := <-servicesStatsChan:
+			if !isChanOpen {
+				// the error is sent before the stats channel gets closed
+				if streamErr := <-servicesStatsErrChan; streamErr != nil {
+					return stacktrace.Propagate(streamErr, "The stream of the stats of the services in enclave '%v' ended with an error", enclaveIdentifier)
+				}
+				return nil
+			}
+			if _, err := fmt.Fprint(out.GetOut(), clearScreenSequence); err != nil {
+				logrus.Debugf("An error occurred clearing the terminal before printing the stats of the services:\n%v", err)
+			}
+			if err := printServicesStats(servicesStatsResponse); err != nil {
+				return stacktrace.Propagate(err, "An error occurred printing the stats of the services in enclave '%v'", enclaveIdentifier)
+			}
+		case <-interruptChan:
+			logrus.Debugf("Received signal interruption in service stats Kurtosis CLI command")
+			return nil
+		}
+	}
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func printServicesStats(servicesStats *kurtosis_core_rpc_api_bindings.GetServicesStatsResponse) error {
+	tablePrinter := output_printers.NewTablePrinter(
+		serviceNameHeader,
+		cpuHeader,
+		memoryUsageHeader,
+		memoryShareHeader,
+		networkIOHeader,
+		blockIOHeader,
+	)
+
+	serviceNames := []string{}
+	for serviceName := range servicesStats.GetServiceStats() {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	// the services whose stats couldn't be sampled still get a row, so they don't silently vanish from the table
+	for serviceName := range servicesStats.GetServiceErrors() {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	for _, serviceName := range serviceNames {
+		row := getUnavailableServiceStatsRow(serviceName)
+		if serviceStats, found := servicesStats.GetServiceStats()[serviceName]; found {
+			row = getServiceStatsRow(serviceName, serviceStats)
+		}
+		if err := tablePrinter.AddRow(row...); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding the row of service '%v' to the table; this is a bug in Kurtosis", serviceName)
+		}
+	}
+	tablePrinter.Print()
+
+	for _, serviceName := range serviceNames {
+		serviceErrStr, found := servicesStats.GetServiceErrors()[serviceName]
+		if !found {
+			continue
+		}
+		serviceErr := out.GetErrorMessageToBeDisplayedOnCli(errors.New(serviceErrStr))
+		out.PrintErrLn(fmt.Sprintf("The stats of service '%v' couldn't be sampled:\n%v", serviceName, serviceErr))
+	}
+	return nil
+}
+
+func getUnavailableServiceStatsRow(serviceName string) []string {
+	return []string{
+		serviceName,
+		unavailableStatStr,
+		unavailableStatStr,
+		unavailableStatStr,
+		unavailableStatStr,
+		unavailableStatStr,
+	}
+}
+
+func getServiceStatsRow(serviceName string, serviceStats *kurtosis_core_rpc_api_bindings.ServiceStats) []string {
+	cpuStr := fmt.Sprintf(percentFormat, float64(serviceStats.GetCpuMillicores())/millicoresPerCpu*percentMultiplier)
+
+	memoryUsageStr := units.BytesSize(float64(serviceStats.GetMemoryUsageBytes()))
+	memoryLimitStr := unavailableStatStr
+	memoryShareStr := unavailableStatStr
+	if serviceStats.MemoryLimitBytes != nil {
+		memoryLimitStr = units.BytesSize(float64(serviceStats.GetMemoryLimitBytes()))
+		memoryShareStr = fmt.Sprintf(percentFormat, float64(serviceStats.GetMemoryUsageBytes())/float64(serviceStats.GetMemoryLimitBytes())*percentMultiplier)
+	}
+
+	return []string{
+		serviceName,
+		cpuStr,
+		fmt.Sprintf(ioFormat, memoryUsageStr, memoryLimitStr),
+		memoryShareStr,
+		formatIOStats(serviceStats.NetworkReceivedBytes, serviceStats.NetworkTransmittedBytes),
+		formatIOStats(serviceStats.BlockReadBytes, serviceStats.BlockWrittenBytes),
+	}
+}
+
+func formatIOStats(maybeInBytes *uint64, maybeOutBytes *uint64) string {
+	if maybeInBytes == nil || maybeOutBytes == nil {
+		return unavailableStatStr
+	}
+	return fmt.Sprintf(ioFormat, units.HumanSize(float64(*maybeInBytes)), units.HumanSize(float64(*maybeOutBytes)))
+}
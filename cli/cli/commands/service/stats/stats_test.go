@@ -0,0 +1,42 @@
+package stats
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetServiceStatsRow(t *testing.T) {
+	memoryLimitBytes := uint64(1024 * 1024 * 1024)
+	networkReceivedBytes := uint64(1_500)
+	networkTransmittedBytes := uint64(2_000_000)
+	blockReadBytes := uint64(0)
+	blockWrittenBytes := uint64(4_000)
+	serviceStats := &kurtosis_core_rpc_api_bindings.ServiceStats{ //nolint:exhaustruct
+		CpuMillicores:           250,
+		MemoryUsageBytes:        256 * 1024 * 1024,
+		MemoryLimitBytes:        &memoryLimitBytes,
+		NetworkReceivedBytes:    &networkReceivedBytes,
+		NetworkTransmittedBytes: &networkTransmittedBytes,
+		BlockReadBytes:          &blockReadBytes,
+		BlockWrittenBytes:       &blockWrittenBytes,
+	}
+
+	row := getServiceStatsRow("my-service", serviceStats)
+	require.Equal(t, []string{"my-service", "25.00%", "256MiB / 1GiB", "25.00%", "1.5kB / 2MB", "0B / 4kB"}, row)
+}
+
+func TestGetServiceStatsRow_NoLimitNorIOStats(t *testing.T) {
+	serviceStats := &kurtosis_core_rpc_api_bindings.ServiceStats{ //nolint:exhaustruct
+		CpuMillicores:    1_500,
+		MemoryUsageBytes: 1024,
+	}
+
+	row := getServiceStatsRow("my-service", serviceStats)
+	require.Equal(t, []string{"my-service", "150.00%", "1KiB / -", "-", "-", "-"}, row)
+}
+
+func TestGetUnavailableServiceStatsRow(t *testing.T) {
+	row := getUnavailableServiceStatsRow("my-service")
+	require.Equal(t, []string{"my-service", "-", "-", "-", "-", "-"}, row)
+}
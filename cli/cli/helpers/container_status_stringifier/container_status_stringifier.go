@@ -8,6 +8,13 @@ import (
 var (
 	colorizeRunning = color.New(color.FgGreen).SprintFunc()
 	colorizeStopped = color.New(color.FgYellow).SprintFunc()
+
+	colorizeHealthy   = color.New(color.FgGreen).SprintFunc()
+	colorizeUnhealthy = color.New(color.FgRed).SprintFunc()
+)
+
+const (
+	unknownHealthStatusStr = "UNKNOWN"
 )
 
 func ContainerStatusStringifier(containerStatus kurtosis_core_rpc_api_bindings.Container_Status) string {
@@ -21,3 +28,15 @@ func ContainerStatusStringifier(containerStatus kurtosis_core_rpc_api_bindings.C
 		return containerStatusStr
 	}
 }
+
+func HealthStatusStringifier(healthStatus kurtosis_core_rpc_api_bindings.ServiceHealthStatus) string {
+	healthStatusStr := kurtosis_core_rpc_api_bindings.ServiceHealthStatus_name[int32(healthStatus)]
+	switch healthStatus {
+	case kurtosis_core_rpc_api_bindings.ServiceHealthStatus_HEALTHY:
+		return colorizeHealthy(healthStatusStr)
+	case kurtosis_core_rpc_api_bindings.ServiceHealthStatus_UNHEALTHY:
+		return colorizeUnhealthy(healthStatusStr)
+	default:
+		return unknownHealthStatusStr
+	}
+}
@@ -0,0 +1,76 @@
+package enclave_labels
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+	"strings"
+)
+
+const (
+	// The flag the enclave commands use to set or select enclave labels
+	LabelsFlagKey       = "label"
+	LabelsFlagShorthand = "l"
+
+	labelKeyValueDelimiter     = "="
+	labelDeclarationsDelimiter = ","
+
+	// Each label should be KEY=VALUE, which means we should have two components to each label declaration
+	expectedNumberKeyValueComponentsInLabelDeclaration = 2
+)
+
+// LabelsFlagFormat describes the format of the labels flag value, to be used in the flag usage
+var LabelsFlagFormat = fmt.Sprintf(
+	"\"KEY1%vVALUE1%vKEY2%vVALUE2\"",
+	labelKeyValueDelimiter,
+	labelDeclarationsDelimiter,
+	labelKeyValueDelimiter,
+)
+
+// ParseLabelsStr parses a string in the form KEY1=VALUE1,KEY2=VALUE2 into a label map, which is empty if the string is blank
+func ParseLabelsStr(labelsStr string) (map[string]string, error) {
+	result := map[string]string{}
+	if strings.TrimSpace(labelsStr) == "" {
+		return result, nil
+	}
+
+	for _, labelDeclarationStr := range strings.Split(labelsStr, labelDeclarationsDelimiter) {
+		if len(strings.TrimSpace(labelDeclarationStr)) == 0 {
+			continue
+		}
+
+		labelKeyValueComponents := strings.SplitN(labelDeclarationStr, labelKeyValueDelimiter, expectedNumberKeyValueComponentsInLabelDeclaration)
+		if len(labelKeyValueComponents) < expectedNumberKeyValueComponentsInLabelDeclaration {
+			return nil, stacktrace.NewError("Label declaration string '%v' must be of the form KEY%vVALUE", labelDeclarationStr, labelKeyValueDelimiter)
+		}
+		key := strings.TrimSpace(labelKeyValueComponents[0])
+		value := strings.TrimSpace(labelKeyValueComponents[1])
+		if key == "" {
+			return nil, stacktrace.NewError("Label declaration string '%v' has an empty key", labelDeclarationStr)
+		}
+
+		preexistingValue, found := result[key]
+		if found {
+			return nil, stacktrace.NewError(
+				"Cannot declare label '%v' with value '%v' because the key has previously been assigned to value '%v'",
+				key,
+				value,
+				preexistingValue,
+			)
+		}
+
+		result[key] = value
+	}
+
+	return result, nil
+}
+
+// GetLabelsStr is the inverse of ParseLabelsStr, with the labels sorted by key so the output is stable
+func GetLabelsStr(labels map[string]string) string {
+	labelDeclarationStrs := []string{}
+	for key, value := range labels {
+		labelDeclarationStrs = append(labelDeclarationStrs, key+labelKeyValueDelimiter+value)
+	}
+	sort.Strings(labelDeclarationStrs)
+	return strings.Join(labelDeclarationStrs, labelDeclarationsDelimiter)
+}
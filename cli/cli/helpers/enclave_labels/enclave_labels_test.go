@@ -0,0 +1,37 @@
+package enclave_labels
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseLabelsStr(t *testing.T) {
+	labels, err := ParseLabelsStr("team=infra, branch=main,empty=")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "infra", "branch": "main", "empty": ""}, labels)
+
+	labels, err = ParseLabelsStr("")
+	require.NoError(t, err)
+	require.Empty(t, labels)
+}
+
+func TestParseLabelsStr_InvalidDeclarations(t *testing.T) {
+	_, err := ParseLabelsStr("team")
+	require.Error(t, err)
+
+	_, err = ParseLabelsStr("=infra")
+	require.Error(t, err)
+
+	_, err = ParseLabelsStr("team=infra,team=other")
+	require.Error(t, err)
+}
+
+func TestGetLabelsStr(t *testing.T) {
+	labels := map[string]string{"team": "infra", "branch": "main"}
+	labelsStr := GetLabelsStr(labels)
+	require.Equal(t, "branch=main,team=infra", labelsStr)
+
+	parsedLabels, err := ParseLabelsStr(labelsStr)
+	require.NoError(t, err)
+	require.Equal(t, labels, parsedLabels)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/user_support_constants"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/stacktrace"
@@ -69,6 +70,9 @@ type engineExistenceGuarantor struct {
 	poolSize uint8
 
 	enclaveEnvVars string
+
+	// Where the logs aggregator sends the service logs to, on top of the logs storage
+	logsSinks logs_aggregator.Sinks
 }
 
 func newEngineExistenceGuarantorWithDefaultVersion(
@@ -83,6 +87,7 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 	onBastionHost bool,
 	poolSize uint8,
 	enclaveEnvVars string,
+	logsSinks logs_aggregator.Sinks,
 ) *engineExistenceGuarantor {
 	return newEngineExistenceGuarantorWithCustomVersion(
 		ctx,
@@ -97,6 +102,7 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 		onBastionHost,
 		poolSize,
 		enclaveEnvVars,
+		logsSinks,
 	)
 }
 
@@ -113,6 +119,7 @@ func newEngineExistenceGuarantorWithCustomVersion(
 	onBastionHost bool,
 	poolSize uint8,
 	enclaveEnvVars string,
+	logsSinks logs_aggregator.Sinks,
 ) *engineExistenceGuarantor {
 	return &engineExistenceGuarantor{
 		ctx:                                  ctx,
@@ -129,6 +136,7 @@ func newEngineExistenceGuarantorWithCustomVersion(
 		onBastionHost:                             onBastionHost,
 		poolSize:                                  poolSize,
 		enclaveEnvVars:                            enclaveEnvVars,
+		logsSinks:                                 logsSinks,
 	}
 }
 
@@ -158,6 +166,7 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.onBastionHost,
 			guarantor.poolSize,
 			guarantor.enclaveEnvVars,
+			guarantor.logsSinks,
 		)
 	} else {
 		_, _, engineLaunchErr = guarantor.engineServerLauncher.LaunchWithCustomVersion(
@@ -171,6 +180,7 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.onBastionHost,
 			guarantor.poolSize,
 			guarantor.enclaveEnvVars,
+			guarantor.logsSinks,
 		)
 	}
 	if engineLaunchErr != nil {
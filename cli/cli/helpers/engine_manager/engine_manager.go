@@ -17,6 +17,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/stacktrace"
@@ -42,6 +43,7 @@ type EngineManager struct {
 	clusterConfig                             *resolved_config.KurtosisClusterConfig
 	onBastionHost                             bool
 	enclaveEnvVars                            string
+	logsSinks                                 logs_aggregator.Sinks
 	// Make engine IP, port, and protocol configurable in the future
 }
 
@@ -101,6 +103,7 @@ func NewEngineManager(ctx context.Context) (*EngineManager, error) {
 		clusterConfig:  clusterConfig,
 		onBastionHost:  onBastionHost,
 		enclaveEnvVars: enclaveEnvVars,
+		logsSinks:      kurtosisConfig.GetLogsSinks(),
 	}, nil
 }
 
@@ -191,6 +194,7 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(ctx cont
 		manager.onBastionHost,
 		poolSize,
 		manager.enclaveEnvVars,
+		manager.logsSinks,
 	)
 	// TODO Need to handle the Kubernetes case, where a gateway needs to be started after the engine is started but
 	//  before we can return an EngineClient
@@ -222,6 +226,7 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 		manager.onBastionHost,
 		poolSize,
 		manager.enclaveEnvVars,
+		manager.logsSinks,
 	)
 	engineClient, engineClientCloseFunc, err := manager.startEngineWithGuarantor(ctx, status, engineGuarantor)
 	if err != nil {
@@ -13,6 +13,8 @@ const (
 
 	kurtosisClusterSettingFilename = "cluster-setting"
 
+	gitAuthFilename = "git-auth.json"
+
 	latestCLIReleaseVersionCacheFilename = "latest-cli-release-version-cache"
 
 	metricsUserIDFilename = "metrics-user-id"
@@ -66,6 +68,16 @@ func GetKurtosisClusterSettingFilepath() (string, error) {
 	return kurtosisClusterSettingFilepath, nil
 }
 
+// Get the filepath where the credentials used to clone private Git repositories are saved
+func GetGitAuthFilepath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(gitAuthFilename)
+	gitAuthFilepath, err := xdg.DataFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the Git auth filepath from relative path '%v'", xdgRelFilepath)
+	}
+	return gitAuthFilepath, nil
+}
+
 func GetMetricsUserIdFilepath() (string, error) {
 	xdgRelFilepath := getRelativeFilepathForXDG(metricsUserIDFilename)
 	filepath, err := xdg.DataFile(xdgRelFilepath)
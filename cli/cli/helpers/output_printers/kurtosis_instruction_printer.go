@@ -29,6 +29,14 @@ const (
 
 	codeCommentPrefix = "# "
 
+	planDiffIndentation          = "  "
+	planDiffAddedPrefix          = "+ "
+	planDiffUpdatedPrefix        = "~ "
+	planDiffRemovedPrefix        = "- "
+	planDiffNoChangeMsg          = "No changes. The enclave already matches the Starlark code."
+	planDiffServicesHeader       = "Services:"
+	planDiffFilesArtifactsHeader = "Files artifacts:"
+
 	newlineChar = "\n"
 )
 
@@ -40,6 +48,10 @@ var (
 	colorizeRunSuccessfulMsg = color.New(color.FgGreen).SprintFunc()
 	colorizeInfo             = color.New(color.FgCyan).SprintFunc()
 
+	colorizePlanDiffAdded   = color.New(color.FgGreen).SprintFunc()
+	colorizePlanDiffUpdated = color.New(color.FgYellow).SprintFunc()
+	colorizePlanDiffRemoved = color.New(color.FgRed).SprintFunc()
+
 	colorizeProgressBarIsDone    = color.New(color.FgGreen).SprintFunc()
 	colorizeProgressBarRemaining = color.New(color.FgWhite).SprintFunc()
 )
@@ -142,6 +154,12 @@ func (printer *ExecutionPrinter) PrintKurtosisExecutionResponseLineToStdOut(resp
 			progressBarStr := formatProgressBar(progress.GetCurrentStepNumber(), progress.GetTotalSteps(), progressBarChar)
 			printer.spinner.Suffix = fmt.Sprintf("   %s %s", progressBarStr, progressMessageStr)
 		}
+	} else if responseLine.GetPlanDiff() != nil {
+		formattedPlanDiff := formatPlanDiff(responseLine.GetPlanDiff(), verbosity)
+		formattedPlanDiffWithNewline := fmt.Sprintf("\n%s", formattedPlanDiff)
+		if err := printer.printPersistentLineToStdOut(formattedPlanDiffWithNewline); err != nil {
+			return stacktrace.Propagate(err, "Error printing the plan diff: \n%v", formattedPlanDiff)
+		}
 	} else if responseLine.GetRunFinishedEvent() != nil {
 		formattedRunOutputMessage := formatRunOutput(responseLine.GetRunFinishedEvent(), dryRun)
 		formattedRunOutputMessageWithNewline := fmt.Sprintf("\n%s", formattedRunOutputMessage)
@@ -254,6 +272,51 @@ func formatInstructionToExecutable(instruction *kurtosis_core_rpc_api_bindings.S
 	return multiLineInstruction.String()
 }
 
+// formatPlanDiff prints the components changed by a run first, the way a Terraform plan does, followed by the
+// instructions that the run would execute and the ones it would skip as they already ran in the enclave
+func formatPlanDiff(planDiff *kurtosis_core_rpc_api_bindings.StarlarkPlanDiff, verbosity run.Verbosity) string {
+	if len(planDiff.GetInstructionsToExecute()) == 0 {
+		return colorizeRunSuccessfulMsg(planDiffNoChangeMsg)
+	}
+	formattedPlanDiffLines := []string{}
+	if len(planDiff.GetServiceChanges()) > 0 {
+		formattedPlanDiffLines = append(formattedPlanDiffLines, planDiffServicesHeader)
+		formattedPlanDiffLines = append(formattedPlanDiffLines, formatPlanDiffComponentChanges(planDiff.GetServiceChanges())...)
+	}
+	if len(planDiff.GetFilesArtifactChanges()) > 0 {
+		formattedPlanDiffLines = append(formattedPlanDiffLines, planDiffFilesArtifactsHeader)
+		formattedPlanDiffLines = append(formattedPlanDiffLines, formatPlanDiffComponentChanges(planDiff.GetFilesArtifactChanges())...)
+	}
+	formattedPlanDiffLines = append(formattedPlanDiffLines, fmt.Sprintf("Instructions to execute: %d", len(planDiff.GetInstructionsToExecute())))
+	for _, instruction := range planDiff.GetInstructionsToExecute() {
+		formattedPlanDiffLines = append(formattedPlanDiffLines, formatInstruction(instruction, verbosity))
+	}
+	if len(planDiff.GetSkippedInstructions()) > 0 {
+		formattedPlanDiffLines = append(formattedPlanDiffLines, fmt.Sprintf("Instructions skipped as they already ran in the enclave: %d", len(planDiff.GetSkippedInstructions())))
+		for _, instruction := range planDiff.GetSkippedInstructions() {
+			formattedPlanDiffLines = append(formattedPlanDiffLines, formatInstruction(instruction, verbosity))
+		}
+	}
+	return strings.Join(formattedPlanDiffLines, newlineChar)
+}
+
+func formatPlanDiffComponentChanges(componentChanges []*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange) []string {
+	formattedComponentChanges := []string{}
+	for _, componentChange := range componentChanges {
+		var formattedComponentChange string
+		switch componentChange.GetChangeType() {
+		case kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_ADDED:
+			formattedComponentChange = colorizePlanDiffAdded(planDiffAddedPrefix + componentChange.GetName())
+		case kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_UPDATED:
+			formattedComponentChange = colorizePlanDiffUpdated(planDiffUpdatedPrefix + componentChange.GetName())
+		case kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_REMOVED:
+			formattedComponentChange = colorizePlanDiffRemoved(planDiffRemovedPrefix + componentChange.GetName())
+		}
+		formattedComponentChanges = append(formattedComponentChanges, planDiffIndentation+formattedComponentChange)
+	}
+	return formattedComponentChanges
+}
+
 func formatProgressMessage(messageLines []string) string {
 	return strings.Join(messageLines, newlineChar)
 }
@@ -156,3 +156,42 @@ func TestFormatRunOutput_Failure_ExecutedRun(t *testing.T) {
 	expectedMessage := `Error encountered running Starlark code.`
 	require.Equal(t, expectedMessage, message)
 }
+
+func TestFormatPlanDiff(t *testing.T) {
+	planDiff := binding_constructors.NewStarlarkPlanDiff(
+		[]*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange{
+			binding_constructors.NewStarlarkPlanDiffComponentChange("cache", kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_ADDED),
+			binding_constructors.NewStarlarkPlanDiffComponentChange("database", kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_UPDATED),
+			binding_constructors.NewStarlarkPlanDiffComponentChange("web", kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_REMOVED),
+		},
+		[]*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange{
+			binding_constructors.NewStarlarkPlanDiffComponentChange("config", kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_ADDED),
+		},
+		[]*kurtosis_core_rpc_api_bindings.StarlarkInstruction{testInstruction()},
+		[]*kurtosis_core_rpc_api_bindings.StarlarkInstruction{testInstruction(), testInstruction()},
+	)
+	formattedPlanDiff := formatPlanDiff(planDiff, run.Brief)
+	expectedResult := `Services:
+  + cache
+  ~ database
+  - web
+Files artifacts:
+  + config
+Instructions to execute: 1
+> my_instruction foo kwarg1=serviceA
+Instructions skipped as they already ran in the enclave: 2
+> my_instruction foo kwarg1=serviceA
+> my_instruction foo kwarg1=serviceA`
+	require.Equal(t, expectedResult, formattedPlanDiff)
+}
+
+func TestFormatPlanDiff_NoChange(t *testing.T) {
+	planDiff := binding_constructors.NewStarlarkPlanDiff(
+		[]*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange{},
+		[]*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange{},
+		[]*kurtosis_core_rpc_api_bindings.StarlarkInstruction{},
+		[]*kurtosis_core_rpc_api_bindings.StarlarkInstruction{testInstruction()},
+	)
+	formattedPlanDiff := formatPlanDiff(planDiff, run.Brief)
+	require.Equal(t, "No changes. The enclave already matches the Starlark code.", formattedPlanDiff)
+}
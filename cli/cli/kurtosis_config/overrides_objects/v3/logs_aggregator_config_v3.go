@@ -0,0 +1,21 @@
+package v3
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type LogsAggregatorConfigV3 struct {
+	Sinks map[string]*LogsSinkConfigV3 `yaml:"sinks,omitempty"`
+}
+
+type LogsSinkConfigV3 struct {
+	Type      *string           `yaml:"type,omitempty"`
+	Endpoint  *string           `yaml:"endpoint,omitempty"`
+	Headers   map[string]string `yaml:"headers,omitempty"`
+	Directory *string           `yaml:"directory,omitempty"`
+}
@@ -2,8 +2,11 @@ package resolved_config
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v3 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/stacktrace"
+	"path/filepath"
+	"strings"
 )
 
 const (
@@ -37,11 +40,12 @@ the overrides on top of the default config.
 */
 type KurtosisConfig struct {
 	// Only necessary to store for when we serialize overrides
-	overrides *v2.KurtosisConfigV2
+	overrides *v3.KurtosisConfigV3
 
 	shouldSendMetrics bool
 	clusters          map[string]*KurtosisClusterConfig
 	cloudConfig       *KurtosisCloudConfig
+	logsSinks         logs_aggregator.Sinks
 }
 
 // NewKurtosisConfigFromOverrides constructs a new KurtosisConfig that uses the given overrides
@@ -58,6 +62,7 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 		shouldSendMetrics: false,
 		clusters:          nil,
 		cloudConfig:       nil,
+		logsSinks:         nil,
 	}
 
 	// Get latest config version
@@ -125,21 +130,28 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 		}
 	}
 
+	logsSinks, err := getLogsSinksFromOverrides(overrides.LogsAggregator)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs sinks from the logs aggregator config")
+	}
+
 	return &KurtosisConfig{
 		overrides:         overrides,
 		shouldSendMetrics: shouldSendMetrics,
 		clusters:          allClusterConfigs,
 		cloudConfig:       cloudConfig,
+		logsSinks:         logsSinks,
 	}, nil
 }
 
 // NOTE: We probably want to remove this function entirely
 func NewKurtosisConfigFromRequiredFields(shouldSendMetrics bool) (*KurtosisConfig, error) {
-	overrides := &v2.KurtosisConfigV2{
+	overrides := &v3.KurtosisConfigV3{
 		ConfigVersion:     0,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		LogsAggregator:    nil,
 	}
 	result, err := NewKurtosisConfigFromOverrides(overrides)
 	if err != nil {
@@ -154,6 +166,7 @@ func NewKurtosisConfigWithMetricsSetFromExistingConfig(config *KurtosisConfig, s
 		shouldSendMetrics: shouldSendMetrics,
 		clusters:          config.clusters,
 		cloudConfig:       config.cloudConfig,
+		logsSinks:         config.logsSinks,
 	}
 	newConfig.overrides.ShouldSendMetrics = &shouldSendMetrics
 	return newConfig
@@ -167,7 +180,7 @@ func (kurtosisConfig *KurtosisConfig) GetKurtosisClusters() map[string]*Kurtosis
 	return kurtosisConfig.clusters
 }
 
-func (kurtosisConfig *KurtosisConfig) GetOverrides() *v2.KurtosisConfigV2 {
+func (kurtosisConfig *KurtosisConfig) GetOverrides() *v3.KurtosisConfigV3 {
 	return kurtosisConfig.overrides
 }
 
@@ -175,35 +188,40 @@ func (kurtosisConfig *KurtosisConfig) GetCloudConfig() *KurtosisCloudConfig {
 	return kurtosisConfig.cloudConfig
 }
 
+// GetLogsSinks returns the sinks, outside of Kurtosis, that the logs aggregator sends the service logs to
+func (kurtosisConfig *KurtosisConfig) GetLogsSinks() logs_aggregator.Sinks {
+	return kurtosisConfig.logsSinks
+}
+
 // ====================================================================================================
 //
 //	Private Helpers
 //
 // ====================================================================================================
 // This is a separate helper function so that we can use it to ensure that the
-func castUncastedOverrides(uncastedOverrides interface{}) (*v2.KurtosisConfigV2, error) {
-	castedOverrides, ok := uncastedOverrides.(*v2.KurtosisConfigV2)
+func castUncastedOverrides(uncastedOverrides interface{}) (*v3.KurtosisConfigV3, error) {
+	castedOverrides, ok := uncastedOverrides.(*v3.KurtosisConfigV3)
 	if !ok {
 		return nil, stacktrace.NewError("An error occurred casting the uncasted config overrides to the right version")
 	}
 	return castedOverrides, nil
 }
 
-func getDefaultKurtosisClusterConfigOverrides() map[string]*v2.KurtosisClusterConfigV2 {
+func getDefaultKurtosisClusterConfigOverrides() map[string]*v3.KurtosisClusterConfigV3 {
 	dockerClusterType := KurtosisClusterType_Docker.String()
 	minikubeClusterType := KurtosisClusterType_Kubernetes.String()
 	minikubeKubernetesClusterName := defaultMinikubeClusterKubernetesClusterNameStr
 	minikubeStorageClass := defaultMinikubeStorageClass
 	minikubeEnclaveDataVolSizeMB := defaultMinikubeEnclaveDataVolumeMB
 
-	result := map[string]*v2.KurtosisClusterConfigV2{
+	result := map[string]*v3.KurtosisClusterConfigV3{
 		DefaultDockerClusterName: {
 			Type:   &dockerClusterType,
 			Config: nil, // Must be nil for Docker
 		},
 		defaultMinikubeClusterName: {
 			Type: &minikubeClusterType,
-			Config: &v2.KubernetesClusterConfigV2{
+			Config: &v3.KubernetesClusterConfigV3{
 				KubernetesClusterName:  &minikubeKubernetesClusterName,
 				StorageClass:           &minikubeStorageClass,
 				EnclaveSizeInMegabytes: &minikubeEnclaveDataVolSizeMB,
@@ -213,3 +231,47 @@ func getDefaultKurtosisClusterConfigOverrides() map[string]*v2.KurtosisClusterCo
 
 	return result
 }
+
+func getLogsSinksFromOverrides(logsAggregatorOverrides *v3.LogsAggregatorConfigV3) (logs_aggregator.Sinks, error) {
+	if logsAggregatorOverrides == nil {
+		return nil, nil
+	}
+	logsSinks := logs_aggregator.Sinks{}
+	for sinkName, sinkOverrides := range logsAggregatorOverrides.Sinks {
+		if sinkOverrides == nil || sinkOverrides.Type == nil {
+			return nil, stacktrace.NewError("Logs sink '%v' must have a defined type", sinkName)
+		}
+		sinkType, err := logs_aggregator.SinkTypeString(*sinkOverrides.Type)
+		if err != nil {
+			return nil, stacktrace.Propagate(
+				err,
+				"Logs sink '%v' has unrecognized type '%v'; valid values are: %v",
+				sinkName,
+				*sinkOverrides.Type,
+				strings.Join(logs_aggregator.SinkTypeStrings(), ", "),
+			)
+		}
+		var endpoint string
+		if sinkOverrides.Endpoint != nil {
+			endpoint = *sinkOverrides.Endpoint
+		}
+		var directory string
+		if sinkOverrides.Directory != nil {
+			directory = *sinkOverrides.Directory
+		}
+		if sinkType == logs_aggregator.SinkType_File {
+			if directory == "" {
+				return nil, stacktrace.NewError("Logs sink '%v' is of type '%v' but has no directory", sinkName, sinkType.String())
+			}
+			absoluteDirectory, err := filepath.Abs(directory)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the absolute path of the directory '%v' of logs sink '%v'", directory, sinkName)
+			}
+			directory = absoluteDirectory
+		} else if endpoint == "" {
+			return nil, stacktrace.NewError("Logs sink '%v' is of type '%v' but has no endpoint", sinkName, sinkType.String())
+		}
+		logsSinks[sinkName] = logs_aggregator.NewSink(sinkType, endpoint, sinkOverrides.Headers, directory)
+	}
+	return logsSinks, nil
+}
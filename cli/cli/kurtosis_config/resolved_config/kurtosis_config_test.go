@@ -3,7 +3,8 @@ package resolved_config
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v3 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/stretchr/testify/require"
 	"sort"
 	"testing"
@@ -50,11 +51,12 @@ func TestNewKurtosisConfigFromRequiredFields_MetricsElectionIsSent(t *testing.T)
 }
 
 func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
-	_, err := NewKurtosisConfigFromOverrides(&v2.KurtosisConfigV2{
+	_, err := NewKurtosisConfigFromOverrides(&v3.KurtosisConfigV3{
 		ConfigVersion:     0,
 		ShouldSendMetrics: nil,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		LogsAggregator:    nil,
 	})
 	// You can not initialize a Kurtosis config with empty overrides - it needs at least `ShouldSendMetrics`
 	require.Error(t, err)
@@ -63,11 +65,12 @@ func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
 func TestNewKurtosisConfigJustMetrics(t *testing.T) {
 	version := config_version.ConfigVersion_v0
 	shouldSendMetrics := true
-	originalOverrides := v2.KurtosisConfigV2{
+	originalOverrides := v3.KurtosisConfigV3{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		LogsAggregator:    nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	// You can not initialize a Kurtosis config with empty originalOverrides - it needs at least `ShouldSendMetrics`
@@ -94,15 +97,16 @@ func TestCloudConfigOverridesApiUrl(t *testing.T) {
 	version := config_version.ConfigVersion_v0
 	shouldSendMetrics := true
 	apiUrl := "test.com"
-	originalOverrides := v2.KurtosisConfigV2{
+	originalOverrides := v3.KurtosisConfigV3{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
-		CloudConfig: &v2.KurtosisCloudConfigV2{
+		CloudConfig: &v3.KurtosisCloudConfigV3{
 			ApiUrl:           &apiUrl,
 			Port:             nil,
 			CertificateChain: nil,
 		},
+		LogsAggregator: nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	require.NoError(t, err)
@@ -112,3 +116,65 @@ func TestCloudConfigOverridesApiUrl(t *testing.T) {
 	require.Nil(t, overrides.CloudConfig.Port)
 	require.Nil(t, overrides.CloudConfig.CertificateChain)
 }
+
+func TestLogsAggregatorOverridesLogsSinks(t *testing.T) {
+	shouldSendMetrics := true
+	otlpSinkType := "otlp-http"
+	otlpEndpoint := "http://collector:4318/v1/logs"
+	fileSinkType := "file"
+	fileDirectory := "/tmp/kurtosis-logs"
+	originalOverrides := v3.KurtosisConfigV3{
+		ConfigVersion:     config_version.ConfigVersion_v3,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		LogsAggregator: &v3.LogsAggregatorConfigV3{
+			Sinks: map[string]*v3.LogsSinkConfigV3{
+				"collector": {
+					Type:      &otlpSinkType,
+					Endpoint:  &otlpEndpoint,
+					Headers:   map[string]string{"Authorization": "Bearer token"},
+					Directory: nil,
+				},
+				"archive": {
+					Type:      &fileSinkType,
+					Endpoint:  nil,
+					Headers:   nil,
+					Directory: &fileDirectory,
+				},
+			},
+		},
+	}
+	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+	require.NoError(t, err)
+
+	logsSinks := config.GetLogsSinks()
+	require.Len(t, logsSinks, 2)
+	require.Equal(t, logs_aggregator.SinkType_OtlpHttp, logsSinks["collector"].GetType())
+	require.Equal(t, otlpEndpoint, logsSinks["collector"].GetEndpoint())
+	require.Equal(t, map[string]string{"Authorization": "Bearer token"}, logsSinks["collector"].GetHeaders())
+	require.Equal(t, logs_aggregator.SinkType_File, logsSinks["archive"].GetType())
+	require.Equal(t, fileDirectory, logsSinks["archive"].GetDirectory())
+}
+
+func TestLogsAggregatorOverridesInvalidLogsSinks(t *testing.T) {
+	shouldSendMetrics := true
+	unknownSinkType := "kafka"
+	lokiSinkType := "loki"
+	for _, sinkOverrides := range []*v3.LogsSinkConfigV3{
+		{Type: nil, Endpoint: nil, Headers: nil, Directory: nil},
+		{Type: &unknownSinkType, Endpoint: nil, Headers: nil, Directory: nil},
+		{Type: &lokiSinkType, Endpoint: nil, Headers: nil, Directory: nil},
+	} {
+		_, err := NewKurtosisConfigFromOverrides(&v3.KurtosisConfigV3{
+			ConfigVersion:     config_version.ConfigVersion_v3,
+			ShouldSendMetrics: &shouldSendMetrics,
+			KurtosisClusters:  nil,
+			CloudConfig:       nil,
+			LogsAggregator: &v3.LogsAggregatorConfigV3{
+				Sinks: map[string]*v3.LogsSinkConfigV3{"sink": sinkOverrides},
+			},
+		})
+		require.Error(t, err)
+	}
+}
@@ -65,7 +65,7 @@ func (provider *GatewayConnectionProvider) ForEngine(engine *engine.Engine) (Gat
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to find an api endpoint for Kubernetes portforward to engine '%v', instead a non-nil error was returned", engine.GetGUID())
 	}
-	engineConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, enginePorts)
+	engineConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, enginePorts, map[string]uint16{})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get a connection to engine '%v', instead a non-nil error was returned", engine.GetGUID())
 	}
@@ -88,7 +88,7 @@ func (provider *GatewayConnectionProvider) ForEnclaveApiContainer(enclaveInfo *k
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get an endpoint for portforwarding to the API Container in enclave '%v', instead a non-nil error was returned", enclaveId)
 	}
-	apiContainerConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, apiContainerPorts)
+	apiContainerConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, apiContainerPorts, map[string]uint16{})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to connect to api container in enclave '%v', instead a non-nil error was returned", enclaveId)
 	}
@@ -102,13 +102,34 @@ func (provider *GatewayConnectionProvider) ForUserServiceIfRunning(enclaveId str
 		return nil, stacktrace.Propagate(err, "an error occurred while getting the enclave namespace name")
 	}
 	podPortforwardEndpoint := provider.getUserServicePortForwardEndpoint(enclaveNamespaceName, serviceName)
-	userServiceConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, servicePortSpecs)
+	userServiceConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, servicePortSpecs, map[string]uint16{})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to connect to user service with name '%v', instead a non-nil error was returned", serviceName)
 	}
 	return userServiceConnection, nil
 }
 
+// ForUserServicePort forwards a single port of a running user service to the given local port, a random local port
+// being bound if the local port number is 0
+func (provider *GatewayConnectionProvider) ForUserServicePort(enclaveId string, serviceName string, portId string, servicePortSpec *port_spec.PortSpec, localPortNumber uint16) (GatewayConnectionToKurtosis, error) {
+	enclaveNamespaceName, err := provider.getEnclaveNamespaceNameForEnclaveId(enclaveId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "an error occurred while getting the enclave namespace name")
+	}
+	podPortforwardEndpoint := provider.getUserServicePortForwardEndpoint(enclaveNamespaceName, serviceName)
+	servicePortSpecs := map[string]*port_spec.PortSpec{
+		portId: servicePortSpec,
+	}
+	localPortNumbers := map[string]uint16{
+		portId: localPortNumber,
+	}
+	userServicePortConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, servicePortSpecs, localPortNumbers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to forward port '%v' of user service with name '%v', instead a non-nil error was returned", portId, serviceName)
+	}
+	return userServicePortConnection, nil
+}
+
 func (provider *GatewayConnectionProvider) getEnginePodPortforwardEndpoint(engineGuid engine.EngineGUID) (*url.URL, error) {
 	engineLabels := map[string]string{
 		kubernetes_label_key.IDKubernetesLabelKey.GetString():                   string(engineGuid),
@@ -192,6 +192,53 @@ func (service *ApiContainerGatewayServiceServer) StoreFilesArtifactFromService(c
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) CopyFilesToService(server kurtosis_core_rpc_api_bindings.ApiContainerService_CopyFilesToServiceServer) error {
+	client, err := service.remoteApiContainerClient.CopyFilesToService(server.Context())
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	// The chunks of this stream carry the copy destination, so they can't go through forwardDataChunkStreamWithClose
+	for {
+		dataChunk, readErr := server.Recv()
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return stacktrace.Propagate(readErr, "Error reading the files to copy from the stream on gateway")
+		}
+		if writeErr := client.Send(dataChunk); writeErr != nil {
+			return stacktrace.Propagate(writeErr, "Error forwarding the files to copy from the stream on gateway")
+		}
+	}
+	response, closeErr := client.CloseAndRecv()
+	if closeErr != nil {
+		return stacktrace.Propagate(closeErr, "Error during Kurtosis closing upload client")
+	}
+	if closeErr = server.SendAndClose(response); closeErr != nil {
+		return stacktrace.Propagate(closeErr, "Error during Kurtosis closing upload server")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) CopyFilesArtifactToService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.CopyFilesArtifactToService(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) CopyFilesFromService(args *kurtosis_core_rpc_api_bindings.CopyFilesFromServiceArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_CopyFilesFromServiceServer) error {
+	client, err := service.remoteApiContainerClient.CopyFilesFromService(server.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := forwardDataChunkStream(client, server); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from CopyFilesFromService on gateway")
+	}
+	return nil
+}
+
 func (service *ApiContainerGatewayServiceServer) InspectFilesArtifactContents(ctx context.Context, args *kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest) (*kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse, error) {
 	remoteApiContainerResponse, err := service.remoteApiContainerClient.InspectFilesArtifactContents(ctx, args)
 	if err != nil {
@@ -200,6 +247,73 @@ func (service *ApiContainerGatewayServiceServer) InspectFilesArtifactContents(ct
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) RemoveFilesArtifact(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RemoveFilesArtifactArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.RemoveFilesArtifact(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) ListFilesArtifactVersions(ctx context.Context, args *kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsArgs) (*kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.ListFilesArtifactVersions(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) ResumeServices(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ResumeServicesResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.ResumeServices(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetEnclaveExpiry(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.EnclaveExpiry, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetEnclaveExpiry(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) SetEnclaveExpiry(ctx context.Context, args *kurtosis_core_rpc_api_bindings.EnclaveExpiry) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.SetEnclaveExpiry(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetEnclaveResourceQuotas(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetEnclaveResourceQuotasResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetEnclaveResourceQuotas(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) LintStarlark(ctx context.Context, args *kurtosis_core_rpc_api_bindings.LintStarlarkArgs) (*kurtosis_core_rpc_api_bindings.LintStarlarkResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.LintStarlark(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetServicesStats(args *kurtosis_core_rpc_api_bindings.GetServicesStatsArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_GetServicesStatsServer) error {
+	client, err := service.remoteApiContainerClient.GetServicesStats(server.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.GetServicesStatsResponse](client, server); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from GetServicesStats on gateway")
+	}
+	return nil
+}
+
 func (service *ApiContainerGatewayServiceServer) DownloadFilesArtifact(args *kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_DownloadFilesArtifactServer) error {
 	client, err := service.remoteApiContainerClient.DownloadFilesArtifact(server.Context(), args)
 	if err != nil {
@@ -230,6 +344,28 @@ func (service *ApiContainerGatewayServiceServer) GetStarlarkRun(ctx context.Cont
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) ExportEnclaveSnapshot(args *kurtosis_core_rpc_api_bindings.ExportEnclaveSnapshotArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_ExportEnclaveSnapshotServer) error {
+	client, err := service.remoteApiContainerClient.ExportEnclaveSnapshot(server.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := forwardDataChunkStream(client, server); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from ExportEnclaveSnapshot on gateway")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) ImportEnclaveSnapshot(server kurtosis_core_rpc_api_bindings.ApiContainerService_ImportEnclaveSnapshotServer) error {
+	client, err := service.remoteApiContainerClient.ImportEnclaveSnapshot(server.Context())
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := forwardDataChunkStreamWithClose[*emptypb.Empty](server, client); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from ImportEnclaveSnapshot on gateway")
+	}
+	return nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
 	restclient "k8s.io/client-go/rest"
 	"sync"
 	"time"
@@ -119,7 +120,7 @@ func (service *EngineGatewayServiceServer) CreateEnclave(ctx context.Context, ar
 	return remoteEngineResponse, nil
 }
 
-func (service *EngineGatewayServiceServer) GetEnclaves(ctx context.Context, in *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetEnclavesResponse, error) {
+func (service *EngineGatewayServiceServer) GetEnclaves(ctx context.Context, in *kurtosis_engine_rpc_api_bindings.GetEnclavesArgs) (*kurtosis_engine_rpc_api_bindings.GetEnclavesResponse, error) {
 	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
@@ -178,6 +179,32 @@ func (service *EngineGatewayServiceServer) StopEnclave(ctx context.Context, args
 	return &emptypb.Empty{}, nil
 }
 
+func (service *EngineGatewayServiceServer) StartEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.StartEnclaveArgs) (*kurtosis_engine_rpc_api_bindings.StartEnclaveResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	startEnclaveResponse, err := remoteEngineClient.StartEnclave(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to start enclave '%v'", args.EnclaveIdentifier)
+	}
+
+	return startEnclaveResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) ExtendEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs) (*kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	extendEnclaveResponse, err := remoteEngineClient.ExtendEnclave(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to extend the expiration of enclave '%v'", args.EnclaveIdentifier)
+	}
+
+	return extendEnclaveResponse, nil
+}
+
 func (service *EngineGatewayServiceServer) DestroyEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
 	if err != nil {
@@ -239,6 +266,67 @@ func (service *EngineGatewayServiceServer) GetServiceLogs(
 	return nil
 }
 
+func (service *EngineGatewayServiceServer) SnapshotEnclave(
+	args *kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs,
+	streamToWriteTo kurtosis_engine_rpc_api_bindings.EngineService_SnapshotEnclaveServer,
+) error {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	streamToReadFrom, err := remoteEngineClient.SnapshotEnclave(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred snapshotting enclave '%v' through the remote engine", args.GetEnclaveIdentifier())
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_engine_rpc_api_bindings.EnclaveSnapshotChunk](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from Kurtosis engine back to the user")
+	}
+	return nil
+}
+
+func (service *EngineGatewayServiceServer) RestoreEnclave(streamToReadFrom kurtosis_engine_rpc_api_bindings.EngineService_RestoreEnclaveServer) error {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	streamToWriteTo, err := remoteEngineClient.RestoreEnclave(streamToReadFrom.Context())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring an enclave through the remote engine")
+	}
+	for {
+		snapshotChunk, readErr := streamToReadFrom.Recv()
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return stacktrace.Propagate(readErr, "Error reading the enclave snapshot chunks sent by the user")
+		}
+		if writeErr := streamToWriteTo.Send(snapshotChunk); writeErr != nil {
+			return stacktrace.Propagate(writeErr, "Received an enclave snapshot chunk but failed forwarding it to the remote engine")
+		}
+	}
+	remoteEngineResponse, err := streamToWriteTo.CloseAndRecv()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring an enclave through the remote engine")
+	}
+
+	// Overwrite the hostmachineinfo for the apicontainer returned by the remote engine, as in CreateEnclave
+	restoredEnclaveInfo := remoteEngineResponse.GetEnclaveInfo()
+	if restoredEnclaveInfo == nil {
+		return stacktrace.NewError("Expected the response from the remote engine to have info on the restored enclave, instead no enclave information was found.")
+	}
+	runningApiContainerGateway, err := service.startRunningGatewayForEnclave(restoredEnclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to start a local gateway for enclave '%v', instead a non-nil err was returned", restoredEnclaveInfo.GetEnclaveUuid())
+	}
+	restoredEnclaveInfo.ApiContainerHostMachineInfo = runningApiContainerGateway.hostMachineInfo
+
+	if err := streamToReadFrom.SendAndClose(remoteEngineResponse); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the restored enclave information back to the user")
+	}
+	return nil
+}
+
 // Private functions for managing our running enclave api container gateways
 func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (*runningApiContainerGateway, error) {
 	service.mutex.Lock()
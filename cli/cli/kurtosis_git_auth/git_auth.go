@@ -0,0 +1,76 @@
+package kurtosis_git_auth
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+)
+
+const (
+	httpsTokenGitAuthType = "https-token"
+	sshKeyGitAuthType     = "ssh-key"
+)
+
+// GitAuth is the credential configured for a single Git host. Only one of the token and the SSH private key is set.
+// SSH keys and known hosts are referenced by path, and are only read when they are sent to the API container
+type GitAuth struct {
+	Host string `json:"host"`
+
+	Username string `json:"username,omitempty"`
+	Token    string `json:"token,omitempty"`
+
+	SshPrivateKeyFilepath   string `json:"sshPrivateKeyFilepath,omitempty"`
+	SshPrivateKeyPassphrase string `json:"sshPrivateKeyPassphrase,omitempty"`
+	SshKnownHostsFilepath   string `json:"sshKnownHostsFilepath,omitempty"`
+}
+
+func NewHttpsTokenGitAuth(host string, username string, token string) *GitAuth {
+	return &GitAuth{
+		Host:                    host,
+		Username:                username,
+		Token:                   token,
+		SshPrivateKeyFilepath:   "",
+		SshPrivateKeyPassphrase: "",
+		SshKnownHostsFilepath:   "",
+	}
+}
+
+func NewSshKeyGitAuth(host string, privateKeyFilepath string, privateKeyPassphrase string, knownHostsFilepath string) *GitAuth {
+	return &GitAuth{
+		Host:                    host,
+		Username:                "",
+		Token:                   "",
+		SshPrivateKeyFilepath:   privateKeyFilepath,
+		SshPrivateKeyPassphrase: privateKeyPassphrase,
+		SshKnownHostsFilepath:   knownHostsFilepath,
+	}
+}
+
+func (gitAuth *GitAuth) IsSshKey() bool {
+	return gitAuth.SshPrivateKeyFilepath != ""
+}
+
+// GetType returns a human-readable type of the credential, which is safe to print
+func (gitAuth *GitAuth) GetType() string {
+	if gitAuth.IsSshKey() {
+		return sshKeyGitAuthType
+	}
+	return httpsTokenGitAuthType
+}
+
+// ToGitCredential builds the credential sent to the API container, reading the SSH key and known hosts from disk
+func (gitAuth *GitAuth) ToGitCredential() (*kurtosis_core_rpc_api_bindings.GitCredential, error) {
+	if !gitAuth.IsSshKey() {
+		return binding_constructors.NewHttpsTokenGitCredential(gitAuth.Host, gitAuth.Username, gitAuth.Token), nil
+	}
+	privateKey, err := os.ReadFile(gitAuth.SshPrivateKeyFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the SSH private key for Git host '%s' at '%s'", gitAuth.Host, gitAuth.SshPrivateKeyFilepath)
+	}
+	knownHosts, err := os.ReadFile(gitAuth.SshKnownHostsFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the SSH known hosts for Git host '%s' at '%s'", gitAuth.Host, gitAuth.SshKnownHostsFilepath)
+	}
+	return binding_constructors.NewSshKeyGitCredential(gitAuth.Host, privateKey, gitAuth.SshPrivateKeyPassphrase, knownHosts), nil
+}
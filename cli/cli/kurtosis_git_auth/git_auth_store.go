@@ -0,0 +1,171 @@
+package kurtosis_git_auth
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"sort"
+	"sync"
+)
+
+const (
+	// The file holds secrets, so only the current user can read it
+	gitAuthFilePermissions os.FileMode = 0600
+)
+
+var (
+	// NOTE: This will be initialized exactly once (singleton pattern)
+	currentGitAuthStore *gitAuthStore
+	once                sync.Once
+)
+
+type gitAuthStore struct {
+	mutex *sync.RWMutex
+}
+
+func GetGitAuthStore() *gitAuthStore {
+	// NOTE: We use a 'once' to initialize the gitAuthStore because it contains a mutex to guard the Git auth file, and
+	// we don't ever want multiple gitAuthStore instances in existence
+	once.Do(func() {
+		currentGitAuthStore = &gitAuthStore{mutex: &sync.RWMutex{}}
+	})
+	return currentGitAuthStore
+}
+
+// SetGitAuth adds the credential for its host, replacing the existing one if any
+func (store *gitAuthStore) SetGitAuth(gitAuth *GitAuth) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	gitAuths, err := store.getGitAuthsFromFile()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Git credentials from the Git auth file")
+	}
+	gitAuths[gitAuth.Host] = gitAuth
+	if err = store.saveGitAuthFile(gitAuths); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the Git credential for host '%s'", gitAuth.Host)
+	}
+	return nil
+}
+
+// RemoveGitAuth removes the credential for the host, returning false if there was none
+func (store *gitAuthStore) RemoveGitAuth(host string) (bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	gitAuths, err := store.getGitAuthsFromFile()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the Git credentials from the Git auth file")
+	}
+	if _, found := gitAuths[host]; !found {
+		return false, nil
+	}
+	delete(gitAuths, host)
+	if err = store.saveGitAuthFile(gitAuths); err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred removing the Git credential for host '%s'", host)
+	}
+	return true, nil
+}
+
+// GetAllGitAuths returns the configured credentials, sorted by host
+func (store *gitAuthStore) GetAllGitAuths() ([]*GitAuth, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	gitAuthsByHost, err := store.getGitAuthsFromFile()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Git credentials from the Git auth file")
+	}
+	gitAuths := []*GitAuth{}
+	for _, gitAuth := range gitAuthsByHost {
+		gitAuths = append(gitAuths, gitAuth)
+	}
+	sort.Slice(gitAuths, func(i, j int) bool {
+		return gitAuths[i].Host < gitAuths[j].Host
+	})
+	return gitAuths, nil
+}
+
+// GetGitCredentialsForPackage returns, in the shape expected by the API container, the configured credentials of the
+// hosts the package or its dependencies are fetched from, so that the credentials of the other hosts never leave the
+// machine
+func (store *gitAuthStore) GetGitCredentialsForPackage(packageIdOrPath string) ([]*kurtosis_core_rpc_api_bindings.GitCredential, error) {
+	gitAuths, err := store.GetAllGitAuths()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the configured Git credentials")
+	}
+	if len(gitAuths) == 0 {
+		return []*kurtosis_core_rpc_api_bindings.GitCredential{}, nil
+	}
+	gitAuthHosts := []string{}
+	for _, gitAuth := range gitAuths {
+		gitAuthHosts = append(gitAuthHosts, gitAuth.Host)
+	}
+	referencedHosts, err := getGitHostsReferencedByPackage(packageIdOrPath, gitAuthHosts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Git hosts package '%s' depends on", packageIdOrPath)
+	}
+
+	gitCredentials := []*kurtosis_core_rpc_api_bindings.GitCredential{}
+	for _, gitAuth := range gitAuths {
+		if !referencedHosts[gitAuth.Host] {
+			logrus.Debugf("Not sending the Git credential for host '%s' as package '%s' doesn't depend on it", gitAuth.Host, packageIdOrPath)
+			continue
+		}
+		gitCredential, err := gitAuth.ToGitCredential()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred building the Git credential for host '%s'", gitAuth.Host)
+		}
+		gitCredentials = append(gitCredentials, gitCredential)
+	}
+	return gitCredentials, nil
+}
+
+// ======================================== Private Helpers ===========================================
+func (store *gitAuthStore) getGitAuthsFromFile() (map[string]*GitAuth, error) {
+	filepath, err := host_machine_directories.GetGitAuthFilepath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Git auth filepath")
+	}
+	logrus.Debugf("Git auth filepath: '%v'", filepath)
+
+	fileContentBytes, err := os.ReadFile(filepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]*GitAuth{}, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred reading Git auth file '%v'", filepath)
+	}
+
+	gitAuths := map[string]*GitAuth{}
+	if err = json.Unmarshal(fileContentBytes, &gitAuths); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing Git auth file '%v'", filepath)
+	}
+	return gitAuths, nil
+}
+
+func (store *gitAuthStore) saveGitAuthFile(gitAuths map[string]*GitAuth) error {
+	fileContent, err := json.Marshal(gitAuths)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the Git credentials")
+	}
+
+	filepath, err := host_machine_directories.GetGitAuthFilepath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Git auth filepath")
+	}
+
+	logrus.Debugf("Saving Git credentials in file...")
+	if err = os.WriteFile(filepath, fileContent, gitAuthFilePermissions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing Git auth file '%v'", filepath)
+	}
+	// WriteFile doesn't change the permissions of an existing file
+	if err = os.Chmod(filepath, gitAuthFilePermissions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred restricting the permissions of Git auth file '%v'", filepath)
+	}
+	logrus.Debugf("Git auth file saved")
+	return nil
+}
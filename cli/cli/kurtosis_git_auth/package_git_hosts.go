@@ -0,0 +1,104 @@
+package kurtosis_git_auth
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	packageIdSeparator = "/"
+
+	starlarkFileExtension = ".star"
+
+	hiddenDirectoryPrefix = "."
+
+	// a locator of the host is the host followed by a path, at the start of a line, of a string or of a YAML value
+	gitHostLocatorRegexFormat = `(?m)(^|["'\s])%s/`
+)
+
+// the files a package references its dependencies from: its imports, its kurtosis.yml replace options and its
+// kurtosis.lock
+var packageDependencyFileExtensions = []string{starlarkFileExtension, ".yml", ".yaml", ".lock"}
+
+// getGitHostsReferencedByPackage returns which of the candidate hosts the package, or any of its dependencies, is
+// fetched from. A local package, whether a directory, a kurtosis.yml or a standalone script, references a host if one
+// of its files contains a locator on it. The dependencies of a remote package are only known once it's cloned, so only
+// the host of its package ID is returned
+func getGitHostsReferencedByPackage(packageIdOrPath string, candidateHosts []string) (map[string]bool, error) {
+	referencedHosts := map[string]bool{}
+	fileInfo, err := os.Stat(packageIdOrPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, stacktrace.Propagate(err, "An error occurred reading package '%s' from disk", packageIdOrPath)
+		}
+		packageHost := strings.SplitN(packageIdOrPath, packageIdSeparator, 2)[0]
+		for _, candidateHost := range candidateHosts {
+			if candidateHost == packageHost {
+				referencedHosts[candidateHost] = true
+			}
+		}
+		return referencedHosts, nil
+	}
+
+	packageFilepaths := []string{packageIdOrPath}
+	if fileInfo.IsDir() {
+		packageFilepaths, err = getPackageDependencyFilepaths(packageIdOrPath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred finding the files of the package at '%s'", packageIdOrPath)
+		}
+	} else if !strings.HasSuffix(packageIdOrPath, starlarkFileExtension) {
+		// a kurtosis.yml stands for the package in its directory
+		packageFilepaths, err = getPackageDependencyFilepaths(filepath.Dir(packageIdOrPath))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred finding the files of the package at '%s'", filepath.Dir(packageIdOrPath))
+		}
+	}
+
+	gitHostLocatorRegexes := map[string]*regexp.Regexp{}
+	for _, candidateHost := range candidateHosts {
+		gitHostLocatorRegexes[candidateHost] = regexp.MustCompile(fmt.Sprintf(gitHostLocatorRegexFormat, regexp.QuoteMeta(candidateHost)))
+	}
+	for _, packageFilepath := range packageFilepaths {
+		content, err := os.ReadFile(packageFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading package file '%s'", packageFilepath)
+		}
+		for candidateHost, gitHostLocatorRegex := range gitHostLocatorRegexes {
+			if gitHostLocatorRegex.Match(content) {
+				referencedHosts[candidateHost] = true
+			}
+		}
+	}
+	return referencedHosts, nil
+}
+
+func getPackageDependencyFilepaths(packageDirpath string) ([]string, error) {
+	var packageFilepaths []string
+	err := filepath.WalkDir(packageDirpath, func(filePath string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if dirEntry.IsDir() {
+			if filePath != packageDirpath && strings.HasPrefix(dirEntry.Name(), hiddenDirectoryPrefix) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		for _, extension := range packageDependencyFileExtensions {
+			if strings.HasSuffix(dirEntry.Name(), extension) {
+				packageFilepaths = append(packageFilepaths, filePath)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred walking the package at '%s'", packageDirpath)
+	}
+	return packageFilepaths, nil
+}
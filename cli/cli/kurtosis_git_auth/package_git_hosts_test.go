@@ -0,0 +1,64 @@
+package kurtosis_git_auth
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	githubHost      = "github.com"
+	gitlabHost      = "gitlab.com"
+	gitInternalHost = "git.internal.example.com"
+
+	mainStarContent = `dependency = import_module("gitlab.com/org/private-package/lib.star")
+
+# see https://git.internal.example.com/org/docs for the conventions
+def run(plan):
+    dependency.run(plan)
+`
+	kurtosisYmlContent = `name: "github.com/org/package"
+`
+)
+
+var candidateHosts = []string{githubHost, gitlabHost, gitInternalHost}
+
+func TestGetGitHostsReferencedByPackage_LocalPackage(t *testing.T) {
+	packageDirpath := createPackage(t)
+
+	referencedHosts, err := getGitHostsReferencedByPackage(packageDirpath, candidateHosts)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{githubHost: true, gitlabHost: true}, referencedHosts)
+}
+
+func TestGetGitHostsReferencedByPackage_KurtosisYml(t *testing.T) {
+	packageDirpath := createPackage(t)
+
+	referencedHosts, err := getGitHostsReferencedByPackage(filepath.Join(packageDirpath, "kurtosis.yml"), candidateHosts)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{githubHost: true, gitlabHost: true}, referencedHosts)
+}
+
+func TestGetGitHostsReferencedByPackage_StandaloneScript(t *testing.T) {
+	packageDirpath := createPackage(t)
+	scriptFilepath := filepath.Join(packageDirpath, "script.star")
+	require.NoError(t, os.WriteFile(scriptFilepath, []byte(`lib = import_module("github.com/org/lib/lib.star")`), 0644))
+
+	referencedHosts, err := getGitHostsReferencedByPackage(scriptFilepath, candidateHosts)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{githubHost: true}, referencedHosts)
+}
+
+func TestGetGitHostsReferencedByPackage_RemotePackage(t *testing.T) {
+	referencedHosts, err := getGitHostsReferencedByPackage("gitlab.com/org/private-package", candidateHosts)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{gitlabHost: true}, referencedHosts)
+}
+
+func createPackage(t *testing.T) string {
+	packageDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirpath, "kurtosis.yml"), []byte(kurtosisYmlContent), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirpath, "main.star"), []byte(mainStarContent), 0644))
+	return packageDirpath
+}
@@ -0,0 +1,30 @@
+package kurtosis_package
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// LintStarlarkFiles lints the given Starlark files, keyed by path, in a new enclave, which is destroyed afterwards, so
+// that they are checked against the Kurtosis instructions and types of the engine version in use
+func LintStarlarkFiles(ctx context.Context, starlarkFiles map[string][]byte) (*kurtosis_core_rpc_api_bindings.LintStarlarkResponse, error) {
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	logrus.Infof("Creating a temporary enclave to lint the Starlark files in...")
+	enclaveCtx, err := kurtosisCtx.CreateEnclave(ctx, autogenerateEnclaveName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave to lint the Starlark files in")
+	}
+	defer destroyTemporaryEnclave(ctx, kurtosisCtx, enclaveCtx)
+
+	lintResponse, err := enclaveCtx.LintStarlark(ctx, starlarkFiles)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred linting the Starlark files")
+	}
+	return lintResponse, nil
+}
@@ -0,0 +1,101 @@
+package kurtosis_package
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_git_auth"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"path"
+	"sort"
+)
+
+const (
+	autogenerateEnclaveName = ""
+	isDryRun                = true
+)
+
+// LockKurtosisPackage interprets the package in the given directory in a new enclave, which is destroyed afterwards,
+// and writes the commit every package it imported from Git resolved to in its kurtosis.lock. The packageLockMode
+// decides whether the dependencies already in the lock keep their commit or are resolved again
+func LockKurtosisPackage(
+	ctx context.Context,
+	packageDirpath string,
+	serializedParams string,
+	packageLockMode kurtosis_core_rpc_api_bindings.PackageLockMode,
+) error {
+	kurtosisLockFilepath := path.Join(packageDirpath, enclaves.KurtosisLockFilename)
+	existingKurtosisLock, err := enclaves.ParseKurtosisLock(kurtosisLockFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the existing '%s'", enclaves.KurtosisLockFilename)
+	}
+
+	gitCredentials, err := kurtosis_git_auth.GetGitAuthStore().GetGitCredentialsForPackage(packageDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Git credentials to clone private packages with")
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	logrus.Infof("Creating a temporary enclave to resolve the package dependencies in...")
+	enclaveCtx, err := kurtosisCtx.CreateEnclave(ctx, autogenerateEnclaveName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the enclave to resolve the package dependencies in")
+	}
+	defer destroyTemporaryEnclave(ctx, kurtosisCtx, enclaveCtx)
+
+	runConfig := starlark_run_config.NewRunStarlarkConfig(
+		starlark_run_config.WithDryRun(isDryRun),
+		starlark_run_config.WithSerializedParams(serializedParams),
+		starlark_run_config.WithGitCredentials(gitCredentials),
+		starlark_run_config.WithPackageLockMode(packageLockMode),
+	)
+	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, runConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred interpreting the package at '%s' to resolve its dependencies", packageDirpath)
+	}
+
+	kurtosisLock := enclaves.NewKurtosisLockFromPackageDependencies(runResult.ResolvedPackageDependencies)
+	if err = kurtosisLock.Write(kurtosisLockFilepath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the '%s' of the package at '%s'", enclaves.KurtosisLockFilename, packageDirpath)
+	}
+	logLockChanges(existingKurtosisLock, kurtosisLock)
+	logrus.Infof("Locked %d package dependencies in '%s'", len(kurtosisLock.Dependencies), kurtosisLockFilepath)
+	return nil
+}
+
+func logLockChanges(existingKurtosisLock *enclaves.KurtosisLock, kurtosisLock *enclaves.KurtosisLock) {
+	existingDependencies := map[string]string{}
+	if existingKurtosisLock != nil {
+		existingDependencies = existingKurtosisLock.Dependencies
+	}
+
+	repositories := []string{}
+	for repository := range existingDependencies {
+		repositories = append(repositories, repository)
+	}
+	for repository := range kurtosisLock.Dependencies {
+		if _, found := existingDependencies[repository]; !found {
+			repositories = append(repositories, repository)
+		}
+	}
+	sort.Strings(repositories)
+
+	for _, repository := range repositories {
+		existingCommit, isExisting := existingDependencies[repository]
+		commit, isLocked := kurtosisLock.Dependencies[repository]
+		switch {
+		case !isExisting:
+			logrus.Infof("Added '%s' at commit '%s'", repository, commit)
+		case !isLocked:
+			logrus.Infof("Removed '%s', which isn't imported anymore", repository)
+		case existingCommit != commit:
+			logrus.Infof("Updated '%s' from commit '%s' to '%s'", repository, existingCommit, commit)
+		}
+	}
+}
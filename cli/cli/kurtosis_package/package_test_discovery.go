@@ -0,0 +1,106 @@
+package kurtosis_package
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/syntax"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	StarlarkTestFileSuffix     = "_test.star"
+	StarlarkTestFunctionPrefix = "test_"
+
+	hiddenDirectoryPrefix = "."
+
+	noParseMode = 0
+
+	starlarkTestIdFormat = "%s::%s"
+)
+
+// StarlarkTest is a `test_*` function defined at the top level of a `*_test.star` file of a package
+type StarlarkTest struct {
+	// The path of the test file relative to the root of the package, with forward slashes
+	RelativeFilepath string
+
+	FunctionName string
+}
+
+func (test *StarlarkTest) String() string {
+	return fmt.Sprintf(starlarkTestIdFormat, test.RelativeFilepath, test.FunctionName)
+}
+
+// DiscoverStarlarkTests returns the tests of the package in the given directory, ordered by file and then by their
+// position in the file. Hidden directories are skipped. When testFunctionNameFilter isn't nil, only the tests whose
+// function name matches it are returned
+func DiscoverStarlarkTests(packageDirpath string, testFunctionNameFilter *regexp.Regexp) ([]*StarlarkTest, error) {
+	var testFilepaths []string
+	err := filepath.WalkDir(packageDirpath, func(filePath string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if dirEntry.IsDir() {
+			if filePath != packageDirpath && strings.HasPrefix(dirEntry.Name(), hiddenDirectoryPrefix) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(dirEntry.Name(), StarlarkTestFileSuffix) {
+			testFilepaths = append(testFilepaths, filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred walking the package at '%s' to find its test files", packageDirpath)
+	}
+	sort.Strings(testFilepaths)
+
+	var tests []*StarlarkTest
+	for _, testFilepath := range testFilepaths {
+		relativeTestFilepath, err := filepath.Rel(packageDirpath, testFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the path of test file '%s' relative to the package root '%s'", testFilepath, packageDirpath)
+		}
+		testFunctionNames, err := getTestFunctionNames(testFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred finding the tests of file '%s'", testFilepath)
+		}
+		for _, testFunctionName := range testFunctionNames {
+			if testFunctionNameFilter != nil && !testFunctionNameFilter.MatchString(testFunctionName) {
+				continue
+			}
+			tests = append(tests, &StarlarkTest{
+				RelativeFilepath: filepath.ToSlash(relativeTestFilepath),
+				FunctionName:     testFunctionName,
+			})
+		}
+	}
+	return tests, nil
+}
+
+// getTestFunctionNames returns the names of the `test_*` functions defined at the top level of the file, in the order
+// they are defined
+func getTestFunctionNames(testFilepath string) ([]string, error) {
+	content, err := os.ReadFile(testFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading test file '%s'", testFilepath)
+	}
+	parsedFile, err := syntax.Parse(testFilepath, content, noParseMode)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing test file '%s'", testFilepath)
+	}
+	var testFunctionNames []string
+	for _, stmt := range parsedFile.Stmts {
+		defStmt, ok := stmt.(*syntax.DefStmt)
+		if !ok || !strings.HasPrefix(defStmt.Name.Name, StarlarkTestFunctionPrefix) {
+			continue
+		}
+		testFunctionNames = append(testFunctionNames, defStmt.Name.Name)
+	}
+	return testFunctionNames, nil
+}
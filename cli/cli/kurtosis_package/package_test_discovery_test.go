@@ -0,0 +1,76 @@
+package kurtosis_package
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"regexp"
+	"testing"
+)
+
+const (
+	packageTestDiscoveryTestDirPattern = "package-test-discovery-test-dir-*"
+
+	testFileContent = `helpers = import_module("/lib/helpers.star")
+
+def test_add_service(plan):
+    helpers.add_web(plan)
+
+def helper_test_function(plan):
+    pass
+
+def test_print(plan):
+    def test_nested():
+        pass
+    plan.print("hello")
+`
+)
+
+func TestDiscoverStarlarkTests(t *testing.T) {
+	packageDirpath := createPackageWithTestFiles(t)
+	defer os.RemoveAll(packageDirpath)
+
+	tests, err := DiscoverStarlarkTests(packageDirpath, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*StarlarkTest{
+		{RelativeFilepath: "main_test.star", FunctionName: "test_add_service"},
+		{RelativeFilepath: "main_test.star", FunctionName: "test_print"},
+		{RelativeFilepath: "tests/web_test.star", FunctionName: "test_add_service"},
+		{RelativeFilepath: "tests/web_test.star", FunctionName: "test_print"},
+	}, tests)
+	require.Equal(t, "tests/web_test.star::test_print", tests[3].String())
+}
+
+func TestDiscoverStarlarkTests_Filtered(t *testing.T) {
+	packageDirpath := createPackageWithTestFiles(t)
+	defer os.RemoveAll(packageDirpath)
+
+	tests, err := DiscoverStarlarkTests(packageDirpath, regexp.MustCompile("print$"))
+	require.NoError(t, err)
+	require.Equal(t, []*StarlarkTest{
+		{RelativeFilepath: "main_test.star", FunctionName: "test_print"},
+		{RelativeFilepath: "tests/web_test.star", FunctionName: "test_print"},
+	}, tests)
+}
+
+func TestDiscoverStarlarkTests_InvalidTestFile(t *testing.T) {
+	packageDirpath, err := os.MkdirTemp("", packageTestDiscoveryTestDirPattern)
+	require.NoError(t, err)
+	defer os.RemoveAll(packageDirpath)
+	require.NoError(t, os.WriteFile(path.Join(packageDirpath, "main_test.star"), []byte("def test_print(plan)\n"), kurtosisPackageFilePermissions))
+
+	_, err = DiscoverStarlarkTests(packageDirpath, nil)
+	require.Error(t, err)
+}
+
+func createPackageWithTestFiles(t *testing.T) string {
+	packageDirpath, err := os.MkdirTemp("", packageTestDiscoveryTestDirPattern)
+	require.NoError(t, err)
+	for _, dirpath := range []string{"tests", "lib", ".git"} {
+		require.NoError(t, os.Mkdir(path.Join(packageDirpath, dirpath), 0755))
+	}
+	for _, filepath := range []string{"main_test.star", "tests/web_test.star", "lib/helpers.star", ".git/hidden_test.star"} {
+		require.NoError(t, os.WriteFile(path.Join(packageDirpath, filepath), []byte(testFileContent), kurtosisPackageFilePermissions))
+	}
+	return packageDirpath
+}
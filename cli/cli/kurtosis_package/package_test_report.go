@@ -0,0 +1,136 @@
+package kurtosis_package
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"time"
+)
+
+const (
+	junitReportIndent = "  "
+	noPrefix          = ""
+
+	junitTimeFormat     = "%.3f"
+	junitFailureMessage = "Test failed"
+	junitSkippedMessage = "Test skipped"
+	junitTestSuitesName = "kurtosis package test"
+)
+
+// SerializeStarlarkTestResultsToJUnit returns the results as a JUnit XML report, the format CI systems ingest, with a
+// test suite per test file and a test case per test function
+func SerializeStarlarkTestResultsToJUnit(results []*StarlarkTestResult) ([]byte, error) {
+	testSuites := &junitTestSuites{
+		XMLName:    xml.Name{Space: "", Local: ""},
+		Name:       junitTestSuitesName,
+		Tests:      0,
+		Failures:   0,
+		Skipped:    0,
+		Time:       "",
+		TestSuites: nil,
+	}
+	testSuitesByFilepath := map[string]*junitTestSuite{}
+	var totalDuration time.Duration
+	for _, result := range results {
+		testSuite, found := testSuitesByFilepath[result.Test.RelativeFilepath]
+		if !found {
+			testSuite = &junitTestSuite{
+				Name:      result.Test.RelativeFilepath,
+				Tests:     0,
+				Failures:  0,
+				Skipped:   0,
+				Time:      "",
+				TestCases: nil,
+				duration:  0,
+			}
+			testSuitesByFilepath[result.Test.RelativeFilepath] = testSuite
+			testSuites.TestSuites = append(testSuites.TestSuites, testSuite)
+		}
+
+		testCase := &junitTestCase{
+			Name:      result.Test.FunctionName,
+			ClassName: result.Test.RelativeFilepath,
+			Time:      formatJUnitDuration(result.Duration),
+			Failure:   nil,
+			Skipped:   nil,
+			SystemOut: result.Output,
+		}
+		if result.IsFailed() {
+			testCase.Failure = &junitFailure{
+				Message: junitFailureMessage,
+				Content: result.FailureMessage,
+			}
+			testSuite.Failures++
+			testSuites.Failures++
+		} else if result.IsSkipped() {
+			testCase.Skipped = &junitSkipped{
+				Message: junitSkippedMessage,
+				Content: result.SkipMessage,
+			}
+			testSuite.Skipped++
+			testSuites.Skipped++
+		}
+		testSuite.TestCases = append(testSuite.TestCases, testCase)
+		testSuite.Tests++
+		testSuite.duration += result.Duration
+		testSuites.Tests++
+		totalDuration += result.Duration
+	}
+	for _, testSuite := range testSuites.TestSuites {
+		testSuite.Time = formatJUnitDuration(testSuite.duration)
+	}
+	testSuites.Time = formatJUnitDuration(totalDuration)
+
+	serializedReport, err := xml.MarshalIndent(testSuites, noPrefix, junitReportIndent)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the test results to JUnit XML")
+	}
+	return append([]byte(xml.Header), serializedReport...), nil
+}
+
+// formatJUnitDuration formats the duration in seconds, as JUnit reports expect
+func formatJUnitDuration(duration time.Duration) string {
+	return fmt.Sprintf(junitTimeFormat, duration.Seconds())
+}
+
+// The subset of the JUnit XML format understood by CI systems
+// See https://github.com/testmoapp/junitxml
+type junitTestSuites struct {
+	XMLName    xml.Name          `xml:"testsuites"`
+	Name       string            `xml:"name,attr"`
+	Tests      int               `xml:"tests,attr"`
+	Failures   int               `xml:"failures,attr"`
+	Skipped    int               `xml:"skipped,attr"`
+	Time       string            `xml:"time,attr"`
+	TestSuites []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Skipped   int              `xml:"skipped,attr"`
+	Time      string           `xml:"time,attr"`
+	TestCases []*junitTestCase `xml:"testcase"`
+
+	duration time.Duration
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
@@ -0,0 +1,64 @@
+package kurtosis_package
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	expectedJUnitReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="kurtosis package test" tests="4" failures="1" skipped="1" time="4.250">
+  <testsuite name="main_test.star" tests="3" failures="1" skipped="1" time="4.000">
+    <testcase name="test_add_service" classname="main_test.star" time="1.500">
+      <system-out>Service &#39;web&#39; added</system-out>
+    </testcase>
+    <testcase name="test_verify" classname="main_test.star" time="2.000">
+      <failure message="Test failed">Verification failed: &#39;0&#39; &#39;==&#39; &#39;1&#39;</failure>
+    </testcase>
+    <testcase name="test_verify_dry_run" classname="main_test.star" time="0.500">
+      <skipped message="Test skipped">The 1 verify assertions of the test are only checked when it&#39;s executed</skipped>
+    </testcase>
+  </testsuite>
+  <testsuite name="tests/web_test.star" tests="1" failures="0" skipped="0" time="0.250">
+    <testcase name="test_print" classname="tests/web_test.star" time="0.250"></testcase>
+  </testsuite>
+</testsuites>`
+)
+
+func TestSerializeStarlarkTestResultsToJUnit(t *testing.T) {
+	results := []*StarlarkTestResult{
+		{
+			Test:           &StarlarkTest{RelativeFilepath: "main_test.star", FunctionName: "test_add_service"},
+			Duration:       1500 * time.Millisecond,
+			FailureMessage: "",
+			SkipMessage:    "",
+			Output:         "Service 'web' added",
+		},
+		{
+			Test:           &StarlarkTest{RelativeFilepath: "main_test.star", FunctionName: "test_verify"},
+			Duration:       2 * time.Second,
+			FailureMessage: "Verification failed: '0' '==' '1'",
+			SkipMessage:    "",
+			Output:         "",
+		},
+		{
+			Test:           &StarlarkTest{RelativeFilepath: "main_test.star", FunctionName: "test_verify_dry_run"},
+			Duration:       500 * time.Millisecond,
+			FailureMessage: "",
+			SkipMessage:    "The 1 verify assertions of the test are only checked when it's executed",
+			Output:         "",
+		},
+		{
+			Test:           &StarlarkTest{RelativeFilepath: "tests/web_test.star", FunctionName: "test_print"},
+			Duration:       250 * time.Millisecond,
+			FailureMessage: "",
+			SkipMessage:    "",
+			Output:         "",
+		},
+	}
+
+	junitReport, err := SerializeStarlarkTestResultsToJUnit(results)
+	require.NoError(t, err)
+	require.Equal(t, expectedJUnitReport, string(junitReport))
+}
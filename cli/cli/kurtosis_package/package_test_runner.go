@@ -0,0 +1,183 @@
+package kurtosis_package
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_git_auth"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+const (
+	noTestArgs = "{}"
+
+	validationErrorsSeparator = "\n"
+
+	verifyInstructionName = "verify"
+)
+
+// StarlarkTestResult is the outcome of running a StarlarkTest
+type StarlarkTestResult struct {
+	Test *StarlarkTest
+
+	Duration time.Duration
+
+	// Empty when the test didn't fail
+	FailureMessage string
+
+	// Set when the test didn't fail but some of its assertions couldn't be checked, like the `verify` instructions of a
+	// dry run, which only hold on runtime values
+	SkipMessage string
+
+	// What the test printed and returned
+	Output string
+}
+
+func (result *StarlarkTestResult) IsPassed() bool {
+	return result.FailureMessage == "" && result.SkipMessage == ""
+}
+
+func (result *StarlarkTestResult) IsFailed() bool {
+	return result.FailureMessage != ""
+}
+
+func (result *StarlarkTestResult) IsSkipped() bool {
+	return !result.IsFailed() && result.SkipMessage != ""
+}
+
+// RunStarlarkTests runs the tests of the package in the given directory, one after the other, calling
+// reportTestResult as soon as each of them finishes.
+//
+// Each test runs in a new enclave, destroyed afterwards, so that the services a test adds can't leak into another one.
+// With dryRun, the tests are only interpreted and validated against the plan they build, which is cheap enough for
+// all of them to share a single enclave as none of them changes it. Interpreting still happens in the API container of
+// that enclave, so a running engine is needed either way.
+//
+// A test fails if it can't be interpreted, if its plan doesn't validate or if one of its instructions fails at
+// execution, `verify` instructions included. As a dry run never executes the `verify` instructions, the tests that have
+// some are reported as skipped rather than passed. The returned error is only about failing to run the tests
+func RunStarlarkTests(
+	ctx context.Context,
+	packageDirpath string,
+	tests []*StarlarkTest,
+	dryRun bool,
+	reportTestResult func(result *StarlarkTestResult),
+) ([]*StarlarkTestResult, error) {
+	gitCredentials, err := kurtosis_git_auth.GetGitAuthStore().GetGitCredentialsForPackage(packageDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Git credentials to clone private packages with")
+	}
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	var sharedEnclaveCtx *enclaves.EnclaveContext
+	if dryRun {
+		logrus.Infof("Creating a temporary enclave to interpret the tests in...")
+		sharedEnclaveCtx, err = kurtosisCtx.CreateEnclave(ctx, autogenerateEnclaveName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the enclave to interpret the tests in")
+		}
+		defer destroyTemporaryEnclave(ctx, kurtosisCtx, sharedEnclaveCtx)
+	}
+
+	var results []*StarlarkTestResult
+	for _, test := range tests {
+		enclaveCtx := sharedEnclaveCtx
+		if enclaveCtx == nil {
+			logrus.Debugf("Creating a temporary enclave to run test '%s' in...", test)
+			enclaveCtx, err = kurtosisCtx.CreateEnclave(ctx, autogenerateEnclaveName)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred creating the enclave to run test '%s' in", test)
+			}
+		}
+		result, err := runStarlarkTest(ctx, enclaveCtx, packageDirpath, test, dryRun, gitCredentials)
+		if enclaveCtx != sharedEnclaveCtx {
+			destroyTemporaryEnclave(ctx, kurtosisCtx, enclaveCtx)
+		}
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred running test '%s'", test)
+		}
+		reportTestResult(result)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func runStarlarkTest(
+	ctx context.Context,
+	enclaveCtx *enclaves.EnclaveContext,
+	packageDirpath string,
+	test *StarlarkTest,
+	dryRun bool,
+	gitCredentials []*kurtosis_core_rpc_api_bindings.GitCredential,
+) (*StarlarkTestResult, error) {
+	runConfig := starlark_run_config.NewRunStarlarkConfig(
+		starlark_run_config.WithRelativePathToMainFile(test.RelativeFilepath),
+		starlark_run_config.WithMainFunctionName(test.FunctionName),
+		starlark_run_config.WithSerializedParams(noTestArgs),
+		starlark_run_config.WithDryRun(dryRun),
+		starlark_run_config.WithGitCredentials(gitCredentials),
+	)
+	startTime := time.Now()
+	// the error returned alongside a run result only repeats the failure already in the result
+	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, runConfig)
+	if runResult == nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the run of test '%s'", test)
+	}
+	return &StarlarkTestResult{
+		Test:           test,
+		Duration:       time.Since(startTime),
+		FailureMessage: getRunFailureMessage(runResult),
+		SkipMessage:    getDryRunSkipMessage(runResult, dryRun),
+		Output:         string(runResult.RunOutput),
+	}, nil
+}
+
+// getRunFailureMessage returns why the run failed, or an empty string if it succeeded
+func getRunFailureMessage(runResult *enclaves.StarlarkRunResult) string {
+	if runResult.InterpretationError != nil {
+		return runResult.InterpretationError.GetErrorMessage()
+	}
+	if len(runResult.ValidationErrors) > 0 {
+		validationErrorMessages := []string{}
+		for _, validationErr := range runResult.ValidationErrors {
+			validationErrorMessages = append(validationErrorMessages, validationErr.GetErrorMessage())
+		}
+		return fmt.Sprintf("Found %d validation errors:\n%s", len(validationErrorMessages), strings.Join(validationErrorMessages, validationErrorsSeparator))
+	}
+	if runResult.ExecutionError != nil {
+		return runResult.ExecutionError.GetErrorMessage()
+	}
+	return ""
+}
+
+// getDryRunSkipMessage returns why the dry run of the test doesn't prove it passes, or an empty string if it does
+func getDryRunSkipMessage(runResult *enclaves.StarlarkRunResult, dryRun bool) string {
+	if !dryRun {
+		return ""
+	}
+	verifyInstructionsCount := 0
+	for _, instruction := range runResult.Instructions {
+		if instruction.GetInstructionName() == verifyInstructionName {
+			verifyInstructionsCount++
+		}
+	}
+	if verifyInstructionsCount == 0 {
+		return ""
+	}
+	return fmt.Sprintf("The %d `%s` assertions of the test are only checked when it's executed, run it without dry run to check them", verifyInstructionsCount, verifyInstructionName)
+}
+
+func destroyTemporaryEnclave(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveCtx *enclaves.EnclaveContext) {
+	if err := kurtosisCtx.DestroyEnclave(ctx, string(enclaveCtx.GetEnclaveUuid())); err != nil {
+		logrus.Warnf("An error occurred destroying temporary enclave '%s'; you'll need to remove it manually with 'kurtosis enclave rm'. Error was:\n%v", enclaveCtx.GetEnclaveName(), err)
+	}
+}
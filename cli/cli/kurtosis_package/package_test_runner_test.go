@@ -0,0 +1,39 @@
+package kurtosis_package
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	isNotDryRun = false
+)
+
+func TestGetDryRunSkipMessage_SkipsDryRunsWithVerifyInstructions(t *testing.T) {
+	runResult := newRunResultWithInstructions("add_service", "request", "verify", "verify")
+
+	require.Equal(t, "The 2 `verify` assertions of the test are only checked when it's executed, run it without dry run to check them", getDryRunSkipMessage(runResult, isDryRun))
+}
+
+func TestGetDryRunSkipMessage_DoesNotSkipDryRunsWithoutVerifyInstructions(t *testing.T) {
+	runResult := newRunResultWithInstructions("add_service", "print")
+
+	require.Empty(t, getDryRunSkipMessage(runResult, isDryRun))
+}
+
+func TestGetDryRunSkipMessage_DoesNotSkipExecutedRuns(t *testing.T) {
+	runResult := newRunResultWithInstructions("add_service", "verify")
+
+	require.Empty(t, getDryRunSkipMessage(runResult, isNotDryRun))
+}
+
+func newRunResultWithInstructions(instructionNames ...string) *enclaves.StarlarkRunResult {
+	var instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction
+	for _, instructionName := range instructionNames {
+		//nolint:exhaustruct
+		instructions = append(instructions, &kurtosis_core_rpc_api_bindings.StarlarkInstruction{InstructionName: instructionName})
+	}
+	return enclaves.NewStarlarkRunResult("", instructions, nil, nil, nil, nil, nil)
+}
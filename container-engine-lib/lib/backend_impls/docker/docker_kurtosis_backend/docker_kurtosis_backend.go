@@ -27,7 +27,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/persistent_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
 	"github.com/kurtosis-tech/stacktrace"
@@ -105,6 +108,7 @@ func (backend *DockerKurtosisBackend) CreateEngine(
 	imageVersionTag string,
 	grpcPortNum uint16,
 	envVars map[string]string,
+	logsSinks logs_aggregator.Sinks,
 ) (
 	*engine.Engine,
 	error,
@@ -115,6 +119,7 @@ func (backend *DockerKurtosisBackend) CreateEngine(
 		imageVersionTag,
 		grpcPortNum,
 		envVars,
+		logsSinks,
 		backend.dockerManager,
 		backend.objAttrsProvider,
 	)
@@ -282,6 +287,18 @@ func (backend *DockerKurtosisBackend) GetUserServiceLogs(
 	return user_service_functions.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) GetUserServicesStats(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service_stats.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return user_service_functions.GetUserServicesStats(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
 // NOTE: This function will block while the exec is ongoing; if we need more perf we can make it async
 func (backend *DockerKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
@@ -319,6 +336,33 @@ func (backend *DockerKurtosisBackend) CopyFilesFromUserService(
 	return user_service_functions.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPathOnContainer, output, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpathOnContainer string,
+	tarContent io.Reader,
+) error {
+	return user_service_functions.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, dstDirpathOnContainer, tarContent, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) ExportUserServiceFilesystem(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	imageName string,
+	output io.Writer,
+) error {
+	return user_service_functions.ExportUserServiceFilesystem(ctx, enclaveUuid, serviceUuid, imageName, output, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) ImportUserServiceFilesystem(
+	ctx context.Context,
+	filesystemContent io.Reader,
+) error {
+	return user_service_functions.ImportUserServiceFilesystem(ctx, filesystemContent, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -363,8 +407,108 @@ func (backend *DockerKurtosisBackend) DestroyUserServices(
 	return successfullyDestroyedServices, failedServices, nil
 }
 
+func (backend *DockerKurtosisBackend) GetPersistentDirectoryVolumes(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+) (
+	map[string]*persistent_directory.PersistentDirectoryVolume,
+	error,
+) {
+	return user_service_functions.GetPersistentDirectoryVolumes(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) DestroyPersistentDirectoryVolumes(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+) (
+	resultSuccessfulVolumeNames map[string]bool,
+	resultErroredVolumeNames map[string]error,
+	resultErr error,
+) {
+	return user_service_functions.DestroyPersistentDirectoryVolumes(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) CopyFilesFromPersistentDirectoryVolume(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	volumeName string,
+	output io.Writer,
+) error {
+	enclaveNetwork, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave network by enclave UUID '%v'", enclaveUuid)
+	}
+	return user_service_functions.CopyFilesFromPersistentDirectoryVolume(ctx, enclaveUuid, enclaveNetwork.GetId(), volumeName, output, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) CopyFilesToPersistentDirectoryVolume(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	volumeName string,
+	tarContent io.Reader,
+) error {
+	enclaveNetwork, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave network by enclave UUID '%v'", enclaveUuid)
+	}
+	return user_service_functions.CopyFilesToPersistentDirectoryVolume(ctx, enclaveUuid, enclaveNetwork.GetId(), volumeName, tarContent, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) CreateNetworkingSidecar(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+) (
+	*networking_sidecar.NetworkingSidecar,
+	error,
+) {
+	enclaveNetwork, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave network by enclave UUID '%v'", enclaveUuid)
+	}
+	return user_service_functions.CreateNetworkingSidecar(ctx, enclaveUuid, enclaveNetwork.GetId(), serviceUuid, backend.objAttrsProvider, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) GetNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar,
+	error,
+) {
+	return user_service_functions.GetNetworkingSidecars(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) RunNetworkingSidecarExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	networkingSidecarsCommands map[service.ServiceUUID][]string,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return user_service_functions.RunNetworkingSidecarExecCommands(ctx, enclaveUuid, networkingSidecarsCommands, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) DestroyNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return user_service_functions.DestroyNetworkingSidecars(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) CreateLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
-	logsAggregatorContainer := vector.NewVectorLogsAggregatorContainer() //Declaring the implementation
+	// The logs sinks are only known when the engine is created, so this logs aggregator only writes to the logs storage
+	logsAggregatorContainer := vector.NewVectorLogsAggregatorContainer(nil) //Declaring the implementation
 
 	logsAggregator, _, err := logs_aggregator_functions.CreateLogsAggregator(
 		ctx,
@@ -38,7 +38,7 @@ type matchingNetworkInformation struct {
 	containers    []*types.Container
 }
 
-func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
+func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, labels map[string]string) (*enclave.Enclave, error) {
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 
 	searchNetworkLabels := map[string]string{
@@ -74,7 +74,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 
 	creationTime := time.Now()
 
-	enclaveNetworkAttrs, err := enclaveObjAttrsProvider.ForEnclaveNetwork(enclaveName, creationTime)
+	enclaveNetworkAttrs, err := enclaveObjAttrsProvider.ForEnclaveNetwork(enclaveName, creationTime, labels)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave network attributes for the enclave with ID '%v'", enclaveUuid)
 	}
@@ -147,7 +147,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 	}()
 
 	// TODO: return production mode for create enclave request as well
-	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false)
+	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false, labels)
 	// TODO the logs collector has a random private ip address in the enclave network that must be tracked
 	if _, err := backend.CreateLogsCollectorForEnclave(ctx, enclaveUuid, defaultTcpLogsCollectorPortNum, defaultHttpLogsCollectorPortNum); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector with TCP port number '%v' and HTTP port number '%v'", defaultTcpLogsCollectorPortNum, defaultHttpLogsCollectorPortNum)
@@ -220,6 +220,7 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 			matchingNetworkInfo.enclaveStatus,
 			creationTime,
 			productionMode,
+			docker_label_key.GetUserCustomLabelsFromLabels(matchingNetworkInfo.dockerNetwork.GetLabels()),
 		)
 	}
 
@@ -302,6 +303,34 @@ func (backend *DockerKurtosisBackend) StopEnclaves(
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+// StartEnclaves restarts the logs collector and the API container of the stopped enclaves matching the given filters;
+// the API container then takes care of resuming the user services
+func (backend *DockerKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	resultSuccessfulEnclaveUuids map[enclave.EnclaveUUID]bool,
+	resultErroredEnclaveUuids map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	matchingNetworkInfo, err := backend.getMatchingEnclaveNetworkInfo(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclave network info using filters '%+v'", filters)
+	}
+
+	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	for enclaveUuid, networkInfo := range matchingNetworkInfo {
+		if err := backend.startEnclaveContainers(ctx, networkInfo.containers); err != nil {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.Propagate(err, "An error occurred starting the containers of enclave '%v'", enclaveUuid)
+			continue
+		}
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
 func (backend *DockerKurtosisBackend) DumpEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -420,6 +449,14 @@ func (backend *DockerKurtosisBackend) getMatchingEnclaveNetworkInfo(
 		docker_label_key.AppIDDockerLabelKey.GetString(): label_value_consts.AppIDDockerLabelValue.GetString(),
 		// NOTE: we don't search by enclave ID here because Docker has no way to do disjunctive search
 	}
+	// Docker label search is conjunctive though, so the enclave labels filter can be applied by Docker itself
+	for enclaveLabelKey, enclaveLabelValue := range filters.Labels {
+		dockerLabelKey, err := docker_label_key.CreateNewDockerUserCustomLabelKey(enclaveLabelKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a user custom Docker label key from enclave label key '%s'", enclaveLabelKey)
+		}
+		kurtosisNetworkLabels[dockerLabelKey.GetString()] = enclaveLabelValue
+	}
 
 	allKurtosisNetworks, err := backend.dockerManager.GetNetworksByLabels(ctx, kurtosisNetworkLabels)
 	if err != nil {
@@ -789,3 +826,45 @@ func getProductionModeFromEnvVars(serializedParamsStr string) (bool, error) {
 	return args.IsProductionEnclave, nil
 
 }
+
+// The logs collector is started first so that the API container logs can be shipped as soon as it's up
+func (backend *DockerKurtosisBackend) startEnclaveContainers(ctx context.Context, enclaveContainers []*types.Container) error {
+	var logsCollectorContainer *types.Container
+	var apiContainer *types.Container
+	for _, container := range enclaveContainers {
+		switch container.GetLabels()[docker_label_key.ContainerTypeDockerLabelKey.GetString()] {
+		case label_value_consts.LogsCollectorTypeDockerLabelValue.GetString():
+			logsCollectorContainer = container
+		case label_value_consts.APIContainerContainerTypeDockerLabelValue.GetString():
+			apiContainer = container
+		}
+	}
+	if apiContainer == nil {
+		return stacktrace.NewError("No API container was found in the enclave; it can't be started")
+	}
+
+	for _, container := range []*types.Container{logsCollectorContainer, apiContainer} {
+		if container == nil || container.GetStatus() == types.ContainerStatus_Running {
+			continue
+		}
+		if err := backend.dockerManager.StartContainer(ctx, container.GetId()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting container '%v'", container.GetName())
+		}
+	}
+
+	privateGrpcPortSpec, err := getPrivateApiContainerPorts(apiContainer.GetLabels())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the private grpc port of API container '%v'", apiContainer.GetName())
+	}
+	if err := shared_helpers.WaitForPortAvailabilityUsingNetstat(
+		ctx,
+		backend.dockerManager,
+		apiContainer.GetId(),
+		privateGrpcPortSpec,
+		maxWaitForApiContainerAvailabilityRetries,
+		timeBetweenWaitForApiContainerAvailabilityRetries,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for the API container's grpc port to become available")
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package docker_kurtosis_backend
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	buildContextTarTmpDir         = ""
+	buildContextTarTmpFilePattern = "image-build-context-*.tar"
+)
+
+var buildContextEntriesModTime = time.Unix(0, 0)
+
+// BuildImage builds the image from the build spec using the Docker daemon, unless an image with the same name was
+// already built from the exact same build context and options, in which case the existing image is reused
+func (backend *DockerKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (bool, error) {
+	buildContextTarFile, err := os.CreateTemp(buildContextTarTmpDir, buildContextTarTmpFilePattern)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred creating a temporary file to write the build context of image '%v' to", imageName)
+	}
+	defer func() {
+		buildContextTarFile.Close()
+		if err := os.Remove(buildContextTarFile.Name()); err != nil {
+			logrus.Warnf("An error occurred removing temporary build context file '%v'; it will have to be removed manually:\n%v", buildContextTarFile.Name(), err)
+		}
+	}()
+
+	contentHash, err := writeBuildContextTar(imageBuildSpec, buildContextTarFile)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred archiving build context directory '%v' of image '%v'", imageBuildSpec.GetBuildContextDirpath(), imageName)
+	}
+
+	contentHashLabelKey := docker_label_key.ImageBuildContentHashDockerLabelKey.GetString()
+	existingImageLabels, imageExists, err := backend.dockerManager.GetImageLabels(ctx, imageName)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred checking whether image '%v' was already built", imageName)
+	}
+	if imageExists && existingImageLabels[contentHashLabelKey] == contentHash {
+		logrus.Debugf("Image '%v' was already built from the same build context, skipping its build", imageName)
+		return false, nil
+	}
+
+	if _, err = buildContextTarFile.Seek(0, io.SeekStart); err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred rewinding the build context file of image '%v'", imageName)
+	}
+	labels := map[string]string{
+		contentHashLabelKey: contentHash,
+	}
+	if err = backend.dockerManager.BuildImage(ctx, imageName, buildContextTarFile, imageBuildSpec.GetDockerfileRelativeFilepath(), imageBuildSpec.GetTargetStage(), labels); err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred building image '%v'", imageName)
+	}
+	return true, nil
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+
+// writeBuildContextTar writes the build context directory as a tar to the writer, and returns a hash of it and of the
+// build options. The tar is reproducible - entries are sorted and their timestamps and owners dropped - so the hash only
+// changes when the content does.
+func writeBuildContextTar(imageBuildSpec *image_build_spec.ImageBuildSpec, writer io.Writer) (string, error) {
+	hasher := sha256.New()
+	// The build options are part of the hash as the same context can produce different images
+	for _, buildOption := range []string{imageBuildSpec.GetDockerfileRelativeFilepath(), imageBuildSpec.GetTargetStage()} {
+		if _, err := hasher.Write([]byte(buildOption + "\x00")); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred hashing build option '%v'", buildOption)
+		}
+	}
+
+	buildContextDirpath := imageBuildSpec.GetBuildContextDirpath()
+	tarWriter := tar.NewWriter(io.MultiWriter(writer, hasher))
+	// WalkDir visits the entries in lexical order, which keeps the tar reproducible
+	err := filepath.WalkDir(buildContextDirpath, func(entryPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading '%v'", entryPath)
+		}
+		relativePath, err := filepath.Rel(buildContextDirpath, entryPath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to the build context", entryPath)
+		}
+		if relativePath == "." {
+			return nil
+		}
+		entryInfo, err := entry.Info()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the info of '%v'", entryPath)
+		}
+		var symlinkTarget string
+		if entryInfo.Mode()&fs.ModeSymlink != 0 {
+			if symlinkTarget, err = os.Readlink(entryPath); err != nil {
+				return stacktrace.Propagate(err, "An error occurred reading the target of symlink '%v'", entryPath)
+			}
+		}
+		header, err := tar.FileInfoHeader(entryInfo, symlinkTarget)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the tar header of '%v'", entryPath)
+		}
+		// Dropping what doesn't affect the image so that identical contents produce identical tars
+		header.Name = filepath.ToSlash(relativePath)
+		header.ModTime = buildContextEntriesModTime
+		header.AccessTime = time.Time{}
+		header.ChangeTime = time.Time{}
+		header.Uid = 0
+		header.Gid = 0
+		header.Uname = ""
+		header.Gname = ""
+		if err = tarWriter.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the tar header of '%v'", entryPath)
+		}
+		if !entryInfo.Mode().IsRegular() {
+			return nil
+		}
+		file, err := os.Open(entryPath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred opening '%v'", entryPath)
+		}
+		defer file.Close()
+		if _, err = io.Copy(tarWriter, file); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the content of '%v' to the tar", entryPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred walking build context directory '%v'", buildContextDirpath)
+	}
+	if err = tarWriter.Close(); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred finishing the build context tar")
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
@@ -0,0 +1,69 @@
+package docker_kurtosis_backend
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDockerfileName = "Dockerfile"
+	testFilePermission = 0o644
+)
+
+func TestWriteBuildContextTar_SameContentSameHash(t *testing.T) {
+	buildContextDirpath := createTestBuildContext(t)
+	imageBuildSpec := image_build_spec.NewImageBuildSpec(buildContextDirpath, testDockerfileName, "")
+
+	firstTar := &bytes.Buffer{}
+	firstHash, err := writeBuildContextTar(imageBuildSpec, firstTar)
+	require.NoError(t, err)
+
+	// Touching the files must not change the hash, only their content does
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(buildContextDirpath, testDockerfileName), later, later))
+	secondTar := &bytes.Buffer{}
+	secondHash, err := writeBuildContextTar(imageBuildSpec, secondTar)
+	require.NoError(t, err)
+
+	require.Equal(t, firstHash, secondHash)
+	require.Equal(t, firstTar.Bytes(), secondTar.Bytes())
+}
+
+func TestWriteBuildContextTar_DifferentContentDifferentHash(t *testing.T) {
+	buildContextDirpath := createTestBuildContext(t)
+	imageBuildSpec := image_build_spec.NewImageBuildSpec(buildContextDirpath, testDockerfileName, "")
+
+	firstHash, err := writeBuildContextTar(imageBuildSpec, &bytes.Buffer{})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(buildContextDirpath, "app", "main.sh"), []byte("echo bye"), testFilePermission))
+	secondHash, err := writeBuildContextTar(imageBuildSpec, &bytes.Buffer{})
+	require.NoError(t, err)
+
+	require.NotEqual(t, firstHash, secondHash)
+}
+
+func TestWriteBuildContextTar_DifferentTargetStageDifferentHash(t *testing.T) {
+	buildContextDirpath := createTestBuildContext(t)
+
+	firstHash, err := writeBuildContextTar(image_build_spec.NewImageBuildSpec(buildContextDirpath, testDockerfileName, ""), &bytes.Buffer{})
+	require.NoError(t, err)
+	secondHash, err := writeBuildContextTar(image_build_spec.NewImageBuildSpec(buildContextDirpath, testDockerfileName, "runtime"), &bytes.Buffer{})
+	require.NoError(t, err)
+
+	require.NotEqual(t, firstHash, secondHash)
+}
+
+func createTestBuildContext(t *testing.T) string {
+	buildContextDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(buildContextDirpath, testDockerfileName), []byte("FROM alpine\nCOPY app /app\n"), testFilePermission))
+	require.NoError(t, os.Mkdir(filepath.Join(buildContextDirpath, "app"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(buildContextDirpath, "app", "main.sh"), []byte("echo hi"), testFilePermission))
+	return buildContextDirpath
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
@@ -36,6 +37,7 @@ func CreateEngine(
 	imageVersionTag string,
 	grpcPortNum uint16,
 	envVars map[string]string,
+	logsSinks logs_aggregator.Sinks,
 	dockerManager *docker_manager.DockerManager,
 	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 ) (
@@ -74,6 +76,22 @@ func CreateEngine(
 	}
 	targetNetworkId := engineNetwork.GetId()
 
+	engineDbAttrs, err := objAttrsProvider.ForEngineDbVolume()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving the engine database volume object attributes")
+	}
+	engineDbVolNameStr := engineDbAttrs.GetName().GetString()
+	engineDbVolumeLabelStrs := map[string]string{}
+	for labelKey, labelValue := range engineDbAttrs.GetLabels() {
+		engineDbVolumeLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+
+	// The engine database volume is kept across engine restarts, and its creation is idempotent, so we don't defer an
+	// undo volume if this operation fails
+	if err = dockerManager.CreateVolume(ctx, engineDbVolNameStr, engineDbVolumeLabelStrs); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine database volume '%v'", engineDbVolNameStr)
+	}
+
 	logrus.Infof("Starting the centralized logs components...")
 	logsStorageAttrs, err := objAttrsProvider.ForLogsStorageVolume()
 	if err != nil {
@@ -91,7 +109,7 @@ func CreateEngine(
 		return nil, stacktrace.Propagate(err, "An error occurred creating logs storage.")
 	}
 
-	logsAggregatorContainer := vector.NewVectorLogsAggregatorContainer() // Declaring implementation
+	logsAggregatorContainer := vector.NewVectorLogsAggregatorContainer(logsSinks) // Declaring implementation
 	_, removeLogsAggregatorFunc, err := logs_aggregator_functions.CreateLogsAggregator(
 		ctx,
 		logsAggregatorContainer,
@@ -176,6 +194,7 @@ func CreateEngine(
 
 	volumeMounts := map[string]string{
 		logsStorageVolNameStr: logsStorageDirpath,
+		engineDbVolNameStr:    engine.EngineDatabaseDirpath,
 	}
 
 	if serverArgs.OnBastionHost {
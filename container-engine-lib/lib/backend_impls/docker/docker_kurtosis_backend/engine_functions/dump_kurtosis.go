@@ -19,7 +19,7 @@ const (
 	errorSeparator             = "\n\n"
 )
 
-var allEnclavesFilter = &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil}
+var allEnclavesFilter = &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil, Labels: nil}
 
 func DumpKurtosis(ctx context.Context, outputDirpath string, backend backend_interface.KurtosisBackend) error {
 
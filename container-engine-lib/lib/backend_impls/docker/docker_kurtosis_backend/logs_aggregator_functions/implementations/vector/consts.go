@@ -11,6 +11,9 @@ const (
 	configFileFlag = "-c"
 
 	logsStorageDirpath = "/var/log/kurtosis/"
+
+	// The directories of the host that the file sinks write to are mounted under this directory, one per sink
+	fileSinksDirpath = "/var/log/kurtosis-sinks/"
 	////////////////////////--FINISH VECTOR CONTAINER CONFIGURATION SECTION--/////////////////////////////
 
 	////////////////////////--VECTOR CONFIGURATION SECTION--/////////////////////////////
@@ -20,6 +23,40 @@ const (
 
 	fileSinkIdSuffix = "file"
 	fileTypeId       = "\"file\""
+	lokiTypeId       = "\"loki\""
+	httpTypeId       = "\"http\""
+	remapTypeId      = "\"remap\""
+
+	// The sinks configured by the user are prefixed so that they can't clash with the logs storage sinks
+	exportSinkIdPrefix           = "export_"
+	otlpTransformIdSuffix        = "_otlp"
+	exportFileSinkFilepathFormat = "\"" + fileSinksDirpath + "%s/{{ enclave_uuid }}/{{ service_name }}.json\""
+
+	contentTypeHeaderName = "Content-Type"
+	jsonContentType       = "application/json"
+
+	// Converts the log events to OTLP/HTTP JSON logs requests, one per log line
+	// https://opentelemetry.io/docs/specs/otlp/#otlphttp-request
+	otlpRemapProgram = `
+ts = timestamp(.timestamp) ?? now()
+. = {
+  "resourceLogs": [{
+    "resource": {
+      "attributes": [
+        {"key": "service.name", "value": {"stringValue": to_string(.service_name) ?? ""}},
+        {"key": "kurtosis.enclave.uuid", "value": {"stringValue": to_string(.enclave_uuid) ?? ""}},
+        {"key": "kurtosis.service.uuid", "value": {"stringValue": to_string(.service_uuid) ?? ""}}
+      ]
+    },
+    "scopeLogs": [{
+      "logRecords": [{
+        "timeUnixNano": to_string(to_unix_timestamp(ts, unit: "nanoseconds")),
+        "body": {"stringValue": to_string(.log) ?? ""}
+      }]
+    }]
+  }]
+}
+`
 
 	// We instruct vector to store log files per-year, per-week (00-53), per-enclave, per-service
 	// To construct the filepath, we utilize vectors template syntax that allows us to reference fields in log events
@@ -35,8 +72,11 @@ const (
 	nameLogsFilepath      = baseLogsFilepath + "{{ enclave_uuid }}/{{ service_name }}.json\""
 	shortUUIDLogsFilepath = baseLogsFilepath + "{{ enclave_uuid }}/{{ service_short_uuid }}.json\""
 
-	sourceConfigFileTemplateName = "srcVectorConfigFileTemplate"
-	sinkConfigFileTemplateName   = "sinkVectorConfigFileTemplate"
+	sourceConfigFileTemplateName    = "srcVectorConfigFileTemplate"
+	sinkConfigFileTemplateName      = "sinkVectorConfigFileTemplate"
+	lokiSinkConfigFileTemplateName  = "lokiSinkVectorConfigFileTemplate"
+	httpSinkConfigFileTemplateName  = "httpSinkVectorConfigFileTemplate"
+	transformConfigFileTemplateName = "transformVectorConfigFileTemplate"
 
 	// Note: we set buffer to block so that we don't drop any logs, however this could apply backpressure up the topology
 	// if we start noticing slowdown due to vector buffer blocking, we might want to revisit our architecture
@@ -52,6 +92,44 @@ inputs = {{ .Inputs }}
 path = {{ .Filepath }}	
 encoding.codec = "json"
 buffer.when_full = "block"
+`
+
+	// Vector's default buffer blocks when it's full, which would push backpressure onto the shared source and stall the
+	// logs storage sinks, so the sinks outside of Kurtosis drop the newest logs instead when their endpoint is unreachable
+	lokiSinkConfigFileTemplate = `
+[sinks.{{ .Id }}]
+type = {{ .Type }}
+inputs = {{ .Inputs }}
+endpoint = "{{ .Endpoint }}"
+encoding.codec = "json"
+buffer.when_full = "drop_newest"
+{{- range $name, $value := .Labels }}
+labels.{{ $name }} = "{{ $value }}"
+{{- end }}
+{{- range $name, $value := .Headers }}
+request.headers."{{ $name }}" = "{{ $value }}"
+{{- end }}
+`
+	httpSinkConfigFileTemplate = `
+[sinks.{{ .Id }}]
+type = {{ .Type }}
+inputs = {{ .Inputs }}
+uri = "{{ .Endpoint }}"
+encoding.codec = "json"
+buffer.when_full = "drop_newest"
+{{- if .IsOneEventPerRequest }}
+framing.method = "newline_delimited"
+batch.max_events = 1
+{{- end }}
+{{- range $name, $value := .Headers }}
+request.headers."{{ $name }}" = "{{ $value }}"
+{{- end }}
+`
+	transformConfigFileTemplate = `
+[transforms.{{ .Id }}]
+type = {{ .Type }}
+inputs = {{ .Inputs }}
+source = """{{ .Program }}"""
 `
 	////////////////////////--FINISH--VECTOR CONFIGURATION SECTION--/////////////////////////////
 )
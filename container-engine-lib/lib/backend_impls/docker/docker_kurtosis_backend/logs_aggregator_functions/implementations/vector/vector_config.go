@@ -3,14 +3,29 @@ package vector
 import (
 	"bytes"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/stacktrace"
+	"path"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"text/template"
 )
 
+const (
+	forbiddenExportSinkValueChars = "'\"\\\n"
+)
+
+var (
+	exportSinkNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+	headerNameRegex     = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+)
+
 type VectorConfig struct {
-	Source *Source
-	Sinks  []*Sink
+	Source     *Source
+	Transforms []*Transform
+	Sinks      []*Sink
 }
 
 type Source struct {
@@ -19,11 +34,127 @@ type Source struct {
 	Address string
 }
 
+type Transform struct {
+	Id      string
+	Type    string
+	Inputs  []string
+	Program string
+}
+
 type Sink struct {
 	Id       string
 	Type     string
 	Inputs   []string
 	Filepath string
+
+	// Only used by the sinks sending the logs over HTTP
+	Endpoint             string
+	Headers              map[string]string
+	Labels               map[string]string
+	IsOneEventPerRequest bool
+}
+
+// newVectorConfig returns the default config, that writes the logs to the logs storage, along with the sinks sending
+// the logs outside of Kurtosis
+func newVectorConfig(listeningPortNumber uint16, exportSinks logs_aggregator.Sinks) (*VectorConfig, error) {
+	config := newDefaultVectorConfig(listeningPortNumber)
+
+	exportSinkNames := make([]string, 0, len(exportSinks))
+	for sinkName := range exportSinks {
+		exportSinkNames = append(exportSinkNames, sinkName)
+	}
+	sort.Strings(exportSinkNames)
+
+	for _, sinkName := range exportSinkNames {
+		exportSink := exportSinks[sinkName]
+		if err := validateExportSink(sinkName, exportSink); err != nil {
+			return nil, stacktrace.Propagate(err, "Logs sink '%s' is invalid", sinkName)
+		}
+		sinkId := exportSinkIdPrefix + sinkName
+		headers := map[string]string{}
+		for headerName, headerValue := range exportSink.GetHeaders() {
+			headers[headerName] = escapePercentSigns(headerValue)
+		}
+		endpoint := escapePercentSigns(exportSink.GetEndpoint())
+
+		switch exportSink.GetType() {
+		case logs_aggregator.SinkType_OtlpHttp:
+			transformId := sinkId + otlpTransformIdSuffix
+			config.Transforms = append(config.Transforms, &Transform{
+				Id:      transformId,
+				Type:    remapTypeId,
+				Inputs:  []string{fluentBitSourceId},
+				Program: otlpRemapProgram,
+			})
+			if _, found := headers[contentTypeHeaderName]; !found {
+				headers[contentTypeHeaderName] = jsonContentType
+			}
+			config.Sinks = append(config.Sinks, &Sink{
+				Id:                   sinkId,
+				Type:                 httpTypeId,
+				Inputs:               []string{fmt.Sprintf("%q", transformId)},
+				Filepath:             "",
+				Endpoint:             endpoint,
+				Headers:              headers,
+				Labels:               nil,
+				IsOneEventPerRequest: true,
+			})
+		case logs_aggregator.SinkType_Loki:
+			config.Sinks = append(config.Sinks, &Sink{
+				Id:       sinkId,
+				Type:     lokiTypeId,
+				Inputs:   []string{fluentBitSourceId},
+				Filepath: "",
+				Endpoint: endpoint,
+				Headers:  headers,
+				// Vector's template syntax, so that the logs can be queried by enclave and by service
+				Labels: map[string]string{
+					"enclave_uuid": "{{ enclave_uuid }}",
+					"service_name": "{{ service_name }}",
+					"service_uuid": "{{ service_uuid }}",
+				},
+				IsOneEventPerRequest: false,
+			})
+		case logs_aggregator.SinkType_Http:
+			config.Sinks = append(config.Sinks, &Sink{
+				Id:                   sinkId,
+				Type:                 httpTypeId,
+				Inputs:               []string{fluentBitSourceId},
+				Filepath:             "",
+				Endpoint:             endpoint,
+				Headers:              headers,
+				Labels:               nil,
+				IsOneEventPerRequest: false,
+			})
+		case logs_aggregator.SinkType_File:
+			config.Sinks = append(config.Sinks, &Sink{
+				Id:                   sinkId,
+				Type:                 fileTypeId,
+				Inputs:               []string{fluentBitSourceId},
+				Filepath:             fmt.Sprintf(exportFileSinkFilepathFormat, sinkName),
+				Endpoint:             "",
+				Headers:              nil,
+				Labels:               nil,
+				IsOneEventPerRequest: false,
+			})
+		default:
+			return nil, stacktrace.NewError("Logs sink '%s' has unrecognized type '%v'", sinkName, exportSink.GetType())
+		}
+	}
+	return config, nil
+}
+
+// getExportFileSinkBindMounts returns the directories of the host the file sinks write to, mapped to where they're
+// mounted in the logs aggregator container
+func getExportFileSinkBindMounts(exportSinks logs_aggregator.Sinks) map[string]string {
+	bindMounts := map[string]string{}
+	for sinkName, exportSink := range exportSinks {
+		if exportSink.GetType() != logs_aggregator.SinkType_File {
+			continue
+		}
+		bindMounts[exportSink.GetDirectory()] = fileSinksDirpath + sinkName
+	}
+	return bindMounts
 }
 
 func newDefaultVectorConfig(listeningPortNumber uint16) *VectorConfig {
@@ -33,24 +164,37 @@ func newDefaultVectorConfig(listeningPortNumber uint16) *VectorConfig {
 			Type:    fluentBitSourceType,
 			Address: fmt.Sprintf("%s:%s", fluentBitSourceIpAddress, strconv.Itoa(int(listeningPortNumber))),
 		},
+		Transforms: nil,
 		Sinks: []*Sink{
 			{
-				Id:       "uuid_" + fileSinkIdSuffix,
-				Type:     fileTypeId,
-				Inputs:   []string{fluentBitSourceId},
-				Filepath: uuidLogsFilepath,
+				Id:                   "uuid_" + fileSinkIdSuffix,
+				Type:                 fileTypeId,
+				Inputs:               []string{fluentBitSourceId},
+				Filepath:             uuidLogsFilepath,
+				Endpoint:             "",
+				Headers:              nil,
+				Labels:               nil,
+				IsOneEventPerRequest: false,
 			},
 			{
-				Id:       "name_" + fileSinkIdSuffix,
-				Type:     fileTypeId,
-				Inputs:   []string{fluentBitSourceId},
-				Filepath: nameLogsFilepath,
+				Id:                   "name_" + fileSinkIdSuffix,
+				Type:                 fileTypeId,
+				Inputs:               []string{fluentBitSourceId},
+				Filepath:             nameLogsFilepath,
+				Endpoint:             "",
+				Headers:              nil,
+				Labels:               nil,
+				IsOneEventPerRequest: false,
 			},
 			{
-				Id:       "short_uuid_" + fileSinkIdSuffix,
-				Type:     fileTypeId,
-				Inputs:   []string{fluentBitSourceId},
-				Filepath: shortUUIDLogsFilepath,
+				Id:                   "short_uuid_" + fileSinkIdSuffix,
+				Type:                 fileTypeId,
+				Inputs:               []string{fluentBitSourceId},
+				Filepath:             shortUUIDLogsFilepath,
+				Endpoint:             "",
+				Headers:              nil,
+				Labels:               nil,
+				IsOneEventPerRequest: false,
 			},
 		},
 	}
@@ -61,18 +205,44 @@ func (cfg *VectorConfig) getConfigFileContent() (string, error) {
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred parsing Vector's source config template.")
 	}
+	transformCfgFileTemplate, err := template.New(transformConfigFileTemplateName).Parse(transformConfigFileTemplate)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing Vector's transform config template.")
+	}
 	sinkCfgFileTemplate, err := template.New(sinkConfigFileTemplateName).Parse(sinkConfigFileTemplate)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred parsing Vector's sink config template.")
 	}
+	lokiSinkCfgFileTemplate, err := template.New(lokiSinkConfigFileTemplateName).Parse(lokiSinkConfigFileTemplate)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing Vector's Loki sink config template.")
+	}
+	httpSinkCfgFileTemplate, err := template.New(httpSinkConfigFileTemplateName).Parse(httpSinkConfigFileTemplate)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing Vector's HTTP sink config template.")
+	}
+	sinkCfgFileTemplatesByType := map[string]*template.Template{
+		fileTypeId: sinkCfgFileTemplate,
+		lokiTypeId: lokiSinkCfgFileTemplate,
+		httpTypeId: httpSinkCfgFileTemplate,
+	}
 
 	templateStrBuffer := &bytes.Buffer{}
 
 	if err := srcCfgFileTemplate.Execute(templateStrBuffer, cfg.Source); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred executing Vector's source config file template.")
 	}
+	for _, transform := range cfg.Transforms {
+		if err := transformCfgFileTemplate.Execute(templateStrBuffer, transform); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred executing Vector's transform config file template.")
+		}
+	}
 	for _, sink := range cfg.Sinks {
-		if err := sinkCfgFileTemplate.Execute(templateStrBuffer, sink); err != nil {
+		sinkCfgFileTemplateForType, found := sinkCfgFileTemplatesByType[sink.Type]
+		if !found {
+			return "", stacktrace.NewError("No Vector sink config file template exists for sink type '%s'", sink.Type)
+		}
+		if err := sinkCfgFileTemplateForType.Execute(templateStrBuffer, sink); err != nil {
 			return "", stacktrace.Propagate(err, "An error occurred executing Vector's sink config file template.")
 		}
 	}
@@ -81,3 +251,36 @@ func (cfg *VectorConfig) getConfigFileContent() (string, error) {
 
 	return templateStr, nil
 }
+
+// The config file is written with printf in the logs aggregator container, so the values provided by the user can't
+// contain quotes or backslashes, and their percent signs must be escaped
+func validateExportSink(sinkName string, exportSink *logs_aggregator.Sink) error {
+	if !exportSinkNameRegex.MatchString(sinkName) {
+		return stacktrace.NewError("The name of the sink must match '%s'", exportSinkNameRegex.String())
+	}
+	if exportSink.GetType() == logs_aggregator.SinkType_File {
+		if !path.IsAbs(exportSink.GetDirectory()) {
+			return stacktrace.NewError("The directory of a file sink must be an absolute path but was '%s'", exportSink.GetDirectory())
+		}
+		return nil
+	}
+	if exportSink.GetEndpoint() == "" {
+		return stacktrace.NewError("An endpoint is required for a sink of type '%v'", exportSink.GetType())
+	}
+	if strings.ContainsAny(exportSink.GetEndpoint(), forbiddenExportSinkValueChars) {
+		return stacktrace.NewError("The endpoint '%s' can't contain quotes, backslashes or line breaks", exportSink.GetEndpoint())
+	}
+	for headerName, headerValue := range exportSink.GetHeaders() {
+		if !headerNameRegex.MatchString(headerName) {
+			return stacktrace.NewError("The header name '%s' must match '%s'", headerName, headerNameRegex.String())
+		}
+		if strings.ContainsAny(headerValue, forbiddenExportSinkValueChars) {
+			return stacktrace.NewError("The value of header '%s' can't contain quotes, backslashes or line breaks", headerName)
+		}
+	}
+	return nil
+}
+
+func escapePercentSigns(value string) string {
+	return strings.ReplaceAll(value, "%", "%%")
+}
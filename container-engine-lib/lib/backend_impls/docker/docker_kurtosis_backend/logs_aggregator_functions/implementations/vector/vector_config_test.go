@@ -0,0 +1,87 @@
+package vector
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testListeningPortNumber = uint16(24224)
+)
+
+func TestNewVectorConfig_ExportSinksAreRendered(t *testing.T) {
+	exportSinks := logs_aggregator.Sinks{
+		"collector": logs_aggregator.NewSink(logs_aggregator.SinkType_OtlpHttp, "http://collector:4318/v1/logs", nil, ""),
+		"loki":      logs_aggregator.NewSink(logs_aggregator.SinkType_Loki, "http://loki:3100", map[string]string{"X-Scope-OrgID": "team-1"}, ""),
+		"receiver":  logs_aggregator.NewSink(logs_aggregator.SinkType_Http, "http://receiver:8080/logs?sampling=100%", nil, ""),
+		"archive":   logs_aggregator.NewSink(logs_aggregator.SinkType_File, "", nil, "/home/user/logs"),
+	}
+	config, err := newVectorConfig(testListeningPortNumber, exportSinks)
+	require.NoError(t, err)
+
+	configFileContent, err := config.getConfigFileContent()
+	require.NoError(t, err)
+
+	require.Contains(t, configFileContent, `
+[transforms.export_collector_otlp]
+type = "remap"
+inputs = ["fluent_bit"]
+`)
+	require.Contains(t, configFileContent, `
+[sinks.export_collector]
+type = "http"
+inputs = ["export_collector_otlp"]
+uri = "http://collector:4318/v1/logs"
+encoding.codec = "json"
+buffer.when_full = "drop_newest"
+framing.method = "newline_delimited"
+batch.max_events = 1
+request.headers."Content-Type" = "application/json"
+`)
+	require.Contains(t, configFileContent, `
+[sinks.export_loki]
+type = "loki"
+inputs = ["fluent_bit"]
+endpoint = "http://loki:3100"
+encoding.codec = "json"
+buffer.when_full = "drop_newest"
+labels.enclave_uuid = "{{ enclave_uuid }}"
+labels.service_name = "{{ service_name }}"
+labels.service_uuid = "{{ service_uuid }}"
+request.headers."X-Scope-OrgID" = "team-1"
+`)
+	// percent signs are escaped as the config file is written with printf
+	require.Contains(t, configFileContent, `
+[sinks.export_receiver]
+type = "http"
+inputs = ["fluent_bit"]
+uri = "http://receiver:8080/logs?sampling=100%%"
+encoding.codec = "json"
+buffer.when_full = "drop_newest"
+`)
+	require.Contains(t, configFileContent, `path = "/var/log/kurtosis-sinks/archive/{{ enclave_uuid }}/{{ service_name }}.json"`)
+
+	require.Equal(t, map[string]string{"/home/user/logs": "/var/log/kurtosis-sinks/archive"}, getExportFileSinkBindMounts(exportSinks))
+}
+
+func TestNewVectorConfig_InvalidExportSinksAreRejected(t *testing.T) {
+	invalidExportSinks := []logs_aggregator.Sinks{
+		{"my-sink": logs_aggregator.NewSink(logs_aggregator.SinkType_Http, "http://receiver:8080", nil, "")},
+		{"receiver": logs_aggregator.NewSink(logs_aggregator.SinkType_Http, "", nil, "")},
+		{"receiver": logs_aggregator.NewSink(logs_aggregator.SinkType_Http, "http://receiver:8080/'", nil, "")},
+		{"receiver": logs_aggregator.NewSink(logs_aggregator.SinkType_Http, "http://receiver:8080", map[string]string{"Authorization": "Bearer \"token\""}, "")},
+		{"archive": logs_aggregator.NewSink(logs_aggregator.SinkType_File, "", nil, "logs")},
+	}
+	for _, exportSinks := range invalidExportSinks {
+		_, err := newVectorConfig(testListeningPortNumber, exportSinks)
+		require.Error(t, err)
+	}
+}
+
+func TestNewVectorConfig_NoExportSinks(t *testing.T) {
+	config, err := newVectorConfig(testListeningPortNumber, nil)
+	require.NoError(t, err)
+	require.Equal(t, newDefaultVectorConfig(testListeningPortNumber), config)
+	require.Empty(t, getExportFileSinkBindMounts(nil))
+}
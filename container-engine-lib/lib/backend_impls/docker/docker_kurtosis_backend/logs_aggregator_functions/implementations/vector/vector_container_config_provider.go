@@ -14,10 +14,16 @@ const (
 
 type vectorContainerConfigProvider struct {
 	config *VectorConfig
+
+	// The directories of the host that the file sinks write to, mapped to where they're mounted in the container
+	exportFileSinkBindMounts map[string]string
 }
 
-func newVectorContainerConfigProvider(config *VectorConfig) *vectorContainerConfigProvider {
-	return &vectorContainerConfigProvider{config: config}
+func newVectorContainerConfigProvider(config *VectorConfig, exportFileSinkBindMounts map[string]string) *vectorContainerConfigProvider {
+	return &vectorContainerConfigProvider{
+		config:                   config,
+		exportFileSinkBindMounts: exportFileSinkBindMounts,
+	}
 }
 
 func (vector *vectorContainerConfigProvider) GetContainerArgs(
@@ -64,6 +70,8 @@ func (vector *vectorContainerConfigProvider) GetContainerArgs(
 		containerLabels,
 	).WithVolumeMounts(
 		volumeMounts,
+	).WithBindMounts(
+		vector.exportFileSinkBindMounts,
 	).WithEntrypointArgs(
 		[]string{
 			shBinaryFilepath,
@@ -1,6 +1,14 @@
 package vector
 
-func createVectorContainerConfigProvider(portNumber uint16) *vectorContainerConfigProvider {
-	config := newDefaultVectorConfig(portNumber)
-	return newVectorContainerConfigProvider(config)
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func createVectorContainerConfigProvider(portNumber uint16, exportSinks logs_aggregator.Sinks) (*vectorContainerConfigProvider, error) {
+	config, err := newVectorConfig(portNumber, exportSinks)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Vector config")
+	}
+	return newVectorContainerConfigProvider(config, getExportFileSinkBindMounts(exportSinks)), nil
 }
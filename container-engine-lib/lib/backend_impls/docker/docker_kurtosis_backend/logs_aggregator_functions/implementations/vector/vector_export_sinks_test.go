@@ -0,0 +1,201 @@
+package vector
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	vectorBinaryName = "vector"
+
+	testLogLine        = "hello from the export sinks test"
+	testEnclaveUuid    = "test-enclave-uuid"
+	testServiceName    = "test-service"
+	testServiceUuid    = "test-service-uuid"
+	testFluentTag      = "test"
+	otlpLogsRoute      = "/v1/logs"
+	receiverLogsRoute  = "/logs"
+	vectorStartTimeout = 30 * time.Second
+	logsExportTimeout  = 30 * time.Second
+	pollInterval       = 200 * time.Millisecond
+
+	configFilePerms = 0644
+)
+
+// TestVectorConfig_LogsReachExportSinks runs Vector with the rendered config and a stand-in HTTP receiver as the
+// sinks, then sends a log line the way Fluent Bit does and checks it reaches every sink. It needs the Vector binary,
+// which runs in the logs aggregator container, to be installed locally
+func TestVectorConfig_LogsReachExportSinks(t *testing.T) {
+	vectorBinaryPath, err := exec.LookPath(vectorBinaryName)
+	if err != nil {
+		t.Skipf("Skipping as the '%s' binary can't be found in the PATH", vectorBinaryName)
+	}
+
+	receiver := newStandInReceiver()
+	receiverServer := httptest.NewServer(receiver)
+	defer receiverServer.Close()
+
+	listeningPortNumber := getFreePortNumber(t)
+	exportSinks := logs_aggregator.Sinks{
+		"collector": logs_aggregator.NewSink(logs_aggregator.SinkType_OtlpHttp, receiverServer.URL+otlpLogsRoute, nil, ""),
+		"receiver":  logs_aggregator.NewSink(logs_aggregator.SinkType_Http, receiverServer.URL+receiverLogsRoute, map[string]string{"X-Test": "export-sinks"}, ""),
+	}
+	config, err := newVectorConfig(listeningPortNumber, exportSinks)
+	require.NoError(t, err)
+	configFileContent, err := config.getConfigFileContent()
+	require.NoError(t, err)
+
+	// the config is written with printf in the container, and the logs storage is replaced by a local directory
+	workDirpath := t.TempDir()
+	configFileContent = strings.ReplaceAll(configFileContent, "%%", "%")
+	configFileContent = strings.ReplaceAll(configFileContent, logsStorageDirpath, workDirpath+"/")
+	configFileContent = fmt.Sprintf("data_dir = %q\n%s", workDirpath, configFileContent)
+	configFilepath := path.Join(workDirpath, "vector.toml")
+	require.NoError(t, os.WriteFile(configFilepath, []byte(configFileContent), configFilePerms))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vectorCmd := exec.CommandContext(ctx, vectorBinaryPath, configFileFlag, configFilepath)
+	require.NoError(t, vectorCmd.Start())
+	defer func() {
+		cancel()
+		_ = vectorCmd.Wait()
+	}()
+
+	fluentConn := dialWithRetries(t, fmt.Sprintf("127.0.0.1:%d", listeningPortNumber), vectorStartTimeout)
+	defer fluentConn.Close()
+	_, err = fluentConn.Write(encodeFluentForwardMessage(testFluentTag, time.Now(), map[string]string{
+		"log":          testLogLine,
+		"enclave_uuid": testEnclaveUuid,
+		"service_name": testServiceName,
+		"service_uuid": testServiceUuid,
+	}))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return receiver.hasReceived(otlpLogsRoute, testLogLine) && receiver.hasReceived(receiverLogsRoute, testLogLine)
+	}, logsExportTimeout, pollInterval, "The log line didn't reach every sink, requests received: %v", receiver.getRequestBodies())
+
+	otlpRequestBody := strings.Join(receiver.getRequestBodies()[otlpLogsRoute], "")
+	require.Contains(t, otlpRequestBody, `"resourceLogs"`)
+	require.Contains(t, otlpRequestBody, testServiceName)
+	require.Equal(t, "export-sinks", receiver.getHeader(receiverLogsRoute, "X-Test"))
+}
+
+// standInReceiver records the bodies and headers of the requests it receives, by route
+type standInReceiver struct {
+	mutex         sync.Mutex
+	requestBodies map[string][]string
+	headers       map[string]http.Header
+}
+
+func newStandInReceiver() *standInReceiver {
+	return &standInReceiver{
+		mutex:         sync.Mutex{},
+		requestBodies: map[string][]string{},
+		headers:       map[string]http.Header{},
+	}
+}
+
+func (receiver *standInReceiver) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	receiver.mutex.Lock()
+	receiver.requestBodies[request.URL.Path] = append(receiver.requestBodies[request.URL.Path], string(body))
+	receiver.headers[request.URL.Path] = request.Header.Clone()
+	receiver.mutex.Unlock()
+	writer.WriteHeader(http.StatusOK)
+}
+
+func (receiver *standInReceiver) hasReceived(route string, content string) bool {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+	for _, body := range receiver.requestBodies[route] {
+		if strings.Contains(body, content) {
+			return true
+		}
+	}
+	return false
+}
+
+func (receiver *standInReceiver) getRequestBodies() map[string][]string {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+	requestBodies := map[string][]string{}
+	for route, bodies := range receiver.requestBodies {
+		requestBodies[route] = append([]string{}, bodies...)
+	}
+	return requestBodies
+}
+
+func (receiver *standInReceiver) getHeader(route string, headerName string) string {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+	return receiver.headers[route].Get(headerName)
+}
+
+func getFreePortNumber(t *testing.T) uint16 {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	tcpAddr, ok := listener.Addr().(*net.TCPAddr)
+	require.True(t, ok)
+	return uint16(tcpAddr.Port)
+}
+
+func dialWithRetries(t *testing.T, address string, timeout time.Duration) net.Conn {
+	deadline := time.Now().Add(timeout)
+	for {
+		conn, err := net.Dial("tcp", address)
+		if err == nil {
+			return conn
+		}
+		if time.Now().After(deadline) {
+			require.NoError(t, err, "Vector didn't start listening on '%s' in time", address)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// encodeFluentForwardMessage encodes a log record in the Message mode of the Fluent Forward protocol, which is the
+// MessagePack array [tag, time, record]
+// https://github.com/fluent/fluentd/wiki/Forward-Protocol-Specification-v1#message-modes
+func encodeFluentForwardMessage(tag string, timestamp time.Time, record map[string]string) []byte {
+	message := []byte{0x93} // fixarray of 3 elements
+	message = appendMsgpackString(message, tag)
+	message = append(message, 0xce) // uint32
+	message = binary.BigEndian.AppendUint32(message, uint32(timestamp.Unix()))
+	message = append(message, 0x80|byte(len(record))) // fixmap, the record has less than 16 entries
+	for key, value := range record {
+		message = appendMsgpackString(message, key)
+		message = appendMsgpackString(message, value)
+	}
+	return message
+}
+
+func appendMsgpackString(message []byte, value string) []byte {
+	if len(value) < 32 {
+		message = append(message, 0xa0|byte(len(value))) // fixstr
+	} else {
+		message = append(message, 0xd9, byte(len(value))) // str 8, the values used here are shorter than 256 bytes
+	}
+	return append(message, value...)
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
 
-type vectorLogsAggregatorContainer struct{}
+type vectorLogsAggregatorContainer struct {
+	// Where the logs are sent to, on top of the logs storage
+	exportSinks logs_aggregator.Sinks
+}
 
-func NewVectorLogsAggregatorContainer() *vectorLogsAggregatorContainer {
-	return &vectorLogsAggregatorContainer{}
+func NewVectorLogsAggregatorContainer(exportSinks logs_aggregator.Sinks) *vectorLogsAggregatorContainer {
+	return &vectorLogsAggregatorContainer{exportSinks: exportSinks}
 }
 
 func (vectorContainer *vectorLogsAggregatorContainer) CreateAndStart(
@@ -21,7 +25,10 @@ func (vectorContainer *vectorLogsAggregatorContainer) CreateAndStart(
 	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 	dockerManager *docker_manager.DockerManager,
 ) (string, map[string]string, func(), error) {
-	vectorContainerConfigProviderObj := createVectorContainerConfigProvider(logsListeningPortNumber)
+	vectorContainerConfigProviderObj, err := createVectorContainerConfigProvider(logsListeningPortNumber, vectorContainer.exportSinks)
+	if err != nil {
+		return "", nil, nil, stacktrace.Propagate(err, "An error occurred creating the logs aggregator container config provider.")
+	}
 
 	logsAggregatorAttrs, err := objAttrsProvider.ForLogsAggregator()
 	if err != nil {
@@ -638,19 +638,29 @@ func getUserServiceObjsFromDockerResources(
 		if isContainerRunning {
 			serviceContainerStatus = container.ContainerStatus_Running
 		}
+		serviceContainerObj := container.NewContainer(
+			serviceContainerStatus,
+			serviceContainer.GetImageName(),
+			serviceContainer.GetEntrypointArgs(),
+			serviceContainer.GetCmdArgs(),
+			serviceContainer.GetEnvVars(),
+		)
+		if containerStatus == types.ContainerStatus_Exited {
+			serviceContainerObj = container.NewExitedContainer(
+				serviceContainer.GetImageName(),
+				serviceContainer.GetEntrypointArgs(),
+				serviceContainer.GetCmdArgs(),
+				serviceContainer.GetEnvVars(),
+				serviceContainer.GetExitCode(),
+			)
+		}
 
 		result[serviceUuid] = service.NewService(
 			registration,
 			privatePorts,
 			maybePublicIp,
 			maybePublicPorts,
-			container.NewContainer(
-				serviceContainerStatus,
-				serviceContainer.GetImageName(),
-				serviceContainer.GetEntrypointArgs(),
-				serviceContainer.GetCmdArgs(),
-				serviceContainer.GetEnvVars(),
-			),
+			serviceContainerObj,
 		)
 	}
 	return result, nil
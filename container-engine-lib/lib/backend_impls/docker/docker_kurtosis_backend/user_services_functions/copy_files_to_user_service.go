@@ -0,0 +1,54 @@
+package user_service_functions
+
+import (
+	"bytes"
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+)
+
+const (
+	mkdirSuccessExitCode = 0
+)
+
+// CopyFilesToUserService extracts the TAR'd content into the given directory of the user service container, creating the
+// directory if it doesn't exist
+func CopyFilesToUserService(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpathOnContainer string,
+	tarContent io.Reader,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	_, serviceDockerResources, err := getSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting user service with UUID '%v' in enclave with ID '%v'", serviceUuid, enclaveId)
+	}
+	container := serviceDockerResources.ServiceContainer
+
+	// Docker only extracts content into existing directories, so the destination gets created beforehand. The images
+	// without 'mkdir' are still able to receive content into their existing directories
+	mkdirCmd := []string{"mkdir", "-p", dstDirpathOnContainer}
+	mkdirOutput := &bytes.Buffer{}
+	exitCode, err := dockerManager.RunExecCommand(ctx, container.GetId(), mkdirCmd, mkdirOutput)
+	if err != nil || exitCode != mkdirSuccessExitCode {
+		logrus.Debugf("Couldn't create directory '%v' in container '%v', the copy will only succeed if it exists. Exit code was '%v', output was:\n%v\nError was:\n%v", dstDirpathOnContainer, container.GetName(), exitCode, mkdirOutput.String(), err)
+	}
+
+	if err := dockerManager.CopyToContainer(ctx, container.GetId(), dstDirpathOnContainer, tarContent); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying content to destination path '%v' in container '%v' for user service '%v' in enclave '%v'",
+			dstDirpathOnContainer,
+			container.GetName(),
+			serviceUuid,
+			enclaveId,
+		)
+	}
+	return nil
+}
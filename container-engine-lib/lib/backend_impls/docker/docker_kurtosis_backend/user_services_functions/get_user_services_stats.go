@@ -0,0 +1,153 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/docker/docker/api/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
+	"github.com/kurtosis-tech/stacktrace"
+	"reflect"
+	"strings"
+)
+
+const (
+	millicoresPerCpu = 1000
+
+	// The page cache the container filled is part of its memory usage for the kernel, but it can be reclaimed at any
+	// time, so like 'docker stats' we leave out the inactive part of it. The stat has a different name with cgroup v1
+	cgroupV1InactiveFileMemoryStatKey = "total_inactive_file"
+	cgroupV2InactiveFileMemoryStatKey = "inactive_file"
+
+	blockIOReadOp  = "read"
+	blockIOWriteOp = "write"
+)
+
+func GetUserServicesStats(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	map[service.ServiceUUID]*service_stats.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	_, allDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveId, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+
+	successfulUserServicesStats := map[service.ServiceUUID]*service_stats.ServiceStats{}
+	erroredUserServices := map[service.ServiceUUID]error{}
+
+	// Docker takes a moment to sample the stats of each container, so they're all sampled at the same time
+	statsOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
+	for serviceUuid, resourcesForService := range allDockerResources {
+		serviceContainer := resourcesForService.ServiceContainer
+		if serviceContainer == nil || !consts.IsContainerRunningDeterminer[serviceContainer.GetStatus()] {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Cannot get the stats of service '%v' as it has no running container", serviceUuid)
+			continue
+		}
+		statsOperations[operation_parallelizer.OperationID(serviceUuid)] = createStatsOperation(ctx, serviceUuid, serviceContainer.GetId(), dockerManager)
+	}
+
+	successfulOperations, failedOperations := operation_parallelizer.RunOperationsInParallel(statsOperations)
+	for operationId, operationResult := range successfulOperations {
+		serviceUuid := service.ServiceUUID(operationId)
+		serviceStats, ok := operationResult.(*service_stats.ServiceStats)
+		if !ok {
+			return nil, nil, stacktrace.NewError("An error occurred processing the stats of service '%s'. It seems "+
+				"the result object is of an unexpected type ('%v'). This is a Kurtosis internal bug.", serviceUuid, reflect.TypeOf(operationResult))
+		}
+		successfulUserServicesStats[serviceUuid] = serviceStats
+	}
+	for operationId, err := range failedOperations {
+		erroredUserServices[service.ServiceUUID(operationId)] = err
+	}
+	return successfulUserServicesStats, erroredUserServices, nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func createStatsOperation(
+	ctx context.Context,
+	serviceUuid service.ServiceUUID,
+	containerId string,
+	dockerManager *docker_manager.DockerManager,
+) operation_parallelizer.Operation {
+	return func() (interface{}, error) {
+		containerStats, err := dockerManager.GetContainerStats(ctx, containerId)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the stats of container '%v' for user service '%v'", containerId, serviceUuid)
+		}
+		return newServiceStatsFromContainerStats(containerStats), nil
+	}
+}
+
+// newServiceStatsFromContainerStats computes the stats the way the 'docker stats' command does
+func newServiceStatsFromContainerStats(containerStats *types.StatsJSON) *service_stats.ServiceStats {
+	networkReceivedBytes := uint64(0)
+	networkTransmittedBytes := uint64(0)
+	for _, networkStats := range containerStats.Networks {
+		networkReceivedBytes += networkStats.RxBytes
+		networkTransmittedBytes += networkStats.TxBytes
+	}
+
+	blockReadBytes := uint64(0)
+	blockWrittenBytes := uint64(0)
+	for _, blockIOEntry := range containerStats.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(blockIOEntry.Op) {
+		case blockIOReadOp:
+			blockReadBytes += blockIOEntry.Value
+		case blockIOWriteOp:
+			blockWrittenBytes += blockIOEntry.Value
+		}
+	}
+
+	return service_stats.NewServiceStats(
+		getCpuMillicores(containerStats),
+		getMemoryUsageBytes(containerStats.MemoryStats),
+		containerStats.MemoryStats.Limit,
+		networkReceivedBytes,
+		networkTransmittedBytes,
+		blockReadBytes,
+		blockWrittenBytes,
+	)
+}
+
+// getCpuMillicores returns the share of the host CPU time the container used between the two samples
+func getCpuMillicores(containerStats *types.StatsJSON) uint64 {
+	cpuStats := containerStats.CPUStats
+	previousCpuStats := containerStats.PreCPUStats
+	if cpuStats.CPUUsage.TotalUsage <= previousCpuStats.CPUUsage.TotalUsage || cpuStats.SystemUsage <= previousCpuStats.SystemUsage {
+		return 0
+	}
+	containerCpuDelta := float64(cpuStats.CPUUsage.TotalUsage - previousCpuStats.CPUUsage.TotalUsage)
+	systemCpuDelta := float64(cpuStats.SystemUsage - previousCpuStats.SystemUsage)
+
+	numCpus := uint64(cpuStats.OnlineCPUs)
+	if numCpus == 0 {
+		// older Docker engines don't report the online CPUs
+		numCpus = uint64(len(cpuStats.CPUUsage.PercpuUsage))
+	}
+	return uint64(containerCpuDelta / systemCpuDelta * float64(numCpus*millicoresPerCpu))
+}
+
+func getMemoryUsageBytes(memoryStats types.MemoryStats) uint64 {
+	inactiveFileBytes, found := memoryStats.Stats[cgroupV1InactiveFileMemoryStatKey]
+	if !found {
+		inactiveFileBytes = memoryStats.Stats[cgroupV2InactiveFileMemoryStatKey]
+	}
+	if inactiveFileBytes > memoryStats.Usage {
+		return memoryStats.Usage
+	}
+	return memoryStats.Usage - inactiveFileBytes
+}
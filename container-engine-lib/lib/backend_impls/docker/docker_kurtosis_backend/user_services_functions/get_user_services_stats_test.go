@@ -0,0 +1,54 @@
+package user_service_functions
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServiceStatsFromContainerStats(t *testing.T) {
+	var containerStats types.StatsJSON
+	// the container used a quarter of the time of the 4 CPUs of the host, so one full CPU
+	containerStats.PreCPUStats.CPUUsage.TotalUsage = 1_000
+	containerStats.PreCPUStats.SystemUsage = 10_000
+	containerStats.CPUStats.CPUUsage.TotalUsage = 3_000
+	containerStats.CPUStats.SystemUsage = 18_000
+	containerStats.CPUStats.OnlineCPUs = 4
+	containerStats.MemoryStats.Usage = 300
+	containerStats.MemoryStats.Limit = 1_000
+	containerStats.MemoryStats.Stats = map[string]uint64{cgroupV2InactiveFileMemoryStatKey: 100}
+	containerStats.Networks = map[string]types.NetworkStats{
+		"eth0": {RxBytes: 10, TxBytes: 20}, //nolint:exhaustruct
+		"eth1": {RxBytes: 1, TxBytes: 2},   //nolint:exhaustruct
+	}
+	containerStats.BlkioStats.IoServiceBytesRecursive = []types.BlkioStatEntry{
+		{Major: 8, Minor: 0, Op: "Read", Value: 40},
+		{Major: 8, Minor: 0, Op: "Write", Value: 50},
+		{Major: 8, Minor: 0, Op: "Total", Value: 90},
+		{Major: 8, Minor: 16, Op: "read", Value: 2},
+	}
+
+	serviceStats := newServiceStatsFromContainerStats(&containerStats)
+	require.Equal(t, uint64(1000), serviceStats.GetCpuMillicores())
+	require.Equal(t, uint64(200), serviceStats.GetMemoryUsageBytes())
+	require.Equal(t, uint64(1_000), serviceStats.GetMemoryLimitBytes())
+	require.True(t, serviceStats.HasIOStats())
+	require.Equal(t, uint64(11), serviceStats.GetNetworkReceivedBytes())
+	require.Equal(t, uint64(22), serviceStats.GetNetworkTransmittedBytes())
+	require.Equal(t, uint64(42), serviceStats.GetBlockReadBytes())
+	require.Equal(t, uint64(50), serviceStats.GetBlockWrittenBytes())
+}
+
+func TestNewServiceStatsFromContainerStats_NoPreviousSample(t *testing.T) {
+	var containerStats types.StatsJSON
+	containerStats.CPUStats.CPUUsage.TotalUsage = 3_000
+	containerStats.CPUStats.CPUUsage.PercpuUsage = []uint64{1_500, 1_500}
+	containerStats.MemoryStats.Usage = 300
+	// the engine reports the cgroup v1 name of the stat when the host uses cgroup v1
+	containerStats.MemoryStats.Stats = map[string]uint64{cgroupV1InactiveFileMemoryStatKey: 100}
+
+	serviceStats := newServiceStatsFromContainerStats(&containerStats)
+	require.Equal(t, uint64(0), serviceStats.GetCpuMillicores())
+	require.Equal(t, uint64(200), serviceStats.GetMemoryUsageBytes())
+}
@@ -0,0 +1,312 @@
+package user_service_functions
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// The networking sidecar only needs the 'tc' binary from iproute2
+	networkingSidecarImageName = "kurtosistech/iproute2"
+
+	shouldFetchStoppedContainersWhenGettingNetworkingSidecars = true
+)
+
+// The sidecar does nothing by itself, it just needs to stay up so that we can exec 'tc' commands into it
+var networkingSidecarEntrypointArgs = []string{
+	"tail",
+	"-f",
+	"/dev/null",
+}
+
+// CreateNetworkingSidecar starts a container sharing the network namespace of the given user service container, with
+// the NET_ADMIN capability so that it can change the traffic control rules of the service
+func CreateNetworkingSidecar(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNetworkId string,
+	serviceUuid service.ServiceUUID,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+	dockerManager *docker_manager.DockerManager,
+) (*networking_sidecar.NetworkingSidecar, error) {
+	serviceFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
+	}
+	_, allDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveUuid, serviceFilters, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	userServiceDockerResources, found := allDockerResources[serviceUuid]
+	if !found || userServiceDockerResources.ServiceContainer == nil {
+		return nil, stacktrace.NewError("No container was found for user service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	userServiceContainer := userServiceDockerResources.ServiceContainer
+	if isRunning, found := consts.IsContainerRunningDeterminer[userServiceContainer.GetStatus()]; !found || !isRunning {
+		return nil, stacktrace.NewError("Cannot create a networking sidecar for user service '%v' because its container isn't running", serviceUuid)
+	}
+
+	existingSidecarContainers, err := getMatchingNetworkingSidecarContainers(ctx, enclaveUuid, &networking_sidecar.NetworkingSidecarFilters{
+		UserServiceUUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
+	}, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred checking for an existing networking sidecar for user service '%v'", serviceUuid)
+	}
+	if len(existingSidecarContainers) > 0 {
+		return nil, stacktrace.NewError("A networking sidecar already exists for user service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the object attributes provider for enclave '%v'", enclaveUuid)
+	}
+	containerAttrs, err := enclaveObjAttrsProvider.ForNetworkingSidecarContainer(serviceUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecar container attributes for user service '%v'", serviceUuid)
+	}
+	containerName := containerAttrs.GetName().GetString()
+	containerLabels := map[string]string{}
+	for labelKey, labelValue := range containerAttrs.GetLabels() {
+		containerLabels[labelKey.GetString()] = labelValue.GetString()
+	}
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		networkingSidecarImageName,
+		containerName,
+		enclaveNetworkId,
+	).WithNetworkMode(
+		docker_manager.NewContainerNetworkMode(userServiceContainer.GetId()),
+	).WithAddedCapabilities(map[docker_manager.ContainerCapability]bool{
+		docker_manager.NetAdmin: true,
+	}).WithEntrypointArgs(
+		networkingSidecarEntrypointArgs,
+	).WithLabels(
+		containerLabels,
+	).Build()
+
+	if _, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the networking sidecar container for user service '%v' with these args '%+v'", serviceUuid, createAndStartArgs)
+	}
+	return networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveUuid, container.ContainerStatus_Running), nil
+}
+
+func GetNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error) {
+	matchingContainers, err := getMatchingNetworkingSidecarContainers(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting networking sidecars matching filters '%+v'", filters)
+	}
+
+	result := map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar{}
+	for serviceUuid, sidecarContainer := range matchingContainers {
+		sidecar, err := getNetworkingSidecarObjectFromContainer(enclaveUuid, serviceUuid, sidecarContainer)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecar object from container '%v'", sidecarContainer.GetId())
+		}
+		result[serviceUuid] = sidecar
+	}
+	return result, nil
+}
+
+func RunNetworkingSidecarExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	networkingSidecarsCommands map[service.ServiceUUID][]string,
+	dockerManager *docker_manager.DockerManager,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	userServiceUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range networkingSidecarsCommands {
+		userServiceUuids[serviceUuid] = true
+	}
+	filters := &networking_sidecar.NetworkingSidecarFilters{
+		UserServiceUUIDs: userServiceUuids,
+		Statuses: map[container.ContainerStatus]bool{
+			container.ContainerStatus_Running: true,
+		},
+	}
+	matchingContainers, err := getMatchingNetworkingSidecarContainers(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting networking sidecars matching filters '%+v'", filters)
+	}
+
+	successfulExecs := map[service.ServiceUUID]*exec_result.ExecResult{}
+	failedExecs := map[service.ServiceUUID]error{}
+
+	execOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
+	for serviceUuid, commandArgs := range networkingSidecarsCommands {
+		sidecarContainer, found := matchingContainers[serviceUuid]
+		if !found {
+			failedExecs[serviceUuid] = stacktrace.NewError(
+				"Cannot execute command '%+v' on the networking sidecar of service '%v' because no running sidecar was found for it",
+				commandArgs,
+				serviceUuid,
+			)
+			continue
+		}
+		execOperations[operation_parallelizer.OperationID(serviceUuid)] = createNetworkingSidecarExecOperation(ctx, serviceUuid, sidecarContainer, commandArgs, dockerManager)
+	}
+
+	successfulOperations, failedOperations := operation_parallelizer.RunOperationsInParallel(execOperations)
+	for operationId, operationResult := range successfulOperations {
+		serviceUuid := service.ServiceUUID(operationId)
+		execResult, ok := operationResult.(*exec_result.ExecResult)
+		if !ok {
+			return nil, nil, stacktrace.NewError("An error occurred processing the result of the exec command "+
+				"run on the networking sidecar of service '%s'. It seems the result object is of an unexpected type ('%v'). "+
+				"This is a Kurtosis internal bug.", serviceUuid, reflect.TypeOf(operationResult))
+		}
+		successfulExecs[serviceUuid] = execResult
+	}
+	for operationId, err := range failedOperations {
+		failedExecs[service.ServiceUUID(operationId)] = err
+	}
+	return successfulExecs, failedExecs, nil
+}
+
+func DestroyNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	matchingContainers, err := getMatchingNetworkingSidecarContainers(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting networking sidecars matching filters '%+v'", filters)
+	}
+
+	successfulServiceUuids := map[service.ServiceUUID]bool{}
+	erroredServiceUuids := map[service.ServiceUUID]error{}
+	for serviceUuid, sidecarContainer := range matchingContainers {
+		if err := dockerManager.RemoveContainer(ctx, sidecarContainer.GetId()); err != nil {
+			erroredServiceUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred removing the networking sidecar container '%v' of service '%v'", sidecarContainer.GetId(), serviceUuid)
+			continue
+		}
+		successfulServiceUuids[serviceUuid] = true
+	}
+	return successfulServiceUuids, erroredServiceUuids, nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func getMatchingNetworkingSidecarContainers(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]*types.Container, error) {
+	searchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString():   string(enclaveUuid),
+		docker_label_key.ContainerTypeDockerLabelKey.GetString(): label_value_consts.NetworkingSidecarContainerTypeDockerLabelValue.GetString(),
+	}
+	sidecarContainers, err := dockerManager.GetContainersByLabels(ctx, searchLabels, shouldFetchStoppedContainersWhenGettingNetworkingSidecars)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting networking sidecar containers in enclave '%v' by labels: %+v", enclaveUuid, searchLabels)
+	}
+
+	result := map[service.ServiceUUID]*types.Container{}
+	for _, sidecarContainer := range sidecarContainers {
+		serviceUuidStr, found := sidecarContainer.GetLabels()[docker_label_key.UserServiceGUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Found networking sidecar container '%v' that didn't have expected service GUID label '%v'", sidecarContainer.GetId(), docker_label_key.UserServiceGUIDDockerLabelKey.GetString())
+		}
+		serviceUuid := service.ServiceUUID(serviceUuidStr)
+
+		if filters.UserServiceUUIDs != nil && len(filters.UserServiceUUIDs) > 0 {
+			if _, found := filters.UserServiceUUIDs[serviceUuid]; !found {
+				continue
+			}
+		}
+
+		if filters.Statuses != nil && len(filters.Statuses) > 0 {
+			sidecar, err := getNetworkingSidecarObjectFromContainer(enclaveUuid, serviceUuid, sidecarContainer)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecar object from container '%v'", sidecarContainer.GetId())
+			}
+			if _, found := filters.Statuses[sidecar.GetStatus()]; !found {
+				continue
+			}
+		}
+
+		result[serviceUuid] = sidecarContainer
+	}
+	return result, nil
+}
+
+func getNetworkingSidecarObjectFromContainer(
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	sidecarContainer *types.Container,
+) (*networking_sidecar.NetworkingSidecar, error) {
+	containerStatus := sidecarContainer.GetStatus()
+	isContainerRunning, found := consts.IsContainerRunningDeterminer[containerStatus]
+	if !found {
+		// This should never happen because we enforce completeness in a unit test
+		return nil, stacktrace.NewError("No is-running designation found for networking sidecar container status '%v'; this is a bug in Kurtosis!", containerStatus.String())
+	}
+	sidecarStatus := container.ContainerStatus_Stopped
+	if isContainerRunning {
+		sidecarStatus = container.ContainerStatus_Running
+	}
+	return networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveUuid, sidecarStatus), nil
+}
+
+func createNetworkingSidecarExecOperation(
+	ctx context.Context,
+	serviceUuid service.ServiceUUID,
+	sidecarContainer *types.Container,
+	commandArgs []string,
+	dockerManager *docker_manager.DockerManager,
+) operation_parallelizer.Operation {
+	return func() (interface{}, error) {
+		execOutputBuf := &bytes.Buffer{}
+		exitCode, err := dockerManager.RunExecCommand(ctx, sidecarContainer.GetId(), commandArgs, execOutputBuf)
+		if err != nil {
+			return nil, stacktrace.Propagate(
+				err,
+				"An error occurred executing command '%+v' on networking sidecar container '%v' of user service '%v'",
+				commandArgs,
+				sidecarContainer.GetName(),
+				serviceUuid,
+			)
+		}
+		return exec_result.NewExecResult(exitCode, execOutputBuf.String()), nil
+	}
+}
@@ -2,29 +2,61 @@ package user_service_functions
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/docker/docker/api/types/volume"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/persistent_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// We use this image and version because we already are using this in other projects so there is a high probability
+	// that the image is in the local machine's cache
+	persistentDirectoryReaderContainerImage          = "alpine:3.17"
+	persistentDirectoryReaderContainerNameFragment   = "kurtosis-persistent-directory-reader"
+	persistentDirectoryWriterContainerNameFragment   = "kurtosis-persistent-directory-writer"
+	persistentDirectoryReaderContainerSleepSeconds   = 1800
+	persistentDirectoryReaderContainerMountpoint     = "/data"
+	persistentDirectoryReaderContainerNameSeparator  = "--"
+	persistentDirectoryReaderShBinaryFilepath        = "/bin/sh"
+	persistentDirectoryReaderShCmdFlag               = "-c"
+	persistentDirectorySizeLabelValueBase            = 10
+	persistentDirectorySizeLabelValueBitSize         = 64
+	persistentDirectoryReaderContainerCopySrcPathFmt = "%v/."
+)
+
+// getOrCreatePersistentDirectories returns the mapping of (volume name) -> (mountpoint on container) for the read-write
+// volumes and the read-only volumes, along with the "set" of volumes that belong to the service (i.e. that aren't shared
+// with the rest of the enclave)
 func getOrCreatePersistentDirectories(
 	ctx context.Context,
 	serviceUuid service.ServiceUUID,
 	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
-	serviceMountpointsToPersistentKey map[string]service_directory.DirectoryPersistentKey,
+	serviceMountpointsToPersistentDirectory map[string]service_directory.PersistentDirectory,
 	dockerManager *docker_manager.DockerManager,
-) (map[string]string, error) {
+) (map[string]string, map[string]string, map[string]bool, error) {
 	shouldDeleteVolumes := true
 	volumeNamesToRemoveIfFailure := map[string]bool{}
 	persistentDirectories := map[string]string{}
+	readOnlyPersistentDirectories := map[string]string{}
+	serviceOwnedVolumeNames := map[string]bool{}
 
-	for serviceDirPath, persistentKey := range serviceMountpointsToPersistentKey {
-		volumeAttrs, err := objAttrsProvider.ForSinglePersistentDirectoryVolume(serviceUuid, persistentKey)
+	for serviceDirPath, persistentDirectory := range serviceMountpointsToPersistentDirectory {
+		persistentKey := persistentDirectory.PersistentKey
+		volumeAttrs, err := objAttrsProvider.ForSinglePersistentDirectoryVolume(serviceUuid, persistentDirectory)
 		if err != nil {
-			return nil, stacktrace.Propagate(err, "Error creating persistent directory labels for '%s'", persistentKey)
+			return nil, nil, nil, stacktrace.Propagate(err, "Error creating persistent directory labels for '%s'", persistentKey)
 		}
 
 		volumeName := volumeAttrs.GetName().GetString()
@@ -33,20 +65,32 @@ func getOrCreatePersistentDirectories(
 			volumeLabelsStrs[key.GetString()] = value.GetString()
 		}
 
+		if !persistentDirectory.IsShared {
+			serviceOwnedVolumeNames[volumeName] = true
+		}
+		if persistentDirectory.IsReadOnly {
+			readOnlyPersistentDirectories[volumeName] = serviceDirPath
+		} else {
+			persistentDirectories[volumeName] = serviceDirPath
+		}
+
 		potentiallyExistingVolumes, err := dockerManager.GetVolumesByName(ctx, volumeName)
 		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred checking for persistent volume existence")
+			return nil, nil, nil, stacktrace.Propagate(err, "An error occurred checking for persistent volume existence")
 		}
 		if len(potentiallyExistingVolumes) == 1 {
 			// volume already existed for this enclave, return it and continue to the next one
-			persistentDirectories[volumeName] = serviceDirPath
 			continue
 		} else if len(potentiallyExistingVolumes) > 1 {
-			return nil, stacktrace.NewError("More than one volume with name '%s' exists in docker. This is unexpected", volumeName)
+			return nil, nil, nil, stacktrace.NewError("More than one volume with name '%s' exists in docker. This is unexpected", volumeName)
 		}
 
+		// Docker local volumes can't be size-limited, so the size is only recorded as a label for it to be reported back
+		if persistentDirectory.Size != 0 {
+			logrus.Warnf("Persistent directory '%s' has a size of %d megabytes, but Docker doesn't support limiting the size of volumes so it will be ignored", persistentKey, persistentDirectory.Size)
+		}
 		if err = dockerManager.CreateVolume(ctx, volumeName, volumeLabelsStrs); err != nil {
-			return nil, stacktrace.Propagate(
+			return nil, nil, nil, stacktrace.Propagate(
 				err,
 				"An error occurred creating persistent directory volume '%s' for service '%v'",
 				persistentKey,
@@ -54,7 +98,6 @@ func getOrCreatePersistentDirectories(
 			)
 		}
 		volumeNamesToRemoveIfFailure[volumeName] = true
-		persistentDirectories[volumeName] = serviceDirPath
 	}
 
 	defer func() {
@@ -74,5 +117,239 @@ func getOrCreatePersistentDirectories(
 		}
 	}()
 	shouldDeleteVolumes = false
-	return persistentDirectories, nil
+	return persistentDirectories, readOnlyPersistentDirectories, serviceOwnedVolumeNames, nil
+}
+
+func GetPersistentDirectoryVolumes(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+	dockerManager *docker_manager.DockerManager,
+) (map[string]*persistent_directory.PersistentDirectoryVolume, error) {
+	matchingVolumes, err := getMatchingPersistentDirectoryDockerVolumes(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting persistent directory volumes in enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+
+	result := map[string]*persistent_directory.PersistentDirectoryVolume{}
+	for _, dockerVolume := range matchingVolumes {
+		persistentDirectoryVolume, err := getPersistentDirectoryVolumeObjectFromDockerVolume(dockerVolume)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred converting Docker volume '%v' to a persistent directory volume object", dockerVolume.Name)
+		}
+		result[persistentDirectoryVolume.GetName()] = persistentDirectoryVolume
+	}
+	return result, nil
+}
+
+// DestroyPersistentDirectoryVolumes removes the matching volumes; Docker refuses to remove a volume that is still
+// mounted by a container, in which case the error is reported for that volume
+func DestroyPersistentDirectoryVolumes(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	map[string]bool,
+	map[string]error,
+	error,
+) {
+	matchingVolumes, err := getMatchingPersistentDirectoryDockerVolumes(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting persistent directory volumes in enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+
+	successfulVolumeNames := map[string]bool{}
+	erroredVolumeNames := map[string]error{}
+	for _, dockerVolume := range matchingVolumes {
+		volumeName := dockerVolume.Name
+		if err := dockerManager.RemoveVolume(ctx, volumeName); err != nil {
+			erroredVolumeNames[volumeName] = stacktrace.Propagate(err, "An error occurred removing persistent directory volume '%v'", volumeName)
+			continue
+		}
+		successfulVolumeNames[volumeName] = true
+	}
+	return successfulVolumeNames, erroredVolumeNames, nil
+}
+
+// CopyFilesFromPersistentDirectoryVolume mounts the volume in a short-lived helper container to read its content,
+// because the host can't easily read a Docker volume directly
+func CopyFilesFromPersistentDirectoryVolume(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNetworkId string,
+	volumeName string,
+	output io.Writer,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	doMountReadOnly := true
+	containerId, err := startPersistentDirectoryHelperContainer(ctx, enclaveUuid, enclaveNetworkId, volumeName, persistentDirectoryReaderContainerNameFragment, doMountReadOnly, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting a helper container to read persistent directory volume '%v'", volumeName)
+	}
+	defer removePersistentDirectoryHelperContainer(containerId, volumeName, dockerManager)
+
+	srcPath := fmt.Sprintf(persistentDirectoryReaderContainerCopySrcPathFmt, persistentDirectoryReaderContainerMountpoint)
+	tarStreamReadCloser, err := dockerManager.CopyFromContainer(ctx, containerId, srcPath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the content of persistent directory volume '%v' from reader container '%v'", volumeName, containerId)
+	}
+	defer tarStreamReadCloser.Close()
+
+	if _, err := io.Copy(output, tarStreamReadCloser); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the bytes of TAR'd up persistent directory volume '%v' to the output", volumeName)
+	}
+	return nil
+}
+
+// CopyFilesToPersistentDirectoryVolume extracts the TAR'd content at the root of the volume, using a short-lived helper
+// container mounting it in read-write mode. Existing files with the same path are overwritten
+func CopyFilesToPersistentDirectoryVolume(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNetworkId string,
+	volumeName string,
+	tarContent io.Reader,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	doMountReadOnly := false
+	containerId, err := startPersistentDirectoryHelperContainer(ctx, enclaveUuid, enclaveNetworkId, volumeName, persistentDirectoryWriterContainerNameFragment, doMountReadOnly, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting a helper container to write persistent directory volume '%v'", volumeName)
+	}
+	defer removePersistentDirectoryHelperContainer(containerId, volumeName, dockerManager)
+
+	if err := dockerManager.CopyToContainer(ctx, containerId, persistentDirectoryReaderContainerMountpoint, tarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content to persistent directory volume '%v' through writer container '%v'", volumeName, containerId)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func startPersistentDirectoryHelperContainer(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNetworkId string,
+	volumeName string,
+	containerNameFragment string,
+	doMountReadOnly bool,
+	dockerManager *docker_manager.DockerManager,
+) (string, error) {
+	volumeFilters := &persistent_directory.PersistentDirectoryVolumeFilters{
+		Names: map[string]bool{
+			volumeName: true,
+		},
+		PersistentKeys: nil,
+	}
+	matchingVolumes, err := getMatchingPersistentDirectoryDockerVolumes(ctx, enclaveUuid, volumeFilters, dockerManager)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting persistent directory volume '%v' in enclave '%v'", volumeName, enclaveUuid)
+	}
+	if len(matchingVolumes) == 0 {
+		return "", stacktrace.NewError("No persistent directory volume '%v' was found in enclave '%v'", volumeName, enclaveUuid)
+	}
+
+	suffix, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred generating a UUID for the persistent directory helper container name")
+	}
+	containerName := containerNameFragment + persistentDirectoryReaderContainerNameSeparator + uuid_generator.ShortenedUUIDString(suffix)
+
+	entrypointArgs := []string{
+		persistentDirectoryReaderShBinaryFilepath,
+		persistentDirectoryReaderShCmdFlag,
+		fmt.Sprintf("sleep %v", persistentDirectoryReaderContainerSleepSeconds),
+	}
+	volumeMounts := map[string]string{
+		volumeName: persistentDirectoryReaderContainerMountpoint,
+	}
+
+	createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		persistentDirectoryReaderContainerImage,
+		containerName,
+		enclaveNetworkId,
+	).WithEntrypointArgs(
+		entrypointArgs,
+	)
+	if doMountReadOnly {
+		createAndStartArgsBuilder = createAndStartArgsBuilder.WithReadOnlyVolumeMounts(volumeMounts)
+	} else {
+		createAndStartArgsBuilder = createAndStartArgsBuilder.WithVolumeMounts(volumeMounts)
+	}
+	createAndStartArgs := createAndStartArgsBuilder.Build()
+
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred starting the persistent directory helper container with these args '%+v'", createAndStartArgs)
+	}
+	return containerId, nil
+}
+
+// removePersistentDirectoryHelperContainer has to be executed always, in the success and also in the failed case
+func removePersistentDirectoryHelperContainer(containerId string, volumeName string, dockerManager *docker_manager.DockerManager) {
+	if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+		logrus.Errorf(
+			"Accessing the persistent directory volume '%v' completed, so we tried to remove the helper container with ID '%v' "+
+				"that we started, but doing so exited with an error:\n%v",
+			volumeName,
+			containerId,
+			err)
+		logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the container with ID '%v'!!!!!!", containerId)
+	}
+}
+
+func getMatchingPersistentDirectoryDockerVolumes(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+	dockerManager *docker_manager.DockerManager,
+) ([]*volume.Volume, error) {
+	searchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString():  label_value_consts.PersistentDirectoryVolumeTypeDockerLabelValue.GetString(),
+	}
+	volumes, err := dockerManager.GetVolumesByLabels(ctx, searchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting persistent directory volumes in enclave '%v' by labels: %+v", enclaveUuid, searchLabels)
+	}
+
+	result := []*volume.Volume{}
+	for _, dockerVolume := range volumes {
+		if filters != nil && len(filters.Names) > 0 {
+			if _, found := filters.Names[dockerVolume.Name]; !found {
+				continue
+			}
+		}
+		if filters != nil && len(filters.PersistentKeys) > 0 {
+			persistentKey := service_directory.DirectoryPersistentKey(dockerVolume.Labels[docker_label_key.IDDockerLabelKey.GetString()])
+			if _, found := filters.PersistentKeys[persistentKey]; !found {
+				continue
+			}
+		}
+		result = append(result, dockerVolume)
+	}
+	return result, nil
+}
+
+func getPersistentDirectoryVolumeObjectFromDockerVolume(dockerVolume *volume.Volume) (*persistent_directory.PersistentDirectoryVolume, error) {
+	// Volumes created before persistent keys were stored in a label won't have it, so we leave the key empty
+	persistentKey := service_directory.DirectoryPersistentKey(dockerVolume.Labels[docker_label_key.IDDockerLabelKey.GetString()])
+	// Volumes without the service GUID label are shared across the enclave
+	serviceUuid := service.ServiceUUID(dockerVolume.Labels[docker_label_key.UserServiceGUIDDockerLabelKey.GetString()])
+
+	var size service_directory.DirectoryPersistentSize
+	if sizeStr, found := dockerVolume.Labels[docker_label_key.PersistentDirectorySizeDockerLabelKey.GetString()]; found {
+		sizeUint, err := strconv.ParseUint(sizeStr, persistentDirectorySizeLabelValueBase, persistentDirectorySizeLabelValueBitSize)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the size label value '%v' of volume '%v'", sizeStr, dockerVolume.Name)
+		}
+		size = service_directory.DirectoryPersistentSize(sizeUint)
+	}
+
+	return persistent_directory.NewPersistentDirectoryVolume(dockerVolume.Name, persistentKey, size, serviceUuid), nil
 }
@@ -588,12 +588,13 @@ func createStartServiceOperation(
 			}
 		}
 
+		readOnlyVolumeMounts := map[string]string{}
 		if persistentDirectories != nil {
-			candidateVolumeMounts, err := getOrCreatePersistentDirectories(
+			candidateVolumeMounts, candidateReadOnlyVolumeMounts, serviceOwnedVolumeNames, err := getOrCreatePersistentDirectories(
 				ctx,
 				serviceUUID,
 				enclaveObjAttrsProvider,
-				persistentDirectories.ServiceDirpathToDirectoryPersistentKey,
+				persistentDirectories.ServiceDirpathToPersistentDirectory,
 				dockerManager,
 			)
 			if err != nil {
@@ -601,7 +602,8 @@ func createStartServiceOperation(
 			}
 			defer func() {
 				if shouldDeleteVolumes {
-					for volumeName := range candidateVolumeMounts {
+					// Shared persistent directory volumes are left untouched as other services in the enclave may be using them
+					for volumeName := range serviceOwnedVolumeNames {
 						// Use background context, so we delete these even if input context was cancelled
 						if err := dockerManager.RemoveVolume(context.Background(), volumeName); err != nil {
 							logrus.Errorf("Starting the service failed so we tried to delete persistent directory volume '%v' that we created, but doing so threw an error:\n%v", volumeName, err)
@@ -616,6 +618,9 @@ func createStartServiceOperation(
 				}
 				volumeMounts[dirpath] = volumeName
 			}
+			for volumeName, dirpath := range candidateReadOnlyVolumeMounts {
+				readOnlyVolumeMounts[volumeName] = dirpath
+			}
 		}
 
 		containerAttrs, err := enclaveObjAttrsProvider.ForUserServiceContainer(
@@ -687,6 +692,8 @@ func createStartServiceOperation(
 			true,
 		).WithVolumeMounts(
 			volumeMounts,
+		).WithReadOnlyVolumeMounts(
+			readOnlyVolumeMounts,
 		).WithLoggingDriver(
 			fluentdLoggingDriverCnfg,
 		).WithRestartPolicy(
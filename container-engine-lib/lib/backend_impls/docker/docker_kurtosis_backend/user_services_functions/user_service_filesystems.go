@@ -0,0 +1,54 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+)
+
+// ExportUserServiceFilesystem commits the container of the user service into an image tagged with the given name,
+// and writes that image to the output. The commit is only needed for the export, so the image is removed afterward.
+func ExportUserServiceFilesystem(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	imageName string,
+	output io.Writer,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	_, serviceDockerResources, err := getSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting user service with UUID '%v' in enclave with ID '%v'", serviceUuid, enclaveId)
+	}
+	container := serviceDockerResources.ServiceContainer
+
+	if err := dockerManager.CommitContainer(ctx, container.GetId(), imageName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred committing the container '%v' of user service '%v'", container.GetName(), serviceUuid)
+	}
+	defer func() {
+		if err := dockerManager.RemoveImage(ctx, imageName); err != nil {
+			logrus.Warnf("An error occurred removing image '%v' committed from user service '%v', it will need to be removed manually:\n%v", imageName, serviceUuid, err)
+		}
+	}()
+
+	if err := dockerManager.SaveImage(ctx, imageName, output); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the filesystem of user service '%v' to the output", serviceUuid)
+	}
+	return nil
+}
+
+// ImportUserServiceFilesystem loads an image written by ExportUserServiceFilesystem, under the name it was exported with
+func ImportUserServiceFilesystem(
+	ctx context.Context,
+	filesystemContent io.Reader,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	if err := dockerManager.LoadImage(ctx, filesystemContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading the user service filesystem image")
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ type CreateAndStartContainerArgs struct {
 	envVariables                             map[string]string
 	bindMounts                               map[string]string
 	volumeMounts                             map[string]string
+	readOnlyVolumeMounts                     map[string]string
 	needsAccessToDockerHostMachine           bool
 	labels                                   map[string]string
 	cpuAllocationMillicpus                   uint64
@@ -50,6 +51,7 @@ type CreateAndStartContainerArgsBuilder struct {
 	envVariables                             map[string]string
 	bindMounts                               map[string]string
 	volumeMounts                             map[string]string
+	readOnlyVolumeMounts                     map[string]string
 	needsAccessToDockerHostMachine           bool
 	labels                                   map[string]string
 	cpuAllocationMillicpus                   uint64
@@ -84,6 +86,7 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		envVariables:                             map[string]string{},
 		bindMounts:                               map[string]string{},
 		volumeMounts:                             map[string]string{},
+		readOnlyVolumeMounts:                     map[string]string{},
 		needsAccessToDockerHostMachine:           false,
 		labels:                                   map[string]string{},
 		cpuAllocationMillicpus:                   0,
@@ -113,6 +116,7 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		envVariables:                             builder.envVariables,
 		bindMounts:                               builder.bindMounts,
 		volumeMounts:                             builder.volumeMounts,
+		readOnlyVolumeMounts:                     builder.readOnlyVolumeMounts,
 		needsAccessToDockerHostMachine:           builder.needsAccessToDockerHostMachine,
 		cpuAllocationMillicpus:                   builder.cpuAllocationMillicpus,
 		memoryAllocationMegabytes:                builder.memoryAllocationMegabytes,
@@ -192,6 +196,12 @@ func (builder *CreateAndStartContainerArgsBuilder) WithVolumeMounts(volumeMounts
 	return builder
 }
 
+// Mounts: Mapping of (volume name) -> (mountpoint on container) to mount read-only during container launch
+func (builder *CreateAndStartContainerArgsBuilder) WithReadOnlyVolumeMounts(readOnlyVolumeMounts map[string]string) *CreateAndStartContainerArgsBuilder {
+	builder.readOnlyVolumeMounts = readOnlyVolumeMounts
+	return builder
+}
+
 // Will provide the container with a magic "host.docker.internal" domain name
 // that it can use to access ports of the machine running Docker itself (useful if, e.g., the container
 // needs to check the host machine's free ports)
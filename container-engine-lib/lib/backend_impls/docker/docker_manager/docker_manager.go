@@ -70,6 +70,9 @@ const (
 	// the value of HostGatewayIP daemon config value
 	hostGatewayName = "host-gateway"
 
+	// Option appended to a volume bind to mount it read-only inside the container
+	readOnlyVolumeMountOption = "ro"
+
 	// ------------------ Filter Search Keys ----------------------
 	// All these defined in https://docs.docker.com/engine/api/v1.24
 
@@ -100,6 +103,10 @@ const (
 
 	shouldFollowContainerLogsWhenGettingFailedContainerLogs = false
 
+	shouldStreamContainerStats = false
+
+	shouldLoadImageQuietly = false
+
 	shouldAttachStdinWhenCreatingContainerExec                = true
 	shouldAttachStandardStreamsToTtyWhenCreatingContainerExec = true
 	shouldAttachStderrWhenCreatingContainerExec               = true
@@ -570,6 +577,7 @@ func (manager *DockerManager) CreateAndStartContainer(
 		args.networkMode,
 		args.bindMounts,
 		args.volumeMounts,
+		args.readOnlyVolumeMounts,
 		args.usedPorts,
 		args.needsAccessToDockerHostMachine,
 		args.cpuAllocationMillicpus,
@@ -1268,6 +1276,120 @@ func (manager *DockerManager) FetchImage(ctx context.Context, image string, down
 	return pulledFromRemote, nil
 }
 
+// BuildImage builds an image tagged with the given name from the tar of a build context, and labels it with the given
+// labels. It blocks until the build is over, returning the error reported by the builder if it fails.
+func (manager *DockerManager) BuildImage(ctx context.Context, imageName string, buildContextTar io.Reader, dockerfileRelativeFilepath string, targetStage string, labels map[string]string) error {
+	logrus.Infof("Building image '%s'", imageName)
+	// Builds can take a long time, so the client with no timeout is used
+	buildResponse, err := manager.dockerClientNoTimeout.ImageBuild(ctx, buildContextTar, types.ImageBuildOptions{ //nolint:exhaustruct
+		Tags:       []string{imageName},
+		Remove:     true,
+		Dockerfile: dockerfileRelativeFilepath,
+		Target:     targetStage,
+		Labels:     labels,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the build of image '%v'", imageName)
+	}
+	defer buildResponse.Body.Close()
+
+	responseDecoder := json.NewDecoder(buildResponse.Body)
+	for {
+		jsonMessage := new(jsonmessage.JSONMessage)
+		err = responseDecoder.Decode(&jsonMessage)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "The build of image '%v' failed with an unexpected error", imageName)
+		}
+		if jsonMessage.Error != nil {
+			return stacktrace.NewError("The build of image '%v' failed with the following error:\n%v", imageName, jsonMessage.Error.Message)
+		}
+		if jsonMessage.Stream != "" {
+			logrus.Debugf("Building image '%s': %s", imageName, strings.TrimSuffix(jsonMessage.Stream, "\n"))
+		}
+	}
+	logrus.Infof("Image '%s' successfully built", imageName)
+	return nil
+}
+
+// CommitContainer creates an image tagged with the given name from the filesystem of the container, the content of
+// its volumes excluded. The container is paused while it is committed.
+func (manager *DockerManager) CommitContainer(ctx context.Context, containerId string, imageName string) error {
+	if _, err := manager.dockerClientNoTimeout.ContainerCommit(ctx, containerId, types.ContainerCommitOptions{ //nolint:exhaustruct
+		Reference: imageName,
+		Pause:     true,
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred committing container with ID '%v' into image '%v'", containerId, imageName)
+	}
+	return nil
+}
+
+// SaveImage writes the local image with the given name, along with its tag, to the output as a TAR
+func (manager *DockerManager) SaveImage(ctx context.Context, imageName string, output io.Writer) error {
+	imageReadCloser, err := manager.dockerClientNoTimeout.ImageSave(ctx, []string{imageName})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving image '%v'", imageName)
+	}
+	defer imageReadCloser.Close()
+	if _, err := io.Copy(output, imageReadCloser); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the content of image '%v' to the output", imageName)
+	}
+	return nil
+}
+
+// LoadImage loads the images of a TAR written by SaveImage, with the tags they were saved with
+func (manager *DockerManager) LoadImage(ctx context.Context, imageTar io.Reader) error {
+	loadResponse, err := manager.dockerClientNoTimeout.ImageLoad(ctx, imageTar, shouldLoadImageQuietly)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading the image")
+	}
+	defer loadResponse.Body.Close()
+
+	responseDecoder := json.NewDecoder(loadResponse.Body)
+	for {
+		jsonMessage := new(jsonmessage.JSONMessage)
+		err = responseDecoder.Decode(&jsonMessage)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "The load of the image failed with an unexpected error")
+		}
+		if jsonMessage.Error != nil {
+			return stacktrace.NewError("The load of the image failed with the following error:\n%v", jsonMessage.Error.Message)
+		}
+		if jsonMessage.Stream != "" {
+			logrus.Debugf("Loading image: %s", strings.TrimSuffix(jsonMessage.Stream, "\n"))
+		}
+	}
+	return nil
+}
+
+// RemoveImage removes the tag of the local image with the given name, and the image itself if it has no other tag
+func (manager *DockerManager) RemoveImage(ctx context.Context, imageName string) error {
+	if _, err := manager.dockerClient.ImageRemove(ctx, imageName, types.ImageRemoveOptions{}); err != nil { //nolint:exhaustruct
+		return stacktrace.Propagate(err, "An error occurred removing image '%v'", imageName)
+	}
+	return nil
+}
+
+// GetImageLabels returns the labels of the local image with the given name, and false if there's no such image
+func (manager *DockerManager) GetImageLabels(ctx context.Context, imageName string) (map[string]string, bool, error) {
+	imageInspect, _, err := manager.dockerClient.ImageInspectWithRaw(ctx, imageName)
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, stacktrace.Propagate(err, "An error occurred inspecting image '%v'", imageName)
+	}
+	if imageInspect.Config == nil {
+		return map[string]string{}, true, nil
+	}
+	return imageInspect.Config.Labels, true, nil
+}
+
 func (manager *DockerManager) CreateContainerExec(context context.Context, containerId string, cmd []string) (*types.HijackedResponse, error) {
 	config := types.ExecConfig{
 		User:         "",
@@ -1323,6 +1445,34 @@ func (manager *DockerManager) CopyFromContainer(ctx context.Context, containerId
 	return tarStreamReadCloser, nil
 }
 
+// CopyToContainer extracts the TAR'd content at dstPath in the container, which must be an existing directory
+func (manager *DockerManager) CopyToContainer(ctx context.Context, containerId string, dstPath string, tarContent io.Reader) error {
+	copyOptions := types.CopyToContainerOptions{
+		AllowOverwriteDirWithFile: false,
+		CopyUIDGID:                false,
+	}
+	if err := manager.dockerClient.CopyToContainer(ctx, containerId, dstPath, tarContent, copyOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content to '%v' in container with ID '%v'", dstPath, containerId)
+	}
+	return nil
+}
+
+// GetContainerStats returns a single stats sample of the container with the given ID. Docker takes two samples a
+// moment apart to fill in the previous CPU stats, so that the CPU usage can be computed from the difference
+func (manager *DockerManager) GetContainerStats(ctx context.Context, containerId string) (*types.StatsJSON, error) {
+	containerStats, err := manager.dockerClient.ContainerStats(ctx, containerId, shouldStreamContainerStats)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the stats of container with ID '%v'", containerId)
+	}
+	defer containerStats.Body.Close()
+
+	var stats types.StatsJSON
+	if err := json.NewDecoder(containerStats.Body).Decode(&stats); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the stats of container with ID '%v'", containerId)
+	}
+	return &stats, nil
+}
+
 // GetAvailableCPUAndMemory returns free memory in megabytes, free cpu in millicores, information on whether cpu information is complete
 func (manager *DockerManager) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, error) {
 	availableMemoryInBytes, availableCpuInMilliCores, err := getFreeMemoryAndCPU(ctx, manager.dockerClient)
@@ -1415,6 +1565,7 @@ Args:
 	volumeMounts: Mapping of (volume name) -> (mountpoint on container) that will be mounted at container startup (used
 		when sharing data between containers). This is distinct from a bind mount because the host filesystem can't easily
 		read from a Docker volume - you need to be inside a Docker container to do so.
+	readOnlyVolumeMounts: Same as volumeMounts, but the volumes are mounted read-only
 	usedPortsWithPublishSpec: Ports that are used by the container, with a specification for how they should be published to the
 		host machine (if at all)
 	needsToAccessDockerHostMachine: If true, adds a "host.docker.internal:host-gateway" extra host binding, which is necessary
@@ -1425,6 +1576,7 @@ func (manager *DockerManager) getContainerHostConfig(
 	networkMode DockerManagerNetworkMode,
 	bindMounts map[string]string,
 	volumeMounts map[string]string,
+	readOnlyVolumeMounts map[string]string,
 	usedPortsWithPublishSpec map[nat.Port]PortPublishSpec,
 	needsToAccessDockerHostMachine bool,
 	cpuAllocationMillicpus uint64,
@@ -1443,6 +1595,9 @@ func (manager *DockerManager) getContainerHostConfig(
 		//  a separate thing called a "bind mount".... blame the Docker API
 		bindsList = append(bindsList, volumeName+":"+containerFilepath)
 	}
+	for volumeName, containerFilepath := range readOnlyVolumeMounts {
+		bindsList = append(bindsList, volumeName+":"+containerFilepath+":"+readOnlyVolumeMountOption)
+	}
 
 	logrus.Debugf("Binds: %v", bindsList)
 
@@ -1828,6 +1983,10 @@ func newContainerFromDockerContainer(dockerContainer types.ContainerJSON) (*dock
 		envSlice := strings.Split(env, "=")
 		containerEnvArgs[envSlice[0]] = envSlice[1]
 	}
+	unsizedExitCode := dockerContainer.State.ExitCode
+	if unsizedExitCode > math.MaxInt32 || unsizedExitCode < math.MinInt32 {
+		return nil, stacktrace.NewError("Could not cast exit code '%v' of container '%v' to int32 because it does not fit", unsizedExitCode, dockerContainer.Name)
+	}
 
 	newContainer := docker_manager_types.NewContainer(
 		dockerContainer.ID,
@@ -1839,6 +1998,7 @@ func newContainerFromDockerContainer(dockerContainer types.ContainerJSON) (*dock
 		dockerContainer.Config.Entrypoint,
 		dockerContainer.Config.Cmd,
 		containerEnvArgs,
+		int32(unsizedExitCode),
 	)
 
 	return newContainer, nil
@@ -14,6 +14,7 @@ type Container struct {
 	entrypointArgs   []string
 	cmdArgs          []string
 	envVars          map[string]string
+	exitCode         int32
 }
 
 func NewContainer(
@@ -26,6 +27,7 @@ func NewContainer(
 	entrypointArgs []string,
 	cmdArgs []string,
 	envVars map[string]string,
+	exitCode int32,
 ) *Container {
 	return &Container{
 		id:               id,
@@ -37,6 +39,7 @@ func NewContainer(
 		entrypointArgs:   entrypointArgs,
 		cmdArgs:          cmdArgs,
 		envVars:          envVars,
+		exitCode:         exitCode,
 	}
 }
 
@@ -75,3 +78,8 @@ func (c *Container) GetCmdArgs() []string {
 func (c *Container) GetEnvVars() map[string]string {
 	return c.envVars
 }
+
+// GetExitCode returns the exit code of the container's last run, only meaningful once the container has exited
+func (c *Container) GetExitCode() int32 {
+	return c.exitCode
+}
@@ -50,6 +50,20 @@ func ValidateUserCustomLabelKey(str string) error {
 	return nil
 }
 
+// GetUserCustomLabelsFromLabels returns the user custom labels found in the given Docker labels, with the Kurtosis custom
+// user prefix removed from their keys
+func GetUserCustomLabelsFromLabels(labels map[string]string) map[string]string {
+	userCustomLabels := map[string]string{}
+	for labelKey, labelValue := range labels {
+		if !strings.HasPrefix(labelKey, customUserLabelsKeyPrefixStr) {
+			continue
+		}
+		userCustomLabelKey := strings.TrimPrefix(labelKey, customUserLabelsKeyPrefixStr)
+		userCustomLabels[userCustomLabelKey] = labelValue
+	}
+	return userCustomLabels
+}
+
 func createNewDockerLabelKey(str string) (*DockerLabelKey, error) {
 	if err := validate(str); err != nil {
 		return nil, stacktrace.NewError("Label key string '%v' is not valid", str)
@@ -59,3 +59,16 @@ func TestMaxAllowedLabel(t *testing.T) {
 	_, err = CreateNewDockerUserCustomLabelKey(overUserCustomValidMaxLabel)
 	require.Error(t, err)
 }
+
+func TestGetUserCustomLabelsFromLabels(t *testing.T) {
+	labels := map[string]string{
+		appIdLabelKeyStr:                        "kurtosis",
+		customUserLabelsKeyPrefixStr + "team":   "infra",
+		customUserLabelsKeyPrefixStr + "ci.job": "1234",
+	}
+	expectedUserCustomLabels := map[string]string{
+		"team":   "infra",
+		"ci.job": "1234",
+	}
+	require.Equal(t, expectedUserCustomLabels, GetUserCustomLabelsFromLabels(labels))
+}
@@ -35,6 +35,12 @@ const (
 
 	privateIpAddrLabelKeyStr = labelNamespaceStr + "private-ip"
 
+	// The size, in megabytes, requested for a persistent directory volume
+	persistentDirectorySizeLabelKeyStr = labelNamespaceStr + "persistent-directory-size"
+
+	// The hash of the build context and options an image was built from, to skip rebuilding it when they didn't change
+	imageBuildContentHashLabelKeyStr = labelNamespaceStr + "image-build-content-hash"
+
 	// We create a duplicate of the enclave uuid and service uuid label key because:
 	// the logs aggregator (vector) needs the enclave uuid and service uuid label keys to create the filepath where logs are stored in persistent volume
 	// but vectors template syntax can't interpret the "com.kurtosistech." prefix, so we can't use the existing label keys
@@ -64,6 +70,8 @@ var EnclaveNameDockerLabelKey = MustCreateNewDockerLabelKey(enclaveNameLabelKeyS
 var EnclaveCreationTimeLabelKey = MustCreateNewDockerLabelKey(enclaveCreationTime)
 var PrivateIPDockerLabelKey = MustCreateNewDockerLabelKey(privateIpAddrLabelKeyStr)
 var UserServiceGUIDDockerLabelKey = MustCreateNewDockerLabelKey(userServiceGuidDockerLabelKeyStr)
+var PersistentDirectorySizeDockerLabelKey = MustCreateNewDockerLabelKey(persistentDirectorySizeLabelKeyStr)
+var ImageBuildContentHashDockerLabelKey = MustCreateNewDockerLabelKey(imageBuildContentHashLabelKeyStr)
 var LogsEnclaveUUIDDockerLabelKey = MustCreateNewDockerLabelKey(logsEnclaveUuidLabelKeyStr)
 var LogsServiceUUIDDockerLabelKey = MustCreateNewDockerLabelKey(logsServiceUuidDockerLabelKey)
 var LogsServiceShortUUIDDockerLabelKey = MustCreateNewDockerLabelKey(logsServiceShortUuidDockerLabelKey)
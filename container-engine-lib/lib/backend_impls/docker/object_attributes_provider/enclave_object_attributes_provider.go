@@ -14,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -26,13 +27,16 @@ const (
 	persistentServiceDirectoryNameFragment = "service-persistent-directory"
 
 	artifactsExpanderContainerNameFragment = "files-artifacts-expander"
+	networkingSidecarContainerNameFragment = "networking-sidecar"
 	logsCollectorFragment                  = "kurtosis-logs-collector"
 	// The collector is per enclave so this is a suffix
 	logsCollectorVolumeFragment = logsCollectorFragment + "-vol"
+
+	persistentDirectorySizeBase = 10
 )
 
 type DockerEnclaveObjectAttributesProvider interface {
-	ForEnclaveNetwork(enclaveName string, creationTime time.Time) (DockerObjectAttributes, error)
+	ForEnclaveNetwork(enclaveName string, creationTime time.Time, enclaveLabels map[string]string) (DockerObjectAttributes, error)
 	ForEnclaveDataVolume() (DockerObjectAttributes, error)
 	ForApiContainer(
 		ipAddr net.IP,
@@ -49,12 +53,15 @@ type DockerEnclaveObjectAttributesProvider interface {
 	ForFilesArtifactsExpanderContainer(
 		serviceUUID service.ServiceUUID,
 	) (DockerObjectAttributes, error)
+	ForNetworkingSidecarContainer(
+		serviceUUID service.ServiceUUID,
+	) (DockerObjectAttributes, error)
 	ForSingleFilesArtifactExpansionVolume(
 		serviceUUID service.ServiceUUID,
 	) (DockerObjectAttributes, error)
 	ForSinglePersistentDirectoryVolume(
 		serviceUUID service.ServiceUUID,
-		persistentKey service_directory.DirectoryPersistentKey,
+		persistentDirectory service_directory.PersistentDirectory,
 	) (DockerObjectAttributes, error)
 	ForLogsCollector(tcpPortId string, tcpPortSpec *port_spec.PortSpec, httpPortId string, httpPortSpec *port_spec.PortSpec) (DockerObjectAttributes, error)
 	ForLogsCollectorVolume() (DockerObjectAttributes, error)
@@ -73,7 +80,7 @@ func newDockerEnclaveObjectAttributesProviderImpl(
 	}
 }
 
-func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enclaveName string, creationTime time.Time) (DockerObjectAttributes, error) {
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enclaveName string, creationTime time.Time, enclaveLabels map[string]string) (DockerObjectAttributes, error) {
 	// TODO: might need to revert this if we have multiple users on the same cluster (what if two people create enclaves with name test?)
 	enclaveNetworkNameStr := networkPrefix + enclaveName
 	name, err := docker_object_name.CreateNewDockerObjectName(enclaveNetworkNameStr)
@@ -113,6 +120,19 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enc
 	labels[docker_label_key.EnclaveCreationTimeLabelKey] = creationTimeLabelValue
 	labels[docker_label_key.EnclaveNameDockerLabelKey] = enclaveNameLabelValue
 
+	// add user enclave labels
+	for enclaveLabelKey, enclaveLabelValue := range enclaveLabels {
+		dockerLabelKey, err := docker_label_key.CreateNewDockerUserCustomLabelKey(enclaveLabelKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Docker label key '%s'", enclaveLabelKey)
+		}
+		dockerLabelValue, err := docker_label_value.CreateNewDockerLabelValue(enclaveLabelValue)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Docker label value '%s'", enclaveLabelValue)
+		}
+		labels[dockerLabelKey] = dockerLabelValue
+	}
+
 	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -306,15 +326,17 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForSingleFilesArtifac
 	return objectAttributes, nil
 }
 
-// In Docker we get one volume per persistent directory
+// In Docker we get one volume per persistent directory, except for shared persistent directories which get one volume
+// per persistent key for the whole enclave
 func (provider *dockerEnclaveObjectAttributesProviderImpl) ForSinglePersistentDirectoryVolume(
 	serviceUUID service.ServiceUUID,
-	persistentKey service_directory.DirectoryPersistentKey,
+	persistentDirectory service_directory.PersistentDirectory,
 ) (
 	DockerObjectAttributes,
 	error,
 ) {
 	serviceUuidStr := string(serviceUUID)
+	persistentKey := persistentDirectory.PersistentKey
 
 	guidStr, err := uuid_generator.GenerateUUIDString()
 	if err != nil {
@@ -322,7 +344,9 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForSinglePersistentDi
 	}
 
 	hasher := md5.New()
-	hasher.Write([]byte(serviceUUID))
+	if !persistentDirectory.IsShared {
+		hasher.Write([]byte(serviceUUID))
+	}
 	hasher.Write([]byte(persistentKey))
 	persistentKeyHash := hex.EncodeToString(hasher.Sum(nil))
 
@@ -331,19 +355,29 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForSinglePersistentDi
 		persistentKeyHash,
 	})
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred creating the files artifact expansion volume name object using GUID '%v' and service GUID '%v'", guidStr, serviceUuidStr)
+		return nil, stacktrace.Propagate(err, "An error occurred creating the persistent directory volume name object using GUID '%v' and service GUID '%v'", guidStr, serviceUuidStr)
 	}
 
-	labels, err := provider.getLabelsForEnclaveObjectWithGUID(guidStr)
+	labels, err := provider.getLabelsForEnclaveObjectWithIDAndGUID(string(persistentKey), guidStr)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred getting labels for files artifact expansion volume with UUID '%v'", guidStr)
+		return nil, stacktrace.Propagate(err, "An error occurred getting labels for persistent directory volume with UUID '%v'", guidStr)
 	}
 
-	serviceUuidLabelValue, err := docker_label_value.CreateNewDockerLabelValue(serviceUuidStr)
+	// Shared persistent directories don't belong to any service, so they don't get the service GUID label
+	if !persistentDirectory.IsShared {
+		serviceUuidLabelValue, err := docker_label_value.CreateNewDockerLabelValue(serviceUuidStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value from service GUID string '%v'", serviceUuidStr)
+		}
+		labels[docker_label_key.UserServiceGUIDDockerLabelKey] = serviceUuidLabelValue
+	}
+
+	sizeStr := strconv.FormatUint(uint64(persistentDirectory.Size), persistentDirectorySizeBase)
+	sizeLabelValue, err := docker_label_value.CreateNewDockerLabelValue(sizeStr)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value from service GUID string '%v'", serviceUuidStr)
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value from persistent directory size '%v'", sizeStr)
 	}
-	labels[docker_label_key.UserServiceGUIDDockerLabelKey] = serviceUuidLabelValue
+	labels[docker_label_key.PersistentDirectorySizeDockerLabelKey] = sizeLabelValue
 	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.PersistentDirectoryVolumeTypeDockerLabelValue
 	// TODO Create a KurtosisResourceDockerLabelKey object, like Kubernetes, and apply the "user-service" label here?
 
@@ -398,6 +432,41 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForFilesArtifactsExpa
 	return objectAttributes, nil
 }
 
+// We'll have at most one networking sidecar container per service, as it is attached to the network stack of the
+// service container
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForNetworkingSidecarContainer(
+	serviceUUID service.ServiceUUID,
+) (
+	DockerObjectAttributes,
+	error,
+) {
+	serviceUuidStr := string(serviceUUID)
+
+	name, err := provider.getNameForEnclaveObject([]string{
+		networkingSidecarContainerNameFragment,
+		serviceUuidStr,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the networking sidecar container name for service '%v'", serviceUuidStr)
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+
+	serviceUuidLabelValue, err := docker_label_value.CreateNewDockerLabelValue(serviceUuidStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value from service GUID string '%v'", serviceUuidStr)
+	}
+	labels[docker_label_key.UserServiceGUIDDockerLabelKey] = serviceUuidLabelValue
+	labels[docker_label_key.ContainerTypeDockerLabelKey] = label_value_consts.NetworkingSidecarContainerTypeDockerLabelValue
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
 func (provider *dockerEnclaveObjectAttributesProviderImpl) ForLogsCollector(tcpPortId string, tcpPortSpec *port_spec.PortSpec, httpPortId string, httpPortSpec *port_spec.PortSpec) (DockerObjectAttributes, error) {
 	name, err := provider.getNameForEnclaveObject([]string{logsCollectorFragment})
 	if err != nil {
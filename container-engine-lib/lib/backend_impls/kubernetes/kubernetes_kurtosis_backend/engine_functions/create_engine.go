@@ -26,6 +26,13 @@ const (
 	maxWaitForEngineContainerAvailabilityRetries         = 30
 	timeBetweenWaitForEngineContainerAvailabilityRetries = 1 * time.Second
 	httpApplicationProtocol                              = "http"
+
+	engineDbPodVolumeName = "engine-db"
+	// The host path of the engine database persistent volume is derived from this rather than from the engine
+	// namespace, which changes with every engine, so that the next engines keep using the same database
+	engineDbPersistentVolumeHostPathDirname = "kurtosis-engine"
+	// A zero size means the default persistent volume size is used
+	engineDbPersistentVolumeSizeInBytes int64 = 0
 )
 
 var noWait *port_spec.Wait = nil
@@ -128,7 +135,14 @@ func CreateEngine(
 		}
 	}()
 
-	enginePod, enginePodLabels, err := createEnginePod(ctx, namespaceName, engineAttributesProvider, imageOrgAndRepo, imageVersionTag, envVars, privatePortSpecs, serviceAccount.Name, kubernetesManager)
+	// The claim of the engine database persistent volume is removed along with the namespace, while the volume is kept
+	// for the next engines
+	engineDbVolume, err := createEngineDbVolume(ctx, namespaceName, engineAttributesProvider, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine database volume")
+	}
+
+	enginePod, enginePodLabels, err := createEnginePod(ctx, namespaceName, engineAttributesProvider, imageOrgAndRepo, imageVersionTag, envVars, privatePortSpecs, serviceAccount.Name, engineDbVolume, kubernetesManager)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the engine pod")
 	}
@@ -374,6 +388,7 @@ func createEnginePod(
 	envVars map[string]string,
 	privatePorts map[string]*port_spec.PortSpec,
 	serviceAccountName string,
+	engineDbVolume *apiv1.Volume,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 ) (*apiv1.Pod, map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, error) {
 	// Get Pod Attributes
@@ -416,10 +431,20 @@ func createEnginePod(
 			Image: containerImageAndTag,
 			Env:   engineContainerEnvVars,
 			Ports: containerPorts,
+			VolumeMounts: []apiv1.VolumeMount{
+				{
+					Name:             engineDbVolume.Name,
+					ReadOnly:         false,
+					MountPath:        engine.EngineDatabaseDirpath,
+					SubPath:          "",
+					MountPropagation: nil,
+					SubPathExpr:      "",
+				},
+			},
 		},
 	}
 
-	engineVolumes := []apiv1.Volume{}
+	engineVolumes := []apiv1.Volume{*engineDbVolume}
 	engineInitContainers := []apiv1.Container{}
 
 	// Create pods with engine containers and volumes in kubernetes
@@ -440,6 +465,64 @@ func createEnginePod(
 	return pod, enginePodLabels, nil
 }
 
+// createEngineDbVolume returns the pod volume of the engine database, backed by a persistent volume kept across engines
+func createEngineDbVolume(
+	ctx context.Context,
+	namespace string,
+	engineAttributesProvider object_attributes_provider.KubernetesEngineObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.Volume, error) {
+	hasMultipleNodes, err := kubernetesManager.HasMultipleComputeNodes(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while verifying whether the Kubernetes cluster has multiple compute nodes")
+	}
+	if hasMultipleNodes {
+		logrus.Warnf("The persistent volumes aren't supported on Kubernetes clusters with multiple nodes yet, so the engine database won't be kept when the engine restarts")
+		//nolint:exhaustruct
+		return newEngineDbVolume(apiv1.VolumeSource{
+			EmptyDir: &apiv1.EmptyDirVolumeSource{
+				Medium:    apiv1.StorageMediumDefault,
+				SizeLimit: nil,
+			},
+		}), nil
+	}
+
+	volumeAttributes, err := engineAttributesProvider.ForEngineDbPersistentVolume()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get Kubernetes attributes for the engine database persistent volume, instead got a non-nil error")
+	}
+	volumeName := volumeAttributes.GetName().GetString()
+	volumeLabels := shared_helpers.GetStringMapFromLabelMap(volumeAttributes.GetLabels())
+
+	if _, err := kubernetesManager.GetPersistentVolume(ctx, volumeName); err != nil {
+		if _, err := kubernetesManager.CreatePersistentVolume(ctx, engineDbPersistentVolumeHostPathDirname, volumeName, volumeLabels, engineDbPersistentVolumeSizeInBytes); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the engine database persistent volume '%v'", volumeName)
+		}
+	} else if err := kubernetesManager.ReclaimPersistentVolume(ctx, volumeName); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reclaiming the engine database persistent volume '%v' of the previous engine", volumeName)
+	}
+
+	// The claim binds the volume of its name
+	volumeClaim, err := kubernetesManager.CreatePersistentVolumeClaim(ctx, namespace, volumeName, volumeLabels, engineDbPersistentVolumeSizeInBytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the claim of the engine database persistent volume '%v' in namespace '%v'", volumeName, namespace)
+	}
+	//nolint:exhaustruct
+	return newEngineDbVolume(apiv1.VolumeSource{
+		PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{
+			ClaimName: volumeClaim.Name,
+			ReadOnly:  false,
+		},
+	}), nil
+}
+
+func newEngineDbVolume(volumeSource apiv1.VolumeSource) *apiv1.Volume {
+	return &apiv1.Volume{
+		Name:         engineDbPodVolumeName,
+		VolumeSource: volumeSource,
+	}
+}
+
 func createEngineService(
 	ctx context.Context,
 	namespace string,
@@ -15,10 +15,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/persistent_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
@@ -124,6 +128,10 @@ func (backend *KubernetesKurtosisBackend) FetchImage(ctx context.Context, image
 	return false, nil
 }
 
+func (backend *KubernetesKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (bool, error) {
+	return false, stacktrace.NewError("Building images isn't supported on Kubernetes yet; image '%v' needs to be built and pushed to a registry the cluster can pull from, then referenced by name", imageName)
+}
+
 func (backend *KubernetesKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]string, error) {
 	logrus.Warnf("PruneUnusedImages isn't implemented for Kubernetes yet")
 	return nil, nil
@@ -135,10 +143,14 @@ func (backend *KubernetesKurtosisBackend) CreateEngine(
 	imageVersionTag string,
 	grpcPortNum uint16,
 	envVars map[string]string,
+	logsSinks logs_aggregator.Sinks,
 ) (
 	*engine.Engine,
 	error,
 ) {
+	if len(logsSinks) > 0 {
+		return nil, stacktrace.NewError("Logs sinks are configured, but they aren't supported on Kubernetes yet; remove the 'logs-aggregator' sinks from the Kurtosis config to start the engine")
+	}
 	kubernetesEngine, err := engine_functions.CreateEngine(
 		ctx,
 		imageOrgAndRepo,
@@ -336,6 +348,25 @@ func (backend *KubernetesKurtosisBackend) GetUserServiceLogs(
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) GetUserServicesStats(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	successfulUserServicesStats map[service.ServiceUUID]*service_stats.ServiceStats,
+	erroredUserServiceUuids map[service.ServiceUUID]error,
+	resultErr error,
+) {
+	return user_services_functions.GetUserServicesStats(
+		ctx,
+		enclaveUuid,
+		filters,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
 func (backend *KubernetesKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -400,6 +431,52 @@ func (backend *KubernetesKurtosisBackend) CopyFilesFromUserService(
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpath string,
+	tarContent io.Reader,
+) error {
+	return user_services_functions.CopyFilesToUserService(
+		ctx,
+		enclaveUuid,
+		serviceUuid,
+		dstDirpath,
+		tarContent,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) CopyFilesToPersistentDirectoryVolume(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	volumeName string,
+	tarContent io.Reader,
+) error {
+	// TODO IMPLEMENT
+	return stacktrace.NewError("Writing content to persistent directory volume '%v' isn't yet implemented on Kubernetes", volumeName)
+}
+
+func (backend *KubernetesKurtosisBackend) ExportUserServiceFilesystem(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	imageName string,
+	output io.Writer,
+) error {
+	return stacktrace.NewError("Exporting the filesystem of user service '%v' isn't supported on Kubernetes, only the content of its persistent directories can be exported", serviceUuid)
+}
+
+func (backend *KubernetesKurtosisBackend) ImportUserServiceFilesystem(
+	ctx context.Context,
+	filesystemContent io.Reader,
+) error {
+	return stacktrace.NewError("Importing the filesystem of a user service isn't supported on Kubernetes")
+}
+
 func (backend *KubernetesKurtosisBackend) StopUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (resultSuccessfulGuids map[service.ServiceUUID]bool, resultErroredGuids map[service.ServiceUUID]error, resultErr error) {
 	return user_services_functions.StopUserServices(
 		ctx,
@@ -422,6 +499,103 @@ func (backend *KubernetesKurtosisBackend) DestroyUserServices(ctx context.Contex
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) GetPersistentDirectoryVolumes(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+) (
+	map[string]*persistent_directory.PersistentDirectoryVolume,
+	error,
+) {
+	return user_services_functions.GetPersistentDirectoryVolumes(ctx, enclaveUuid, filters, backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyPersistentDirectoryVolumes(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+) (
+	resultSuccessfulVolumeNames map[string]bool,
+	resultErroredVolumeNames map[string]error,
+	resultErr error,
+) {
+	return user_services_functions.DestroyPersistentDirectoryVolumes(
+		ctx,
+		enclaveUuid,
+		filters,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) CopyFilesFromPersistentDirectoryVolume(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	volumeName string,
+	output io.Writer,
+) error {
+	return user_services_functions.CopyFilesFromPersistentDirectoryVolume(
+		ctx,
+		enclaveUuid,
+		volumeName,
+		output,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) CreateNetworkingSidecar(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+) (
+	*networking_sidecar.NetworkingSidecar,
+	error,
+) {
+	// TODO IMPLEMENT
+	return nil, stacktrace.NewError("Creating networking sidecars isn't yet implemented on Kubernetes")
+}
+
+func (backend *KubernetesKurtosisBackend) GetNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar,
+	error,
+) {
+	// Networking sidecars can't be created on Kubernetes yet, so there is never any to return
+	return map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar{}, nil
+}
+
+func (backend *KubernetesKurtosisBackend) RunNetworkingSidecarExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	networkingSidecarsCommands map[service.ServiceUUID][]string,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	// TODO IMPLEMENT
+	return nil, nil, stacktrace.NewError("Running commands on networking sidecars isn't yet implemented on Kubernetes")
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	// Networking sidecars can't be created on Kubernetes yet, so there is never any to destroy
+	return map[service.ServiceUUID]bool{}, map[service.ServiceUUID]error{}, nil
+}
+
 func (backend *KubernetesKurtosisBackend) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, bool, error) {
 	// TODO - implement resource calculation in kubernetes
 	return 0, 0, isResourceInformationComplete, nil
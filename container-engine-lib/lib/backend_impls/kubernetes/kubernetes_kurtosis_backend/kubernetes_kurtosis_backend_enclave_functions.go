@@ -52,6 +52,7 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
 	enclaveName string,
+	labels map[string]string,
 ) (
 	*enclave.Enclave,
 	error,
@@ -74,7 +75,7 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 
 	// Make Enclave attributes provider
 	enclaveObjAttrsProvider := backend.objAttrsProvider.ForEnclave(enclaveUuid)
-	enclaveNamespaceAttrs, err := enclaveObjAttrsProvider.ForEnclaveNamespace(creationTime, enclaveName)
+	enclaveNamespaceAttrs, err := enclaveObjAttrsProvider.ForEnclaveNamespace(creationTime, enclaveName, labels)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave network attributes for the enclave with ID '%v'", enclaveUuid)
 	}
@@ -170,6 +171,18 @@ func (backend *KubernetesKurtosisBackend) UpdateEnclave(
 	return nil
 }
 
+func (backend *KubernetesKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]bool,
+	map[enclave.EnclaveUUID]error,
+	error,
+) {
+	// Stopping an enclave removes its pods on Kubernetes, so there is nothing left to start
+	return nil, nil, stacktrace.NewError("Starting stopped enclaves isn't supported on Kubernetes yet")
+}
+
 func (backend *KubernetesKurtosisBackend) StopEnclaves(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -389,6 +402,10 @@ func (backend *KubernetesKurtosisBackend) getMatchingEnclaveObjectsAndKubernetes
 			}
 		}
 
+		if !enclaveObj.HasLabels(filters.Labels) {
+			continue
+		}
+
 		resultEnclaveObjs[enclaveId] = enclaveObj
 		if _, found := matchingResources[enclaveId]; !found {
 			return nil, nil, stacktrace.NewError("Expected to find Kubernetes resources matching enclave '%v' but none was found", enclaveId)
@@ -406,6 +423,7 @@ func (backend *KubernetesKurtosisBackend) getSingleEnclaveAndKubernetesResources
 			enclaveUuid: true,
 		},
 		Statuses: nil,
+		Labels:   nil,
 	}
 	matchingEnclaveObjects, matchingKubernetesResources, err := backend.getMatchingEnclaveObjectsAndKubernetesResources(ctx, enclaveSearchFilters)
 	if err != nil {
@@ -588,6 +606,7 @@ func getEnclaveObjectsFromKubernetesResources(
 			enclaveStatus,
 			enclaveCreationTime,
 			false,
+			kubernetes_label_key.GetUserCustomLabelsFromLabels(resourcesForEnclaveId.namespace.GetLabels()),
 		)
 
 		result[enclaveId] = enclaveObj
@@ -464,18 +464,29 @@ func GetUserServiceObjectsFromKubernetesResources(
 			podContainerEnvVars[env.Name] = env.Value
 		}
 
+		serviceContainer := container.NewContainer(
+			containerStatus,
+			podContainer.Image,
+			podContainer.Command,
+			podContainer.Args,
+			podContainerEnvVars,
+		)
+		if exitCode, found := getContainerExitCodeFromPod(resourcesToParse.Pod); containerStatus == container.ContainerStatus_Stopped && found {
+			serviceContainer = container.NewExitedContainer(
+				podContainer.Image,
+				podContainer.Command,
+				podContainer.Args,
+				podContainerEnvVars,
+				exitCode,
+			)
+		}
+
 		resultObj.Service = service.NewService(
 			serviceRegistrationObj,
 			privatePorts,
 			servicePublicIp,
 			servicePublicPorts,
-			container.NewContainer(
-				containerStatus,
-				podContainer.Image,
-				podContainer.Command,
-				podContainer.Args,
-				podContainerEnvVars,
-			),
+			serviceContainer,
 		)
 	}
 
@@ -557,6 +568,18 @@ func GetContainerStatusFromPod(pod *apiv1.Pod) (container.ContainerStatus, error
 	return status, nil
 }
 
+// getContainerExitCodeFromPod returns the exit code of the pod's user service container, and false if it hasn't terminated
+func getContainerExitCodeFromPod(pod *apiv1.Pod) (int32, bool) {
+	if pod == nil || len(pod.Status.ContainerStatuses) == 0 {
+		return 0, false
+	}
+	terminatedState := pod.Status.ContainerStatuses[0].State.Terminated
+	if terminatedState == nil {
+		return 0, false
+	}
+	return terminatedState.ExitCode, true
+}
+
 func GetStringMapFromLabelMap(labelMap map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue) map[string]string {
 	strMap := map[string]string{}
 	for labelKey, labelValue := range labelMap {
@@ -0,0 +1,41 @@
+package user_services_functions
+
+import (
+	"archive/tar"
+	"bytes"
+	"github.com/stretchr/testify/require"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetCopyToCommand_DestinationIsNotInterpretedByTheShell(t *testing.T) {
+	tmpDirpath := t.TempDir()
+	injectedFilepath := filepath.Join(tmpDirpath, "injected")
+	dstDirpath := filepath.Join(tmpDirpath, "it's a '$(touch "+injectedFilepath+")' dir")
+
+	tarContent := &bytes.Buffer{}
+	tarWriter := tar.NewWriter(tarContent)
+	fileContent := []byte("Long Live Kurtosis!")
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{
+		Name: "file.txt",
+		Mode: 0644,
+		Size: int64(len(fileContent)),
+	}))
+	_, err := tarWriter.Write(fileContent)
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+
+	copyToCommand := getCopyToCommand(dstDirpath)
+	//nolint:gosec
+	cmd := exec.Command(copyToCommand[0], copyToCommand[1:]...)
+	cmd.Stdin = tarContent
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	copiedFileContent, err := os.ReadFile(filepath.Join(dstDirpath, "file.txt"))
+	require.NoError(t, err)
+	require.Equal(t, fileContent, copiedFileContent)
+	require.NoFileExists(t, injectedFilepath)
+}
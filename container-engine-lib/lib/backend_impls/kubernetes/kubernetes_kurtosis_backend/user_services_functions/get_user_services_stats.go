@@ -0,0 +1,73 @@
+package user_services_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// GetUserServicesStats gets the CPU and memory usage of the user services from the metrics API. It doesn't report the
+// network and block IO, so the stats have none
+func GetUserServicesStats(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (
+	map[service.ServiceUUID]*service_stats.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	serviceObjectsAndResources, err := shared_helpers.GetMatchingUserServiceObjectsAndKubernetesResources(ctx, enclaveId, filters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Expected to be able to get user services and Kubernetes resources, instead a non nil error was returned")
+	}
+
+	successfulUserServicesStats := map[service.ServiceUUID]*service_stats.ServiceStats{}
+	erroredUserServices := map[service.ServiceUUID]error{}
+	for serviceUuid, serviceObjectAndResource := range serviceObjectsAndResources {
+		servicePod := serviceObjectAndResource.KubernetesResources.Pod
+		if servicePod == nil || servicePod.Status.Phase != apiv1.PodRunning {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Cannot get the stats of service '%v' as it has no running pod", serviceUuid)
+			continue
+		}
+
+		resourceUsageByContainerName, err := kubernetesManager.GetPodContainersResourceUsage(ctx, servicePod.Namespace, servicePod.Name)
+		if err != nil {
+			erroredUserServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting the resource usage of pod '%v' for service '%v'", servicePod.Name, serviceUuid)
+			continue
+		}
+		resourceUsage, found := resourceUsageByContainerName[userServiceContainerName]
+		if !found {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("The metrics of pod '%v' for service '%v' have no resource usage for container '%v'", servicePod.Name, serviceUuid, userServiceContainerName)
+			continue
+		}
+
+		successfulUserServicesStats[serviceUuid] = service_stats.NewServiceStatsWithoutIO(
+			uint64(resourceUsage.Cpu().MilliValue()),
+			uint64(resourceUsage.Memory().Value()),
+			getUserServiceContainerMemoryLimitBytes(servicePod),
+		)
+	}
+	return successfulUserServicesStats, erroredUserServices, nil
+}
+
+func getUserServiceContainerMemoryLimitBytes(servicePod *apiv1.Pod) uint64 {
+	for _, podContainer := range servicePod.Spec.Containers {
+		if podContainer.Name != userServiceContainerName {
+			continue
+		}
+		if memoryLimit, found := podContainer.Resources.Limits[apiv1.ResourceMemory]; found {
+			return uint64(memoryLimit.Value())
+		}
+	}
+	return service_stats.NoMemoryLimit
+}
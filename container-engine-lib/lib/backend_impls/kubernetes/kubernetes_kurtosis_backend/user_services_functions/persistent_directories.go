@@ -1,20 +1,40 @@
 package user_services_functions
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/persistent_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"io"
 	apiv1 "k8s.io/api/core/v1"
+	"math"
+	"path"
+	"strconv"
+)
+
+const (
+	persistentDirectorySizeLabelValueBase    = 10
+	persistentDirectorySizeLabelValueBitSize = 64
 )
 
 type kubernetesVolumeWithClaim struct {
 	VolumeName string
 
 	VolumeClaimName string
+
+	IsShared bool
+
+	IsReadOnly bool
 }
 
 func (volumeAndClaim *kubernetesVolumeWithClaim) GetVolume() *apiv1.Volume {
@@ -32,7 +52,7 @@ func (volumeAndClaim *kubernetesVolumeWithClaim) GetVolume() *apiv1.Volume {
 			Glusterfs:            nil,
 			PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{
 				ClaimName: volumeAndClaim.VolumeClaimName,
-				ReadOnly:  false,
+				ReadOnly:  volumeAndClaim.IsReadOnly,
 			},
 			RBD:                  nil,
 			FlexVolume:           nil,
@@ -60,7 +80,7 @@ func (volumeAndClaim *kubernetesVolumeWithClaim) GetVolume() *apiv1.Volume {
 func (volumeAndClaim *kubernetesVolumeWithClaim) GetVolumeMount(mountPath string) *apiv1.VolumeMount {
 	return &apiv1.VolumeMount{
 		Name:             volumeAndClaim.VolumeName,
-		ReadOnly:         false,
+		ReadOnly:         volumeAndClaim.IsReadOnly,
 		MountPath:        mountPath,
 		SubPath:          "",
 		MountPropagation: nil,
@@ -73,7 +93,7 @@ func preparePersistentDirectoriesResources(
 	namespace string,
 	serviceUuid service.ServiceUUID,
 	objAttributeProviders object_attributes_provider.KubernetesEnclaveObjectAttributesProvider,
-	serviceMountpointsToPersistentKey map[string]service_directory.DirectoryPersistentKey,
+	serviceMountpointsToPersistentDirectory map[string]service_directory.PersistentDirectory,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 ) (map[string]*kubernetesVolumeWithClaim, error) {
 	shouldDeleteVolumesAndClaimsCreated := true
@@ -82,8 +102,13 @@ func preparePersistentDirectoriesResources(
 
 	persistentVolumesAndClaims := map[string]*kubernetesVolumeWithClaim{}
 
-	for dirPath, persistentKey := range serviceMountpointsToPersistentKey {
-		volumeAttrs, err := objAttributeProviders.ForSinglePersistentDirectoryVolume(serviceUuid, persistentKey)
+	for dirPath, persistentDirectory := range serviceMountpointsToPersistentDirectory {
+		persistentKey := persistentDirectory.PersistentKey
+		if uint64(persistentDirectory.Size) > math.MaxInt64/megabytesToBytesFactor {
+			return nil, stacktrace.NewError("The size of persistent directory '%s' is too large: %d megabytes", persistentKey, persistentDirectory.Size)
+		}
+		volumeSizeInBytes := int64(persistentDirectory.Size) * megabytesToBytesFactor
+		volumeAttrs, err := objAttributeProviders.ForSinglePersistentDirectoryVolume(serviceUuid, persistentDirectory)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating the labels for persist service directory '%s'", persistentKey)
 		}
@@ -96,7 +121,7 @@ func preparePersistentDirectoriesResources(
 
 		var persistentVolume *apiv1.PersistentVolume
 		if persistentVolume, err = kubernetesManager.GetPersistentVolume(ctx, volumeName); err != nil {
-			persistentVolume, err = kubernetesManager.CreatePersistentVolume(ctx, namespace, volumeName, volumeLabelsStrs)
+			persistentVolume, err = kubernetesManager.CreatePersistentVolume(ctx, namespace, volumeName, volumeLabelsStrs, volumeSizeInBytes)
 			if err != nil {
 				return nil, stacktrace.Propagate(err, "An error occurred creating the persistent volume for '%s'", persistentKey)
 			}
@@ -106,7 +131,7 @@ func preparePersistentDirectoriesResources(
 		// For now, we have a 1:1 mapping between volume and volume claims, so it's fine giving it the same name
 		var persistentVolumeClaim *apiv1.PersistentVolumeClaim
 		if persistentVolumeClaim, err = kubernetesManager.GetPersistentVolumeClaim(ctx, namespace, volumeName); err != nil {
-			persistentVolumeClaim, err = kubernetesManager.CreatePersistentVolumeClaim(ctx, namespace, volumeName, volumeLabelsStrs)
+			persistentVolumeClaim, err = kubernetesManager.CreatePersistentVolumeClaim(ctx, namespace, volumeName, volumeLabelsStrs, volumeSizeInBytes)
 			if err != nil {
 				return nil, stacktrace.Propagate(err, "An error occurred creating the persistent volume claim for '%s'", persistentKey)
 			}
@@ -116,6 +141,8 @@ func preparePersistentDirectoriesResources(
 		persistentVolumesAndClaims[dirPath] = &kubernetesVolumeWithClaim{
 			VolumeName:      persistentVolume.Name,
 			VolumeClaimName: persistentVolumeClaim.Name,
+			IsShared:        persistentDirectory.IsShared,
+			IsReadOnly:      persistentDirectory.IsReadOnly,
 		}
 	}
 
@@ -150,3 +177,267 @@ func preparePersistentDirectoriesResources(
 	shouldDeleteVolumesAndClaimsCreated = false
 	return persistentVolumesAndClaims, nil
 }
+
+func GetPersistentDirectoryVolumes(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (map[string]*persistent_directory.PersistentDirectoryVolume, error) {
+	matchingVolumes, err := getMatchingPersistentDirectoryKubernetesVolumes(ctx, enclaveId, filters, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting persistent directory volumes in enclave '%v' matching filters '%+v'", enclaveId, filters)
+	}
+
+	result := map[string]*persistent_directory.PersistentDirectoryVolume{}
+	for _, kubernetesVolume := range matchingVolumes {
+		persistentDirectoryVolume, err := getPersistentDirectoryVolumeObjectFromKubernetesVolume(kubernetesVolume)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred converting Kubernetes persistent volume '%v' to a persistent directory volume object", kubernetesVolume.Name)
+		}
+		result[persistentDirectoryVolume.GetName()] = persistentDirectoryVolume
+	}
+	return result, nil
+}
+
+// DestroyPersistentDirectoryVolumes removes the matching volumes along with their claims. Volumes whose claim is still
+// used by a pod in the enclave are not removed, and the error is reported for that volume
+func DestroyPersistentDirectoryVolumes(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (
+	map[string]bool,
+	map[string]error,
+	error,
+) {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveId, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveId)
+	}
+
+	matchingVolumes, err := getMatchingPersistentDirectoryKubernetesVolumes(ctx, enclaveId, filters, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting persistent directory volumes in enclave '%v' matching filters '%+v'", enclaveId, filters)
+	}
+
+	claimNamesToPodNames, err := getPersistentVolumeClaimNamesToUserServicePodNames(ctx, enclaveId, namespaceName, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the persistent volume claims used by the pods in enclave '%v'", enclaveId)
+	}
+
+	successfulVolumeNames := map[string]bool{}
+	erroredVolumeNames := map[string]error{}
+	for _, kubernetesVolume := range matchingVolumes {
+		// For now, we have a 1:1 mapping between volume and volume claims, so they have the same name
+		volumeName := kubernetesVolume.Name
+		if podName, found := claimNamesToPodNames[volumeName]; found {
+			erroredVolumeNames[volumeName] = stacktrace.NewError("Persistent directory volume '%v' is still mounted by pod '%v'; remove the service using it first", volumeName, podName)
+			continue
+		}
+		if err := kubernetesManager.RemovePersistentVolumeClaim(ctx, namespaceName, volumeName); err != nil {
+			erroredVolumeNames[volumeName] = stacktrace.Propagate(err, "An error occurred removing the claim of persistent directory volume '%v'", volumeName)
+			continue
+		}
+		if err := kubernetesManager.RemovePersistentVolume(ctx, volumeName); err != nil {
+			erroredVolumeNames[volumeName] = stacktrace.Propagate(err, "An error occurred removing persistent directory volume '%v'", volumeName)
+			continue
+		}
+		successfulVolumeNames[volumeName] = true
+	}
+	return successfulVolumeNames, erroredVolumeNames, nil
+}
+
+// CopyFilesFromPersistentDirectoryVolume reads the volume content through a running pod mounting it, as host path volumes
+// can't be read from outside the cluster node
+func CopyFilesFromPersistentDirectoryVolume(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	volumeName string,
+	output io.Writer,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveId, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveId)
+	}
+
+	pods, err := getUserServicePods(ctx, enclaveId, namespaceName, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the user service pods in enclave '%v'", enclaveId)
+	}
+
+	for _, pod := range pods {
+		if pod.Status.Phase != apiv1.PodRunning {
+			continue
+		}
+		mountPath, found := getPersistentVolumeClaimMountPath(pod, volumeName)
+		if !found {
+			continue
+		}
+
+		srcPath := path.Join(mountPath, ignoreParentDirInArchiveSymbol)
+		commandToRun := fmt.Sprintf(commandString, mountPath, ignoreParentDirInArchiveSymbol, srcPath)
+		shWrappedCommandToRun := []string{
+			"sh",
+			"-c",
+			commandToRun,
+		}
+		stdErrOutput := &bytes.Buffer{}
+		exitCode, err := kubernetesManager.RunExecCommand(
+			namespaceName,
+			pod.Name,
+			userServiceContainerName,
+			shWrappedCommandToRun,
+			output,
+			stdErrOutput,
+		)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred running command '%v' on pod '%v' in namespace '%v'", commandToRun, pod.Name, namespaceName)
+		}
+		if exitCode != tarSuccessExitCode {
+			return stacktrace.NewError(
+				"Command '%v' exited with non-%v exit code %v and the following STDERR:\n%v",
+				commandToRun,
+				tarSuccessExitCode,
+				exitCode,
+				stdErrOutput.String(),
+			)
+		}
+		return nil
+	}
+	return stacktrace.NewError(
+		"Cannot download persistent directory volume '%v' in enclave '%v' because no running service is mounting it; "+
+			"on Kubernetes the volume content can only be read through a running service using it",
+		volumeName,
+		enclaveId,
+	)
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func getMatchingPersistentDirectoryKubernetesVolumes(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) ([]apiv1.PersistentVolume, error) {
+	searchLabels := map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():              label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString():        string(enclaveId),
+		kubernetes_label_key.KurtosisVolumeTypeKubernetesLabelKey.GetString(): label_value_consts.PersistentDirectoryVolumeTypeKubernetesLabelValue.GetString(),
+	}
+	volumes, err := kubernetesManager.GetPersistentVolumesByLabels(ctx, searchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting persistent directory volumes in enclave '%v' by labels: %+v", enclaveId, searchLabels)
+	}
+
+	result := []apiv1.PersistentVolume{}
+	for _, kubernetesVolume := range volumes.Items {
+		if filters != nil && len(filters.Names) > 0 {
+			if _, found := filters.Names[kubernetesVolume.Name]; !found {
+				continue
+			}
+		}
+		if filters != nil && len(filters.PersistentKeys) > 0 {
+			persistentKey := service_directory.DirectoryPersistentKey(kubernetesVolume.Labels[kubernetes_label_key.IDKubernetesLabelKey.GetString()])
+			if _, found := filters.PersistentKeys[persistentKey]; !found {
+				continue
+			}
+		}
+		result = append(result, kubernetesVolume)
+	}
+	return result, nil
+}
+
+func getPersistentDirectoryVolumeObjectFromKubernetesVolume(kubernetesVolume apiv1.PersistentVolume) (*persistent_directory.PersistentDirectoryVolume, error) {
+	persistentKey := service_directory.DirectoryPersistentKey(kubernetesVolume.Labels[kubernetes_label_key.IDKubernetesLabelKey.GetString()])
+	// Volumes without the service GUID label are shared across the enclave
+	serviceUuid := service.ServiceUUID(kubernetesVolume.Labels[kubernetes_label_key.UserServiceGUIDKubernetesLabelKey.GetString()])
+
+	var size service_directory.DirectoryPersistentSize
+	if sizeStr, found := kubernetesVolume.Labels[kubernetes_label_key.PersistentDirectorySizeKubernetesLabelKey.GetString()]; found {
+		sizeUint, err := strconv.ParseUint(sizeStr, persistentDirectorySizeLabelValueBase, persistentDirectorySizeLabelValueBitSize)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the size label value '%v' of persistent volume '%v'", sizeStr, kubernetesVolume.Name)
+		}
+		size = service_directory.DirectoryPersistentSize(sizeUint)
+	}
+
+	return persistent_directory.NewPersistentDirectoryVolume(kubernetesVolume.Name, persistentKey, size, serviceUuid), nil
+}
+
+func getUserServicePods(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	namespaceName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) ([]apiv1.Pod, error) {
+	podSearchLabels := map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString():          string(enclaveId),
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	}
+	pods, err := kubernetesManager.GetPodsByLabels(ctx, namespaceName, podSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service pods in namespace '%v' by labels: %+v", namespaceName, podSearchLabels)
+	}
+	return pods.Items, nil
+}
+
+func getPersistentVolumeClaimNamesToUserServicePodNames(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	namespaceName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (map[string]string, error) {
+	pods, err := getUserServicePods(ctx, enclaveId, namespaceName, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the user service pods in enclave '%v'", enclaveId)
+	}
+	result := map[string]string{}
+	for _, pod := range pods {
+		for _, podVolume := range pod.Spec.Volumes {
+			if podVolume.PersistentVolumeClaim == nil {
+				continue
+			}
+			result[podVolume.PersistentVolumeClaim.ClaimName] = pod.Name
+		}
+	}
+	return result, nil
+}
+
+// getPersistentVolumeClaimMountPath returns the path at which the user service container of the pod mounts the claim
+func getPersistentVolumeClaimMountPath(pod apiv1.Pod, claimName string) (string, bool) {
+	podVolumeName := ""
+	for _, podVolume := range pod.Spec.Volumes {
+		if podVolume.PersistentVolumeClaim != nil && podVolume.PersistentVolumeClaim.ClaimName == claimName {
+			podVolumeName = podVolume.Name
+			break
+		}
+	}
+	if podVolumeName == "" {
+		return "", false
+	}
+	for _, container := range pod.Spec.Containers {
+		if container.Name != userServiceContainerName {
+			continue
+		}
+		for _, volumeMount := range container.VolumeMounts {
+			if volumeMount.Name == podVolumeName {
+				return volumeMount.MountPath, true
+			}
+		}
+	}
+	return "", false
+}
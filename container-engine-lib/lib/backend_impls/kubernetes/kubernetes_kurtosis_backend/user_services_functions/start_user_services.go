@@ -346,7 +346,7 @@ func createStartServiceOperation(
 				namespaceName,
 				serviceUuid,
 				enclaveObjAttributesProvider,
-				persistentDirectories.ServiceDirpathToDirectoryPersistentKey,
+				persistentDirectories.ServiceDirpathToPersistentDirectory,
 				kubernetesManager)
 			if err != nil {
 				return nil, stacktrace.Propagate(err, "An error occurred creating the persistent volumes and claims requested for service '%s'", serviceName)
@@ -361,6 +361,10 @@ func createStartServiceOperation(
 				return
 			}
 			for _, volumeAndClaim := range createVolumesWithClaims {
+				// Shared persistent directories are left untouched as other services in the enclave may be using them
+				if volumeAndClaim.IsShared {
+					continue
+				}
 				volumeClaimName := volumeAndClaim.VolumeClaimName
 				volumeName := volumeAndClaim.VolumeName
 				if err := kubernetesManager.RemovePersistentVolumeClaim(ctx, namespaceName, volumeClaimName); err != nil {
@@ -67,6 +67,10 @@ const (
 	shouldFollowContainerLogsWhenPrintingPodInfo = false
 	shouldAddTimestampsWhenPrintingPodInfo       = true
 
+	// client-go has no typed client for the metrics API, which the metrics server of the cluster serves
+	metricsApiNamespacesPath = "/apis/metrics.k8s.io/v1beta1/namespaces"
+	metricsApiPodsResource   = "pods"
+
 	listOptionsTimeoutSeconds      int64 = 10
 	contextDeadlineExceeded              = "context deadline exceeded"
 	expectedStatusMessageSliceSize       = 6
@@ -78,6 +82,8 @@ const (
 	waitForPersistentVolumeBoundTimeout                        = 30 * time.Second
 	waitForPersistentVolumeBoundInitialDelayMilliSeconds       = 100
 	waitForPersistentVolumeBoundRetriesDelayMilliSeconds       = 500
+	waitForPersistentVolumeReleasedTimeout                     = 30 * time.Second
+	waitForPersistentVolumeReleasedRetriesDelay                = 500 * time.Millisecond
 )
 
 // We'll try to use the nicer-to-use shells first before we drop down to the lower shells
@@ -147,6 +153,16 @@ type KubernetesManager struct {
 	kuberneteRestConfig *rest.Config
 }
 
+// podMetrics is the part of the PodMetrics object of the metrics API we use
+type podMetrics struct {
+	Containers []containerMetrics `json:"containers"`
+}
+
+type containerMetrics struct {
+	Name  string             `json:"name"`
+	Usage apiv1.ResourceList `json:"usage"`
+}
+
 func int64Ptr(i int64) *int64 { return &i }
 
 func NewKubernetesManager(kubernetesClientSet *kubernetes.Clientset, kuberneteRestConfig *rest.Config) *KubernetesManager {
@@ -300,11 +316,13 @@ func (manager *KubernetesManager) GetServicesByLabels(ctx context.Context, names
 
 // ---------------------------Volumes------------------------------------------------------------------------------
 
+// CreatePersistentVolume creates a volume of the given size in bytes; a zero size means the default volume size is used
 func (manager *KubernetesManager) CreatePersistentVolume(
 	ctx context.Context,
 	namespace string,
 	volumeName string,
 	labels map[string]string,
+	volumeSizeInBytes int64,
 ) (*apiv1.PersistentVolume, error) {
 	volumesClient := manager.kubernetesClientSet.CoreV1().PersistentVolumes()
 
@@ -355,7 +373,7 @@ func (manager *KubernetesManager) CreatePersistentVolume(
 		},
 		Spec: apiv1.PersistentVolumeSpec{
 			Capacity: apiv1.ResourceList{
-				apiv1.ResourceStorage: *resource.NewQuantity(persistentVolumeDefaultSize, resource.BinarySI),
+				apiv1.ResourceStorage: *resource.NewQuantity(getPersistentVolumeSize(volumeSizeInBytes), resource.BinarySI),
 			},
 			PersistentVolumeSource: apiv1.PersistentVolumeSource{
 				GCEPersistentDisk:    nil,
@@ -431,6 +449,51 @@ func (manager *KubernetesManager) GetPersistentVolume(
 	return volume, nil
 }
 
+// ReclaimPersistentVolume makes a volume whose claim was removed available to a new claim, keeping its content, as
+// Kubernetes keeps the retained volumes reserved to their removed claim. As the claim can still be in the process of
+// being removed (e.g. along with its namespace), it waits for the volume to be released
+func (manager *KubernetesManager) ReclaimPersistentVolume(
+	ctx context.Context,
+	volumeName string,
+) error {
+	volumesClient := manager.kubernetesClientSet.CoreV1().PersistentVolumes()
+	deadline := time.Now().Add(waitForPersistentVolumeReleasedTimeout)
+	for {
+		volume, err := manager.GetPersistentVolume(ctx, volumeName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the persistent volume '%s' to reclaim", volumeName)
+		}
+		switch volume.Status.Phase {
+		case apiv1.VolumePending, apiv1.VolumeAvailable:
+			return nil
+		case apiv1.VolumeReleased:
+			volume.Spec.ClaimRef = nil
+			updateOptions := metav1.UpdateOptions{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "",
+					APIVersion: "",
+				},
+				DryRun:          nil,
+				FieldManager:    fieldManager,
+				FieldValidation: "",
+			}
+			if _, err := volumesClient.Update(ctx, volume, updateOptions); err != nil {
+				return stacktrace.Propagate(err, "An error occurred removing the reference to its removed claim from persistent volume '%s'", volumeName)
+			}
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return stacktrace.NewError(
+				"Persistent volume '%s' is still in phase '%v' after waiting for %v for it to be released by its claim",
+				volumeName,
+				volume.Status.Phase,
+				waitForPersistentVolumeReleasedTimeout,
+			)
+		}
+		time.Sleep(waitForPersistentVolumeReleasedRetriesDelay)
+	}
+}
+
 func (manager *KubernetesManager) GetPersistentVolumesByLabels(ctx context.Context, persistentVolumeLabels map[string]string) (*apiv1.PersistentVolumeList, error) {
 	persistentVolumesClient := manager.kubernetesClientSet.CoreV1().PersistentVolumes()
 
@@ -457,11 +520,13 @@ func (manager *KubernetesManager) GetPersistentVolumesByLabels(ctx context.Conte
 	return &persistentVolumesNotMarkedForDeletionserviceList, nil
 }
 
+// CreatePersistentVolumeClaim creates a claim of the given size in bytes; a zero size means the default volume size is used
 func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 	ctx context.Context,
 	namespace string,
 	volumeClaimName string,
 	labels map[string]string,
+	volumeSizeInBytes int64,
 ) (*apiv1.PersistentVolumeClaim, error) {
 	volumeClaimsClient := manager.kubernetesClientSet.CoreV1().PersistentVolumeClaims(namespace)
 
@@ -499,7 +564,7 @@ func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 				Requests: apiv1.ResourceList{
 					// we give each claim 100% of the corresponding volume. Since we have a 1:1 mapping between volumes
 					// and claims right now, it's the best we can do
-					apiv1.ResourceStorage: *resource.NewQuantity(persistentVolumeDefaultSize, resource.BinarySI),
+					apiv1.ResourceStorage: *resource.NewQuantity(getPersistentVolumeSize(volumeSizeInBytes), resource.BinarySI),
 				},
 				Claims: nil,
 			},
@@ -1304,6 +1369,33 @@ func (manager *KubernetesManager) GetPod(ctx context.Context, namespace string,
 	return pod, nil
 }
 
+// GetPodContainersResourceUsage returns the CPU and memory each container of the pod uses, keyed by container name,
+// as averaged by the metrics server over its last scraping window
+func (manager *KubernetesManager) GetPodContainersResourceUsage(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+) (map[string]apiv1.ResourceList, error) {
+	rawPodMetrics, err := manager.kubernetesClientSet.RESTClient().
+		Get().
+		AbsPath(metricsApiNamespacesPath, namespaceName, metricsApiPodsResource, podName).
+		DoRaw(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the metrics of pod '%v' in namespace '%v'; is the metrics server installed in the cluster?", podName, namespaceName)
+	}
+
+	var metrics podMetrics
+	if err := json.Unmarshal(rawPodMetrics, &metrics); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the metrics of pod '%v' in namespace '%v'", podName, namespaceName)
+	}
+
+	resourceUsageByContainerName := map[string]apiv1.ResourceList{}
+	for _, containerMetrics := range metrics.Containers {
+		resourceUsageByContainerName[containerMetrics.Name] = containerMetrics.Usage
+	}
+	return resourceUsageByContainerName, nil
+}
+
 // GetContainerLogs gets the logs for a given container running inside the given pod in the give namespace
 // TODO We could upgrade this to get the logs of many containers at once just like kubectl does, see:
 //
@@ -1509,6 +1601,83 @@ func (manager *KubernetesManager) RunExecCommand(
 	return successExecCommandExitCode, nil
 }
 
+// RunExecCommandWithStdin runs the exec like RunExecCommandWithContext, streaming the given input to the STDIN of the
+// command
+func (manager *KubernetesManager) RunExecCommandWithStdin(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	containerName string,
+	command []string,
+	stdInInput io.Reader,
+	stdOutOutput io.Writer,
+	stdErrOutput io.Writer,
+) (
+	resultExitCode int32,
+	resultErr error,
+) {
+	execOptions := &apiv1.PodExecOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		Stdin:     true,
+		Stdout:    shouldAllocatedStdoutOnPodExec,
+		Stderr:    shouldAllocatedStderrOnPodExec,
+		TTY:       shouldAllocateTtyOnPodExec,
+		Container: containerName,
+		Command:   command,
+	}
+
+	//Create a RESTful command request.
+	request := manager.kubernetesClientSet.CoreV1().RESTClient().
+		Post().
+		Namespace(namespaceName).
+		Resource("pods").
+		Name(podName).
+		SubResource("exec").
+		VersionedParams(execOptions, scheme.ParameterCodec)
+	if request == nil {
+		return -1, stacktrace.NewError(
+			"Failed to build a working RESTful request for the command '%s'.",
+			execOptions.Command,
+		)
+	}
+
+	exec, err := remotecommand.NewSPDYExecutor(manager.kuberneteRestConfig, http.MethodPost, request.URL())
+	if err != nil {
+		return -1, stacktrace.Propagate(
+			err,
+			"Failed to build an executor for the command '%s' with the RESTful endpoint '%s'.",
+			execOptions.Command,
+			request.URL().String(),
+		)
+	}
+
+	if err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:             stdInInput,
+		Stdout:            stdOutOutput,
+		Stderr:            stdErrOutput,
+		Tty:               false,
+		TerminalSizeQueue: nil,
+	}); err != nil {
+		// Kubernetes returns the exit code of the command via a string in the error message, so we have to extract it
+		statusError := err.Error()
+		exitCode, err := getExitCodeFromStatusMessage(statusError)
+		if err != nil {
+			return exitCode, stacktrace.Propagate(
+				err,
+				"There was an error trying to parse the message '%s' to an exit code.",
+				statusError,
+			)
+		}
+
+		return exitCode, nil
+	}
+
+	return successExecCommandExitCode, nil
+}
+
 func (manager *KubernetesManager) RunExecCommandWithStreamedOutput(
 	ctx context.Context,
 	namespaceName string,
@@ -1753,6 +1922,15 @@ func (manager *KubernetesManager) HasComputeNodes(ctx context.Context) (bool, er
 	return len(nodes.Items) != 0, nil
 }
 
+// HasMultipleComputeNodes tells whether the cluster has more than one node, which the persistent volumes don't support
+func (manager *KubernetesManager) HasMultipleComputeNodes(ctx context.Context) (bool, error) {
+	nodes, err := manager.kubernetesClientSet.CoreV1().Nodes().List(ctx, globalListOptions)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred while checking if the Kubernetes cluster has multiple nodes")
+	}
+	return len(nodes.Items) > 1, nil
+}
+
 // TODO Delete this after 2022-08-01 if we're not using Jobs
 /*
 func (manager *KubernetesManager) CreateJobWithContainerAndVolume(ctx context.Context,
@@ -2163,6 +2341,13 @@ func renderContainerStatuses(containerStatuses []apiv1.ContainerStatus, prefixSt
 	return containerStatusStrs
 }
 
+func getPersistentVolumeSize(volumeSizeInBytes int64) int64 {
+	if volumeSizeInBytes == 0 {
+		return persistentVolumeDefaultSize
+	}
+	return volumeSizeInBytes
+}
+
 // Kubernetes doesn't seem to have a nice API for getting back the exit code of a command (though this seems strange??),
 // so we have to parse it out of a status message
 func getExitCodeFromStatusMessage(statusMessage string) (int32, error) {
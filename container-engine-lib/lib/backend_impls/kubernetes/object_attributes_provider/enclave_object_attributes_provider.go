@@ -16,6 +16,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/stacktrace"
+	"strconv"
 	"time"
 )
 
@@ -23,10 +24,12 @@ const (
 	namespacePrefix = "kt"
 
 	persistentServiceDirectoryNameFragment = "service-persistent-directory"
+
+	persistentDirectorySizeBase = 10
 )
 
 type KubernetesEnclaveObjectAttributesProvider interface {
-	ForEnclaveNamespace(creationTime time.Time, enclaveName string) (KubernetesObjectAttributes, error)
+	ForEnclaveNamespace(creationTime time.Time, enclaveName string, enclaveLabels map[string]string) (KubernetesObjectAttributes, error)
 	ForApiContainer() KubernetesApiContainerObjectAttributesProvider
 	ForUserServiceService(
 		uuid service.ServiceUUID,
@@ -40,7 +43,7 @@ type KubernetesEnclaveObjectAttributesProvider interface {
 	) (KubernetesObjectAttributes, error)
 	ForSinglePersistentDirectoryVolume(
 		serviceUUID service.ServiceUUID,
-		persistentKey service_directory.DirectoryPersistentKey,
+		persistentDirectory service_directory.PersistentDirectory,
 	) (KubernetesObjectAttributes, error)
 }
 
@@ -62,7 +65,7 @@ func GetKubernetesEnclaveObjectAttributesProvider(enclaveId enclave.EnclaveUUID)
 	return newKubernetesEnclaveObjectAttributesProviderImpl(enclaveId)
 }
 
-func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespace(creationTime time.Time, enclaveName string) (KubernetesObjectAttributes, error) {
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespace(creationTime time.Time, enclaveName string, enclaveLabels map[string]string) (KubernetesObjectAttributes, error) {
 	// TODO: might need to revert this if we have multiple users on the same cluster (what if two people create enclaves with name test?)
 	name, err := getCompositeKubernetesObjectName([]string{
 		namespacePrefix,
@@ -80,6 +83,19 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespa
 		return nil, stacktrace.Propagate(err, "Failed to get labels for enclave namespace using ID '%v'", provider.enclaveId)
 	}
 
+	// add user enclave labels
+	for enclaveLabelKey, enclaveLabelValue := range enclaveLabels {
+		kubernetesLabelKey, err := kubernetes_label_key.CreateNewKubernetesUserCustomLabelKey(enclaveLabelKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Kubernetes label key '%s'", enclaveLabelKey)
+		}
+		kubernetesLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(enclaveLabelValue)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Kubernetes label value '%s'", enclaveLabelValue)
+		}
+		labels[kubernetesLabelKey] = kubernetesLabelValue
+	}
+
 	creationTimeStr := creationTime.Format(time.RFC3339)
 
 	creationTimeAnnotationValue, err := kubernetes_annotation_value.CreateNewKubernetesAnnotationValue(creationTimeStr)
@@ -199,10 +215,15 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserServicePod
 	return objectAttributes, nil
 }
 
-func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForSinglePersistentDirectoryVolume(serviceUUID service.ServiceUUID, persistentKey service_directory.DirectoryPersistentKey) (KubernetesObjectAttributes, error) {
+// Shared persistent directories get one volume per persistent key for the whole enclave, so the service UUID is left
+// out of the hash and of the labels
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForSinglePersistentDirectoryVolume(serviceUUID service.ServiceUUID, persistentDirectory service_directory.PersistentDirectory) (KubernetesObjectAttributes, error) {
+	persistentKey := persistentDirectory.PersistentKey
 	hasher := md5.New()
 	hasher.Write([]byte(provider.enclaveId))
-	hasher.Write([]byte(serviceUUID))
+	if !persistentDirectory.IsShared {
+		hasher.Write([]byte(serviceUUID))
+	}
 	hasher.Write([]byte(persistentKey))
 	persistentKeyHash := hex.EncodeToString(hasher.Sum(nil))
 
@@ -215,6 +236,19 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForSinglePersiste
 			persistentKeyHash,
 		)
 	}
+	if !persistentDirectory.IsShared {
+		serviceUuidLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(string(serviceUUID))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Kubernetes label value from service UUID '%v'", serviceUUID)
+		}
+		labels[kubernetes_label_key.UserServiceGUIDKubernetesLabelKey] = serviceUuidLabelValue
+	}
+	sizeLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(strconv.FormatUint(uint64(persistentDirectory.Size), persistentDirectorySizeBase))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Kubernetes label value from persistent directory size '%v'", persistentDirectory.Size)
+	}
+	labels[kubernetes_label_key.PersistentDirectorySizeKubernetesLabelKey] = sizeLabelValue
+	labels[kubernetes_label_key.KurtosisVolumeTypeKubernetesLabelKey] = label_value_consts.PersistentDirectoryVolumeTypeKubernetesLabelValue
 
 	//No userServiceService annotations.
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
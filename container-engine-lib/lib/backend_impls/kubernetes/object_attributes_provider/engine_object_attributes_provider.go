@@ -16,6 +16,8 @@ import (
 
 const (
 	engineNamePrefix = "kurtosis-engine"
+
+	engineDbNameFragment = "db"
 )
 
 type KubernetesEngineObjectAttributesProvider interface {
@@ -33,6 +35,9 @@ type KubernetesEngineObjectAttributesProvider interface {
 	ForEngineClusterRole() (KubernetesObjectAttributes, error)
 
 	ForEngineClusterRoleBindings() (KubernetesObjectAttributes, error)
+
+	// The engine database volume is kept for the next engines, so its attributes don't depend on the engine GUID
+	ForEngineDbPersistentVolume() (KubernetesObjectAttributes, error)
 }
 
 // Private so it can't be instantiated
@@ -204,6 +209,31 @@ func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEngineClusterRo
 	return objectAttributes, nil
 }
 
+func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEngineDbPersistentVolume() (KubernetesObjectAttributes, error) {
+	name, err := getCompositeKubernetesObjectName([]string{
+		engineNamePrefix,
+		engineDbNameFragment,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Kubernetes object name for the engine database persistent volume")
+	}
+
+	labels := map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue{
+		kubernetes_label_key.KurtosisVolumeTypeKubernetesLabelKey: label_value_consts.EngineDbVolumeTypeKubernetesLabelValue,
+	}
+
+	// No custom annotations for the engine database persistent volume
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
+			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
+	}
+
+	return objectAttributes, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Methods
@@ -41,6 +41,20 @@ func ValidateUserCustomLabelKey(str string) error {
 	return nil
 }
 
+// GetUserCustomLabelsFromLabels returns the user custom labels found in the given Kubernetes labels, with the Kurtosis
+// custom user prefix removed from their keys
+func GetUserCustomLabelsFromLabels(labels map[string]string) map[string]string {
+	userCustomLabels := map[string]string{}
+	for labelKey, labelValue := range labels {
+		if !strings.HasPrefix(labelKey, customUserLabelsKeyPrefixStr) {
+			continue
+		}
+		userCustomLabelKey := strings.TrimPrefix(labelKey, customUserLabelsKeyPrefixStr)
+		userCustomLabels[userCustomLabelKey] = labelValue
+	}
+	return userCustomLabels
+}
+
 func createNewKubernetesLabelKey(str string) (*KubernetesLabelKey, error) {
 	if err := validate(str); err != nil {
 		return nil, stacktrace.Propagate(err, "Label value string '%v' doesn't pass validation of being a Kubernetes label key", str)
@@ -21,6 +21,8 @@ const (
 
 	enclaveDataVolumeTypeLabelValueStr             = "enclave-data"
 	filesArtifactsExpansionVolumeTypeLabelValueStr = "files-artifacts-expansion"
+	persistentDirectoryVolumeTypeLabelValueStr     = "persistent-directory"
+	engineDbVolumeTypeLabelValueStr                = "engine-db"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -39,3 +41,5 @@ var UserServiceKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value
 
 var EnclaveDataVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(enclaveDataVolumeTypeLabelValueStr)
 var FilesArtifactsExpansionVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(filesArtifactsExpansionVolumeTypeLabelValueStr)
+var PersistentDirectoryVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(persistentDirectoryVolumeTypeLabelValueStr)
+var EngineDbVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(engineDbVolumeTypeLabelValueStr)
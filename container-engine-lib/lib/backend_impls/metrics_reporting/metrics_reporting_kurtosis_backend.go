@@ -11,10 +11,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/persistent_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -35,6 +39,14 @@ func (backend *MetricsReportingKurtosisBackend) FetchImage(ctx context.Context,
 	return pulledFromRemote, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (bool, error) {
+	built, err := backend.underlying.BuildImage(ctx, imageName, imageBuildSpec)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred building image '%v'", imageName)
+	}
+	return built, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]string, error) {
 	prunedImages, err := backend.underlying.PruneUnusedImages(ctx)
 	if err != nil {
@@ -49,6 +61,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateEngine(
 	imageVersionTag string,
 	grpcPortNum uint16,
 	envVars map[string]string,
+	logsSinks logs_aggregator.Sinks,
 ) (*engine.Engine, error) {
 	result, err := backend.underlying.CreateEngine(
 		ctx,
@@ -56,6 +69,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateEngine(
 		imageVersionTag,
 		grpcPortNum,
 		envVars,
+		logsSinks,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the engine using image '%v' with tag '%v'", imageOrgAndRepo, imageVersionTag)
@@ -110,8 +124,8 @@ func (backend *MetricsReportingKurtosisBackend) DumpKurtosis(ctx context.Context
 	return nil
 }
 
-func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
-	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName)
+func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, labels map[string]string) (*enclave.Enclave, error) {
+	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName, labels)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with UUID '%v'", enclaveUuid)
 	}
@@ -161,6 +175,21 @@ func (backend *MetricsReportingKurtosisBackend) StopEnclaves(
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	successfulEnclaveIds map[enclave.EnclaveUUID]bool,
+	erroredEnclaveIds map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	successes, failures, err := backend.underlying.StartEnclaves(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred starting enclaves using filters: %+v", filters)
+	}
+	return successes, failures, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) DumpEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -308,6 +337,22 @@ func (backend *MetricsReportingKurtosisBackend) GetUserServiceLogs(
 	return userServiceLogs, erroredUserServices, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetUserServicesStats(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service_stats.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	userServicesStats, erroredUserServices, err := backend.underlying.GetUserServicesStats(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services stats in enclave '%v' using filters '%+v'", enclaveUuid, filters)
+	}
+	return userServicesStats, erroredUserServices, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -365,6 +410,25 @@ func (backend *MetricsReportingKurtosisBackend) CopyFilesFromUserService(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpath string,
+	tarContent io.Reader,
+) error {
+	if err := backend.underlying.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, dstDirpath, tarContent); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files to destination path '%v' in user service with UUID '%v' in enclave with UUID '%v'",
+			dstDirpath,
+			serviceUuid,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -397,6 +461,181 @@ func (backend *MetricsReportingKurtosisBackend) DestroyUserServices(
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetPersistentDirectoryVolumes(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+) (
+	map[string]*persistent_directory.PersistentDirectoryVolume,
+	error,
+) {
+	volumes, err := backend.underlying.GetPersistentDirectoryVolumes(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting persistent directory volumes in enclave '%v' using filters: %+v", enclaveUuid, filters)
+	}
+	return volumes, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) DestroyPersistentDirectoryVolumes(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *persistent_directory.PersistentDirectoryVolumeFilters,
+) (
+	successfulVolumeNames map[string]bool,
+	erroredVolumeNames map[string]error,
+	resultErr error,
+) {
+	successes, failures, err := backend.underlying.DestroyPersistentDirectoryVolumes(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying persistent directory volumes in enclave '%v' using filters: %+v", enclaveUuid, filters)
+	}
+	return successes, failures, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) CopyFilesFromPersistentDirectoryVolume(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	volumeName string,
+	output io.Writer,
+) error {
+	if err := backend.underlying.CopyFilesFromPersistentDirectoryVolume(ctx, enclaveUuid, volumeName, output); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files from persistent directory volume '%v' in enclave with UUID '%v'",
+			volumeName,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) ExportUserServiceFilesystem(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	imageName string,
+	output io.Writer,
+) error {
+	if err := backend.underlying.ExportUserServiceFilesystem(ctx, enclaveUuid, serviceUuid, imageName, output); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred exporting the filesystem of user service '%v' in enclave with UUID '%v'",
+			serviceUuid,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) ImportUserServiceFilesystem(
+	ctx context.Context,
+	filesystemContent io.Reader,
+) error {
+	if err := backend.underlying.ImportUserServiceFilesystem(ctx, filesystemContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing a user service filesystem")
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) CopyFilesToPersistentDirectoryVolume(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	volumeName string,
+	tarContent io.Reader,
+) error {
+	if err := backend.underlying.CopyFilesToPersistentDirectoryVolume(ctx, enclaveUuid, volumeName, tarContent); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files to persistent directory volume '%v' in enclave with UUID '%v'",
+			volumeName,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) CreateNetworkingSidecar(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+) (
+	*networking_sidecar.NetworkingSidecar,
+	error,
+) {
+	sidecar, err := backend.underlying.CreateNetworkingSidecar(ctx, enclaveUuid, serviceUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred creating the networking sidecar for service with UUID '%v' in enclave with UUID '%v'",
+			serviceUuid,
+			enclaveUuid,
+		)
+	}
+	return sidecar, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) GetNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar,
+	error,
+) {
+	sidecars, err := backend.underlying.GetNetworkingSidecars(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred getting networking sidecars in enclave with UUID '%v' using filters '%+v'",
+			enclaveUuid,
+			filters,
+		)
+	}
+	return sidecars, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) RunNetworkingSidecarExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	networkingSidecarsCommands map[service.ServiceUUID][]string,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	successfulSidecarExecResults, erroredUserServiceUuids, err := backend.underlying.RunNetworkingSidecarExecCommands(ctx, enclaveUuid, networkingSidecarsCommands)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(
+			err,
+			"An error occurred running networking sidecar exec commands '%+v' in enclave with UUID '%v'",
+			networkingSidecarsCommands,
+			enclaveUuid,
+		)
+	}
+	return successfulSidecarExecResults, erroredUserServiceUuids, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) DestroyNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	successfulUserServiceUuids, erroredUserServiceUuids, err := backend.underlying.DestroyNetworkingSidecars(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(
+			err,
+			"An error occurred destroying networking sidecars in enclave with UUID '%v' using filters '%+v'",
+			enclaveUuid,
+			filters,
+		)
+	}
+	return successfulUserServiceUuids, erroredUserServiceUuids, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) CreateLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
 	return backend.underlying.CreateLogsAggregator(ctx)
 }
@@ -10,10 +10,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/persistent_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
 )
 
 // TODO This mega-backend should really have its individual functionalities split up into
@@ -33,6 +37,11 @@ type KurtosisBackend interface {
 	// Returns True is it was retrieved from cloud or False if it's a local image
 	FetchImage(ctx context.Context, image string, downloadMode image_download_mode.ImageDownloadMode) (bool, error)
 
+	// BuildImage builds the image with the given name from the build spec, reusing the image already built if neither
+	// the build context nor the options changed since.
+	// Returns True if the image was built or False if the existing image was reused
+	BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (bool, error)
+
 	PruneUnusedImages(ctx context.Context) ([]string, error)
 
 	// Creates an engine with the given parameters
@@ -42,6 +51,7 @@ type KurtosisBackend interface {
 		imageVersionTag string,
 		grpcPortNum uint16,
 		envVars map[string]string,
+		logsSinks logs_aggregator.Sinks,
 	) (
 		*engine.Engine,
 		error,
@@ -76,8 +86,8 @@ type KurtosisBackend interface {
 	// Dumps all of Kurtosis (engines + all enclaves)
 	DumpKurtosis(ctx context.Context, outputDirpath string) error
 
-	// Creates an enclave with the given enclave UUID
-	CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error)
+	// Creates an enclave with the given enclave UUID, storing the given user-defined labels on it
+	CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, labels map[string]string) (*enclave.Enclave, error)
 
 	// Update an enclave by UUID, it's only possible to udpate the name and creation time so far
 	// The newCreationTime param is optional, it won't be updated if the value is nil
@@ -107,6 +117,16 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Starts the stopped enclaves matching the given filters again; their user services are resumed by their API container
+	StartEnclaves(
+		ctx context.Context,
+		filters *enclave.EnclaveFilters,
+	) (
+		successfulEnclaveIds map[enclave.EnclaveUUID]bool,
+		erroredEnclaveIds map[enclave.EnclaveUUID]error,
+		resultErr error,
+	)
+
 	// Dumps the contents of the given enclave to the given directory
 	// TODO add this to K8S
 	DumpEnclave(
@@ -267,6 +287,18 @@ type KurtosisBackend interface {
 		resultError error,
 	)
 
+	// GetUserServicesStats returns a snapshot of the CPU, memory, network and block IO that the running user services
+	// matching the filters consume
+	GetUserServicesStats(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *service.ServiceFilters,
+	) (
+		successfulUserServicesStats map[service.ServiceUUID]*service_stats.ServiceStats,
+		erroredUserServiceUuids map[service.ServiceUUID]error,
+		resultErr error,
+	)
+
 	// Executes a shell command inside an user service instance indenfified by its ID
 	RunUserServiceExecCommands(
 		ctx context.Context,
@@ -298,6 +330,41 @@ type KurtosisBackend interface {
 		output io.Writer,
 	) error
 
+	// Extracts the given TAR'd content into the given directory of the given user service, creating the directory if it
+	// doesn't exist
+	CopyFilesToUserService(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		dstDirpathOnService string,
+		tarContent io.Reader,
+	) error
+
+	// ExportUserServiceFilesystem writes the filesystem of the user service, the content of its volumes excluded, to
+	// the output as an image tagged with the given name
+	ExportUserServiceFilesystem(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		imageName string,
+		output io.Writer,
+	) error
+
+	// ImportUserServiceFilesystem loads the filesystem written by ExportUserServiceFilesystem, such that a user service
+	// can be started from the image name it was exported with
+	ImportUserServiceFilesystem(
+		ctx context.Context,
+		filesystemContent io.Reader,
+	) error
+
+	// CopyFilesToPersistentDirectoryVolume extracts the given TAR'd content at the root of the persistent directory volume
+	CopyFilesToPersistentDirectoryVolume(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		volumeName string,
+		tarContent io.Reader,
+	) error
+
 	// StopUserServices stops the user containers for the services matching the given filters
 	StopUserServices(
 		ctx context.Context,
@@ -320,6 +387,79 @@ type KurtosisBackend interface {
 		resultErr error, // Represents an error with the function itself, rather than the user services
 	)
 
+	// GetPersistentDirectoryVolumes gets the volumes backing the persistent directories of the enclave, keyed by volume name
+	GetPersistentDirectoryVolumes(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *persistent_directory.PersistentDirectoryVolumeFilters,
+	) (
+		map[string]*persistent_directory.PersistentDirectoryVolume,
+		error,
+	)
+
+	// DestroyPersistentDirectoryVolumes destroys the persistent directory volumes matching the given filters
+	// Volumes still mounted by a running service cannot be destroyed
+	DestroyPersistentDirectoryVolumes(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *persistent_directory.PersistentDirectoryVolumeFilters,
+	) (
+		successfulVolumeNames map[string]bool, // "set" of volume names that were successfully destroyed
+		erroredVolumeNames map[string]error, // "set" of volume names that errored when destroying, with the error
+		resultErr error, // Represents an error with the function itself, rather than the volumes
+	)
+
+	// Copy the content of the persistent directory volume, packaged as a TAR, and writes the bytes to the given output writer
+	CopyFilesFromPersistentDirectoryVolume(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		volumeName string,
+		output io.Writer,
+	) error
+
+	// CreateNetworkingSidecar creates a networking sidecar attached to the network stack of the given user service,
+	// which can then be used to shape the traffic going out of the service
+	CreateNetworkingSidecar(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+	) (
+		*networking_sidecar.NetworkingSidecar,
+		error,
+	)
+
+	// GetNetworkingSidecars gets the networking sidecars of the enclave matching the given filters
+	GetNetworkingSidecars(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *networking_sidecar.NetworkingSidecarFilters,
+	) (
+		map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar,
+		error,
+	)
+
+	// RunNetworkingSidecarExecCommands executes the given commands inside the networking sidecars of the given user services
+	RunNetworkingSidecarExecCommands(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		networkingSidecarsCommands map[service.ServiceUUID][]string,
+	) (
+		successfulSidecarExecResults map[service.ServiceUUID]*exec_result.ExecResult, // Results of successful commands
+		erroredUserServiceUuids map[service.ServiceUUID]error, // Errors of failed commands
+		resultErr error, // Represents an error with the function itself, rather than the commands
+	)
+
+	// DestroyNetworkingSidecars destroys the networking sidecars matching the given filters
+	DestroyNetworkingSidecars(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *networking_sidecar.NetworkingSidecarFilters,
+	) (
+		successfulUserServiceUuids map[service.ServiceUUID]bool, // "set" of user service UUIDs whose sidecar was successfully destroyed
+		erroredUserServiceUuids map[service.ServiceUUID]error, // "set" of user service UUIDs whose sidecar errored when destroying, with the error
+		resultErr error, // Represents an error with the function itself, rather than the sidecars
+	)
+
 	CreateLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error)
 
 	// Returns nil if logs aggregator was not found
@@ -14,6 +14,8 @@ import (
 
 	exec_result "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 
+	image_build_spec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+
 	image_download_mode "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 
 	io "io"
@@ -24,8 +26,14 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	networking_sidecar "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+
+	persistent_directory "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/persistent_directory"
+
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 
+	service_stats "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
+
 	time "time"
 )
 
@@ -42,6 +50,105 @@ func (_m *MockKurtosisBackend) EXPECT() *MockKurtosisBackend_Expecter {
 	return &MockKurtosisBackend_Expecter{mock: &_m.Mock}
 }
 
+// BuildImage provides a mock function with given fields: ctx, imageName, imageBuildSpec
+func (_m *MockKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (bool, error) {
+	ret := _m.Called(ctx, imageName, imageBuildSpec)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *image_build_spec.ImageBuildSpec) (bool, error)); ok {
+		return rf(ctx, imageName, imageBuildSpec)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *image_build_spec.ImageBuildSpec) bool); ok {
+		r0 = rf(ctx, imageName, imageBuildSpec)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *image_build_spec.ImageBuildSpec) error); ok {
+		r1 = rf(ctx, imageName, imageBuildSpec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_BuildImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildImage'
+type MockKurtosisBackend_BuildImage_Call struct {
+	*mock.Call
+}
+
+// BuildImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imageName string
+//   - imageBuildSpec *image_build_spec.ImageBuildSpec
+func (_e *MockKurtosisBackend_Expecter) BuildImage(ctx interface{}, imageName interface{}, imageBuildSpec interface{}) *MockKurtosisBackend_BuildImage_Call {
+	return &MockKurtosisBackend_BuildImage_Call{Call: _e.mock.On("BuildImage", ctx, imageName, imageBuildSpec)}
+}
+
+func (_c *MockKurtosisBackend_BuildImage_Call) Run(run func(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec)) *MockKurtosisBackend_BuildImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*image_build_spec.ImageBuildSpec))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_BuildImage_Call) Return(_a0 bool, _a1 error) *MockKurtosisBackend_BuildImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_BuildImage_Call) RunAndReturn(run func(context.Context, string, *image_build_spec.ImageBuildSpec) (bool, error)) *MockKurtosisBackend_BuildImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyFilesFromPersistentDirectoryVolume provides a mock function with given fields: ctx, enclaveUuid, volumeName, output
+func (_m *MockKurtosisBackend) CopyFilesFromPersistentDirectoryVolume(ctx context.Context, enclaveUuid enclave.EnclaveUUID, volumeName string, output io.Writer) error {
+	ret := _m.Called(ctx, enclaveUuid, volumeName, output)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, io.Writer) error); ok {
+		r0 = rf(ctx, enclaveUuid, volumeName, output)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesFromPersistentDirectoryVolume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesFromPersistentDirectoryVolume'
+type MockKurtosisBackend_CopyFilesFromPersistentDirectoryVolume_Call struct {
+	*mock.Call
+}
+
+// CopyFilesFromPersistentDirectoryVolume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - volumeName string
+//   - output io.Writer
+func (_e *MockKurtosisBackend_Expecter) CopyFilesFromPersistentDirectoryVolume(ctx interface{}, enclaveUuid interface{}, volumeName interface{}, output interface{}) *MockKurtosisBackend_CopyFilesFromPersistentDirectoryVolume_Call {
+	return &MockKurtosisBackend_CopyFilesFromPersistentDirectoryVolume_Call{Call: _e.mock.On("CopyFilesFromPersistentDirectoryVolume", ctx, enclaveUuid, volumeName, output)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromPersistentDirectoryVolume_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, volumeName string, output io.Writer)) *MockKurtosisBackend_CopyFilesFromPersistentDirectoryVolume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromPersistentDirectoryVolume_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesFromPersistentDirectoryVolume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromPersistentDirectoryVolume_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string, io.Writer) error) *MockKurtosisBackend_CopyFilesFromPersistentDirectoryVolume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CopyFilesFromUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, srcPathOnService, output
 func (_m *MockKurtosisBackend) CopyFilesFromUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, srcPathOnService string, output io.Writer) error {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid, srcPathOnService, output)
@@ -88,6 +195,97 @@ func (_c *MockKurtosisBackend_CopyFilesFromUserService_Call) RunAndReturn(run fu
 	return _c
 }
 
+// CopyFilesToPersistentDirectoryVolume provides a mock function with given fields: ctx, enclaveUuid, volumeName, tarContent
+func (_m *MockKurtosisBackend) CopyFilesToPersistentDirectoryVolume(ctx context.Context, enclaveUuid enclave.EnclaveUUID, volumeName string, tarContent io.Reader) error {
+	ret := _m.Called(ctx, enclaveUuid, volumeName, tarContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, io.Reader) error); ok {
+		r0 = rf(ctx, enclaveUuid, volumeName, tarContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesToPersistentDirectoryVolume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesToPersistentDirectoryVolume'
+type MockKurtosisBackend_CopyFilesToPersistentDirectoryVolume_Call struct {
+	*mock.Call
+}
+
+// CopyFilesToPersistentDirectoryVolume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - volumeName string
+//   - tarContent io.Reader
+func (_e *MockKurtosisBackend_Expecter) CopyFilesToPersistentDirectoryVolume(ctx interface{}, enclaveUuid interface{}, volumeName interface{}, tarContent interface{}) *MockKurtosisBackend_CopyFilesToPersistentDirectoryVolume_Call {
+	return &MockKurtosisBackend_CopyFilesToPersistentDirectoryVolume_Call{Call: _e.mock.On("CopyFilesToPersistentDirectoryVolume", ctx, enclaveUuid, volumeName, tarContent)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToPersistentDirectoryVolume_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, volumeName string, tarContent io.Reader)) *MockKurtosisBackend_CopyFilesToPersistentDirectoryVolume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToPersistentDirectoryVolume_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesToPersistentDirectoryVolume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToPersistentDirectoryVolume_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string, io.Reader) error) *MockKurtosisBackend_CopyFilesToPersistentDirectoryVolume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyFilesToUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent
+func (_m *MockKurtosisBackend) CopyFilesToUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Reader) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesToUserService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesToUserService'
+type MockKurtosisBackend_CopyFilesToUserService_Call struct {
+	*mock.Call
+}
+
+// CopyFilesToUserService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - dstDirpathOnService string
+//   - tarContent io.Reader
+func (_e *MockKurtosisBackend_Expecter) CopyFilesToUserService(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, dstDirpathOnService interface{}, tarContent interface{}) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	return &MockKurtosisBackend_CopyFilesToUserService_Call{Call: _e.mock.On("CopyFilesToUserService", ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader)) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(string), args[4].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Reader) error) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAPIContainer provides a mock function with given fields: ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars
 func (_m *MockKurtosisBackend) CreateAPIContainer(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string) (*api_container.APIContainer, error) {
 	ret := _m.Called(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)
@@ -148,25 +346,25 @@ func (_c *MockKurtosisBackend_CreateAPIContainer_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName
-func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
-	ret := _m.Called(ctx, enclaveUuid, enclaveName)
+// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName, labels
+func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, labels map[string]string) (*enclave.Enclave, error) {
+	ret := _m.Called(ctx, enclaveUuid, enclaveName, labels)
 
 	var r0 *enclave.Enclave
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) (*enclave.Enclave, error)); ok {
-		return rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, map[string]string) (*enclave.Enclave, error)); ok {
+		return rf(ctx, enclaveUuid, enclaveName, labels)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) *enclave.Enclave); ok {
-		r0 = rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, map[string]string) *enclave.Enclave); ok {
+		r0 = rf(ctx, enclaveUuid, enclaveName, labels)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*enclave.Enclave)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string) error); ok {
-		r1 = rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string, map[string]string) error); ok {
+		r1 = rf(ctx, enclaveUuid, enclaveName, labels)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -183,13 +381,14 @@ type MockKurtosisBackend_CreateEnclave_Call struct {
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
 //   - enclaveName string
-func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}) *MockKurtosisBackend_CreateEnclave_Call {
-	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName)}
+//   - labels map[string]string
+func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}, labels interface{}) *MockKurtosisBackend_CreateEnclave_Call {
+	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName, labels)}
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, labels map[string]string)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(map[string]string))
 	})
 	return _c
 }
@@ -199,30 +398,30 @@ func (_c *MockKurtosisBackend_CreateEnclave_Call) Return(_a0 *enclave.Enclave, _
 	return _c
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string) (*enclave.Enclave, error)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string, map[string]string) (*enclave.Enclave, error)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateEngine provides a mock function with given fields: ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars
-func (_m *MockKurtosisBackend) CreateEngine(ctx context.Context, imageOrgAndRepo string, imageVersionTag string, grpcPortNum uint16, envVars map[string]string) (*engine.Engine, error) {
-	ret := _m.Called(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars)
+// CreateEngine provides a mock function with given fields: ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, logsSinks
+func (_m *MockKurtosisBackend) CreateEngine(ctx context.Context, imageOrgAndRepo string, imageVersionTag string, grpcPortNum uint16, envVars map[string]string, logsSinks logs_aggregator.Sinks) (*engine.Engine, error) {
+	ret := _m.Called(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, logsSinks)
 
 	var r0 *engine.Engine
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint16, map[string]string) (*engine.Engine, error)); ok {
-		return rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint16, map[string]string, logs_aggregator.Sinks) (*engine.Engine, error)); ok {
+		return rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, logsSinks)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint16, map[string]string) *engine.Engine); ok {
-		r0 = rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint16, map[string]string, logs_aggregator.Sinks) *engine.Engine); ok {
+		r0 = rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, logsSinks)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*engine.Engine)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint16, map[string]string) error); ok {
-		r1 = rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint16, map[string]string, logs_aggregator.Sinks) error); ok {
+		r1 = rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, logsSinks)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -241,13 +440,14 @@ type MockKurtosisBackend_CreateEngine_Call struct {
 //   - imageVersionTag string
 //   - grpcPortNum uint16
 //   - envVars map[string]string
-func (_e *MockKurtosisBackend_Expecter) CreateEngine(ctx interface{}, imageOrgAndRepo interface{}, imageVersionTag interface{}, grpcPortNum interface{}, envVars interface{}) *MockKurtosisBackend_CreateEngine_Call {
-	return &MockKurtosisBackend_CreateEngine_Call{Call: _e.mock.On("CreateEngine", ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars)}
+//   - logsSinks logs_aggregator.Sinks
+func (_e *MockKurtosisBackend_Expecter) CreateEngine(ctx interface{}, imageOrgAndRepo interface{}, imageVersionTag interface{}, grpcPortNum interface{}, envVars interface{}, logsSinks interface{}) *MockKurtosisBackend_CreateEngine_Call {
+	return &MockKurtosisBackend_CreateEngine_Call{Call: _e.mock.On("CreateEngine", ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, logsSinks)}
 }
 
-func (_c *MockKurtosisBackend_CreateEngine_Call) Run(run func(ctx context.Context, imageOrgAndRepo string, imageVersionTag string, grpcPortNum uint16, envVars map[string]string)) *MockKurtosisBackend_CreateEngine_Call {
+func (_c *MockKurtosisBackend_CreateEngine_Call) Run(run func(ctx context.Context, imageOrgAndRepo string, imageVersionTag string, grpcPortNum uint16, envVars map[string]string, logsSinks logs_aggregator.Sinks)) *MockKurtosisBackend_CreateEngine_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uint16), args[4].(map[string]string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uint16), args[4].(map[string]string), args[5].(logs_aggregator.Sinks))
 	})
 	return _c
 }
@@ -257,7 +457,7 @@ func (_c *MockKurtosisBackend_CreateEngine_Call) Return(_a0 *engine.Engine, _a1
 	return _c
 }
 
-func (_c *MockKurtosisBackend_CreateEngine_Call) RunAndReturn(run func(context.Context, string, string, uint16, map[string]string) (*engine.Engine, error)) *MockKurtosisBackend_CreateEngine_Call {
+func (_c *MockKurtosisBackend_CreateEngine_Call) RunAndReturn(run func(context.Context, string, string, uint16, map[string]string, logs_aggregator.Sinks) (*engine.Engine, error)) *MockKurtosisBackend_CreateEngine_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -373,6 +573,62 @@ func (_c *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call) RunAndReturn(r
 	return _c
 }
 
+// CreateNetworkingSidecar provides a mock function with given fields: ctx, enclaveUuid, serviceUuid
+func (_m *MockKurtosisBackend) CreateNetworkingSidecar(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (*networking_sidecar.NetworkingSidecar, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid)
+
+	var r0 *networking_sidecar.NetworkingSidecar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) (*networking_sidecar.NetworkingSidecar, error)); ok {
+		return rf(ctx, enclaveUuid, serviceUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) *networking_sidecar.NetworkingSidecar); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*networking_sidecar.NetworkingSidecar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_CreateNetworkingSidecar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNetworkingSidecar'
+type MockKurtosisBackend_CreateNetworkingSidecar_Call struct {
+	*mock.Call
+}
+
+// CreateNetworkingSidecar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+func (_e *MockKurtosisBackend_Expecter) CreateNetworkingSidecar(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}) *MockKurtosisBackend_CreateNetworkingSidecar_Call {
+	return &MockKurtosisBackend_CreateNetworkingSidecar_Call{Call: _e.mock.On("CreateNetworkingSidecar", ctx, enclaveUuid, serviceUuid)}
+}
+
+func (_c *MockKurtosisBackend_CreateNetworkingSidecar_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID)) *MockKurtosisBackend_CreateNetworkingSidecar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateNetworkingSidecar_Call) Return(_a0 *networking_sidecar.NetworkingSidecar, _a1 error) *MockKurtosisBackend_CreateNetworkingSidecar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateNetworkingSidecar_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) (*networking_sidecar.NetworkingSidecar, error)) *MockKurtosisBackend_CreateNetworkingSidecar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DestroyAPIContainers provides a mock function with given fields: ctx, filters
 func (_m *MockKurtosisBackend) DestroyAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
 	ret := _m.Called(ctx, filters)
@@ -650,17 +906,17 @@ func (_c *MockKurtosisBackend_DestroyLogsCollectorForEnclave_Call) RunAndReturn(
 	return _c
 }
 
-// DestroyUserServices provides a mock function with given fields: ctx, enclaveUuid, filters
-func (_m *MockKurtosisBackend) DestroyUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+// DestroyNetworkingSidecars provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) DestroyNetworkingSidecars(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, filters)
 
 	var r0 map[service.ServiceUUID]bool
 	var r1 map[service.ServiceUUID]error
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)); ok {
 		return rf(ctx, enclaveUuid, filters)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]bool); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) map[service.ServiceUUID]bool); ok {
 		r0 = rf(ctx, enclaveUuid, filters)
 	} else {
 		if ret.Get(0) != nil {
@@ -668,7 +924,7 @@ func (_m *MockKurtosisBackend) DestroyUserServices(ctx context.Context, enclaveU
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) map[service.ServiceUUID]error); ok {
 		r1 = rf(ctx, enclaveUuid, filters)
 	} else {
 		if ret.Get(1) != nil {
@@ -676,7 +932,7 @@ func (_m *MockKurtosisBackend) DestroyUserServices(ctx context.Context, enclaveU
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) error); ok {
 		r2 = rf(ctx, enclaveUuid, filters)
 	} else {
 		r2 = ret.Error(2)
@@ -685,98 +941,228 @@ func (_m *MockKurtosisBackend) DestroyUserServices(ctx context.Context, enclaveU
 	return r0, r1, r2
 }
 
-// MockKurtosisBackend_DestroyUserServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DestroyUserServices'
-type MockKurtosisBackend_DestroyUserServices_Call struct {
+// MockKurtosisBackend_DestroyNetworkingSidecars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DestroyNetworkingSidecars'
+type MockKurtosisBackend_DestroyNetworkingSidecars_Call struct {
 	*mock.Call
 }
 
-// DestroyUserServices is a helper method to define mock.On call
+// DestroyNetworkingSidecars is a helper method to define mock.On call
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
-//   - filters *service.ServiceFilters
-func (_e *MockKurtosisBackend_Expecter) DestroyUserServices(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_DestroyUserServices_Call {
-	return &MockKurtosisBackend_DestroyUserServices_Call{Call: _e.mock.On("DestroyUserServices", ctx, enclaveUuid, filters)}
+//   - filters *networking_sidecar.NetworkingSidecarFilters
+func (_e *MockKurtosisBackend_Expecter) DestroyNetworkingSidecars(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_DestroyNetworkingSidecars_Call {
+	return &MockKurtosisBackend_DestroyNetworkingSidecars_Call{Call: _e.mock.On("DestroyNetworkingSidecars", ctx, enclaveUuid, filters)}
 }
 
-func (_c *MockKurtosisBackend_DestroyUserServices_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_DestroyUserServices_Call {
+func (_c *MockKurtosisBackend_DestroyNetworkingSidecars_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *networking_sidecar.NetworkingSidecarFilters)) *MockKurtosisBackend_DestroyNetworkingSidecars_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*networking_sidecar.NetworkingSidecarFilters))
 	})
 	return _c
 }
 
-func (_c *MockKurtosisBackend_DestroyUserServices_Call) Return(successfulUserServiceUuids map[service.ServiceUUID]bool, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) *MockKurtosisBackend_DestroyUserServices_Call {
+func (_c *MockKurtosisBackend_DestroyNetworkingSidecars_Call) Return(successfulUserServiceUuids map[service.ServiceUUID]bool, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) *MockKurtosisBackend_DestroyNetworkingSidecars_Call {
 	_c.Call.Return(successfulUserServiceUuids, erroredUserServiceUuids, resultErr)
 	return _c
 }
 
-func (_c *MockKurtosisBackend_DestroyUserServices_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_DestroyUserServices_Call {
+func (_c *MockKurtosisBackend_DestroyNetworkingSidecars_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_DestroyNetworkingSidecars_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DumpEnclave provides a mock function with given fields: ctx, enclaveUuid, outputDirpath
-func (_m *MockKurtosisBackend) DumpEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, outputDirpath string) error {
-	ret := _m.Called(ctx, enclaveUuid, outputDirpath)
+// DestroyPersistentDirectoryVolumes provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) DestroyPersistentDirectoryVolumes(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *persistent_directory.PersistentDirectoryVolumeFilters) (map[string]bool, map[string]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) error); ok {
-		r0 = rf(ctx, enclaveUuid, outputDirpath)
+	var r0 map[string]bool
+	var r1 map[string]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *persistent_directory.PersistentDirectoryVolumeFilters) (map[string]bool, map[string]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *persistent_directory.PersistentDirectoryVolumeFilters) map[string]bool); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *persistent_directory.PersistentDirectoryVolumeFilters) map[string]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *persistent_directory.PersistentDirectoryVolumeFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// MockKurtosisBackend_DumpEnclave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DumpEnclave'
-type MockKurtosisBackend_DumpEnclave_Call struct {
+// MockKurtosisBackend_DestroyPersistentDirectoryVolumes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DestroyPersistentDirectoryVolumes'
+type MockKurtosisBackend_DestroyPersistentDirectoryVolumes_Call struct {
 	*mock.Call
 }
 
-// DumpEnclave is a helper method to define mock.On call
+// DestroyPersistentDirectoryVolumes is a helper method to define mock.On call
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
-//   - outputDirpath string
-func (_e *MockKurtosisBackend_Expecter) DumpEnclave(ctx interface{}, enclaveUuid interface{}, outputDirpath interface{}) *MockKurtosisBackend_DumpEnclave_Call {
-	return &MockKurtosisBackend_DumpEnclave_Call{Call: _e.mock.On("DumpEnclave", ctx, enclaveUuid, outputDirpath)}
+//   - filters *persistent_directory.PersistentDirectoryVolumeFilters
+func (_e *MockKurtosisBackend_Expecter) DestroyPersistentDirectoryVolumes(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_DestroyPersistentDirectoryVolumes_Call {
+	return &MockKurtosisBackend_DestroyPersistentDirectoryVolumes_Call{Call: _e.mock.On("DestroyPersistentDirectoryVolumes", ctx, enclaveUuid, filters)}
 }
 
-func (_c *MockKurtosisBackend_DumpEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, outputDirpath string)) *MockKurtosisBackend_DumpEnclave_Call {
+func (_c *MockKurtosisBackend_DestroyPersistentDirectoryVolumes_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *persistent_directory.PersistentDirectoryVolumeFilters)) *MockKurtosisBackend_DestroyPersistentDirectoryVolumes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*persistent_directory.PersistentDirectoryVolumeFilters))
 	})
 	return _c
 }
 
-func (_c *MockKurtosisBackend_DumpEnclave_Call) Return(_a0 error) *MockKurtosisBackend_DumpEnclave_Call {
-	_c.Call.Return(_a0)
+func (_c *MockKurtosisBackend_DestroyPersistentDirectoryVolumes_Call) Return(successfulVolumeNames map[string]bool, erroredVolumeNames map[string]error, resultErr error) *MockKurtosisBackend_DestroyPersistentDirectoryVolumes_Call {
+	_c.Call.Return(successfulVolumeNames, erroredVolumeNames, resultErr)
 	return _c
 }
 
-func (_c *MockKurtosisBackend_DumpEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string) error) *MockKurtosisBackend_DumpEnclave_Call {
+func (_c *MockKurtosisBackend_DestroyPersistentDirectoryVolumes_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *persistent_directory.PersistentDirectoryVolumeFilters) (map[string]bool, map[string]error, error)) *MockKurtosisBackend_DestroyPersistentDirectoryVolumes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DumpKurtosis provides a mock function with given fields: ctx, outputDirpath
-func (_m *MockKurtosisBackend) DumpKurtosis(ctx context.Context, outputDirpath string) error {
-	ret := _m.Called(ctx, outputDirpath)
+// DestroyUserServices provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) DestroyUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, outputDirpath)
+	var r0 map[service.ServiceUUID]bool
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]bool); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]bool)
+		}
 	}
 
-	return r0
-}
-
-// MockKurtosisBackend_DumpKurtosis_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DumpKurtosis'
-type MockKurtosisBackend_DumpKurtosis_Call struct {
-	*mock.Call
-}
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_DestroyUserServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DestroyUserServices'
+type MockKurtosisBackend_DestroyUserServices_Call struct {
+	*mock.Call
+}
+
+// DestroyUserServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) DestroyUserServices(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_DestroyUserServices_Call {
+	return &MockKurtosisBackend_DestroyUserServices_Call{Call: _e.mock.On("DestroyUserServices", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_DestroyUserServices_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_DestroyUserServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DestroyUserServices_Call) Return(successfulUserServiceUuids map[service.ServiceUUID]bool, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) *MockKurtosisBackend_DestroyUserServices_Call {
+	_c.Call.Return(successfulUserServiceUuids, erroredUserServiceUuids, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DestroyUserServices_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_DestroyUserServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DumpEnclave provides a mock function with given fields: ctx, enclaveUuid, outputDirpath
+func (_m *MockKurtosisBackend) DumpEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, outputDirpath string) error {
+	ret := _m.Called(ctx, enclaveUuid, outputDirpath)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) error); ok {
+		r0 = rf(ctx, enclaveUuid, outputDirpath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_DumpEnclave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DumpEnclave'
+type MockKurtosisBackend_DumpEnclave_Call struct {
+	*mock.Call
+}
+
+// DumpEnclave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - outputDirpath string
+func (_e *MockKurtosisBackend_Expecter) DumpEnclave(ctx interface{}, enclaveUuid interface{}, outputDirpath interface{}) *MockKurtosisBackend_DumpEnclave_Call {
+	return &MockKurtosisBackend_DumpEnclave_Call{Call: _e.mock.On("DumpEnclave", ctx, enclaveUuid, outputDirpath)}
+}
+
+func (_c *MockKurtosisBackend_DumpEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, outputDirpath string)) *MockKurtosisBackend_DumpEnclave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DumpEnclave_Call) Return(_a0 error) *MockKurtosisBackend_DumpEnclave_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DumpEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string) error) *MockKurtosisBackend_DumpEnclave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DumpKurtosis provides a mock function with given fields: ctx, outputDirpath
+func (_m *MockKurtosisBackend) DumpKurtosis(ctx context.Context, outputDirpath string) error {
+	ret := _m.Called(ctx, outputDirpath)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, outputDirpath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_DumpKurtosis_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DumpKurtosis'
+type MockKurtosisBackend_DumpKurtosis_Call struct {
+	*mock.Call
+}
 
 // DumpKurtosis is a helper method to define mock.On call
 //   - ctx context.Context
@@ -802,6 +1188,52 @@ func (_c *MockKurtosisBackend_DumpKurtosis_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ExportUserServiceFilesystem provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, imageName, output
+func (_m *MockKurtosisBackend) ExportUserServiceFilesystem(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, imageName string, output io.Writer) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, imageName, output)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Writer) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, imageName, output)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_ExportUserServiceFilesystem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUserServiceFilesystem'
+type MockKurtosisBackend_ExportUserServiceFilesystem_Call struct {
+	*mock.Call
+}
+
+// ExportUserServiceFilesystem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - imageName string
+//   - output io.Writer
+func (_e *MockKurtosisBackend_Expecter) ExportUserServiceFilesystem(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, imageName interface{}, output interface{}) *MockKurtosisBackend_ExportUserServiceFilesystem_Call {
+	return &MockKurtosisBackend_ExportUserServiceFilesystem_Call{Call: _e.mock.On("ExportUserServiceFilesystem", ctx, enclaveUuid, serviceUuid, imageName, output)}
+}
+
+func (_c *MockKurtosisBackend_ExportUserServiceFilesystem_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, imageName string, output io.Writer)) *MockKurtosisBackend_ExportUserServiceFilesystem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(string), args[4].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ExportUserServiceFilesystem_Call) Return(_a0 error) *MockKurtosisBackend_ExportUserServiceFilesystem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ExportUserServiceFilesystem_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Writer) error) *MockKurtosisBackend_ExportUserServiceFilesystem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FetchImage provides a mock function with given fields: ctx, image, downloadMode
 func (_m *MockKurtosisBackend) FetchImage(ctx context.Context, image string, downloadMode image_download_mode.ImageDownloadMode) (bool, error) {
 	ret := _m.Called(ctx, image, downloadMode)
@@ -1239,6 +1671,118 @@ func (_c *MockKurtosisBackend_GetLogsCollectorForEnclave_Call) RunAndReturn(run
 	return _c
 }
 
+// GetNetworkingSidecars provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) GetNetworkingSidecars(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetNetworkingSidecars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNetworkingSidecars'
+type MockKurtosisBackend_GetNetworkingSidecars_Call struct {
+	*mock.Call
+}
+
+// GetNetworkingSidecars is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *networking_sidecar.NetworkingSidecarFilters
+func (_e *MockKurtosisBackend_Expecter) GetNetworkingSidecars(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetNetworkingSidecars_Call {
+	return &MockKurtosisBackend_GetNetworkingSidecars_Call{Call: _e.mock.On("GetNetworkingSidecars", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetNetworkingSidecars_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *networking_sidecar.NetworkingSidecarFilters)) *MockKurtosisBackend_GetNetworkingSidecars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*networking_sidecar.NetworkingSidecarFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetNetworkingSidecars_Call) Return(_a0 map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, _a1 error) *MockKurtosisBackend_GetNetworkingSidecars_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetNetworkingSidecars_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error)) *MockKurtosisBackend_GetNetworkingSidecars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersistentDirectoryVolumes provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) GetPersistentDirectoryVolumes(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *persistent_directory.PersistentDirectoryVolumeFilters) (map[string]*persistent_directory.PersistentDirectoryVolume, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[string]*persistent_directory.PersistentDirectoryVolume
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *persistent_directory.PersistentDirectoryVolumeFilters) (map[string]*persistent_directory.PersistentDirectoryVolume, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *persistent_directory.PersistentDirectoryVolumeFilters) map[string]*persistent_directory.PersistentDirectoryVolume); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*persistent_directory.PersistentDirectoryVolume)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *persistent_directory.PersistentDirectoryVolumeFilters) error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetPersistentDirectoryVolumes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersistentDirectoryVolumes'
+type MockKurtosisBackend_GetPersistentDirectoryVolumes_Call struct {
+	*mock.Call
+}
+
+// GetPersistentDirectoryVolumes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *persistent_directory.PersistentDirectoryVolumeFilters
+func (_e *MockKurtosisBackend_Expecter) GetPersistentDirectoryVolumes(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetPersistentDirectoryVolumes_Call {
+	return &MockKurtosisBackend_GetPersistentDirectoryVolumes_Call{Call: _e.mock.On("GetPersistentDirectoryVolumes", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetPersistentDirectoryVolumes_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *persistent_directory.PersistentDirectoryVolumeFilters)) *MockKurtosisBackend_GetPersistentDirectoryVolumes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*persistent_directory.PersistentDirectoryVolumeFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetPersistentDirectoryVolumes_Call) Return(_a0 map[string]*persistent_directory.PersistentDirectoryVolume, _a1 error) *MockKurtosisBackend_GetPersistentDirectoryVolumes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetPersistentDirectoryVolumes_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *persistent_directory.PersistentDirectoryVolumeFilters) (map[string]*persistent_directory.PersistentDirectoryVolume, error)) *MockKurtosisBackend_GetPersistentDirectoryVolumes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetShellOnUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid
 func (_m *MockKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) error {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid)
@@ -1405,6 +1949,114 @@ func (_c *MockKurtosisBackend_GetUserServices_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetUserServicesStats provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) GetUserServicesStats(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service_stats.ServiceStats, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]*service_stats.ServiceStats
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service_stats.ServiceStats, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]*service_stats.ServiceStats); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*service_stats.ServiceStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_GetUserServicesStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserServicesStats'
+type MockKurtosisBackend_GetUserServicesStats_Call struct {
+	*mock.Call
+}
+
+// GetUserServicesStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) GetUserServicesStats(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetUserServicesStats_Call {
+	return &MockKurtosisBackend_GetUserServicesStats_Call{Call: _e.mock.On("GetUserServicesStats", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetUserServicesStats_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_GetUserServicesStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServicesStats_Call) Return(successfulUserServicesStats map[service.ServiceUUID]*service_stats.ServiceStats, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) *MockKurtosisBackend_GetUserServicesStats_Call {
+	_c.Call.Return(successfulUserServicesStats, erroredUserServiceUuids, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServicesStats_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service_stats.ServiceStats, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_GetUserServicesStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportUserServiceFilesystem provides a mock function with given fields: ctx, filesystemContent
+func (_m *MockKurtosisBackend) ImportUserServiceFilesystem(ctx context.Context, filesystemContent io.Reader) error {
+	ret := _m.Called(ctx, filesystemContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) error); ok {
+		r0 = rf(ctx, filesystemContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_ImportUserServiceFilesystem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportUserServiceFilesystem'
+type MockKurtosisBackend_ImportUserServiceFilesystem_Call struct {
+	*mock.Call
+}
+
+// ImportUserServiceFilesystem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filesystemContent io.Reader
+func (_e *MockKurtosisBackend_Expecter) ImportUserServiceFilesystem(ctx interface{}, filesystemContent interface{}) *MockKurtosisBackend_ImportUserServiceFilesystem_Call {
+	return &MockKurtosisBackend_ImportUserServiceFilesystem_Call{Call: _e.mock.On("ImportUserServiceFilesystem", ctx, filesystemContent)}
+}
+
+func (_c *MockKurtosisBackend_ImportUserServiceFilesystem_Call) Run(run func(ctx context.Context, filesystemContent io.Reader)) *MockKurtosisBackend_ImportUserServiceFilesystem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ImportUserServiceFilesystem_Call) Return(_a0 error) *MockKurtosisBackend_ImportUserServiceFilesystem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ImportUserServiceFilesystem_Call) RunAndReturn(run func(context.Context, io.Reader) error) *MockKurtosisBackend_ImportUserServiceFilesystem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PruneUnusedImages provides a mock function with given fields: ctx
 func (_m *MockKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]string, error) {
 	ret := _m.Called(ctx)
@@ -1589,6 +2241,71 @@ func (_c *MockKurtosisBackend_RemoveRegisteredUserServiceProcesses_Call) RunAndR
 	return _c
 }
 
+// RunNetworkingSidecarExecCommands provides a mock function with given fields: ctx, enclaveUuid, networkingSidecarsCommands
+func (_m *MockKurtosisBackend) RunNetworkingSidecarExecCommands(ctx context.Context, enclaveUuid enclave.EnclaveUUID, networkingSidecarsCommands map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, networkingSidecarsCommands)
+
+	var r0 map[service.ServiceUUID]*exec_result.ExecResult
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, networkingSidecarsCommands)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID][]string) map[service.ServiceUUID]*exec_result.ExecResult); ok {
+		r0 = rf(ctx, enclaveUuid, networkingSidecarsCommands)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*exec_result.ExecResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID][]string) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, networkingSidecarsCommands)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID][]string) error); ok {
+		r2 = rf(ctx, enclaveUuid, networkingSidecarsCommands)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunNetworkingSidecarExecCommands'
+type MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call struct {
+	*mock.Call
+}
+
+// RunNetworkingSidecarExecCommands is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - networkingSidecarsCommands map[service.ServiceUUID][]string
+func (_e *MockKurtosisBackend_Expecter) RunNetworkingSidecarExecCommands(ctx interface{}, enclaveUuid interface{}, networkingSidecarsCommands interface{}) *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call {
+	return &MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call{Call: _e.mock.On("RunNetworkingSidecarExecCommands", ctx, enclaveUuid, networkingSidecarsCommands)}
+}
+
+func (_c *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, networkingSidecarsCommands map[service.ServiceUUID][]string)) *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(map[service.ServiceUUID][]string))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call) Return(successfulSidecarExecResults map[service.ServiceUUID]*exec_result.ExecResult, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call {
+	_c.Call.Return(successfulSidecarExecResults, erroredUserServiceUuids, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunUserServiceExecCommandWithStreamedOutput provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, cmd
 func (_m *MockKurtosisBackend) RunUserServiceExecCommandWithStreamedOutput(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, cmd []string) (chan string, chan *exec_result.ExecResult, error) {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid, cmd)
@@ -1720,6 +2437,70 @@ func (_c *MockKurtosisBackend_RunUserServiceExecCommands_Call) RunAndReturn(run
 	return _c
 }
 
+// StartEnclaves provides a mock function with given fields: ctx, filters
+func (_m *MockKurtosisBackend) StartEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 map[enclave.EnclaveUUID]bool
+	var r1 map[enclave.EnclaveUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) map[enclave.EnclaveUUID]bool); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[enclave.EnclaveUUID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *enclave.EnclaveFilters) map[enclave.EnclaveUUID]error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[enclave.EnclaveUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *enclave.EnclaveFilters) error); ok {
+		r2 = rf(ctx, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_StartEnclaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartEnclaves'
+type MockKurtosisBackend_StartEnclaves_Call struct {
+	*mock.Call
+}
+
+// StartEnclaves is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters *enclave.EnclaveFilters
+func (_e *MockKurtosisBackend_Expecter) StartEnclaves(ctx interface{}, filters interface{}) *MockKurtosisBackend_StartEnclaves_Call {
+	return &MockKurtosisBackend_StartEnclaves_Call{Call: _e.mock.On("StartEnclaves", ctx, filters)}
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) Run(run func(ctx context.Context, filters *enclave.EnclaveFilters)) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*enclave.EnclaveFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) Return(_a0 map[enclave.EnclaveUUID]bool, _a1 map[enclave.EnclaveUUID]error, _a2 error) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) RunAndReturn(run func(context.Context, *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error)) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartRegisteredUserServices provides a mock function with given fields: ctx, enclaveUuid, services
 func (_m *MockKurtosisBackend) StartRegisteredUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, services)
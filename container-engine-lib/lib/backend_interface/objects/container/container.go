@@ -7,10 +7,18 @@ type Container struct {
 	entrypointArgs []string
 	cmdArgs        []string
 	envVars        map[string]string
+
+	// the exit code of the container, only known once the container has exited
+	exitCode *int32
 }
 
 func NewContainer(status ContainerStatus, imageName string, entrypointArgs []string, cmdArgs []string, envVars map[string]string) *Container {
-	return &Container{status: status, imageName: imageName, entrypointArgs: entrypointArgs, cmdArgs: cmdArgs, envVars: envVars}
+	return &Container{status: status, imageName: imageName, entrypointArgs: entrypointArgs, cmdArgs: cmdArgs, envVars: envVars, exitCode: nil}
+}
+
+// NewExitedContainer creates a stopped container whose process exited with the given exit code
+func NewExitedContainer(imageName string, entrypointArgs []string, cmdArgs []string, envVars map[string]string, exitCode int32) *Container {
+	return &Container{status: ContainerStatus_Stopped, imageName: imageName, entrypointArgs: entrypointArgs, cmdArgs: cmdArgs, envVars: envVars, exitCode: &exitCode}
 }
 
 func (container *Container) GetStatus() ContainerStatus {
@@ -32,3 +40,11 @@ func (container *Container) GetCmdArgs() []string {
 func (container *Container) GetEnvVars() map[string]string {
 	return container.envVars
 }
+
+// GetExitCode returns the exit code of the container, and false if the container hasn't exited or its exit code is unknown
+func (container *Container) GetExitCode() (int32, bool) {
+	if container.exitCode == nil {
+		return 0, false
+	}
+	return *container.exitCode, true
+}
@@ -10,10 +10,11 @@ type Enclave struct {
 	status              EnclaveStatus
 	creationTime        *time.Time
 	isProductionEnclave bool
+	labels              map[string]string
 }
 
-func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, productionMode bool) *Enclave {
-	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, isProductionEnclave: productionMode}
+func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, productionMode bool, labels map[string]string) *Enclave {
+	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, isProductionEnclave: productionMode, labels: labels}
 }
 
 func (enclave *Enclave) GetUUID() EnclaveUUID {
@@ -35,3 +36,19 @@ func (enclave *Enclave) GetName() string {
 func (enclave *Enclave) IsProductionEnclave() bool {
 	return enclave.isProductionEnclave
 }
+
+// GetLabels returns the user-defined labels the enclave was created with
+func (enclave *Enclave) GetLabels() map[string]string {
+	return enclave.labels
+}
+
+// HasLabels returns true if the enclave has every one of the given labels, with the same value
+func (enclave *Enclave) HasLabels(labels map[string]string) bool {
+	for key, value := range labels {
+		enclaveLabelValue, found := enclave.labels[key]
+		if !found || enclaveLabelValue != value {
+			return false
+		}
+	}
+	return true
+}
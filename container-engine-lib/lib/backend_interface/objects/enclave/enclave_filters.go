@@ -8,4 +8,8 @@ type EnclaveFilters struct {
 	// Disjunctive set of enclave statuses to operate on
 	// If nil or empty, will match all UUIDs
 	Statuses map[EnclaveStatus]bool
+
+	// Conjunctive set of user-defined enclave labels to operate on; an enclave must have all of them to match
+	// If nil or empty, will match all enclaves
+	Labels map[string]string
 }
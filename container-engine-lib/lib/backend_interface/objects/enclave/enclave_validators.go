@@ -0,0 +1,32 @@
+package enclave
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_value"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// ValidateEnclaveLabels checks that the user-defined enclave labels are valid for both the Docker and the Kubernetes backends
+func ValidateEnclaveLabels(labels map[string]string) error {
+	for labelKey, labelValue := range labels {
+
+		// key validations
+		if err := docker_label_key.ValidateUserCustomLabelKey(labelKey); err != nil {
+			return stacktrace.Propagate(err, "Invalid enclave label key '%s'", labelKey)
+		}
+		if err := kubernetes_label_key.ValidateUserCustomLabelKey(labelKey); err != nil {
+			return stacktrace.Propagate(err, "Invalid enclave label key '%s'", labelKey)
+		}
+
+		// values validations
+		if err := docker_label_value.ValidateDockerLabelValue(labelValue); err != nil {
+			return stacktrace.Propagate(err, "Invalid enclave label value '%s'", labelValue)
+		}
+		if err := kubernetes_label_value.ValidateKubernetesLabelValue(labelValue); err != nil {
+			return stacktrace.Propagate(err, "Invalid enclave label value '%s'", labelValue)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package enclave
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var testValidEnclaveLabels = map[string]string{
+	"team":        "infra",
+	"branch":      "", // empty value is allowed
+	"ci.job-id":   "1234",
+	"environment": "RaNdoM", // allowed caps on values
+}
+
+var testInvalidEnclaveLabels = []map[string]string{
+	{
+		"": "random", // empty key not allowed
+	},
+	{
+		"Team": "random", // caps on key not allowed for both Docker and K8s
+	},
+	{
+		"team/name": "random", // slash on key not allowed for both Docker and K8s
+	},
+	{
+		"team": "random-", // not valid for K8s because it ends with a non-alphanumerical char
+	},
+}
+
+func TestValidateEnclaveLabels(t *testing.T) {
+	require.NoError(t, ValidateEnclaveLabels(testValidEnclaveLabels))
+	for _, notAllowedLabels := range testInvalidEnclaveLabels {
+		require.Error(t, ValidateEnclaveLabels(notAllowedLabels))
+	}
+}
+
+func TestEnclaveHasLabels(t *testing.T) {
+	creationTime := time.Now()
+	enclaveObj := NewEnclave("enclave-uuid", "enclave-name", EnclaveStatus_Running, &creationTime, false, map[string]string{
+		"team":   "infra",
+		"branch": "main",
+	})
+
+	require.True(t, enclaveObj.HasLabels(nil))
+	require.True(t, enclaveObj.HasLabels(map[string]string{"team": "infra"}))
+	require.True(t, enclaveObj.HasLabels(map[string]string{"team": "infra", "branch": "main"}))
+	require.False(t, enclaveObj.HasLabels(map[string]string{"team": "other"}))
+	require.False(t, enclaveObj.HasLabels(map[string]string{"team": "infra", "owner": "me"}))
+}
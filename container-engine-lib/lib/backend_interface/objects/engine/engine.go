@@ -6,6 +6,10 @@ import (
 	"net"
 )
 
+// Every backend mounts the persisted volume of the engine database in the engine container at this location, which
+// survives engine restarts
+const EngineDatabaseDirpath = "/var/lib/kurtosis/"
+
 type EngineGUID string
 
 // Object that represents POINT-IN-TIME information about an engine server
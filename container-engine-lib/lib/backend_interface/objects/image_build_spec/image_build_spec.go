@@ -0,0 +1,63 @@
+package image_build_spec
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// ImageBuildSpec describes how to build a container image from a Dockerfile, for services whose image isn't prebuilt
+type ImageBuildSpec struct {
+	// we do this way in order to have exported fields which can be marshalled
+	// and an unexported type for encapsulation
+	privateImageBuildSpec *privateImageBuildSpec
+}
+
+type privateImageBuildSpec struct {
+	// Absolute path of the directory sent to the builder as build context, on the machine running the backend
+	BuildContextDirpath string
+
+	// Path of the Dockerfile, relative to the build context directory
+	DockerfileRelativeFilepath string
+
+	// Stage of a multi-stage Dockerfile to build; empty to build the last one
+	TargetStage string
+}
+
+func NewImageBuildSpec(buildContextDirpath string, dockerfileRelativeFilepath string, targetStage string) *ImageBuildSpec {
+	internalImageBuildSpec := &privateImageBuildSpec{
+		BuildContextDirpath:        buildContextDirpath,
+		DockerfileRelativeFilepath: dockerfileRelativeFilepath,
+		TargetStage:                targetStage,
+	}
+	return &ImageBuildSpec{internalImageBuildSpec}
+}
+
+func (imageBuildSpec *ImageBuildSpec) GetBuildContextDirpath() string {
+	return imageBuildSpec.privateImageBuildSpec.BuildContextDirpath
+}
+
+func (imageBuildSpec *ImageBuildSpec) GetDockerfileRelativeFilepath() string {
+	return imageBuildSpec.privateImageBuildSpec.DockerfileRelativeFilepath
+}
+
+func (imageBuildSpec *ImageBuildSpec) GetTargetStage() string {
+	return imageBuildSpec.privateImageBuildSpec.TargetStage
+}
+
+func (imageBuildSpec *ImageBuildSpec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(imageBuildSpec.privateImageBuildSpec)
+}
+
+func (imageBuildSpec *ImageBuildSpec) UnmarshalJSON(data []byte) error {
+
+	// Suppressing exhaustruct requirement because we want an object with zero values
+	// nolint: exhaustruct
+	unmarshalledPrivateStructPtr := &privateImageBuildSpec{}
+
+	if err := json.Unmarshal(data, unmarshalledPrivateStructPtr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unmarshalling the private struct")
+	}
+
+	imageBuildSpec.privateImageBuildSpec = unmarshalledPrivateStructPtr
+	return nil
+}
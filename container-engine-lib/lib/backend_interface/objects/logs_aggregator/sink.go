@@ -0,0 +1,58 @@
+package logs_aggregator
+
+//go:generate go run github.com/dmarkham/enumer -trimprefix=SinkType_ -transform=kebab -type=SinkType
+type SinkType int
+
+const (
+	// SinkType_OtlpHttp sends each log line as an OTLP/HTTP JSON logs request to the endpoint
+	SinkType_OtlpHttp SinkType = iota
+	// SinkType_Loki pushes the log lines to the Loki push API at the endpoint, labelled with the enclave and the service
+	SinkType_Loki
+	// SinkType_Http posts batches of log lines, as a JSON array, to the endpoint
+	SinkType_Http
+	// SinkType_File writes the log lines to a JSON file per enclave and per service in a directory of the host
+	SinkType_File
+)
+
+// Sinks are the destinations, on top of the logs storage, that the logs aggregator forwards the service logs to,
+// identified by a name
+type Sinks map[string]*Sink
+
+// Sink is a destination outside of Kurtosis that the logs aggregator forwards the service logs to
+type Sink struct {
+	sinkType SinkType
+
+	// URL the logs are sent to; unused for file sinks
+	endpoint string
+
+	// HTTP headers sent with every request; unused for file sinks
+	headers map[string]string
+
+	// Absolute path of the directory of the host the logs are written to; only used by file sinks
+	directory string
+}
+
+func NewSink(sinkType SinkType, endpoint string, headers map[string]string, directory string) *Sink {
+	return &Sink{
+		sinkType:  sinkType,
+		endpoint:  endpoint,
+		headers:   headers,
+		directory: directory,
+	}
+}
+
+func (sink *Sink) GetType() SinkType {
+	return sink.sinkType
+}
+
+func (sink *Sink) GetEndpoint() string {
+	return sink.endpoint
+}
+
+func (sink *Sink) GetHeaders() map[string]string {
+	return sink.headers
+}
+
+func (sink *Sink) GetDirectory() string {
+	return sink.directory
+}
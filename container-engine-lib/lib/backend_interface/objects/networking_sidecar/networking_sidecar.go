@@ -0,0 +1,35 @@
+package networking_sidecar
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
+
+// NetworkingSidecar is a container attached to the network stack of a user service, used to shape the traffic going
+// out of that service (latency, packet loss, bandwidth limits, etc.)
+type NetworkingSidecar struct {
+	serviceUuid service.ServiceUUID
+	enclaveUuid enclave.EnclaveUUID
+	status      container.ContainerStatus
+}
+
+func NewNetworkingSidecar(serviceUuid service.ServiceUUID, enclaveUuid enclave.EnclaveUUID, status container.ContainerStatus) *NetworkingSidecar {
+	return &NetworkingSidecar{
+		serviceUuid: serviceUuid,
+		enclaveUuid: enclaveUuid,
+		status:      status,
+	}
+}
+
+func (sidecar *NetworkingSidecar) GetServiceUUID() service.ServiceUUID {
+	return sidecar.serviceUuid
+}
+
+func (sidecar *NetworkingSidecar) GetEnclaveUUID() enclave.EnclaveUUID {
+	return sidecar.enclaveUuid
+}
+
+func (sidecar *NetworkingSidecar) GetStatus() container.ContainerStatus {
+	return sidecar.status
+}
@@ -0,0 +1,17 @@
+package networking_sidecar
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
+
+// Selector for matching the networking sidecars of an enclave
+type NetworkingSidecarFilters struct {
+	// Disjunctive set of user service UUIDs to find networking sidecars for
+	// If nil or empty, will match all user services
+	UserServiceUUIDs map[service.ServiceUUID]bool
+
+	// Disjunctive set of statuses that returned networking sidecars must conform to
+	// If nil or empty, will match all statuses
+	Statuses map[container.ContainerStatus]bool
+}
@@ -0,0 +1,51 @@
+package persistent_directory
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+)
+
+// PersistentDirectoryVolume represents the backend object (a Docker volume or a Kubernetes persistent volume) storing
+// the data of a persistent directory inside an enclave
+type PersistentDirectoryVolume struct {
+	name          string
+	persistentKey service_directory.DirectoryPersistentKey
+	size          service_directory.DirectoryPersistentSize
+
+	// The service the volume was created for; empty when the volume is shared across the enclave
+	serviceUuid service.ServiceUUID
+}
+
+func NewPersistentDirectoryVolume(
+	name string,
+	persistentKey service_directory.DirectoryPersistentKey,
+	size service_directory.DirectoryPersistentSize,
+	serviceUuid service.ServiceUUID,
+) *PersistentDirectoryVolume {
+	return &PersistentDirectoryVolume{
+		name:          name,
+		persistentKey: persistentKey,
+		size:          size,
+		serviceUuid:   serviceUuid,
+	}
+}
+
+func (volume *PersistentDirectoryVolume) GetName() string {
+	return volume.name
+}
+
+func (volume *PersistentDirectoryVolume) GetPersistentKey() service_directory.DirectoryPersistentKey {
+	return volume.persistentKey
+}
+
+func (volume *PersistentDirectoryVolume) GetSize() service_directory.DirectoryPersistentSize {
+	return volume.size
+}
+
+func (volume *PersistentDirectoryVolume) GetServiceUUID() service.ServiceUUID {
+	return volume.serviceUuid
+}
+
+func (volume *PersistentDirectoryVolume) IsShared() bool {
+	return volume.serviceUuid == ""
+}
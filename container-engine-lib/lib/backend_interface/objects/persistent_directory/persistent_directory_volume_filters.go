@@ -0,0 +1,14 @@
+package persistent_directory
+
+import "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+
+// Selector for matching persistent directory volumes inside an enclave
+type PersistentDirectoryVolumeFilters struct {
+	// Disjunctive set of volume names to find volumes for
+	// If nil or empty, will match all names
+	Names map[string]bool
+
+	// Disjunctive set of persistent keys to find volumes for
+	// If nil or empty, will match all persistent keys
+	PersistentKeys map[service_directory.DirectoryPersistentKey]bool
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/stacktrace"
@@ -17,6 +18,9 @@ type ServiceConfig struct {
 type privateServiceConfig struct {
 	ContainerImageName string
 
+	// Leave as nil to fetch the container image instead of building it
+	ImageBuildSpec *image_build_spec.ImageBuildSpec
+
 	PrivatePorts map[string]*port_spec.PortSpec
 
 	PublicPorts map[string]*port_spec.PortSpec //TODO this is a huge hack to temporarily enable static ports for NEAR until we have a more productized solution
@@ -47,6 +51,7 @@ type privateServiceConfig struct {
 
 func CreateServiceConfig(
 	containerImageName string,
+	imageBuildSpec *image_build_spec.ImageBuildSpec,
 	privatePorts map[string]*port_spec.PortSpec,
 	publicPorts map[string]*port_spec.PortSpec,
 	entrypointArgs []string,
@@ -68,6 +73,7 @@ func CreateServiceConfig(
 
 	internalServiceConfig := &privateServiceConfig{
 		ContainerImageName:        containerImageName,
+		ImageBuildSpec:            imageBuildSpec,
 		PrivatePorts:              privatePorts,
 		PublicPorts:               publicPorts,
 		EntrypointArgs:            entrypointArgs,
@@ -90,6 +96,10 @@ func (serviceConfig *ServiceConfig) GetContainerImageName() string {
 	return serviceConfig.privateServiceConfig.ContainerImageName
 }
 
+func (serviceConfig *ServiceConfig) GetImageBuildSpec() *image_build_spec.ImageBuildSpec {
+	return serviceConfig.privateServiceConfig.ImageBuildSpec
+}
+
 func (serviceConfig *ServiceConfig) GetPrivatePorts() map[string]*port_spec.PortSpec {
 	return serviceConfig.privateServiceConfig.PrivatePorts
 }
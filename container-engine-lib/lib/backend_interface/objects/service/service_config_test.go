@@ -2,6 +2,7 @@ package service
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,7 @@ func TestServiceConfigMarshallers(t *testing.T) {
 		require.EqualValues(t, publicPortSpec, originalPublicPortSpec)
 	}
 
+	require.EqualValues(t, originalServiceConfig.GetImageBuildSpec(), newServiceConfig.GetImageBuildSpec())
 	require.Equal(t, originalServiceConfig.GetEnvVars(), newServiceConfig.GetEnvVars())
 	require.Equal(t, originalServiceConfig.GetCmdArgs(), newServiceConfig.GetCmdArgs())
 	require.Equal(t, originalServiceConfig.GetEnvVars(), newServiceConfig.GetEnvVars())
@@ -57,6 +59,7 @@ func TestServiceConfigMarshallers(t *testing.T) {
 func getServiceConfigForTest(t *testing.T, imageName string) *ServiceConfig {
 	serviceConfig, err := CreateServiceConfig(
 		imageName,
+		image_build_spec.NewImageBuildSpec("/kurtosis-data/repositories/package/image", "Dockerfile", "runtime"),
 		testPrivatePorts(t),
 		testPublicPorts(t),
 		[]string{"bin", "bash", "ls"},
@@ -79,9 +82,9 @@ func getServiceConfigForTest(t *testing.T, imageName string) *ServiceConfig {
 }
 
 func testPersistentDirectory() *service_directory.PersistentDirectories {
-	persistentDirectoriesMap := map[string]service_directory.DirectoryPersistentKey{
-		"dirpath1": service_directory.DirectoryPersistentKey("dirpath1_persistent_directory_key"),
-		"dirpath2": service_directory.DirectoryPersistentKey("dirpath2_persistent_directory_key"),
+	persistentDirectoriesMap := map[string]service_directory.PersistentDirectory{
+		"dirpath1": service_directory.NewPersistentDirectory(service_directory.DirectoryPersistentKey("dirpath1_persistent_directory_key"), 0, false, false),
+		"dirpath2": service_directory.NewPersistentDirectory(service_directory.DirectoryPersistentKey("dirpath2_persistent_directory_key"), 1024, true, true),
 	}
 
 	return service_directory.NewPersistentDirectories(persistentDirectoriesMap)
@@ -2,12 +2,43 @@ package service_directory
 
 type DirectoryPersistentKey string
 
+// DirectoryPersistentSize is the size of a persistent directory, in megabytes
+// 0 is the empty value, meaning the backend default size will be used
+type DirectoryPersistentSize uint64
+
+type PersistentDirectory struct {
+	PersistentKey DirectoryPersistentKey
+
+	Size DirectoryPersistentSize
+
+	// When true, the directory belongs to the enclave rather than to the service mounting it, so that every service
+	// using the same persistent key mounts the same data
+	IsShared bool
+
+	// When true, the directory is mounted read-only inside the service
+	IsReadOnly bool
+}
+
 type PersistentDirectories struct {
-	ServiceDirpathToDirectoryPersistentKey map[string]DirectoryPersistentKey
+	ServiceDirpathToPersistentDirectory map[string]PersistentDirectory
+}
+
+func NewPersistentDirectory(
+	persistentKey DirectoryPersistentKey,
+	size DirectoryPersistentSize,
+	isShared bool,
+	isReadOnly bool,
+) PersistentDirectory {
+	return PersistentDirectory{
+		PersistentKey: persistentKey,
+		Size:          size,
+		IsShared:      isShared,
+		IsReadOnly:    isReadOnly,
+	}
 }
 
-func NewPersistentDirectories(serviceDirpathToDirectoryPersistentKey map[string]DirectoryPersistentKey) *PersistentDirectories {
+func NewPersistentDirectories(serviceDirpathToPersistentDirectory map[string]PersistentDirectory) *PersistentDirectories {
 	return &PersistentDirectories{
-		ServiceDirpathToDirectoryPersistentKey: serviceDirpathToDirectoryPersistentKey,
+		ServiceDirpathToPersistentDirectory: serviceDirpathToPersistentDirectory,
 	}
 }
@@ -0,0 +1,92 @@
+package service_stats
+
+const (
+	// NoMemoryLimit is the memory limit of a service the backend knows no limit of
+	NoMemoryLimit uint64 = 0
+)
+
+// ServiceStats is a snapshot of the resources a user service actually consumes, as opposed to the max_cpu and
+// max_memory it gets allocated
+type ServiceStats struct {
+	// 1000 millicores is one full CPU, so a service using several CPUs goes over 1000
+	cpuMillicores uint64
+
+	memoryUsageBytes uint64
+	memoryLimitBytes uint64
+
+	// The Kubernetes metrics API only reports CPU and memory, so the network and block IO stats are only available on
+	// Docker. They are cumulative since the service container started
+	hasIOStats              bool
+	networkReceivedBytes    uint64
+	networkTransmittedBytes uint64
+	blockReadBytes          uint64
+	blockWrittenBytes       uint64
+}
+
+func NewServiceStats(
+	cpuMillicores uint64,
+	memoryUsageBytes uint64,
+	memoryLimitBytes uint64,
+	networkReceivedBytes uint64,
+	networkTransmittedBytes uint64,
+	blockReadBytes uint64,
+	blockWrittenBytes uint64,
+) *ServiceStats {
+	return &ServiceStats{
+		cpuMillicores:           cpuMillicores,
+		memoryUsageBytes:        memoryUsageBytes,
+		memoryLimitBytes:        memoryLimitBytes,
+		hasIOStats:              true,
+		networkReceivedBytes:    networkReceivedBytes,
+		networkTransmittedBytes: networkTransmittedBytes,
+		blockReadBytes:          blockReadBytes,
+		blockWrittenBytes:       blockWrittenBytes,
+	}
+}
+
+// NewServiceStatsWithoutIO is for backends that can't report the network and block IO of a service
+func NewServiceStatsWithoutIO(cpuMillicores uint64, memoryUsageBytes uint64, memoryLimitBytes uint64) *ServiceStats {
+	return &ServiceStats{
+		cpuMillicores:           cpuMillicores,
+		memoryUsageBytes:        memoryUsageBytes,
+		memoryLimitBytes:        memoryLimitBytes,
+		hasIOStats:              false,
+		networkReceivedBytes:    0,
+		networkTransmittedBytes: 0,
+		blockReadBytes:          0,
+		blockWrittenBytes:       0,
+	}
+}
+
+func (stats *ServiceStats) GetCpuMillicores() uint64 {
+	return stats.cpuMillicores
+}
+
+func (stats *ServiceStats) GetMemoryUsageBytes() uint64 {
+	return stats.memoryUsageBytes
+}
+
+// GetMemoryLimitBytes returns NoMemoryLimit if the backend knows no limit for the service
+func (stats *ServiceStats) GetMemoryLimitBytes() uint64 {
+	return stats.memoryLimitBytes
+}
+
+func (stats *ServiceStats) HasIOStats() bool {
+	return stats.hasIOStats
+}
+
+func (stats *ServiceStats) GetNetworkReceivedBytes() uint64 {
+	return stats.networkReceivedBytes
+}
+
+func (stats *ServiceStats) GetNetworkTransmittedBytes() uint64 {
+	return stats.networkTransmittedBytes
+}
+
+func (stats *ServiceStats) GetBlockReadBytes() uint64 {
+	return stats.blockReadBytes
+}
+
+func (stats *ServiceStats) GetBlockWrittenBytes() uint64 {
+	return stats.blockWrittenBytes
+}
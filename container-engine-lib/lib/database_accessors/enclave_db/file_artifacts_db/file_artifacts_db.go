@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/stacktrace"
 	bolt "go.etcd.io/bbolt"
+	"time"
 )
 
 type FileArtifactPersisted struct {
@@ -18,6 +19,15 @@ type fileArtifactData struct {
 	ArtifactNameToArtifactUuid map[string]string
 	ShortenedUuidToFullUuid    map[string][]string
 	ArtifactContentMd5         map[string][]byte
+	ArtifactVersions           map[string][]*FileArtifactVersion
+}
+
+// FileArtifactVersion is a version of the content of a files artifact, the last version of a files artifact being its
+// current content
+type FileArtifactVersion struct {
+	Number       uint32
+	ContentMd5   []byte
+	CreationTime time.Time
 }
 
 var (
@@ -103,11 +113,25 @@ func (fileArtifactDb *FileArtifactPersisted) DeleteContentMd5(artifactName strin
 	delete(fileArtifactDb.data.ArtifactContentMd5, artifactName)
 }
 
+func (fileArtifactDb *FileArtifactPersisted) SetVersions(artifactUuid string, versions []*FileArtifactVersion) {
+	fileArtifactDb.data.ArtifactVersions[artifactUuid] = versions
+}
+
+func (fileArtifactDb *FileArtifactPersisted) GetVersions(artifactUuid string) ([]*FileArtifactVersion, bool) {
+	value, found := fileArtifactDb.data.ArtifactVersions[artifactUuid]
+	return value, found
+}
+
+func (fileArtifactDb *FileArtifactPersisted) DeleteVersions(artifactUuid string) {
+	delete(fileArtifactDb.data.ArtifactVersions, artifactUuid)
+}
+
 func GetOrCreateNewFileArtifactsDb() (*FileArtifactPersisted, error) {
 	data := fileArtifactData{
 		map[string]string{},
 		map[string][]string{},
 		map[string][]byte{},
+		map[string][]*FileArtifactVersion{},
 	}
 	db, err := enclave_db.GetOrCreateEnclaveDatabase()
 	if err != nil {
@@ -155,6 +179,7 @@ func GetFileArtifactsDbForTesting(db *enclave_db.EnclaveDB, nameToUuid map[strin
 		nameToUuid,
 		map[string][]string{},
 		map[string][]byte{},
+		map[string][]*FileArtifactVersion{},
 	})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to hydrate pre-existing file artifacts")
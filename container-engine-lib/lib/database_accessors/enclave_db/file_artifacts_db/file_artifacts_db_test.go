@@ -4,6 +4,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestFileArtifactPersistance(t *testing.T) {
@@ -15,17 +16,23 @@ func TestFileArtifactPersistance(t *testing.T) {
 	require.Empty(t, fileArtifactDb.data.ArtifactNameToArtifactUuid)
 	require.Empty(t, fileArtifactDb.data.ArtifactContentMd5)
 	require.Empty(t, fileArtifactDb.data.ShortenedUuidToFullUuid)
+	require.Empty(t, fileArtifactDb.data.ArtifactVersions)
 	fileArtifactDb.SetArtifactUuid("1", "1")
 	fileArtifactDb.SetContentMd5("1", []byte("1"))
 	fileArtifactDb.SetFullUuid("1", []string{"1"})
+	fileArtifactDb.SetVersions("1", []*FileArtifactVersion{{Number: 1, ContentMd5: []byte("1"), CreationTime: time.Now()}})
 	require.Nil(t, fileArtifactDb.Persist())
 	fileArtifactDb, err = getFileArtifactsDbFromEnclaveDb(enclaveDb, &fileArtifactData{
 		map[string]string{},
 		map[string][]string{},
 		map[string][]byte{},
+		map[string][]*FileArtifactVersion{},
 	})
 	require.Nil(t, err)
 	require.Len(t, fileArtifactDb.GetArtifactUuidMap(), 1)
 	require.Len(t, fileArtifactDb.GetFullUuidMap(), 1)
 	require.Len(t, fileArtifactDb.GetContentMd5Map(), 1)
+	versions, found := fileArtifactDb.GetVersions("1")
+	require.True(t, found)
+	require.Len(t, versions, 1)
 }
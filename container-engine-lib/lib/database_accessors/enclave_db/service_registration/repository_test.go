@@ -304,6 +304,7 @@ func getServiceRegistrationWithDataForTest(
 func getServiceConfigForTest(t *testing.T, imageName string) *service.ServiceConfig {
 	serviceConfig, err := service.CreateServiceConfig(
 		imageName,
+		nil,
 		testPrivatePorts(t),
 		testPublicPorts(t),
 		[]string{"bin", "bash", "ls"},
@@ -326,9 +327,9 @@ func getServiceConfigForTest(t *testing.T, imageName string) *service.ServiceCon
 }
 
 func testPersistentDirectory() *service_directory.PersistentDirectories {
-	persistentDirectoriesMap := map[string]service_directory.DirectoryPersistentKey{
-		"dirpath1": service_directory.DirectoryPersistentKey("dirpath1_persistent_directory_key"),
-		"dirpath2": service_directory.DirectoryPersistentKey("dirpath2_persistent_directory_key"),
+	persistentDirectoriesMap := map[string]service_directory.PersistentDirectory{
+		"dirpath1": service_directory.NewPersistentDirectory(service_directory.DirectoryPersistentKey("dirpath1_persistent_directory_key"), 0, false, false),
+		"dirpath2": service_directory.NewPersistentDirectory(service_directory.DirectoryPersistentKey("dirpath2_persistent_directory_key"), 1024, true, true),
 	}
 
 	return service_directory.NewPersistentDirectories(persistentDirectoriesMap)
@@ -41,6 +41,10 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 	isProductionEnclave bool,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	filesArtifactsStorageQuota uint64,
+	cpuQuotaMillicpus uint64,
+	memoryQuotaMegabytes uint64,
+	serviceCountQuota uint32,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -56,6 +60,10 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 		isProductionEnclave,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
+		filesArtifactsStorageQuota,
+		cpuQuotaMillicpus,
+		memoryQuotaMegabytes,
+		serviceCountQuota,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred launching the API container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -74,6 +82,10 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 	isProductionEnclave bool,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	filesArtifactsStorageQuota uint64,
+	cpuQuotaMillicpus uint64,
+	memoryQuotaMegabytes uint64,
+	serviceCountQuota uint32,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -91,6 +103,10 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		isProductionEnclave,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
+		filesArtifactsStorageQuota,
+		cpuQuotaMillicpus,
+		memoryQuotaMegabytes,
+		serviceCountQuota,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the API container args")
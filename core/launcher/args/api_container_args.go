@@ -44,6 +44,17 @@ type APIContainerArgs struct {
 
 	//User consent to send metrics
 	DidUserAcceptSendingMetrics bool `json:"didUserAcceptSendingMetrics"`
+
+	// The maximum total size, in bytes, of the files artifacts stored in the enclave; 0 means there's no quota
+	FilesArtifactsStorageQuota uint64 `json:"filesArtifactsStorageQuota"`
+
+	// The maximum total CPU, in millicpus, and memory, in megabytes, the services of the enclave can be allocated
+	// through their max_cpu and max_memory; 0 means there's no quota
+	CpuQuotaMillicpus    uint64 `json:"cpuQuotaMillicpus"`
+	MemoryQuotaMegabytes uint64 `json:"memoryQuotaMegabytes"`
+
+	// The maximum number of services in the enclave; 0 means there's no quota
+	ServiceCountQuota uint32 `json:"serviceCountQuota"`
 }
 
 func (args *APIContainerArgs) UnmarshalJSON(data []byte) error {
@@ -92,6 +103,10 @@ func NewAPIContainerArgs(
 	isProductionEnclave bool,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	filesArtifactsStorageQuota uint64,
+	cpuQuotaMillicpus uint64,
+	memoryQuotaMegabytes uint64,
+	serviceCountQuota uint32,
 ) (*APIContainerArgs, error) {
 	result := &APIContainerArgs{
 		Version:                     version,
@@ -105,6 +120,10 @@ func NewAPIContainerArgs(
 		IsProductionEnclave:         isProductionEnclave,
 		MetricsUserID:               metricsUserID,
 		DidUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
+		FilesArtifactsStorageQuota:  filesArtifactsStorageQuota,
+		CpuQuotaMillicpus:           cpuQuotaMillicpus,
+		MemoryQuotaMegabytes:        memoryQuotaMegabytes,
+		ServiceCountQuota:           serviceCountQuota,
 	}
 
 	if err := result.validate(); err != nil {
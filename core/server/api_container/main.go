@@ -25,6 +25,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_expiry"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_quotas"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_resume"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_snapshot"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
@@ -119,6 +124,13 @@ func runMain() error {
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the files artifact store")
 	}
+	filesArtifactStore.SetStorageQuota(serverArgs.FilesArtifactsStorageQuota)
+	enclaveQuotas := enclave_quotas.NewEnclaveQuotas(
+		serverArgs.CpuQuotaMillicpus,
+		serverArgs.MemoryQuotaMegabytes,
+		uint64(serverArgs.ServiceCountQuota),
+		serverArgs.FilesArtifactsStorageQuota,
+	)
 
 	clusterConfig := serverArgs.KurtosisBackendConfig
 	if clusterConfig == nil {
@@ -196,6 +208,12 @@ func runMain() error {
 		}
 	}()
 
+	serviceHealthMonitor, err := service_health.NewServiceHealthMonitor(serviceNetwork, enclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the service health monitor")
+	}
+	defer serviceHealthMonitor.Close()
+
 	starlarkValueSerde := createStarlarkValueSerde()
 	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(starlarkValueSerde, enclaveDb)
 	if err != nil {
@@ -210,10 +228,21 @@ func runMain() error {
 
 	// TODO: Consolidate Interpreter, Validator and Executor into a single interface
 	startosisRunner := startosis_engine.NewStartosisRunner(
-		startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtimeValueStore, starlarkValueSerde, serverArgs.EnclaveEnvVars),
-		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore),
+		startosis_engine.NewStartosisInterpreter(serviceNetwork, serviceHealthMonitor, gitPackageContentProvider, runtimeValueStore, starlarkValueSerde, serverArgs.EnclaveEnvVars),
+		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore, enclaveQuotas),
 		startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclavePlan, enclaveDb))
 
+	// the health monitoring of the services is restored in case the API container is being restarted
+	enclaveResumer := enclave_resume.NewEnclaveResumer(serviceNetwork, serviceHealthMonitor, runtimeValueStore, starlarkValueSerde, enclaveDb)
+	if err := enclaveResumer.RestoreServiceHealthMonitoring(ctx); err != nil {
+		logrus.Warnf("An error occurred restoring the health monitoring of the services, their liveness checks and restart policies won't be enforced until they're added again:\n%v", err)
+	}
+
+	enclaveExpiryTracker, err := enclave_expiry.NewEnclaveExpiryTracker(enclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the enclave expiry tracker")
+	}
+
 	//Creation of ApiContainerService
 	restartPolicy := kurtosis_core_rpc_api_bindings.RestartPolicy_NEVER
 	if serverArgs.IsProductionEnclave {
@@ -222,17 +251,23 @@ func runMain() error {
 	apiContainerService, err := server.NewApiContainerService(
 		filesArtifactStore,
 		serviceNetwork,
+		serviceHealthMonitor,
 		startosisRunner,
 		gitPackageContentProvider,
 		restartPolicy,
 		metricsClient,
+		enclave_snapshot.NewEnclaveSnapshotter(serviceNetwork, filesArtifactStore, kurtosisBackend, enclaveDb),
+		enclaveResumer,
+		enclaveExpiryTracker,
+		enclaveQuotas,
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the API container service")
 	}
 
 	apiContainerServiceRegistrationFunc := func(grpcServer *grpc.Server) {
-		kurtosis_core_rpc_api_bindings.RegisterApiContainerServiceServer(grpcServer, apiContainerService)
+		// every API call counts as activity for the enclave idle timeout
+		enclave_expiry.RegisterActivityTrackingApiContainerServiceServer(grpcServer, apiContainerService, enclaveExpiryTracker)
 	}
 	apiContainerServer := minimal_grpc_server.NewMinimalGRPCServer(
 		serverArgs.GrpcListenPortNum,
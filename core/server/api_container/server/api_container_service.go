@@ -11,12 +11,14 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"math"
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -33,10 +35,17 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_expiry"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_quotas"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_resume"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_snapshot"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_linter"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
@@ -44,6 +53,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -57,6 +67,7 @@ const (
 	missingPublicIpAddrStr = ""
 
 	defaultStartosisDryRun = false
+	defaultStartosisDiff   = false
 
 	// Overwrite existing module with new module, this allows user to iterate on an enclave with a
 	// given module
@@ -73,8 +84,25 @@ const (
 	isNotScript              = false
 	isNotRemote              = false
 	defaultParallelism       = 4
+
+	defaultPackageLockMode           = kurtosis_core_rpc_api_bindings.PackageLockMode_ENFORCE_LOCK
+	allowUnlockedPackageDependencies = true
+
+	enclaveSnapshotContentName     = "enclave-snapshot"
+	enclaveSnapshotTempFilePattern = "enclave-snapshot-*.tgz"
+
+	serviceFilesContentName     = "service-files"
+	serviceFilesTempFilePattern = "service-files-*.tgz"
+
+	// Sampling the stats already takes Docker a moment, so the stats get refreshed a bit less often than that
+	followedServicesStatsInterval = 2 * time.Second
 )
 
+// A nil lock disables the package lock
+var noLockedPackageDependencies map[string]string
+
+var noGitCredentials []*startosis_packages.GitCredential
+
 // Guaranteed (by a unit test) to be a 1:1 mapping between API port protos and port spec protos
 var apiContainerPortProtoToPortSpecPortProto = map[kurtosis_core_rpc_api_bindings.Port_TransportProtocol]port_spec.TransportProtocol{
 	kurtosis_core_rpc_api_bindings.Port_TCP:  port_spec.TransportProtocol_TCP,
@@ -87,6 +115,8 @@ type ApiContainerService struct {
 
 	serviceNetwork service_network.ServiceNetwork
 
+	serviceHealthMonitor *service_health.ServiceHealthMonitor
+
 	startosisRunner *startosis_engine.StartosisRunner
 
 	startosisModuleContentProvider startosis_packages.PackageContentProvider
@@ -96,19 +126,33 @@ type ApiContainerService struct {
 	starlarkRun *kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse
 
 	metricsClient metrics_client.MetricsClient
+
+	enclaveSnapshotter *enclave_snapshot.EnclaveSnapshotter
+
+	enclaveResumer *enclave_resume.EnclaveResumer
+
+	enclaveExpiryTracker *enclave_expiry.EnclaveExpiryTracker
+
+	enclaveQuotas *enclave_quotas.EnclaveQuotas
 }
 
 func NewApiContainerService(
 	filesArtifactStore *enclave_data_directory.FilesArtifactStore,
 	serviceNetwork service_network.ServiceNetwork,
+	serviceHealthMonitor *service_health.ServiceHealthMonitor,
 	startosisRunner *startosis_engine.StartosisRunner,
 	startosisModuleContentProvider startosis_packages.PackageContentProvider,
 	restartPolicy kurtosis_core_rpc_api_bindings.RestartPolicy,
 	metricsClient metrics_client.MetricsClient,
+	enclaveSnapshotter *enclave_snapshot.EnclaveSnapshotter,
+	enclaveResumer *enclave_resume.EnclaveResumer,
+	enclaveExpiryTracker *enclave_expiry.EnclaveExpiryTracker,
+	enclaveQuotas *enclave_quotas.EnclaveQuotas,
 ) (*ApiContainerService, error) {
 	service := &ApiContainerService{
 		filesArtifactStore:             filesArtifactStore,
 		serviceNetwork:                 serviceNetwork,
+		serviceHealthMonitor:           serviceHealthMonitor,
 		startosisRunner:                startosisRunner,
 		startosisModuleContentProvider: startosisModuleContentProvider,
 		restartPolicy:                  restartPolicy,
@@ -122,7 +166,11 @@ func NewApiContainerService(
 			ExperimentalFeatures:   []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{},
 			RestartPolicy:          kurtosis_core_rpc_api_bindings.RestartPolicy_NEVER,
 		},
-		metricsClient: metricsClient,
+		metricsClient:        metricsClient,
+		enclaveSnapshotter:   enclaveSnapshotter,
+		enclaveResumer:       enclaveResumer,
+		enclaveExpiryTracker: enclaveExpiryTracker,
+		enclaveQuotas:        enclaveQuotas,
 	}
 
 	return service, nil
@@ -136,6 +184,7 @@ func (apicService *ApiContainerService) RunStarlarkScript(args *kurtosis_core_rp
 		parallelism = defaultParallelism
 	}
 	dryRun := shared_utils.GetOrDefaultBool(args.DryRun, defaultStartosisDryRun)
+	diff := shared_utils.GetOrDefaultBool(args.Diff, defaultStartosisDiff)
 	mainFuncName := args.GetMainFunctionName()
 	experimentalFeatures := args.GetExperimentalFeatures()
 	cloudUserId := shared_utils.GetOrDefaultString(args.CloudUserId, defaultCloudUserId)
@@ -143,14 +192,23 @@ func (apicService *ApiContainerService) RunStarlarkScript(args *kurtosis_core_rp
 	ApiDownloadMode := shared_utils.GetOrDefault(args.ImageDownloadMode, defaultImageDownloadMode)
 
 	downloadMode := convertFromImageDownloadModeAPI(ApiDownloadMode)
+	apicService.startosisModuleContentProvider.SetGitCredentials(convertFromGitCredentialsAPI(args.GetGitCredentials()))
+	// the credentials are scoped to this run, they must not be used to clone repositories on later runs
+	defer apicService.startosisModuleContentProvider.SetGitCredentials(noGitCredentials)
+	// scripts have no kurtosis.lock
+	apicService.startosisModuleContentProvider.SetPackageLock(startosis_constants.PackageIdPlaceholderForStandaloneScript, noLockedPackageDependencies, allowUnlockedPackageDependencies)
 
-	metricsErr := apicService.metricsClient.TrackKurtosisRun(startosis_constants.PackageIdPlaceholderForStandaloneScript, isNotRemote, dryRun, isScript, cloudInstanceID, cloudUserId)
+	metricsErr := apicService.metricsClient.TrackKurtosisRun(startosis_constants.PackageIdPlaceholderForStandaloneScript, isNotRemote, dryRun || diff, isScript, cloudInstanceID, cloudUserId)
 	if metricsErr != nil {
 		logrus.Warn("An error occurred tracking kurtosis run event")
 	}
 	noPackageReplaceOptions := map[string]string{}
 
-	apicService.runStarlark(parallelism, dryRun, startosis_constants.PackageIdPlaceholderForStandaloneScript, noPackageReplaceOptions, mainFuncName, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, serializedStarlarkScript, serializedParams, downloadMode, args.GetExperimentalFeatures(), stream)
+	apicService.runStarlark(parallelism, dryRun, diff, startosis_constants.PackageIdPlaceholderForStandaloneScript, noPackageReplaceOptions, mainFuncName, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, serializedStarlarkScript, serializedParams, downloadMode, args.GetExperimentalFeatures(), stream)
+	if diff {
+		// nothing ran, the last run of the enclave is still the one to report
+		return nil
+	}
 
 	apicService.starlarkRun = &kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse{
 		PackageId:              startosis_constants.PackageIdPlaceholderForStandaloneScript,
@@ -212,13 +270,10 @@ func (apicService *ApiContainerService) InspectFilesArtifactContents(_ context.C
 		return nil, stacktrace.NewError("An error occurred because files artifact identifier is empty '%v'", artifactIdentifier)
 	}
 
-	_, filesArtifact, _, found, err := apicService.filesArtifactStore.GetFile(artifactIdentifier)
+	filesArtifact, err := apicService.getFilesArtifactVersionFile(artifactIdentifier, args.Version)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
 	}
-	if !found {
-		return nil, stacktrace.NewError("An error occurred getting files artifact '%v', it doesn't exist in this enclave", artifactIdentifier)
-	}
 
 	fileDescriptions, err := getFileDescriptionsFromArtifact(filesArtifact.GetAbsoluteFilepath())
 	if err != nil {
@@ -237,14 +292,19 @@ func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_r
 		parallelism = defaultParallelism
 	}
 	dryRun := shared_utils.GetOrDefaultBool(args.DryRun, defaultStartosisDryRun)
+	diff := shared_utils.GetOrDefaultBool(args.Diff, defaultStartosisDiff)
 	serializedParams := args.GetSerializedParams()
 	relativePathToMainFile := args.GetRelativePathToMainFile()
 	mainFuncName := args.GetMainFunctionName()
 	cloudUserId := shared_utils.GetOrDefaultString(args.CloudUserId, defaultCloudUserId)
 	cloudInstanceID := shared_utils.GetOrDefaultString(args.CloudInstanceId, defaultCloudInstanceId)
 	ApiDownloadMode := shared_utils.GetOrDefault(args.ImageDownloadMode, defaultImageDownloadMode)
+	packageLockMode := shared_utils.GetOrDefault(args.PackageLockMode, defaultPackageLockMode)
 
 	downloadMode := convertFromImageDownloadModeAPI(ApiDownloadMode)
+	apicService.startosisModuleContentProvider.SetGitCredentials(convertFromGitCredentialsAPI(args.GetGitCredentials()))
+	// the credentials are scoped to this run, they must not be used to clone repositories on later runs
+	defer apicService.startosisModuleContentProvider.SetGitCredentials(noGitCredentials)
 
 	if relativePathToMainFile == "" {
 		relativePathToMainFile = startosis_constants.MainFileName
@@ -265,6 +325,9 @@ func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_r
 		isRemote = args.GetRemote()
 		scriptWithRunFunction, kurtosisYml, interpretationError = apicService.runStarlarkPackageSetup(packageId, args.GetRemote(), moduleContentIfLocal, relativePathToMainFile)
 	}
+	if interpretationError == nil {
+		interpretationError = apicService.setPackageLock(packageId, packageLockMode)
+	}
 	if interpretationError != nil {
 		if err := stream.SendMsg(binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationError.ToAPIType())); err != nil {
 			return stacktrace.Propagate(err, "Error preparing for package execution and this error could not be sent through the output stream: '%s'", packageId)
@@ -275,11 +338,15 @@ func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_r
 	packageReplaceOptions := kurtosisYml.GetPackageReplaceOptions()
 	logrus.Debugf("package replace options received '%+v'", packageReplaceOptions)
 
-	metricsErr := apicService.metricsClient.TrackKurtosisRun(packageName, isRemote, dryRun, isNotScript, cloudInstanceID, cloudUserId)
+	metricsErr := apicService.metricsClient.TrackKurtosisRun(packageName, isRemote, dryRun || diff, isNotScript, cloudInstanceID, cloudUserId)
 	if metricsErr != nil {
 		logrus.Warn("An error occurred tracking kurtosis run event")
 	}
-	apicService.runStarlark(parallelism, dryRun, packageName, packageReplaceOptions, mainFuncName, relativePathToMainFile, scriptWithRunFunction, serializedParams, downloadMode, args.ExperimentalFeatures, stream)
+	apicService.runStarlark(parallelism, dryRun, diff, packageName, packageReplaceOptions, mainFuncName, relativePathToMainFile, scriptWithRunFunction, serializedParams, downloadMode, args.ExperimentalFeatures, stream)
+	if diff {
+		// nothing ran, the last run of the enclave is still the one to report
+		return nil
+	}
 
 	apicService.starlarkRun = &kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse{
 		PackageId:              packageId,
@@ -393,7 +460,7 @@ func (apicService *ApiContainerService) GetServices(ctx context.Context, args *k
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while fetching all services from the backend")
 	}
-	serviceInfos, err = getServiceInfosFromServiceObjs(allServices)
+	serviceInfos, err = getServiceInfosFromServiceObjs(allServices, apicService.serviceHealthMonitor)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while converting the service obj into service info")
 	}
@@ -471,13 +538,10 @@ func (apicService *ApiContainerService) DownloadFilesArtifact(args *kurtosis_cor
 		return stacktrace.NewError("Cannot download file with empty files artifact identifier")
 	}
 
-	_, filesArtifact, _, found, err := apicService.filesArtifactStore.GetFile(artifactIdentifier)
+	filesArtifact, err := apicService.getFilesArtifactVersionFile(artifactIdentifier, args.Version)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
 	}
-	if !found {
-		return stacktrace.NewError("An error occurred getting files artifact '%v', it doesn't exist in this enclave", artifactIdentifier)
-	}
 
 	file, err := os.OpenFile(filesArtifact.GetAbsoluteFilepath(), os.O_RDONLY, allFilePermissionsForOwner)
 	if err != nil {
@@ -556,23 +620,371 @@ func (apicService *ApiContainerService) StoreFilesArtifactFromService(ctx contex
 	return response, nil
 }
 
+func (apicService *ApiContainerService) CopyFilesToService(server kurtosis_core_rpc_api_bindings.ApiContainerService_CopyFilesToServiceServer) error {
+	var serviceIdentifier string
+	var dstPath string
+	serverStream := grpc_file_streaming.NewServerStream[kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk, emptypb.Empty](server)
+	err := serverStream.ReceiveData(
+		serviceFilesContentName,
+		func(dataChunk *kurtosis_core_rpc_api_bindings.CopyFilesToServiceChunk) ([]byte, string, error) {
+			if serviceIdentifier == "" && dstPath == "" {
+				serviceIdentifier = dataChunk.GetServiceIdentifier()
+				dstPath = dataChunk.GetDestinationPath()
+			} else if serviceIdentifier != dataChunk.GetServiceIdentifier() || dstPath != dataChunk.GetDestinationPath() {
+				return nil, "", stacktrace.NewError("An unexpected error occurred receiving the files to copy. The service or destination path was changed during the copy")
+			}
+			return dataChunk.GetChunk().GetData(), dataChunk.GetChunk().GetPreviousChunkHash(), nil
+		},
+		func(assembledContent io.Reader) (*emptypb.Empty, error) {
+			if err := apicService.serviceNetwork.CopyFilesToService(server.Context(), serviceIdentifier, dstPath, assembledContent); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred copying the files to destination '%v' of service '%v'", dstPath, serviceIdentifier)
+			}
+			return &emptypb.Empty{}, nil
+		},
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the files to copy")
+	}
+	return nil
+}
+
+func (apicService *ApiContainerService) CopyFilesArtifactToService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs) (*emptypb.Empty, error) {
+	artifactIdentifier := args.GetFilesArtifactIdentifier()
+	serviceIdentifier := args.GetServiceIdentifier()
+	dstPath := args.GetDestinationPath()
+
+	_, filesArtifact, _, found, err := apicService.filesArtifactStore.GetFile(artifactIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
+	}
+	if !found {
+		return nil, stacktrace.NewError("No files artifact with identifier '%v' exists in the enclave", artifactIdentifier)
+	}
+	file, err := os.Open(filesArtifact.GetAbsoluteFilepath())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading files artifact file at '%s'", filesArtifact.GetAbsoluteFilepath())
+	}
+	defer file.Close()
+
+	if err := apicService.serviceNetwork.CopyFilesToService(ctx, serviceIdentifier, dstPath, file); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying files artifact '%v' to destination '%v' of service '%v'", artifactIdentifier, dstPath, serviceIdentifier)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService *ApiContainerService) CopyFilesFromService(args *kurtosis_core_rpc_api_bindings.CopyFilesFromServiceArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_CopyFilesFromServiceServer) error {
+	serviceIdentifier := args.GetServiceIdentifier()
+	srcPath := args.GetSourcePath()
+
+	// The content size needs to be known before streaming it, so the files get written to a temporary file first
+	serviceFilesFile, err := os.CreateTemp("", serviceFilesTempFilePattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary file to write the files copied from service '%v' to", serviceIdentifier)
+	}
+	defer func() {
+		serviceFilesFile.Close()
+		if err := os.Remove(serviceFilesFile.Name()); err != nil {
+			logrus.Warnf("An error occurred removing the temporary file '%s' holding the files copied from a service:\n%v", serviceFilesFile.Name(), err)
+		}
+	}()
+
+	if err := apicService.serviceNetwork.WriteFilesFromService(server.Context(), serviceIdentifier, srcPath, serviceFilesFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying source '%v' from service '%v'", srcPath, serviceIdentifier)
+	}
+	fileInfo, err := serviceFilesFile.Stat()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting the file holding the files copied from service '%v' at '%s'", serviceIdentifier, serviceFilesFile.Name())
+	}
+	if _, err := serviceFilesFile.Seek(0, io.SeekStart); err != nil {
+		return stacktrace.Propagate(err, "An error occurred rewinding the file holding the files copied from service '%v' at '%s'", serviceIdentifier, serviceFilesFile.Name())
+	}
+
+	serverStream := grpc_file_streaming.NewServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](server)
+	err = serverStream.SendData(
+		serviceFilesContentName,
+		serviceFilesFile,
+		uint64(fileInfo.Size()),
+		func(previousChunkHash string, contentChunk []byte) (*kurtosis_core_rpc_api_bindings.StreamedDataChunk, error) {
+			return &kurtosis_core_rpc_api_bindings.StreamedDataChunk{
+				Data:              contentChunk,
+				PreviousChunkHash: previousChunkHash,
+				Metadata: &kurtosis_core_rpc_api_bindings.DataChunkMetadata{
+					Name: serviceFilesContentName,
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the files copied from source '%v' of service '%v'", srcPath, serviceIdentifier)
+	}
+	return nil
+}
+
 func (apicService *ApiContainerService) ListFilesArtifactNamesAndUuids(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse, error) {
 	filesArtifactsNamesAndUuids := apicService.filesArtifactStore.GetFileNamesAndUuids()
 	var filesArtifactNamesAndUuids []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid
+	totalSize := uint64(0)
 	for _, nameAndUuid := range filesArtifactsNamesAndUuids {
 		fileNameAndUuidGrpcType := &kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{
 			FileName: nameAndUuid.GetName(),
 			FileUuid: string(nameAndUuid.GetUuid()),
+			Size:     nameAndUuid.GetSize(),
 		}
 		filesArtifactNamesAndUuids = append(filesArtifactNamesAndUuids, fileNameAndUuidGrpcType)
+		totalSize += nameAndUuid.GetSize()
+	}
+	var storageQuota *uint64
+	if quota := apicService.filesArtifactStore.GetStorageQuota(); quota != enclave_data_directory.NoStorageQuota {
+		storageQuota = &quota
+	}
+	return &kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse{
+		FileNamesAndUuids: filesArtifactNamesAndUuids,
+		TotalSize:         totalSize,
+		StorageQuota:      storageQuota,
+	}, nil
+}
+
+func (apicService *ApiContainerService) RemoveFilesArtifact(_ context.Context, args *kurtosis_core_rpc_api_bindings.RemoveFilesArtifactArgs) (*emptypb.Empty, error) {
+	artifactIdentifier := args.GetIdentifier()
+	filesArtifactUuid, err := apicService.serviceNetwork.RemoveFilesArtifact(artifactIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing files artifact '%v'", artifactIdentifier)
+	}
+	logrus.Infof("Removed files artifact '%v' with UUID '%v'", artifactIdentifier, filesArtifactUuid)
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService *ApiContainerService) ListFilesArtifactVersions(_ context.Context, args *kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsArgs) (*kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsResponse, error) {
+	artifactIdentifier := args.GetIdentifier()
+	_, versions, found, err := apicService.filesArtifactStore.GetFileVersions(artifactIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the versions of files artifact '%v'", artifactIdentifier)
+	}
+	if !found {
+		return nil, stacktrace.NewError("An error occurred getting the versions of files artifact '%v', it doesn't exist in this enclave", artifactIdentifier)
 	}
-	return &kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse{FileNamesAndUuids: filesArtifactNamesAndUuids}, nil
+
+	var filesArtifactVersions []*kurtosis_core_rpc_api_bindings.FilesArtifactVersion
+	for _, version := range versions {
+		var creationTime *timestamppb.Timestamp
+		if !version.GetCreationTime().IsZero() {
+			creationTime = timestamppb.New(version.GetCreationTime())
+		}
+		filesArtifactVersions = append(filesArtifactVersions, &kurtosis_core_rpc_api_bindings.FilesArtifactVersion{
+			Number:       version.GetNumber(),
+			ContentMd5:   hex.EncodeToString(version.GetContentMd5()),
+			CreationTime: creationTime,
+			IsCurrent:    version.IsCurrent(),
+		})
+	}
+	return &kurtosis_core_rpc_api_bindings.ListFilesArtifactVersionsResponse{Versions: filesArtifactVersions}, nil
 }
 
 func (apicService *ApiContainerService) GetStarlarkRun(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse, error) {
 	return apicService.starlarkRun, nil
 }
 
+func (apicService *ApiContainerService) ExportEnclaveSnapshot(args *kurtosis_core_rpc_api_bindings.ExportEnclaveSnapshotArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_ExportEnclaveSnapshotServer) error {
+	// the snapshot is written to a file first as its size needs to be known before streaming it
+	snapshotFile, err := os.CreateTemp("", enclaveSnapshotTempFilePattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary file to write the enclave snapshot to")
+	}
+	defer func() {
+		snapshotFile.Close()
+		if err := os.Remove(snapshotFile.Name()); err != nil {
+			logrus.Warnf("An error occurred removing the temporary enclave snapshot file '%s':\n%v", snapshotFile.Name(), err)
+		}
+	}()
+
+	if err := apicService.enclaveSnapshotter.Export(server.Context(), args.GetIncludePersistentDirectories(), args.GetIncludeContainerFilesystems(), snapshotFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred snapshotting the enclave")
+	}
+	fileInfo, err := snapshotFile.Stat()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting the enclave snapshot file at '%s'", snapshotFile.Name())
+	}
+	if _, err := snapshotFile.Seek(0, io.SeekStart); err != nil {
+		return stacktrace.Propagate(err, "An error occurred rewinding the enclave snapshot file at '%s'", snapshotFile.Name())
+	}
+
+	serverStream := grpc_file_streaming.NewServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](server)
+	err = serverStream.SendData(
+		enclaveSnapshotContentName,
+		snapshotFile,
+		uint64(fileInfo.Size()),
+		func(previousChunkHash string, contentChunk []byte) (*kurtosis_core_rpc_api_bindings.StreamedDataChunk, error) {
+			return &kurtosis_core_rpc_api_bindings.StreamedDataChunk{
+				Data:              contentChunk,
+				PreviousChunkHash: previousChunkHash,
+				Metadata: &kurtosis_core_rpc_api_bindings.DataChunkMetadata{
+					Name: enclaveSnapshotContentName,
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the enclave snapshot")
+	}
+	return nil
+}
+
+func (apicService *ApiContainerService) ImportEnclaveSnapshot(server kurtosis_core_rpc_api_bindings.ApiContainerService_ImportEnclaveSnapshotServer) error {
+	serverStream := grpc_file_streaming.NewServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, emptypb.Empty](server)
+	err := serverStream.ReceiveData(
+		enclaveSnapshotContentName,
+		func(dataChunk *kurtosis_core_rpc_api_bindings.StreamedDataChunk) ([]byte, string, error) {
+			return dataChunk.GetData(), dataChunk.GetPreviousChunkHash(), nil
+		},
+		func(assembledContent io.Reader) (*emptypb.Empty, error) {
+			if err := apicService.enclaveSnapshotter.Import(server.Context(), assembledContent); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred restoring the enclave snapshot")
+			}
+			// the executor keeps the enclave plan in memory, so it needs to pick up the restored one
+			if err := apicService.startosisRunner.ReloadEnclavePlan(); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred reloading the enclave plan once the enclave snapshot was restored")
+			}
+			return &emptypb.Empty{}, nil
+		},
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the enclave snapshot")
+	}
+	return nil
+}
+
+func (apicService *ApiContainerService) ResumeServices(ctx context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ResumeServicesResponse, error) {
+	resumedServiceNames, err := apicService.enclaveResumer.Resume(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resuming the services of the enclave")
+	}
+	resumedServiceNameStrs := []string{}
+	for _, serviceName := range resumedServiceNames {
+		resumedServiceNameStrs = append(resumedServiceNameStrs, string(serviceName))
+	}
+	return &kurtosis_core_rpc_api_bindings.ResumeServicesResponse{
+		ResumedServiceNames: resumedServiceNameStrs,
+	}, nil
+}
+
+func (apicService *ApiContainerService) GetEnclaveExpiry(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.EnclaveExpiry, error) {
+	expiry, lastActivityTime := apicService.enclaveExpiryTracker.GetExpiry()
+	response := &kurtosis_core_rpc_api_bindings.EnclaveExpiry{
+		ExpirationTime:     nil,
+		IdleTimeoutSeconds: 0,
+		ShouldDestroy:      false,
+		LastActivityTime:   timestamppb.New(lastActivityTime),
+	}
+	if expiry != nil {
+		if expiry.ExpirationTime != nil {
+			response.ExpirationTime = timestamppb.New(*expiry.ExpirationTime)
+		}
+		response.IdleTimeoutSeconds = uint32(expiry.IdleTimeout.Seconds())
+		response.ShouldDestroy = expiry.ShouldDestroy
+	}
+	return response, nil
+}
+
+func (apicService *ApiContainerService) SetEnclaveExpiry(_ context.Context, args *kurtosis_core_rpc_api_bindings.EnclaveExpiry) (*emptypb.Empty, error) {
+	var expirationTime *time.Time
+	if args.ExpirationTime != nil {
+		expirationTimeValue := args.GetExpirationTime().AsTime()
+		expirationTime = &expirationTimeValue
+	}
+	expiry := &enclave_expiry.EnclaveExpiry{
+		ExpirationTime: expirationTime,
+		IdleTimeout:    time.Duration(args.GetIdleTimeoutSeconds()) * time.Second,
+		ShouldDestroy:  args.GetShouldDestroy(),
+	}
+	if err := apicService.enclaveExpiryTracker.SetExpiry(expiry); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred setting the enclave expiry")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService *ApiContainerService) GetEnclaveResourceQuotas(ctx context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetEnclaveResourceQuotasResponse, error) {
+	usage, err := startosis_engine.GetEnclaveQuotasUsage(ctx, apicService.serviceNetwork, apicService.filesArtifactStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred computing the usage of the enclave quotas")
+	}
+	quotas := apicService.enclaveQuotas
+	return &kurtosis_core_rpc_api_bindings.GetEnclaveResourceQuotasResponse{
+		CpuMillicpus:               newResourceQuota(usage.GetCpuMillicpus(), quotas.GetCpuMillicpus()),
+		MemoryMegabytes:            newResourceQuota(usage.GetMemoryMegabytes(), quotas.GetMemoryMegabytes()),
+		ServiceCount:               newResourceQuota(usage.GetServiceCount(), quotas.GetServiceCount()),
+		FilesArtifactsStorageBytes: newResourceQuota(usage.GetFilesArtifactsStorageBytes(), quotas.GetFilesArtifactsStorageBytes()),
+	}, nil
+}
+
+func (apicService *ApiContainerService) LintStarlark(_ context.Context, args *kurtosis_core_rpc_api_bindings.LintStarlarkArgs) (*kurtosis_core_rpc_api_bindings.LintStarlarkResponse, error) {
+	linter := startosis_linter.NewStarlarkLinter(startosis_engine.KurtosisBuiltinDefinitions())
+	findings := linter.Lint(args.GetStarlarkFiles())
+
+	findingProtos := []*kurtosis_core_rpc_api_bindings.StarlarkLintFinding{}
+	for _, finding := range findings {
+		findingProtos = append(findingProtos, &kurtosis_core_rpc_api_bindings.StarlarkLintFinding{
+			RuleId:   finding.RuleId,
+			Severity: string(finding.Severity),
+			Filepath: finding.Filepath,
+			Line:     finding.Line,
+			Column:   finding.Column,
+			Message:  finding.Message,
+		})
+	}
+	ruleProtos := []*kurtosis_core_rpc_api_bindings.StarlarkLintRule{}
+	for _, rule := range startosis_linter.GetLintRules() {
+		ruleProtos = append(ruleProtos, &kurtosis_core_rpc_api_bindings.StarlarkLintRule{
+			Id:          rule.Id,
+			Severity:    string(rule.Severity),
+			Description: rule.Description,
+		})
+	}
+	return &kurtosis_core_rpc_api_bindings.LintStarlarkResponse{
+		Findings: findingProtos,
+		Rules:    ruleProtos,
+	}, nil
+}
+
+func (apicService *ApiContainerService) GetServicesStats(args *kurtosis_core_rpc_api_bindings.GetServicesStatsArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_GetServicesStatsServer) error {
+	serviceIdentifiers := []string{}
+	for serviceIdentifier := range args.GetServiceIdentifiers() {
+		serviceIdentifiers = append(serviceIdentifiers, serviceIdentifier)
+	}
+	sort.Strings(serviceIdentifiers)
+
+	ctx := server.Context()
+	for {
+		servicesStats, servicesErrors, err := apicService.serviceNetwork.GetServicesStats(ctx, serviceIdentifiers)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the stats of services '%v'", serviceIdentifiers)
+		}
+		servicesStatsProtos := map[string]*kurtosis_core_rpc_api_bindings.ServiceStats{}
+		for serviceName, serviceStats := range servicesStats {
+			servicesStatsProtos[string(serviceName)] = newServiceStatsProto(serviceStats)
+		}
+		servicesErrorStrs := map[string]string{}
+		for serviceName, serviceErr := range servicesErrors {
+			servicesErrorStrs[string(serviceName)] = serviceErr.Error()
+		}
+		response := &kurtosis_core_rpc_api_bindings.GetServicesStatsResponse{
+			ServiceStats:  servicesStatsProtos,
+			ServiceErrors: servicesErrorStrs,
+		}
+		if err := server.Send(response); err != nil {
+			return stacktrace.Propagate(err, "An error occurred sending the stats of services '%v'", serviceIdentifiers)
+		}
+
+		if !args.GetFollow() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(followedServicesStatsInterval):
+		}
+	}
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -726,7 +1138,7 @@ func (apicService *ApiContainerService) getServiceInfoForIdentifier(ctx context.
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting info for service '%v'", serviceIdentifier)
 	}
-	serviceInfo, err := getServiceInfoFromServiceObj(serviceObj)
+	serviceInfo, err := getServiceInfoFromServiceObj(serviceObj, apicService.serviceHealthMonitor)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while converting service obj for service with id '%v' to service info", serviceIdentifier)
 	}
@@ -777,9 +1189,36 @@ func (apicService *ApiContainerService) runStarlarkPackageSetup(
 	return string(mainScriptToExecute), kurtosisYml, nil
 }
 
+// setPackageLock applies the kurtosis.lock at the root of the package, if there's one, to the packages it imports
+func (apicService *ApiContainerService) setPackageLock(packageId string, packageLockMode kurtosis_core_rpc_api_bindings.PackageLockMode) *startosis_errors.InterpretationError {
+	if packageLockMode == kurtosis_core_rpc_api_bindings.PackageLockMode_IGNORE_LOCK {
+		apicService.startosisModuleContentProvider.SetPackageLock(packageId, noLockedPackageDependencies, allowUnlockedPackageDependencies)
+		return nil
+	}
+
+	packageRootPathOnDisk, interpretationError := apicService.startosisModuleContentProvider.GetOnDiskAbsolutePackagePath(packageId)
+	if interpretationError != nil {
+		return interpretationError
+	}
+	lockedPackageDependencies := noLockedPackageDependencies
+	pathToKurtosisLock := path.Join(packageRootPathOnDisk, startosis_constants.KurtosisLockName)
+	if _, err := os.Stat(pathToKurtosisLock); err == nil {
+		kurtosisLock, err := yaml_parser.ParseKurtosisLock(pathToKurtosisLock)
+		if err != nil {
+			return startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing the %s of package '%s'", startosis_constants.KurtosisLockName, packageId)
+		}
+		lockedPackageDependencies = kurtosisLock.GetDependencies()
+	} else if !os.IsNotExist(err) {
+		return startosis_errors.WrapWithInterpretationError(err, "An error occurred checking whether package '%s' has a %s", packageId, startosis_constants.KurtosisLockName)
+	}
+	apicService.startosisModuleContentProvider.SetPackageLock(packageId, lockedPackageDependencies, packageLockMode == kurtosis_core_rpc_api_bindings.PackageLockMode_EXTEND_LOCK)
+	return nil
+}
+
 func (apicService *ApiContainerService) runStarlark(
 	parallelism int,
 	dryRun bool,
+	diff bool,
 	packageId string,
 	packageReplaceOptions map[string]string,
 	mainFunctionName string,
@@ -790,7 +1229,7 @@ func (apicService *ApiContainerService) runStarlark(
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
 	stream grpc.ServerStream,
 ) {
-	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, parallelism, packageId, packageReplaceOptions, mainFunctionName, relativePathToMainFile, serializedStarlark, serializedParams, imageDownloadMode, experimentalFeatures)
+	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, diff, parallelism, packageId, packageReplaceOptions, mainFunctionName, relativePathToMainFile, serializedStarlark, serializedParams, imageDownloadMode, experimentalFeatures)
 	for {
 		select {
 		case <-stream.Context().Done():
@@ -804,6 +1243,9 @@ func (apicService *ApiContainerService) runStarlark(
 				logrus.Info("Startosis script execution returned, no more output to stream.")
 				return
 			}
+			if runFinishedEvent := responseLine.GetRunFinishedEvent(); runFinishedEvent != nil {
+				runFinishedEvent.ResolvedPackageDependencies = convertToPackageDependenciesAPI(apicService.startosisModuleContentProvider.GetResolvedPackageDependencies())
+			}
 			// in addition to send the msg to the RPC stream, we also print the lines to the APIC logs at debug level
 			logrus.Debugf("Received response line from Starlark runner: '%v'", responseLine)
 			if err := stream.SendMsg(responseLine); err != nil {
@@ -813,10 +1255,30 @@ func (apicService *ApiContainerService) runStarlark(
 	}
 }
 
-func getServiceInfosFromServiceObjs(services map[service.ServiceUUID]*service.Service) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+// getFilesArtifactVersionFile returns the file holding the given version of the files artifact, its current content if
+// no version is given
+func (apicService *ApiContainerService) getFilesArtifactVersionFile(artifactIdentifier string, maybeVersion *uint32) (*enclave_data_directory.EnclaveDataDirFile, error) {
+	var filesArtifact *enclave_data_directory.EnclaveDataDirFile
+	var found bool
+	var err error
+	if maybeVersion == nil {
+		_, filesArtifact, _, found, err = apicService.filesArtifactStore.GetFile(artifactIdentifier)
+	} else {
+		_, filesArtifact, _, found, err = apicService.filesArtifactStore.GetFileVersion(artifactIdentifier, *maybeVersion)
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting files artifact '%v' from the store", artifactIdentifier)
+	}
+	if !found {
+		return nil, stacktrace.NewError("Files artifact '%v' doesn't exist in this enclave", artifactIdentifier)
+	}
+	return filesArtifact, nil
+}
+
+func getServiceInfosFromServiceObjs(services map[service.ServiceUUID]*service.Service, serviceHealthMonitor *service_health.ServiceHealthMonitor) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	serviceInfos := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{}
 	for uuid, serviceObj := range services {
-		serviceInfo, err := getServiceInfoFromServiceObj(serviceObj)
+		serviceInfo, err := getServiceInfoFromServiceObj(serviceObj, serviceHealthMonitor)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "there was an error converting the service obj for service with uuid '%v' and name '%v' to service info", uuid, serviceObj.GetRegistration().GetName())
 		}
@@ -825,7 +1287,7 @@ func getServiceInfosFromServiceObjs(services map[service.ServiceUUID]*service.Se
 	return serviceInfos, nil
 }
 
-func getServiceInfoFromServiceObj(serviceObj *service.Service) (*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+func getServiceInfoFromServiceObj(serviceObj *service.Service, serviceHealthMonitor *service_health.ServiceHealthMonitor) (*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	privatePorts := serviceObj.GetPrivatePorts()
 	privateIp := serviceObj.GetRegistration().GetPrivateIP()
 	maybePublicIp := serviceObj.GetMaybePublicIP()
@@ -857,6 +1319,12 @@ func getServiceInfoFromServiceObj(serviceObj *service.Service) (*kurtosis_core_r
 			return nil, stacktrace.Propagate(err, "An error occurred transforming the service's public port spec ports to API ports")
 		}
 	}
+	healthStatus := kurtosis_core_rpc_api_bindings.ServiceHealthStatus_HEALTH_UNKNOWN
+	restartCount := uint32(0)
+	if serviceHealth, found := serviceHealthMonitor.GetServiceHealthStatus(serviceObj.GetRegistration().GetName()); found {
+		healthStatus = convertHealthStateToServiceInfoHealthStatus(serviceHealth.GetState())
+		restartCount = serviceHealth.GetRestartCount()
+	}
 	serviceInfoContainer := &kurtosis_core_rpc_api_bindings.Container{
 		Status:         serviceInfoContainerStatus,
 		ImageName:      serviceContainer.GetImageName(),
@@ -875,6 +1343,8 @@ func getServiceInfoFromServiceObj(serviceObj *service.Service) (*kurtosis_core_r
 		publicApiPorts,
 		serviceStatus,
 		serviceInfoContainer,
+		healthStatus,
+		restartCount,
 	)
 	return serviceInfoResponse, nil
 }
@@ -952,6 +1422,17 @@ func convertServiceStatusToServiceInfoStatus(serviceStatus service.ServiceStatus
 	}
 }
 
+func convertHealthStateToServiceInfoHealthStatus(healthState service_health.HealthState) kurtosis_core_rpc_api_bindings.ServiceHealthStatus {
+	switch healthState {
+	case service_health.HealthState_Healthy:
+		return kurtosis_core_rpc_api_bindings.ServiceHealthStatus_HEALTHY
+	case service_health.HealthState_Unhealthy:
+		return kurtosis_core_rpc_api_bindings.ServiceHealthStatus_UNHEALTHY
+	default:
+		return kurtosis_core_rpc_api_bindings.ServiceHealthStatus_HEALTH_UNKNOWN
+	}
+}
+
 func convertContainerStatusToServiceInfoContainerStatus(containerStatus container.ContainerStatus) (kurtosis_core_rpc_api_bindings.Container_Status, error) {
 	switch containerStatus {
 	case container.ContainerStatus_Running:
@@ -973,3 +1454,64 @@ func convertFromImageDownloadModeAPI(api_mode kurtosis_core_rpc_api_bindings.Ima
 		panic(stacktrace.NewError("Failed to convert image download mode %v", api_mode))
 	}
 }
+
+func convertFromGitCredentialsAPI(apiGitCredentials []*kurtosis_core_rpc_api_bindings.GitCredential) []*startosis_packages.GitCredential {
+	gitCredentials := []*startosis_packages.GitCredential{}
+	for _, apiGitCredential := range apiGitCredentials {
+		if sshKey := apiGitCredential.GetSshKey(); sshKey != nil {
+			gitCredentials = append(gitCredentials, startosis_packages.NewSshKeyGitCredential(apiGitCredential.GetHost(), sshKey.GetPrivateKey(), sshKey.GetPassphrase(), sshKey.GetKnownHosts()))
+			continue
+		}
+		httpsToken := apiGitCredential.GetHttpsToken()
+		gitCredentials = append(gitCredentials, startosis_packages.NewHttpsTokenGitCredential(apiGitCredential.GetHost(), httpsToken.GetUsername(), httpsToken.GetToken()))
+	}
+	return gitCredentials
+}
+
+func convertToPackageDependenciesAPI(resolvedPackageDependencies map[string]string) []*kurtosis_core_rpc_api_bindings.PackageDependency {
+	packageDependencies := []*kurtosis_core_rpc_api_bindings.PackageDependency{}
+	for repository, commit := range resolvedPackageDependencies {
+		packageDependencies = append(packageDependencies, binding_constructors.NewPackageDependency(repository, commit))
+	}
+	sort.Slice(packageDependencies, func(i, j int) bool {
+		return packageDependencies[i].GetRepository() < packageDependencies[j].GetRepository()
+	})
+	return packageDependencies
+}
+
+func newResourceQuota(usage uint64, quota uint64) *kurtosis_core_rpc_api_bindings.ResourceQuota {
+	var maybeQuota *uint64
+	if quota != enclave_quotas.NoQuota {
+		maybeQuota = &quota
+	}
+	return &kurtosis_core_rpc_api_bindings.ResourceQuota{
+		Usage: usage,
+		Quota: maybeQuota,
+	}
+}
+
+func newServiceStatsProto(serviceStats *service_stats.ServiceStats) *kurtosis_core_rpc_api_bindings.ServiceStats {
+	serviceStatsProto := &kurtosis_core_rpc_api_bindings.ServiceStats{
+		CpuMillicores:           serviceStats.GetCpuMillicores(),
+		MemoryUsageBytes:        serviceStats.GetMemoryUsageBytes(),
+		MemoryLimitBytes:        nil,
+		NetworkReceivedBytes:    nil,
+		NetworkTransmittedBytes: nil,
+		BlockReadBytes:          nil,
+		BlockWrittenBytes:       nil,
+	}
+	if memoryLimitBytes := serviceStats.GetMemoryLimitBytes(); memoryLimitBytes != service_stats.NoMemoryLimit {
+		serviceStatsProto.MemoryLimitBytes = &memoryLimitBytes
+	}
+	if serviceStats.HasIOStats() {
+		networkReceivedBytes := serviceStats.GetNetworkReceivedBytes()
+		networkTransmittedBytes := serviceStats.GetNetworkTransmittedBytes()
+		blockReadBytes := serviceStats.GetBlockReadBytes()
+		blockWrittenBytes := serviceStats.GetBlockWrittenBytes()
+		serviceStatsProto.NetworkReceivedBytes = &networkReceivedBytes
+		serviceStatsProto.NetworkTransmittedBytes = &networkTransmittedBytes
+		serviceStatsProto.BlockReadBytes = &blockReadBytes
+		serviceStatsProto.BlockWrittenBytes = &blockWrittenBytes
+	}
+	return serviceStatsProto
+}
@@ -0,0 +1,53 @@
+package enclave_expiry
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"google.golang.org/grpc"
+)
+
+var methodsNotCountingAsActivity = map[string]bool{
+	// the engine polls it to check whether the enclave expired, so it'd keep idle enclaves alive forever
+	"GetEnclaveExpiry": true,
+}
+
+// RegisterActivityTrackingApiContainerServiceServer registers the API container service like the generated
+// RegisterApiContainerServiceServer does, except that every call received is recorded as activity on the tracker
+func RegisterActivityTrackingApiContainerServiceServer(
+	grpcServer *grpc.Server,
+	apiContainerService kurtosis_core_rpc_api_bindings.ApiContainerServiceServer,
+	tracker *EnclaveExpiryTracker,
+) {
+	serviceDesc := kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc
+	methods := []grpc.MethodDesc{}
+	for _, method := range serviceDesc.Methods {
+		if !methodsNotCountingAsActivity[method.MethodName] {
+			method.Handler = wrapMethodHandler(method.Handler, tracker)
+		}
+		methods = append(methods, method)
+	}
+	streams := []grpc.StreamDesc{}
+	for _, stream := range serviceDesc.Streams {
+		stream.Handler = wrapStreamHandler(stream.Handler, tracker)
+		streams = append(streams, stream)
+	}
+	serviceDesc.Methods = methods
+	serviceDesc.Streams = streams
+	grpcServer.RegisterService(&serviceDesc, apiContainerService)
+}
+
+func wrapMethodHandler(handler grpc.MethodHandler, tracker *EnclaveExpiryTracker) grpc.MethodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		tracker.RecordActivity()
+		return handler(srv, ctx, dec, interceptor)
+	}
+}
+
+func wrapStreamHandler(handler grpc.StreamHandler, tracker *EnclaveExpiryTracker) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		tracker.RecordActivity()
+		// long-running streams (e.g. a Starlark run) count as activity until they end
+		defer tracker.RecordActivity()
+		return handler(srv, stream)
+	}
+}
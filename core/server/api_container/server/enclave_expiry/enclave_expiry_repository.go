@@ -0,0 +1,82 @@
+package enclave_expiry
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+	"time"
+)
+
+var (
+	enclaveExpiryBucketName = []byte("enclave-expiry-repository")
+	enclaveExpiryKey        = []byte("enclave-expiry")
+)
+
+// EnclaveExpiry is the time-to-live and idle timeout configured for the enclave
+type EnclaveExpiry struct {
+	// nil when the enclave has no time-to-live
+	ExpirationTime *time.Time `json:"expirationTime"`
+
+	// zero when the enclave has no idle timeout
+	IdleTimeout time.Duration `json:"idleTimeout"`
+
+	// whether the engine destroys the enclave once it expires, rather than stopping it
+	ShouldDestroy bool `json:"shouldDestroy"`
+}
+
+type enclaveExpiryRepository struct {
+	enclaveDb *enclave_db.EnclaveDB
+}
+
+func newEnclaveExpiryRepository(enclaveDb *enclave_db.EnclaveDB) *enclaveExpiryRepository {
+	return &enclaveExpiryRepository{
+		enclaveDb: enclaveDb,
+	}
+}
+
+func (repository *enclaveExpiryRepository) Save(expiry *EnclaveExpiry) error {
+	if expiry == nil {
+		return stacktrace.NewError("Expected to receive an enclave expiry but a nil value was received instead, this is a bug in Kurtosis")
+	}
+	serializedExpiry, err := json.Marshal(expiry)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing enclave expiry '%+v'", expiry)
+	}
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(enclaveExpiryBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the enclave expiry bucket")
+		}
+		if err := bucket.Put(enclaveExpiryKey, serializedExpiry); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while saving the enclave expiry into the enclave db bucket")
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while saving enclave expiry '%+v' into the enclave db", expiry)
+	}
+	return nil
+}
+
+// Get returns nil if no expiry was ever saved for this enclave
+func (repository *enclaveExpiryRepository) Get() (*EnclaveExpiry, error) {
+	var serializedExpiry []byte
+	if err := repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(enclaveExpiryBucketName)
+		if bucket == nil {
+			return nil
+		}
+		serializedExpiry = bucket.Get(enclaveExpiryKey)
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the enclave expiry from the enclave db")
+	}
+	if serializedExpiry == nil {
+		return nil, nil
+	}
+	expiry := new(EnclaveExpiry)
+	if err := json.Unmarshal(serializedExpiry, expiry); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave expiry from the enclave db")
+	}
+	return expiry, nil
+}
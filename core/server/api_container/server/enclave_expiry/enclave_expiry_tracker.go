@@ -0,0 +1,68 @@
+package enclave_expiry
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"sync"
+	"time"
+)
+
+// EnclaveExpiryTracker keeps the expiry configured for the enclave along with the time of the last API call the
+// enclave received. The engine polls it to decide whether the enclave has expired and should be reaped.
+type EnclaveExpiryTracker struct {
+	mutex *sync.RWMutex
+
+	repository *enclaveExpiryRepository
+
+	// nil if the enclave never expires
+	expiry *EnclaveExpiry
+
+	// not persisted; an API container restart counts as activity
+	lastActivityTime time.Time
+}
+
+func NewEnclaveExpiryTracker(enclaveDb *enclave_db.EnclaveDB) (*EnclaveExpiryTracker, error) {
+	repository := newEnclaveExpiryRepository(enclaveDb)
+	expiry, err := repository.Get()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the stored enclave expiry")
+	}
+	return &EnclaveExpiryTracker{
+		mutex:            &sync.RWMutex{},
+		repository:       repository,
+		expiry:           expiry,
+		lastActivityTime: time.Now(),
+	}, nil
+}
+
+func (tracker *EnclaveExpiryTracker) RecordActivity() {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.lastActivityTime = time.Now()
+}
+
+// GetExpiry returns the configured expiry, nil if the enclave never expires, and the time of the last activity
+func (tracker *EnclaveExpiryTracker) GetExpiry() (*EnclaveExpiry, time.Time) {
+	tracker.mutex.RLock()
+	defer tracker.mutex.RUnlock()
+	return tracker.expiry, tracker.lastActivityTime
+}
+
+// SetExpiry replaces the configured expiry and counts as activity. A nil expiry means the enclave never expires
+func (tracker *EnclaveExpiryTracker) SetExpiry(expiry *EnclaveExpiry) error {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	if expiry == nil {
+		expiry = &EnclaveExpiry{
+			ExpirationTime: nil,
+			IdleTimeout:    0,
+			ShouldDestroy:  false,
+		}
+	}
+	if err := tracker.repository.Save(expiry); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting enclave expiry '%+v'", expiry)
+	}
+	tracker.expiry = expiry
+	tracker.lastActivityTime = time.Now()
+	return nil
+}
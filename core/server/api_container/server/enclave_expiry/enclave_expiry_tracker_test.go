@@ -0,0 +1,67 @@
+package enclave_expiry
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestNewEnclaveExpiryTracker_NoExpiry(t *testing.T) {
+	tracker, err := NewEnclaveExpiryTracker(getEnclaveDbForTest(t))
+	require.NoError(t, err)
+
+	expiry, lastActivityTime := tracker.GetExpiry()
+	require.Nil(t, expiry)
+	require.WithinDuration(t, time.Now(), lastActivityTime, time.Minute)
+}
+
+func TestSetExpiry_SurvivesRestart(t *testing.T) {
+	enclaveDb := getEnclaveDbForTest(t)
+	tracker, err := NewEnclaveExpiryTracker(enclaveDb)
+	require.NoError(t, err)
+
+	expirationTime := time.Now().Add(time.Hour).Round(time.Second).UTC()
+	expiry := &EnclaveExpiry{
+		ExpirationTime: &expirationTime,
+		IdleTimeout:    10 * time.Minute,
+		ShouldDestroy:  true,
+	}
+	require.NoError(t, tracker.SetExpiry(expiry))
+
+	restartedTracker, err := NewEnclaveExpiryTracker(enclaveDb)
+	require.NoError(t, err)
+	restoredExpiry, _ := restartedTracker.GetExpiry()
+	require.NotNil(t, restoredExpiry)
+	require.True(t, expirationTime.Equal(*restoredExpiry.ExpirationTime))
+	require.Equal(t, expiry.IdleTimeout, restoredExpiry.IdleTimeout)
+	require.True(t, restoredExpiry.ShouldDestroy)
+}
+
+func TestRecordActivity_MovesLastActivityTime(t *testing.T) {
+	tracker, err := NewEnclaveExpiryTracker(getEnclaveDbForTest(t))
+	require.NoError(t, err)
+
+	_, initialActivityTime := tracker.GetExpiry()
+	time.Sleep(10 * time.Millisecond)
+	tracker.RecordActivity()
+	_, lastActivityTime := tracker.GetExpiry()
+	require.True(t, lastActivityTime.After(initialActivityTime))
+}
+
+func getEnclaveDbForTest(t *testing.T) *enclave_db.EnclaveDB {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer func() {
+		err = os.Remove(file.Name())
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	return &enclave_db.EnclaveDB{
+		DB: db,
+	}
+}
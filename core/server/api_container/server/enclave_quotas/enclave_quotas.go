@@ -0,0 +1,41 @@
+package enclave_quotas
+
+const (
+	// NoQuota is the value of a quota that doesn't limit the resource
+	NoQuota uint64 = 0
+)
+
+// EnclaveQuotas are the limits, set at the creation of the enclave, on the resources its services and files artifacts
+// can use. The CPU and memory quotas bound the max_cpu and max_memory the services are allocated rather than what they
+// actually consume, so that an enclave can never take more than its share of the host.
+type EnclaveQuotas struct {
+	cpuMillicpus               uint64
+	memoryMegabytes            uint64
+	serviceCount               uint64
+	filesArtifactsStorageBytes uint64
+}
+
+func NewEnclaveQuotas(cpuMillicpus uint64, memoryMegabytes uint64, serviceCount uint64, filesArtifactsStorageBytes uint64) *EnclaveQuotas {
+	return &EnclaveQuotas{
+		cpuMillicpus:               cpuMillicpus,
+		memoryMegabytes:            memoryMegabytes,
+		serviceCount:               serviceCount,
+		filesArtifactsStorageBytes: filesArtifactsStorageBytes,
+	}
+}
+
+func (quotas *EnclaveQuotas) GetCpuMillicpus() uint64 {
+	return quotas.cpuMillicpus
+}
+
+func (quotas *EnclaveQuotas) GetMemoryMegabytes() uint64 {
+	return quotas.memoryMegabytes
+}
+
+func (quotas *EnclaveQuotas) GetServiceCount() uint64 {
+	return quotas.serviceCount
+}
+
+func (quotas *EnclaveQuotas) GetFilesArtifactsStorageBytes() uint64 {
+	return quotas.filesArtifactsStorageBytes
+}
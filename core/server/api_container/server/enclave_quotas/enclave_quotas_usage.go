@@ -0,0 +1,83 @@
+package enclave_quotas
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
+
+// EnclaveQuotasUsage is how much of its quotas an enclave uses. Every service counts, whether it's running or not, as
+// a stopped service can be started again without going through validation.
+// The validator updates it as it goes through the instructions of a run, so that it reflects the usage the run leads to
+type EnclaveQuotasUsage struct {
+	cpuMillicpusByServiceName    map[service.ServiceName]uint64
+	memoryMegabytesByServiceName map[service.ServiceName]uint64
+	filesArtifactsStorageBytes   uint64
+}
+
+func NewEnclaveQuotasUsage(services map[service.ServiceUUID]*service.Service, filesArtifactsStorageBytes uint64) *EnclaveQuotasUsage {
+	usage := &EnclaveQuotasUsage{
+		cpuMillicpusByServiceName:    map[service.ServiceName]uint64{},
+		memoryMegabytesByServiceName: map[service.ServiceName]uint64{},
+		filesArtifactsStorageBytes:   filesArtifactsStorageBytes,
+	}
+	for _, serviceObj := range services {
+		registration := serviceObj.GetRegistration()
+		usage.SetService(registration.GetName(), registration.GetConfig())
+	}
+	return usage
+}
+
+// SetService counts the service with the given config, replacing what the service with the same name counted for if any
+func (usage *EnclaveQuotasUsage) SetService(serviceName service.ServiceName, serviceConfig *service.ServiceConfig) {
+	usage.cpuMillicpusByServiceName[serviceName] = 0
+	usage.memoryMegabytesByServiceName[serviceName] = 0
+	// services registered by older API containers may not have their config stored
+	if serviceConfig == nil {
+		return
+	}
+	usage.cpuMillicpusByServiceName[serviceName] = serviceConfig.GetCPUAllocationMillicpus()
+	usage.memoryMegabytesByServiceName[serviceName] = serviceConfig.GetMemoryAllocationMegabytes()
+}
+
+func (usage *EnclaveQuotasUsage) RemoveService(serviceName service.ServiceName) {
+	delete(usage.cpuMillicpusByServiceName, serviceName)
+	delete(usage.memoryMegabytesByServiceName, serviceName)
+}
+
+func (usage *EnclaveQuotasUsage) HasService(serviceName service.ServiceName) bool {
+	_, found := usage.cpuMillicpusByServiceName[serviceName]
+	return found
+}
+
+// GetServiceCpuMillicpus returns the millicpus the service counts for, 0 if it doesn't exist
+func (usage *EnclaveQuotasUsage) GetServiceCpuMillicpus(serviceName service.ServiceName) uint64 {
+	return usage.cpuMillicpusByServiceName[serviceName]
+}
+
+// GetServiceMemoryMegabytes returns the megabytes of memory the service counts for, 0 if it doesn't exist
+func (usage *EnclaveQuotasUsage) GetServiceMemoryMegabytes(serviceName service.ServiceName) uint64 {
+	return usage.memoryMegabytesByServiceName[serviceName]
+}
+
+func (usage *EnclaveQuotasUsage) GetCpuMillicpus() uint64 {
+	total := uint64(0)
+	for _, cpuMillicpus := range usage.cpuMillicpusByServiceName {
+		total += cpuMillicpus
+	}
+	return total
+}
+
+func (usage *EnclaveQuotasUsage) GetMemoryMegabytes() uint64 {
+	total := uint64(0)
+	for _, memoryMegabytes := range usage.memoryMegabytesByServiceName {
+		total += memoryMegabytes
+	}
+	return total
+}
+
+func (usage *EnclaveQuotasUsage) GetServiceCount() uint64 {
+	return uint64(len(usage.cpuMillicpusByServiceName))
+}
+
+func (usage *EnclaveQuotasUsage) GetFilesArtifactsStorageBytes() uint64 {
+	return usage.filesArtifactsStorageBytes
+}
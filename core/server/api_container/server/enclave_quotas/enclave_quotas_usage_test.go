@@ -0,0 +1,87 @@
+package enclave_quotas
+
+import (
+	"net"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("enclave-uuid")
+
+	testServiceName1 = service.ServiceName("service-1")
+	testServiceUuid1 = service.ServiceUUID("uuid-1")
+	testServiceName2 = service.ServiceName("service-2")
+	testServiceUuid2 = service.ServiceUUID("uuid-2")
+
+	testFilesArtifactsStorageBytes = 2048
+)
+
+func TestEnclaveQuotasUsage_CountsExistingServices(t *testing.T) {
+	services := map[service.ServiceUUID]*service.Service{
+		testServiceUuid1: newTestService(testServiceName1, testServiceUuid1, newTestServiceConfig(t, 1000, 512)),
+		testServiceUuid2: newTestService(testServiceName2, testServiceUuid2, nil),
+	}
+	usage := NewEnclaveQuotasUsage(services, testFilesArtifactsStorageBytes)
+
+	require.Equal(t, uint64(2), usage.GetServiceCount())
+	require.Equal(t, uint64(1000), usage.GetCpuMillicpus())
+	require.Equal(t, uint64(512), usage.GetMemoryMegabytes())
+	require.Equal(t, uint64(testFilesArtifactsStorageBytes), usage.GetFilesArtifactsStorageBytes())
+	require.True(t, usage.HasService(testServiceName2))
+}
+
+func TestEnclaveQuotasUsage_SetServiceReplacesExistingOne(t *testing.T) {
+	services := map[service.ServiceUUID]*service.Service{
+		testServiceUuid1: newTestService(testServiceName1, testServiceUuid1, newTestServiceConfig(t, 1000, 512)),
+	}
+	usage := NewEnclaveQuotasUsage(services, 0)
+
+	usage.SetService(testServiceName1, newTestServiceConfig(t, 250, 128))
+	require.Equal(t, uint64(1), usage.GetServiceCount())
+	require.Equal(t, uint64(250), usage.GetCpuMillicpus())
+	require.Equal(t, uint64(128), usage.GetMemoryMegabytes())
+
+	usage.SetService(testServiceName2, newTestServiceConfig(t, 500, 256))
+	require.Equal(t, uint64(2), usage.GetServiceCount())
+	require.Equal(t, uint64(750), usage.GetCpuMillicpus())
+	require.Equal(t, uint64(256), usage.GetServiceMemoryMegabytes(testServiceName2))
+
+	usage.RemoveService(testServiceName1)
+	require.Equal(t, uint64(1), usage.GetServiceCount())
+	require.Equal(t, uint64(500), usage.GetCpuMillicpus())
+	require.Equal(t, uint64(256), usage.GetMemoryMegabytes())
+	require.False(t, usage.HasService(testServiceName1))
+	require.Equal(t, uint64(0), usage.GetServiceCpuMillicpus(testServiceName1))
+}
+
+func newTestService(serviceName service.ServiceName, serviceUuid service.ServiceUUID, serviceConfig *service.ServiceConfig) *service.Service {
+	registration := service.NewServiceRegistration(serviceName, serviceUuid, testEnclaveUuid, net.IP{}, string(serviceName))
+	registration.SetConfig(serviceConfig)
+	return service.NewService(registration, nil, nil, nil, nil)
+}
+
+func newTestServiceConfig(t *testing.T, cpuAllocationMillicpus uint64, memoryAllocationMegabytes uint64) *service.ServiceConfig {
+	serviceConfig, err := service.CreateServiceConfig(
+		"image",
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		cpuAllocationMillicpus,
+		memoryAllocationMegabytes,
+		"",
+		0,
+		0,
+		nil,
+	)
+	require.NoError(t, err)
+	return serviceConfig
+}
@@ -0,0 +1,339 @@
+package enclave_resume
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_health"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"sort"
+	"time"
+)
+
+const (
+	starlarkThreadName = "enclave-resume-thread"
+)
+
+// EnclaveResumer restarts the services of an enclave that was stopped and started again. The services are restarted
+// in place, keeping their UUIDs, IP addresses and volumes, in the order in which the enclave plan added them, so that
+// a service is only started once the services it was added after are ready.
+type EnclaveResumer struct {
+	serviceNetwork       service_network.ServiceNetwork
+	serviceHealthMonitor *service_health.ServiceHealthMonitor
+	runtimeValueStore    *runtime_value_store.RuntimeValueStore
+	starlarkValueSerde   *kurtosis_types.StarlarkValueSerde
+	enclaveDb            *enclave_db.EnclaveDB
+}
+
+func NewEnclaveResumer(
+	serviceNetwork service_network.ServiceNetwork,
+	serviceHealthMonitor *service_health.ServiceHealthMonitor,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
+	enclaveDb *enclave_db.EnclaveDB,
+) *EnclaveResumer {
+	return &EnclaveResumer{
+		serviceNetwork:       serviceNetwork,
+		serviceHealthMonitor: serviceHealthMonitor,
+		runtimeValueStore:    runtimeValueStore,
+		starlarkValueSerde:   starlarkValueSerde,
+		enclaveDb:            enclaveDb,
+	}
+}
+
+// RestoreServiceHealthMonitoring registers again the health monitoring of the services of the enclave when the API
+// container starts. The services waiting to be resumed are left aside, otherwise their restart policy would restart
+// them concurrently with Resume, which restores their health monitoring once they are ready.
+func (resumer *EnclaveResumer) RestoreServiceHealthMonitoring(ctx context.Context) error {
+	services, err := resumer.serviceNetwork.GetServices(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services of the enclave")
+	}
+	servicesToResume, err := resumer.getServicesToResume(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services to resume")
+	}
+	servicesToRestore := map[service.ServiceName]bool{}
+	for _, serviceObj := range services {
+		serviceName := serviceObj.GetRegistration().GetName()
+		if !servicesToResume[serviceName] {
+			servicesToRestore[serviceName] = true
+		}
+	}
+	if err := add_service.RestoreServiceHealthMonitoring(resumer.serviceHealthMonitor, resumer.serviceNetwork, resumer.runtimeValueStore, resumer.starlarkValueSerde, servicesToRestore); err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring the health monitoring of the services")
+	}
+	return nil
+}
+
+// Resume restarts the services that were running when the enclave stopped, waits for their ready conditions, restores
+// their health monitoring and returns their names in the order they were resumed
+func (resumer *EnclaveResumer) Resume(ctx context.Context) ([]service.ServiceName, error) {
+	servicesToResume, err := resumer.getServicesToResume(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services to resume")
+	}
+	if len(servicesToResume) == 0 {
+		return []service.ServiceName{}, nil
+	}
+
+	enclavePlan, err := enclave_plan_persistence.Load(resumer.enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the enclave plan")
+	}
+	batches := getResumeBatches(enclavePlan.GeneratePlan(), servicesToResume)
+
+	resumedServiceNames := []service.ServiceName{}
+	for _, batch := range batches {
+		readyConditions, err := getReadyConditions(batch.instruction)
+		if err != nil {
+			// the services can still be resumed, only their readiness can't be checked
+			logrus.Warnf("The ready conditions of services '%v' could not be read from the enclave plan, they won't be checked:\n%v", batch.serviceNames, err)
+			readyConditions = map[service.ServiceName]*service_config.ReadyCondition{}
+		}
+
+		_, failedServices, err := resumer.serviceNetwork.ResumeServices(ctx, batch.serviceNames)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resuming services '%v'", batch.serviceNames)
+		}
+		for serviceUuid, serviceErr := range failedServices {
+			return nil, stacktrace.Propagate(serviceErr, "An error occurred resuming service '%v'", serviceUuid)
+		}
+
+		for _, serviceName := range batch.serviceNames {
+			readyCondition, found := readyConditions[serviceName]
+			if !found || readyCondition == nil {
+				continue
+			}
+			if err := resumer.waitUntilReady(ctx, serviceName, readyCondition); err != nil {
+				return nil, stacktrace.Propagate(err, "Service '%v' was resumed but didn't become ready", serviceName)
+			}
+		}
+
+		// the services of the batch are running again, their liveness checks and restart policies can take over
+		batchServiceNames := map[service.ServiceName]bool{}
+		for _, serviceName := range batch.serviceNames {
+			batchServiceNames[serviceName] = true
+		}
+		if err := add_service.RestoreServiceHealthMonitoring(resumer.serviceHealthMonitor, resumer.serviceNetwork, resumer.runtimeValueStore, resumer.starlarkValueSerde, batchServiceNames); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred restoring the health monitoring of resumed services '%v'", batch.serviceNames)
+		}
+		resumedServiceNames = append(resumedServiceNames, batch.serviceNames...)
+	}
+	logrus.Infof("Resumed %d services", len(resumedServiceNames))
+	return resumedServiceNames, nil
+}
+
+// getServicesToResume returns the services which were started when the enclave stopped, and whose container is not
+// running anymore
+func (resumer *EnclaveResumer) getServicesToResume(ctx context.Context) (map[service.ServiceName]bool, error) {
+	services, err := resumer.serviceNetwork.GetServices(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services of the enclave")
+	}
+	servicesToResume := map[service.ServiceName]bool{}
+	for _, serviceObj := range services {
+		registration := serviceObj.GetRegistration()
+		if registration.GetStatus() != service.ServiceStatus_Started {
+			continue
+		}
+		if serviceObj.GetContainer() != nil && serviceObj.GetContainer().GetStatus() == container.ContainerStatus_Running {
+			continue
+		}
+		servicesToResume[registration.GetName()] = true
+	}
+	return servicesToResume, nil
+}
+
+func (resumer *EnclaveResumer) waitUntilReady(ctx context.Context, serviceName service.ServiceName, readyCondition *service_config.ReadyCondition) error {
+	recipe, interpretationErr := readyCondition.GetRecipe()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the recipe of the ready condition")
+	}
+	field, interpretationErr := readyCondition.GetField()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the field of the ready condition")
+	}
+	assertion, interpretationErr := readyCondition.GetAssertion()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the assertion of the ready condition")
+	}
+	target, interpretationErr := readyCondition.GetTarget()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the target of the ready condition")
+	}
+	interval, interpretationErr := readyCondition.GetInterval()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the interval of the ready condition")
+	}
+	timeout, interpretationErr := readyCondition.GetTimeout()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the timeout of the ready condition")
+	}
+
+	startTime := time.Now()
+	_, tries, err := shared_helpers.ExecuteServiceAssertionWithRecipe(
+		ctx,
+		resumer.serviceNetwork,
+		resumer.runtimeValueStore,
+		serviceName,
+		recipe,
+		field,
+		assertion,
+		target,
+		interval,
+		timeout,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking if service '%v' is ready", serviceName)
+	}
+	logrus.Infof("Service '%v' became ready after %d tries (%v in total)", serviceName, tries, time.Since(startTime))
+	return nil
+}
+
+// resumeBatch is a group of services added by the same instruction of the enclave plan, which are resumed together.
+// The instruction is nil for the services that are not part of the plan
+type resumeBatch struct {
+	instruction  *enclave_plan_persistence.EnclavePlanInstruction
+	serviceNames []service.ServiceName
+}
+
+// getResumeBatches groups the services to resume by the instruction which last added them, in the order of the plan
+func getResumeBatches(
+	instructions []*enclave_plan_persistence.EnclavePlanInstruction,
+	servicesToResume map[service.ServiceName]bool,
+) []*resumeBatch {
+	lastInstructionIndexByServiceName := map[service.ServiceName]int{}
+	for instructionIndex, instruction := range instructions {
+		if instruction.Type != add_service.AddServiceBuiltinName && instruction.Type != add_service.AddServicesBuiltinName {
+			continue
+		}
+		for _, serviceNameStr := range instruction.ServiceNames {
+			serviceName := service.ServiceName(serviceNameStr)
+			if servicesToResume[serviceName] {
+				lastInstructionIndexByServiceName[serviceName] = instructionIndex
+			}
+		}
+	}
+
+	batches := []*resumeBatch{}
+	for instructionIndex, instruction := range instructions {
+		batch := &resumeBatch{
+			instruction:  instruction,
+			serviceNames: []service.ServiceName{},
+		}
+		for _, serviceNameStr := range instruction.ServiceNames {
+			serviceName := service.ServiceName(serviceNameStr)
+			lastInstructionIndex, found := lastInstructionIndexByServiceName[serviceName]
+			if found && lastInstructionIndex == instructionIndex {
+				batch.serviceNames = append(batch.serviceNames, serviceName)
+			}
+		}
+		if len(batch.serviceNames) > 0 {
+			batches = append(batches, batch)
+		}
+	}
+
+	// services added outside of Starlark (e.g. restored from a snapshot) are not in the plan, they're resumed last
+	servicesNotInPlan := []service.ServiceName{}
+	for serviceName := range servicesToResume {
+		if _, found := lastInstructionIndexByServiceName[serviceName]; !found {
+			servicesNotInPlan = append(servicesNotInPlan, serviceName)
+		}
+	}
+	if len(servicesNotInPlan) > 0 {
+		sort.Slice(servicesNotInPlan, func(i, j int) bool {
+			return servicesNotInPlan[i] < servicesNotInPlan[j]
+		})
+		batches = append(batches, &resumeBatch{
+			instruction:  nil,
+			serviceNames: servicesNotInPlan,
+		})
+	}
+	return batches
+}
+
+// getReadyConditions reads the ready conditions of the services added by the instruction back from its Starlark code
+func getReadyConditions(instruction *enclave_plan_persistence.EnclavePlanInstruction) (map[service.ServiceName]*service_config.ReadyCondition, error) {
+	readyConditions := map[service.ServiceName]*service_config.ReadyCondition{}
+	if instruction == nil {
+		return readyConditions, nil
+	}
+
+	// The instruction builtin is replaced by one capturing its arguments, so that the service configs can be read back
+	// without running the instruction again
+	var capturedKwargs []starlark.Tuple
+	captureKwargs := func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		capturedKwargs = kwargs
+		return starlark.None, nil
+	}
+	starlarkEnv := startosis_engine.Predeclared()
+	for _, typeConstructor := range startosis_engine.KurtosisTypeConstructors() {
+		starlarkEnv[typeConstructor.Name()] = typeConstructor
+	}
+	starlarkEnv[instruction.Type] = starlark.NewBuiltin(instruction.Type, captureKwargs)
+	starlarkThread := &starlark.Thread{
+		Name:       starlarkThreadName,
+		Print:      nil,
+		Load:       nil,
+		OnMaxSteps: nil,
+		Steps:      0,
+	}
+	if _, err := starlark.Eval(starlarkThread, "", instruction.StarlarkCode, starlarkEnv); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred evaluating the Starlark code of instruction '%s'", instruction.Uuid)
+	}
+
+	var serviceName starlark.String
+	var serviceConfig *service_config.ServiceConfig
+	var serviceConfigs *starlark.Dict
+	for _, kwarg := range capturedKwargs {
+		switch string(kwarg[0].(starlark.String)) {
+		case add_service.ServiceNameArgName:
+			serviceName, _ = kwarg[1].(starlark.String)
+		case add_service.ServiceConfigArgName:
+			serviceConfig, _ = kwarg[1].(*service_config.ServiceConfig)
+		case add_service.ConfigsArgName:
+			serviceConfigs, _ = kwarg[1].(*starlark.Dict)
+		}
+	}
+
+	if serviceConfig != nil {
+		readyCondition, interpretationErr := serviceConfig.GetReadyCondition()
+		if interpretationErr != nil {
+			return nil, stacktrace.Propagate(interpretationErr, "An error occurred getting the ready condition of service '%s'", serviceName.GoString())
+		}
+		readyConditions[service.ServiceName(serviceName.GoString())] = readyCondition
+	}
+	if serviceConfigs != nil {
+		for _, item := range serviceConfigs.Items() {
+			replicaSetName, isString := item[0].(starlark.String)
+			replicaSetConfig, isServiceConfig := item[1].(*service_config.ServiceConfig)
+			if !isString || !isServiceConfig {
+				return nil, stacktrace.NewError("The configs of instruction '%s' are not a dictionary of service names to service configs", instruction.Uuid)
+			}
+			readyCondition, interpretationErr := replicaSetConfig.GetReadyCondition()
+			if interpretationErr != nil {
+				return nil, stacktrace.Propagate(interpretationErr, "An error occurred getting the ready condition of service '%s'", replicaSetName.GoString())
+			}
+			serviceNames, _, interpretationErr := add_service.GetServiceNamesForConfig(service.ServiceName(replicaSetName.GoString()), replicaSetConfig)
+			if interpretationErr != nil {
+				return nil, stacktrace.Propagate(interpretationErr, "An error occurred getting the names of the services created by config '%s'", replicaSetName.GoString())
+			}
+			for _, name := range serviceNames {
+				readyConditions[name] = readyCondition
+			}
+		}
+	}
+	return readyConditions, nil
+}
@@ -0,0 +1,100 @@
+package enclave_resume
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testDatabaseServiceName = service.ServiceName("database")
+	testApiServiceName      = service.ServiceName("api")
+	testWorkerServiceName   = service.ServiceName("worker")
+	testRestoredServiceName = service.ServiceName("restored")
+
+	testAddDatabaseCode = `add_service(name="database", config=ServiceConfig(image="postgres", ports={"postgres": PortSpec(number=5432)}, ready_conditions=ReadyCondition(recipe=ExecRecipe(command=["pg_isready"]), field="code", assertion="==", target_value=0)))`
+	testAddServicesCode = `add_services(configs={"api": ServiceConfig(image="api"), "worker": ServiceConfig(image="worker", replicas=2, ready_conditions=ReadyCondition(recipe=ExecRecipe(command=["true"]), field="code", assertion="==", target_value=0))})`
+)
+
+func TestGetResumeBatches_FollowsPlanOrder(t *testing.T) {
+	instructions := []*enclave_plan_persistence.EnclavePlanInstruction{
+		newTestInstruction("add_service", testAddDatabaseCode, testDatabaseServiceName),
+		newTestInstruction("exec", `exec(service_name="database", recipe=ExecRecipe(command=["ls"]))`, testDatabaseServiceName),
+		newTestInstruction("add_services", testAddServicesCode, testApiServiceName, "worker-0", "worker-1"),
+	}
+	servicesToResume := map[service.ServiceName]bool{
+		testDatabaseServiceName: true,
+		testApiServiceName:      true,
+		"worker-1":              true,
+		testRestoredServiceName: true,
+	}
+
+	batches := getResumeBatches(instructions, servicesToResume)
+	require.Len(t, batches, 3)
+	require.Equal(t, instructions[0], batches[0].instruction)
+	require.Equal(t, []service.ServiceName{testDatabaseServiceName}, batches[0].serviceNames)
+	require.Equal(t, instructions[2], batches[1].instruction)
+	require.Equal(t, []service.ServiceName{testApiServiceName, "worker-1"}, batches[1].serviceNames)
+	require.Nil(t, batches[2].instruction)
+	require.Equal(t, []service.ServiceName{testRestoredServiceName}, batches[2].serviceNames)
+}
+
+func TestGetResumeBatches_ServiceReAddedIsResumedWithLastInstruction(t *testing.T) {
+	instructions := []*enclave_plan_persistence.EnclavePlanInstruction{
+		newTestInstruction("add_service", testAddDatabaseCode, testDatabaseServiceName),
+		newTestInstruction("add_services", testAddServicesCode, testApiServiceName),
+		newTestInstruction("add_service", testAddDatabaseCode, testDatabaseServiceName),
+	}
+	servicesToResume := map[service.ServiceName]bool{
+		testDatabaseServiceName: true,
+		testApiServiceName:      true,
+	}
+
+	batches := getResumeBatches(instructions, servicesToResume)
+	require.Len(t, batches, 2)
+	require.Equal(t, []service.ServiceName{testApiServiceName}, batches[0].serviceNames)
+	require.Equal(t, instructions[2], batches[1].instruction)
+	require.Equal(t, []service.ServiceName{testDatabaseServiceName}, batches[1].serviceNames)
+}
+
+func TestGetReadyConditions_AddService(t *testing.T) {
+	readyConditions, err := getReadyConditions(newTestInstruction("add_service", testAddDatabaseCode, testDatabaseServiceName))
+	require.NoError(t, err)
+	require.Len(t, readyConditions, 1)
+	readyCondition := readyConditions[testDatabaseServiceName]
+	require.NotNil(t, readyCondition)
+	field, interpretationErr := readyCondition.GetField()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, "code", field)
+}
+
+func TestGetReadyConditions_AddServicesWithReplicas(t *testing.T) {
+	readyConditions, err := getReadyConditions(newTestInstruction("add_services", testAddServicesCode, testApiServiceName, "worker-0", "worker-1"))
+	require.NoError(t, err)
+	require.Len(t, readyConditions, 3)
+	require.Nil(t, readyConditions[testApiServiceName])
+	require.NotNil(t, readyConditions["worker-0"])
+	require.NotNil(t, readyConditions["worker-1"])
+}
+
+func TestGetReadyConditions_NoInstruction(t *testing.T) {
+	readyConditions, err := getReadyConditions(nil)
+	require.NoError(t, err)
+	require.Empty(t, readyConditions)
+}
+
+func newTestInstruction(instructionType string, starlarkCode string, serviceNames ...service.ServiceName) *enclave_plan_persistence.EnclavePlanInstruction {
+	serviceNamesStr := []string{}
+	for _, serviceName := range serviceNames {
+		serviceNamesStr = append(serviceNamesStr, string(serviceName))
+	}
+	return &enclave_plan_persistence.EnclavePlanInstruction{
+		Uuid:           instructionType + "-uuid",
+		Type:           instructionType,
+		StarlarkCode:   starlarkCode,
+		ReturnedValue:  "",
+		ServiceNames:   serviceNamesStr,
+		FilesArtifacts: map[string][]byte{},
+	}
+}
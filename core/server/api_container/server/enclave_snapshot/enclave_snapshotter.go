@@ -0,0 +1,556 @@
+package enclave_snapshot
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/persistent_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.etcd.io/bbolt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	snapshotTempDirPattern = "enclave-snapshot-*"
+
+	// The image is tagged with the time of the snapshot so that restoring a snapshot doesn't overwrite the image
+	// loaded from another snapshot of the same service
+	containerFilesystemImageNameFormat = "kurtosis-enclave-snapshot/%s:%d"
+
+	restoredServicesBatchSize = 4
+)
+
+// The enclave database buckets that are part of the snapshot. They mirror the repositories of the runtime value store,
+// the enclave plan and the package replace options. The other buckets hold data tied to the service UUIDs and IPs, which
+// are generated again when the services are restored
+var snapshottedDatabaseBucketNames = []string{
+	"EnclavePlan",
+	"recipe-result-repository",
+	"service-associated-values-repository",
+	"package-replace-options-repository",
+}
+
+// EnclaveSnapshotter freezes the state of the enclave into an archive, and restores such an archive into an empty
+// enclave. The snapshot contains the services configs, the files artifacts, the runtime values, the enclave plan and
+// optionally the persistent directories content and the container filesystems. Service UUIDs and IP addresses are not
+// part of it, as they are generated again when the services are re-created. The IP addresses of the snapshotted
+// services are replaced by the new ones in the services configs and in the runtime values.
+type EnclaveSnapshotter struct {
+	serviceNetwork     service_network.ServiceNetwork
+	filesArtifactStore *enclave_data_directory.FilesArtifactStore
+	kurtosisBackend    backend_interface.KurtosisBackend
+	enclaveDb          *enclave_db.EnclaveDB
+}
+
+func NewEnclaveSnapshotter(
+	serviceNetwork service_network.ServiceNetwork,
+	filesArtifactStore *enclave_data_directory.FilesArtifactStore,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveDb *enclave_db.EnclaveDB,
+) *EnclaveSnapshotter {
+	return &EnclaveSnapshotter{
+		serviceNetwork:     serviceNetwork,
+		filesArtifactStore: filesArtifactStore,
+		kurtosisBackend:    kurtosisBackend,
+		enclaveDb:          enclaveDb,
+	}
+}
+
+// Export writes the snapshot of the enclave as a TGZ archive to the output
+func (snapshotter *EnclaveSnapshotter) Export(ctx context.Context, includePersistentDirectories bool, includeContainerFilesystems bool, output io.Writer) error {
+	enclaveUuid := snapshotter.serviceNetwork.GetEnclaveUuid()
+	stagingDirpath, err := os.MkdirTemp("", snapshotTempDirPattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the staging directory for the snapshot of enclave '%v'", enclaveUuid)
+	}
+	defer os.RemoveAll(stagingDirpath)
+
+	manifest := newSnapshotManifest(enclaveUuid, time.Now())
+	filepathsByArchivePath := map[string]string{}
+
+	services, err := snapshotter.serviceNetwork.GetServices(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", enclaveUuid)
+	}
+	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
+	for serviceUuid, serviceObj := range services {
+		registration := serviceObj.GetRegistration()
+		serviceNamesByUuid[serviceUuid] = registration.GetName()
+		var filesystemImageName, filesystemArchivePath string
+		if includeContainerFilesystems {
+			filesystemImageName = fmt.Sprintf(containerFilesystemImageNameFormat, serviceUuid, manifest.CreationTime.Unix())
+			filesystemArchivePath = path.Join(containerFilesystemsDirname, string(serviceUuid)+containerFilesystemArchiveExtension)
+			filesystemFilepath := path.Join(stagingDirpath, string(serviceUuid)+containerFilesystemArchiveExtension)
+			if err := snapshotter.exportContainerFilesystem(ctx, serviceUuid, filesystemImageName, filesystemFilepath); err != nil {
+				return stacktrace.Propagate(err, "An error occurred exporting the filesystem of service '%s'", registration.GetName())
+			}
+			filepathsByArchivePath[filesystemArchivePath] = filesystemFilepath
+		}
+		manifest.Services = append(manifest.Services, &serviceSnapshot{
+			Name:                  registration.GetName(),
+			Config:                registration.GetConfig(),
+			IsStopped:             registration.GetStatus() != service.ServiceStatus_Started,
+			PrivateIpAddress:      registration.GetPrivateIP().String(),
+			FilesystemImageName:   filesystemImageName,
+			FilesystemArchivePath: filesystemArchivePath,
+		})
+	}
+	sort.Slice(manifest.Services, func(i, j int) bool {
+		return manifest.Services[i].Name < manifest.Services[j].Name
+	})
+
+	for _, filesArtifactNameAndUuid := range snapshotter.filesArtifactStore.GetFileNamesAndUuids() {
+		filesArtifactName := filesArtifactNameAndUuid.GetName()
+		_, filesArtifactFile, contentMd5, found, err := snapshotter.filesArtifactStore.GetFile(filesArtifactName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting files artifact '%s'", filesArtifactName)
+		}
+		if !found {
+			return stacktrace.NewError("Files artifact '%s' was listed in the store but could not be found", filesArtifactName)
+		}
+		archivePath := path.Join(filesArtifactsDirname, string(filesArtifactNameAndUuid.GetUuid())+filesArtifactArchiveExtension)
+		filepathsByArchivePath[archivePath] = filesArtifactFile.GetAbsoluteFilepath()
+		manifest.FilesArtifacts = append(manifest.FilesArtifacts, &filesArtifactSnapshot{
+			Name:        filesArtifactName,
+			ContentMd5:  contentMd5,
+			ArchivePath: archivePath,
+		})
+	}
+
+	if includePersistentDirectories {
+		persistentDirectoryVolumes, err := snapshotter.kurtosisBackend.GetPersistentDirectoryVolumes(ctx, enclaveUuid, nil)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the persistent directory volumes of enclave '%v'", enclaveUuid)
+		}
+		for volumeName, volume := range persistentDirectoryVolumes {
+			var serviceName service.ServiceName
+			if !volume.IsShared() {
+				var found bool
+				serviceName, found = serviceNamesByUuid[volume.GetServiceUUID()]
+				if !found {
+					logrus.Warnf("Persistent directory '%s' belongs to service '%v' which doesn't exist anymore, it won't be part of the snapshot", volume.GetPersistentKey(), volume.GetServiceUUID())
+					continue
+				}
+			}
+			archivePath := path.Join(persistentDirectoriesDirname, volumeName+persistentDirectoryArchiveExtension)
+			volumeContentFilepath := path.Join(stagingDirpath, volumeName+persistentDirectoryArchiveExtension)
+			if err := snapshotter.copyPersistentDirectoryContent(ctx, volumeName, volumeContentFilepath); err != nil {
+				return stacktrace.Propagate(err, "An error occurred copying the content of persistent directory '%s'", volume.GetPersistentKey())
+			}
+			filepathsByArchivePath[archivePath] = volumeContentFilepath
+			manifest.PersistentDirectories = append(manifest.PersistentDirectories, &persistentDirectorySnapshot{
+				PersistentKey: volume.GetPersistentKey(),
+				ServiceName:   serviceName,
+				ArchivePath:   archivePath,
+			})
+		}
+	}
+
+	databaseBuckets, err := snapshotter.dumpDatabaseBuckets()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the enclave database")
+	}
+	manifest.DatabaseBuckets = databaseBuckets
+
+	if err := writeSnapshotArchive(output, manifest, filepathsByArchivePath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the snapshot archive of enclave '%v'", enclaveUuid)
+	}
+	logrus.Infof("Snapshotted enclave '%v' with %d services and %d files artifacts", enclaveUuid, len(manifest.Services), len(manifest.FilesArtifacts))
+	return nil
+}
+
+// Import restores the snapshot read from the input into the enclave, which must not contain any service or files
+// artifact. The caller is responsible for reloading the state it caches from the enclave database.
+func (snapshotter *EnclaveSnapshotter) Import(ctx context.Context, input io.Reader) error {
+	enclaveUuid := snapshotter.serviceNetwork.GetEnclaveUuid()
+	existingServiceNames, err := snapshotter.serviceNetwork.GetServiceNames()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", enclaveUuid)
+	}
+	if len(existingServiceNames) > 0 || len(snapshotter.filesArtifactStore.ListFiles()) > 0 {
+		return stacktrace.NewError("A snapshot can only be restored into an empty enclave, but enclave '%v' already contains services or files artifacts", enclaveUuid)
+	}
+
+	extractionDirpath, err := os.MkdirTemp("", snapshotTempDirPattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the extraction directory for the snapshot")
+	}
+	defer os.RemoveAll(extractionDirpath)
+
+	manifest, err := extractSnapshotArchive(input, extractionDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred extracting the snapshot archive")
+	}
+	logrus.Infof("Restoring snapshot of enclave '%v' taken at '%v' into enclave '%v'", manifest.EnclaveUuid, manifest.CreationTime, enclaveUuid)
+
+	for _, filesArtifact := range manifest.FilesArtifacts {
+		if err := snapshotter.restoreFilesArtifact(extractionDirpath, filesArtifact); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring files artifact '%s'", filesArtifact.Name)
+		}
+	}
+
+	ipAddressReplacements, err := snapshotter.restoreServices(ctx, extractionDirpath, manifest)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring the services")
+	}
+
+	// The database is restored last as the runtime values it holds must point to the IP addresses of the restored services
+	if err := snapshotter.restoreDatabaseBuckets(manifest.DatabaseBuckets, ipAddressReplacements); err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring the enclave database")
+	}
+	return nil
+}
+
+func (snapshotter *EnclaveSnapshotter) exportContainerFilesystem(ctx context.Context, serviceUuid service.ServiceUUID, imageName string, destFilepath string) error {
+	destFile, err := os.Create(destFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%s'", destFilepath)
+	}
+	defer destFile.Close()
+	if err := snapshotter.kurtosisBackend.ExportUserServiceFilesystem(ctx, snapshotter.serviceNetwork.GetEnclaveUuid(), serviceUuid, imageName, destFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting the filesystem of service '%v' to '%s'", serviceUuid, destFilepath)
+	}
+	return nil
+}
+
+func (snapshotter *EnclaveSnapshotter) importContainerFilesystem(ctx context.Context, extractionDirpath string, serviceSnapshot *serviceSnapshot) error {
+	filesystemFilepath, err := getExtractedFilepath(extractionDirpath, serviceSnapshot.FilesystemArchivePath)
+	if err != nil {
+		return stacktrace.Propagate(err, "The container filesystem path is invalid")
+	}
+	filesystemFile, err := os.Open(filesystemFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening the container filesystem at '%s'", filesystemFilepath)
+	}
+	defer filesystemFile.Close()
+	if err := snapshotter.kurtosisBackend.ImportUserServiceFilesystem(ctx, filesystemFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing the container filesystem at '%s'", filesystemFilepath)
+	}
+	return nil
+}
+
+func (snapshotter *EnclaveSnapshotter) copyPersistentDirectoryContent(ctx context.Context, volumeName string, destFilepath string) error {
+	destFile, err := os.Create(destFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%s'", destFilepath)
+	}
+	defer destFile.Close()
+	if err := snapshotter.kurtosisBackend.CopyFilesFromPersistentDirectoryVolume(ctx, snapshotter.serviceNetwork.GetEnclaveUuid(), volumeName, destFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the content of volume '%s'", volumeName)
+	}
+	return nil
+}
+
+func (snapshotter *EnclaveSnapshotter) dumpDatabaseBuckets() (map[string]map[string][]byte, error) {
+	databaseBuckets := map[string]map[string][]byte{}
+	err := snapshotter.enclaveDb.View(func(tx *bbolt.Tx) error {
+		for _, bucketName := range snapshottedDatabaseBucketNames {
+			bucket := tx.Bucket([]byte(bucketName))
+			if bucket == nil {
+				continue
+			}
+			bucketContent := map[string][]byte{}
+			if err := bucket.ForEach(func(key []byte, value []byte) error {
+				// bbolt values are only valid during the transaction, so they're copied
+				bucketContent[string(key)] = append([]byte{}, value...)
+				return nil
+			}); err != nil {
+				return stacktrace.Propagate(err, "An error occurred reading bucket '%s'", bucketName)
+			}
+			databaseBuckets[bucketName] = bucketContent
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave database")
+	}
+	return databaseBuckets, nil
+}
+
+func (snapshotter *EnclaveSnapshotter) restoreDatabaseBuckets(databaseBuckets map[string]map[string][]byte, ipAddressReplacements map[string]string) error {
+	snapshottedBucketNames := map[string]bool{}
+	for _, bucketName := range snapshottedDatabaseBucketNames {
+		snapshottedBucketNames[bucketName] = true
+	}
+	err := snapshotter.enclaveDb.Update(func(tx *bbolt.Tx) error {
+		for bucketName, bucketContent := range databaseBuckets {
+			if !snapshottedBucketNames[bucketName] {
+				logrus.Warnf("Skipping unexpected bucket '%s' found in the snapshot", bucketName)
+				continue
+			}
+			bucket, err := tx.CreateBucketIfNotExists([]byte(bucketName))
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting or creating bucket '%s'", bucketName)
+			}
+			for key, value := range bucketContent {
+				restoredValue := []byte(replaceIpAddresses(string(value), ipAddressReplacements))
+				if err := bucket.Put([]byte(key), restoredValue); err != nil {
+					return stacktrace.Propagate(err, "An error occurred writing key '%s' to bucket '%s'", key, bucketName)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing to the enclave database")
+	}
+	return nil
+}
+
+func (snapshotter *EnclaveSnapshotter) restoreFilesArtifact(extractionDirpath string, filesArtifact *filesArtifactSnapshot) error {
+	filesArtifactFilepath, err := getExtractedFilepath(extractionDirpath, filesArtifact.ArchivePath)
+	if err != nil {
+		return stacktrace.Propagate(err, "The files artifact content path is invalid")
+	}
+	filesArtifactFile, err := os.Open(filesArtifactFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening the files artifact content at '%s'", filesArtifactFilepath)
+	}
+	defer filesArtifactFile.Close()
+	if _, err := snapshotter.serviceNetwork.UploadFilesArtifact(filesArtifactFile, filesArtifact.ContentMd5, filesArtifact.Name); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the files artifact content")
+	}
+	return nil
+}
+
+// restoreServices re-creates the services of the snapshot and returns the IP addresses they had in the snapshotted
+// enclave mapped to the ones they got in this enclave
+func (snapshotter *EnclaveSnapshotter) restoreServices(ctx context.Context, extractionDirpath string, manifest *snapshotManifest) (map[string]string, error) {
+	serviceConfigs := map[service.ServiceName]*service.ServiceConfig{}
+	servicesToStop := map[service.ServiceName]bool{}
+	for _, serviceSnapshot := range manifest.Services {
+		if serviceSnapshot.FilesystemArchivePath != "" {
+			if err := snapshotter.importContainerFilesystem(ctx, extractionDirpath, serviceSnapshot); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred importing the container filesystem of service '%s'", serviceSnapshot.Name)
+			}
+		}
+		serviceConfig, err := snapshotter.rebuildServiceConfig(serviceSnapshot.Config, serviceSnapshot.FilesystemImageName, nil)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred rebuilding the config of service '%s'", serviceSnapshot.Name)
+		}
+		serviceConfigs[serviceSnapshot.Name] = serviceConfig
+		if serviceSnapshot.IsStopped {
+			servicesToStop[serviceSnapshot.Name] = true
+		}
+	}
+	ipAddressReplacements := map[string]string{}
+	if len(serviceConfigs) == 0 {
+		return ipAddressReplacements, nil
+	}
+
+	restoredServices, failedServices, err := snapshotter.serviceNetwork.AddServices(ctx, serviceConfigs, restoredServicesBatchSize)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the services")
+	}
+	if err := getServicesFailureError(failedServices); err != nil {
+		return nil, stacktrace.Propagate(err, "Some services could not be restored")
+	}
+
+	// The services are given new IP addresses, so the configs referencing the addresses of the snapshotted services
+	// are updated to reference the restored ones instead
+	for _, serviceSnapshot := range manifest.Services {
+		restoredService, found := restoredServices[serviceSnapshot.Name]
+		if !found || serviceSnapshot.PrivateIpAddress == "" {
+			continue
+		}
+		restoredIpAddress := restoredService.GetRegistration().GetPrivateIP().String()
+		if restoredIpAddress != serviceSnapshot.PrivateIpAddress {
+			ipAddressReplacements[serviceSnapshot.PrivateIpAddress] = restoredIpAddress
+		}
+	}
+	serviceConfigsToUpdate := map[service.ServiceName]*service.ServiceConfig{}
+	for serviceName, serviceConfig := range serviceConfigs {
+		updatedServiceConfig, err := snapshotter.rebuildServiceConfig(serviceConfig, "", ipAddressReplacements)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred replacing the IP addresses in the config of service '%s'", serviceName)
+		}
+		if updatedServiceConfig != nil {
+			serviceConfigs[serviceName] = updatedServiceConfig
+			serviceConfigsToUpdate[serviceName] = updatedServiceConfig
+		}
+	}
+	if len(serviceConfigsToUpdate) > 0 {
+		_, failedServices, err := snapshotter.serviceNetwork.UpdateServices(ctx, serviceConfigsToUpdate, restoredServicesBatchSize)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred updating the services referencing other services IP addresses")
+		}
+		if err := getServicesFailureError(failedServices); err != nil {
+			return nil, stacktrace.Propagate(err, "Some services referencing other services IP addresses could not be updated")
+		}
+	}
+
+	// The persistent directories are written while the services mounting them are stopped, such that they start
+	// with the restored content
+	servicesToRestart := map[service.ServiceName]bool{}
+	if len(manifest.PersistentDirectories) > 0 {
+		for serviceName, serviceConfig := range serviceConfigs {
+			if serviceConfig.GetPersistentDirectories() != nil && !servicesToStop[serviceName] {
+				servicesToRestart[serviceName] = true
+			}
+		}
+	}
+	if err := snapshotter.stopServices(ctx, servicesToStop); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred stopping the services that were stopped in the snapshot")
+	}
+	if err := snapshotter.stopServices(ctx, servicesToRestart); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred stopping the services mounting persistent directories")
+	}
+
+	for _, persistentDirectory := range manifest.PersistentDirectories {
+		if err := snapshotter.restorePersistentDirectory(ctx, extractionDirpath, persistentDirectory, restoredServices); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred restoring persistent directory '%s'", persistentDirectory.PersistentKey)
+		}
+	}
+
+	for serviceName := range servicesToRestart {
+		if err := snapshotter.serviceNetwork.StartService(ctx, string(serviceName)); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred starting service '%s' once its persistent directories were restored", serviceName)
+		}
+	}
+	return ipAddressReplacements, nil
+}
+
+// rebuildServiceConfig rebuilds the service config for this enclave. When the IP address replacements are nil, the
+// files artifacts expansion is regenerated, as it embeds the address of the API container of the enclave the snapshot
+// was taken from, and the image is replaced by the filesystem image if there's one. Otherwise, the IP addresses of the
+// snapshotted services found in the entrypoint, the command and the environment variables are replaced, and nil is
+// returned if none was found
+func (snapshotter *EnclaveSnapshotter) rebuildServiceConfig(serviceConfig *service.ServiceConfig, filesystemImageName string, ipAddressReplacements map[string]string) (*service.ServiceConfig, error) {
+	containerImageName := serviceConfig.GetContainerImageName()
+	imageBuildSpec := serviceConfig.GetImageBuildSpec()
+	if filesystemImageName != "" {
+		// the filesystem image already contains the result of the build
+		containerImageName = filesystemImageName
+		imageBuildSpec = nil
+	}
+	entrypointArgs := serviceConfig.GetEntrypointArgs()
+	cmdArgs := serviceConfig.GetCmdArgs()
+	envVars := serviceConfig.GetEnvVars()
+	filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion()
+	if ipAddressReplacements != nil {
+		var isEntrypointChanged, isCmdChanged, isEnvVarsChanged bool
+		entrypointArgs, isEntrypointChanged = replaceIpAddressesInSlice(entrypointArgs, ipAddressReplacements)
+		cmdArgs, isCmdChanged = replaceIpAddressesInSlice(cmdArgs, ipAddressReplacements)
+		envVars, isEnvVarsChanged = replaceIpAddressesInMap(envVars, ipAddressReplacements)
+		if !isEntrypointChanged && !isCmdChanged && !isEnvVarsChanged {
+			return nil, nil
+		}
+	} else if filesArtifactsExpansion != nil {
+		var interpretationErr *startosis_errors.InterpretationError
+		filesArtifactsExpansion, interpretationErr = service_config.ConvertFilesArtifactsMounts(serviceConfig.GetFilesArtifactsExpansion().ServiceDirpathsToArtifactIdentifiers, snapshotter.serviceNetwork)
+		if interpretationErr != nil {
+			return nil, stacktrace.Propagate(interpretationErr, "An error occurred building the files artifacts expansion")
+		}
+	}
+	rebuiltServiceConfig, err := service.CreateServiceConfig(
+		containerImageName,
+		imageBuildSpec,
+		serviceConfig.GetPrivatePorts(),
+		serviceConfig.GetPublicPorts(),
+		entrypointArgs,
+		cmdArgs,
+		envVars,
+		filesArtifactsExpansion,
+		serviceConfig.GetPersistentDirectories(),
+		serviceConfig.GetCPUAllocationMillicpus(),
+		serviceConfig.GetMemoryAllocationMegabytes(),
+		serviceConfig.GetPrivateIPAddrPlaceholder(),
+		serviceConfig.GetMinCPUAllocationMillicpus(),
+		serviceConfig.GetMinMemoryAllocationMegabytes(),
+		serviceConfig.GetLabels(),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the service config")
+	}
+	return rebuiltServiceConfig, nil
+}
+
+func (snapshotter *EnclaveSnapshotter) stopServices(ctx context.Context, serviceNames map[service.ServiceName]bool) error {
+	if len(serviceNames) == 0 {
+		return nil
+	}
+	serviceIdentifiers := []string{}
+	for serviceName := range serviceNames {
+		serviceIdentifiers = append(serviceIdentifiers, string(serviceName))
+	}
+	_, failedServices, err := snapshotter.serviceNetwork.StopServices(ctx, serviceIdentifiers)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred stopping services '%v'", serviceIdentifiers)
+	}
+	for serviceUuid, serviceErr := range failedServices {
+		return stacktrace.Propagate(serviceErr, "An error occurred stopping service '%v'", serviceUuid)
+	}
+	return nil
+}
+
+func (snapshotter *EnclaveSnapshotter) restorePersistentDirectory(
+	ctx context.Context,
+	extractionDirpath string,
+	persistentDirectory *persistentDirectorySnapshot,
+	restoredServices map[service.ServiceName]*service.Service,
+) error {
+	enclaveUuid := snapshotter.serviceNetwork.GetEnclaveUuid()
+	var expectedServiceUuid service.ServiceUUID
+	if persistentDirectory.ServiceName != "" {
+		restoredService, found := restoredServices[persistentDirectory.ServiceName]
+		if !found {
+			return stacktrace.NewError("Service '%s' owning the persistent directory was not restored", persistentDirectory.ServiceName)
+		}
+		expectedServiceUuid = restoredService.GetRegistration().GetUUID()
+	}
+
+	volumeFilters := &persistent_directory.PersistentDirectoryVolumeFilters{
+		Names: nil,
+		PersistentKeys: map[service_directory.DirectoryPersistentKey]bool{
+			persistentDirectory.PersistentKey: true,
+		},
+	}
+	volumes, err := snapshotter.kurtosisBackend.GetPersistentDirectoryVolumes(ctx, enclaveUuid, volumeFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent directory volumes of enclave '%v'", enclaveUuid)
+	}
+	for volumeName, volume := range volumes {
+		if volume.GetServiceUUID() != expectedServiceUuid {
+			continue
+		}
+		contentFilepath, err := getExtractedFilepath(extractionDirpath, persistentDirectory.ArchivePath)
+		if err != nil {
+			return stacktrace.Propagate(err, "The persistent directory content path is invalid")
+		}
+		contentFile, err := os.Open(contentFilepath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred opening the persistent directory content at '%s'", contentFilepath)
+		}
+		defer contentFile.Close()
+		if err := snapshotter.kurtosisBackend.CopyFilesToPersistentDirectoryVolume(ctx, enclaveUuid, volumeName, contentFile); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying the content to volume '%s'", volumeName)
+		}
+		return nil
+	}
+	return stacktrace.NewError("No volume was created for the persistent directory when restoring the services")
+}
+
+func getServicesFailureError(failedServices map[service.ServiceName]error) error {
+	if len(failedServices) == 0 {
+		return nil
+	}
+	failureMessages := []string{}
+	for serviceName, serviceErr := range failedServices {
+		failureMessages = append(failureMessages, fmt.Sprintf("Service '%s': %v", serviceName, serviceErr.Error()))
+	}
+	sort.Strings(failureMessages)
+	return stacktrace.NewError("The following services failed:\n%s", strings.Join(failureMessages, "\n"))
+}
@@ -0,0 +1,49 @@
+package enclave_snapshot
+
+import (
+	"regexp"
+)
+
+// Matches the dotted numbers contained in a string, among which the IPv4 addresses. The whole dotted number is matched
+// such that a known IP address which is only a part of it (e.g. of a version number) isn't replaced
+var dottedNumberRegex = regexp.MustCompile(`\b\d+(?:\.\d+)+\b`)
+
+// replaceIpAddresses replaces the IP addresses of the value found in the replacements map, leaving the other ones
+// untouched
+func replaceIpAddresses(value string, ipAddressReplacements map[string]string) string {
+	if len(ipAddressReplacements) == 0 {
+		return value
+	}
+	return dottedNumberRegex.ReplaceAllStringFunc(value, func(dottedNumber string) string {
+		if replacement, found := ipAddressReplacements[dottedNumber]; found {
+			return replacement
+		}
+		return dottedNumber
+	})
+}
+
+func replaceIpAddressesInSlice(values []string, ipAddressReplacements map[string]string) ([]string, bool) {
+	if values == nil {
+		return nil, false
+	}
+	isChanged := false
+	replacedValues := make([]string, len(values))
+	for idx, value := range values {
+		replacedValues[idx] = replaceIpAddresses(value, ipAddressReplacements)
+		isChanged = isChanged || replacedValues[idx] != value
+	}
+	return replacedValues, isChanged
+}
+
+func replaceIpAddressesInMap(values map[string]string, ipAddressReplacements map[string]string) (map[string]string, bool) {
+	if values == nil {
+		return nil, false
+	}
+	isChanged := false
+	replacedValues := make(map[string]string, len(values))
+	for key, value := range values {
+		replacedValues[key] = replaceIpAddresses(value, ipAddressReplacements)
+		isChanged = isChanged || replacedValues[key] != value
+	}
+	return replacedValues, isChanged
+}
@@ -0,0 +1,39 @@
+package enclave_snapshot
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var testIpAddressReplacements = map[string]string{
+	"172.16.0.4": "172.16.8.12",
+	"172.16.0.5": "172.16.8.13",
+}
+
+func TestReplaceIpAddresses(t *testing.T) {
+	require.Equal(t, "http://172.16.8.12:8080/path", replaceIpAddresses("http://172.16.0.4:8080/path", testIpAddressReplacements))
+	require.Equal(t, "172.16.8.12,172.16.8.13", replaceIpAddresses("172.16.0.4,172.16.0.5", testIpAddressReplacements))
+	require.Equal(t, "enode@172.16.8.13:30303", replaceIpAddresses("enode@172.16.0.5:30303", testIpAddressReplacements))
+}
+
+func TestReplaceIpAddresses_OnlyWholeKnownAddressesAreReplaced(t *testing.T) {
+	require.Equal(t, "8.8.8.8", replaceIpAddresses("8.8.8.8", testIpAddressReplacements))
+	require.Equal(t, "172.16.0.44", replaceIpAddresses("172.16.0.44", testIpAddressReplacements))
+	require.Equal(t, "1172.16.0.4", replaceIpAddresses("1172.16.0.4", testIpAddressReplacements))
+	require.Equal(t, "version 172.16.0.4.1", replaceIpAddresses("version 172.16.0.4.1", testIpAddressReplacements))
+}
+
+func TestReplaceIpAddressesInSlice(t *testing.T) {
+	replacedValues, isChanged := replaceIpAddressesInSlice([]string{"--peer", "172.16.0.5"}, testIpAddressReplacements)
+	require.True(t, isChanged)
+	require.Equal(t, []string{"--peer", "172.16.8.13"}, replacedValues)
+
+	_, isChanged = replaceIpAddressesInSlice([]string{"--port", "8080"}, testIpAddressReplacements)
+	require.False(t, isChanged)
+}
+
+func TestReplaceIpAddressesInMap(t *testing.T) {
+	replacedValues, isChanged := replaceIpAddressesInMap(map[string]string{"PEER": "172.16.0.4", "MODE": "full"}, testIpAddressReplacements)
+	require.True(t, isChanged)
+	require.Equal(t, map[string]string{"PEER": "172.16.8.12", "MODE": "full"}, replacedValues)
+}
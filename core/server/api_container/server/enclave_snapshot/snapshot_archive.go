@@ -0,0 +1,267 @@
+package enclave_snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// Bump this every time the content of the archive changes in a non backward compatible way
+	snapshotFormatVersion = 1
+
+	manifestFilename             = "manifest.json"
+	filesArtifactsDirname        = "files-artifacts"
+	persistentDirectoriesDirname = "persistent-directories"
+	containerFilesystemsDirname  = "container-filesystems"
+
+	filesArtifactArchiveExtension       = ".tgz"
+	persistentDirectoryArchiveExtension = ".tar"
+	containerFilesystemArchiveExtension = ".tar"
+
+	archiveEntryFilePermissions      = 0644
+	extractedDirPermissions          = 0755
+	extractedFilePermissions         = 0644
+	manifestJsonIndent               = "  "
+	manifestJsonPrefix               = ""
+	archiveEntryPathParentDirElement = ".."
+)
+
+// snapshotManifest describes the content of an enclave snapshot archive. It is stored at the root of the archive,
+// the files artifacts and persistent directories content being stored alongside it in their own directories
+type snapshotManifest struct {
+	FormatVersion int `json:"formatVersion"`
+
+	// The enclave the snapshot was taken from, for information only
+	EnclaveUuid enclave.EnclaveUUID `json:"enclaveUuid"`
+
+	CreationTime time.Time `json:"creationTime"`
+
+	Services []*serviceSnapshot `json:"services"`
+
+	FilesArtifacts []*filesArtifactSnapshot `json:"filesArtifacts"`
+
+	PersistentDirectories []*persistentDirectorySnapshot `json:"persistentDirectories"`
+
+	// Raw content of the enclave database buckets, indexed by bucket name then by key
+	DatabaseBuckets map[string]map[string][]byte `json:"databaseBuckets"`
+}
+
+type serviceSnapshot struct {
+	Name service.ServiceName `json:"name"`
+
+	Config *service.ServiceConfig `json:"config"`
+
+	IsStopped bool `json:"isStopped"`
+
+	// Used to replace the IP address of the service by the one of the restored service in the other services configs
+	// and in the runtime values
+	PrivateIpAddress string `json:"privateIpAddress"`
+
+	// Image holding the filesystem of the service container, from which the service is restored instead of the image
+	// of its config. Both are empty when the container filesystems aren't part of the snapshot
+	FilesystemImageName string `json:"filesystemImageName"`
+
+	// Path of the image TAR relative to the root of the archive
+	FilesystemArchivePath string `json:"filesystemArchivePath"`
+}
+
+type filesArtifactSnapshot struct {
+	Name string `json:"name"`
+
+	ContentMd5 []byte `json:"contentMd5"`
+
+	// Path of the files artifact content relative to the root of the archive
+	ArchivePath string `json:"archivePath"`
+}
+
+type persistentDirectorySnapshot struct {
+	PersistentKey service_directory.DirectoryPersistentKey `json:"persistentKey"`
+
+	// Empty when the persistent directory is shared across the enclave
+	ServiceName service.ServiceName `json:"serviceName"`
+
+	// Path of the TAR'd persistent directory content relative to the root of the archive
+	ArchivePath string `json:"archivePath"`
+}
+
+func newSnapshotManifest(enclaveUuid enclave.EnclaveUUID, creationTime time.Time) *snapshotManifest {
+	return &snapshotManifest{
+		FormatVersion:         snapshotFormatVersion,
+		EnclaveUuid:           enclaveUuid,
+		CreationTime:          creationTime,
+		Services:              []*serviceSnapshot{},
+		FilesArtifacts:        []*filesArtifactSnapshot{},
+		PersistentDirectories: []*persistentDirectorySnapshot{},
+		DatabaseBuckets:       map[string]map[string][]byte{},
+	}
+}
+
+// writeSnapshotArchive writes a TGZ archive to the output containing the manifest and the files at the given
+// absolute filepaths, indexed by their path inside the archive
+func writeSnapshotArchive(output io.Writer, manifest *snapshotManifest, filepathsByArchivePath map[string]string) error {
+	gzipWriter := gzip.NewWriter(output)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	serializedManifest, err := json.MarshalIndent(manifest, manifestJsonPrefix, manifestJsonIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the enclave snapshot manifest")
+	}
+	manifestHeader := &tar.Header{
+		Name:    manifestFilename,
+		Mode:    archiveEntryFilePermissions,
+		Size:    int64(len(serializedManifest)),
+		ModTime: manifest.CreationTime,
+	}
+	if err := tarWriter.WriteHeader(manifestHeader); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the enclave snapshot manifest header")
+	}
+	if _, err := tarWriter.Write(serializedManifest); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the enclave snapshot manifest")
+	}
+
+	// sorted to produce the same archive for the same enclave content
+	archivePaths := []string{}
+	for archivePath := range filepathsByArchivePath {
+		archivePaths = append(archivePaths, archivePath)
+	}
+	sort.Strings(archivePaths)
+	for _, archivePath := range archivePaths {
+		if err := addFileToArchive(tarWriter, archivePath, filepathsByArchivePath[archivePath]); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding '%s' to the enclave snapshot archive", archivePath)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the enclave snapshot TAR writer")
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the enclave snapshot GZIP writer")
+	}
+	return nil
+}
+
+// extractSnapshotArchive extracts the TGZ archive into the destination directory and returns the manifest it contains
+func extractSnapshotArchive(input io.Reader, destDirpath string) (*snapshotManifest, error) {
+	gzipReader, err := gzip.NewReader(input)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave snapshot, it doesn't seem to be a TGZ archive")
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the next entry of the enclave snapshot archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			return nil, stacktrace.NewError("Entry '%s' of the enclave snapshot archive is not a regular file", header.Name)
+		}
+		if err := extractFileFromArchive(tarReader, header.Name, destDirpath); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred extracting '%s' from the enclave snapshot archive", header.Name)
+		}
+	}
+
+	serializedManifest, err := os.ReadFile(path.Join(destDirpath, manifestFilename))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the manifest of the enclave snapshot archive")
+	}
+	manifest := new(snapshotManifest)
+	if err := json.Unmarshal(serializedManifest, manifest); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the manifest of the enclave snapshot archive")
+	}
+	if manifest.FormatVersion != snapshotFormatVersion {
+		return nil, stacktrace.NewError("The enclave snapshot has format version '%d' but only version '%d' is supported", manifest.FormatVersion, snapshotFormatVersion)
+	}
+	for _, filesArtifact := range manifest.FilesArtifacts {
+		if _, err := getExtractedFilepath(destDirpath, filesArtifact.ArchivePath); err != nil {
+			return nil, stacktrace.Propagate(err, "The manifest of the enclave snapshot archive contains an invalid path for files artifact '%s'", filesArtifact.Name)
+		}
+	}
+	for _, serviceSnapshot := range manifest.Services {
+		if serviceSnapshot.FilesystemArchivePath == "" {
+			continue
+		}
+		if _, err := getExtractedFilepath(destDirpath, serviceSnapshot.FilesystemArchivePath); err != nil {
+			return nil, stacktrace.Propagate(err, "The manifest of the enclave snapshot archive contains an invalid path for the filesystem of service '%s'", serviceSnapshot.Name)
+		}
+	}
+	for _, persistentDirectory := range manifest.PersistentDirectories {
+		if _, err := getExtractedFilepath(destDirpath, persistentDirectory.ArchivePath); err != nil {
+			return nil, stacktrace.Propagate(err, "The manifest of the enclave snapshot archive contains an invalid path for persistent directory '%s'", persistentDirectory.PersistentKey)
+		}
+	}
+	return manifest, nil
+}
+
+func addFileToArchive(tarWriter *tar.Writer, archivePath string, absoluteFilepath string) error {
+	file, err := os.Open(absoluteFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening file '%s'", absoluteFilepath)
+	}
+	defer file.Close()
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting file '%s'", absoluteFilepath)
+	}
+
+	header := &tar.Header{
+		Name:    archivePath,
+		Mode:    archiveEntryFilePermissions,
+		Size:    fileInfo.Size(),
+		ModTime: fileInfo.ModTime(),
+	}
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the archive header for file '%s'", absoluteFilepath)
+	}
+	if _, err := io.Copy(tarWriter, file); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing file '%s' to the archive", absoluteFilepath)
+	}
+	return nil
+}
+
+// getExtractedFilepath returns the path at which the archive entry is extracted in the destination directory. The
+// archive might come from another machine, so the paths it contains are rejected if they point outside of it.
+func getExtractedFilepath(destDirpath string, archivePath string) (string, error) {
+	cleanedArchivePath := filepath.Clean(archivePath)
+	if filepath.IsAbs(cleanedArchivePath) || cleanedArchivePath == archiveEntryPathParentDirElement || strings.HasPrefix(cleanedArchivePath, archiveEntryPathParentDirElement+string(filepath.Separator)) {
+		return "", stacktrace.NewError("Path '%s' points outside of the archive", archivePath)
+	}
+	return filepath.Join(destDirpath, cleanedArchivePath), nil
+}
+
+func extractFileFromArchive(tarReader *tar.Reader, archivePath string, destDirpath string) error {
+	destFilepath, err := getExtractedFilepath(destDirpath, archivePath)
+	if err != nil {
+		return stacktrace.Propagate(err, "Entry '%s' of the archive is invalid", archivePath)
+	}
+	if err := os.MkdirAll(filepath.Dir(destFilepath), extractedDirPermissions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the parent directory of '%s'", destFilepath)
+	}
+
+	destFile, err := os.OpenFile(destFilepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, extractedFilePermissions)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%s'", destFilepath)
+	}
+	defer destFile.Close()
+	if _, err := io.Copy(destFile, tarReader); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing file '%s'", destFilepath)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package enclave_snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+const (
+	testEnclaveUuid          = enclave.EnclaveUUID("test-enclave-uuid")
+	testFilesArtifactName    = "test-artifact"
+	testFilesArtifactPath    = filesArtifactsDirname + "/test-artifact-uuid" + filesArtifactArchiveExtension
+	testFilesArtifactContent = "files artifact content"
+	testBucketName           = "EnclavePlan"
+	testBucketKey            = "key"
+	testBucketValue          = "value"
+)
+
+func TestSnapshotArchive_RoundTrip(t *testing.T) {
+	sourceDirpath := t.TempDir()
+	filesArtifactFilepath := path.Join(sourceDirpath, "artifact.tgz")
+	require.NoError(t, os.WriteFile(filesArtifactFilepath, []byte(testFilesArtifactContent), extractedFilePermissions))
+
+	manifest := newSnapshotManifest(testEnclaveUuid, time.Now().UTC().Truncate(time.Second))
+	manifest.FilesArtifacts = append(manifest.FilesArtifacts, &filesArtifactSnapshot{
+		Name:        testFilesArtifactName,
+		ContentMd5:  []byte{1, 2, 3},
+		ArchivePath: testFilesArtifactPath,
+	})
+	manifest.DatabaseBuckets[testBucketName] = map[string][]byte{
+		testBucketKey: []byte(testBucketValue),
+	}
+
+	archive := &bytes.Buffer{}
+	err := writeSnapshotArchive(archive, manifest, map[string]string{
+		testFilesArtifactPath: filesArtifactFilepath,
+	})
+	require.NoError(t, err)
+
+	destDirpath := t.TempDir()
+	extractedManifest, err := extractSnapshotArchive(archive, destDirpath)
+	require.NoError(t, err)
+	require.Equal(t, manifest, extractedManifest)
+
+	extractedContent, err := os.ReadFile(path.Join(destDirpath, testFilesArtifactPath))
+	require.NoError(t, err)
+	require.Equal(t, testFilesArtifactContent, string(extractedContent))
+}
+
+func TestSnapshotArchive_UnsupportedFormatVersion(t *testing.T) {
+	manifest := newSnapshotManifest(testEnclaveUuid, time.Now())
+	manifest.FormatVersion = snapshotFormatVersion + 1
+
+	archive := &bytes.Buffer{}
+	require.NoError(t, writeSnapshotArchive(archive, manifest, map[string]string{}))
+
+	_, err := extractSnapshotArchive(archive, t.TempDir())
+	require.Error(t, err)
+}
+
+func TestSnapshotArchive_EntryOutsideOfArchiveIsRejected(t *testing.T) {
+	archive := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	content := []byte("malicious")
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{
+		Name: "../outside.txt",
+		Mode: archiveEntryFilePermissions,
+		Size: int64(len(content)),
+	}))
+	_, err := tarWriter.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	parentDirpath := t.TempDir()
+	destDirpath := path.Join(parentDirpath, "dest")
+	_, err = extractSnapshotArchive(archive, destDirpath)
+	require.Error(t, err)
+	require.NoFileExists(t, path.Join(parentDirpath, "outside.txt"))
+}
+
+func TestSnapshotArchive_ManifestPathOutsideOfArchiveIsRejected(t *testing.T) {
+	for _, invalidArchivePath := range []string{"/etc/passwd", "../outside.tgz", filesArtifactsDirname + "/../../outside.tgz"} {
+		manifest := newSnapshotManifest(testEnclaveUuid, time.Now())
+		manifest.FilesArtifacts = append(manifest.FilesArtifacts, &filesArtifactSnapshot{
+			Name:        testFilesArtifactName,
+			ContentMd5:  []byte{1, 2, 3},
+			ArchivePath: invalidArchivePath,
+		})
+
+		archive := &bytes.Buffer{}
+		require.NoError(t, writeSnapshotArchive(archive, manifest, map[string]string{}))
+
+		_, err := extractSnapshotArchive(archive, t.TempDir())
+		require.Error(t, err, "Path '%s' should have been rejected", invalidArchivePath)
+	}
+}
@@ -0,0 +1,42 @@
+package service_health
+
+import (
+	"context"
+	"time"
+)
+
+// LivenessCheckFunc runs a single liveness probe against a service, returning an error if the service is not alive
+type LivenessCheckFunc func(ctx context.Context) error
+
+// LivenessCheck is continuously evaluated by the ServiceHealthMonitor once a service is up and running
+type LivenessCheck struct {
+	check LivenessCheckFunc
+
+	// time between two consecutive probes, also used as the timeout of a single probe
+	interval time.Duration
+
+	// number of consecutive failed probes after which the service is considered unhealthy
+	failureThreshold uint32
+}
+
+func NewLivenessCheck(check LivenessCheckFunc, interval time.Duration, failureThreshold uint32) *LivenessCheck {
+	return &LivenessCheck{
+		check:            check,
+		interval:         interval,
+		failureThreshold: failureThreshold,
+	}
+}
+
+func (livenessCheck *LivenessCheck) GetInterval() time.Duration {
+	return livenessCheck.interval
+}
+
+func (livenessCheck *LivenessCheck) GetFailureThreshold() uint32 {
+	return livenessCheck.failureThreshold
+}
+
+func (livenessCheck *LivenessCheck) run(ctx context.Context) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, livenessCheck.interval)
+	defer cancel()
+	return livenessCheck.check(ctxWithTimeout)
+}
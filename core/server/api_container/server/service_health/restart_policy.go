@@ -0,0 +1,98 @@
+package service_health
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"time"
+)
+
+type RestartPolicyMode string
+
+const (
+	// RestartPolicyMode_Never never restarts the service; the liveness check (if any) is only used to report health
+	RestartPolicyMode_Never RestartPolicyMode = "never"
+
+	// RestartPolicyMode_OnFailure restarts the service when its liveness check fails 'failure_threshold' times in a row,
+	// and also when its container exits with a non-zero exit code although the service was not stopped by the user
+	RestartPolicyMode_OnFailure RestartPolicyMode = "on-failure"
+
+	// RestartPolicyMode_Always restarts the service when its liveness check fails, and also when its container is
+	// found not running although the service was not stopped by the user
+	RestartPolicyMode_Always RestartPolicyMode = "always"
+
+	// UnlimitedRestarts can be used as max retries to never stop restarting a service
+	UnlimitedRestarts = uint32(0)
+
+	successfulExitCode = int32(0)
+)
+
+func RestartPolicyModeValues() []RestartPolicyMode {
+	return []RestartPolicyMode{
+		RestartPolicyMode_Never,
+		RestartPolicyMode_OnFailure,
+		RestartPolicyMode_Always,
+	}
+}
+
+func RestartPolicyModeFromString(modeStr string) (RestartPolicyMode, error) {
+	for _, mode := range RestartPolicyModeValues() {
+		if string(mode) == modeStr {
+			return mode, nil
+		}
+	}
+	return "", stacktrace.NewError("Unknown restart policy '%s'. Valid values are: %v", modeStr, RestartPolicyModeValues())
+}
+
+// RestartPolicy controls when the API container restarts a service
+type RestartPolicy struct {
+	mode RestartPolicyMode
+
+	// the maximum number of restarts the API container will do for the service, UnlimitedRestarts for no limit
+	maxRetries uint32
+
+	// the minimum time to wait between two consecutive restarts of the service
+	backoff time.Duration
+}
+
+func NewRestartPolicy(mode RestartPolicyMode, maxRetries uint32, backoff time.Duration) *RestartPolicy {
+	return &RestartPolicy{
+		mode:       mode,
+		maxRetries: maxRetries,
+		backoff:    backoff,
+	}
+}
+
+func NewNeverRestartPolicy() *RestartPolicy {
+	return NewRestartPolicy(RestartPolicyMode_Never, UnlimitedRestarts, 0)
+}
+
+func (policy *RestartPolicy) GetMode() RestartPolicyMode {
+	return policy.mode
+}
+
+func (policy *RestartPolicy) GetMaxRetries() uint32 {
+	return policy.maxRetries
+}
+
+func (policy *RestartPolicy) GetBackoff() time.Duration {
+	return policy.backoff
+}
+
+func (policy *RestartPolicy) restartsOnLivenessFailure() bool {
+	return policy.mode == RestartPolicyMode_OnFailure || policy.mode == RestartPolicyMode_Always
+}
+
+// restartsOnContainerExit takes the exit code of the container, if known
+func (policy *RestartPolicy) restartsOnContainerExit(exitCode int32, isExitCodeKnown bool) bool {
+	switch policy.mode {
+	case RestartPolicyMode_Always:
+		return true
+	case RestartPolicyMode_OnFailure:
+		return isExitCodeKnown && exitCode != successfulExitCode
+	default:
+		return false
+	}
+}
+
+func (policy *RestartPolicy) hasRetriesLeft(restartCount uint32) bool {
+	return policy.maxRetries == UnlimitedRestarts || restartCount < policy.maxRetries
+}
@@ -0,0 +1,320 @@
+package service_health
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+const (
+	// how often the container of a service with no liveness check is inspected to enforce its restart policy
+	defaultContainerPollInterval = 5 * time.Second
+)
+
+type HealthState string
+
+const (
+	// HealthState_Unknown is reported for services without liveness check, or whose liveness check hasn't run yet
+	HealthState_Unknown HealthState = "unknown"
+
+	HealthState_Healthy HealthState = "healthy"
+
+	HealthState_Unhealthy HealthState = "unhealthy"
+)
+
+// serviceController is the subset of the service network the monitor needs to inspect and restart services
+type serviceController interface {
+	GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error)
+	ExistServiceRegistration(serviceName service.ServiceName) (bool, error)
+	StopService(ctx context.Context, serviceIdentifier string) error
+	StartService(ctx context.Context, serviceIdentifier string) error
+}
+
+type ServiceHealthStatus struct {
+	state        HealthState
+	restartCount uint32
+}
+
+func (status *ServiceHealthStatus) GetState() HealthState {
+	return status.state
+}
+
+func (status *ServiceHealthStatus) GetRestartCount() uint32 {
+	return status.restartCount
+}
+
+type monitoredService struct {
+	livenessCheck *LivenessCheck
+	restartPolicy *RestartPolicy
+
+	state               HealthState
+	consecutiveFailures uint32
+	restartCount        uint32
+	lastRestart         time.Time
+
+	cancelMonitoring context.CancelFunc
+}
+
+// ServiceHealthMonitor continuously evaluates the liveness checks of the services of the enclave and enforces their
+// restart policy. Each monitored service gets its own goroutine, running until the service is unregistered.
+// Registrations are persisted in the enclave DB, so that they can be restored when the API container restarts.
+type ServiceHealthMonitor struct {
+	serviceController serviceController
+
+	registrationRepository *serviceRegistrationRepository
+
+	mutex             *sync.Mutex
+	monitoredServices map[service.ServiceName]*monitoredService
+}
+
+func NewServiceHealthMonitor(serviceController serviceController, enclaveDb *enclave_db.EnclaveDB) (*ServiceHealthMonitor, error) {
+	registrationRepository, err := getOrCreateNewServiceRegistrationRepository(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the service health registrations repository")
+	}
+	return &ServiceHealthMonitor{
+		serviceController:      serviceController,
+		registrationRepository: registrationRepository,
+		mutex:                  &sync.Mutex{},
+		monitoredServices:      map[service.ServiceName]*monitoredService{},
+	}, nil
+}
+
+// RegisterService starts monitoring the service, replacing any liveness check and restart policy previously
+// registered for it. A nil restart policy is equivalent to the 'never' policy. If neither a liveness check nor a
+// restart policy is provided, the service is simply unregistered.
+// The liveness check definition is persisted along with the restart policy, and handed back by
+// GetPersistedRegistrations for the liveness check to be rebuilt after a restart of the API container.
+func (monitor *ServiceHealthMonitor) RegisterService(serviceName service.ServiceName, livenessCheck *LivenessCheck, livenessCheckDefinition string, restartPolicy *RestartPolicy) error {
+	if livenessCheck == nil && restartPolicy == nil {
+		return monitor.UnregisterService(serviceName)
+	}
+	if restartPolicy == nil {
+		restartPolicy = NewNeverRestartPolicy()
+	}
+
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+
+	persistedRegistration := &PersistedServiceRegistration{
+		LivenessCheckDefinition: livenessCheckDefinition,
+		RestartPolicyMode:       restartPolicy.GetMode(),
+		RestartPolicyMaxRetries: restartPolicy.GetMaxRetries(),
+		RestartPolicyBackoff:    restartPolicy.GetBackoff(),
+	}
+	if err := monitor.registrationRepository.Save(serviceName, persistedRegistration); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the health monitoring of service '%s'", serviceName)
+	}
+
+	monitor.unregisterServiceUnlocked(serviceName)
+
+	monitoringCtx, cancelMonitoring := context.WithCancel(context.Background())
+	monitored := &monitoredService{
+		livenessCheck:       livenessCheck,
+		restartPolicy:       restartPolicy,
+		state:               HealthState_Unknown,
+		consecutiveFailures: 0,
+		restartCount:        0,
+		lastRestart:         time.Time{},
+		cancelMonitoring:    cancelMonitoring,
+	}
+	monitor.monitoredServices[serviceName] = monitored
+
+	pollInterval := defaultContainerPollInterval
+	if livenessCheck != nil {
+		pollInterval = livenessCheck.GetInterval()
+	}
+	go monitor.monitorService(monitoringCtx, serviceName, pollInterval)
+	logrus.Debugf("Started health monitoring of service '%s' with restart policy '%s'", serviceName, restartPolicy.GetMode())
+	return nil
+}
+
+// UnregisterService stops monitoring the service and forgets its persisted registration. It is a no-op if the service
+// is not monitored
+func (monitor *ServiceHealthMonitor) UnregisterService(serviceName service.ServiceName) error {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	monitor.unregisterServiceUnlocked(serviceName)
+	if err := monitor.registrationRepository.Delete(serviceName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred deleting the persisted health monitoring of service '%s'", serviceName)
+	}
+	return nil
+}
+
+// GetPersistedRegistrations returns the registrations persisted by RegisterService, keyed by service name. They're
+// kept when the monitor is closed, so they can be registered again when the API container restarts.
+func (monitor *ServiceHealthMonitor) GetPersistedRegistrations() (map[service.ServiceName]*PersistedServiceRegistration, error) {
+	registrations, err := monitor.registrationRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persisted service health registrations")
+	}
+	return registrations, nil
+}
+
+// GetServiceHealthStatus returns the current health status of the service, and false if the service is not monitored
+func (monitor *ServiceHealthMonitor) GetServiceHealthStatus(serviceName service.ServiceName) (*ServiceHealthStatus, bool) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	monitored, found := monitor.monitoredServices[serviceName]
+	if !found {
+		return nil, false
+	}
+	return &ServiceHealthStatus{
+		state:        monitored.state,
+		restartCount: monitored.restartCount,
+	}, true
+}
+
+// Close stops monitoring all services, keeping their persisted registrations
+func (monitor *ServiceHealthMonitor) Close() {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	for serviceName := range monitor.monitoredServices {
+		monitor.unregisterServiceUnlocked(serviceName)
+	}
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func (monitor *ServiceHealthMonitor) unregisterServiceUnlocked(serviceName service.ServiceName) {
+	monitored, found := monitor.monitoredServices[serviceName]
+	if !found {
+		return
+	}
+	monitored.cancelMonitoring()
+	delete(monitor.monitoredServices, serviceName)
+}
+
+func (monitor *ServiceHealthMonitor) monitorService(ctx context.Context, serviceName service.ServiceName, pollInterval time.Duration) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := monitor.checkService(ctx, serviceName); err != nil {
+				logrus.Warnf("An error occurred checking the health of service '%s':\n%v", serviceName, err)
+			}
+		}
+	}
+}
+
+// checkService runs a single round of monitoring for the service: it inspects its container, runs its liveness check
+// and restarts it if its restart policy requires it
+func (monitor *ServiceHealthMonitor) checkService(ctx context.Context, serviceName service.ServiceName) error {
+	monitor.mutex.Lock()
+	monitored, found := monitor.monitoredServices[serviceName]
+	monitor.mutex.Unlock()
+	if !found {
+		return nil
+	}
+
+	serviceObj, err := monitor.serviceController.GetService(ctx, string(serviceName))
+	if err != nil {
+		exists, existErr := monitor.serviceController.ExistServiceRegistration(serviceName)
+		if existErr == nil && !exists {
+			logrus.Debugf("Service '%s' doesn't exist anymore, stopping its health monitoring", serviceName)
+			monitor.unregisterIfStillMonitored(serviceName, monitored)
+			return nil
+		}
+		return stacktrace.Propagate(err, "An error occurred getting service '%s'", serviceName)
+	}
+
+	// services that were stopped by the user are neither checked nor restarted
+	if serviceObj.GetRegistration().GetStatus() != service.ServiceStatus_Started {
+		return nil
+	}
+
+	serviceContainer := serviceObj.GetContainer()
+	isContainerRunning := serviceContainer != nil && serviceContainer.GetStatus() == container.ContainerStatus_Running
+	var livenessErr error
+	if isContainerRunning && monitored.livenessCheck != nil {
+		livenessErr = monitored.livenessCheck.run(ctx)
+	}
+	var exitCode int32
+	var isExitCodeKnown bool
+	if serviceContainer != nil {
+		exitCode, isExitCodeKnown = serviceContainer.GetExitCode()
+	}
+
+	if !monitor.updateStateAndDecideRestart(serviceName, monitored, isContainerRunning, exitCode, isExitCodeKnown, livenessErr) {
+		return nil
+	}
+
+	logrus.Infof("Restarting unhealthy service '%s' according to its '%s' restart policy", serviceName, monitored.restartPolicy.GetMode())
+	if err := monitor.serviceController.StopService(ctx, string(serviceName)); err != nil {
+		logrus.Debugf("An error occurred stopping service '%s' before restarting it, trying to start it anyway:\n%v", serviceName, err)
+	}
+	if err := monitor.serviceController.StartService(ctx, string(serviceName)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred restarting service '%s'", serviceName)
+	}
+	return nil
+}
+
+// updateStateAndDecideRestart records the outcome of a check and returns true if the service needs to be restarted,
+// in which case the restart is already accounted for
+func (monitor *ServiceHealthMonitor) updateStateAndDecideRestart(serviceName service.ServiceName, monitored *monitoredService, isContainerRunning bool, exitCode int32, isExitCodeKnown bool, livenessErr error) bool {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	if monitor.monitoredServices[serviceName] != monitored {
+		// the service was unregistered or re-registered while it was being checked
+		return false
+	}
+
+	shouldRestart := false
+	switch {
+	case !isContainerRunning:
+		monitored.state = HealthState_Unhealthy
+		shouldRestart = monitored.restartPolicy.restartsOnContainerExit(exitCode, isExitCodeKnown)
+	case monitored.livenessCheck == nil:
+		monitored.state = HealthState_Unknown
+	case livenessErr == nil:
+		monitored.state = HealthState_Healthy
+		monitored.consecutiveFailures = 0
+	default:
+		monitored.consecutiveFailures += 1
+		logrus.Debugf("Liveness check of service '%s' failed (%d consecutive failures):\n%v", serviceName, monitored.consecutiveFailures, livenessErr)
+		if monitored.consecutiveFailures >= monitored.livenessCheck.GetFailureThreshold() {
+			monitored.state = HealthState_Unhealthy
+			shouldRestart = monitored.restartPolicy.restartsOnLivenessFailure()
+		}
+	}
+	if !shouldRestart {
+		return false
+	}
+
+	if !monitored.restartPolicy.hasRetriesLeft(monitored.restartCount) {
+		logrus.Debugf("Service '%s' is unhealthy but was already restarted %d times, which is the maximum allowed by its restart policy", serviceName, monitored.restartCount)
+		return false
+	}
+	if !monitored.lastRestart.IsZero() && time.Since(monitored.lastRestart) < monitored.restartPolicy.GetBackoff() {
+		// too early to restart it again, it will be retried on the next check
+		return false
+	}
+	monitored.restartCount += 1
+	monitored.lastRestart = time.Now()
+	monitored.consecutiveFailures = 0
+	monitored.state = HealthState_Unknown
+	return true
+}
+
+func (monitor *ServiceHealthMonitor) unregisterIfStillMonitored(serviceName service.ServiceName, monitored *monitoredService) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	if monitor.monitoredServices[serviceName] != monitored {
+		return
+	}
+	monitor.unregisterServiceUnlocked(serviceName)
+	if err := monitor.registrationRepository.Delete(serviceName); err != nil {
+		logrus.Warnf("Service '%s' doesn't exist anymore but its persisted health monitoring couldn't be deleted:\n%v", serviceName, err)
+	}
+}
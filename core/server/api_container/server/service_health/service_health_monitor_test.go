@@ -0,0 +1,295 @@
+package service_health
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+const (
+	testServiceName = service.ServiceName("test-service")
+
+	// long enough for the monitoring goroutine to never tick during a test, checks are triggered manually
+	testInterval = time.Hour
+
+	testLivenessCheckDefinition = "LivenessCheck(recipe=ExecRecipe(command=[\"true\"]))"
+)
+
+type fakeServiceController struct {
+	serviceStatus   service.ServiceStatus
+	containerStatus container.ContainerStatus
+	// exit code of the stopped container, nil if unknown
+	exitCode *int32
+	exists   bool
+
+	stopCount  int
+	startCount int
+}
+
+func newFakeServiceController() *fakeServiceController {
+	return &fakeServiceController{
+		serviceStatus:   service.ServiceStatus_Started,
+		containerStatus: container.ContainerStatus_Running,
+		exitCode:        nil,
+		exists:          true,
+		stopCount:       0,
+		startCount:      0,
+	}
+}
+
+func (controller *fakeServiceController) GetService(_ context.Context, serviceIdentifier string) (*service.Service, error) {
+	if !controller.exists {
+		return nil, stacktrace.NewError("Service '%s' does not exist", serviceIdentifier)
+	}
+	registration := service.NewServiceRegistration(service.ServiceName(serviceIdentifier), "test-uuid", "test-enclave", net.IP{}, "")
+	registration.SetStatus(controller.serviceStatus)
+	serviceContainer := container.NewContainer(controller.containerStatus, "test-image", nil, nil, nil)
+	if controller.containerStatus == container.ContainerStatus_Stopped && controller.exitCode != nil {
+		serviceContainer = container.NewExitedContainer("test-image", nil, nil, nil, *controller.exitCode)
+	}
+	return service.NewService(registration, nil, nil, nil, serviceContainer), nil
+}
+
+func (controller *fakeServiceController) ExistServiceRegistration(_ service.ServiceName) (bool, error) {
+	return controller.exists, nil
+}
+
+func (controller *fakeServiceController) StopService(_ context.Context, _ string) error {
+	controller.stopCount += 1
+	controller.containerStatus = container.ContainerStatus_Stopped
+	return nil
+}
+
+func (controller *fakeServiceController) StartService(_ context.Context, _ string) error {
+	controller.startCount += 1
+	controller.containerStatus = container.ContainerStatus_Running
+	controller.exitCode = nil
+	return nil
+}
+
+func newServiceHealthMonitorForTest(t *testing.T, controller *fakeServiceController) *ServiceHealthMonitor {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.Remove(file.Name()))
+	})
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	monitor, err := NewServiceHealthMonitor(controller, &enclave_db.EnclaveDB{DB: db})
+	require.NoError(t, err)
+	return monitor
+}
+
+func newLivenessCheckForTest(result *error, failureThreshold uint32) *LivenessCheck {
+	return NewLivenessCheck(func(ctx context.Context) error {
+		return *result
+	}, testInterval, failureThreshold)
+}
+
+func TestCheckService_HealthyService(t *testing.T) {
+	controller := newFakeServiceController()
+	monitor := newServiceHealthMonitorForTest(t, controller)
+	defer monitor.Close()
+
+	var livenessResult error
+	require.NoError(t, monitor.RegisterService(testServiceName, newLivenessCheckForTest(&livenessResult, 1), testLivenessCheckDefinition, NewRestartPolicy(RestartPolicyMode_OnFailure, UnlimitedRestarts, 0)))
+
+	status, found := monitor.GetServiceHealthStatus(testServiceName)
+	require.True(t, found)
+	require.Equal(t, HealthState_Unknown, status.GetState())
+
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	status, _ = monitor.GetServiceHealthStatus(testServiceName)
+	require.Equal(t, HealthState_Healthy, status.GetState())
+	require.Equal(t, uint32(0), status.GetRestartCount())
+	require.Equal(t, 0, controller.startCount)
+}
+
+func TestCheckService_RestartsAfterFailureThreshold(t *testing.T) {
+	controller := newFakeServiceController()
+	monitor := newServiceHealthMonitorForTest(t, controller)
+	defer monitor.Close()
+
+	livenessResult := stacktrace.NewError("not alive")
+	require.NoError(t, monitor.RegisterService(testServiceName, newLivenessCheckForTest(&livenessResult, 2), testLivenessCheckDefinition, NewRestartPolicy(RestartPolicyMode_OnFailure, UnlimitedRestarts, 0)))
+
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	status, _ := monitor.GetServiceHealthStatus(testServiceName)
+	require.Equal(t, HealthState_Unknown, status.GetState())
+	require.Equal(t, 0, controller.startCount)
+
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	status, _ = monitor.GetServiceHealthStatus(testServiceName)
+	require.Equal(t, uint32(1), status.GetRestartCount())
+	require.Equal(t, 1, controller.stopCount)
+	require.Equal(t, 1, controller.startCount)
+}
+
+func TestCheckService_NeverPolicyOnlyReportsHealth(t *testing.T) {
+	controller := newFakeServiceController()
+	monitor := newServiceHealthMonitorForTest(t, controller)
+	defer monitor.Close()
+
+	livenessResult := stacktrace.NewError("not alive")
+	require.NoError(t, monitor.RegisterService(testServiceName, newLivenessCheckForTest(&livenessResult, 1), testLivenessCheckDefinition, nil))
+
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	status, _ := monitor.GetServiceHealthStatus(testServiceName)
+	require.Equal(t, HealthState_Unhealthy, status.GetState())
+	require.Equal(t, uint32(0), status.GetRestartCount())
+	require.Equal(t, 0, controller.startCount)
+}
+
+func TestCheckService_ContainerExitWithSuccessOnlyRestartedWithAlwaysPolicy(t *testing.T) {
+	controller := newFakeServiceController()
+	controller.containerStatus = container.ContainerStatus_Stopped
+	successExitCode := int32(0)
+	controller.exitCode = &successExitCode
+	monitor := newServiceHealthMonitorForTest(t, controller)
+	defer monitor.Close()
+
+	require.NoError(t, monitor.RegisterService(testServiceName, nil, "", NewRestartPolicy(RestartPolicyMode_OnFailure, UnlimitedRestarts, 0)))
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	status, _ := monitor.GetServiceHealthStatus(testServiceName)
+	require.Equal(t, HealthState_Unhealthy, status.GetState())
+	require.Equal(t, 0, controller.startCount)
+
+	require.NoError(t, monitor.RegisterService(testServiceName, nil, "", NewRestartPolicy(RestartPolicyMode_Always, UnlimitedRestarts, 0)))
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	status, _ = monitor.GetServiceHealthStatus(testServiceName)
+	require.Equal(t, uint32(1), status.GetRestartCount())
+	require.Equal(t, 1, controller.startCount)
+}
+
+func TestCheckService_ContainerExitWithFailureRestartedWithOnFailurePolicy(t *testing.T) {
+	controller := newFakeServiceController()
+	controller.containerStatus = container.ContainerStatus_Stopped
+	failureExitCode := int32(1)
+	controller.exitCode = &failureExitCode
+	monitor := newServiceHealthMonitorForTest(t, controller)
+	defer monitor.Close()
+
+	require.NoError(t, monitor.RegisterService(testServiceName, nil, "", NewNeverRestartPolicy()))
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	require.Equal(t, 0, controller.startCount)
+
+	require.NoError(t, monitor.RegisterService(testServiceName, nil, "", NewRestartPolicy(RestartPolicyMode_OnFailure, UnlimitedRestarts, 0)))
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	status, _ := monitor.GetServiceHealthStatus(testServiceName)
+	require.Equal(t, uint32(1), status.GetRestartCount())
+	require.Equal(t, 1, controller.startCount)
+}
+
+func TestCheckService_ContainerExitWithUnknownExitCodeNotRestartedWithOnFailurePolicy(t *testing.T) {
+	controller := newFakeServiceController()
+	controller.containerStatus = container.ContainerStatus_Stopped
+	monitor := newServiceHealthMonitorForTest(t, controller)
+	defer monitor.Close()
+
+	require.NoError(t, monitor.RegisterService(testServiceName, nil, "", NewRestartPolicy(RestartPolicyMode_OnFailure, UnlimitedRestarts, 0)))
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	require.Equal(t, 0, controller.startCount)
+}
+
+func TestCheckService_StoppedServiceIsNotRestarted(t *testing.T) {
+	controller := newFakeServiceController()
+	controller.serviceStatus = service.ServiceStatus_Stopped
+	controller.containerStatus = container.ContainerStatus_Stopped
+	monitor := newServiceHealthMonitorForTest(t, controller)
+	defer monitor.Close()
+
+	require.NoError(t, monitor.RegisterService(testServiceName, nil, "", NewRestartPolicy(RestartPolicyMode_Always, UnlimitedRestarts, 0)))
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	require.Equal(t, 0, controller.startCount)
+}
+
+func TestCheckService_MaxRetriesAndBackoffAreRespected(t *testing.T) {
+	controller := newFakeServiceController()
+	monitor := newServiceHealthMonitorForTest(t, controller)
+	defer monitor.Close()
+
+	livenessResult := stacktrace.NewError("not alive")
+	require.NoError(t, monitor.RegisterService(testServiceName, newLivenessCheckForTest(&livenessResult, 1), testLivenessCheckDefinition, NewRestartPolicy(RestartPolicyMode_OnFailure, 2, 0)))
+	for i := 0; i < 5; i++ {
+		require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	}
+	status, _ := monitor.GetServiceHealthStatus(testServiceName)
+	require.Equal(t, uint32(2), status.GetRestartCount())
+	require.Equal(t, HealthState_Unhealthy, status.GetState())
+	require.Equal(t, 2, controller.startCount)
+
+	require.NoError(t, monitor.RegisterService(testServiceName, newLivenessCheckForTest(&livenessResult, 1), testLivenessCheckDefinition, NewRestartPolicy(RestartPolicyMode_OnFailure, UnlimitedRestarts, time.Hour)))
+	for i := 0; i < 5; i++ {
+		require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	}
+	status, _ = monitor.GetServiceHealthStatus(testServiceName)
+	require.Equal(t, uint32(1), status.GetRestartCount())
+}
+
+func TestCheckService_RemovedServiceIsUnregistered(t *testing.T) {
+	controller := newFakeServiceController()
+	monitor := newServiceHealthMonitorForTest(t, controller)
+	defer monitor.Close()
+
+	require.NoError(t, monitor.RegisterService(testServiceName, nil, "", NewRestartPolicy(RestartPolicyMode_Always, UnlimitedRestarts, 0)))
+	controller.exists = false
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	_, found := monitor.GetServiceHealthStatus(testServiceName)
+	require.False(t, found)
+}
+
+func TestRegisterService_NothingToMonitor(t *testing.T) {
+	monitor := newServiceHealthMonitorForTest(t, newFakeServiceController())
+	defer monitor.Close()
+
+	require.NoError(t, monitor.RegisterService(testServiceName, nil, "", NewRestartPolicy(RestartPolicyMode_Always, UnlimitedRestarts, 0)))
+	require.NoError(t, monitor.RegisterService(testServiceName, nil, "", nil))
+	_, found := monitor.GetServiceHealthStatus(testServiceName)
+	require.False(t, found)
+}
+
+func TestRegisterService_RegistrationIsPersisted(t *testing.T) {
+	controller := newFakeServiceController()
+	monitor := newServiceHealthMonitorForTest(t, controller)
+
+	var livenessResult error
+	require.NoError(t, monitor.RegisterService(testServiceName, newLivenessCheckForTest(&livenessResult, 1), testLivenessCheckDefinition, NewRestartPolicy(RestartPolicyMode_Always, 3, time.Minute)))
+	monitor.Close()
+
+	registrations, err := monitor.GetPersistedRegistrations()
+	require.NoError(t, err)
+	require.Len(t, registrations, 1)
+	registration, found := registrations[testServiceName]
+	require.True(t, found)
+	require.Equal(t, testLivenessCheckDefinition, registration.LivenessCheckDefinition)
+	require.Equal(t, NewRestartPolicy(RestartPolicyMode_Always, 3, time.Minute), registration.GetRestartPolicy())
+
+	require.NoError(t, monitor.UnregisterService(testServiceName))
+	registrations, err = monitor.GetPersistedRegistrations()
+	require.NoError(t, err)
+	require.Empty(t, registrations)
+}
+
+func TestCheckService_RemovedServiceRegistrationIsNotPersisted(t *testing.T) {
+	controller := newFakeServiceController()
+	monitor := newServiceHealthMonitorForTest(t, controller)
+	defer monitor.Close()
+
+	require.NoError(t, monitor.RegisterService(testServiceName, nil, "", NewRestartPolicy(RestartPolicyMode_Always, UnlimitedRestarts, 0)))
+	controller.exists = false
+	require.NoError(t, monitor.checkService(context.Background(), testServiceName))
+	registrations, err := monitor.GetPersistedRegistrations()
+	require.NoError(t, err)
+	require.Empty(t, registrations)
+}
@@ -0,0 +1,106 @@
+package service_health
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"time"
+)
+
+var (
+	serviceRegistrationsBucketName = []byte("service-health-registrations-repository")
+)
+
+// PersistedServiceRegistration is the persisted form of the monitoring of a service, from which the monitoring is
+// restored when the API container restarts. The liveness check is a function, so it is persisted as the serialized
+// definition it was built from, which is opaque to the monitor
+type PersistedServiceRegistration struct {
+	// empty if the service has no liveness check
+	LivenessCheckDefinition string `json:"livenessCheckDefinition"`
+
+	RestartPolicyMode       RestartPolicyMode `json:"restartPolicyMode"`
+	RestartPolicyMaxRetries uint32            `json:"restartPolicyMaxRetries"`
+	RestartPolicyBackoff    time.Duration     `json:"restartPolicyBackoff"`
+}
+
+func (registration *PersistedServiceRegistration) GetRestartPolicy() *RestartPolicy {
+	return NewRestartPolicy(registration.RestartPolicyMode, registration.RestartPolicyMaxRetries, registration.RestartPolicyBackoff)
+}
+
+type serviceRegistrationRepository struct {
+	enclaveDb *enclave_db.EnclaveDB
+}
+
+func getOrCreateNewServiceRegistrationRepository(enclaveDb *enclave_db.EnclaveDB) (*serviceRegistrationRepository, error) {
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(serviceRegistrationsBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the service health registrations database bucket")
+		}
+		logrus.Debugf("Service health registrations bucket: '%+v'", bucket)
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the service health registrations repository")
+	}
+
+	return &serviceRegistrationRepository{
+		enclaveDb: enclaveDb,
+	}, nil
+}
+
+func (repository *serviceRegistrationRepository) Save(serviceName service.ServiceName, registration *PersistedServiceRegistration) error {
+	serializedRegistration, err := json.Marshal(registration)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the health registration of service '%s'", serviceName)
+	}
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceRegistrationsBucketName)
+		if err := bucket.Put([]byte(serviceName), serializedRegistration); err != nil {
+			return stacktrace.Propagate(err, "An error occurred saving the health registration of service '%s' into the enclave db bucket", serviceName)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the health registration of service '%s' into the enclave db", serviceName)
+	}
+	return nil
+}
+
+func (repository *serviceRegistrationRepository) Delete(serviceName service.ServiceName) error {
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceRegistrationsBucketName)
+		if err := bucket.Delete([]byte(serviceName)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred deleting the health registration of service '%s' from the enclave db bucket", serviceName)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred deleting the health registration of service '%s' from the enclave db", serviceName)
+	}
+	return nil
+}
+
+func (repository *serviceRegistrationRepository) GetAll() (map[service.ServiceName]*PersistedServiceRegistration, error) {
+	registrations := map[service.ServiceName]*PersistedServiceRegistration{}
+	if err := repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceRegistrationsBucketName)
+		return bucket.ForEach(func(serviceNameKey []byte, serializedRegistration []byte) error {
+			registration := &PersistedServiceRegistration{
+				LivenessCheckDefinition: "",
+				RestartPolicyMode:       "",
+				RestartPolicyMaxRetries: 0,
+				RestartPolicyBackoff:    0,
+			}
+			if err := json.Unmarshal(serializedRegistration, registration); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deserializing the health registration of service '%s'", string(serviceNameKey))
+			}
+			registrations[service.ServiceName(serviceNameKey)] = registration
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service health registrations from the enclave db")
+	}
+	return registrations, nil
+}
@@ -11,10 +11,13 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/traffic_control"
 	"io"
 	"net"
 	"net/http"
@@ -29,6 +32,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
@@ -58,6 +62,8 @@ const (
 	serviceLogsFooter = "== FINISHED SERVICE '%s' LOGS ==================================="
 
 	scanPortTimeout = 200 * time.Millisecond
+
+	tcCommandSuccessExitCode = 0
 )
 
 type storeFilesArtifactResult struct {
@@ -82,6 +88,9 @@ type DefaultServiceNetwork struct {
 
 	// This contains all service identifiers ever successfully created
 	serviceIdentifiersRepository *service_identifiers.ServiceIdentifiersRepository
+
+	// This contains the connection config between the services, applied to the services through networking sidecars
+	partitionTopologyRepository *partition_topology.PartitionTopologyRepository
 }
 
 func NewDefaultServiceNetwork(
@@ -99,6 +108,10 @@ func NewDefaultServiceNetwork(
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the service registration repository")
 	}
+	partitionTopologyRepository, err := partition_topology.GetOrCreateNewPartitionTopologyRepository(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the partition topology repository")
+	}
 
 	return &DefaultServiceNetwork{
 		enclaveUuid:      enclaveUuid,
@@ -110,6 +123,7 @@ func NewDefaultServiceNetwork(
 
 		serviceRegistrationRepository: serviceRegistrationRepository,
 		serviceIdentifiersRepository:  serviceIdentifiersRepository,
+		partitionTopologyRepository:   partitionTopologyRepository,
 	}, nil
 }
 
@@ -381,6 +395,14 @@ func (network *DefaultServiceNetwork) UpdateServices(ctx context.Context, update
 		}
 		successfullyUpdatedService[serviceName] = newServiceObj
 	}
+
+	// The networking sidecars were attached to the containers that got removed, so they need to be recreated
+	for serviceName := range successfullyUpdatedService {
+		if err := network.refreshNetworkingSidecarUnlocked(ctx, serviceName); err != nil {
+			failedServicesPool[serviceName] = stacktrace.Propagate(err, "An error occurred applying the connection configs of service '%s' after the service was updated", serviceName)
+			delete(successfullyUpdatedService, serviceName)
+		}
+	}
 	return successfullyUpdatedService, failedServicesPool, nil
 }
 
@@ -418,10 +440,28 @@ func (network *DefaultServiceNetwork) RemoveService(
 		return "", stacktrace.Propagate(err, "An error occurred stopping service '%v'", serviceUuid)
 	}
 
+	hasConnections, err := network.hasConnectionsUnlocked(serviceName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred checking whether service '%v' has connection configs", serviceName)
+	}
+	if hasConnections {
+		if err := network.destroyNetworkingSidecarUnlocked(ctx, serviceUuid); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred destroying the networking sidecar of service '%v'", serviceName)
+		}
+	}
+
 	if err := network.serviceRegistrationRepository.Delete(serviceName); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred deleting the service registration for service '%v' from the repository", serviceName)
 	}
 
+	formerPeers, err := network.partitionTopologyRepository.RemoveService(serviceName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred removing the connections of service '%v' from the partition topology", serviceName)
+	}
+	if err := network.applyConnectionsUnlocked(ctx, formerPeers); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred applying the connection configs of the services that were connected to removed service '%v'", serviceName)
+	}
+
 	return serviceUuid, nil
 }
 
@@ -453,9 +493,6 @@ func (network *DefaultServiceNetwork) StartServices(
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
 
-	successfulUuids := map[service.ServiceUUID]bool{}
-	erroredUuids := map[service.ServiceUUID]error{}
-	serviceConfigs := map[service.ServiceUUID]*service.ServiceConfig{}
 	serviceRegistrations := map[service.ServiceUUID]*service.ServiceRegistration{}
 
 	for _, serviceIdentifier := range serviceIdentifiers {
@@ -469,6 +506,83 @@ func (network *DefaultServiceNetwork) StartServices(
 		serviceRegistrations[serviceRegistration.GetUUID()] = serviceRegistration
 	}
 
+	return network.startServicesUnlocked(ctx, serviceRegistrations)
+}
+
+// ResumeServices restarts the given services, which are still registered as started but whose containers stopped
+// along with the enclave. It's meant to be called by the enclave resumer only, once the API container came back up.
+// The services that can't be resumed are registered as started again, so that resuming the enclave again retries them
+func (network *DefaultServiceNetwork) ResumeServices(
+	ctx context.Context,
+	serviceNames []service.ServiceName,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistrations := map[service.ServiceUUID]*service.ServiceRegistration{}
+	for _, serviceName := range serviceNames {
+		serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(string(serviceName))
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred while getting service registration for service '%v'", serviceName)
+		}
+		if serviceRegistration.GetStatus() != service.ServiceStatus_Started {
+			return nil, nil, stacktrace.NewError("Service '%v' can't be resumed as it was not running when the enclave stopped", serviceName)
+		}
+		serviceRegistrations[serviceRegistration.GetUUID()] = serviceRegistration
+	}
+
+	// The containers of the services are stopped, so flagging them as such makes the backend restart them in place,
+	// keeping their IP addresses. They are flagged as started again once their container is running
+	flaggedServiceNames := []service.ServiceName{}
+	for _, serviceRegistration := range serviceRegistrations {
+		serviceName := serviceRegistration.GetName()
+		if err := network.serviceRegistrationRepository.UpdateStatus(serviceName, service.ServiceStatus_Stopped); err != nil {
+			network.restoreStartedStatusUnlocked(flaggedServiceNames)
+			return nil, nil, stacktrace.Propagate(err, "An error occurred while updating the status of service '%v' before resuming it", serviceName)
+		}
+		serviceRegistration.SetStatus(service.ServiceStatus_Stopped)
+		flaggedServiceNames = append(flaggedServiceNames, serviceName)
+	}
+
+	successfulUuids, erroredUuids, err := network.startServicesUnlocked(ctx, serviceRegistrations)
+	if err != nil {
+		network.restoreStartedStatusUnlocked(flaggedServiceNames)
+		return nil, nil, stacktrace.Propagate(err, "An error occurred resuming services '%v'", serviceNames)
+	}
+	erroredServiceNames := []service.ServiceName{}
+	for erroredUuid := range erroredUuids {
+		erroredServiceNames = append(erroredServiceNames, serviceRegistrations[erroredUuid].GetName())
+	}
+	network.restoreStartedStatusUnlocked(erroredServiceNames)
+	return successfulUuids, erroredUuids, nil
+}
+
+// restoreStartedStatusUnlocked registers the given services, which were flagged as stopped to be resumed, as started
+// again. This should be called from a thread safe context
+func (network *DefaultServiceNetwork) restoreStartedStatusUnlocked(serviceNames []service.ServiceName) {
+	for _, serviceName := range serviceNames {
+		if err := network.serviceRegistrationRepository.UpdateStatus(serviceName, service.ServiceStatus_Started); err != nil {
+			logrus.Errorf("An error occurred registering service '%v' as started again after failing to resume it. It won't be resumed the next time the enclave is started. Error was:\n%v", serviceName, err)
+		}
+	}
+}
+
+// this should be called from a thread safe context
+func (network *DefaultServiceNetwork) startServicesUnlocked(
+	ctx context.Context,
+	serviceRegistrations map[service.ServiceUUID]*service.ServiceRegistration,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	successfulUuids := map[service.ServiceUUID]bool{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	serviceConfigs := map[service.ServiceUUID]*service.ServiceConfig{}
 	for serviceUuid, serviceRegistration := range serviceRegistrations {
 		serviceConfigs[serviceUuid] = serviceRegistration.GetConfig()
 	}
@@ -485,6 +599,10 @@ func (network *DefaultServiceNetwork) StartServices(
 			failedServices[successfulUuid] = stacktrace.Propagate(err, "An error occurred while updating status to '%v' for service '%v' after it was successfully started", serviceStatus, serviceName)
 			continue
 		}
+		if err := network.refreshNetworkingSidecarUnlocked(ctx, serviceName); err != nil {
+			failedServices[successfulUuid] = stacktrace.Propagate(err, "An error occurred applying the connection configs of service '%v' after it was successfully started", serviceName)
+			continue
+		}
 		successfulUuids[successfulUuid] = true
 	}
 
@@ -566,6 +684,55 @@ func (network *DefaultServiceNetwork) StopServices(
 	return successfulUuids, erroredUuids, nil
 }
 
+func (network *DefaultServiceNetwork) SetConnection(
+	ctx context.Context,
+	servicesA map[service.ServiceName]bool,
+	servicesB map[service.ServiceName]bool,
+	connectionConfig partition_topology.ConnectionConfig,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	for serviceA := range servicesA {
+		for serviceB := range servicesB {
+			if err := network.partitionTopologyRepository.SetConnection(serviceA, serviceB, connectionConfig); err != nil {
+				return stacktrace.Propagate(err, "An error occurred setting the connection between service '%s' and service '%s'", serviceA, serviceB)
+			}
+		}
+	}
+	if err := network.applyConnectionsUnlocked(ctx, mergeServiceNameSets(servicesA, servicesB)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying the updated connection configs to the services")
+	}
+	return nil
+}
+
+func (network *DefaultServiceNetwork) UpdateConnection(
+	ctx context.Context,
+	servicesA map[service.ServiceName]bool,
+	servicesB map[service.ServiceName]bool,
+	connectionConfigOverrides *partition_topology.ConnectionConfigOverrides,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	for serviceA := range servicesA {
+		for serviceB := range servicesB {
+			currentConnectionConfig, err := network.partitionTopologyRepository.GetConnection(serviceA, serviceB)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the connection between service '%s' and service '%s'", serviceA, serviceB)
+			}
+			updatedConnectionConfig := connectionConfigOverrides.ApplyTo(currentConnectionConfig)
+			if err := network.partitionTopologyRepository.SetConnection(serviceA, serviceB, updatedConnectionConfig); err != nil {
+				return stacktrace.Propagate(err, "An error occurred setting the connection between service '%s' and service '%s'", serviceA, serviceB)
+			}
+		}
+	}
+	if err := network.applyConnectionsUnlocked(ctx, mergeServiceNameSets(servicesA, servicesB)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying the updated connection configs to the services")
+	}
+	return nil
+}
+
 func (network *DefaultServiceNetwork) RunExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error) {
 	// NOTE: This will block all other operations while this command is running!!!! We might need to change this so it's
 	// asynchronous
@@ -763,6 +930,82 @@ func (network *DefaultServiceNetwork) CopyFilesFromService(ctx context.Context,
 	return filesArtifactUuid, nil
 }
 
+func (network *DefaultServiceNetwork) CopyFilesToService(ctx context.Context, serviceIdentifier string, dstDirpath string, tgzContent io.Reader) error {
+	if !path.IsAbs(dstDirpath) {
+		return stacktrace.NewError("Cannot copy files to relative path '%v', the path to copy files to must be absolute", dstDirpath)
+	}
+	serviceUuid, err := network.getRunningServiceUuidForIdentifier(serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the running service matching identifier '%v'", serviceIdentifier)
+	}
+
+	tarContent, err := gzip.NewReader(tgzContent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred decompressing the files to copy to service '%v'", serviceIdentifier)
+	}
+	defer tarContent.Close()
+
+	if err := network.kurtosisBackend.CopyFilesToUserService(ctx, network.enclaveUuid, serviceUuid, dstDirpath, tarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying files to destination '%v' of user service with UUID '%v' in enclave with UUID '%v'", dstDirpath, serviceUuid, network.enclaveUuid)
+	}
+	return nil
+}
+
+func (network *DefaultServiceNetwork) WriteFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, output io.Writer) error {
+	serviceUuid, err := network.getRunningServiceUuidForIdentifier(serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the running service matching identifier '%v'", serviceIdentifier)
+	}
+
+	gzippingOutput := gzip.NewWriter(output)
+	if err := network.kurtosisBackend.CopyFilesFromUserService(ctx, network.enclaveUuid, serviceUuid, srcPath, gzippingOutput); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying source '%v' from user service with UUID '%v' in enclave with UUID '%v'", srcPath, serviceUuid, network.enclaveUuid)
+	}
+	if err := gzippingOutput.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred compressing the files copied from source '%v' of user service with UUID '%v'", srcPath, serviceUuid)
+	}
+	return nil
+}
+
+func (network *DefaultServiceNetwork) GetServicesStats(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceName]*service_stats.ServiceStats, map[service.ServiceName]error, error) {
+	serviceNamesByUuid, err := network.getRunningServiceNamesByUuid(serviceIdentifiers)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the running services matching identifiers '%v'", serviceIdentifiers)
+	}
+	servicesStats := map[service.ServiceName]*service_stats.ServiceStats{}
+	servicesErrors := map[service.ServiceName]error{}
+	if len(serviceNamesByUuid) == 0 {
+		return servicesStats, servicesErrors, nil
+	}
+
+	serviceUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range serviceNamesByUuid {
+		serviceUuids[serviceUuid] = true
+	}
+	filters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    serviceUuids,
+		Statuses: nil,
+	}
+	successfulServicesStats, erroredServices, err := network.kurtosisBackend.GetUserServicesStats(ctx, network.enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the stats of user services with UUIDs '%v' in enclave with UUID '%v'", serviceUuids, network.enclaveUuid)
+	}
+	// a service can get stopped while its stats are being sampled, which mustn't fail getting the stats of the others
+	for serviceUuid, serviceName := range serviceNamesByUuid {
+		if serviceStats, found := successfulServicesStats[serviceUuid]; found {
+			servicesStats[serviceName] = serviceStats
+			continue
+		}
+		if serviceErr, found := erroredServices[serviceUuid]; found {
+			servicesErrors[serviceName] = stacktrace.Propagate(serviceErr, "An error occurred getting the stats of service '%v'", serviceName)
+			continue
+		}
+		servicesErrors[serviceName] = stacktrace.NewError("The backend returned no stats for service '%v'; it probably stopped while its stats were being sampled", serviceName)
+	}
+	return servicesStats, servicesErrors, nil
+}
+
 func (network *DefaultServiceNetwork) ExistServiceRegistration(serviceName service.ServiceName) (bool, error) {
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
@@ -820,6 +1063,48 @@ func (network *DefaultServiceNetwork) UpdateFilesArtifact(fileArtifactUuid encla
 	return nil
 }
 
+// RemoveFilesArtifact removes the files artifact from the store, refusing to do so if a running service mounts it
+func (network *DefaultServiceNetwork) RemoveFilesArtifact(artifactIdentifier string) (enclave_data_directory.FilesArtifactUUID, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	filesArtifactStore, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while getting files artifact store")
+	}
+	filesArtifactUuid, _, _, found, err := filesArtifactStore.GetFile(artifactIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred retrieving files artifact '%v' from the store", artifactIdentifier)
+	}
+	if !found {
+		return "", stacktrace.NewError("No files artifact with identifier '%v' exists in the enclave", artifactIdentifier)
+	}
+
+	registeredServices, err := network.serviceRegistrationRepository.GetAll()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting registered services from the repository")
+	}
+	for serviceName, registration := range registeredServices {
+		if registration.GetStatus() != service.ServiceStatus_Started || registration.GetConfig() == nil || registration.GetConfig().GetFilesArtifactsExpansion() == nil {
+			continue
+		}
+		for mountDirpath, mountedArtifactIdentifier := range registration.GetConfig().GetFilesArtifactsExpansion().ServiceDirpathsToArtifactIdentifiers {
+			mountedFilesArtifactUuid, _, _, mountedFound, err := filesArtifactStore.GetFile(mountedArtifactIdentifier)
+			if err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred retrieving files artifact '%v' mounted by service '%v' from the store", mountedArtifactIdentifier, serviceName)
+			}
+			if mountedFound && mountedFilesArtifactUuid == filesArtifactUuid {
+				return "", stacktrace.NewError("Files artifact '%v' can't be removed as it is mounted at '%v' by running service '%v'; stop or remove the service first", artifactIdentifier, mountDirpath, serviceName)
+			}
+		}
+	}
+
+	if err = filesArtifactStore.RemoveFile(string(filesArtifactUuid)); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred removing files artifact '%v' from the store", artifactIdentifier)
+	}
+	return filesArtifactUuid, nil
+}
+
 func (network *DefaultServiceNetwork) GetExistingAndHistoricalServiceIdentifiers() (service_identifiers.ServiceIdentifiers, error) {
 	serviceIdentifiers, err := network.serviceIdentifiersRepository.GetServiceIdentifiers()
 	if err != nil {
@@ -1064,6 +1349,144 @@ func (network *DefaultServiceNetwork) destroyService(ctx context.Context, servic
 		}
 	}
 
+	hasConnections, err := network.hasConnectionsUnlocked(serviceName)
+	if err != nil {
+		return stacktrace.Propagate(err, "Service '%s' was successfully destroyed but it could not be determined whether it has a networking sidecar. You must manually destroy the sidecar if any", serviceUuid)
+	}
+	if hasConnections {
+		if err := network.destroyNetworkingSidecarUnlocked(ctx, serviceUuid); err != nil {
+			return stacktrace.Propagate(err, "Service '%s' was successfully destroyed but its networking sidecar could not be destroyed. You must manually destroy the sidecar", serviceUuid)
+		}
+	}
+	return nil
+}
+
+// applyConnectionsUnlocked configures the networking sidecar of each of the services so that their traffic matches
+// the connection configs currently stored in the partition topology. A started service has a networking sidecar if and
+// only if it has at least one non-default connection, so sidecars are created and destroyed here as needed
+func (network *DefaultServiceNetwork) applyConnectionsUnlocked(ctx context.Context, serviceNames map[service.ServiceName]bool) error {
+	sidecarCommands := map[service.ServiceUUID][]string{}
+	servicesWithoutConnections := map[service.ServiceUUID]bool{}
+	for serviceName := range serviceNames {
+		serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the service registration for service '%s'", serviceName)
+		}
+		serviceUuid := serviceRegistration.GetUUID()
+		serviceConnections, err := network.partitionTopologyRepository.GetServiceConnections(serviceName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the connections of service '%s'", serviceName)
+		}
+		if len(serviceConnections) == 0 {
+			servicesWithoutConnections[serviceUuid] = true
+			continue
+		}
+		if serviceRegistration.GetStatus() != service.ServiceStatus_Started {
+			// the connection configs will be applied when the service gets started
+			continue
+		}
+		connectionConfigsByPeerIp := map[string]partition_topology.ConnectionConfig{}
+		for peerServiceName, connectionConfig := range serviceConnections {
+			peerServiceRegistration, err := network.serviceRegistrationRepository.Get(peerServiceName)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the service registration for service '%s'", peerServiceName)
+			}
+			connectionConfigsByPeerIp[peerServiceRegistration.GetPrivateIP().String()] = connectionConfig
+		}
+		sidecarCommands[serviceUuid] = traffic_control.GenerateTcCommand(connectionConfigsByPeerIp)
+	}
+
+	for serviceUuid := range servicesWithoutConnections {
+		if err := network.destroyNetworkingSidecarUnlocked(ctx, serviceUuid); err != nil {
+			return stacktrace.Propagate(err, "An error occurred destroying the networking sidecar of service '%s' which doesn't have any connection config to apply anymore", serviceUuid)
+		}
+	}
+	if len(sidecarCommands) == 0 {
+		return nil
+	}
+
+	sidecarFilters := &networking_sidecar.NetworkingSidecarFilters{
+		UserServiceUUIDs: map[service.ServiceUUID]bool{},
+		Statuses:         nil,
+	}
+	for serviceUuid := range sidecarCommands {
+		sidecarFilters.UserServiceUUIDs[serviceUuid] = true
+	}
+	existingSidecars, err := network.kurtosisBackend.GetNetworkingSidecars(ctx, network.enclaveUuid, sidecarFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the networking sidecars of the services")
+	}
+	for serviceUuid := range sidecarCommands {
+		if _, found := existingSidecars[serviceUuid]; found {
+			continue
+		}
+		if _, err := network.kurtosisBackend.CreateNetworkingSidecar(ctx, network.enclaveUuid, serviceUuid); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the networking sidecar for service '%s'", serviceUuid)
+		}
+	}
+
+	successfulExecResults, erroredExecs, err := network.kurtosisBackend.RunNetworkingSidecarExecCommands(ctx, network.enclaveUuid, sidecarCommands)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the traffic control commands in the networking sidecars")
+	}
+	for serviceUuid, execErr := range erroredExecs {
+		return stacktrace.Propagate(execErr, "An error occurred running the traffic control command in the networking sidecar of service '%s'", serviceUuid)
+	}
+	for serviceUuid, execResult := range successfulExecResults {
+		if execResult.GetExitCode() != tcCommandSuccessExitCode {
+			return stacktrace.NewError("The traffic control command '%v' run in the networking sidecar of service '%s' exited with code '%d' and output:\n%s",
+				sidecarCommands[serviceUuid], serviceUuid, execResult.GetExitCode(), execResult.GetOutput())
+		}
+	}
+	return nil
+}
+
+// refreshNetworkingSidecarUnlocked re-creates the networking sidecar of a service which container was just re-created,
+// as the previous sidecar was attached to the network of the former container. It's a no-op for services that don't
+// have any connection config
+func (network *DefaultServiceNetwork) refreshNetworkingSidecarUnlocked(ctx context.Context, serviceName service.ServiceName) error {
+	hasConnections, err := network.hasConnectionsUnlocked(serviceName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking whether service '%s' has connection configs", serviceName)
+	}
+	if !hasConnections {
+		return nil
+	}
+	serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service registration for service '%s'", serviceName)
+	}
+	if err := network.destroyNetworkingSidecarUnlocked(ctx, serviceRegistration.GetUUID()); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the networking sidecar of service '%s'", serviceName)
+	}
+	if err := network.applyConnectionsUnlocked(ctx, map[service.ServiceName]bool{serviceName: true}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying the connection configs of service '%s'", serviceName)
+	}
+	return nil
+}
+
+func (network *DefaultServiceNetwork) hasConnectionsUnlocked(serviceName service.ServiceName) (bool, error) {
+	serviceConnections, err := network.partitionTopologyRepository.GetServiceConnections(serviceName)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the connections of service '%s'", serviceName)
+	}
+	return len(serviceConnections) > 0, nil
+}
+
+func (network *DefaultServiceNetwork) destroyNetworkingSidecarUnlocked(ctx context.Context, serviceUuid service.ServiceUUID) error {
+	sidecarFilters := &networking_sidecar.NetworkingSidecarFilters{
+		UserServiceUUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
+	}
+	_, erroredSidecars, err := network.kurtosisBackend.DestroyNetworkingSidecars(ctx, network.enclaveUuid, sidecarFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the networking sidecar of service '%s'", serviceUuid)
+	}
+	if sidecarErr, found := erroredSidecars[serviceUuid]; found {
+		return stacktrace.Propagate(sidecarErr, "An error occurred destroying the networking sidecar of service '%s'", serviceUuid)
+	}
 	return nil
 }
 
@@ -1487,6 +1910,55 @@ func mergeAndGetAllPrivateAndPublicServicePorts(service *service.Service) map[st
 	return allPrivateAndPublicPorts
 }
 
+// getRunningServiceUuidForIdentifier only holds the lock while resolving the identifier, so that long-running
+// operations on the service don't block the network
+func (network *DefaultServiceNetwork) getRunningServiceUuidForIdentifier(serviceIdentifier string) (service.ServiceUUID, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(serviceIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while fetching registration for service identifier '%v'", serviceIdentifier)
+	}
+	if serviceRegistration.GetStatus() != service.ServiceStatus_Started {
+		return "", stacktrace.NewError("Service '%v' isn't running, its status is '%v'", serviceIdentifier, serviceRegistration.GetStatus())
+	}
+	return serviceRegistration.GetUUID(), nil
+}
+
+// getRunningServiceNamesByUuid resolves the identifiers to running services, or returns all the running services if
+// there's no identifier
+func (network *DefaultServiceNetwork) getRunningServiceNamesByUuid(serviceIdentifiers []string) (map[service.ServiceUUID]service.ServiceName, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
+	if len(serviceIdentifiers) == 0 {
+		serviceRegistrations, err := network.serviceRegistrationRepository.GetAll()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting registered services from the repository")
+		}
+		for serviceName, serviceRegistration := range serviceRegistrations {
+			if serviceRegistration.GetStatus() == service.ServiceStatus_Started {
+				serviceNamesByUuid[serviceRegistration.GetUUID()] = serviceName
+			}
+		}
+		return serviceNamesByUuid, nil
+	}
+
+	for _, serviceIdentifier := range serviceIdentifiers {
+		serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(serviceIdentifier)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while fetching registration for service identifier '%v'", serviceIdentifier)
+		}
+		if serviceRegistration.GetStatus() != service.ServiceStatus_Started {
+			return nil, stacktrace.NewError("Service '%v' isn't running, its status is '%v'", serviceIdentifier, serviceRegistration.GetStatus())
+		}
+		serviceNamesByUuid[serviceRegistration.GetUUID()] = serviceRegistration.GetName()
+	}
+	return serviceNamesByUuid, nil
+}
+
 // This isn't thread safe and must be called from a thread safe context
 func (network *DefaultServiceNetwork) getServiceRegistrationForIdentifierUnlocked(
 	serviceIdentifier string,
@@ -1503,3 +1975,13 @@ func (network *DefaultServiceNetwork) getServiceRegistrationForIdentifierUnlocke
 
 	return serviceRegistration, nil
 }
+
+func mergeServiceNameSets(serviceNameSets ...map[service.ServiceName]bool) map[service.ServiceName]bool {
+	mergedServiceNames := map[service.ServiceName]bool{}
+	for _, serviceNameSet := range serviceNameSets {
+		for serviceName := range serviceNameSet {
+			mergedServiceNames[serviceName] = true
+		}
+	}
+	return mergedServiceNames
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/pkg/errors"
@@ -850,6 +851,190 @@ func TestStartService_ServiceAlreadyStarted(t *testing.T) {
 	require.Equal(t, serviceRegistration.GetStatus(), service.ServiceStatus_Started)
 }
 
+func TestResumeServices_FailedServiceIsRegisteredAsStartedAgain(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	resumedServiceName := testServiceNameFromInt(1)
+	resumedServiceUuid := testServiceUuidFromInt(1)
+	resumedServiceIp := testIpFromInt(1)
+	resumedServiceRegistration := service.NewServiceRegistration(resumedServiceName, resumedServiceUuid, enclaveName, resumedServiceIp, string(resumedServiceName))
+	resumedServiceRegistration.SetStatus(service.ServiceStatus_Started)
+	resumedServiceConfig := testServiceConfig(t, testContainerImageName)
+	resumedServiceRegistration.SetConfig(resumedServiceConfig)
+	resumedServiceObj := service.NewService(resumedServiceRegistration, map[string]*port_spec.PortSpec{}, resumedServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+
+	failedServiceName := testServiceNameFromInt(2)
+	failedServiceUuid := testServiceUuidFromInt(2)
+	failedServiceRegistration := service.NewServiceRegistration(failedServiceName, failedServiceUuid, enclaveName, testIpFromInt(2), string(failedServiceName))
+	failedServiceRegistration.SetStatus(service.ServiceStatus_Started)
+	failedServiceConfig := testServiceConfig(t, testContainerImageName)
+	failedServiceRegistration.SetConfig(failedServiceConfig)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+	)
+	require.Nil(t, err)
+	require.NoError(t, network.serviceRegistrationRepository.Save(resumedServiceRegistration))
+	require.NoError(t, network.serviceRegistrationRepository.Save(failedServiceRegistration))
+
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceUUID]*service.ServiceConfig{
+			resumedServiceUuid: resumedServiceConfig,
+			failedServiceUuid:  failedServiceConfig,
+		},
+	).RunAndReturn(func(_ context.Context, _ enclave.EnclaveUUID, _ map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+		// the services are flagged as stopped for the backend to restart them in place
+		for _, serviceName := range []service.ServiceName{resumedServiceName, failedServiceName} {
+			serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+			require.NoError(t, err)
+			require.Equal(t, service.ServiceStatus_Stopped, serviceRegistration.GetStatus())
+		}
+		return map[service.ServiceUUID]*service.Service{
+				resumedServiceUuid: resumedServiceObj,
+			},
+			map[service.ServiceUUID]error{
+				failedServiceUuid: stacktrace.NewError("Failed starting service"),
+			},
+			nil
+	}).Times(1)
+
+	successfulUuids, erroredUuids, err := network.ResumeServices(ctx, []service.ServiceName{resumedServiceName, failedServiceName})
+	require.NoError(t, err)
+	require.Equal(t, map[service.ServiceUUID]bool{resumedServiceUuid: true}, successfulUuids)
+	require.Len(t, erroredUuids, 1)
+	require.Contains(t, erroredUuids, failedServiceUuid)
+
+	// both services are registered as started, so that resuming the enclave again retries the one that failed
+	for _, serviceName := range []service.ServiceName{resumedServiceName, failedServiceName} {
+		serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+		require.NoError(t, err)
+		require.Equal(t, service.ServiceStatus_Started, serviceRegistration.GetStatus())
+	}
+}
+
+func TestResumeServices_BackendErrorRegistersServicesAsStartedAgain(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceName := testServiceNameFromInt(1)
+	serviceUuid := testServiceUuidFromInt(1)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, testIpFromInt(1), string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Started)
+	serviceConfig := testServiceConfig(t, testContainerImageName)
+	serviceRegistration.SetConfig(serviceConfig)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+	)
+	require.Nil(t, err)
+	require.NoError(t, network.serviceRegistrationRepository.Save(serviceRegistration))
+
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceUUID]*service.ServiceConfig{
+			serviceUuid: serviceConfig,
+		},
+	).Times(1).Return(nil, nil, stacktrace.NewError("The backend is unreachable"))
+
+	_, _, err = network.ResumeServices(ctx, []service.ServiceName{serviceName})
+	require.Error(t, err)
+	serviceRegistrationAfterFailure, err := network.serviceRegistrationRepository.Get(serviceName)
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Started, serviceRegistrationAfterFailure.GetStatus())
+}
+
+func TestGetServicesStats_ServicesWithoutStatsAreReportedAsErrored(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	sampledServiceName := testServiceNameFromInt(1)
+	sampledServiceUuid := testServiceUuidFromInt(1)
+	erroredServiceName := testServiceNameFromInt(2)
+	erroredServiceUuid := testServiceUuidFromInt(2)
+	missingServiceName := testServiceNameFromInt(3)
+	missingServiceUuid := testServiceUuidFromInt(3)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+	)
+	require.Nil(t, err)
+	for i, serviceName := range []service.ServiceName{sampledServiceName, erroredServiceName, missingServiceName} {
+		serviceRegistration := service.NewServiceRegistration(serviceName, testServiceUuidFromInt(i+1), enclaveName, testIpFromInt(i+1), string(serviceName))
+		serviceRegistration.SetStatus(service.ServiceStatus_Started)
+		require.NoError(t, network.serviceRegistrationRepository.Save(serviceRegistration))
+	}
+
+	sampledServiceStats := service_stats.NewServiceStatsWithoutIO(250, 1024, 0)
+	backend.EXPECT().GetUserServicesStats(
+		ctx,
+		enclaveName,
+		&service.ServiceFilters{
+			Names: nil,
+			UUIDs: map[service.ServiceUUID]bool{
+				sampledServiceUuid: true,
+				erroredServiceUuid: true,
+				missingServiceUuid: true,
+			},
+			Statuses: nil,
+		},
+	).Times(1).Return(
+		map[service.ServiceUUID]*service_stats.ServiceStats{
+			sampledServiceUuid: sampledServiceStats,
+		},
+		map[service.ServiceUUID]error{
+			erroredServiceUuid: stacktrace.NewError("The container of the service stopped"),
+		},
+		nil,
+	)
+
+	servicesStats, servicesErrors, err := network.GetServicesStats(ctx, []string{string(sampledServiceName), string(erroredServiceName), string(missingServiceName)})
+	require.NoError(t, err)
+	require.Equal(t, map[service.ServiceName]*service_stats.ServiceStats{sampledServiceName: sampledServiceStats}, servicesStats)
+	require.Len(t, servicesErrors, 2)
+	require.Contains(t, servicesErrors, erroredServiceName)
+	require.Contains(t, servicesErrors, missingServiceName)
+}
+
 func TestUpdateService(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -1200,6 +1385,7 @@ func testServiceConfig(t *testing.T, imageName string) *service.ServiceConfig {
 		nil,
 		nil,
 		nil,
+		nil,
 		0,
 		0,
 		"",
@@ -16,11 +16,15 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	partition_topology "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+
 	render_templates "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
 
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 
 	service_identifiers "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
+
+	service_stats "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
 )
 
 // MockServiceNetwork is an autogenerated mock type for the ServiceNetwork type
@@ -212,6 +216,51 @@ func (_c *MockServiceNetwork_CopyFilesFromService_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// CopyFilesToService provides a mock function with given fields: ctx, serviceIdentifier, dstDirpath, tgzContent
+func (_m *MockServiceNetwork) CopyFilesToService(ctx context.Context, serviceIdentifier string, dstDirpath string, tgzContent io.Reader) error {
+	ret := _m.Called(ctx, serviceIdentifier, dstDirpath, tgzContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.Reader) error); ok {
+		r0 = rf(ctx, serviceIdentifier, dstDirpath, tgzContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_CopyFilesToService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesToService'
+type MockServiceNetwork_CopyFilesToService_Call struct {
+	*mock.Call
+}
+
+// CopyFilesToService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - dstDirpath string
+//   - tgzContent io.Reader
+func (_e *MockServiceNetwork_Expecter) CopyFilesToService(ctx interface{}, serviceIdentifier interface{}, dstDirpath interface{}, tgzContent interface{}) *MockServiceNetwork_CopyFilesToService_Call {
+	return &MockServiceNetwork_CopyFilesToService_Call{Call: _e.mock.On("CopyFilesToService", ctx, serviceIdentifier, dstDirpath, tgzContent)}
+}
+
+func (_c *MockServiceNetwork_CopyFilesToService_Call) Run(run func(ctx context.Context, serviceIdentifier string, dstDirpath string, tgzContent io.Reader)) *MockServiceNetwork_CopyFilesToService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_CopyFilesToService_Call) Return(_a0 error) *MockServiceNetwork_CopyFilesToService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_CopyFilesToService_Call) RunAndReturn(run func(context.Context, string, string, io.Reader) error) *MockServiceNetwork_CopyFilesToService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExistServiceRegistration provides a mock function with given fields: serviceName
 func (_m *MockServiceNetwork) ExistServiceRegistration(serviceName service.ServiceName) (bool, error) {
 	ret := _m.Called(serviceName)
@@ -631,6 +680,70 @@ func (_c *MockServiceNetwork_GetServices_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetServicesStats provides a mock function with given fields: ctx, serviceIdentifiers
+func (_m *MockServiceNetwork) GetServicesStats(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceName]*service_stats.ServiceStats, map[service.ServiceName]error, error) {
+	ret := _m.Called(ctx, serviceIdentifiers)
+
+	var r0 map[service.ServiceName]*service_stats.ServiceStats
+	var r1 map[service.ServiceName]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[service.ServiceName]*service_stats.ServiceStats, map[service.ServiceName]error, error)); ok {
+		return rf(ctx, serviceIdentifiers)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[service.ServiceName]*service_stats.ServiceStats); ok {
+		r0 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]*service_stats.ServiceStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) map[service.ServiceName]error); ok {
+		r1 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceName]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string) error); ok {
+		r2 = rf(ctx, serviceIdentifiers)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_GetServicesStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServicesStats'
+type MockServiceNetwork_GetServicesStats_Call struct {
+	*mock.Call
+}
+
+// GetServicesStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifiers []string
+func (_e *MockServiceNetwork_Expecter) GetServicesStats(ctx interface{}, serviceIdentifiers interface{}) *MockServiceNetwork_GetServicesStats_Call {
+	return &MockServiceNetwork_GetServicesStats_Call{Call: _e.mock.On("GetServicesStats", ctx, serviceIdentifiers)}
+}
+
+func (_c *MockServiceNetwork_GetServicesStats_Call) Run(run func(ctx context.Context, serviceIdentifiers []string)) *MockServiceNetwork_GetServicesStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServicesStats_Call) Return(_a0 map[service.ServiceName]*service_stats.ServiceStats, _a1 map[service.ServiceName]error, _a2 error) *MockServiceNetwork_GetServicesStats_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServicesStats_Call) RunAndReturn(run func(context.Context, []string) (map[service.ServiceName]*service_stats.ServiceStats, map[service.ServiceName]error, error)) *MockServiceNetwork_GetServicesStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUniqueNameForFileArtifact provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetUniqueNameForFileArtifact() (string, error) {
 	ret := _m.Called()
@@ -742,6 +855,58 @@ func (_c *MockServiceNetwork_HttpRequestService_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// RemoveFilesArtifact provides a mock function with given fields: artifactIdentifier
+func (_m *MockServiceNetwork) RemoveFilesArtifact(artifactIdentifier string) (enclave_data_directory.FilesArtifactUUID, error) {
+	ret := _m.Called(artifactIdentifier)
+
+	var r0 enclave_data_directory.FilesArtifactUUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
+		return rf(artifactIdentifier)
+	}
+	if rf, ok := ret.Get(0).(func(string) enclave_data_directory.FilesArtifactUUID); ok {
+		r0 = rf(artifactIdentifier)
+	} else {
+		r0 = ret.Get(0).(enclave_data_directory.FilesArtifactUUID)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(artifactIdentifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_RemoveFilesArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFilesArtifact'
+type MockServiceNetwork_RemoveFilesArtifact_Call struct {
+	*mock.Call
+}
+
+// RemoveFilesArtifact is a helper method to define mock.On call
+//   - artifactIdentifier string
+func (_e *MockServiceNetwork_Expecter) RemoveFilesArtifact(artifactIdentifier interface{}) *MockServiceNetwork_RemoveFilesArtifact_Call {
+	return &MockServiceNetwork_RemoveFilesArtifact_Call{Call: _e.mock.On("RemoveFilesArtifact", artifactIdentifier)}
+}
+
+func (_c *MockServiceNetwork_RemoveFilesArtifact_Call) Run(run func(artifactIdentifier string)) *MockServiceNetwork_RemoveFilesArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RemoveFilesArtifact_Call) Return(_a0 enclave_data_directory.FilesArtifactUUID, _a1 error) *MockServiceNetwork_RemoveFilesArtifact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RemoveFilesArtifact_Call) RunAndReturn(run func(string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_RemoveFilesArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error) {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -848,6 +1013,70 @@ func (_c *MockServiceNetwork_RenderTemplates_Call) RunAndReturn(run func(map[str
 	return _c
 }
 
+// ResumeServices provides a mock function with given fields: ctx, serviceNames
+func (_m *MockServiceNetwork) ResumeServices(ctx context.Context, serviceNames []service.ServiceName) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, serviceNames)
+
+	var r0 map[service.ServiceUUID]bool
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []service.ServiceName) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, serviceNames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []service.ServiceName) map[service.ServiceUUID]bool); ok {
+		r0 = rf(ctx, serviceNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []service.ServiceName) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, serviceNames)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []service.ServiceName) error); ok {
+		r2 = rf(ctx, serviceNames)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_ResumeServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeServices'
+type MockServiceNetwork_ResumeServices_Call struct {
+	*mock.Call
+}
+
+// ResumeServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceNames []service.ServiceName
+func (_e *MockServiceNetwork_Expecter) ResumeServices(ctx interface{}, serviceNames interface{}) *MockServiceNetwork_ResumeServices_Call {
+	return &MockServiceNetwork_ResumeServices_Call{Call: _e.mock.On("ResumeServices", ctx, serviceNames)}
+}
+
+func (_c *MockServiceNetwork_ResumeServices_Call) Run(run func(ctx context.Context, serviceNames []service.ServiceName)) *MockServiceNetwork_ResumeServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_ResumeServices_Call) Return(_a0 map[service.ServiceUUID]bool, _a1 map[service.ServiceUUID]error, _a2 error) *MockServiceNetwork_ResumeServices_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockServiceNetwork_ResumeServices_Call) RunAndReturn(run func(context.Context, []service.ServiceName) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)) *MockServiceNetwork_ResumeServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunExec provides a mock function with given fields: ctx, serviceIdentifier, userServiceCommand
 func (_m *MockServiceNetwork) RunExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error) {
 	ret := _m.Called(ctx, serviceIdentifier, userServiceCommand)
@@ -968,6 +1197,51 @@ func (_c *MockServiceNetwork_RunExecs_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// SetConnection provides a mock function with given fields: ctx, servicesA, servicesB, connectionConfig
+func (_m *MockServiceNetwork) SetConnection(ctx context.Context, servicesA map[service.ServiceName]bool, servicesB map[service.ServiceName]bool, connectionConfig partition_topology.ConnectionConfig) error {
+	ret := _m.Called(ctx, servicesA, servicesB, connectionConfig)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[service.ServiceName]bool, map[service.ServiceName]bool, partition_topology.ConnectionConfig) error); ok {
+		r0 = rf(ctx, servicesA, servicesB, connectionConfig)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_SetConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConnection'
+type MockServiceNetwork_SetConnection_Call struct {
+	*mock.Call
+}
+
+// SetConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - servicesA map[service.ServiceName]bool
+//   - servicesB map[service.ServiceName]bool
+//   - connectionConfig partition_topology.ConnectionConfig
+func (_e *MockServiceNetwork_Expecter) SetConnection(ctx interface{}, servicesA interface{}, servicesB interface{}, connectionConfig interface{}) *MockServiceNetwork_SetConnection_Call {
+	return &MockServiceNetwork_SetConnection_Call{Call: _e.mock.On("SetConnection", ctx, servicesA, servicesB, connectionConfig)}
+}
+
+func (_c *MockServiceNetwork_SetConnection_Call) Run(run func(ctx context.Context, servicesA map[service.ServiceName]bool, servicesB map[service.ServiceName]bool, connectionConfig partition_topology.ConnectionConfig)) *MockServiceNetwork_SetConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[service.ServiceName]bool), args[2].(map[service.ServiceName]bool), args[3].(partition_topology.ConnectionConfig))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetConnection_Call) Return(_a0 error) *MockServiceNetwork_SetConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetConnection_Call) RunAndReturn(run func(context.Context, map[service.ServiceName]bool, map[service.ServiceName]bool, partition_topology.ConnectionConfig) error) *MockServiceNetwork_SetConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) StartService(ctx context.Context, serviceIdentifier string) error {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -1182,6 +1456,51 @@ func (_c *MockServiceNetwork_StopServices_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// UpdateConnection provides a mock function with given fields: ctx, servicesA, servicesB, connectionConfigOverrides
+func (_m *MockServiceNetwork) UpdateConnection(ctx context.Context, servicesA map[service.ServiceName]bool, servicesB map[service.ServiceName]bool, connectionConfigOverrides *partition_topology.ConnectionConfigOverrides) error {
+	ret := _m.Called(ctx, servicesA, servicesB, connectionConfigOverrides)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[service.ServiceName]bool, map[service.ServiceName]bool, *partition_topology.ConnectionConfigOverrides) error); ok {
+		r0 = rf(ctx, servicesA, servicesB, connectionConfigOverrides)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_UpdateConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConnection'
+type MockServiceNetwork_UpdateConnection_Call struct {
+	*mock.Call
+}
+
+// UpdateConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - servicesA map[service.ServiceName]bool
+//   - servicesB map[service.ServiceName]bool
+//   - connectionConfigOverrides *partition_topology.ConnectionConfigOverrides
+func (_e *MockServiceNetwork_Expecter) UpdateConnection(ctx interface{}, servicesA interface{}, servicesB interface{}, connectionConfigOverrides interface{}) *MockServiceNetwork_UpdateConnection_Call {
+	return &MockServiceNetwork_UpdateConnection_Call{Call: _e.mock.On("UpdateConnection", ctx, servicesA, servicesB, connectionConfigOverrides)}
+}
+
+func (_c *MockServiceNetwork_UpdateConnection_Call) Run(run func(ctx context.Context, servicesA map[service.ServiceName]bool, servicesB map[service.ServiceName]bool, connectionConfigOverrides *partition_topology.ConnectionConfigOverrides)) *MockServiceNetwork_UpdateConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[service.ServiceName]bool), args[2].(map[service.ServiceName]bool), args[3].(*partition_topology.ConnectionConfigOverrides))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_UpdateConnection_Call) Return(_a0 error) *MockServiceNetwork_UpdateConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_UpdateConnection_Call) RunAndReturn(run func(context.Context, map[service.ServiceName]bool, map[service.ServiceName]bool, *partition_topology.ConnectionConfigOverrides) error) *MockServiceNetwork_UpdateConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateFilesArtifact provides a mock function with given fields: fileArtifactUuid, updatedContent, contentMd5
 func (_m *MockServiceNetwork) UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte) error {
 	ret := _m.Called(fileArtifactUuid, updatedContent, contentMd5)
@@ -1406,6 +1725,51 @@ type mockConstructorTestingTNewMockServiceNetwork interface {
 	Cleanup(func())
 }
 
+// WriteFilesFromService provides a mock function with given fields: ctx, serviceIdentifier, srcPath, output
+func (_m *MockServiceNetwork) WriteFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, output io.Writer) error {
+	ret := _m.Called(ctx, serviceIdentifier, srcPath, output)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.Writer) error); ok {
+		r0 = rf(ctx, serviceIdentifier, srcPath, output)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_WriteFilesFromService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteFilesFromService'
+type MockServiceNetwork_WriteFilesFromService_Call struct {
+	*mock.Call
+}
+
+// WriteFilesFromService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - srcPath string
+//   - output io.Writer
+func (_e *MockServiceNetwork_Expecter) WriteFilesFromService(ctx interface{}, serviceIdentifier interface{}, srcPath interface{}, output interface{}) *MockServiceNetwork_WriteFilesFromService_Call {
+	return &MockServiceNetwork_WriteFilesFromService_Call{Call: _e.mock.On("WriteFilesFromService", ctx, serviceIdentifier, srcPath, output)}
+}
+
+func (_c *MockServiceNetwork_WriteFilesFromService_Call) Run(run func(ctx context.Context, serviceIdentifier string, srcPath string, output io.Writer)) *MockServiceNetwork_WriteFilesFromService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_WriteFilesFromService_Call) Return(_a0 error) *MockServiceNetwork_WriteFilesFromService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_WriteFilesFromService_Call) RunAndReturn(run func(context.Context, string, string, io.Writer) error) *MockServiceNetwork_WriteFilesFromService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockServiceNetwork creates a new instance of MockServiceNetwork. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 func NewMockServiceNetwork(t mockConstructorTestingTNewMockServiceNetwork) *MockServiceNetwork {
 	mock := &MockServiceNetwork{}
@@ -0,0 +1,100 @@
+package partition_topology
+
+const (
+	// BandwidthUnlimited is the bandwidth value meaning no bandwidth cap is applied to the connection
+	BandwidthUnlimited uint64 = 0
+
+	blockedPacketLossPercentage float32 = 100
+)
+
+// ConnectionConfig describes the network conditions applied to the traffic flowing between two services.
+// The zero value is a healthy connection with no impairment at all
+type ConnectionConfig struct {
+	PacketLossPercentage float32 `json:"packetLossPercentage"`
+
+	PacketDelay PacketDelay `json:"packetDelay"`
+
+	// Bandwidth cap in kilobits per second. 0 means unlimited
+	BandwidthKbit uint64 `json:"bandwidthKbit"`
+
+	// If set, all packets between the two services are dropped, regardless of the other values
+	IsBlocked bool `json:"isBlocked"`
+}
+
+type PacketDelay struct {
+	DelayMs uint32 `json:"delayMs"`
+
+	JitterMs uint32 `json:"jitterMs"`
+
+	CorrelationPercentage float32 `json:"correlationPercentage"`
+}
+
+// ConnectionConfigOverrides is a partial connection config. Only the non-nil values are applied on top of an existing
+// connection config, the others are left untouched
+type ConnectionConfigOverrides struct {
+	PacketLossPercentage *float32
+
+	DelayMs *uint32
+
+	JitterMs *uint32
+
+	CorrelationPercentage *float32
+
+	BandwidthKbit *uint64
+
+	IsBlocked *bool
+}
+
+func NewDefaultConnectionConfig() ConnectionConfig {
+	return ConnectionConfig{
+		PacketLossPercentage: 0,
+		PacketDelay: PacketDelay{
+			DelayMs:               0,
+			JitterMs:              0,
+			CorrelationPercentage: 0,
+		},
+		BandwidthKbit: BandwidthUnlimited,
+		IsBlocked:     false,
+	}
+}
+
+func NewBlockedConnectionConfig() ConnectionConfig {
+	connectionConfig := NewDefaultConnectionConfig()
+	connectionConfig.IsBlocked = true
+	return connectionConfig
+}
+
+func (config ConnectionConfig) IsDefault() bool {
+	return config == NewDefaultConnectionConfig()
+}
+
+// GetEffectivePacketLossPercentage returns the packet loss that has to be applied to the traffic, taking into account
+// whether the connection is blocked
+func (config ConnectionConfig) GetEffectivePacketLossPercentage() float32 {
+	if config.IsBlocked {
+		return blockedPacketLossPercentage
+	}
+	return config.PacketLossPercentage
+}
+
+func (overrides *ConnectionConfigOverrides) ApplyTo(config ConnectionConfig) ConnectionConfig {
+	if overrides.PacketLossPercentage != nil {
+		config.PacketLossPercentage = *overrides.PacketLossPercentage
+	}
+	if overrides.DelayMs != nil {
+		config.PacketDelay.DelayMs = *overrides.DelayMs
+	}
+	if overrides.JitterMs != nil {
+		config.PacketDelay.JitterMs = *overrides.JitterMs
+	}
+	if overrides.CorrelationPercentage != nil {
+		config.PacketDelay.CorrelationPercentage = *overrides.CorrelationPercentage
+	}
+	if overrides.BandwidthKbit != nil {
+		config.BandwidthKbit = *overrides.BandwidthKbit
+	}
+	if overrides.IsBlocked != nil {
+		config.IsBlocked = *overrides.IsBlocked
+	}
+	return config
+}
@@ -0,0 +1,210 @@
+package partition_topology
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	partitionTopologyBucketName = []byte("partition-topology-repository")
+	connectionsSliceKey         = []byte("connections-slice")
+)
+
+// connection is the persisted form of the connection config between two services. Connections are undirected, so
+// the two service names are always stored sorted to have a single entry per pair of services
+type connection struct {
+	ServiceA service.ServiceName `json:"serviceA"`
+	ServiceB service.ServiceName `json:"serviceB"`
+	Config   ConnectionConfig    `json:"config"`
+}
+
+// PartitionTopologyRepository stores the connection config between each pair of services of the enclave. Pairs of
+// services that don't have any entry are connected through a default, non-impaired, connection
+type PartitionTopologyRepository struct {
+	enclaveDb *enclave_db.EnclaveDB
+}
+
+func GetOrCreateNewPartitionTopologyRepository(enclaveDb *enclave_db.EnclaveDB) (*PartitionTopologyRepository, error) {
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(partitionTopologyBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the partition topology database bucket")
+		}
+		logrus.Debugf("Partition topology bucket: '%+v'", bucket)
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the partition topology repository")
+	}
+
+	partitionTopologyRepository := &PartitionTopologyRepository{
+		enclaveDb: enclaveDb,
+	}
+
+	return partitionTopologyRepository, nil
+}
+
+// SetConnection sets the connection config between the two services, overriding any previous value. Setting the
+// default connection config removes the entry
+func (repository *PartitionTopologyRepository) SetConnection(
+	serviceA service.ServiceName,
+	serviceB service.ServiceName,
+	connectionConfig ConnectionConfig,
+) error {
+	if serviceA == serviceB {
+		return stacktrace.NewError("Cannot set a connection between service '%s' and itself", serviceA)
+	}
+	first, second := sortServicePair(serviceA, serviceB)
+
+	if err := repository.updateConnections(func(connections []*connection) []*connection {
+		updatedConnections := []*connection{}
+		for _, existingConnection := range connections {
+			if existingConnection.ServiceA == first && existingConnection.ServiceB == second {
+				continue
+			}
+			updatedConnections = append(updatedConnections, existingConnection)
+		}
+		if !connectionConfig.IsDefault() {
+			updatedConnections = append(updatedConnections, &connection{
+				ServiceA: first,
+				ServiceB: second,
+				Config:   connectionConfig,
+			})
+		}
+		return updatedConnections
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the connection between service '%s' and service '%s'", serviceA, serviceB)
+	}
+	return nil
+}
+
+// GetConnection returns the connection config between the two services, which is the default connection config if
+// it was never set
+func (repository *PartitionTopologyRepository) GetConnection(
+	serviceA service.ServiceName,
+	serviceB service.ServiceName,
+) (ConnectionConfig, error) {
+	first, second := sortServicePair(serviceA, serviceB)
+	connections, err := repository.getConnections()
+	if err != nil {
+		return NewDefaultConnectionConfig(), stacktrace.Propagate(err, "An error occurred getting the connection between service '%s' and service '%s'", serviceA, serviceB)
+	}
+	for _, existingConnection := range connections {
+		if existingConnection.ServiceA == first && existingConnection.ServiceB == second {
+			return existingConnection.Config, nil
+		}
+	}
+	return NewDefaultConnectionConfig(), nil
+}
+
+// GetServiceConnections returns all the non-default connection configs involving the service, keyed by the name of
+// the service at the other end of the connection
+func (repository *PartitionTopologyRepository) GetServiceConnections(
+	serviceName service.ServiceName,
+) (map[service.ServiceName]ConnectionConfig, error) {
+	connections, err := repository.getConnections()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the connections of service '%s'", serviceName)
+	}
+	serviceConnections := map[service.ServiceName]ConnectionConfig{}
+	for _, existingConnection := range connections {
+		if existingConnection.ServiceA == serviceName {
+			serviceConnections[existingConnection.ServiceB] = existingConnection.Config
+		} else if existingConnection.ServiceB == serviceName {
+			serviceConnections[existingConnection.ServiceA] = existingConnection.Config
+		}
+	}
+	return serviceConnections, nil
+}
+
+// RemoveService removes all the connections involving the service, returning the names of the services that were
+// at the other end of the removed connections
+func (repository *PartitionTopologyRepository) RemoveService(serviceName service.ServiceName) (map[service.ServiceName]bool, error) {
+	formerPeers := map[service.ServiceName]bool{}
+	if err := repository.updateConnections(func(connections []*connection) []*connection {
+		updatedConnections := []*connection{}
+		for _, existingConnection := range connections {
+			if existingConnection.ServiceA == serviceName {
+				formerPeers[existingConnection.ServiceB] = true
+				continue
+			}
+			if existingConnection.ServiceB == serviceName {
+				formerPeers[existingConnection.ServiceA] = true
+				continue
+			}
+			updatedConnections = append(updatedConnections, existingConnection)
+		}
+		return updatedConnections
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing the connections of service '%s'", serviceName)
+	}
+	return formerPeers, nil
+}
+
+func (repository *PartitionTopologyRepository) getConnections() ([]*connection, error) {
+	var (
+		connections = []*connection{}
+		err         error
+	)
+	if err := repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(partitionTopologyBucketName)
+
+		connections, err = getConnectionsFromBucket(bucket)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting connections from bucket with name '%s'", partitionTopologyBucketName)
+		}
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting connections from the enclave db")
+	}
+	return connections, nil
+}
+
+func (repository *PartitionTopologyRepository) updateConnections(updateFunc func([]*connection) []*connection) error {
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(partitionTopologyBucketName)
+
+		connections, err := getConnectionsFromBucket(bucket)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting connections from bucket with name '%s'", partitionTopologyBucketName)
+		}
+
+		updatedConnections := updateFunc(connections)
+		jsonBytes, err := json.Marshal(updatedConnections)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred marshalling connections '%+v'", updatedConnections)
+		}
+
+		if err := bucket.Put(connectionsSliceKey, jsonBytes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while saving connections '%+v' into the enclave db", updatedConnections)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while updating connections in the enclave db")
+	}
+	return nil
+}
+
+func getConnectionsFromBucket(bucket *bolt.Bucket) ([]*connection, error) {
+	connections := []*connection{}
+
+	connectionsBytes := bucket.Get(connectionsSliceKey)
+	if connectionsBytes == nil {
+		return connections, nil
+	}
+	if err := json.Unmarshal(connectionsBytes, &connections); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred unmarshalling connections")
+	}
+	return connections, nil
+}
+
+func sortServicePair(serviceA service.ServiceName, serviceB service.ServiceName) (service.ServiceName, service.ServiceName) {
+	if serviceA < serviceB {
+		return serviceA, serviceB
+	}
+	return serviceB, serviceA
+}
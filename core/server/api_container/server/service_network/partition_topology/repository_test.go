@@ -0,0 +1,132 @@
+package partition_topology
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	"os"
+	"testing"
+)
+
+const (
+	serviceName1 = service.ServiceName("service-for-test-1")
+	serviceName2 = service.ServiceName("service-for-test-2")
+	serviceName3 = service.ServiceName("service-for-test-3")
+)
+
+func TestSetConnection_IsUndirected(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	connectionConfig := NewDefaultConnectionConfig()
+	connectionConfig.PacketLossPercentage = 50
+	require.NoError(t, repository.SetConnection(serviceName2, serviceName1, connectionConfig))
+
+	result, err := repository.GetConnection(serviceName1, serviceName2)
+	require.NoError(t, err)
+	require.Equal(t, connectionConfig, result)
+
+	result, err = repository.GetConnection(serviceName2, serviceName1)
+	require.NoError(t, err)
+	require.Equal(t, connectionConfig, result)
+}
+
+func TestSetConnection_OverridesPreviousValue(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	require.NoError(t, repository.SetConnection(serviceName1, serviceName2, NewBlockedConnectionConfig()))
+	connectionConfig := NewDefaultConnectionConfig()
+	connectionConfig.BandwidthKbit = 1000
+	require.NoError(t, repository.SetConnection(serviceName2, serviceName1, connectionConfig))
+
+	serviceConnections, err := repository.GetServiceConnections(serviceName1)
+	require.NoError(t, err)
+	require.Equal(t, map[service.ServiceName]ConnectionConfig{serviceName2: connectionConfig}, serviceConnections)
+}
+
+func TestSetConnection_DefaultConfigRemovesConnection(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	require.NoError(t, repository.SetConnection(serviceName1, serviceName2, NewBlockedConnectionConfig()))
+	require.NoError(t, repository.SetConnection(serviceName1, serviceName2, NewDefaultConnectionConfig()))
+
+	serviceConnections, err := repository.GetServiceConnections(serviceName1)
+	require.NoError(t, err)
+	require.Empty(t, serviceConnections)
+}
+
+func TestSetConnection_FailsForSameService(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	require.Error(t, repository.SetConnection(serviceName1, serviceName1, NewBlockedConnectionConfig()))
+}
+
+func TestGetConnection_DefaultIfNeverSet(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	result, err := repository.GetConnection(serviceName1, serviceName2)
+	require.NoError(t, err)
+	require.True(t, result.IsDefault())
+}
+
+func TestRemoveService(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	require.NoError(t, repository.SetConnection(serviceName1, serviceName2, NewBlockedConnectionConfig()))
+	require.NoError(t, repository.SetConnection(serviceName1, serviceName3, NewBlockedConnectionConfig()))
+	require.NoError(t, repository.SetConnection(serviceName2, serviceName3, NewBlockedConnectionConfig()))
+
+	formerPeers, err := repository.RemoveService(serviceName1)
+	require.NoError(t, err)
+	require.Equal(t, map[service.ServiceName]bool{serviceName2: true, serviceName3: true}, formerPeers)
+
+	serviceConnections, err := repository.GetServiceConnections(serviceName1)
+	require.NoError(t, err)
+	require.Empty(t, serviceConnections)
+
+	serviceConnections, err = repository.GetServiceConnections(serviceName2)
+	require.NoError(t, err)
+	require.Equal(t, map[service.ServiceName]ConnectionConfig{serviceName3: NewBlockedConnectionConfig()}, serviceConnections)
+}
+
+func TestConnectionConfigOverrides_ApplyTo(t *testing.T) {
+	initialConfig := NewDefaultConnectionConfig()
+	initialConfig.PacketLossPercentage = 10
+	initialConfig.PacketDelay.DelayMs = 100
+
+	newDelayMs := uint32(200)
+	isBlocked := true
+	overrides := &ConnectionConfigOverrides{
+		PacketLossPercentage:  nil,
+		DelayMs:               &newDelayMs,
+		JitterMs:              nil,
+		CorrelationPercentage: nil,
+		BandwidthKbit:         nil,
+		IsBlocked:             &isBlocked,
+	}
+
+	result := overrides.ApplyTo(initialConfig)
+	require.Equal(t, float32(10), result.PacketLossPercentage)
+	require.Equal(t, newDelayMs, result.PacketDelay.DelayMs)
+	require.True(t, result.IsBlocked)
+	require.Equal(t, float32(100), result.GetEffectivePacketLossPercentage())
+}
+
+func getRepositoryForTest(t *testing.T) *PartitionTopologyRepository {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer func() {
+		err = os.Remove(file.Name())
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	enclaveDb := &enclave_db.EnclaveDB{
+		DB: db,
+	}
+	repository, err := GetOrCreateNewPartitionTopologyRepository(enclaveDb)
+	require.NoError(t, err)
+
+	return repository
+}
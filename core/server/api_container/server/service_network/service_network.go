@@ -5,6 +5,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_stats"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
@@ -68,6 +70,16 @@ type ServiceNetwork interface {
 		error,
 	)
 
+	// ResumeServices restarts the services that were running when the enclave stopped
+	ResumeServices(
+		ctx context.Context,
+		serviceNames []service.ServiceName,
+	) (
+		map[service.ServiceUUID]bool,
+		map[service.ServiceUUID]error,
+		error,
+	)
+
 	StopService(ctx context.Context, serviceIdentifier string) error
 
 	StopServices(
@@ -79,6 +91,24 @@ type ServiceNetwork interface {
 		error,
 	)
 
+	// SetConnection sets the connection config between each service of servicesA and each service of servicesB,
+	// overriding any previously set connection config between them
+	SetConnection(
+		ctx context.Context,
+		servicesA map[service.ServiceName]bool,
+		servicesB map[service.ServiceName]bool,
+		connectionConfig partition_topology.ConnectionConfig,
+	) error
+
+	// UpdateConnection applies the overrides on top of the current connection config between each service of
+	// servicesA and each service of servicesB
+	UpdateConnection(
+		ctx context.Context,
+		servicesA map[service.ServiceName]bool,
+		servicesB map[service.ServiceName]bool,
+		connectionConfigOverrides *partition_topology.ConnectionConfigOverrides,
+	) error
+
 	RunExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error)
 
 	RunExecs(
@@ -98,6 +128,16 @@ type ServiceNetwork interface {
 
 	CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
+	// CopyFilesToService extracts the given TGZ, packaged like a files artifact, into a directory of a running service
+	CopyFilesToService(ctx context.Context, serviceIdentifier string, dstDirpath string, tgzContent io.Reader) error
+
+	// WriteFilesFromService writes the content at the given path of a running service to the output, packaged as a TGZ
+	WriteFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, output io.Writer) error
+
+	// GetServicesStats returns the resource usage of the running services matching the identifiers, or of all the
+	// running services if there's no identifier, along with the errors of the services whose stats couldn't be sampled
+	GetServicesStats(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceName]*service_stats.ServiceStats, map[service.ServiceName]error, error)
+
 	GetServiceNames() (map[service.ServiceName]bool, error)
 
 	GetExistingAndHistoricalServiceIdentifiers() (service_identifiers.ServiceIdentifiers, error)
@@ -112,6 +152,8 @@ type ServiceNetwork interface {
 
 	UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte) error
 
+	RemoveFilesArtifact(artifactIdentifier string) (enclave_data_directory.FilesArtifactUUID, error)
+
 	GetUniqueNameForFileArtifact() (string, error)
 
 	GetApiContainerInfo() *ApiContainerInfo
@@ -0,0 +1,106 @@
+package traffic_control
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+)
+
+const (
+	// The networking sidecar shares the network namespace of the user service container, so this is the interface of
+	// the user service
+	interfaceName = "eth0"
+
+	rootQdiscHandle = "1:"
+
+	// Class used for the traffic that doesn't match any of the filters, i.e. traffic to services with a default
+	// connection
+	defaultClassMinor = 1
+
+	// Classes dedicated to peers are numbered starting from this value, to never collide with the default class
+	firstPeerClassMinor = 10
+
+	// Rate used for classes that don't have any bandwidth cap. HTB requires a rate for every class
+	unlimitedRate = "10gbit"
+
+	shellBinary = "sh"
+	shellFlag   = "-c"
+
+	commandSeparator = " && "
+)
+
+// GenerateTcCommand generates the command to run in the networking sidecar of a service so that its outgoing
+// traffic matches the connection configs, keyed by the IP address of the peer service.
+// The generated command always starts by wiping the existing qdiscs, so it can be run any number of times and always
+// leaves the interface in the same state. Passing an empty map simply resets the interface to its default state
+func GenerateTcCommand(connectionConfigsByPeerIp map[string]partition_topology.ConnectionConfig) []string {
+	commands := []string{
+		// The root qdisc doesn't exist the first time the sidecar runs, so failure to delete it is expected
+		fmt.Sprintf("tc qdisc del dev %s root 2>/dev/null || true", interfaceName),
+	}
+
+	if len(connectionConfigsByPeerIp) > 0 {
+		commands = append(
+			commands,
+			fmt.Sprintf("tc qdisc add dev %s root handle %s htb default %x", interfaceName, rootQdiscHandle, defaultClassMinor),
+			fmt.Sprintf("tc class add dev %s parent %s classid %s%x htb rate %s", interfaceName, rootQdiscHandle, rootQdiscHandle, defaultClassMinor, unlimitedRate),
+		)
+
+		// Sorted so that the same topology always produces the exact same command
+		peerIps := []string{}
+		for peerIp := range connectionConfigsByPeerIp {
+			peerIps = append(peerIps, peerIp)
+		}
+		sort.Strings(peerIps)
+
+		for idx, peerIp := range peerIps {
+			connectionConfig := connectionConfigsByPeerIp[peerIp]
+			classMinor := firstPeerClassMinor + idx
+			classId := fmt.Sprintf("%s%x", rootQdiscHandle, classMinor)
+			commands = append(
+				commands,
+				fmt.Sprintf("tc class add dev %s parent %s classid %s htb rate %s", interfaceName, rootQdiscHandle, classId, getRate(connectionConfig)),
+				fmt.Sprintf("tc qdisc add dev %s parent %s handle %x: %s", interfaceName, classId, classMinor, getNetemQdisc(connectionConfig)),
+				fmt.Sprintf("tc filter add dev %s protocol ip parent %s prio 1 u32 match ip dst %s/32 flowid %s", interfaceName, rootQdiscHandle, peerIp, classId),
+			)
+		}
+	}
+
+	return []string{
+		shellBinary,
+		shellFlag,
+		strings.Join(commands, commandSeparator),
+	}
+}
+
+func getRate(connectionConfig partition_topology.ConnectionConfig) string {
+	if connectionConfig.BandwidthKbit == partition_topology.BandwidthUnlimited {
+		return unlimitedRate
+	}
+	return fmt.Sprintf("%dkbit", connectionConfig.BandwidthKbit)
+}
+
+func getNetemQdisc(connectionConfig partition_topology.ConnectionConfig) string {
+	netemArgs := []string{"netem"}
+	packetDelay := connectionConfig.PacketDelay
+	if packetDelay.DelayMs > 0 {
+		netemArgs = append(netemArgs, fmt.Sprintf("delay %dms", packetDelay.DelayMs))
+		if packetDelay.JitterMs > 0 {
+			netemArgs = append(netemArgs, fmt.Sprintf("%dms", packetDelay.JitterMs))
+			if packetDelay.CorrelationPercentage > 0 {
+				netemArgs = append(netemArgs, fmt.Sprintf("%s%%", formatPercentage(packetDelay.CorrelationPercentage)))
+			}
+		}
+	}
+	packetLossPercentage := connectionConfig.GetEffectivePacketLossPercentage()
+	if packetLossPercentage > 0 {
+		netemArgs = append(netemArgs, fmt.Sprintf("loss %s%%", formatPercentage(packetLossPercentage)))
+	}
+	return strings.Join(netemArgs, " ")
+}
+
+func formatPercentage(percentage float32) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", percentage), "0"), ".")
+}
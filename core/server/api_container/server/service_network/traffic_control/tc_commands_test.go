@@ -0,0 +1,53 @@
+package traffic_control
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTcCommand_NoConnections(t *testing.T) {
+	command := GenerateTcCommand(map[string]partition_topology.ConnectionConfig{})
+	require.Equal(t, []string{"sh", "-c", "tc qdisc del dev eth0 root 2>/dev/null || true"}, command)
+}
+
+func TestGenerateTcCommand_BlockedConnection(t *testing.T) {
+	command := GenerateTcCommand(map[string]partition_topology.ConnectionConfig{
+		"172.16.0.3": partition_topology.NewBlockedConnectionConfig(),
+	})
+	expectedCommand := "tc qdisc del dev eth0 root 2>/dev/null || true && " +
+		"tc qdisc add dev eth0 root handle 1: htb default 1 && " +
+		"tc class add dev eth0 parent 1: classid 1:1 htb rate 10gbit && " +
+		"tc class add dev eth0 parent 1: classid 1:a htb rate 10gbit && " +
+		"tc qdisc add dev eth0 parent 1:a handle a: netem loss 100% && " +
+		"tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst 172.16.0.3/32 flowid 1:a"
+	require.Equal(t, []string{"sh", "-c", expectedCommand}, command)
+}
+
+func TestGenerateTcCommand_MultiplePeersAreSorted(t *testing.T) {
+	degradedConnection := partition_topology.ConnectionConfig{
+		PacketLossPercentage: 12.5,
+		PacketDelay: partition_topology.PacketDelay{
+			DelayMs:               100,
+			JitterMs:              10,
+			CorrelationPercentage: 25,
+		},
+		BandwidthKbit: 512,
+		IsBlocked:     false,
+	}
+	command := GenerateTcCommand(map[string]partition_topology.ConnectionConfig{
+		"172.16.0.5": degradedConnection,
+		"172.16.0.4": partition_topology.NewBlockedConnectionConfig(),
+	})
+	expectedCommand := "tc qdisc del dev eth0 root 2>/dev/null || true && " +
+		"tc qdisc add dev eth0 root handle 1: htb default 1 && " +
+		"tc class add dev eth0 parent 1: classid 1:1 htb rate 10gbit && " +
+		"tc class add dev eth0 parent 1: classid 1:a htb rate 10gbit && " +
+		"tc qdisc add dev eth0 parent 1:a handle a: netem loss 100% && " +
+		"tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst 172.16.0.4/32 flowid 1:a && " +
+		"tc class add dev eth0 parent 1: classid 1:b htb rate 512kbit && " +
+		"tc qdisc add dev eth0 parent 1:b handle b: netem delay 100ms 10ms 25% loss 12.5% && " +
+		"tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst 172.16.0.5/32 flowid 1:b"
+	require.Equal(t, []string{"sh", "-c", expectedCommand}, command)
+}
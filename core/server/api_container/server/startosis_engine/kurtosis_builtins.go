@@ -1,14 +1,17 @@
 package startosis_engine
 
 import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/import_module"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/print_builtin"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/read_file"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/connection"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/exec"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/kurtosis_print"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_files_artifact"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/render_templates"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/request"
@@ -19,8 +22,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/upload_files"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/verify"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/wait"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/directory"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/port_spec"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
@@ -35,6 +41,11 @@ import (
 	"go.starlark.net/starlarkstruct"
 )
 
+const (
+	// the definitions of the builtins don't depend on the package they're called from
+	noPackageIdForDefinitions = ""
+)
+
 func Predeclared() starlark.StringDict {
 	return starlark.StringDict{
 		// go-starlark add-ons
@@ -56,26 +67,33 @@ func Predeclared() starlark.StringDict {
 func KurtosisPlanInstructions(
 	packageId string,
 	serviceNetwork service_network.ServiceNetwork,
+	serviceHealthMonitor *service_health.ServiceHealthMonitor,
 	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	backgroundTasks *tasks.BackgroundTaskRegistry,
 	packageContentProvider startosis_packages.PackageContentProvider,
 	packageReplaceOptions map[string]string,
 ) []*kurtosis_plan_instruction.KurtosisPlanInstruction {
 	return []*kurtosis_plan_instruction.KurtosisPlanInstruction{
-		add_service.NewAddService(serviceNetwork, runtimeValueStore),
-		add_service.NewAddServices(serviceNetwork, runtimeValueStore),
+		add_service.NewAddService(serviceNetwork, serviceHealthMonitor, runtimeValueStore, packageContentProvider, packageReplaceOptions),
+		add_service.NewAddServices(serviceNetwork, serviceHealthMonitor, runtimeValueStore, packageContentProvider, packageReplaceOptions),
 		verify.NewVerify(runtimeValueStore),
 		exec.NewExec(serviceNetwork, runtimeValueStore),
 		kurtosis_print.NewPrint(serviceNetwork, runtimeValueStore),
+		remove_files_artifact.NewRemoveFilesArtifact(serviceNetwork),
 		remove_service.NewRemoveService(serviceNetwork),
 		render_templates.NewRenderTemplatesInstruction(serviceNetwork, runtimeValueStore),
 		request.NewRequest(serviceNetwork, runtimeValueStore),
+		connection.NewSetConnection(serviceNetwork),
 		start_service.NewStartService(serviceNetwork),
-		tasks.NewRunPythonService(serviceNetwork, runtimeValueStore),
-		tasks.NewRunShService(serviceNetwork, runtimeValueStore),
+		tasks.NewRunPythonService(serviceNetwork, runtimeValueStore, backgroundTasks, packageContentProvider, packageReplaceOptions),
+		tasks.NewRunShService(serviceNetwork, runtimeValueStore, backgroundTasks, packageContentProvider, packageReplaceOptions),
 		stop_service.NewStopService(serviceNetwork),
 		store_service_files.NewStoreServiceFiles(serviceNetwork),
+		connection.NewUpdateConnection(serviceNetwork),
 		upload_files.NewUploadFiles(packageId, serviceNetwork, packageContentProvider, packageReplaceOptions),
 		wait.NewWait(serviceNetwork, runtimeValueStore),
+		wait.NewMultiWait(serviceNetwork, runtimeValueStore),
+		tasks.NewWaitForTask(serviceNetwork, runtimeValueStore, backgroundTasks),
 	}
 }
 
@@ -101,15 +119,49 @@ func KurtosisHelpers(packageId string, recursiveInterpret func(moduleId string,
 //
 // Example: ServiceConfig, PortSpec, etc.
 func KurtosisTypeConstructors() []*starlark.Builtin {
-	return []*starlark.Builtin{
-		starlark.NewBuiltin(kurtosis_types.ServiceTypeName, kurtosis_types.NewServiceType().CreateBuiltin()),
-		starlark.NewBuiltin(directory.DirectoryTypeName, directory.NewDirectoryType().CreateBuiltin()),
-		starlark.NewBuiltin(recipe.ExecRecipeTypeName, recipe.NewExecRecipeType().CreateBuiltin()),
-		starlark.NewBuiltin(recipe.GetHttpRecipeTypeName, recipe.NewGetHttpRequestRecipeType().CreateBuiltin()),
-		starlark.NewBuiltin(recipe.PostHttpRecipeTypeName, recipe.NewPostHttpRequestRecipeType().CreateBuiltin()),
-		starlark.NewBuiltin(port_spec.PortSpecTypeName, port_spec.NewPortSpecType().CreateBuiltin()),
-		starlark.NewBuiltin(store_spec.StoreSpecTypeName, store_spec.NewStoreSpecType().CreateBuiltin()),
-		starlark.NewBuiltin(service_config.ServiceConfigTypeName, service_config.NewServiceConfigType().CreateBuiltin()),
-		starlark.NewBuiltin(service_config.ReadyConditionTypeName, service_config.NewReadyConditionType().CreateBuiltin()),
+	var typeConstructors []*starlark.Builtin
+	for _, typeConstructor := range kurtosisTypeConstructors() {
+		typeConstructors = append(typeConstructors, starlark.NewBuiltin(typeConstructor.GetName(), typeConstructor.CreateBuiltin()))
+	}
+	return typeConstructors
+}
+
+// KurtosisBuiltinDefinitions returns the name and the arguments of the builtins declared with the Kurtosis Starlark
+// framework, split between the KurtosisPlanInstruction, which are attributes of the `plan` object, and the
+// KurtosisHelper and KurtosisTypeConstructor, which are global.
+//
+// It is meant for tools inspecting scripts without interpreting them, like the linter. The builtins are created without
+// any of their dependencies, so they must never be called.
+func KurtosisBuiltinDefinitions() ([]*kurtosis_starlark_framework.KurtosisBaseBuiltin, []*kurtosis_starlark_framework.KurtosisBaseBuiltin) {
+	var planInstructionDefinitions []*kurtosis_starlark_framework.KurtosisBaseBuiltin
+	for _, planInstruction := range KurtosisPlanInstructions(noPackageIdForDefinitions, nil, nil, nil, nil, nil, nil) {
+		planInstructionDefinitions = append(planInstructionDefinitions, planInstruction.KurtosisBaseBuiltin)
+	}
+
+	globalBuiltinDefinitions := []*kurtosis_starlark_framework.KurtosisBaseBuiltin{
+		import_module.NewImportModule(noPackageIdForDefinitions, nil, nil, nil, nil).KurtosisBaseBuiltin,
+		read_file.NewReadFileHelper(noPackageIdForDefinitions, nil, nil).KurtosisBaseBuiltin,
+	}
+	for _, typeConstructor := range kurtosisTypeConstructors() {
+		globalBuiltinDefinitions = append(globalBuiltinDefinitions, typeConstructor.KurtosisBaseBuiltin)
+	}
+	return planInstructionDefinitions, globalBuiltinDefinitions
+}
+
+func kurtosisTypeConstructors() []*kurtosis_type_constructor.KurtosisTypeConstructor {
+	return []*kurtosis_type_constructor.KurtosisTypeConstructor{
+		kurtosis_types.NewServiceType(),
+		directory.NewDirectoryType(),
+		recipe.NewExecRecipeType(),
+		recipe.NewGetHttpRequestRecipeType(),
+		recipe.NewPostHttpRequestRecipeType(),
+		port_spec.NewPortSpecType(),
+		store_spec.NewStoreSpecType(),
+		service_config.NewServiceConfigType(),
+		service_config.NewReadyConditionType(),
+		service_config.NewLivenessCheckType(),
+		service_config.NewRestartPolicyType(),
+		service_config.NewImageBuildSpecType(),
+		connection_config.NewConnectionConfigType(),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
@@ -13,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
@@ -26,7 +28,13 @@ const (
 	ServiceConfigArgName = "config"
 )
 
-func NewAddService(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+func NewAddService(
+	serviceNetwork service_network.ServiceNetwork,
+	serviceHealthMonitor *service_health.ServiceHealthMonitor,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string,
+) *kurtosis_plan_instruction.KurtosisPlanInstruction {
 	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
 		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
 			Name: AddServiceBuiltinName,
@@ -45,10 +53,10 @@ func NewAddService(serviceNetwork service_network.ServiceNetwork, runtimeValueSt
 					IsOptional:        false,
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[*service_config.ServiceConfig],
 					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
-						// we just try to convert the configs here to validate their shape, to avoid code duplication
-						// with Interpret
-						if _, _, err := validateAndConvertConfigAndReadyCondition(serviceNetwork, value); err != nil {
-							return err
+						// the config is converted at interpretation time, as resolving the paths it contains requires
+						// the locator of the module calling the instruction
+						if _, ok := value.(*service_config.ServiceConfig); !ok {
+							return startosis_errors.NewInterpretationError("The '%s' argument is not a ServiceConfig (was '%s').", ServiceConfigArgName, reflect.TypeOf(value))
 						}
 						return nil
 					},
@@ -58,14 +66,19 @@ func NewAddService(serviceNetwork service_network.ServiceNetwork, runtimeValueSt
 
 		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
 			return &AddServiceCapabilities{
-				serviceNetwork:    serviceNetwork,
-				runtimeValueStore: runtimeValueStore,
+				serviceNetwork:         serviceNetwork,
+				serviceHealthMonitor:   serviceHealthMonitor,
+				runtimeValueStore:      runtimeValueStore,
+				packageContentProvider: packageContentProvider,
+				packageReplaceOptions:  packageReplaceOptions,
 
 				serviceName:   "",  // populated at interpretation time
 				serviceConfig: nil, // populated at interpretation time
 
 				resultUuid:     "",  // populated at interpretation time
 				readyCondition: nil, // populated at interpretation time
+				livenessCheck:  nil, // populated at interpretation time
+				restartPolicy:  nil, // populated at interpretation time
 			}
 		},
 
@@ -77,17 +90,22 @@ func NewAddService(serviceNetwork service_network.ServiceNetwork, runtimeValueSt
 }
 
 type AddServiceCapabilities struct {
-	serviceNetwork    service_network.ServiceNetwork
-	runtimeValueStore *runtime_value_store.RuntimeValueStore
+	serviceNetwork         service_network.ServiceNetwork
+	serviceHealthMonitor   *service_health.ServiceHealthMonitor
+	runtimeValueStore      *runtime_value_store.RuntimeValueStore
+	packageContentProvider startosis_packages.PackageContentProvider
+	packageReplaceOptions  map[string]string
 
 	serviceName    service.ServiceName
 	serviceConfig  *service.ServiceConfig
 	readyCondition *service_config.ReadyCondition
+	livenessCheck  *service_config.LivenessCheck
+	restartPolicy  *service_health.RestartPolicy
 
 	resultUuid string
 }
 
-func (builtin *AddServiceCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+func (builtin *AddServiceCapabilities) Interpret(locatorOfModuleInWhichThisBuiltInIsBeingCalled string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
 	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
@@ -97,7 +115,12 @@ func (builtin *AddServiceCapabilities) Interpret(_ string, arguments *builtin_ar
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceConfigArgName)
 	}
-	apiServiceConfig, readyCondition, interpretationErr := validateAndConvertConfigAndReadyCondition(builtin.serviceNetwork, serviceConfig)
+	apiServiceConfig, readyCondition, interpretationErr := validateAndConvertConfigAndReadyCondition(builtin.serviceNetwork, serviceConfig, locatorOfModuleInWhichThisBuiltInIsBeingCalled, builtin.packageContentProvider, builtin.packageReplaceOptions)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	livenessCheck, restartPolicy, interpretationErr := convertHealthConfig(serviceConfig)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
@@ -105,6 +128,8 @@ func (builtin *AddServiceCapabilities) Interpret(_ string, arguments *builtin_ar
 	builtin.serviceName = service.ServiceName(serviceName.GoString())
 	builtin.serviceConfig = apiServiceConfig
 	builtin.readyCondition = readyCondition
+	builtin.livenessCheck = livenessCheck
+	builtin.restartPolicy = restartPolicy
 	builtin.resultUuid, err = builtin.runtimeValueStore.GetOrCreateValueAssociatedWithService(builtin.serviceName)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold '%v' command return values", AddServiceBuiltinName)
@@ -153,6 +178,17 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 		return "", stacktrace.Propagate(err, "An error occurred while checking if service '%v' is ready", replacedServiceName)
 	}
 
+	if err := registerServiceHealthMonitoring(
+		builtin.serviceHealthMonitor,
+		builtin.serviceNetwork,
+		builtin.runtimeValueStore,
+		replacedServiceName,
+		builtin.livenessCheck,
+		builtin.restartPolicy,
+	); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred setting up the health monitoring of service '%v'", replacedServiceName)
+	}
+
 	if err := fillAddServiceReturnValueWithRuntimeValues(startedService, builtin.resultUuid, builtin.runtimeValueStore); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while adding service return values with result key UUID '%s'", builtin.resultUuid)
 	}
@@ -212,15 +248,28 @@ func (builtin *AddServiceCapabilities) FillPersistableAttributes(builder *enclav
 func validateAndConvertConfigAndReadyCondition(
 	serviceNetwork service_network.ServiceNetwork,
 	rawConfig starlark.Value,
+	locatorOfModuleInWhichThisBuiltInIsBeingCalled string,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string,
 ) (*service.ServiceConfig, *service_config.ReadyCondition, *startosis_errors.InterpretationError) {
 	config, ok := rawConfig.(*service_config.ServiceConfig)
 	if !ok {
 		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument is not a ServiceConfig (was '%s').", ConfigsArgName, reflect.TypeOf(rawConfig))
 	}
-	apiServiceConfig, interpretationErr := config.ToKurtosisType(serviceNetwork)
+	_, isReplicated, interpretationErr := config.GetReplicasIfSet()
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+	if isReplicated {
+		return nil, nil, startosis_errors.NewInterpretationError("The '%s' attribute of a ServiceConfig can only be used with '%s', '%s' adds a single service", service_config.ReplicasAttr, AddServicesBuiltinName, AddServiceBuiltinName)
+	}
+	apiServiceConfig, interpretationErr := config.ToKurtosisType(serviceNetwork, locatorOfModuleInWhichThisBuiltInIsBeingCalled, packageContentProvider, packageReplaceOptions)
 	if interpretationErr != nil {
 		return nil, nil, interpretationErr
 	}
+	if interpretationErr := validateNoReservedReplicaLabels(apiServiceConfig); interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
 
 	readyCondition, interpretationErr := config.GetReadyCondition()
 	if interpretationErr != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
@@ -89,8 +90,18 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 		return validationErr
 	}
 
+	if validationErr := validatorEnvironment.HasEnoughQuota(serviceConfig, serviceName); validationErr != nil {
+		return validationErr
+	}
+
+	if serviceConfig.GetImageBuildSpec() != nil {
+		if validationErr := validatorEnvironment.AppendRequiredImageBuild(serviceConfig.GetContainerImageName(), serviceConfig.GetImageBuildSpec()); validationErr != nil {
+			return validationErr
+		}
+	} else {
+		validatorEnvironment.AppendRequiredContainerImage(serviceConfig.GetContainerImageName())
+	}
 	validatorEnvironment.AddServiceName(serviceName)
-	validatorEnvironment.AppendRequiredContainerImage(serviceConfig.GetContainerImageName())
 	var portIds []string
 	for portId := range serviceConfig.GetPrivatePorts() {
 		portIds = append(portIds, portId)
@@ -98,6 +109,7 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 	validatorEnvironment.AddPrivatePortIDForService(portIds, serviceName)
 	validatorEnvironment.ConsumeMemory(serviceConfig.GetMinMemoryAllocationMegabytes(), serviceName)
 	validatorEnvironment.ConsumeCPU(serviceConfig.GetMinCPUAllocationMillicpus(), serviceName)
+	validatorEnvironment.ConsumeQuota(serviceConfig, serviceName)
 	return nil
 }
 
@@ -167,6 +179,7 @@ func replaceMagicStrings(
 
 	renderedServiceConfig, err := service.CreateServiceConfig(
 		serviceConfig.GetContainerImageName(),
+		serviceConfig.GetImageBuildSpec(),
 		serviceConfig.GetPrivatePorts(),
 		serviceConfig.GetPublicPorts(),
 		entrypoints,
@@ -266,3 +279,115 @@ func runServiceReadinessCheck(
 	}
 	return nil
 }
+
+func convertHealthConfig(
+	serviceConfig *service_config.ServiceConfig,
+) (*service_config.LivenessCheck, *service_health.RestartPolicy, *startosis_errors.InterpretationError) {
+	livenessCheck, interpretationErr := serviceConfig.GetLivenessCheck()
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+	restartPolicyStarlark, interpretationErr := serviceConfig.GetRestartPolicy()
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+	if restartPolicyStarlark == nil {
+		return livenessCheck, nil, nil
+	}
+	restartPolicy, interpretationErr := restartPolicyStarlark.ToKurtosisType()
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+	return livenessCheck, restartPolicy, nil
+}
+
+// registerServiceHealthMonitoring hands over the liveness check and restart policy of the service to the health
+// monitor, which enforces them for as long as the service exists. It unregisters the service from the monitor if
+// neither is set, as a previous version of the service might have been monitored.
+func registerServiceHealthMonitoring(
+	serviceHealthMonitor *service_health.ServiceHealthMonitor,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	livenessCheck *service_config.LivenessCheck,
+	restartPolicy *service_health.RestartPolicy,
+) error {
+	if livenessCheck == nil {
+		if err := serviceHealthMonitor.RegisterService(serviceName, nil, "", restartPolicy); err != nil {
+			return stacktrace.Propagate(err, "An error occurred registering service '%v' in the health monitor", serviceName)
+		}
+		return nil
+	}
+
+	recipe, interpretationErr := livenessCheck.GetRecipe()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the recipe value from liveness check '%v'", livenessCheck)
+	}
+	field, interpretationErr := livenessCheck.GetField()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the field value from liveness check '%v'", livenessCheck)
+	}
+	assertion, interpretationErr := livenessCheck.GetAssertion()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the assertion value from liveness check '%v'", livenessCheck)
+	}
+	target, interpretationErr := livenessCheck.GetTarget()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the target value from liveness check '%v'", livenessCheck)
+	}
+	interval, interpretationErr := livenessCheck.GetInterval()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the interval value from liveness check '%v'", livenessCheck)
+	}
+	failureThreshold, interpretationErr := livenessCheck.GetFailureThreshold()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the failure threshold value from liveness check '%v'", livenessCheck)
+	}
+
+	checkFunc := func(ctx context.Context) error {
+		return shared_helpers.ExecuteServiceAssertionWithRecipeOnce(ctx, serviceNetwork, runtimeValueStore, serviceName, recipe, field, assertion, target)
+	}
+	// the liveness check is persisted as its Starlark representation, which is read back by RestoreServiceHealthMonitoring
+	livenessCheckDefinition := livenessCheck.String()
+	if err := serviceHealthMonitor.RegisterService(serviceName, service_health.NewLivenessCheck(checkFunc, interval, failureThreshold), livenessCheckDefinition, restartPolicy); err != nil {
+		return stacktrace.Propagate(err, "An error occurred registering service '%v' in the health monitor", serviceName)
+	}
+	return nil
+}
+
+// RestoreServiceHealthMonitoring registers again in the health monitor the services which were monitored before the
+// API container restarted, rebuilding their liveness checks from their persisted Starlark representation. If
+// servicesToRestore is not nil, only these services are restored.
+func RestoreServiceHealthMonitoring(
+	serviceHealthMonitor *service_health.ServiceHealthMonitor,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
+	servicesToRestore map[service.ServiceName]bool,
+) error {
+	registrations, err := serviceHealthMonitor.GetPersistedRegistrations()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persisted service health registrations")
+	}
+	for serviceName, registration := range registrations {
+		if servicesToRestore != nil && !servicesToRestore[serviceName] {
+			continue
+		}
+		var livenessCheck *service_config.LivenessCheck
+		if registration.LivenessCheckDefinition != "" {
+			livenessCheckValue, interpretationErr := starlarkValueSerde.Deserialize(registration.LivenessCheckDefinition)
+			if interpretationErr != nil {
+				return stacktrace.Propagate(interpretationErr, "An error occurred deserializing the liveness check of service '%v'", serviceName)
+			}
+			var isLivenessCheck bool
+			livenessCheck, isLivenessCheck = livenessCheckValue.(*service_config.LivenessCheck)
+			if !isLivenessCheck {
+				return stacktrace.NewError("The persisted liveness check of service '%v' is not a '%s' but a '%s'", serviceName, service_config.LivenessCheckTypeName, livenessCheckValue.Type())
+			}
+		}
+		if err := registerServiceHealthMonitoring(serviceHealthMonitor, serviceNetwork, runtimeValueStore, serviceName, livenessCheck, registration.GetRestartPolicy()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred restoring the health monitoring of service '%v'", serviceName)
+		}
+	}
+	return nil
+}
@@ -40,6 +40,7 @@ func TestAddServiceShared_EntryPointArgsRuntimeValueAreReplaced(t *testing.T) {
 		testContainerImageName,
 		nil,
 		nil,
+		nil,
 		[]string{"-- " + runtimeValue},
 		nil,
 		nil,
@@ -82,6 +83,7 @@ func TestAddServiceShared_CmdArgsRuntimeValueAreReplaced(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		[]string{"bash", "-c", "sleep " + runtimeValue},
 		nil,
 		nil,
@@ -124,6 +126,7 @@ func TestAddServiceShared_EnvVarsWithRuntimeValueAreReplaced(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		map[string]string{
 			"PORT": runtimeValue,
 		},
@@ -173,6 +176,7 @@ func TestAddServiceShared_ServiceNameWithRuntimeValuesAreReplaced(t *testing.T)
 		nil,
 		nil,
 		nil,
+		nil,
 		0,
 		0,
 		"",
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
@@ -14,11 +15,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -29,7 +32,13 @@ const (
 	ConfigsArgName = "configs"
 )
 
-func NewAddServices(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+func NewAddServices(
+	serviceNetwork service_network.ServiceNetwork,
+	serviceHealthMonitor *service_health.ServiceHealthMonitor,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string,
+) *kurtosis_plan_instruction.KurtosisPlanInstruction {
 	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
 		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
 			Name: AddServicesBuiltinName,
@@ -40,10 +49,10 @@ func NewAddServices(serviceNetwork service_network.ServiceNetwork, runtimeValueS
 					IsOptional:        false,
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
 					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
-						// we just try to convert the configs here to validate their shape, to avoid code duplication
-						// with Interpret
-						if _, _, err := validateAndConvertConfigsAndReadyConditions(serviceNetwork, value); err != nil {
-							return err
+						// the configs are converted at interpretation time, as resolving the paths they contain requires
+						// the locator of the module calling the instruction
+						if _, ok := value.(*starlark.Dict); !ok {
+							return startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(value))
 						}
 						return nil
 					},
@@ -53,13 +62,19 @@ func NewAddServices(serviceNetwork service_network.ServiceNetwork, runtimeValueS
 
 		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
 			return &AddServicesCapabilities{
-				serviceNetwork:    serviceNetwork,
-				runtimeValueStore: runtimeValueStore,
+				serviceNetwork:         serviceNetwork,
+				serviceHealthMonitor:   serviceHealthMonitor,
+				runtimeValueStore:      runtimeValueStore,
+				packageContentProvider: packageContentProvider,
+				packageReplaceOptions:  packageReplaceOptions,
 
 				serviceConfigs: nil, // populated at interpretation time
+				replicaSets:    nil, // populated at interpretation time
 
 				resultUuids:     map[service.ServiceName]string{}, // populated at interpretation time
 				readyConditions: nil,                              // populated at interpretation time
+				livenessChecks:  nil,                              // populated at interpretation time
+				restartPolicies: nil,                              // populated at interpretation time
 			}
 		},
 
@@ -72,29 +87,46 @@ func NewAddServices(serviceNetwork service_network.ServiceNetwork, runtimeValueS
 }
 
 type AddServicesCapabilities struct {
-	serviceNetwork    service_network.ServiceNetwork
-	runtimeValueStore *runtime_value_store.RuntimeValueStore
+	serviceNetwork         service_network.ServiceNetwork
+	serviceHealthMonitor   *service_health.ServiceHealthMonitor
+	runtimeValueStore      *runtime_value_store.RuntimeValueStore
+	packageContentProvider startosis_packages.PackageContentProvider
+	packageReplaceOptions  map[string]string
 
 	serviceConfigs map[service.ServiceName]*service.ServiceConfig
 
+	// the names of the replicas of each replicated service, in the order of their index
+	replicaSets map[service.ServiceName][]service.ServiceName
+
 	readyConditions map[service.ServiceName]*service_config.ReadyCondition
 
+	livenessChecks  map[service.ServiceName]*service_config.LivenessCheck
+	restartPolicies map[service.ServiceName]*service_health.RestartPolicy
+
 	resultUuids map[service.ServiceName]string
 }
 
-func (builtin *AddServicesCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+func (builtin *AddServicesCapabilities) Interpret(locatorOfModuleInWhichThisBuiltInIsBeingCalled string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
 	ServiceConfigsDict, err := builtin_argument.ExtractArgumentValue[*starlark.Dict](arguments, ConfigsArgName)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConfigsArgName)
 	}
-	serviceConfigs, readyConditions, interpretationErr := validateAndConvertConfigsAndReadyConditions(builtin.serviceNetwork, ServiceConfigsDict)
+	serviceConfigs, readyConditions, replicaSets, interpretationErr := validateAndConvertConfigsAndReadyConditions(builtin.serviceNetwork, ServiceConfigsDict, locatorOfModuleInWhichThisBuiltInIsBeingCalled, builtin.packageContentProvider, builtin.packageReplaceOptions)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
 	builtin.serviceConfigs = serviceConfigs
+	builtin.replicaSets = replicaSets
 	builtin.readyConditions = readyConditions
 
-	resultUuids, returnValue, interpretationErr := makeAddServicesInterpretationReturnValue(builtin.serviceConfigs, builtin.runtimeValueStore)
+	livenessChecks, restartPolicies, interpretationErr := convertHealthConfigs(ServiceConfigsDict)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	builtin.livenessChecks = livenessChecks
+	builtin.restartPolicies = restartPolicies
+
+	resultUuids, returnValue, interpretationErr := makeAddServicesInterpretationReturnValue(builtin.serviceConfigs, builtin.replicaSets, builtin.runtimeValueStore)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
@@ -200,7 +232,27 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 		}
 		instructionResult.WriteString(fmt.Sprintf("\n  Service '%s' added with UUID '%s'", serviceName, serviceObj.GetRegistration().GetUUID()))
 	}
+	for serviceName := range startedAndUpdatedService {
+		if err := registerServiceHealthMonitoring(
+			builtin.serviceHealthMonitor,
+			builtin.serviceNetwork,
+			builtin.runtimeValueStore,
+			serviceName,
+			builtin.livenessChecks[serviceName],
+			builtin.restartPolicies[serviceName],
+		); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred setting up the health monitoring of service '%v'", serviceName)
+		}
+	}
 	shouldDeleteAllStartedServices = false
+
+	removedReplicaNames, err := builtin.removeScaledDownReplicas(ctx)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred removing the replicas that are not part of their replica set anymore")
+	}
+	for _, removedReplicaName := range removedReplicaNames {
+		instructionResult.WriteString(fmt.Sprintf("\n  Service '%s' removed as its replica set was scaled down", removedReplicaName))
+	}
 	return instructionResult.String(), nil
 }
 
@@ -248,7 +300,12 @@ func (builtin *AddServicesCapabilities) TryResolveWith(instructionsAreEqual bool
 			}
 		}
 	}
-	for _, servicePresentInCurrentInstruction := range previouslyAddedService {
+	for previouslyAddedServiceName, servicePresentInCurrentInstruction := range previouslyAddedService {
+		if !servicePresentInCurrentInstruction && builtin.isReplicaOfAnyReplicaSet(previouslyAddedServiceName) {
+			// the replica set was scaled down, the instruction can be re-run as it removes the replicas which are not
+			// part of their replica set anymore
+			continue
+		}
 		if !servicePresentInCurrentInstruction {
 			// if one service is not present in the current instruction, instruction cannot be re-run
 			for serviceName := range builtin.serviceConfigs {
@@ -283,6 +340,57 @@ func (builtin *AddServicesCapabilities) FillPersistableAttributes(builder *encla
 	}
 }
 
+// removeScaledDownReplicas removes the services of the enclave which were created as part of one of the replica sets of
+// this instruction, but which are not part of it anymore because its number of replicas was reduced. Those are found
+// from the replica labels of the services in the enclave so that this also works when the instruction isn't resolved
+// against a previous run
+func (builtin *AddServicesCapabilities) removeScaledDownReplicas(ctx context.Context) ([]service.ServiceName, error) {
+	if len(builtin.replicaSets) == 0 {
+		return nil, nil
+	}
+	existingServices, err := builtin.serviceNetwork.GetServices(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services of the enclave")
+	}
+	replicaNamesToRemove := []service.ServiceName{}
+	for _, existingService := range existingServices {
+		serviceName := existingService.GetRegistration().GetName()
+		replicaSetName, isReplica := getReplicaSetName(existingService.GetRegistration().GetConfig())
+		if !isReplica {
+			continue
+		}
+		if _, isReplicaSetOfThisInstruction := builtin.replicaSets[replicaSetName]; !isReplicaSetOfThisInstruction {
+			continue
+		}
+		if _, isStillPartOfReplicaSet := builtin.serviceConfigs[serviceName]; isStillPartOfReplicaSet {
+			continue
+		}
+		replicaNamesToRemove = append(replicaNamesToRemove, serviceName)
+	}
+	sort.Slice(replicaNamesToRemove, func(i, j int) bool {
+		return replicaNamesToRemove[i] < replicaNamesToRemove[j]
+	})
+
+	for _, replicaName := range replicaNamesToRemove {
+		if _, err := builtin.serviceNetwork.RemoveService(ctx, string(replicaName)); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred removing replica '%s'", replicaName)
+		}
+		if err := builtin.serviceHealthMonitor.UnregisterService(replicaName); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred stopping the health monitoring of removed replica '%s'", replicaName)
+		}
+	}
+	return replicaNamesToRemove, nil
+}
+
+func (builtin *AddServicesCapabilities) isReplicaOfAnyReplicaSet(serviceName service.ServiceName) bool {
+	for replicaSetName := range builtin.replicaSets {
+		if isReplicaOf(serviceName, replicaSetName) {
+			return true
+		}
+	}
+	return false
+}
+
 func (builtin *AddServicesCapabilities) removeAllStartedServices(
 	ctx context.Context,
 	startedServices map[service.ServiceName]*service.Service,
@@ -369,53 +477,117 @@ func (builtin *AddServicesCapabilities) runServiceReadinessCheck(
 func validateAndConvertConfigsAndReadyConditions(
 	serviceNetwork service_network.ServiceNetwork,
 	configs starlark.Value,
+	locatorOfModuleInWhichThisBuiltInIsBeingCalled string,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string,
 ) (
 	map[service.ServiceName]*service.ServiceConfig,
 	map[service.ServiceName]*service_config.ReadyCondition,
+	map[service.ServiceName][]service.ServiceName,
 	*startosis_errors.InterpretationError,
 ) {
 	configsDict, ok := configs.(*starlark.Dict)
 	if !ok {
-		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(configs))
+		return nil, nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(configs))
 	}
 	if configsDict.Len() == 0 {
-		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
+		return nil, nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
 	}
 	convertedServiceConfigs := map[service.ServiceName]*service.ServiceConfig{}
 	readyConditionsByServiceName := map[service.ServiceName]*service_config.ReadyCondition{}
+	replicaSets := map[service.ServiceName][]service.ServiceName{}
 	for _, serviceName := range configsDict.Keys() {
 		serviceNameStr, isServiceNameAString := serviceName.(starlark.String)
 		if !isServiceNameAString {
-			return nil, nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s'). Keys of this argument should correspond to service names, which should be strings", ConfigsArgName, reflect.TypeOf(serviceName))
+			return nil, nil, nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s'). Keys of this argument should correspond to service names, which should be strings", ConfigsArgName, reflect.TypeOf(serviceName))
 		}
 
 		dictValue, found, err := configsDict.Get(serviceName)
 		if err != nil || !found {
-			return nil, nil, startosis_errors.NewInterpretationError("Could not extract the value of the '%s' dictionary for key '%s'. This is Kurtosis bug", ConfigsArgName, serviceName)
+			return nil, nil, nil, startosis_errors.NewInterpretationError("Could not extract the value of the '%s' dictionary for key '%s'. This is Kurtosis bug", ConfigsArgName, serviceName)
 		}
 		serviceConfig, isDictValueAServiceConfig := dictValue.(*service_config.ServiceConfig)
 		if !isDictValueAServiceConfig {
-			return nil, nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
+			return nil, nil, nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
 		}
-		apiServiceConfig, interpretationErr := serviceConfig.ToKurtosisType(serviceNetwork)
+		apiServiceConfig, interpretationErr := serviceConfig.ToKurtosisType(serviceNetwork, locatorOfModuleInWhichThisBuiltInIsBeingCalled, packageContentProvider, packageReplaceOptions)
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
+		}
+		if interpretationErr := validateNoReservedReplicaLabels(apiServiceConfig); interpretationErr != nil {
+			return nil, nil, nil, startosis_errors.WrapWithInterpretationError(interpretationErr, "The config of service '%s' is invalid", serviceNameStr.GoString())
 		}
-		convertedServiceConfigs[service.ServiceName(serviceNameStr.GoString())] = apiServiceConfig
 
 		readyConditions, interpretationErr := serviceConfig.GetReadyCondition()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 
-		readyConditionsByServiceName[service.ServiceName(serviceNameStr.GoString())] = readyConditions
+		replicaSetName := service.ServiceName(serviceNameStr.GoString())
+		serviceNames, isReplicated, interpretationErr := GetServiceNamesForConfig(replicaSetName, serviceConfig)
+		if interpretationErr != nil {
+			return nil, nil, nil, interpretationErr
+		}
+		if isReplicated && len(serviceNames) > 1 && len(apiServiceConfig.GetPublicPorts()) > 0 {
+			return nil, nil, nil, startosis_errors.NewInterpretationError("Service '%s' has more than one replica and public ports, but all its replicas can't be bound to the same public ports. Public ports can only be set on a service with a single replica", replicaSetName)
+		}
+		for replicaIndex, serviceNameToAdd := range serviceNames {
+			if _, found := convertedServiceConfigs[serviceNameToAdd]; found {
+				return nil, nil, nil, startosis_errors.NewInterpretationError("Service '%s' is defined more than once in the '%s' dictionary. Note that the replicas of a service with '%s' set are named '<service-name>%s<replica-index>'", serviceNameToAdd, ConfigsArgName, service_config.ReplicasAttr, replicaNameSeparator)
+			}
+			serviceConfigToAdd := apiServiceConfig
+			if isReplicated {
+				replicaServiceConfig, err := makeReplicaServiceConfig(apiServiceConfig, replicaSetName, uint32(replicaIndex))
+				if err != nil {
+					return nil, nil, nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating the config of replica '%s'", serviceNameToAdd)
+				}
+				serviceConfigToAdd = replicaServiceConfig
+			}
+			convertedServiceConfigs[serviceNameToAdd] = serviceConfigToAdd
+			readyConditionsByServiceName[serviceNameToAdd] = readyConditions
+		}
+		if isReplicated {
+			replicaSets[replicaSetName] = serviceNames
+		}
 	}
-	return convertedServiceConfigs, readyConditionsByServiceName, nil
+	return convertedServiceConfigs, readyConditionsByServiceName, replicaSets, nil
 }
 
-func makeAddServicesInterpretationReturnValue(serviceConfigs map[service.ServiceName]*service.ServiceConfig, runtimeValueStore *runtime_value_store.RuntimeValueStore) (map[service.ServiceName]string, *starlark.Dict, *startosis_errors.InterpretationError) {
+func convertHealthConfigs(configsDict *starlark.Dict) (
+	map[service.ServiceName]*service_config.LivenessCheck,
+	map[service.ServiceName]*service_health.RestartPolicy,
+	*startosis_errors.InterpretationError,
+) {
+	livenessChecksByServiceName := map[service.ServiceName]*service_config.LivenessCheck{}
+	restartPoliciesByServiceName := map[service.ServiceName]*service_health.RestartPolicy{}
+	for _, serviceNameAndConfig := range configsDict.Items() {
+		serviceNameStr, isServiceNameAString := serviceNameAndConfig[0].(starlark.String)
+		serviceConfig, isDictValueAServiceConfig := serviceNameAndConfig[1].(*service_config.ServiceConfig)
+		if !isServiceNameAString || !isDictValueAServiceConfig {
+			return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, configsDict.String())
+		}
+		livenessCheck, restartPolicy, interpretationErr := convertHealthConfig(serviceConfig)
+		if interpretationErr != nil {
+			return nil, nil, interpretationErr
+		}
+		serviceNames, _, interpretationErr := GetServiceNamesForConfig(service.ServiceName(serviceNameStr.GoString()), serviceConfig)
+		if interpretationErr != nil {
+			return nil, nil, interpretationErr
+		}
+		for _, serviceName := range serviceNames {
+			livenessChecksByServiceName[serviceName] = livenessCheck
+			restartPoliciesByServiceName[serviceName] = restartPolicy
+		}
+	}
+	return livenessChecksByServiceName, restartPoliciesByServiceName, nil
+}
+
+// makeAddServicesInterpretationReturnValue returns a dictionary mapping each service name to its Service object, or to
+// the list of the Service objects of its replicas for the services with replicas
+func makeAddServicesInterpretationReturnValue(serviceConfigs map[service.ServiceName]*service.ServiceConfig, replicaSets map[service.ServiceName][]service.ServiceName, runtimeValueStore *runtime_value_store.RuntimeValueStore) (map[service.ServiceName]string, *starlark.Dict, *startosis_errors.InterpretationError) {
 	servicesObjectDict := starlark.NewDict(len(serviceConfigs))
 	resultUuids := map[service.ServiceName]string{}
+	serviceObjects := map[service.ServiceName]starlark.Value{}
 	var err error
 	for serviceName, serviceConfig := range serviceConfigs {
 		serviceNameStr := starlark.String(serviceName)
@@ -427,7 +599,19 @@ func makeAddServicesInterpretationReturnValue(serviceConfigs map[service.Service
 		if interpretationErr != nil {
 			return nil, nil, interpretationErr
 		}
-		if err := servicesObjectDict.SetKey(serviceNameStr, serviceObject); err != nil {
+		serviceObjects[serviceName] = serviceObject
+	}
+
+	for replicaSetName, replicaNames := range replicaSets {
+		replicaObjects := make([]starlark.Value, len(replicaNames))
+		for replicaIndex, replicaName := range replicaNames {
+			replicaObjects[replicaIndex] = serviceObjects[replicaName]
+			delete(serviceObjects, replicaName)
+		}
+		serviceObjects[replicaSetName] = starlark.NewList(replicaObjects)
+	}
+	for serviceName, serviceObject := range serviceObjects {
+		if err := servicesObjectDict.SetKey(starlark.String(serviceName), serviceObject); err != nil {
 			return nil, nil, startosis_errors.WrapWithInterpretationError(err, "Unable to generate the object that should be returned by the '%s' builtin", AddServicesBuiltinName)
 		}
 	}
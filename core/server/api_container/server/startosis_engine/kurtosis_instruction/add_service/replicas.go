@@ -0,0 +1,138 @@
+package add_service
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ReplicaIndexPlaceholder is replaced with the index of the replica in the entrypoint, command and environment
+	// variables of each replica of a service
+	ReplicaIndexPlaceholder = "{{kurtosis.replica_index}}"
+
+	replicaNameSeparator = "-"
+
+	// Labels set on every replica so that replicas that are not part of the replica set anymore can be found and
+	// removed when the replica set is scaled down
+	replicaSetLabelKey   = "replica-set"
+	replicaIndexLabelKey = "replica-index"
+)
+
+func getReplicaName(replicaSetName service.ServiceName, replicaIndex uint32) service.ServiceName {
+	return service.ServiceName(fmt.Sprintf("%s%s%d", replicaSetName, replicaNameSeparator, replicaIndex))
+}
+
+// isReplicaOf returns true if the service name has the shape of a replica name of the replica set
+func isReplicaOf(serviceName service.ServiceName, replicaSetName service.ServiceName) bool {
+	replicaSetNamePrefix := string(replicaSetName) + replicaNameSeparator
+	if !strings.HasPrefix(string(serviceName), replicaSetNamePrefix) {
+		return false
+	}
+	replicaIndexStr := strings.TrimPrefix(string(serviceName), replicaSetNamePrefix)
+	_, err := strconv.ParseUint(replicaIndexStr, 10, 32)
+	return err == nil
+}
+
+// GetServiceNamesForConfig returns the names of the services that the config will create: the service name itself if
+// no replicas are set, otherwise one name per replica in the order of their index
+func GetServiceNamesForConfig(serviceName service.ServiceName, serviceConfig *service_config.ServiceConfig) ([]service.ServiceName, bool, *startosis_errors.InterpretationError) {
+	replicas, isReplicated, interpretationErr := serviceConfig.GetReplicasIfSet()
+	if interpretationErr != nil {
+		return nil, false, interpretationErr
+	}
+	if !isReplicated {
+		return []service.ServiceName{serviceName}, false, nil
+	}
+	replicaNames := make([]service.ServiceName, replicas)
+	for replicaIndex := uint32(0); replicaIndex < replicas; replicaIndex++ {
+		replicaNames[replicaIndex] = getReplicaName(serviceName, replicaIndex)
+	}
+	return replicaNames, true, nil
+}
+
+// makeReplicaServiceConfig returns a copy of the service config where the replica index placeholder is replaced by
+// the index of the replica, and which is labelled as being part of the replica set
+func makeReplicaServiceConfig(serviceConfig *service.ServiceConfig, replicaSetName service.ServiceName, replicaIndex uint32) (*service.ServiceConfig, error) {
+	replicaIndexStr := strconv.FormatUint(uint64(replicaIndex), 10)
+	replacePlaceholder := func(value string) string {
+		return strings.ReplaceAll(value, ReplicaIndexPlaceholder, replicaIndexStr)
+	}
+
+	var entrypointArgs []string
+	if serviceConfig.GetEntrypointArgs() != nil {
+		entrypointArgs = make([]string, len(serviceConfig.GetEntrypointArgs()))
+		for index, entrypointArg := range serviceConfig.GetEntrypointArgs() {
+			entrypointArgs[index] = replacePlaceholder(entrypointArg)
+		}
+	}
+
+	var cmdArgs []string
+	if serviceConfig.GetCmdArgs() != nil {
+		cmdArgs = make([]string, len(serviceConfig.GetCmdArgs()))
+		for index, cmdArg := range serviceConfig.GetCmdArgs() {
+			cmdArgs[index] = replacePlaceholder(cmdArg)
+		}
+	}
+
+	var envVars map[string]string
+	if serviceConfig.GetEnvVars() != nil {
+		envVars = make(map[string]string, len(serviceConfig.GetEnvVars()))
+		for envVarName, envVarValue := range serviceConfig.GetEnvVars() {
+			envVars[envVarName] = replacePlaceholder(envVarValue)
+		}
+	}
+
+	labels := make(map[string]string, len(serviceConfig.GetLabels())+2) //nolint:gomnd
+	for labelKey, labelValue := range serviceConfig.GetLabels() {
+		labels[labelKey] = labelValue
+	}
+	labels[replicaSetLabelKey] = string(replicaSetName)
+	labels[replicaIndexLabelKey] = replicaIndexStr
+
+	replicaServiceConfig, err := service.CreateServiceConfig(
+		serviceConfig.GetContainerImageName(),
+		serviceConfig.GetImageBuildSpec(),
+		serviceConfig.GetPrivatePorts(),
+		serviceConfig.GetPublicPorts(),
+		entrypointArgs,
+		cmdArgs,
+		envVars,
+		serviceConfig.GetFilesArtifactsExpansion(),
+		serviceConfig.GetPersistentDirectories(),
+		serviceConfig.GetCPUAllocationMillicpus(),
+		serviceConfig.GetMemoryAllocationMegabytes(),
+		serviceConfig.GetPrivateIPAddrPlaceholder(),
+		serviceConfig.GetMinCPUAllocationMillicpus(),
+		serviceConfig.GetMinMemoryAllocationMegabytes(),
+		labels,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the service config of replica '%d' of '%s'", replicaIndex, replicaSetName)
+	}
+	return replicaServiceConfig, nil
+}
+
+// validateNoReservedReplicaLabels returns an error if one of the labels Kurtosis sets on the replicas is set in the
+// config, as a service carrying them would be taken for a replica and removed when its replica set is scaled down
+func validateNoReservedReplicaLabels(serviceConfig *service.ServiceConfig) *startosis_errors.InterpretationError {
+	for _, reservedLabelKey := range []string{replicaSetLabelKey, replicaIndexLabelKey} {
+		if _, found := serviceConfig.GetLabels()[reservedLabelKey]; found {
+			return startosis_errors.NewInterpretationError("Label '%s' is reserved by Kurtosis to keep track of the replicas of a service and can't be set in a service config", reservedLabelKey)
+		}
+	}
+	return nil
+}
+
+// getReplicaSetName returns the name of the replica set the service belongs to, based on its labels
+func getReplicaSetName(serviceConfig *service.ServiceConfig) (service.ServiceName, bool) {
+	if serviceConfig == nil {
+		return "", false
+	}
+	replicaSetName, found := serviceConfig.GetLabels()[replicaSetLabelKey]
+	return service.ServiceName(replicaSetName), found
+}
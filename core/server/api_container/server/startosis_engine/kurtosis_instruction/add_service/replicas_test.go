@@ -0,0 +1,88 @@
+package add_service
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testReplicaSetName = service.ServiceName("node")
+)
+
+func TestReplicas_ReplicaIndexPlaceholderIsReplaced(t *testing.T) {
+	serviceConfig, err := service.CreateServiceConfig(
+		testContainerImageName,
+		nil,
+		nil,
+		nil,
+		[]string{"/entrypoint.sh", "--id=" + ReplicaIndexPlaceholder},
+		[]string{"run", "--data-dir=/data/" + ReplicaIndexPlaceholder},
+		map[string]string{
+			"NODE_INDEX": ReplicaIndexPlaceholder,
+			"NODE_NAME":  "node-" + ReplicaIndexPlaceholder,
+		},
+		nil,
+		nil,
+		0,
+		0,
+		"",
+		0,
+		0,
+		map[string]string{
+			"role": "validator",
+		},
+	)
+	require.NoError(t, err)
+
+	replicaServiceConfig, err := makeReplicaServiceConfig(serviceConfig, testReplicaSetName, 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/entrypoint.sh", "--id=3"}, replicaServiceConfig.GetEntrypointArgs())
+	require.Equal(t, []string{"run", "--data-dir=/data/3"}, replicaServiceConfig.GetCmdArgs())
+	require.Equal(t, map[string]string{
+		"NODE_INDEX": "3",
+		"NODE_NAME":  "node-3",
+	}, replicaServiceConfig.GetEnvVars())
+	require.Equal(t, map[string]string{
+		"role":               "validator",
+		replicaSetLabelKey:   string(testReplicaSetName),
+		replicaIndexLabelKey: "3",
+	}, replicaServiceConfig.GetLabels())
+
+	// the original config is left untouched as it is shared by all replicas
+	require.Equal(t, "validator", serviceConfig.GetLabels()["role"])
+	require.NotContains(t, serviceConfig.GetLabels(), replicaSetLabelKey)
+	require.Equal(t, ReplicaIndexPlaceholder, serviceConfig.GetEnvVars()["NODE_INDEX"])
+
+	replicaSetName, isReplica := getReplicaSetName(replicaServiceConfig)
+	require.True(t, isReplica)
+	require.Equal(t, testReplicaSetName, replicaSetName)
+	_, isReplica = getReplicaSetName(serviceConfig)
+	require.False(t, isReplica)
+}
+
+func TestReplicas_IsReplicaOf(t *testing.T) {
+	require.True(t, isReplicaOf(getReplicaName(testReplicaSetName, 0), testReplicaSetName))
+	require.True(t, isReplicaOf("node-12", testReplicaSetName))
+	require.False(t, isReplicaOf("node", testReplicaSetName))
+	require.False(t, isReplicaOf("node-", testReplicaSetName))
+	require.False(t, isReplicaOf("node-a", testReplicaSetName))
+	require.False(t, isReplicaOf("node-1-0", testReplicaSetName))
+	require.False(t, isReplicaOf("other-node-1", testReplicaSetName))
+}
+
+func TestReplicas_ReservedLabelsAreRejected(t *testing.T) {
+	for _, reservedLabelKey := range []string{replicaSetLabelKey, replicaIndexLabelKey} {
+		serviceConfig, err := service.CreateServiceConfig(testContainerImageName, nil, nil, nil, nil, nil, nil, nil, nil, 0, 0, "", 0, 0, map[string]string{
+			reservedLabelKey: "0",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, validateNoReservedReplicaLabels(serviceConfig), "Label '%s' should have been rejected", reservedLabelKey)
+	}
+
+	serviceConfig, err := service.CreateServiceConfig(testContainerImageName, nil, nil, nil, nil, nil, nil, nil, nil, 0, 0, "", 0, 0, map[string]string{
+		"role": "validator",
+	})
+	require.NoError(t, err)
+	require.Nil(t, validateNoReservedReplicaLabels(serviceConfig))
+}
@@ -0,0 +1,138 @@
+package connection
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"go.starlark.net/starlark"
+	"sort"
+	"strings"
+)
+
+// shared constants
+const (
+	GroupAArgName           = "group_a"
+	GroupBArgName           = "group_b"
+	ConnectionConfigArgName = "config"
+
+	serviceNamesSeparator = ", "
+)
+
+func validateServiceGroup(value starlark.Value, argNameForLogging string) *startosis_errors.InterpretationError {
+	serviceNames, interpretationErr := kurtosis_types.SafeCastToStringSlice(value, argNameForLogging)
+	if interpretationErr != nil {
+		return interpretationErr
+	}
+	if len(serviceNames) == 0 {
+		return startosis_errors.NewInterpretationError("Value for '%s' was an empty list. At least one service name is required", argNameForLogging)
+	}
+	for _, serviceName := range serviceNames {
+		if serviceName == "" {
+			return startosis_errors.NewInterpretationError("Value for '%s' contained an empty service name. This is disallowed", argNameForLogging)
+		}
+	}
+	return nil
+}
+
+// parseServiceGroups extracts the two groups of services of the instruction, making sure a service doesn't belong to
+// both groups as a service cannot be connected to itself
+func parseServiceGroups(arguments *builtin_argument.ArgumentValuesSet) (map[service.ServiceName]bool, map[service.ServiceName]bool, *startosis_errors.InterpretationError) {
+	groupA, interpretationErr := parseServiceGroup(arguments, GroupAArgName)
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+	groupB, interpretationErr := parseServiceGroup(arguments, GroupBArgName)
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+	for serviceName := range groupA {
+		if _, found := groupB[serviceName]; found {
+			return nil, nil, startosis_errors.NewInterpretationError("Service '%s' belongs to both '%s' and '%s'. A service cannot be connected to itself", serviceName, GroupAArgName, GroupBArgName)
+		}
+	}
+	return groupA, groupB, nil
+}
+
+func parseServiceGroup(arguments *builtin_argument.ArgumentValuesSet, argName string) (map[service.ServiceName]bool, *startosis_errors.InterpretationError) {
+	serviceGroupValue, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, argName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", argName)
+	}
+	serviceNames, interpretationErr := kurtosis_types.SafeCastToStringSlice(serviceGroupValue, argName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	serviceGroup := map[service.ServiceName]bool{}
+	for _, serviceName := range serviceNames {
+		serviceGroup[service.ServiceName(serviceName)] = true
+	}
+	return serviceGroup, nil
+}
+
+func parseConnectionConfig(arguments *builtin_argument.ArgumentValuesSet) (*connection_config.ConnectionConfig, *startosis_errors.InterpretationError) {
+	connectionConfig, err := builtin_argument.ExtractArgumentValue[*connection_config.ConnectionConfig](arguments, ConnectionConfigArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConnectionConfigArgName)
+	}
+	return connectionConfig, nil
+}
+
+func validateServicesExist(instructionName string, validatorEnvironment *startosis_validator.ValidatorEnvironment, serviceGroups ...map[service.ServiceName]bool) *startosis_errors.ValidationError {
+	for _, serviceGroup := range serviceGroups {
+		for serviceName := range serviceGroup {
+			if validatorEnvironment.DoesServiceNameExist(serviceName) == startosis_validator.ComponentNotFound {
+				return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' doesn't exist", instructionName, serviceName)
+			}
+		}
+	}
+	return nil
+}
+
+// tryResolveWith re-runs the instruction if one of the services it connects has been updated, as the connection
+// configs need to be applied to the new containers
+func tryResolveWith(instructionsAreEqual bool, enclaveComponents *enclave_structure.EnclaveComponents, serviceGroups ...map[service.ServiceName]bool) enclave_structure.InstructionResolutionStatus {
+	if !instructionsAreEqual {
+		return enclave_structure.InstructionIsUnknown
+	}
+	for _, serviceGroup := range serviceGroups {
+		for serviceName := range serviceGroup {
+			if enclaveComponents.HasServiceBeenUpdated(serviceName) {
+				return enclave_structure.InstructionIsUpdate
+			}
+		}
+	}
+	return enclave_structure.InstructionIsEqual
+}
+
+func fillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder, instructionName string, serviceGroups ...map[service.ServiceName]bool) {
+	builder.SetType(instructionName)
+	for _, serviceName := range getSortedServiceNames(serviceGroups...) {
+		builder.AddServiceName(serviceName)
+	}
+}
+
+func getSortedServiceNames(serviceGroups ...map[service.ServiceName]bool) []service.ServiceName {
+	serviceNames := []service.ServiceName{}
+	for _, serviceGroup := range serviceGroups {
+		for serviceName := range serviceGroup {
+			serviceNames = append(serviceNames, serviceName)
+		}
+	}
+	sort.Slice(serviceNames, func(i, j int) bool {
+		return serviceNames[i] < serviceNames[j]
+	})
+	return serviceNames
+}
+
+func serviceGroupToString(serviceGroup map[service.ServiceName]bool) string {
+	serviceNamesStr := []string{}
+	for _, serviceName := range getSortedServiceNames(serviceGroup) {
+		serviceNamesStr = append(serviceNamesStr, string(serviceName))
+	}
+	return strings.Join(serviceNamesStr, serviceNamesSeparator)
+}
@@ -0,0 +1,121 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	SetConnectionBuiltinName = "set_connection"
+)
+
+func NewSetConnection(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: SetConnectionBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              GroupAArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateServiceGroup(value, GroupAArgName)
+					},
+				},
+				{
+					Name:              GroupBArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateServiceGroup(value, GroupBArgName)
+					},
+				},
+				{
+					Name:              ConnectionConfigArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*connection_config.ConnectionConfig],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &SetConnectionCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				groupA:           nil,                                             // populated at interpretation time
+				groupB:           nil,                                             // populated at interpretation time
+				connectionConfig: partition_topology.NewDefaultConnectionConfig(), // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			GroupAArgName:           true,
+			GroupBArgName:           true,
+			ConnectionConfigArgName: true,
+		},
+	}
+}
+
+type SetConnectionCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	groupA           map[service.ServiceName]bool
+	groupB           map[service.ServiceName]bool
+	connectionConfig partition_topology.ConnectionConfig
+}
+
+func (builtin *SetConnectionCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	groupA, groupB, interpretationErr := parseServiceGroups(arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	connectionConfigStarlark, interpretationErr := parseConnectionConfig(arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	connectionConfig, interpretationErr := connectionConfigStarlark.ToKurtosisType()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	builtin.groupA = groupA
+	builtin.groupB = groupB
+	builtin.connectionConfig = connectionConfig
+	return starlark.None, nil
+}
+
+func (builtin *SetConnectionCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	return validateServicesExist(SetConnectionBuiltinName, validatorEnvironment, builtin.groupA, builtin.groupB)
+}
+
+func (builtin *SetConnectionCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := builtin.serviceNetwork.SetConnection(ctx, builtin.groupA, builtin.groupB, builtin.connectionConfig); err != nil {
+		return "", stacktrace.Propagate(err, "Failed setting connection between services [%s] and services [%s]", serviceGroupToString(builtin.groupA), serviceGroupToString(builtin.groupB))
+	}
+	instructionResult := fmt.Sprintf("Connection between services [%s] and services [%s] set", serviceGroupToString(builtin.groupA), serviceGroupToString(builtin.groupB))
+	return instructionResult, nil
+}
+
+func (builtin *SetConnectionCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	return tryResolveWith(instructionsAreEqual, enclaveComponents, builtin.groupA, builtin.groupB)
+}
+
+func (builtin *SetConnectionCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	fillPersistableAttributes(builder, SetConnectionBuiltinName, builtin.groupA, builtin.groupB)
+}
@@ -0,0 +1,123 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	UpdateConnectionBuiltinName = "update_connection"
+)
+
+func NewUpdateConnection(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: UpdateConnectionBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              GroupAArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateServiceGroup(value, GroupAArgName)
+					},
+				},
+				{
+					Name:              GroupBArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateServiceGroup(value, GroupBArgName)
+					},
+				},
+				{
+					Name:              ConnectionConfigArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*connection_config.ConnectionConfig],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &UpdateConnectionCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				groupA:                    nil, // populated at interpretation time
+				groupB:                    nil, // populated at interpretation time
+				connectionConfigOverrides: nil, // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			GroupAArgName:           true,
+			GroupBArgName:           true,
+			ConnectionConfigArgName: true,
+		},
+	}
+}
+
+// UpdateConnectionCapabilities only changes the attributes explicitly set in the connection config, the other ones are
+// left to their current value
+type UpdateConnectionCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	groupA                    map[service.ServiceName]bool
+	groupB                    map[service.ServiceName]bool
+	connectionConfigOverrides *partition_topology.ConnectionConfigOverrides
+}
+
+func (builtin *UpdateConnectionCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	groupA, groupB, interpretationErr := parseServiceGroups(arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	connectionConfigStarlark, interpretationErr := parseConnectionConfig(arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	connectionConfigOverrides, interpretationErr := connectionConfigStarlark.ToOverrides()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	builtin.groupA = groupA
+	builtin.groupB = groupB
+	builtin.connectionConfigOverrides = connectionConfigOverrides
+	return starlark.None, nil
+}
+
+func (builtin *UpdateConnectionCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	return validateServicesExist(UpdateConnectionBuiltinName, validatorEnvironment, builtin.groupA, builtin.groupB)
+}
+
+func (builtin *UpdateConnectionCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := builtin.serviceNetwork.UpdateConnection(ctx, builtin.groupA, builtin.groupB, builtin.connectionConfigOverrides); err != nil {
+		return "", stacktrace.Propagate(err, "Failed updating connection between services [%s] and services [%s]", serviceGroupToString(builtin.groupA), serviceGroupToString(builtin.groupB))
+	}
+	instructionResult := fmt.Sprintf("Connection between services [%s] and services [%s] updated", serviceGroupToString(builtin.groupA), serviceGroupToString(builtin.groupB))
+	return instructionResult, nil
+}
+
+func (builtin *UpdateConnectionCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	return tryResolveWith(instructionsAreEqual, enclaveComponents, builtin.groupA, builtin.groupB)
+}
+
+func (builtin *UpdateConnectionCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	fillPersistableAttributes(builder, UpdateConnectionBuiltinName, builtin.groupA, builtin.groupB)
+}
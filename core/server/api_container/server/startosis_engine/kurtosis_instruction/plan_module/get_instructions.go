@@ -0,0 +1,50 @@
+package plan_module
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	GetInstructionsBuiltinName = "get_instructions"
+
+	InstructionNameAttr      = "name"
+	InstructionArgumentsAttr = "arguments"
+
+	isSkipped = false
+)
+
+// GenerateGetInstructionsBuiltin returns a builtin listing the instructions added to the plan so far, so that Starlark
+// code, typically package tests, can make assertions on the plan it built. Each instruction is a struct with its name
+// and a dict of its named arguments, the values being serialized the way they are displayed when the plan is printed
+func GenerateGetInstructionsBuiltin(instructionsPlan *instructions_plan.InstructionsPlan) func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	return func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		if err := starlark.UnpackArgs(b.Name(), args, kwargs); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "'%s' doesn't take any argument", GetInstructionsBuiltinName)
+		}
+		scheduledInstructions, interpretationErr := instructionsPlan.GeneratePlan()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		instructions := make([]starlark.Value, 0, len(scheduledInstructions))
+		for _, scheduledInstruction := range scheduledInstructions {
+			canonicalInstruction := scheduledInstruction.GetInstruction().GetCanonicalInstruction(isSkipped)
+			arguments := starlark.NewDict(len(canonicalInstruction.GetArguments()))
+			for _, argument := range canonicalInstruction.GetArguments() {
+				if argument.ArgName == nil {
+					continue
+				}
+				if err := arguments.SetKey(starlark.String(argument.GetArgName()), starlark.String(argument.GetSerializedArgValue())); err != nil {
+					return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred listing the arguments of instruction '%s'", canonicalInstruction.GetInstructionName())
+				}
+			}
+			instructions = append(instructions, starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+				InstructionNameAttr:      starlark.String(canonicalInstruction.GetInstructionName()),
+				InstructionArgumentsAttr: arguments,
+			}))
+		}
+		return starlark.NewList(instructions), nil
+	}
+}
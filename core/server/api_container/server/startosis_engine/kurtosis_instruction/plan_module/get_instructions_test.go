@@ -0,0 +1,48 @@
+package plan_module
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	isRepresentative = true
+)
+
+func TestGetInstructions(t *testing.T) {
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(createMockInstruction(t, "add_service", []*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg{
+		binding_constructors.NewStarlarkInstructionKwarg(`"web"`, "name", isRepresentative),
+		binding_constructors.NewStarlarkInstructionArg(`ServiceConfig(image="nginx")`, !isRepresentative),
+	}), starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(createMockInstruction(t, "print", nil), starlark.None))
+
+	getInstructions := starlark.NewBuiltin(GetInstructionsBuiltinName, GenerateGetInstructionsBuiltin(instructionsPlan))
+	result, err := starlark.Call(&starlark.Thread{}, getInstructions, starlark.Tuple{}, nil) //nolint:exhaustruct
+	require.Nil(t, err)
+
+	instructions, ok := result.(*starlark.List)
+	require.True(t, ok)
+	require.Equal(t, 2, instructions.Len())
+	require.Equal(t, `struct(arguments = {"name": "\"web\""}, name = "add_service")`, instructions.Index(0).String())
+	require.Equal(t, `struct(arguments = {}, name = "print")`, instructions.Index(1).String())
+}
+
+func TestGetInstructions_DoesNotTakeArguments(t *testing.T) {
+	getInstructions := starlark.NewBuiltin(GetInstructionsBuiltinName, GenerateGetInstructionsBuiltin(instructions_plan.NewInstructionsPlan()))
+	_, err := starlark.Call(&starlark.Thread{}, getInstructions, starlark.Tuple{starlark.String("add_service")}, nil) //nolint:exhaustruct
+	require.NotNil(t, err)
+}
+
+func createMockInstruction(t *testing.T, instructionName string, arguments []*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg) *mock_instruction.MockKurtosisInstruction {
+	instruction := mock_instruction.NewMockKurtosisInstruction(t)
+	canonicalInstruction := binding_constructors.NewStarlarkInstruction(nil, instructionName, instructionName+"()", arguments, isSkipped)
+	instruction.EXPECT().GetCanonicalInstruction(mock.Anything).Maybe().Return(canonicalInstruction)
+	return instruction
+}
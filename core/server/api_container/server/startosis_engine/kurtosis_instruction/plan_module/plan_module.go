@@ -26,6 +26,7 @@ func PlanModule(
 		wrappedPlanInstruction := kurtosis_plan_instruction.NewKurtosisPlanInstructionWrapper(planInstruction, enclaveComponents, starlarkValueSerde, instructionsPlanMask, instructionsPlan)
 		moduleBuiltins[planInstruction.GetName()] = starlark.NewBuiltin(planInstruction.GetName(), wrappedPlanInstruction.CreateBuiltin())
 	}
+	moduleBuiltins[GetInstructionsBuiltinName] = starlark.NewBuiltin(GetInstructionsBuiltinName, GenerateGetInstructionsBuiltin(instructionsPlan))
 
 	return &starlarkstruct.Module{
 		Name:    planModuleName,
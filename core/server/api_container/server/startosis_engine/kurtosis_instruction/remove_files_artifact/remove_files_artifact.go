@@ -0,0 +1,98 @@
+package remove_files_artifact
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	RemoveFilesArtifactBuiltinName = "remove_files_artifact"
+
+	FilesArtifactNameArgName = "name"
+)
+
+func NewRemoveFilesArtifact(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RemoveFilesArtifactBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              FilesArtifactNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, FilesArtifactNameArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &RemoveFilesArtifactCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				filesArtifactName: "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			FilesArtifactNameArgName: true,
+		},
+	}
+}
+
+type RemoveFilesArtifactCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	filesArtifactName string
+}
+
+func (builtin *RemoveFilesArtifactCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	filesArtifactName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, FilesArtifactNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", FilesArtifactNameArgName)
+	}
+
+	builtin.filesArtifactName = filesArtifactName.GoString()
+	return starlark.None, nil
+}
+
+func (builtin *RemoveFilesArtifactCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if validatorEnvironment.DoesArtifactNameExist(builtin.filesArtifactName) == startosis_validator.ComponentNotFound {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as files artifact name '%v' doesn't exist", RemoveFilesArtifactBuiltinName, builtin.filesArtifactName)
+	}
+	validatorEnvironment.RemoveArtifactName(builtin.filesArtifactName)
+	return nil
+}
+
+func (builtin *RemoveFilesArtifactCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	filesArtifactUuid, err := builtin.serviceNetwork.RemoveFilesArtifact(builtin.filesArtifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed removing files artifact with unexpected error")
+	}
+	instructionResult := fmt.Sprintf("Files artifact '%s' with artifact UUID '%s' removed", builtin.filesArtifactName, filesArtifactUuid)
+	return instructionResult, nil
+}
+
+func (builtin *RemoveFilesArtifactCapabilities) TryResolveWith(_ bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	return enclave_structure.InstructionIsNotResolvableAbort
+}
+
+func (builtin *RemoveFilesArtifactCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(
+		RemoveFilesArtifactBuiltinName,
+	).AddFilesArtifact(
+		builtin.filesArtifactName, nil,
+	)
+}
@@ -78,6 +78,7 @@ func (builtin *RemoveServiceCapabilities) Validate(_ *builtin_argument.ArgumentV
 	validatorEnvironment.RemoveServiceFromPrivatePortIDMapping(builtin.serviceName)
 	validatorEnvironment.FreeMemory(builtin.serviceName)
 	validatorEnvironment.FreeCPU(builtin.serviceName)
+	validatorEnvironment.FreeQuota(builtin.serviceName)
 	return nil
 }
 
@@ -74,6 +74,71 @@ func ExecuteServiceAssertionWithRecipe(
 
 }
 
+// ExecuteServiceAssertionWithRecipeUntilDone behaves like ExecuteServiceAssertionWithRecipe except that it does not own
+// a timeout: the recipe is retried every 'interval' until the assertion passes or 'ctx' is done, which lets several
+// assertions share one deadline and be stopped together by the caller
+func ExecuteServiceAssertionWithRecipeUntilDone(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	recipe recipe.Recipe,
+	valueField string,
+	assertion string,
+	target starlark.Comparable,
+	interval time.Duration,
+) (map[string]starlark.Comparable, int, error) {
+	tickChan := time.NewTicker(interval)
+	defer tickChan.Stop()
+	executionTickChan := make(chan time.Time, bufferedChannelSize)
+	executionTickChan <- time.Now()
+	go func() {
+		for {
+			select {
+			case tick := <-tickChan.C:
+				select {
+				case executionTickChan <- tick:
+				default:
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	interruptChan := make(chan time.Time)
+	go func() {
+		<-ctx.Done()
+		close(interruptChan)
+	}()
+
+	execFunc := func() (map[string]starlark.Comparable, error) {
+		return execRequestAndGetValue(ctx, serviceNetwork, runtimeValueStore, serviceName, recipe, valueField)
+	}
+	assertFunc := func(currentResult map[string]starlark.Comparable) error {
+		return assertResult(currentResult[valueField], assertion, target)
+	}
+	return executeServiceAssertionWithRecipeWithTicker(serviceName, execFunc, assertFunc, executionTickChan, interruptChan)
+}
+
+// ExecuteServiceAssertionWithRecipeOnce executes the recipe a single time against the service and asserts its result,
+// returning an error if either the execution or the assertion failed
+func ExecuteServiceAssertionWithRecipeOnce(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	recipe recipe.Recipe,
+	valueField string,
+	assertion string,
+	target starlark.Comparable,
+) error {
+	result, err := execRequestAndGetValue(ctx, serviceNetwork, runtimeValueStore, serviceName, recipe, valueField)
+	if err != nil {
+		return err
+	}
+	return assertResult(result[valueField], assertion, target)
+}
+
 func assertResult(currentResult starlark.Comparable, assertion string, target starlark.Comparable) error {
 	err := verify.Verify(currentResult, assertion, target)
 	if err != nil {
@@ -0,0 +1,99 @@
+package tasks
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/store_spec"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+type backgroundTaskFunc func(ctx context.Context) (*exec_result.ExecResult, error)
+
+type backgroundTask struct {
+	// the command that was run, used to build meaningful error messages when the task is joined
+	commandToRun  string
+	storeSpecList []*store_spec.StoreSpec
+
+	done   chan struct{}
+	result *exec_result.ExecResult
+	err    error
+}
+
+// BackgroundTaskRegistry keeps track of the run_sh and run_python tasks running in the background, so that they can
+// be joined later on by wait_for_task. It lives as long as the API container, such that a task started by a run can
+// be joined by a subsequent run.
+type BackgroundTaskRegistry struct {
+	mutex *sync.Mutex
+	tasks map[string]*backgroundTask
+}
+
+func NewBackgroundTaskRegistry() *BackgroundTaskRegistry {
+	return &BackgroundTaskRegistry{
+		mutex: &sync.Mutex{},
+		tasks: map[string]*backgroundTask{},
+	}
+}
+
+// start runs the task function in its own goroutine. The function is not bound to the context of the instruction
+// starting it, as the task is expected to outlive it
+func (registry *BackgroundTaskRegistry) start(taskName string, commandToRun string, storeSpecList []*store_spec.StoreSpec, taskFunc backgroundTaskFunc) error {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	if _, found := registry.tasks[taskName]; found {
+		return stacktrace.NewError("A background task named '%s' is already registered", taskName)
+	}
+
+	task := &backgroundTask{
+		commandToRun:  commandToRun,
+		storeSpecList: storeSpecList,
+		done:          make(chan struct{}),
+		result:        nil,
+		err:           nil,
+	}
+	registry.tasks[taskName] = task
+
+	go func() {
+		defer close(task.done)
+		task.result, task.err = taskFunc(context.Background())
+		logrus.Debugf("Background task '%s' completed", taskName)
+	}()
+	return nil
+}
+
+// join blocks until the task completes, the timeout expires or the context is cancelled. An empty timeout means the
+// task is waited for as long as the context allows. Once it has completed, the task is removed from the registry and
+// cannot be joined anymore.
+func (registry *BackgroundTaskRegistry) join(ctx context.Context, taskName string, timeout time.Duration) (*backgroundTask, error) {
+	registry.mutex.Lock()
+	task, found := registry.tasks[taskName]
+	registry.mutex.Unlock()
+	if !found {
+		return nil, stacktrace.NewError("No background task named '%s' was found. Either it was never started or it was already joined", taskName)
+	}
+
+	var timeoutChan <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutChan = timer.C
+	}
+
+	select {
+	case <-task.done:
+	case <-timeoutChan:
+		return nil, stacktrace.NewError("Background task '%s' did not complete within %v", taskName, timeout)
+	case <-ctx.Done():
+		return nil, stacktrace.Propagate(ctx.Err(), "Stopped waiting for background task '%s'", taskName)
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	if registry.tasks[taskName] != task {
+		return nil, stacktrace.NewError("Background task '%s' was joined concurrently", taskName)
+	}
+	delete(registry.tasks, taskName)
+	return task, nil
+}
@@ -0,0 +1,74 @@
+package tasks
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	testTaskName     = "task-test"
+	testTaskCommand  = "echo hello"
+	testTaskOutput   = "hello"
+	testTaskExitCode = int32(0)
+
+	noTimeout = time.Duration(0)
+)
+
+func TestBackgroundTaskRegistry_JoinReturnsResult(t *testing.T) {
+	registry := NewBackgroundTaskRegistry()
+	err := registry.start(testTaskName, testTaskCommand, nil, func(ctx context.Context) (*exec_result.ExecResult, error) {
+		return exec_result.NewExecResult(testTaskExitCode, testTaskOutput), nil
+	})
+	require.NoError(t, err)
+
+	task, err := registry.join(context.Background(), testTaskName, noTimeout)
+	require.NoError(t, err)
+	require.NoError(t, task.err)
+	require.Equal(t, testTaskCommand, task.commandToRun)
+	require.Equal(t, testTaskExitCode, task.result.GetExitCode())
+	require.Equal(t, testTaskOutput, task.result.GetOutput())
+
+	// a task can be joined only once
+	_, err = registry.join(context.Background(), testTaskName, noTimeout)
+	require.Error(t, err)
+}
+
+func TestBackgroundTaskRegistry_JoinTimesOut(t *testing.T) {
+	registry := NewBackgroundTaskRegistry()
+	releaseTask := make(chan struct{})
+	err := registry.start(testTaskName, testTaskCommand, nil, func(ctx context.Context) (*exec_result.ExecResult, error) {
+		<-releaseTask
+		return exec_result.NewExecResult(testTaskExitCode, testTaskOutput), nil
+	})
+	require.NoError(t, err)
+
+	_, err = registry.join(context.Background(), testTaskName, time.Millisecond)
+	require.Error(t, err)
+
+	// the task is still registered after a timed out join, it can be joined again once completed
+	close(releaseTask)
+	task, err := registry.join(context.Background(), testTaskName, noTimeout)
+	require.NoError(t, err)
+	require.Equal(t, testTaskOutput, task.result.GetOutput())
+}
+
+func TestBackgroundTaskRegistry_JoinUnknownTask(t *testing.T) {
+	registry := NewBackgroundTaskRegistry()
+	_, err := registry.join(context.Background(), testTaskName, noTimeout)
+	require.Error(t, err)
+}
+
+func TestBackgroundTaskRegistry_TaskNamesAreUnique(t *testing.T) {
+	registry := NewBackgroundTaskRegistry()
+	releaseTask := make(chan struct{})
+	defer close(releaseTask)
+	taskFunc := func(ctx context.Context) (*exec_result.ExecResult, error) {
+		<-releaseTask
+		return nil, nil
+	}
+	require.NoError(t, registry.start(testTaskName, testTaskCommand, nil, taskFunc))
+	require.Error(t, registry.start(testTaskName, testTaskCommand, nil, taskFunc))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/store_spec"
@@ -19,6 +20,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/xtgo/uuid"
@@ -54,7 +56,13 @@ const (
 	scriptArtifactFormat = "%v-python-script"
 )
 
-func NewRunPythonService(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+func NewRunPythonService(
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	backgroundTasks *BackgroundTaskRegistry,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string,
+) *kurtosis_plan_instruction.KurtosisPlanInstruction {
 	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
 		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
 			Name: RunPythonBuiltinName,
@@ -79,9 +87,9 @@ func NewRunPythonService(serviceNetwork service_network.ServiceNetwork, runtimeV
 				{
 					Name:              ImageNameArgName,
 					IsOptional:        true,
-					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
 					Validator: func(argumentValue starlark.Value) *startosis_errors.InterpretationError {
-						return builtin_argument.NonEmptyString(argumentValue, ImageNameArgName)
+						return service_config.ValidateImage(argumentValue, ImageNameArgName)
 					},
 				},
 				{
@@ -104,21 +112,30 @@ func NewRunPythonService(serviceNetwork service_network.ServiceNetwork, runtimeV
 						return builtin_argument.DurationOrNone(value, WaitArgName)
 					},
 				},
+				{
+					Name:              BackgroundArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+				},
 			},
 		},
 
 		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
 			return &RunPythonCapabilities{
-				serviceNetwork:    serviceNetwork,
-				runtimeValueStore: runtimeValueStore,
-				pythonArguments:   nil,
-				packages:          nil,
-				name:              "",
-				serviceConfig:     nil, // populated at interpretation time
-				run:               "",  // populated at interpretation time
-				resultUuid:        "",  // populated at interpretation time
-				storeSpecList:     nil,
-				wait:              DefaultWaitTimeoutDurationStr,
+				serviceNetwork:         serviceNetwork,
+				runtimeValueStore:      runtimeValueStore,
+				backgroundTasks:        backgroundTasks,
+				packageContentProvider: packageContentProvider,
+				packageReplaceOptions:  packageReplaceOptions,
+				pythonArguments:        nil,
+				packages:               nil,
+				name:                   "",
+				serviceConfig:          nil, // populated at interpretation time
+				run:                    "",  // populated at interpretation time
+				resultUuid:             "",  // populated at interpretation time
+				storeSpecList:          nil,
+				wait:                   DefaultWaitTimeoutDurationStr,
+				background:             false,
 			}
 		},
 
@@ -130,13 +147,17 @@ func NewRunPythonService(serviceNetwork service_network.ServiceNetwork, runtimeV
 			FilesArgName:           true,
 			StoreFilesArgName:      true,
 			WaitArgName:            true,
+			BackgroundArgName:      true,
 		},
 	}
 }
 
 type RunPythonCapabilities struct {
-	runtimeValueStore *runtime_value_store.RuntimeValueStore
-	serviceNetwork    service_network.ServiceNetwork
+	runtimeValueStore      *runtime_value_store.RuntimeValueStore
+	serviceNetwork         service_network.ServiceNetwork
+	backgroundTasks        *BackgroundTaskRegistry
+	packageContentProvider startosis_packages.PackageContentProvider
+	packageReplaceOptions  map[string]string
 
 	resultUuid string
 	name       string
@@ -148,9 +169,10 @@ type RunPythonCapabilities struct {
 	serviceConfig *service.ServiceConfig
 	storeSpecList []*store_spec.StoreSpec
 	wait          string
+	background    bool
 }
 
-func (builtin *RunPythonCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+func (builtin *RunPythonCapabilities) Interpret(locatorOfModuleInWhichThisBuiltInIsBeingCalled string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
 	randomUuid := uuid.NewRandom()
 	builtin.name = fmt.Sprintf("task-%v", randomUuid.String())
 
@@ -195,15 +217,18 @@ func (builtin *RunPythonCapabilities) Interpret(_ string, arguments *builtin_arg
 		builtin.packages = packagesList
 	}
 
-	var image string
+	image := defaultRunPythonImageName
+	var imageBuildSpec *image_build_spec.ImageBuildSpec
 	if arguments.IsSet(ImageNameArgName) {
-		imageStarlark, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ImageNameArgName)
+		imageStarlark, err := builtin_argument.ExtractArgumentValue[starlark.Value](arguments, ImageNameArgName)
 		if err != nil {
 			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ImageNameArgName)
 		}
-		image = imageStarlark.GoString()
-	} else {
-		image = defaultRunPythonImageName
+		var interpretationErr *startosis_errors.InterpretationError
+		image, imageBuildSpec, interpretationErr = service_config.ConvertImage(imageStarlark, locatorOfModuleInWhichThisBuiltInIsBeingCalled, builtin.packageContentProvider, builtin.packageReplaceOptions)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
 	}
 
 	var filesArtifactExpansion *service_directory.FilesArtifactsExpansion
@@ -234,7 +259,7 @@ func (builtin *RunPythonCapabilities) Interpret(_ string, arguments *builtin_arg
 	}
 
 	// build a service config from image and files artifacts expansion.
-	builtin.serviceConfig, err = getServiceConfig(image, filesArtifactExpansion)
+	builtin.serviceConfig, err = getServiceConfig(image, imageBuildSpec, filesArtifactExpansion)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating service config using image '%s'", image)
 	}
@@ -255,6 +280,19 @@ func (builtin *RunPythonCapabilities) Interpret(_ string, arguments *builtin_arg
 		builtin.wait = waitTimeout
 	}
 
+	if arguments.IsSet(BackgroundArgName) {
+		background, interpretationErr := parseBackgroundArg(arguments)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builtin.background = background
+	}
+
+	// the results of a background task are exposed by the wait_for_task instruction joining it
+	if builtin.background {
+		return createBackgroundTaskHandle(builtin.name, builtin.storeSpecList), nil
+	}
+
 	resultUuid, err := builtin.runtimeValueStore.CreateValue()
 	if err != nil {
 		return nil, startosis_errors.NewInterpretationError("An error occurred while generating UUID for future reference for %v instruction", RunPythonBuiltinName)
@@ -271,7 +309,7 @@ func (builtin *RunPythonCapabilities) Validate(_ *builtin_argument.ArgumentValue
 	if builtin.serviceConfig.GetFilesArtifactsExpansion() != nil {
 		serviceDirpathsToArtifactIdentifiers = builtin.serviceConfig.GetFilesArtifactsExpansion().ServiceDirpathsToArtifactIdentifiers
 	}
-	return validateTasksCommon(validatorEnvironment, builtin.storeSpecList, serviceDirpathsToArtifactIdentifiers, builtin.serviceConfig.GetContainerImageName())
+	return validateTasksCommon(validatorEnvironment, builtin.storeSpecList, serviceDirpathsToArtifactIdentifiers, builtin.serviceConfig.GetContainerImageName(), builtin.serviceConfig.GetImageBuildSpec())
 }
 
 // Execute This is just v0 for run_python task - we can later improve on it.
@@ -286,6 +324,10 @@ func (builtin *RunPythonCapabilities) Execute(ctx context.Context, _ *builtin_ar
 		return "", stacktrace.Propagate(err, "error occurred while creating a run_python task with image: %v", builtin.serviceConfig.GetContainerImageName())
 	}
 
+	if builtin.background {
+		return builtin.startInBackground()
+	}
+
 	pipInstallationResult, err := setupRequiredPackages(ctx, builtin)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "an error occurred while installing dependencies")
@@ -333,7 +375,8 @@ func (builtin *RunPythonCapabilities) Execute(ctx context.Context, _ *builtin_ar
 }
 
 func (builtin *RunPythonCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
-	if instructionsAreEqual {
+	// a background task has to be started again for its handle to be joined by this run
+	if instructionsAreEqual && !builtin.background {
 		return enclave_structure.InstructionIsEqual
 	}
 	return enclave_structure.InstructionIsUnknown
@@ -343,6 +386,29 @@ func (builtin *RunPythonCapabilities) FillPersistableAttributes(builder *enclave
 	builder.SetType(RunPythonBuiltinName)
 }
 
+// startInBackground installs the required packages and runs the python script in the background, failing the task
+// with the pip output if the installation fails
+func (builtin *RunPythonCapabilities) startInBackground() (string, error) {
+	commandToRun, err := getPythonCommandToRun(builtin)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "error occurred while preparing the sh command to execute on the image")
+	}
+	installPackages := func(ctx context.Context) (*exec_result.ExecResult, error) {
+		pipInstallationResult, err := setupRequiredPackages(ctx, builtin)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "an error occurred while installing dependencies")
+		}
+		if pipInstallationResult != nil && pipInstallationResult.GetExitCode() != successfulPipRunExitCode {
+			return pipInstallationResult, nil
+		}
+		return nil, nil
+	}
+	if err = startInBackground(builtin.backgroundTasks, builtin.serviceNetwork, builtin.name, builtin.wait, commandToRun, builtin.storeSpecList, installPackages); err != nil {
+		return "", stacktrace.Propagate(err, "error occurred while starting run_python task '%s' in the background", builtin.name)
+	}
+	return fmt.Sprintf("Task '%s' started in the background", builtin.name), nil
+}
+
 func setupRequiredPackages(ctx context.Context, builtin *RunPythonCapabilities) (*exec_result.ExecResult, error) {
 	if len(builtin.packages) == 0 {
 		return nil, nil
@@ -3,6 +3,7 @@ package tasks
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/store_spec"
@@ -17,6 +18,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/xtgo/uuid"
@@ -29,7 +31,13 @@ const (
 	defaultRunShImageName = "badouralix/curl-jq"
 )
 
-func NewRunShService(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+func NewRunShService(
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	backgroundTasks *BackgroundTaskRegistry,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string,
+) *kurtosis_plan_instruction.KurtosisPlanInstruction {
 	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
 		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
 			Name: RunShBuiltinName,
@@ -43,9 +51,9 @@ func NewRunShService(serviceNetwork service_network.ServiceNetwork, runtimeValue
 				{
 					Name:              ImageNameArgName,
 					IsOptional:        true,
-					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
 					Validator: func(argumentValue starlark.Value) *startosis_errors.InterpretationError {
-						return builtin_argument.NonEmptyString(argumentValue, ImageNameArgName)
+						return service_config.ValidateImage(argumentValue, ImageNameArgName)
 					},
 				},
 				{
@@ -68,19 +76,28 @@ func NewRunShService(serviceNetwork service_network.ServiceNetwork, runtimeValue
 						return builtin_argument.DurationOrNone(value, WaitArgName)
 					},
 				},
+				{
+					Name:              BackgroundArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+				},
 			},
 		},
 
 		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
 			return &RunShCapabilities{
-				serviceNetwork:    serviceNetwork,
-				runtimeValueStore: runtimeValueStore,
-				name:              "",
-				serviceConfig:     nil, // populated at interpretation time
-				run:               "",  // populated at interpretation time
-				resultUuid:        "",  // populated at interpretation time
-				storeSpecList:     nil,
-				wait:              DefaultWaitTimeoutDurationStr,
+				serviceNetwork:         serviceNetwork,
+				runtimeValueStore:      runtimeValueStore,
+				backgroundTasks:        backgroundTasks,
+				packageContentProvider: packageContentProvider,
+				packageReplaceOptions:  packageReplaceOptions,
+				name:                   "",
+				serviceConfig:          nil, // populated at interpretation time
+				run:                    "",  // populated at interpretation time
+				resultUuid:             "",  // populated at interpretation time
+				storeSpecList:          nil,
+				wait:                   DefaultWaitTimeoutDurationStr,
+				background:             false,
 			}
 		},
 
@@ -90,13 +107,17 @@ func NewRunShService(serviceNetwork service_network.ServiceNetwork, runtimeValue
 			FilesArgName:      true,
 			StoreFilesArgName: true,
 			WaitArgName:       true,
+			BackgroundArgName: true,
 		},
 	}
 }
 
 type RunShCapabilities struct {
-	runtimeValueStore *runtime_value_store.RuntimeValueStore
-	serviceNetwork    service_network.ServiceNetwork
+	runtimeValueStore      *runtime_value_store.RuntimeValueStore
+	serviceNetwork         service_network.ServiceNetwork
+	backgroundTasks        *BackgroundTaskRegistry
+	packageContentProvider startosis_packages.PackageContentProvider
+	packageReplaceOptions  map[string]string
 
 	resultUuid string
 	name       string
@@ -105,24 +126,28 @@ type RunShCapabilities struct {
 	serviceConfig *service.ServiceConfig
 	storeSpecList []*store_spec.StoreSpec
 	wait          string
+	background    bool
 }
 
-func (builtin *RunShCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+func (builtin *RunShCapabilities) Interpret(locatorOfModuleInWhichThisBuiltInIsBeingCalled string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
 	runCommand, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, RunArgName)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", RunArgName)
 	}
 	builtin.run = runCommand.GoString()
 
-	var image string
+	image := defaultRunShImageName
+	var imageBuildSpec *image_build_spec.ImageBuildSpec
 	if arguments.IsSet(ImageNameArgName) {
-		imageStarlark, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ImageNameArgName)
+		imageStarlark, err := builtin_argument.ExtractArgumentValue[starlark.Value](arguments, ImageNameArgName)
 		if err != nil {
 			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ImageNameArgName)
 		}
-		image = imageStarlark.GoString()
-	} else {
-		image = defaultRunShImageName
+		var interpretationErr *startosis_errors.InterpretationError
+		image, imageBuildSpec, interpretationErr = service_config.ConvertImage(imageStarlark, locatorOfModuleInWhichThisBuiltInIsBeingCalled, builtin.packageContentProvider, builtin.packageReplaceOptions)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
 	}
 
 	var filesArtifactExpansion *service_directory.FilesArtifactsExpansion
@@ -144,7 +169,7 @@ func (builtin *RunShCapabilities) Interpret(_ string, arguments *builtin_argumen
 	}
 
 	// build a service config from image and files artifacts expansion.
-	builtin.serviceConfig, err = getServiceConfig(image, filesArtifactExpansion)
+	builtin.serviceConfig, err = getServiceConfig(image, imageBuildSpec, filesArtifactExpansion)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating service config using image '%s'", image)
 	}
@@ -165,14 +190,27 @@ func (builtin *RunShCapabilities) Interpret(_ string, arguments *builtin_argumen
 		builtin.wait = waitTimeout
 	}
 
+	if arguments.IsSet(BackgroundArgName) {
+		background, interpretationErr := parseBackgroundArg(arguments)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builtin.background = background
+	}
+
+	randomUuid := uuid.NewRandom()
+	builtin.name = fmt.Sprintf("task-%v", randomUuid.String())
+
+	// the results of a background task are exposed by the wait_for_task instruction joining it
+	if builtin.background {
+		return createBackgroundTaskHandle(builtin.name, builtin.storeSpecList), nil
+	}
+
 	resultUuid, err := builtin.runtimeValueStore.CreateValue()
 	if err != nil {
 		return nil, startosis_errors.NewInterpretationError("An error occurred while generating UUID for future reference for %v instruction", RunShBuiltinName)
 	}
 	builtin.resultUuid = resultUuid
-	randomUuid := uuid.NewRandom()
-	builtin.name = fmt.Sprintf("task-%v", randomUuid.String())
-
 	result := createInterpretationResult(resultUuid, builtin.storeSpecList)
 	return result, nil
 }
@@ -183,7 +221,7 @@ func (builtin *RunShCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet
 	if builtin.serviceConfig.GetFilesArtifactsExpansion() != nil {
 		serviceDirpathsToArtifactIdentifiers = builtin.serviceConfig.GetFilesArtifactsExpansion().ServiceDirpathsToArtifactIdentifiers
 	}
-	return validateTasksCommon(validatorEnvironment, builtin.storeSpecList, serviceDirpathsToArtifactIdentifiers, builtin.serviceConfig.GetContainerImageName())
+	return validateTasksCommon(validatorEnvironment, builtin.storeSpecList, serviceDirpathsToArtifactIdentifiers, builtin.serviceConfig.GetContainerImageName(), builtin.serviceConfig.GetImageBuildSpec())
 }
 
 // Execute This is just v0 for run_sh task - we can later improve on it.
@@ -202,6 +240,13 @@ func (builtin *RunShCapabilities) Execute(ctx context.Context, _ *builtin_argume
 	if err != nil {
 		return "", stacktrace.Propagate(err, "error occurred while preparing the sh command to execute on the image")
 	}
+
+	if builtin.background {
+		if err = startInBackground(builtin.backgroundTasks, builtin.serviceNetwork, builtin.name, builtin.wait, commandToRun, builtin.storeSpecList, nil); err != nil {
+			return "", stacktrace.Propagate(err, "error occurred while starting run_sh task '%s' in the background", builtin.name)
+		}
+		return fmt.Sprintf("Task '%s' started in the background", builtin.name), nil
+	}
 	fullCommandToRun := []string{shellWrapperCommand, "-c", commandToRun}
 
 	// run the command passed in by user in the container
@@ -236,7 +281,8 @@ func (builtin *RunShCapabilities) Execute(ctx context.Context, _ *builtin_argume
 }
 
 func (builtin *RunShCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
-	if instructionsAreEqual {
+	// a background task has to be started again for its handle to be joined by this run
+	if instructionsAreEqual && !builtin.background {
 		return enclave_structure.InstructionIsEqual
 	}
 	return enclave_structure.InstructionIsUnknown
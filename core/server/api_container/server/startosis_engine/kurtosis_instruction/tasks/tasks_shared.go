@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/store_spec"
@@ -31,6 +32,7 @@ const (
 	StoreFilesArgName = "store"
 	WaitArgName       = "wait"
 	FilesArgName      = "files"
+	BackgroundArgName = "background"
 
 	newlineChar = "\n"
 
@@ -41,6 +43,8 @@ const (
 	runResultOutputKey   = "output"
 	runFilesArtifactsKey = "files_artifacts"
 
+	taskHandleNameKey = "name"
+
 	shellWrapperCommand = "/bin/sh"
 	noNameSet           = ""
 	uniqueNameGenErrStr = "error occurred while generating unique name for the file artifact"
@@ -113,28 +117,66 @@ func parseWaitArg(arguments *builtin_argument.ArgumentValuesSet) (string, *start
 	return waitTimeout, nil
 }
 
+func parseBackgroundArg(arguments *builtin_argument.ArgumentValuesSet) (bool, *startosis_errors.InterpretationError) {
+	backgroundValue, err := builtin_argument.ExtractArgumentValue[starlark.Bool](arguments, BackgroundArgName)
+	if err != nil {
+		return false, startosis_errors.WrapWithInterpretationError(err, "error occurred while extracting background information")
+	}
+	return bool(backgroundValue), nil
+}
+
 func createInterpretationResult(resultUuid string, storeSpecList []*store_spec.StoreSpec) *starlarkstruct.Struct {
+	return createInterpretationResultWithArtifactNames(resultUuid, artifactNamesToStarlarkList(storeSpecList))
+}
+
+func createInterpretationResultWithArtifactNames(resultUuid string, artifactNamesList *starlark.List) *starlarkstruct.Struct {
 	runCodeValue := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, runResultCodeKey)
 	runOutputValue := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, runResultOutputKey)
 
 	dict := map[string]starlark.Value{}
 	dict[runResultCodeKey] = starlark.String(runCodeValue)
 	dict[runResultOutputKey] = starlark.String(runOutputValue)
+	dict[runFilesArtifactsKey] = artifactNamesList
+	result := starlarkstruct.FromStringDict(starlarkstruct.Default, dict)
+	return result
+}
+
+// createBackgroundTaskHandle returns the value of a task started in the background, which has to be passed to
+// wait_for_task to join it and retrieve its results
+func createBackgroundTaskHandle(taskName string, storeSpecList []*store_spec.StoreSpec) *starlarkstruct.Struct {
+	dict := map[string]starlark.Value{}
+	dict[taskHandleNameKey] = starlark.String(taskName)
+	dict[runFilesArtifactsKey] = artifactNamesToStarlarkList(storeSpecList)
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, dict)
+}
 
+func artifactNamesToStarlarkList(storeSpecList []*store_spec.StoreSpec) *starlark.List {
 	// converting go slice to starlark list
 	artifactNamesList := &starlark.List{}
-	if len(storeSpecList) > 0 {
-		for _, storeSpec := range storeSpecList {
-			// purposely not checking error for list because it's mutable so should not throw any errors until this point
-			_ = artifactNamesList.Append(starlark.String(storeSpec.GetName()))
-		}
+	for _, storeSpec := range storeSpecList {
+		// purposely not checking error for list because it's mutable so should not throw any errors until this point
+		_ = artifactNamesList.Append(starlark.String(storeSpec.GetName()))
 	}
-	dict[runFilesArtifactsKey] = artifactNamesList
-	result := starlarkstruct.FromStringDict(starlarkstruct.Default, dict)
-	return result
+	return artifactNamesList
 }
 
-func validateTasksCommon(validatorEnvironment *startosis_validator.ValidatorEnvironment, storeSpecList []*store_spec.StoreSpec, serviceDirpathsToArtifactIdentifiers map[string]string, imageName string) *startosis_errors.ValidationError {
+// startInBackground registers the task in the background task registry and runs the command in the task container
+// in a separate goroutine. The wait timeout still applies to the command, even though nothing blocks on it.
+// If set, beforeRun runs first, and a non-nil result returned by it (a failed setup step) is reported as the result
+// of the task without running the command.
+func startInBackground(backgroundTasks *BackgroundTaskRegistry, serviceNetwork service_network.ServiceNetwork, taskName string, wait string, commandToRun string, storeSpecList []*store_spec.StoreSpec, beforeRun backgroundTaskFunc) error {
+	fullCommandToRun := []string{shellWrapperCommand, "-c", commandToRun}
+	return backgroundTasks.start(taskName, commandToRun, storeSpecList, func(ctx context.Context) (*exec_result.ExecResult, error) {
+		if beforeRun != nil {
+			if result, err := beforeRun(ctx); err != nil || result != nil {
+				return result, err
+			}
+		}
+		return executeWithWait(ctx, serviceNetwork, taskName, wait, fullCommandToRun)
+	})
+}
+
+func validateTasksCommon(validatorEnvironment *startosis_validator.ValidatorEnvironment, storeSpecList []*store_spec.StoreSpec, serviceDirpathsToArtifactIdentifiers map[string]string, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) *startosis_errors.ValidationError {
 	if storeSpecList != nil {
 		if err := validatePathIsUniqueWhileCreatingFileArtifact(storeSpecList); err != nil {
 			return startosis_errors.WrapWithValidationError(err, "error occurred while validating file paths to copy into file artifact")
@@ -151,7 +193,13 @@ func validateTasksCommon(validatorEnvironment *startosis_validator.ValidatorEnvi
 		}
 	}
 
-	validatorEnvironment.AppendRequiredContainerImage(imageName)
+	if imageBuildSpec != nil {
+		if validationErr := validatorEnvironment.AppendRequiredImageBuild(imageName, imageBuildSpec); validationErr != nil {
+			return validationErr
+		}
+	} else {
+		validatorEnvironment.AppendRequiredContainerImage(imageName)
+	}
 	return nil
 
 }
@@ -246,9 +294,10 @@ func resultMapToString(resultMap map[string]starlark.Comparable, builtinNameForL
 	return fmt.Sprintf("Command returned with exit code '%v' and the following output: %v", exitCode, outputStr)
 }
 
-func getServiceConfig(image string, filesArtifactExpansion *service_directory.FilesArtifactsExpansion) (*service.ServiceConfig, error) {
+func getServiceConfig(image string, imageBuildSpec *image_build_spec.ImageBuildSpec, filesArtifactExpansion *service_directory.FilesArtifactsExpansion) (*service.ServiceConfig, error) {
 	serviceConfig, err := service.CreateServiceConfig(
 		image,
+		imageBuildSpec,
 		nil,
 		nil,
 		// This make sure that the container does not stop as soon as it starts
@@ -0,0 +1,187 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"reflect"
+	"time"
+)
+
+const (
+	WaitForTaskBuiltinName = "wait_for_task"
+
+	TaskArgName = "task"
+)
+
+func NewWaitForTask(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore, backgroundTasks *BackgroundTaskRegistry) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: WaitForTaskBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              TaskArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlarkstruct.Struct],
+					Validator:         validateBackgroundTaskHandle,
+				},
+				{
+					Name:              WaitArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.DurationOrNone(value, WaitArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &WaitForTaskCapabilities{
+				serviceNetwork:    serviceNetwork,
+				runtimeValueStore: runtimeValueStore,
+				backgroundTasks:   backgroundTasks,
+				taskName:          "", // populated at interpretation time
+				resultUuid:        "", // populated at interpretation time
+				wait:              DefaultWaitTimeoutDurationStr,
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			TaskArgName: true,
+			WaitArgName: true,
+		},
+	}
+}
+
+type WaitForTaskCapabilities struct {
+	serviceNetwork    service_network.ServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+	backgroundTasks   *BackgroundTaskRegistry
+
+	taskName   string
+	resultUuid string
+	wait       string
+}
+
+func (builtin *WaitForTaskCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	taskHandle, err := builtin_argument.ExtractArgumentValue[*starlarkstruct.Struct](arguments, TaskArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TaskArgName)
+	}
+	taskName, artifactNamesList, interpretationErr := parseBackgroundTaskHandle(taskHandle)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	builtin.taskName = taskName
+
+	if arguments.IsSet(WaitArgName) {
+		waitTimeout, interpretationErr := parseWaitArg(arguments)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builtin.wait = waitTimeout
+	}
+
+	resultUuid, err := builtin.runtimeValueStore.CreateValue()
+	if err != nil {
+		return nil, startosis_errors.NewInterpretationError("An error occurred while generating UUID for future reference for %v instruction", WaitForTaskBuiltinName)
+	}
+	builtin.resultUuid = resultUuid
+
+	return createInterpretationResultWithArtifactNames(resultUuid, artifactNamesList), nil
+}
+
+func (builtin *WaitForTaskCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, _ *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	// the files artifacts of the task were already declared by the run_sh or run_python instruction starting it
+	return nil
+}
+
+func (builtin *WaitForTaskCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	var timeout time.Duration
+	if builtin.wait != DisableWaitTimeoutDurationStr {
+		// we validate timeout string during the interpretation so it cannot be invalid at this stage
+		timeout, _ = time.ParseDuration(builtin.wait)
+	}
+
+	task, err := builtin.backgroundTasks.join(ctx, builtin.taskName, timeout)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred waiting for background task '%s'", builtin.taskName)
+	}
+	if task.err != nil {
+		return "", stacktrace.Propagate(task.err, "error occurred while executing background task command: %v", task.commandToRun)
+	}
+
+	result := map[string]starlark.Comparable{
+		runResultOutputKey: starlark.String(task.result.GetOutput()),
+		runResultCodeKey:   starlark.MakeInt(int(task.result.GetExitCode())),
+	}
+	if err := builtin.runtimeValueStore.SetValue(builtin.resultUuid, result); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred setting value '%+v' using key UUID '%s' in the runtime value store", result, builtin.resultUuid)
+	}
+	instructionResult := resultMapToString(result, WaitForTaskBuiltinName)
+
+	// throw an error as execution of the command failed
+	if task.result.GetExitCode() != 0 {
+		errorMessage := fmt.Sprintf("Background task command: %q exited with code %d and output", task.commandToRun, task.result.GetExitCode())
+		return "", stacktrace.NewError(formatErrorMessage(errorMessage, task.result.GetOutput()))
+	}
+
+	if err = copyFilesFromTask(ctx, builtin.serviceNetwork, builtin.taskName, task.storeSpecList); err != nil {
+		return "", stacktrace.Propagate(err, "error occurred while copying files from  a task")
+	}
+	return instructionResult, nil
+}
+
+func (builtin *WaitForTaskCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	return enclave_structure.InstructionIsUnknown
+}
+
+func (builtin *WaitForTaskCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(WaitForTaskBuiltinName)
+}
+
+func validateBackgroundTaskHandle(value starlark.Value) *startosis_errors.InterpretationError {
+	taskHandle, ok := value.(*starlarkstruct.Struct)
+	if !ok {
+		return startosis_errors.NewInterpretationError("The '%s' argument should be the value returned by a run_sh or run_python instruction with '%s=True' (was '%s')", TaskArgName, BackgroundArgName, reflect.TypeOf(value))
+	}
+	_, _, interpretationErr := parseBackgroundTaskHandle(taskHandle)
+	return interpretationErr
+}
+
+func parseBackgroundTaskHandle(taskHandle *starlarkstruct.Struct) (string, *starlark.List, *startosis_errors.InterpretationError) {
+	taskNameValue, err := taskHandle.Attr(taskHandleNameKey)
+	if err != nil {
+		return "", nil, startosis_errors.WrapWithInterpretationError(err, "The '%s' argument is not a background task: it has no '%s' attribute", TaskArgName, taskHandleNameKey)
+	}
+	taskName, ok := taskNameValue.(starlark.String)
+	if !ok || taskName.GoString() == "" {
+		return "", nil, startosis_errors.NewInterpretationError("The '%s' attribute of the '%s' argument should be a non empty string (was '%v')", taskHandleNameKey, TaskArgName, taskNameValue)
+	}
+
+	artifactNamesValue, err := taskHandle.Attr(runFilesArtifactsKey)
+	if err != nil {
+		return "", nil, startosis_errors.WrapWithInterpretationError(err, "The '%s' argument is not a background task: it has no '%s' attribute", TaskArgName, runFilesArtifactsKey)
+	}
+	artifactNamesList, ok := artifactNamesValue.(*starlark.List)
+	if !ok {
+		return "", nil, startosis_errors.NewInterpretationError("The '%s' attribute of the '%s' argument should be a list (was '%s')", runFilesArtifactsKey, TaskArgName, reflect.TypeOf(artifactNamesValue))
+	}
+	return taskName.GoString(), artifactNamesList, nil
+}
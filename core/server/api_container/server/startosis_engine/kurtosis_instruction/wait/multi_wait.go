@@ -0,0 +1,453 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/verify"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	MultiWaitBuiltinName = "multi_wait"
+
+	TargetsArgName   = "targets"
+	ConditionArgName = "condition"
+
+	MultiWaitConditionAll     = "all"
+	MultiWaitConditionAny     = "any"
+	multiWaitConditionAtLeast = "at_least"
+
+	multiWaitTargetNameFormat = "%s[%d]"
+
+	// PassedField is added to the result of every target, telling whether the target passed before the condition was
+	// satisfied or the timeout was hit
+	PassedField = "passed"
+)
+
+var atLeastConditionRegex = regexp.MustCompile(`^` + multiWaitConditionAtLeast + `\(\s*([0-9]+)\s*\)$`)
+
+func NewMultiWait(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: MultiWaitBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              TargetsArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              ConditionArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ConditionArgName)
+					},
+				},
+				{
+					Name:              TimeoutArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &MultiWaitCapabilities{
+				serviceNetwork:    serviceNetwork,
+				runtimeValueStore: runtimeValueStore,
+
+				targets:           nil, // populated at interpretation time
+				condition:         "",  // populated at interpretation time
+				minPassingTargets: 0,   // populated at interpretation time
+				timeout:           0,   // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			TargetsArgName:   true,
+			ConditionArgName: true,
+			TimeoutArgName:   false,
+		},
+	}
+}
+
+// multiWaitTarget is a single recipe + assertion to poll on a service, as provided through a ReadyCondition
+type multiWaitTarget struct {
+	name        string
+	serviceName service.ServiceName
+	recipe      recipe.Recipe
+	valueField  string
+	assertion   string
+	target      starlark.Comparable
+	interval    time.Duration
+
+	resultUuid string
+	// the fields of the recipe result referenced by the return value, without PassedField
+	resultFields []string
+}
+
+type multiWaitTargetResult struct {
+	target     *multiWaitTarget
+	lastResult map[string]starlark.Comparable
+	tries      int
+	err        error
+}
+
+type MultiWaitCapabilities struct {
+	serviceNetwork    service_network.ServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	targets           []*multiWaitTarget
+	condition         string
+	minPassingTargets int
+	timeout           time.Duration
+}
+
+func (builtin *MultiWaitCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	targetsArgumentValue, err := builtin_argument.ExtractArgumentValue[*starlark.Dict](arguments, TargetsArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TargetsArgName)
+	}
+	if targetsArgumentValue.Len() == 0 {
+		return nil, startosis_errors.NewInterpretationError("'%s' argument of %s cannot be empty", TargetsArgName, MultiWaitBuiltinName)
+	}
+
+	var targets []*multiWaitTarget
+	returnValue := starlark.NewDict(targetsArgumentValue.Len())
+	for _, item := range targetsArgumentValue.Items() {
+		serviceNameStr, ok := item[0].(starlark.String)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("'%s' keys must be service names, got '%s'", TargetsArgName, item[0].Type())
+		}
+		serviceName := service.ServiceName(serviceNameStr.GoString())
+
+		switch readyConditionsValue := item[1].(type) {
+		case *service_config.ReadyCondition:
+			target, targetReturnValue, interpretationErr := builtin.newMultiWaitTarget(string(serviceName), serviceName, readyConditionsValue)
+			if interpretationErr != nil {
+				return nil, interpretationErr
+			}
+			targets = append(targets, target)
+			if err := returnValue.SetKey(serviceNameStr, targetReturnValue); err != nil {
+				return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while creating return value for %v instruction", MultiWaitBuiltinName)
+			}
+		case *starlark.List:
+			if readyConditionsValue.Len() == 0 {
+				return nil, startosis_errors.NewInterpretationError("'%s' for service '%s' cannot be an empty list", TargetsArgName, serviceName)
+			}
+			var serviceReturnValues []starlark.Value
+			for idx := 0; idx < readyConditionsValue.Len(); idx++ {
+				readyCondition, ok := readyConditionsValue.Index(idx).(*service_config.ReadyCondition)
+				if !ok {
+					return nil, startosis_errors.NewInterpretationError("'%s' for service '%s' must be a %s or a list of %s, got an element of type '%s'", TargetsArgName, serviceName, service_config.ReadyConditionTypeName, service_config.ReadyConditionTypeName, readyConditionsValue.Index(idx).Type())
+				}
+				target, targetReturnValue, interpretationErr := builtin.newMultiWaitTarget(fmt.Sprintf(multiWaitTargetNameFormat, serviceName, idx), serviceName, readyCondition)
+				if interpretationErr != nil {
+					return nil, interpretationErr
+				}
+				targets = append(targets, target)
+				serviceReturnValues = append(serviceReturnValues, targetReturnValue)
+			}
+			if err := returnValue.SetKey(serviceNameStr, starlark.NewList(serviceReturnValues)); err != nil {
+				return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while creating return value for %v instruction", MultiWaitBuiltinName)
+			}
+		default:
+			return nil, startosis_errors.NewInterpretationError("'%s' for service '%s' must be a %s or a list of %s, got '%s'", TargetsArgName, serviceName, service_config.ReadyConditionTypeName, service_config.ReadyConditionTypeName, item[1].Type())
+		}
+	}
+
+	condition := MultiWaitConditionAll
+	if arguments.IsSet(ConditionArgName) {
+		conditionArgumentValue, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ConditionArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConditionArgName)
+		}
+		condition = conditionArgumentValue.GoString()
+	}
+	minPassingTargets, interpretationErr := getMinPassingTargetsFromCondition(condition, len(targets))
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	timeout := defaultTimeout
+	if arguments.IsSet(TimeoutArgName) {
+		starlarkTimeout, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, TimeoutArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TimeoutArgName)
+		}
+		parsedTimeout, parseErr := time.ParseDuration(starlarkTimeout.GoString())
+		if parseErr != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(parseErr, "An error occurred when parsing timeout '%v'", starlarkTimeout.GoString())
+		}
+		timeout = parsedTimeout
+	}
+
+	builtin.targets = targets
+	builtin.condition = condition
+	builtin.minPassingTargets = minPassingTargets
+	builtin.timeout = timeout
+
+	return returnValue, nil
+}
+
+func (builtin *MultiWaitCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	for _, target := range builtin.targets {
+		if validatorEnvironment.DoesServiceNameExist(target.serviceName) == startosis_validator.ComponentNotFound {
+			return startosis_errors.NewValidationError("Tried creating a multi wait for service '%s' which doesn't exist", target.serviceName)
+		}
+		httpRequestRecipe, ok := target.recipe.(recipe.HttpRequestRecipe)
+		// if the passed recipe isn't http request recipe we can't do much
+		if !ok {
+			continue
+		}
+		if validationErr := recipe.ValidateHttpRequestRecipe(httpRequestRecipe, target.serviceName, validatorEnvironment); validationErr != nil {
+			return validationErr
+		}
+	}
+	return nil
+}
+
+func (builtin *MultiWaitCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	startTime := time.Now()
+
+	// all targets share the same deadline, and get stopped together as soon as the condition is satisfied
+	ctxWithTimeout, cancelCtx := context.WithTimeout(ctx, builtin.timeout)
+	defer cancelCtx()
+
+	resultsChan := make(chan *multiWaitTargetResult, len(builtin.targets))
+	for _, target := range builtin.targets {
+		go func(target *multiWaitTarget) {
+			lastResult, tries, err := shared_helpers.ExecuteServiceAssertionWithRecipeUntilDone(
+				ctxWithTimeout,
+				builtin.serviceNetwork,
+				builtin.runtimeValueStore,
+				target.serviceName,
+				target.recipe,
+				target.valueField,
+				target.assertion,
+				target.target,
+				target.interval,
+			)
+			resultsChan <- &multiWaitTargetResult{
+				target:     target,
+				lastResult: lastResult,
+				tries:      tries,
+				err:        err,
+			}
+		}(target)
+	}
+
+	var passedTargets []*multiWaitTargetResult
+	var failingTargets []*multiWaitTargetResult
+	for range builtin.targets {
+		result := <-resultsChan
+		hasPassed := result.err == nil
+		resultValue := getMultiWaitTargetResultValue(result, hasPassed)
+		if err := builtin.runtimeValueStore.SetValue(result.target.resultUuid, resultValue); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred setting value '%+v' using key UUID '%s' in the runtime value store", resultValue, result.target.resultUuid)
+		}
+		if !hasPassed {
+			failingTargets = append(failingTargets, result)
+			continue
+		}
+		passedTargets = append(passedTargets, result)
+		if len(passedTargets) >= builtin.minPassingTargets {
+			cancelCtx()
+		}
+	}
+	sortMultiWaitTargetResults(passedTargets)
+	sortMultiWaitTargetResults(failingTargets)
+
+	if len(passedTargets) < builtin.minPassingTargets {
+		return "", stacktrace.NewError(
+			"Multi wait timed-out after %v waiting for condition '%s': %d of the %d required targets passed. Targets still failing were:\n%s",
+			builtin.timeout,
+			builtin.condition,
+			len(passedTargets),
+			builtin.minPassingTargets,
+			failingMultiWaitTargetsToString(failingTargets),
+		)
+	}
+
+	instructionResult := strings.Builder{}
+	instructionResult.WriteString(fmt.Sprintf(
+		"Multi wait condition '%s' satisfied with %d of %d targets passing (%v in total).",
+		builtin.condition,
+		len(passedTargets),
+		len(builtin.targets),
+		time.Since(startTime),
+	))
+	for _, passedTarget := range passedTargets {
+		instructionResult.WriteString(fmt.Sprintf(
+			"\nTarget '%s' passed after %d tries with following:\n%s",
+			passedTarget.target.name,
+			passedTarget.tries,
+			passedTarget.target.recipe.ResultMapToString(passedTarget.lastResult),
+		))
+	}
+	if len(failingTargets) > 0 {
+		instructionResult.WriteString(fmt.Sprintf("\nTargets still failing when the condition was satisfied were:\n%s", failingMultiWaitTargetsToString(failingTargets)))
+	}
+	return instructionResult.String(), nil
+}
+
+func (builtin *MultiWaitCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if !instructionsAreEqual {
+		return enclave_structure.InstructionIsUnknown
+	}
+	for _, target := range builtin.targets {
+		if enclaveComponents.HasServiceBeenUpdated(target.serviceName) {
+			return enclave_structure.InstructionIsUpdate
+		}
+	}
+	return enclave_structure.InstructionIsEqual
+}
+
+func (builtin *MultiWaitCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(MultiWaitBuiltinName)
+}
+
+func (builtin *MultiWaitCapabilities) newMultiWaitTarget(name string, serviceName service.ServiceName, readyCondition *service_config.ReadyCondition) (*multiWaitTarget, starlark.Value, *startosis_errors.InterpretationError) {
+	genericRecipe, interpretationErr := readyCondition.GetRecipe()
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+	valueField, interpretationErr := readyCondition.GetField()
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+	assertion, interpretationErr := readyCondition.GetAssertion()
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+	target, interpretationErr := readyCondition.GetTarget()
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+	if _, ok := target.(starlark.Iterable); (assertion == verify.InCollectionAssertionToken || assertion == verify.NotInCollectionAssertionToken) && !ok {
+		return nil, nil, startosis_errors.NewInterpretationError("'%v' assertion of target '%s' requires an iterable for target values, got '%v'", assertion, name, target.Type())
+	}
+	interval, interpretationErr := readyCondition.GetInterval()
+	if interpretationErr != nil {
+		return nil, nil, interpretationErr
+	}
+
+	resultUuid, err := builtin.runtimeValueStore.CreateValue()
+	if err != nil {
+		return nil, nil, startosis_errors.NewInterpretationError("An error occurred while generating UUID for future reference for %v instruction", MultiWaitBuiltinName)
+	}
+	recipeReturnValue, interpretationErr := genericRecipe.CreateStarlarkReturnValue(resultUuid)
+	if interpretationErr != nil {
+		return nil, nil, startosis_errors.NewInterpretationError("An error occurred while creating return value for %v instruction", MultiWaitBuiltinName)
+	}
+	// the recipe return value is frozen, so it gets copied to add the field telling whether the target passed
+	returnValue := starlark.NewDict(recipeReturnValue.Len() + 1)
+	var resultFields []string
+	for _, item := range recipeReturnValue.Items() {
+		resultField, ok := item[0].(starlark.String)
+		if !ok {
+			return nil, nil, startosis_errors.NewInterpretationError("Expected the return value of the recipe of target '%s' to have string keys, got '%s'. This is a bug in Kurtosis", name, item[0].Type())
+		}
+		resultFields = append(resultFields, resultField.GoString())
+		if err := returnValue.SetKey(item[0], item[1]); err != nil {
+			return nil, nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while creating return value for %v instruction", MultiWaitBuiltinName)
+		}
+	}
+	if err := returnValue.SetKey(starlark.String(PassedField), starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, PassedField))); err != nil {
+		return nil, nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while creating return value for %v instruction", MultiWaitBuiltinName)
+	}
+	returnValue.Freeze()
+
+	return &multiWaitTarget{
+		name:         name,
+		serviceName:  serviceName,
+		recipe:       genericRecipe,
+		valueField:   valueField,
+		assertion:    assertion,
+		target:       target,
+		interval:     interval,
+		resultUuid:   resultUuid,
+		resultFields: resultFields,
+	}, returnValue, nil
+}
+
+// getMinPassingTargetsFromCondition returns how many targets need to pass for the condition to be satisfied
+func getMinPassingTargetsFromCondition(condition string, numTargets int) (int, *startosis_errors.InterpretationError) {
+	switch condition {
+	case MultiWaitConditionAll:
+		return numTargets, nil
+	case MultiWaitConditionAny:
+		return 1, nil
+	}
+	matches := atLeastConditionRegex.FindStringSubmatch(condition)
+	if matches == nil {
+		return 0, startosis_errors.NewInterpretationError("Invalid '%s' argument '%s'; expected one of '%s', '%s' or '%s(k)'", ConditionArgName, condition, MultiWaitConditionAll, MultiWaitConditionAny, multiWaitConditionAtLeast)
+	}
+	minPassingTargets, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing the number of targets in '%s' condition '%s'", ConditionArgName, condition)
+	}
+	if minPassingTargets < 1 || minPassingTargets > numTargets {
+		return 0, startosis_errors.NewInterpretationError("'%s' condition '%s' must require between 1 and the number of targets (%d)", ConditionArgName, condition, numTargets)
+	}
+	return minPassingTargets, nil
+}
+
+// getMultiWaitTargetResultValue returns the value stored for the result of a target. A target that didn't pass gets
+// the fields of its last recipe result, and an empty string for the fields its recipe never returned, so that
+// referencing them doesn't fail
+func getMultiWaitTargetResultValue(result *multiWaitTargetResult, hasPassed bool) map[string]starlark.Comparable {
+	resultValue := map[string]starlark.Comparable{}
+	for field, value := range result.lastResult {
+		resultValue[field] = value
+	}
+	if !hasPassed {
+		for _, field := range result.target.resultFields {
+			if _, found := resultValue[field]; !found {
+				resultValue[field] = starlark.String("")
+			}
+		}
+	}
+	resultValue[PassedField] = starlark.Bool(hasPassed)
+	return resultValue
+}
+
+func sortMultiWaitTargetResults(results []*multiWaitTargetResult) {
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].target.name < results[j].target.name
+	})
+}
+
+func failingMultiWaitTargetsToString(failingTargets []*multiWaitTargetResult) string {
+	failingTargetStrs := []string{}
+	for _, failingTarget := range failingTargets {
+		failingTargetStrs = append(failingTargetStrs, fmt.Sprintf("  - '%s' after %d tries: %v", failingTarget.target.name, failingTarget.tries, failingTarget.err))
+	}
+	return strings.Join(failingTargetStrs, "\n")
+}
@@ -32,6 +32,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddService() {
 		mock.MatchedBy(func(serviceConfig *service.ServiceConfig) bool {
 			expectedServiceConfig, err := service.CreateServiceConfig(
 				testContainerImageName,
+				nil,
 				map[string]*port_spec.PortSpec{},
 				map[string]*port_spec.PortSpec{},
 				nil,
@@ -65,7 +66,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddService() {
 }
 
 func (t *addServiceTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
-	return add_service.NewAddService(t.serviceNetwork, t.runtimeValueStore)
+	return add_service.NewAddService(t.serviceNetwork, newServiceHealthMonitorForTest(t.T, t.serviceNetwork), t.runtimeValueStore, nil, testNoPackageReplaceOptions)
 }
 
 func (t *addServiceTestCase) GetStarlarkCode() string {
@@ -48,6 +48,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 
 			expectedServiceConfig1, err := service.CreateServiceConfig(
 				testContainerImageName,
+				nil,
 				map[string]*port_spec.PortSpec{},
 				map[string]*port_spec.PortSpec{},
 				nil,
@@ -69,6 +70,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 
 			expectedServiceConfig2, err := service.CreateServiceConfig(
 				testContainerImageName,
+				nil,
 				map[string]*port_spec.PortSpec{},
 				map[string]*port_spec.PortSpec{},
 				nil,
@@ -193,7 +195,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 }
 
 func (t *addServicesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
-	return add_service.NewAddServices(t.serviceNetwork, t.runtimeValueStore)
+	return add_service.NewAddServices(t.serviceNetwork, newServiceHealthMonitorForTest(t.T, t.serviceNetwork), t.runtimeValueStore, nil, testNoPackageReplaceOptions)
 }
 
 func (t *addServicesTestCase) GetStarlarkCode() string {
@@ -0,0 +1,197 @@
+package test_engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+const (
+	testReplicaSetName = service.ServiceName("test-replicated-service")
+
+	// mirror the labels set by add_services on every replica
+	testReplicaSetLabelKey   = "replica-set"
+	testReplicaIndexLabelKey = "replica-index"
+)
+
+type addServicesReplicasTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	numReplicas         uint32
+	numAddedReplicas    uint32
+	removedReplicaNames []service.ServiceName
+}
+
+// TestAddServicesScaleUpReplicas re-runs add_services with 3 replicas when 2 of them already exist: the existing
+// replicas are updated and the missing one is added
+func (suite *KurtosisPlanInstructionTestSuite) TestAddServicesScaleUpReplicas() {
+	numExistingReplicas := uint32(2)
+	numReplicas := uint32(3)
+	suite.expectReplicasExistence(numExistingReplicas, numReplicas)
+
+	suite.serviceNetwork.EXPECT().UpdateServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*service.ServiceConfig) bool {
+			return suite.areReplicaConfigs(configs, 0, numExistingReplicas)
+		}),
+		mock.Anything,
+	).Times(1).Return(getReplicaServicesForTest(0, numExistingReplicas), map[service.ServiceName]error{}, nil)
+	suite.serviceNetwork.EXPECT().AddServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*service.ServiceConfig) bool {
+			return suite.areReplicaConfigs(configs, numExistingReplicas, numReplicas)
+		}),
+		mock.Anything,
+	).Times(1).Return(getReplicaServicesForTest(numExistingReplicas, numReplicas), map[service.ServiceName]error{}, nil)
+	suite.serviceNetwork.EXPECT().GetServices(mock.Anything).Times(1).Return(getReplicaServicesByUuidForTest(numReplicas), nil)
+
+	suite.run(&addServicesReplicasTestCase{
+		T:                   suite.T(),
+		serviceNetwork:      suite.serviceNetwork,
+		runtimeValueStore:   suite.runtimeValueStore,
+		numReplicas:         numReplicas,
+		numAddedReplicas:    numReplicas - numExistingReplicas,
+		removedReplicaNames: []service.ServiceName{},
+	})
+}
+
+// TestAddServicesScaleDownReplicas re-runs add_services with 2 replicas when 3 of them exist: the replicas that are
+// kept are updated and the extra one is removed
+func (suite *KurtosisPlanInstructionTestSuite) TestAddServicesScaleDownReplicas() {
+	numExistingReplicas := uint32(3)
+	numReplicas := uint32(2)
+	suite.expectReplicasExistence(numExistingReplicas, numReplicas)
+
+	suite.serviceNetwork.EXPECT().UpdateServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*service.ServiceConfig) bool {
+			return suite.areReplicaConfigs(configs, 0, numReplicas)
+		}),
+		mock.Anything,
+	).Times(1).Return(getReplicaServicesForTest(0, numReplicas), map[service.ServiceName]error{}, nil)
+	suite.serviceNetwork.EXPECT().AddServices(
+		mock.Anything,
+		map[service.ServiceName]*service.ServiceConfig{},
+		mock.Anything,
+	).Times(1).Return(map[service.ServiceName]*service.Service{}, map[service.ServiceName]error{}, nil)
+	suite.serviceNetwork.EXPECT().GetServices(mock.Anything).Times(1).Return(getReplicaServicesByUuidForTest(numExistingReplicas), nil)
+	removedReplicaName := getReplicaNameForTest(2)
+	suite.serviceNetwork.EXPECT().RemoveService(mock.Anything, string(removedReplicaName)).Times(1).Return(getReplicaUuidForTest(2), nil)
+
+	suite.run(&addServicesReplicasTestCase{
+		T:                   suite.T(),
+		serviceNetwork:      suite.serviceNetwork,
+		runtimeValueStore:   suite.runtimeValueStore,
+		numReplicas:         numReplicas,
+		numAddedReplicas:    0,
+		removedReplicaNames: []service.ServiceName{removedReplicaName},
+	})
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) expectReplicasExistence(numExistingReplicas uint32, numReplicas uint32) {
+	for replicaIndex := uint32(0); replicaIndex < numReplicas; replicaIndex++ {
+		suite.serviceNetwork.EXPECT().ExistServiceRegistration(getReplicaNameForTest(replicaIndex)).Times(1).Return(replicaIndex < numExistingReplicas, nil)
+	}
+}
+
+// areReplicaConfigs checks that the configs are the ones of the replicas in [firstReplicaIndex, lastReplicaIndex)
+func (suite *KurtosisPlanInstructionTestSuite) areReplicaConfigs(configs map[service.ServiceName]*service.ServiceConfig, firstReplicaIndex uint32, lastReplicaIndex uint32) bool {
+	suite.Require().Len(configs, int(lastReplicaIndex-firstReplicaIndex))
+	for replicaIndex := firstReplicaIndex; replicaIndex < lastReplicaIndex; replicaIndex++ {
+		replicaConfig, found := configs[getReplicaNameForTest(replicaIndex)]
+		suite.Require().True(found, "Missing config of replica '%d'", replicaIndex)
+		suite.Require().Equal(testContainerImageName, replicaConfig.GetContainerImageName())
+		suite.Require().Equal(getReplicaLabelsForTest(replicaIndex), replicaConfig.GetLabels())
+	}
+	return true
+}
+
+func (t *addServicesReplicasTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return add_service.NewAddServices(t.serviceNetwork, newServiceHealthMonitorForTest(t.T, t.serviceNetwork), t.runtimeValueStore, nil, testNoPackageReplaceOptions)
+}
+
+func (t *addServicesReplicasTestCase) GetStarlarkCode() string {
+	serviceConfig := fmt.Sprintf("ServiceConfig(image=%q, replicas=%d)", testContainerImageName, t.numReplicas)
+	return fmt.Sprintf(`%s(%s={%q: %s})`, add_service.AddServicesBuiltinName, add_service.ConfigsArgName, testReplicaSetName, serviceConfig)
+}
+
+func (t *addServicesReplicasTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *addServicesReplicasTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	resultDict, ok := interpretationResult.(*starlark.Dict)
+	require.True(t, ok, "interpretation result should be a dictionary")
+	require.Equal(t, 1, resultDict.Len())
+	replicasValue, found, err := resultDict.Get(starlark.String(testReplicaSetName))
+	require.NoError(t, err)
+	require.True(t, found)
+	replicas, ok := replicasValue.(*starlark.List)
+	require.True(t, ok, "the replicas should be returned as a list")
+	require.Equal(t, int(t.numReplicas), replicas.Len())
+
+	require.Contains(t, *executionResult, fmt.Sprintf("Successfully added the following '%d' services:", t.numAddedReplicas))
+	for replicaIndex := uint32(0); replicaIndex < t.numReplicas; replicaIndex++ {
+		require.Contains(t, *executionResult, fmt.Sprintf("Service '%s' added with UUID '%s'", getReplicaNameForTest(replicaIndex), getReplicaUuidForTest(replicaIndex)))
+	}
+	for _, removedReplicaName := range t.removedReplicaNames {
+		require.Contains(t, *executionResult, fmt.Sprintf("Service '%s' removed as its replica set was scaled down", removedReplicaName))
+	}
+	require.Equal(t, len(t.removedReplicaNames), strings.Count(*executionResult, "removed as its replica set was scaled down"))
+}
+
+func getReplicaNameForTest(replicaIndex uint32) service.ServiceName {
+	return service.ServiceName(fmt.Sprintf("%s-%d", testReplicaSetName, replicaIndex))
+}
+
+func getReplicaUuidForTest(replicaIndex uint32) service.ServiceUUID {
+	return service.ServiceUUID(fmt.Sprintf("test-replica-uuid-%d", replicaIndex))
+}
+
+func getReplicaLabelsForTest(replicaIndex uint32) map[string]string {
+	return map[string]string{
+		testReplicaSetLabelKey:   string(testReplicaSetName),
+		testReplicaIndexLabelKey: strconv.FormatUint(uint64(replicaIndex), 10),
+	}
+}
+
+func getReplicaServiceForTest(replicaIndex uint32) *service.Service {
+	replicaName := getReplicaNameForTest(replicaIndex)
+	registration := service.NewServiceRegistration(replicaName, getReplicaUuidForTest(replicaIndex), testEnclaveUuid, nil, string(replicaName))
+	replicaConfig, err := service.CreateServiceConfig(testContainerImageName, nil, nil, nil, nil, nil, nil, nil, nil, 0, 0, "", 0, 0, getReplicaLabelsForTest(replicaIndex))
+	if err != nil {
+		panic(err)
+	}
+	registration.SetConfig(replicaConfig)
+	return service.NewService(registration, nil, nil, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+}
+
+// getReplicaServicesForTest returns the replicas in [firstReplicaIndex, lastReplicaIndex) by name
+func getReplicaServicesForTest(firstReplicaIndex uint32, lastReplicaIndex uint32) map[service.ServiceName]*service.Service {
+	replicaServices := map[service.ServiceName]*service.Service{}
+	for replicaIndex := firstReplicaIndex; replicaIndex < lastReplicaIndex; replicaIndex++ {
+		replicaServices[getReplicaNameForTest(replicaIndex)] = getReplicaServiceForTest(replicaIndex)
+	}
+	return replicaServices
+}
+
+func getReplicaServicesByUuidForTest(numReplicas uint32) map[service.ServiceUUID]*service.Service {
+	replicaServices := map[service.ServiceUUID]*service.Service{}
+	for replicaIndex := uint32(0); replicaIndex < numReplicas; replicaIndex++ {
+		replicaServices[getReplicaUuidForTest(replicaIndex)] = getReplicaServiceForTest(replicaIndex)
+	}
+	return replicaServices
+}
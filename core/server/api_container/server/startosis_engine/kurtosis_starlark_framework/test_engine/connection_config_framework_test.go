@@ -0,0 +1,53 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type connectionConfigTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestConnectionConfig() {
+	suite.run(&connectionConfigTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *connectionConfigTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%s, %s=%d, %s=%d, %s=%s, %s=%d, %s=%s)",
+		connection_config.ConnectionConfigTypeName,
+		connection_config.PacketLossPercentageAttr, starlark.Float(testPacketLossPercentage),
+		connection_config.LatencyMsAttr, testLatencyMs,
+		connection_config.JitterMsAttr, testJitterMs,
+		connection_config.CorrelationPercentageAttr, starlark.Float(testCorrelationPercentage),
+		connection_config.BandwidthKbitAttr, testBandwidthKbit,
+		connection_config.BlockedAttr, "False",
+	)
+}
+
+func (t *connectionConfigTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	connectionConfigStarlark, ok := typeValue.(*connection_config.ConnectionConfig)
+	require.True(t, ok)
+
+	connectionConfig, err := connectionConfigStarlark.ToKurtosisType()
+	require.Nil(t, err)
+
+	expectedConnectionConfig := partition_topology.ConnectionConfig{
+		PacketLossPercentage: testPacketLossPercentage,
+		PacketDelay: partition_topology.PacketDelay{
+			DelayMs:               testLatencyMs,
+			JitterMs:              testJitterMs,
+			CorrelationPercentage: testCorrelationPercentage,
+		},
+		BandwidthKbit: testBandwidthKbit,
+		IsBlocked:     false,
+	}
+	require.Equal(t, expectedConnectionConfig, connectionConfig)
+}
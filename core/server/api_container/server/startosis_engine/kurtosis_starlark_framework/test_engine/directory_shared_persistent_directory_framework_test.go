@@ -0,0 +1,54 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/directory"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type directorySharedPersistentDirectoryTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestDirectorySharedPersistentDirectory() {
+	suite.run(&directorySharedPersistentDirectoryTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *directorySharedPersistentDirectoryTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%d, %s=%s, %s=%s)",
+		directory.DirectoryTypeName,
+		directory.PersistentKeyAttr, testPersistentDirectoryKey,
+		directory.SizeAttr, testPersistentDirectorySize,
+		directory.SharedAttr, "True",
+		directory.ReadOnlyAttr, "True",
+	)
+}
+
+func (t *directorySharedPersistentDirectoryTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	directoryStarlark, ok := typeValue.(*directory.Directory)
+	require.True(t, ok)
+
+	persistentKey, found, err := directoryStarlark.GetPersistentKeyIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, testPersistentDirectoryKey, persistentKey)
+
+	size, found, err := directoryStarlark.GetSizeIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, testPersistentDirectorySize, size)
+
+	isShared, found, err := directoryStarlark.GetSharedIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.True(t, isShared)
+
+	isReadOnly, found, err := directoryStarlark.GetReadOnlyIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.True(t, isReadOnly)
+}
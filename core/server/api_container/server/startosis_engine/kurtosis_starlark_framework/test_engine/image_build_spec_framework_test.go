@@ -0,0 +1,51 @@
+package test_engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/stretchr/testify/require"
+)
+
+type imageBuildSpecTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestImageBuildSpec() {
+	suite.run(&imageBuildSpecTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *imageBuildSpecTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q, %s=%q)",
+		service_config.ImageBuildSpecTypeName,
+		service_config.BuiltImageNameAttr, testBuiltImageName,
+		service_config.BuildContextDirAttr, testBuildContextLocator,
+		service_config.DockerfileAttr, testDockerfileName,
+		service_config.TargetStageAttr, testBuildTargetStageName,
+	)
+}
+
+func (t *imageBuildSpecTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	imageBuildSpecStarlark, ok := typeValue.(*service_config.ImageBuildSpec)
+	require.True(t, ok)
+
+	buildContextDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(buildContextDirpath, testDockerfileName), []byte("FROM alpine"), 0o644))
+	packageContentProvider := startosis_packages.NewMockPackageContentProvider(t)
+	packageContentProvider.EXPECT().GetAbsoluteLocatorForRelativeLocator(testModulePackageId, testBuildContextLocator, testNoPackageReplaceOptions).Return(testModulePackageId+"/app", nil)
+	packageContentProvider.EXPECT().GetOnDiskAbsoluteFilePath(testModulePackageId+"/app").Return(buildContextDirpath, nil)
+
+	imageName, buildSpec, interpretationErr := service_config.ConvertImage(imageBuildSpecStarlark, testModulePackageId, packageContentProvider, testNoPackageReplaceOptions)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, testBuiltImageName, imageName)
+	require.Equal(t, buildContextDirpath, buildSpec.GetBuildContextDirpath())
+	require.Equal(t, testDockerfileName, buildSpec.GetDockerfileRelativeFilepath())
+	require.Equal(t, testBuildTargetStageName, buildSpec.GetTargetStage())
+}
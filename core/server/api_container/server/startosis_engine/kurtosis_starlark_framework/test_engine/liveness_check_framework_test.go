@@ -0,0 +1,81 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type livenessCheckTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestLivenessCheck() {
+	suite.run(&livenessCheckTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *livenessCheckTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%s(%s=%s), %s=%q, %s=%q, %s=%s, %s=%q, %s=%d)",
+		service_config.LivenessCheckTypeName,
+		service_config.RecipeAttr,
+		recipe.ExecRecipeTypeName,
+		recipe.CommandAttr,
+		fmt.Sprintf("[%q, %q]", testReadyConditionsRecipeCommand[0], testReadyConditionsRecipeCommand[1]),
+		service_config.FieldAttr,
+		testReadyConditionsField,
+		service_config.AssertionAttr,
+		testReadyConditionsAssertion,
+		service_config.TargetAttr,
+		testReadyConditionsTarget,
+		service_config.IntervalAttr,
+		testLivenessCheckInterval,
+		service_config.FailureThresholdAttr,
+		testLivenessCheckFailureThreshold,
+	)
+}
+
+func (t *livenessCheckTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	livenessCheck, ok := typeValue.(*service_config.LivenessCheck)
+	require.True(t, ok)
+
+	uncastedRecipe, err := livenessCheck.GetRecipe()
+	if assert.Nil(t, err) {
+		_, ok := uncastedRecipe.(*recipe.ExecRecipe)
+		require.True(t, ok)
+	}
+
+	field, err := livenessCheck.GetField()
+	if assert.Nil(t, err) {
+		require.Equal(t, testReadyConditionsField, field)
+	}
+
+	assertion, err := livenessCheck.GetAssertion()
+	if assert.Nil(t, err) {
+		require.Equal(t, testReadyConditionsAssertion, assertion)
+	}
+
+	target, err := livenessCheck.GetTarget()
+	if assert.Nil(t, err) {
+		require.Equal(t, testReadyConditionsTarget, target.String())
+	}
+
+	interval, err := livenessCheck.GetInterval()
+	if assert.Nil(t, err) {
+		expectedInterval, err := time.ParseDuration(testLivenessCheckInterval)
+		if assert.Nil(t, err) {
+			require.Equal(t, expectedInterval, interval)
+		}
+	}
+
+	failureThreshold, err := livenessCheck.GetFailureThreshold()
+	if assert.Nil(t, err) {
+		require.Equal(t, testLivenessCheckFailureThreshold, failureThreshold)
+	}
+}
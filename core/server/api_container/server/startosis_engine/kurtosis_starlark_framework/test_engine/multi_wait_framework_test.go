@@ -0,0 +1,385 @@
+package test_engine
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/wait"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+const (
+	multiWaitCondition = "all"
+	multiWaitTimeout   = "5s"
+
+	// the second target of testServiceName2 polls an endpoint that never returns the expected code
+	multiWaitNotReadyEndpoint = "/not-ready"
+	multiWaitNotReadyStatus   = "503 Service Unavailable"
+	multiWaitNotReadyCode     = 503
+
+	singleMultiWaitTargetIndex = -1
+)
+
+var multiWaitRuntimeValueUuidRegex = regexp.MustCompile(`\{\{kurtosis:([0-9a-f]{32}):passed\.runtime_value\}\}`)
+
+type multiWaitTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestMultiWait() {
+	suite.serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(testServiceName),
+		testReadyConditionsRecipePortId,
+		testGetRequestMethod,
+		"",
+		testReadyConditionsRecipeEndpoint,
+		"",
+	).Times(1).Return(&http.Response{
+		Status:           "200 OK",
+		StatusCode:       200,
+		Proto:            "HTTP/1.0",
+		ProtoMajor:       1,
+		ProtoMinor:       0,
+		Header:           nil,
+		Body:             io.NopCloser(strings.NewReader("{}")),
+		ContentLength:    -1,
+		TransferEncoding: nil,
+		Close:            false,
+		Uncompressed:     false,
+		Trailer:          nil,
+		Request:          nil,
+		TLS:              nil,
+	}, nil)
+
+	suite.serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(testServiceName2),
+		testReadyConditions2RecipePortId,
+		testGetRequestMethod,
+		"",
+		testReadyConditions2RecipeEndpoint,
+		"",
+	).Times(1).Return(&http.Response{
+		Status:           "201 Created",
+		StatusCode:       201,
+		Proto:            "HTTP/1.0",
+		ProtoMajor:       1,
+		ProtoMinor:       0,
+		Header:           nil,
+		Body:             io.NopCloser(strings.NewReader("{}")),
+		ContentLength:    -1,
+		TransferEncoding: nil,
+		Close:            false,
+		Uncompressed:     false,
+		Trailer:          nil,
+		Request:          nil,
+		TLS:              nil,
+	}, nil)
+
+	suite.run(&multiWaitTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *multiWaitTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return wait.NewMultiWait(t.serviceNetwork, t.runtimeValueStore)
+}
+
+func (t *multiWaitTestCase) GetStarlarkCode() string {
+	service1ReadyConditionsScriptPart := getDefaultReadyConditionsScriptPart()
+	service2ReadyConditionsScriptPart := getCustomReadyConditionsScripPart(
+		testReadyConditions2RecipePortId,
+		testReadyConditions2RecipeEndpoint,
+		testReadyConditions2RecipeExtract,
+		testReadyConditions2Field,
+		testReadyConditions2Assertion,
+		testReadyConditions2Target,
+		testReadyConditions2Interval,
+		testReadyConditions2Timeout,
+	)
+	return fmt.Sprintf(`%s(%s={%q: %s, %q: [%s]}, %s=%q, %s=%q)`, wait.MultiWaitBuiltinName, wait.TargetsArgName, testServiceName, service1ReadyConditionsScriptPart, testServiceName2, service2ReadyConditionsScriptPart, wait.ConditionArgName, multiWaitCondition, wait.TimeoutArgName, multiWaitTimeout)
+}
+
+func (t *multiWaitTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *multiWaitTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	expectedInterpretationResult := fmt.Sprintf(`^\{%q: \{"body": "\{\{kurtosis:[0-9a-f]{32}:body.runtime_value\}\}", "code": "\{\{kurtosis:[0-9a-f]{32}:code.runtime_value\}\}", "passed": "\{\{kurtosis:[0-9a-f]{32}:passed.runtime_value\}\}"\}, %q: \[\{"body": "\{\{kurtosis:[0-9a-f]{32}:body.runtime_value\}\}", "code": "\{\{kurtosis:[0-9a-f]{32}:code.runtime_value\}\}", "passed": "\{\{kurtosis:[0-9a-f]{32}:passed.runtime_value\}\}"\}\]\}$`, testServiceName, testServiceName2)
+	require.Regexp(t, expectedInterpretationResult, interpretationResult.String())
+
+	require.Contains(t, *executionResult, "Multi wait condition 'all' satisfied with 2 of 2 targets passing")
+	require.Contains(t, *executionResult, fmt.Sprintf("Target '%s' passed after 1 tries with following:\nRequest had response code '200'", testServiceName))
+	require.Contains(t, *executionResult, fmt.Sprintf("Target '%s[0]' passed after 1 tries with following:\nRequest had response code '201'", testServiceName2))
+	require.NotContains(t, *executionResult, "still failing")
+	requireMultiWaitTargetPassed(t.T, t.runtimeValueStore, interpretationResult, testServiceName, singleMultiWaitTargetIndex, true)
+	requireMultiWaitTargetPassed(t.T, t.runtimeValueStore, interpretationResult, testServiceName2, 0, true)
+}
+
+// multiWaitConditionTestCase waits on 3 targets: testServiceName, and 2 targets on testServiceName2, the second of
+// which never passes
+type multiWaitConditionTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	condition                  string
+	timeout                    string
+	expectedNumPassedTargets   int
+	expectedFailingTargetNames []string
+	// whether each target passed, in the order they are declared
+	expectedTargetsPassed []bool
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestMultiWaitAny() {
+	suite.expectMultiWaitTargetsRequests(false)
+
+	suite.run(&multiWaitConditionTestCase{
+		T:                          suite.T(),
+		serviceNetwork:             suite.serviceNetwork,
+		runtimeValueStore:          suite.runtimeValueStore,
+		condition:                  "any",
+		timeout:                    multiWaitTimeout,
+		expectedNumPassedTargets:   1,
+		expectedFailingTargetNames: []string{fmt.Sprintf("%s[0]", testServiceName2), fmt.Sprintf("%s[1]", testServiceName2)},
+		expectedTargetsPassed:      []bool{true, false, false},
+	})
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestMultiWaitAtLeast() {
+	suite.expectMultiWaitTargetsRequests(true)
+
+	suite.run(&multiWaitConditionTestCase{
+		T:                          suite.T(),
+		serviceNetwork:             suite.serviceNetwork,
+		runtimeValueStore:          suite.runtimeValueStore,
+		condition:                  "at_least(2)",
+		timeout:                    multiWaitTimeout,
+		expectedNumPassedTargets:   2,
+		expectedFailingTargetNames: []string{fmt.Sprintf("%s[1]", testServiceName2)},
+		expectedTargetsPassed:      []bool{true, true, false},
+	})
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestMultiWaitTimeout() {
+	suite.expectMultiWaitTargetsRequests(true)
+
+	testCase := &multiWaitConditionTestCase{
+		T:                          suite.T(),
+		serviceNetwork:             suite.serviceNetwork,
+		runtimeValueStore:          suite.runtimeValueStore,
+		condition:                  "all",
+		timeout:                    "1s",
+		expectedNumPassedTargets:   2,
+		expectedFailingTargetNames: []string{fmt.Sprintf("%s[1]", testServiceName2)},
+		expectedTargetsPassed:      []bool{true, true, false},
+	}
+	interpretationResult, err := suite.runShouldFailExecution(testCase)
+
+	require.Contains(suite.T(), err.Error(), "Multi wait timed-out after 1s waiting for condition 'all': 2 of the 3 required targets passed. Targets still failing were:")
+	require.Contains(suite.T(), err.Error(), fmt.Sprintf("  - '%s[1]' after ", testServiceName2))
+	require.NotContains(suite.T(), err.Error(), fmt.Sprintf("'%s' after ", testServiceName))
+	testCase.assertTargetsPassed(interpretationResult)
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestMultiWaitInvalidCondition() {
+	suite.runShouldFail(testModulePackageId, &multiWaitConditionTestCase{
+		T:                          suite.T(),
+		serviceNetwork:             suite.serviceNetwork,
+		runtimeValueStore:          suite.runtimeValueStore,
+		condition:                  "most",
+		timeout:                    multiWaitTimeout,
+		expectedNumPassedTargets:   0,
+		expectedFailingTargetNames: nil,
+		expectedTargetsPassed:      nil,
+	}, "Invalid 'condition' argument 'most'; expected one of 'all', 'any' or 'at_least(k)'")
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestMultiWaitAtLeastZero() {
+	suite.runShouldFail(testModulePackageId, &multiWaitConditionTestCase{
+		T:                          suite.T(),
+		serviceNetwork:             suite.serviceNetwork,
+		runtimeValueStore:          suite.runtimeValueStore,
+		condition:                  "at_least(0)",
+		timeout:                    multiWaitTimeout,
+		expectedNumPassedTargets:   0,
+		expectedFailingTargetNames: nil,
+		expectedTargetsPassed:      nil,
+	}, "'condition' condition 'at_least(0)' must require between 1 and the number of targets (3)")
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestMultiWaitAtLeastMoreThanTargets() {
+	suite.runShouldFail(testModulePackageId, &multiWaitConditionTestCase{
+		T:                          suite.T(),
+		serviceNetwork:             suite.serviceNetwork,
+		runtimeValueStore:          suite.runtimeValueStore,
+		condition:                  "at_least(4)",
+		timeout:                    multiWaitTimeout,
+		expectedNumPassedTargets:   0,
+		expectedFailingTargetNames: nil,
+		expectedTargetsPassed:      nil,
+	}, "'condition' condition 'at_least(4)' must require between 1 and the number of targets (3)")
+}
+
+// expectMultiWaitTargetsRequests mocks the requests of the 3 targets. The first target passes on its first try, the
+// second one too if isSecondTargetPassing is set, and the others never pass, so they are polled until the condition
+// is satisfied or the timeout is hit
+func (suite *KurtosisPlanInstructionTestSuite) expectMultiWaitTargetsRequests(isSecondTargetPassing bool) {
+	suite.serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(testServiceName),
+		testReadyConditionsRecipePortId,
+		testGetRequestMethod,
+		"",
+		testReadyConditionsRecipeEndpoint,
+		"",
+	).Times(1).Return(newMultiWaitHttpResponseForTest("200 OK", 200), nil)
+
+	secondTargetCall := suite.serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(testServiceName2),
+		testReadyConditions2RecipePortId,
+		testGetRequestMethod,
+		"",
+		testReadyConditions2RecipeEndpoint,
+		"",
+	)
+	if isSecondTargetPassing {
+		secondTargetCall.Times(1).Return(newMultiWaitHttpResponseForTest("201 Created", 201), nil)
+	} else {
+		secondTargetCall.RunAndReturn(returnNotReadyMultiWaitHttpResponseForTest).Maybe()
+	}
+
+	// the failing targets may get cancelled before their first try when the condition is satisfied
+	suite.serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(testServiceName2),
+		testReadyConditions2RecipePortId,
+		testGetRequestMethod,
+		"",
+		multiWaitNotReadyEndpoint,
+		"",
+	).RunAndReturn(returnNotReadyMultiWaitHttpResponseForTest).Maybe()
+}
+
+func (t *multiWaitConditionTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return wait.NewMultiWait(t.serviceNetwork, t.runtimeValueStore)
+}
+
+func (t *multiWaitConditionTestCase) GetStarlarkCode() string {
+	service1ReadyConditionsScriptPart := getDefaultReadyConditionsScriptPart()
+	service2ReadyConditionsScriptPart := getCustomReadyConditionsScripPart(
+		testReadyConditions2RecipePortId,
+		testReadyConditions2RecipeEndpoint,
+		testReadyConditions2RecipeExtract,
+		testReadyConditions2Field,
+		testReadyConditions2Assertion,
+		testReadyConditions2Target,
+		testReadyConditions2Interval,
+		testReadyConditions2Timeout,
+	)
+	service2NotReadyConditionsScriptPart := getCustomReadyConditionsScripPart(
+		testReadyConditions2RecipePortId,
+		multiWaitNotReadyEndpoint,
+		testReadyConditions2RecipeExtract,
+		testReadyConditions2Field,
+		testReadyConditions2Assertion,
+		testReadyConditions2Target,
+		testReadyConditions2Interval,
+		testReadyConditions2Timeout,
+	)
+	return fmt.Sprintf(`%s(%s={%q: %s, %q: [%s, %s]}, %s=%q, %s=%q)`, wait.MultiWaitBuiltinName, wait.TargetsArgName, testServiceName, service1ReadyConditionsScriptPart, testServiceName2, service2ReadyConditionsScriptPart, service2NotReadyConditionsScriptPart, wait.ConditionArgName, t.condition, wait.TimeoutArgName, t.timeout)
+}
+
+func (t *multiWaitConditionTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *multiWaitConditionTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Contains(t, *executionResult, fmt.Sprintf("Multi wait condition '%s' satisfied with %d of 3 targets passing", t.condition, t.expectedNumPassedTargets))
+	require.Contains(t, *executionResult, fmt.Sprintf("Target '%s' passed after 1 tries with following:\nRequest had response code '200'", testServiceName))
+	require.Contains(t, *executionResult, "Targets still failing when the condition was satisfied were:")
+	for _, failingTargetName := range t.expectedFailingTargetNames {
+		require.Contains(t, *executionResult, fmt.Sprintf("  - '%s' after ", failingTargetName))
+		require.NotContains(t, *executionResult, fmt.Sprintf("Target '%s' passed", failingTargetName))
+	}
+	t.assertTargetsPassed(interpretationResult)
+}
+
+func (t *multiWaitConditionTestCase) assertTargetsPassed(interpretationResult starlark.Value) {
+	requireMultiWaitTargetPassed(t.T, t.runtimeValueStore, interpretationResult, testServiceName, singleMultiWaitTargetIndex, t.expectedTargetsPassed[0])
+	requireMultiWaitTargetPassed(t.T, t.runtimeValueStore, interpretationResult, testServiceName2, 0, t.expectedTargetsPassed[1])
+	requireMultiWaitTargetPassed(t.T, t.runtimeValueStore, interpretationResult, testServiceName2, 1, t.expectedTargetsPassed[2])
+}
+
+// requireMultiWaitTargetPassed checks the result stored for a target, which is defined whether the target passed or
+// not. targetIndex is the index of the target in the list of targets of the service, or singleMultiWaitTargetIndex if
+// the service has a single target
+func requireMultiWaitTargetPassed(t *testing.T, runtimeValueStore *runtime_value_store.RuntimeValueStore, interpretationResult starlark.Value, serviceName service.ServiceName, targetIndex int, expectedPassed bool) {
+	resultDict, ok := interpretationResult.(*starlark.Dict)
+	require.True(t, ok, "interpretation result should be a dictionary")
+	serviceResultValue, found, err := resultDict.Get(starlark.String(serviceName))
+	require.NoError(t, err)
+	require.True(t, found)
+	targetResultValue := serviceResultValue
+	if targetIndex != singleMultiWaitTargetIndex {
+		serviceResultList, ok := serviceResultValue.(*starlark.List)
+		require.True(t, ok, "the results of service '%s' should be a list", serviceName)
+		targetResultValue = serviceResultList.Index(targetIndex)
+	}
+	targetResultDict, ok := targetResultValue.(*starlark.Dict)
+	require.True(t, ok, "the result of a target should be a dictionary")
+	passedValue, found, err := targetResultDict.Get(starlark.String(wait.PassedField))
+	require.NoError(t, err)
+	require.True(t, found)
+	passedValueStr, ok := passedValue.(starlark.String)
+	require.True(t, ok)
+	matches := multiWaitRuntimeValueUuidRegex.FindStringSubmatch(passedValueStr.GoString())
+	require.Len(t, matches, 2)
+
+	storedResult, err := runtimeValueStore.GetValue(matches[1])
+	require.NoError(t, err)
+	require.Equal(t, starlark.Bool(expectedPassed), storedResult[wait.PassedField])
+	// the fields of the recipe result can be referenced even if the target didn't pass
+	require.Contains(t, storedResult, "code")
+	require.Contains(t, storedResult, "body")
+}
+
+func returnNotReadyMultiWaitHttpResponseForTest(_ context.Context, _ string, _ string, _ string, _ string, _ string, _ string) (*http.Response, error) {
+	return newMultiWaitHttpResponseForTest(multiWaitNotReadyStatus, multiWaitNotReadyCode), nil
+}
+
+func newMultiWaitHttpResponseForTest(status string, statusCode int) *http.Response {
+	return &http.Response{
+		Status:           status,
+		StatusCode:       statusCode,
+		Proto:            "HTTP/1.0",
+		ProtoMajor:       1,
+		ProtoMinor:       0,
+		Header:           nil,
+		Body:             io.NopCloser(strings.NewReader("{}")),
+		ContentLength:    -1,
+		TransferEncoding: nil,
+		Close:            false,
+		Uncompressed:     false,
+		Trailer:          nil,
+		Request:          nil,
+		TLS:              nil,
+	}
+}
@@ -0,0 +1,49 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_files_artifact"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type removeFilesArtifactTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestRemoveFilesArtifact() {
+	suite.serviceNetwork.EXPECT().RemoveFilesArtifact(
+		testArtifactName,
+	).Times(1).Return(
+		testArtifactUuid,
+		nil,
+	)
+
+	suite.run(&removeFilesArtifactTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *removeFilesArtifactTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return remove_files_artifact.NewRemoveFilesArtifact(t.serviceNetwork)
+}
+
+func (t *removeFilesArtifactTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q)", remove_files_artifact.RemoveFilesArtifactBuiltinName, remove_files_artifact.FilesArtifactNameArgName, testArtifactName)
+}
+
+func (t *removeFilesArtifactTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *removeFilesArtifactTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Files artifact '%s' with artifact UUID '%s' removed", testArtifactName, testArtifactUuid)
+	require.Regexp(t, expectedExecutionResult, *executionResult)
+}
@@ -0,0 +1,44 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_health"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type restartPolicyTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestRestartPolicy() {
+	suite.run(&restartPolicyTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *restartPolicyTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%d, %s=%q)",
+		service_config.RestartPolicyTypeName,
+		service_config.PolicyAttr, testRestartPolicyMode,
+		service_config.MaxRetriesAttr, testRestartPolicyMaxRetries,
+		service_config.BackoffAttr, testRestartPolicyBackoff,
+	)
+}
+
+func (t *restartPolicyTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	restartPolicyStarlark, ok := typeValue.(*service_config.RestartPolicy)
+	require.True(t, ok)
+
+	restartPolicy, err := restartPolicyStarlark.ToKurtosisType()
+	require.Nil(t, err)
+
+	expectedBackoff, parseErr := time.ParseDuration(testRestartPolicyBackoff)
+	require.NoError(t, parseErr)
+	require.Equal(t, service_health.RestartPolicyMode_Always, restartPolicy.GetMode())
+	require.Equal(t, testRestartPolicyMaxRetries, restartPolicy.GetMaxRetries())
+	require.Equal(t, expectedBackoff, restartPolicy.GetBackoff())
+}
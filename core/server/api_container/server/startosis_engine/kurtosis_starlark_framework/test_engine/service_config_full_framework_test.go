@@ -34,7 +34,7 @@ func (t *serviceConfigFullTestCase) GetStarlarkCode() string {
 	fileArtifact1 := fmt.Sprintf("%s(%s=%q)", directory.DirectoryTypeName, directory.ArtifactNameAttr, testFilesArtifactName1)
 	fileArtifact2 := fmt.Sprintf("%s(%s=%q)", directory.DirectoryTypeName, directory.ArtifactNameAttr, testFilesArtifactName2)
 	persistentDirectory := fmt.Sprintf("%s(%s=%q)", directory.DirectoryTypeName, directory.PersistentKeyAttr, testPersistentDirectoryKey)
-	starlarkCode := fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%q, %s=%d, %s=%d, %s=%d, %s=%d, %s=%s, %s=%v)",
+	starlarkCode := fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%q, %s=%d, %s=%d, %s=%d, %s=%d, %s=%s, %s=%v, %s=%s)",
 		service_config.ServiceConfigTypeName,
 		service_config.ImageAttr, testContainerImageName,
 		service_config.PortsAttr, fmt.Sprintf("{%q: PortSpec(number=%d, transport_protocol=%q, application_protocol=%q, wait=%q)}", testPrivatePortId, testPrivatePortNumber, testPrivatePortProtocolStr, testPrivateApplicationProtocol, testWaitConfiguration),
@@ -51,6 +51,7 @@ func (t *serviceConfigFullTestCase) GetStarlarkCode() string {
 		service_config.ReadyConditionsAttr,
 		getDefaultReadyConditionsScriptPart(),
 		service_config.LabelsAttr, fmt.Sprintf("{%q: %q, %q: %q}", testServiceConfigLabelsKey1, testServiceConfigLabelsValue1, testServiceConfigLabelsKey2, testServiceConfigLabelsValue2),
+		service_config.RestartPolicyAttr, fmt.Sprintf("%s(%s=%q, %s=%d, %s=%q)", service_config.RestartPolicyTypeName, service_config.PolicyAttr, testRestartPolicyMode, service_config.MaxRetriesAttr, testRestartPolicyMaxRetries, service_config.BackoffAttr, testRestartPolicyBackoff),
 	)
 	return starlarkCode
 }
@@ -59,7 +60,7 @@ func (t *serviceConfigFullTestCase) Assert(typeValue builtin_argument.KurtosisVa
 	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
 	require.True(t, ok)
 
-	serviceConfig, err := serviceConfigStarlark.ToKurtosisType(t.serviceNetwork)
+	serviceConfig, err := serviceConfigStarlark.ToKurtosisType(t.serviceNetwork, testModulePackageId, nil, testNoPackageReplaceOptions)
 	require.Nil(t, err)
 
 	require.Equal(t, testContainerImageName, serviceConfig.GetContainerImageName())
@@ -88,11 +89,11 @@ func (t *serviceConfigFullTestCase) Assert(typeValue builtin_argument.KurtosisVa
 	require.NotNil(t, serviceConfig.GetFilesArtifactsExpansion())
 	require.Equal(t, expectedFilesArtifactMap, serviceConfig.GetFilesArtifactsExpansion().ServiceDirpathsToArtifactIdentifiers)
 
-	expectedPersistentDirectoryMap := map[string]service_directory.DirectoryPersistentKey{
-		testPersistentDirectoryPath: service_directory.DirectoryPersistentKey(testPersistentDirectoryKey),
+	expectedPersistentDirectoryMap := map[string]service_directory.PersistentDirectory{
+		testPersistentDirectoryPath: service_directory.NewPersistentDirectory(service_directory.DirectoryPersistentKey(testPersistentDirectoryKey), 0, false, false),
 	}
 	require.NotNil(t, serviceConfig.GetPersistentDirectories())
-	require.Equal(t, expectedPersistentDirectoryMap, serviceConfig.GetPersistentDirectories().ServiceDirpathToDirectoryPersistentKey)
+	require.Equal(t, expectedPersistentDirectoryMap, serviceConfig.GetPersistentDirectories().ServiceDirpathToPersistentDirectory)
 
 	require.Equal(t, testEntryPointSlice, serviceConfig.GetEntrypointArgs())
 	require.Equal(t, testCmdSlice, serviceConfig.GetCmdArgs())
@@ -110,4 +111,8 @@ func (t *serviceConfigFullTestCase) Assert(typeValue builtin_argument.KurtosisVa
 	require.Equal(t, testMinCpuMilliCores, serviceConfig.GetMinCPUAllocationMillicpus())
 
 	require.Equal(t, testServiceConfigLabels, serviceConfig.GetLabels())
+
+	restartPolicy, err := serviceConfigStarlark.GetRestartPolicy()
+	require.Nil(t, err)
+	require.NotNil(t, restartPolicy)
 }
@@ -0,0 +1,69 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type setConnectionTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestSetConnection() {
+	expectedConnectionConfig := partition_topology.NewDefaultConnectionConfig()
+	expectedConnectionConfig.PacketLossPercentage = testPacketLossPercentage
+	expectedConnectionConfig.PacketDelay.DelayMs = testLatencyMs
+
+	suite.serviceNetwork.EXPECT().SetConnection(
+		mock.Anything,
+		map[service.ServiceName]bool{testServiceName: true},
+		map[service.ServiceName]bool{testServiceName2: true},
+		expectedConnectionConfig,
+	).Times(1).Return(
+		nil,
+	)
+
+	suite.run(&setConnectionTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *setConnectionTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return connection.NewSetConnection(t.serviceNetwork)
+}
+
+func (t *setConnectionTestCase) GetStarlarkCode() string {
+	connectionConfig := fmt.Sprintf("%s(%s=%s, %s=%d)",
+		connection_config.ConnectionConfigTypeName,
+		connection_config.PacketLossPercentageAttr, starlark.Float(testPacketLossPercentage),
+		connection_config.LatencyMsAttr, testLatencyMs,
+	)
+	return fmt.Sprintf("%s(%s=[%q], %s=[%q], %s=%s)",
+		connection.SetConnectionBuiltinName,
+		connection.GroupAArgName, testServiceName,
+		connection.GroupBArgName, testServiceName2,
+		connection.ConnectionConfigArgName, connectionConfig,
+	)
+}
+
+func (t *setConnectionTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *setConnectionTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Connection between services [%s] and services [%s] set", testServiceName, testServiceName2)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -47,6 +47,13 @@ var (
 	testFilesArtifactName2      = "file_2"
 	testPersistentDirectoryPath = "path/to/persistent/dir"
 	testPersistentDirectoryKey  = "persistent-dir-test"
+	testPersistentDirectorySize = uint64(2048)
+
+	testPacketLossPercentage  = float32(50)
+	testLatencyMs             = uint32(100)
+	testJitterMs              = uint32(10)
+	testCorrelationPercentage = float32(25)
+	testBandwidthKbit         = uint64(1024)
 
 	testEntryPointSlice = []string{
 		"127.0.0.0",
@@ -91,6 +98,18 @@ var (
 	testReadyConditions2Interval       = "500ms"
 	testReadyConditions2Timeout        = "2s"
 
+	testLivenessCheckInterval         = "30s"
+	testLivenessCheckFailureThreshold = uint32(5)
+
+	testRestartPolicyMode       = "always"
+	testRestartPolicyMaxRetries = uint32(3)
+	testRestartPolicyBackoff    = "10s"
+
+	testBuiltImageName       = "my-app:latest"
+	testBuildContextLocator  = "./app"
+	testDockerfileName       = "build.Dockerfile"
+	testBuildTargetStageName = "runtime"
+
 	testGetRequestMethod = "GET"
 
 	testNoPackageReplaceOptions = map[string]string{}
@@ -86,6 +86,30 @@ func (suite *KurtosisPlanInstructionTestSuite) run(builtin KurtosisPlanInstructi
 	suite.Require().Equal(starlarkCodeForAssertion, serializedInstruction)
 }
 
+// runShouldFailExecution interprets the instruction and executes it, expecting the execution to fail. It returns the
+// interpretation result and the execution error so that they can be checked by the test
+func (suite *KurtosisPlanInstructionTestSuite) runShouldFailExecution(builtin KurtosisPlanInstructionBaseTest) (starlark.Value, error) {
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+
+	instructionFromBuiltin := builtin.GetInstruction()
+	emptyEnclaveComponents := enclave_structure.NewEnclaveComponents()
+	emptyInstructionsPlanMask := resolver.NewInstructionsPlanMask(0)
+	instructionWrapper := kurtosis_plan_instruction.NewKurtosisPlanInstructionWrapper(instructionFromBuiltin, emptyEnclaveComponents, nil, emptyInstructionsPlanMask, instructionsPlan)
+	suite.starlarkEnv[instructionWrapper.GetName()] = starlark.NewBuiltin(instructionWrapper.GetName(), instructionWrapper.CreateBuiltin())
+
+	globals, err := starlark.ExecFile(suite.starlarkThread, startosis_constants.PackageIdPlaceholderForStandaloneScript, codeToExecute(builtin.GetStarlarkCode()), suite.starlarkEnv)
+	suite.Require().Nil(err, "Error interpreting Starlark code")
+	interpretationResult := extractResultValue(suite.T(), globals)
+
+	suite.Require().Equal(1, instructionsPlan.Size())
+	instructionsSequence, err := instructionsPlan.GeneratePlan()
+	suite.Require().Nil(err)
+
+	_, err = instructionsSequence[0].GetInstruction().Execute(context.WithValue(context.Background(), startosis_constants.ParallelismParam, 1))
+	suite.Require().Error(err, "Expected executing starlark code %s to fail, but it didn't fail", builtin.GetStarlarkCode())
+	return interpretationResult, err
+}
+
 func (suite *KurtosisPlanInstructionTestSuite) runShouldFail(packageId string, builtin KurtosisPlanInstructionBaseTest, expectedErrMsg string) {
 	instructionsPlan := instructions_plan.NewInstructionsPlan()
 
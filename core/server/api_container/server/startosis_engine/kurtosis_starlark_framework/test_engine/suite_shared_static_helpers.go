@@ -3,6 +3,8 @@ package test_engine
 import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_health"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/stretchr/testify/require"
 	bolt "go.etcd.io/bbolt"
@@ -63,3 +65,9 @@ func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 
 	return enclaveDb
 }
+
+func newServiceHealthMonitorForTest(t *testing.T, serviceNetwork service_network.ServiceNetwork) *service_health.ServiceHealthMonitor {
+	serviceHealthMonitor, err := service_health.NewServiceHealthMonitor(serviceNetwork, getEnclaveDBForTest(t))
+	require.NoError(t, err)
+	return serviceHealthMonitor
+}
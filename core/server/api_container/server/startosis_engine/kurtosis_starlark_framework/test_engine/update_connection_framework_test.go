@@ -0,0 +1,74 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type updateConnectionTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestUpdateConnection() {
+	isBlocked := true
+	expectedOverrides := &partition_topology.ConnectionConfigOverrides{
+		PacketLossPercentage:  nil,
+		DelayMs:               nil,
+		JitterMs:              nil,
+		CorrelationPercentage: nil,
+		BandwidthKbit:         nil,
+		IsBlocked:             &isBlocked,
+	}
+
+	suite.serviceNetwork.EXPECT().UpdateConnection(
+		mock.Anything,
+		map[service.ServiceName]bool{testServiceName: true},
+		map[service.ServiceName]bool{testServiceName2: true},
+		expectedOverrides,
+	).Times(1).Return(
+		nil,
+	)
+
+	suite.run(&updateConnectionTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *updateConnectionTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return connection.NewUpdateConnection(t.serviceNetwork)
+}
+
+func (t *updateConnectionTestCase) GetStarlarkCode() string {
+	connectionConfig := fmt.Sprintf("%s(%s=%s)",
+		connection_config.ConnectionConfigTypeName,
+		connection_config.BlockedAttr, "True",
+	)
+	return fmt.Sprintf("%s(%s=[%q], %s=[%q], %s=%s)",
+		connection.UpdateConnectionBuiltinName,
+		connection.GroupAArgName, testServiceName,
+		connection.GroupBArgName, testServiceName2,
+		connection.ConnectionConfigArgName, connectionConfig,
+	)
+}
+
+func (t *updateConnectionTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *updateConnectionTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Connection between services [%s] and services [%s] updated", testServiceName, testServiceName2)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
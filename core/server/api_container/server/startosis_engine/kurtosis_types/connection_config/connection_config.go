@@ -0,0 +1,211 @@
+package connection_config
+
+import (
+	"math"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	ConnectionConfigTypeName = "ConnectionConfig"
+
+	PacketLossPercentageAttr  = "packet_loss_percentage"
+	LatencyMsAttr             = "latency_ms"
+	JitterMsAttr              = "jitter_ms"
+	CorrelationPercentageAttr = "correlation_percentage"
+	BandwidthKbitAttr         = "bandwidth_kbit"
+	BlockedAttr               = "blocked"
+
+	minPercentage = 0
+	maxPercentage = 100
+
+	minBandwidthKbit = 1
+)
+
+func NewConnectionConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ConnectionConfigTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PacketLossPercentageAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Float],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.FloatInRange(value, PacketLossPercentageAttr, minPercentage, maxPercentage)
+					},
+				},
+				{
+					Name:              LatencyMsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, LatencyMsAttr, 0, math.MaxUint32)
+					},
+				},
+				{
+					Name:              JitterMsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, JitterMsAttr, 0, math.MaxUint32)
+					},
+				},
+				{
+					Name:              CorrelationPercentageAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Float],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.FloatInRange(value, CorrelationPercentageAttr, minPercentage, maxPercentage)
+					},
+				},
+				{
+					Name:              BandwidthKbitAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, BandwidthKbitAttr, minBandwidthKbit, math.MaxUint64)
+					},
+				},
+				{
+					Name:              BlockedAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Instantiate: instantiate,
+	}
+}
+
+func instantiate(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(ConnectionConfigTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &ConnectionConfig{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// ConnectionConfig is a starlark.Value that represents the network conditions between two groups of services
+type ConnectionConfig struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (connectionConfig *ConnectionConfig) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := connectionConfig.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &ConnectionConfig{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+// ToKurtosisType returns the full connection config, where the attributes that were not set take their default value
+func (connectionConfig *ConnectionConfig) ToKurtosisType() (partition_topology.ConnectionConfig, *startosis_errors.InterpretationError) {
+	overrides, interpretationErr := connectionConfig.ToOverrides()
+	if interpretationErr != nil {
+		return partition_topology.NewDefaultConnectionConfig(), interpretationErr
+	}
+	return overrides.ApplyTo(partition_topology.NewDefaultConnectionConfig()), nil
+}
+
+// ToOverrides returns only the attributes that were explicitly set on this connection config
+func (connectionConfig *ConnectionConfig) ToOverrides() (*partition_topology.ConnectionConfigOverrides, *startosis_errors.InterpretationError) {
+	overrides := &partition_topology.ConnectionConfigOverrides{
+		PacketLossPercentage:  nil,
+		DelayMs:               nil,
+		JitterMs:              nil,
+		CorrelationPercentage: nil,
+		BandwidthKbit:         nil,
+		IsBlocked:             nil,
+	}
+
+	packetLossPercentage, found, interpretationErr := connectionConfig.getFloat32AttrIfSet(PacketLossPercentageAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		overrides.PacketLossPercentage = &packetLossPercentage
+	}
+
+	latencyMs, found, interpretationErr := connectionConfig.getUint64AttrIfSet(LatencyMsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		delayMs := uint32(latencyMs)
+		overrides.DelayMs = &delayMs
+	}
+
+	jitterMs, found, interpretationErr := connectionConfig.getUint64AttrIfSet(JitterMsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		jitterMsUint32 := uint32(jitterMs)
+		overrides.JitterMs = &jitterMsUint32
+	}
+
+	correlationPercentage, found, interpretationErr := connectionConfig.getFloat32AttrIfSet(CorrelationPercentageAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		overrides.CorrelationPercentage = &correlationPercentage
+	}
+
+	bandwidthKbit, found, interpretationErr := connectionConfig.getUint64AttrIfSet(BandwidthKbitAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		overrides.BandwidthKbit = &bandwidthKbit
+	}
+
+	blocked, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Bool](connectionConfig.KurtosisValueTypeDefault, BlockedAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		isBlocked := bool(blocked)
+		overrides.IsBlocked = &isBlocked
+	}
+	return overrides, nil
+}
+
+func (connectionConfig *ConnectionConfig) getFloat32AttrIfSet(attrName string) (float32, bool, *startosis_errors.InterpretationError) {
+	attrValue, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Float](connectionConfig.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return 0, false, interpretationErr
+	}
+	if !found {
+		return 0, false, nil
+	}
+	return float32(attrValue), true, nil
+}
+
+func (connectionConfig *ConnectionConfig) getUint64AttrIfSet(attrName string) (uint64, bool, *startosis_errors.InterpretationError) {
+	attrValue, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](connectionConfig.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return 0, false, interpretationErr
+	}
+	if !found {
+		return 0, false, nil
+	}
+	attrValueUint64, ok := attrValue.Uint64()
+	if !ok {
+		return 0, false, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", attrName, attrValue)
+	}
+	return attrValueUint64, true, nil
+}
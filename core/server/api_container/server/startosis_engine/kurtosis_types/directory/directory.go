@@ -13,6 +13,14 @@ const (
 
 	ArtifactNameAttr  = "artifact_name"
 	PersistentKeyAttr = "persistent_key"
+	SizeAttr          = "size"
+	SharedAttr        = "shared"
+	ReadOnlyAttr      = "read_only"
+
+	minimumPersistentDirectorySizeMegabytes = 1
+	// 100TB is way above anything a persistent directory is expected to need, and keeps the size in bytes far from
+	// overflowing the int64 the backends use to size volumes
+	maximumPersistentDirectorySizeMegabytes = 100_000_000
 )
 
 func NewDirectoryType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -37,6 +45,26 @@ func NewDirectoryType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return builtin_argument.NonEmptyString(value, ArtifactNameAttr)
 					},
 				},
+				{
+					Name:              SizeAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, SizeAttr, minimumPersistentDirectorySizeMegabytes, maximumPersistentDirectorySizeMegabytes)
+					},
+				},
+				{
+					Name:              SharedAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
+				{
+					Name:              ReadOnlyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
 			},
 		},
 
@@ -66,6 +94,9 @@ func CreateDirectoryFromFilesArtifact(
 	args := []starlark.Value{
 		starlark.String(filesArtifactName),
 		nil,
+		nil,
+		nil,
+		nil,
 	}
 
 	argumentDefinitions := NewDirectoryType().KurtosisBaseBuiltin.Arguments
@@ -112,3 +143,40 @@ func (directory *Directory) GetPersistentKeyIfSet() (string, bool, *startosis_er
 	}
 	return persistentKey.GoString(), true, nil
 }
+
+// GetSizeIfSet returns the size of the persistent directory, in megabytes
+func (directory *Directory) GetSizeIfSet() (uint64, bool, *startosis_errors.InterpretationError) {
+	sizeStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](
+		directory.KurtosisValueTypeDefault, SizeAttr)
+	if interpretationErr != nil {
+		return 0, false, interpretationErr
+	}
+	if !found {
+		return 0, false, nil
+	}
+	size, ok := sizeStarlark.Uint64()
+	if !ok {
+		return 0, false, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", SizeAttr, sizeStarlark)
+	}
+	return size, true, nil
+}
+
+func (directory *Directory) GetSharedIfSet() (bool, bool, *startosis_errors.InterpretationError) {
+	return directory.getBoolAttrIfSet(SharedAttr)
+}
+
+func (directory *Directory) GetReadOnlyIfSet() (bool, bool, *startosis_errors.InterpretationError) {
+	return directory.getBoolAttrIfSet(ReadOnlyAttr)
+}
+
+func (directory *Directory) getBoolAttrIfSet(attrName string) (bool, bool, *startosis_errors.InterpretationError) {
+	attrValue, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Bool](
+		directory.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return false, false, interpretationErr
+	}
+	if !found {
+		return false, false, nil
+	}
+	return bool(attrValue), true, nil
+}
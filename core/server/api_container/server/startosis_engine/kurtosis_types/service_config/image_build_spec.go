@@ -0,0 +1,209 @@
+package service_config
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"go.starlark.net/starlark"
+)
+
+const (
+	ImageBuildSpecTypeName = "ImageBuildSpec"
+
+	BuiltImageNameAttr  = "image_name"
+	BuildContextDirAttr = "build_context_dir"
+	DockerfileAttr      = "dockerfile"
+	TargetStageAttr     = "target_stage"
+
+	defaultDockerfileRelativeFilepath = "Dockerfile"
+	parentDirPathPrefix               = ".."
+)
+
+func NewImageBuildSpecType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ImageBuildSpecTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              BuiltImageNameAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, BuiltImageNameAttr)
+					},
+				},
+				{
+					Name:              BuildContextDirAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, BuildContextDirAttr)
+					},
+				},
+				{
+					Name:              DockerfileAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         validateDockerfileRelativeFilepath,
+				},
+				{
+					Name:              TargetStageAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, TargetStageAttr)
+					},
+				},
+			},
+		},
+
+		Instantiate: instantiateImageBuildSpec,
+	}
+}
+
+func instantiateImageBuildSpec(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, err := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(ImageBuildSpecTypeName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageBuildSpec{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// ImageBuildSpec is a starlark.Value that describes how to build a container image from a Dockerfile inside a
+// package, to be used in place of a prebuilt image name
+type ImageBuildSpec struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (imageBuildSpec *ImageBuildSpec) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := imageBuildSpec.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &ImageBuildSpec{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (imageBuildSpec *ImageBuildSpec) GetImageName() (string, *startosis_errors.InterpretationError) {
+	imageName, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](imageBuildSpec.KurtosisValueTypeDefault, BuiltImageNameAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", BuiltImageNameAttr, ImageBuildSpecTypeName)
+	}
+	return imageName.GoString(), nil
+}
+
+// ToKurtosisType resolves the build context directory, which is a locator relative to the package being run, to its
+// path on disk
+func (imageBuildSpec *ImageBuildSpec) ToKurtosisType(
+	locatorOfModuleInWhichThisBuiltInIsBeingCalled string,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string,
+) (*image_build_spec.ImageBuildSpec, *startosis_errors.InterpretationError) {
+	buildContextLocator, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](imageBuildSpec.KurtosisValueTypeDefault, BuildContextDirAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", BuildContextDirAttr, ImageBuildSpecTypeName)
+	}
+	absoluteLocator, interpretationErr := packageContentProvider.GetAbsoluteLocatorForRelativeLocator(locatorOfModuleInWhichThisBuiltInIsBeingCalled, buildContextLocator.GoString(), packageReplaceOptions)
+	if interpretationErr != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(interpretationErr, "Tried to convert locator '%v' into absolute locator but failed", buildContextLocator.GoString())
+	}
+	buildContextDirpath, interpretationErr := packageContentProvider.GetOnDiskAbsoluteFilePath(absoluteLocator)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	dockerfileRelativeFilepath := defaultDockerfileRelativeFilepath
+	dockerfile, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](imageBuildSpec.KurtosisValueTypeDefault, DockerfileAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		dockerfileRelativeFilepath = dockerfile.GoString()
+	}
+	dockerfileInfo, err := os.Stat(filepath.Join(buildContextDirpath, dockerfileRelativeFilepath))
+	if err != nil || dockerfileInfo.IsDir() {
+		return nil, startosis_errors.NewInterpretationError("No Dockerfile '%v' found in build context directory '%v' of image build spec", dockerfileRelativeFilepath, buildContextLocator.GoString())
+	}
+
+	var targetStage string
+	targetStageStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](imageBuildSpec.KurtosisValueTypeDefault, TargetStageAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		targetStage = targetStageStarlark.GoString()
+	}
+
+	return image_build_spec.NewImageBuildSpec(buildContextDirpath, dockerfileRelativeFilepath, targetStage), nil
+}
+
+// ConvertImage returns the name of the image to use for a service, and how to build it if the image is built from an
+// ImageBuildSpec instead of being fetched by name
+func ConvertImage(
+	rawImage starlark.Value,
+	locatorOfModuleInWhichThisBuiltInIsBeingCalled string,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string,
+) (string, *image_build_spec.ImageBuildSpec, *startosis_errors.InterpretationError) {
+	switch image := rawImage.(type) {
+	case starlark.String:
+		return image.GoString(), nil, nil
+	case *ImageBuildSpec:
+		imageName, interpretationErr := image.GetImageName()
+		if interpretationErr != nil {
+			return "", nil, interpretationErr
+		}
+		imageBuildSpec, interpretationErr := image.ToKurtosisType(locatorOfModuleInWhichThisBuiltInIsBeingCalled, packageContentProvider, packageReplaceOptions)
+		if interpretationErr != nil {
+			return "", nil, interpretationErr
+		}
+		return imageName, imageBuildSpec, nil
+	default:
+		return "", nil, startosis_errors.NewInterpretationError("The image is expected to be an image name or an '%s', got '%s'", ImageBuildSpecTypeName, reflect.TypeOf(rawImage))
+	}
+}
+
+// ValidateImage validates an attribute that accepts either the name of a prebuilt image or an ImageBuildSpec
+func ValidateImage(value starlark.Value, attributeName string) *startosis_errors.InterpretationError {
+	if _, ok := value.(*ImageBuildSpec); ok {
+		return nil
+	}
+	if _, ok := value.(starlark.String); !ok {
+		return startosis_errors.NewInterpretationError("Attribute '%s' is expected to be an image name or an '%s', got '%s'", attributeName, ImageBuildSpecTypeName, reflect.TypeOf(value))
+	}
+	return builtin_argument.NonEmptyString(value, attributeName)
+}
+
+func validateDockerfileRelativeFilepath(value starlark.Value) *startosis_errors.InterpretationError {
+	if interpretationErr := builtin_argument.NonEmptyString(value, DockerfileAttr); interpretationErr != nil {
+		return interpretationErr
+	}
+	dockerfile, ok := value.(starlark.String)
+	if !ok {
+		return startosis_errors.NewInterpretationError("Attribute '%s' is expected to be a string, got '%s'", DockerfileAttr, reflect.TypeOf(value))
+	}
+	cleanedDockerfile := path.Clean(dockerfile.GoString())
+	if path.IsAbs(cleanedDockerfile) || cleanedDockerfile == parentDirPathPrefix || strings.HasPrefix(cleanedDockerfile, parentDirPathPrefix+"/") {
+		return startosis_errors.NewInterpretationError("Attribute '%s' must be a path relative to the build context directory and inside it, got '%s'", DockerfileAttr, dockerfile.GoString())
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/verify"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"math"
+	"time"
+)
+
+const (
+	LivenessCheckTypeName = "LivenessCheck"
+
+	FailureThresholdAttr = "failure_threshold"
+
+	defaultLivenessCheckInterval = 10 * time.Second
+	defaultFailureThreshold      = uint32(3)
+)
+
+func NewLivenessCheckType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: LivenessCheckTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              RecipeAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         validateRecipe,
+				},
+				{
+					Name:              FieldAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, FieldAttr)
+					},
+				},
+				{
+					Name:              AssertionAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         verify.ValidateVerificationToken,
+				},
+				{
+					Name:              TargetAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Comparable],
+					Validator:         nil,
+				},
+				{
+					Name:              IntervalAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, IntervalAttr)
+					},
+				},
+				{
+					Name:              FailureThresholdAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, FailureThresholdAttr, 1, math.MaxUint32)
+					},
+				},
+			},
+		},
+		Instantiate: instantiateLivenessCheck,
+	}
+}
+
+func instantiateLivenessCheck(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, err := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(LivenessCheckTypeName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	return &LivenessCheck{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// LivenessCheck is a starlark.Value that holds all the information needed for continuously checking that a service
+// is alive once it has started
+type LivenessCheck struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (livenessCheck *LivenessCheck) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := livenessCheck.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &LivenessCheck{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (livenessCheck *LivenessCheck) GetRecipe() (recipe.Recipe, *startosis_errors.InterpretationError) {
+	httpRecipe, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[recipe.HttpRequestRecipe](livenessCheck.KurtosisValueTypeDefault, RecipeAttr)
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
+			RecipeAttr, LivenessCheckTypeName)
+	}
+	if interpretationErr == nil {
+		return httpRecipe, nil
+	}
+	execRecipe, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*recipe.ExecRecipe](livenessCheck.KurtosisValueTypeDefault, RecipeAttr)
+	if interpretationErr == nil {
+		return execRecipe, nil
+	}
+	return nil, interpretationErr
+}
+
+func (livenessCheck *LivenessCheck) GetField() (string, *startosis_errors.InterpretationError) {
+	field, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](livenessCheck.KurtosisValueTypeDefault, FieldAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
+			FieldAttr, LivenessCheckTypeName)
+	}
+	return field.GoString(), nil
+}
+
+func (livenessCheck *LivenessCheck) GetAssertion() (string, *startosis_errors.InterpretationError) {
+	assertion, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](livenessCheck.KurtosisValueTypeDefault, AssertionAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
+			AssertionAttr, LivenessCheckTypeName)
+	}
+	return assertion.GoString(), nil
+}
+
+func (livenessCheck *LivenessCheck) GetTarget() (starlark.Comparable, *startosis_errors.InterpretationError) {
+	target, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Comparable](livenessCheck.KurtosisValueTypeDefault, TargetAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
+			TargetAttr, LivenessCheckTypeName)
+	}
+	return target, nil
+}
+
+func (livenessCheck *LivenessCheck) GetInterval() (time.Duration, *startosis_errors.InterpretationError) {
+	interval := defaultLivenessCheckInterval
+
+	intervalStr, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](livenessCheck.KurtosisValueTypeDefault, IntervalAttr)
+	if interpretationErr != nil {
+		return interval, interpretationErr
+	}
+	if found {
+		parsedInterval, parseErr := time.ParseDuration(intervalStr.GoString())
+		if parseErr != nil {
+			return interval, startosis_errors.WrapWithInterpretationError(parseErr, "An error occurred when parsing interval '%v'", intervalStr.GoString())
+		}
+		interval = parsedInterval
+	}
+	return interval, nil
+}
+
+func (livenessCheck *LivenessCheck) GetFailureThreshold() (uint32, *startosis_errors.InterpretationError) {
+	failureThreshold := defaultFailureThreshold
+
+	failureThresholdStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](livenessCheck.KurtosisValueTypeDefault, FailureThresholdAttr)
+	if interpretationErr != nil {
+		return failureThreshold, interpretationErr
+	}
+	if found {
+		failureThresholdUint64, ok := failureThresholdStarlark.Uint64()
+		if !ok {
+			return failureThreshold, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", FailureThresholdAttr, failureThresholdStarlark)
+		}
+		failureThreshold = uint32(failureThresholdUint64)
+	}
+	return failureThreshold, nil
+}
@@ -0,0 +1,130 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_health"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"math"
+	"time"
+)
+
+const (
+	RestartPolicyTypeName = "RestartPolicy"
+
+	PolicyAttr     = "policy"
+	MaxRetriesAttr = "max_retries"
+	BackoffAttr    = "backoff"
+
+	defaultRestartPolicyMode = service_health.RestartPolicyMode_OnFailure
+	defaultRestartBackoff    = 5 * time.Second
+)
+
+func NewRestartPolicyType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RestartPolicyTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PolicyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						var acceptableValues []string
+						for _, mode := range service_health.RestartPolicyModeValues() {
+							acceptableValues = append(acceptableValues, string(mode))
+						}
+						return builtin_argument.StringValues(value, PolicyAttr, acceptableValues)
+					},
+				},
+				{
+					Name:              MaxRetriesAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, MaxRetriesAttr, 0, math.MaxUint32)
+					},
+				},
+				{
+					Name:              BackoffAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, BackoffAttr)
+					},
+				},
+			},
+		},
+		Instantiate: instantiateRestartPolicy,
+	}
+}
+
+func instantiateRestartPolicy(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, err := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(RestartPolicyTypeName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	return &RestartPolicy{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// RestartPolicy is a starlark.Value that controls when a service is restarted by the API container
+type RestartPolicy struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (restartPolicy *RestartPolicy) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := restartPolicy.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &RestartPolicy{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (restartPolicy *RestartPolicy) ToKurtosisType() (*service_health.RestartPolicy, *startosis_errors.InterpretationError) {
+	mode := defaultRestartPolicyMode
+	modeStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](restartPolicy.KurtosisValueTypeDefault, PolicyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		parsedMode, err := service_health.RestartPolicyModeFromString(modeStarlark.GoString())
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing attribute '%s' of type '%s'", PolicyAttr, RestartPolicyTypeName)
+		}
+		mode = parsedMode
+	}
+
+	maxRetries := service_health.UnlimitedRestarts
+	maxRetriesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](restartPolicy.KurtosisValueTypeDefault, MaxRetriesAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		maxRetriesUint64, ok := maxRetriesStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", MaxRetriesAttr, maxRetriesStarlark)
+		}
+		maxRetries = uint32(maxRetriesUint64)
+	}
+
+	backoff := defaultRestartBackoff
+	backoffStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](restartPolicy.KurtosisValueTypeDefault, BackoffAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		parsedBackoff, parseErr := time.ParseDuration(backoffStarlark.GoString())
+		if parseErr != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(parseErr, "An error occurred when parsing backoff '%v'", backoffStarlark.GoString())
+		}
+		backoff = parsedBackoff
+	}
+
+	return service_health.NewRestartPolicy(mode, maxRetries, backoff), nil
+}
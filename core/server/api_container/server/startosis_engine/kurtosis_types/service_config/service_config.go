@@ -15,6 +15,7 @@ import (
 	starlark_port_spec "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/port_spec"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_warning"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"go.starlark.net/starlark"
 	"math"
 	"path"
@@ -39,6 +40,9 @@ const (
 	MaxCpuMilliCoresAttr            = "max_cpu"
 	MaxMemoryMegaBytesAttr          = "max_memory"
 	LabelsAttr                      = "labels"
+	LivenessCheckAttr               = "liveness_check"
+	RestartPolicyAttr               = "restart_policy"
+	ReplicasAttr                    = "replicas"
 
 	DefaultPrivateIPAddrPlaceholder = "KURTOSIS_IP_ADDR_PLACEHOLDER"
 
@@ -47,6 +51,8 @@ const (
 	filesArtifactsExpanderImage string = "kurtosistech/files-artifacts-expander"
 
 	minimumMemoryAllocationMegabytes = 6
+
+	minimumReplicas = 1
 )
 
 func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -58,9 +64,10 @@ func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 				{
 					Name:              ImageAttr,
 					IsOptional:        false,
-					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					// the value can be the name of a prebuilt image, or an ImageBuildSpec to build it from the package
 					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
-						return builtin_argument.NonEmptyString(value, ImageAttr)
+						return ValidateImage(value, ImageAttr)
 					},
 				},
 				{
@@ -179,6 +186,26 @@ func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return builtin_argument.ServiceConfigLabels(value, LabelsAttr)
 					},
 				},
+				{
+					Name:              LivenessCheckAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*LivenessCheck],
+					Validator:         nil,
+				},
+				{
+					Name:              RestartPolicyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*RestartPolicy],
+					Validator:         nil,
+				},
+				{
+					Name:              ReplicasAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, ReplicasAttr, minimumReplicas, math.MaxUint32)
+					},
+				},
 			},
 		},
 
@@ -258,17 +285,14 @@ func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string]strin
 	}, nil
 }
 
-func convertPersistentDirectoryMounts(persistentDirectoriesDirpathsMap map[string]string) *service_directory.PersistentDirectories {
-	persistentDirectoriesMap := map[string]service_directory.DirectoryPersistentKey{}
-	for dirPath, persistentKeyStr := range persistentDirectoriesDirpathsMap {
-		persistentDirectoriesMap[dirPath] = service_directory.DirectoryPersistentKey(persistentKeyStr)
-	}
-	return service_directory.NewPersistentDirectories(persistentDirectoriesMap)
-}
-
-func (config *ServiceConfig) ToKurtosisType(serviceNetwork service_network.ServiceNetwork) (*service.ServiceConfig, *startosis_errors.InterpretationError) {
+func (config *ServiceConfig) ToKurtosisType(
+	serviceNetwork service_network.ServiceNetwork,
+	locatorOfModuleInWhichThisBuiltInIsBeingCalled string,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string,
+) (*service.ServiceConfig, *startosis_errors.InterpretationError) {
 	var ok bool
-	image, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, ImageAttr)
+	image, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Value](config.KurtosisValueTypeDefault, ImageAttr)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
@@ -276,7 +300,10 @@ func (config *ServiceConfig) ToKurtosisType(serviceNetwork service_network.Servi
 		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
 			ImageAttr, ServiceConfigTypeName)
 	}
-	imageName := image.GoString()
+	imageName, imageBuildSpec, interpretationErr := ConvertImage(image, locatorOfModuleInWhichThisBuiltInIsBeingCalled, packageContentProvider, packageReplaceOptions)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
 
 	privatePorts := map[string]*port_spec.PortSpec{}
 	privatePortsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, PortsAttr)
@@ -316,7 +343,7 @@ func (config *ServiceConfig) ToKurtosisType(serviceNetwork service_network.Servi
 	}
 	if found {
 		var filesArtifactsMountDirpathsMap map[string]string
-		var persistentDirectoriesDirpathsMap map[string]string
+		var persistentDirectoriesDirpathsMap map[string]service_directory.PersistentDirectory
 		filesArtifactsMountDirpathsMap, persistentDirectoriesDirpathsMap, interpretationErr = convertFilesArguments(FilesAttr, filesStarlark)
 		if interpretationErr != nil {
 			return nil, interpretationErr
@@ -325,7 +352,7 @@ func (config *ServiceConfig) ToKurtosisType(serviceNetwork service_network.Servi
 		if interpretationErr != nil {
 			return nil, interpretationErr
 		}
-		persistentDirectories = convertPersistentDirectoryMounts(persistentDirectoriesDirpathsMap)
+		persistentDirectories = service_directory.NewPersistentDirectories(persistentDirectoriesDirpathsMap)
 	}
 
 	var entryPointArgs []string
@@ -465,6 +492,7 @@ func (config *ServiceConfig) ToKurtosisType(serviceNetwork service_network.Servi
 
 	serviceConfig, err := service.CreateServiceConfig(
 		imageName,
+		imageBuildSpec,
 		privatePorts,
 		publicPorts,
 		entryPointArgs,
@@ -497,6 +525,46 @@ func (config *ServiceConfig) GetReadyCondition() (*ReadyCondition, *startosis_er
 	return readyConditions, nil
 }
 
+func (config *ServiceConfig) GetLivenessCheck() (*LivenessCheck, *startosis_errors.InterpretationError) {
+	livenessCheck, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*LivenessCheck](config.KurtosisValueTypeDefault, LivenessCheckAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return livenessCheck, nil
+}
+
+func (config *ServiceConfig) GetRestartPolicy() (*RestartPolicy, *startosis_errors.InterpretationError) {
+	restartPolicy, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*RestartPolicy](config.KurtosisValueTypeDefault, RestartPolicyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return restartPolicy, nil
+}
+
+// GetReplicasIfSet returns the number of replicas of the service to create, and whether it was set at all
+func (config *ServiceConfig) GetReplicasIfSet() (uint32, bool, *startosis_errors.InterpretationError) {
+	replicasStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, ReplicasAttr)
+	if interpretationErr != nil {
+		return 0, false, interpretationErr
+	}
+	if !found {
+		return 0, false, nil
+	}
+	replicas, ok := replicasStarlark.Uint64()
+	if !ok {
+		return 0, false, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", ReplicasAttr, replicasStarlark)
+	}
+	return uint32(replicas), true, nil
+}
+
 func convertPortMapEntry(attrNameForLogging string, key starlark.Value, value starlark.Value, dictForLogging *starlark.Dict) (string, *port_spec.PortSpec, *startosis_errors.InterpretationError) {
 	keyStr, ok := key.(starlark.String)
 	if !ok {
@@ -513,9 +581,9 @@ func convertPortMapEntry(attrNameForLogging string, key starlark.Value, value st
 	return keyStr.GoString(), servicePortSpec, nil
 }
 
-func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict) (map[string]string, map[string]string, *startosis_errors.InterpretationError) {
+func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict) (map[string]string, map[string]service_directory.PersistentDirectory, *startosis_errors.InterpretationError) {
 	filesArtifacts := map[string]string{}
-	persistentDirectories := map[string]string{}
+	persistentDirectories := map[string]service_directory.PersistentDirectory{}
 	for _, fileItem := range filesDict.Items() {
 		rawDirPath := fileItem[0]
 		dirPath, ok := rawDirPath.(starlark.String)
@@ -550,11 +618,32 @@ func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict)
 			return nil, nil, startosis_errors.NewInterpretationError("Parameter '%s' and '%s' cannot be set on the same '%s' object: '%s'",
 				directory.ArtifactNameAttr, directory.PersistentKeyAttr, directory.DirectoryTypeName, directoryObj.String())
 		}
+		size, sizeSet, interpretationErr := directoryObj.GetSizeIfSet()
+		if interpretationErr != nil {
+			return nil, nil, interpretationErr
+		}
+		isShared, sharedSet, interpretationErr := directoryObj.GetSharedIfSet()
+		if interpretationErr != nil {
+			return nil, nil, interpretationErr
+		}
+		isReadOnly, readOnlySet, interpretationErr := directoryObj.GetReadOnlyIfSet()
+		if interpretationErr != nil {
+			return nil, nil, interpretationErr
+		}
 		if artifactNameSet {
+			if sizeSet || sharedSet || readOnlySet {
+				return nil, nil, startosis_errors.NewInterpretationError("Parameters '%s', '%s' and '%s' can only be set alongside '%s' on a '%s' object: '%s'",
+					directory.SizeAttr, directory.SharedAttr, directory.ReadOnlyAttr, directory.PersistentKeyAttr, directory.DirectoryTypeName, directoryObj.String())
+			}
 			filesArtifacts[dirPath.GoString()] = artifactName
 		} else {
 			// persistentKey is necessarily set since we checked the exclusivity above
-			persistentDirectories[dirPath.GoString()] = persistentKey
+			persistentDirectories[dirPath.GoString()] = service_directory.NewPersistentDirectory(
+				service_directory.DirectoryPersistentKey(persistentKey),
+				service_directory.DirectoryPersistentSize(size),
+				isShared,
+				isReadOnly,
+			)
 		}
 	}
 	return filesArtifacts, persistentDirectories, nil
@@ -5,6 +5,7 @@ type StarlarkContextParam string
 const (
 	MainFileName     = "main.star"
 	KurtosisYamlName = "kurtosis.yml"
+	KurtosisLockName = "kurtosis.lock"
 	EmptyInputArgs   = "{}" // empty JSON
 
 	NoOutputObject = ""
@@ -51,6 +51,19 @@ func NewStartosisExecutor(starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
 	}
 }
 
+// ReloadEnclavePlan replaces the in-memory enclave plan with the one persisted in the enclave database, which changes
+// when an enclave snapshot is restored
+func (executor *StartosisExecutor) ReloadEnclavePlan() error {
+	executor.mutex.Lock()
+	defer executor.mutex.Unlock()
+	enclavePlan, err := enclave_plan_persistence.Load(executor.enclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading the enclave plan from the enclave database")
+	}
+	executor.enclavePlan = enclavePlan
+	return nil
+}
+
 // Execute executes the list of Kurtosis instructions _asynchronously_ against the Kurtosis backend
 // Consumers of this method should read the response lines channel and return as soon as one it is closed
 //
@@ -0,0 +1,209 @@
+package startosis_linter
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+	"reflect"
+	"strings"
+)
+
+const (
+	starlarkPackagePath = "go.starlark.net/starlark"
+
+	argumentNamesSeparator = "', '"
+)
+
+// checkBuiltinCalls checks the arguments of every call to a builtin declared with the Kurtosis Starlark framework
+// against the definition of its arguments: unknown keyword arguments, too many positional arguments and literal values
+// of the wrong type or not passing validation are reported
+func (linter *StarlarkLinter) checkBuiltinCalls(parsedFile *syntax.File) []*LintFinding {
+	var findings []*LintFinding
+	syntax.Walk(parsedFile, func(node syntax.Node) bool {
+		callExpr, ok := node.(*syntax.CallExpr)
+		if !ok {
+			return true
+		}
+		if builtin, found := linter.getCalledBuiltin(callExpr); found {
+			findings = append(findings, checkBuiltinArguments(builtin, callExpr)...)
+		}
+		return true
+	})
+	return findings
+}
+
+// getCalledBuiltin returns the builtin called by the expression, if any. Plan instructions are only recognized when
+// called on the `plan` object
+func (linter *StarlarkLinter) getCalledBuiltin(callExpr *syntax.CallExpr) (*kurtosis_starlark_framework.KurtosisBaseBuiltin, bool) {
+	switch fn := callExpr.Fn.(type) {
+	case *syntax.Ident:
+		builtin, found := linter.globalBuiltins[fn.Name]
+		return builtin, found
+	case *syntax.DotExpr:
+		return linter.getCalledPlanInstruction(callExpr)
+	}
+	return nil, false
+}
+
+// getCalledPlanInstruction returns the plan instruction called by the expression, if any
+func (linter *StarlarkLinter) getCalledPlanInstruction(callExpr *syntax.CallExpr) (*kurtosis_starlark_framework.KurtosisBaseBuiltin, bool) {
+	fn, ok := callExpr.Fn.(*syntax.DotExpr)
+	if !ok {
+		return nil, false
+	}
+	receiver, ok := fn.X.(*syntax.Ident)
+	if !ok || receiver.Name != planObjectName {
+		return nil, false
+	}
+	instruction, found := linter.planInstructions[fn.Name.Name]
+	return instruction, found
+}
+
+func checkBuiltinArguments(builtin *kurtosis_starlark_framework.KurtosisBaseBuiltin, callExpr *syntax.CallExpr) []*LintFinding {
+	var findings []*LintFinding
+	positionalArgumentIdx := 0
+	hasUnpackedArguments := false
+	for _, argExpr := range callExpr.Args {
+		if keyword, value, isKeywordArgument := getKeywordArgument(argExpr); isKeywordArgument {
+			argument, found := getArgumentDefinition(builtin, keyword.Name)
+			if !found {
+				findings = append(findings, newLintFinding(UnknownArgumentRule, keyword.NamePos, "'%s' has no argument named '%s'; valid arguments are '%s'",
+					builtin.Name, keyword.Name, strings.Join(getArgumentNames(builtin), argumentNamesSeparator)))
+				continue
+			}
+			findings = append(findings, checkArgumentValue(builtin, argument, value)...)
+			continue
+		}
+		if unaryExpr, ok := argExpr.(*syntax.UnaryExpr); ok && (unaryExpr.Op == syntax.STAR || unaryExpr.Op == syntax.STARSTAR) {
+			// the arguments unpacked from a list or a dict are only known at interpretation time
+			hasUnpackedArguments = true
+			continue
+		}
+		if hasUnpackedArguments {
+			continue
+		}
+		if positionalArgumentIdx >= len(builtin.Arguments) {
+			findings = append(findings, newLintFinding(UnknownArgumentRule, syntax.Start(argExpr), "'%s' accepts at most %d positional arguments",
+				builtin.Name, len(builtin.Arguments)))
+			break
+		}
+		findings = append(findings, checkArgumentValue(builtin, builtin.Arguments[positionalArgumentIdx], argExpr)...)
+		positionalArgumentIdx++
+	}
+	return findings
+}
+
+// checkArgumentValue reproduces the checks the framework runs on an argument value at interpretation time, when the
+// value is a literal
+func checkArgumentValue(builtin *kurtosis_starlark_framework.KurtosisBaseBuiltin, argument *builtin_argument.BuiltinArgument, valueExpr syntax.Expr) []*LintFinding {
+	value, isLiteral := evaluateLiteral(valueExpr)
+	if !isLiteral {
+		return nil
+	}
+	if argument.ZeroValueProvider != nil {
+		// a nil type means the argument accepts an interface, hence its concrete type can't be checked
+		expectedType := reflect.TypeOf(argument.ZeroValueProvider())
+		if expectedType != nil && !reflect.TypeOf(value).AssignableTo(expectedType) {
+			return []*LintFinding{
+				newLintFinding(WrongArgumentTypeRule, syntax.Start(valueExpr), "Argument '%s' of '%s' expects a value of type '%s' but got a value of type '%s'",
+					argument.Name, builtin.Name, getTypeName(expectedType), value.Type()),
+			}
+		}
+	}
+	if argument.Validator != nil {
+		interpretationErr, validatorPanic := runValidator(argument.Validator, value)
+		if validatorPanic != nil {
+			return []*LintFinding{
+				newLintFinding(ArgumentValidationFailureRule, syntax.Start(valueExpr), "Argument '%s' of '%s' couldn't be validated, its validator panicked: %v",
+					argument.Name, builtin.Name, validatorPanic),
+			}
+		}
+		if interpretationErr != nil {
+			return []*LintFinding{
+				newLintFinding(WrongArgumentTypeRule, syntax.Start(valueExpr), "Argument '%s' of '%s' is invalid: %s",
+					argument.Name, builtin.Name, interpretationErr.Error()),
+			}
+		}
+	}
+	return nil
+}
+
+// runValidator runs the validator of an argument on a literal value. The builtins being created without their
+// dependencies, a validator relying on one of them panics, in which case the recovered value is returned so that it
+// gets reported instead of crashing the linter
+func runValidator(validator func(argumentValue starlark.Value) *startosis_errors.InterpretationError, value starlark.Value) (interpretationErr *startosis_errors.InterpretationError, validatorPanic interface{}) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			interpretationErr = nil
+			validatorPanic = recovered
+		}
+	}()
+	return validator(value), nil
+}
+
+// getKeywordArgument returns the keyword and the value of a `keyword=value` argument
+func getKeywordArgument(argExpr syntax.Expr) (*syntax.Ident, syntax.Expr, bool) {
+	binaryExpr, ok := argExpr.(*syntax.BinaryExpr)
+	if !ok || binaryExpr.Op != syntax.EQ {
+		return nil, nil, false
+	}
+	keyword, ok := binaryExpr.X.(*syntax.Ident)
+	if !ok {
+		return nil, nil, false
+	}
+	return keyword, binaryExpr.Y, true
+}
+
+// getArgumentValue returns the expression passed to the argument of the builtin with the given name, whether it's
+// passed by keyword or by position
+func getArgumentValue(builtin *kurtosis_starlark_framework.KurtosisBaseBuiltin, callExpr *syntax.CallExpr, argumentName string) (syntax.Expr, bool) {
+	positionalArgumentIdx := 0
+	for _, argExpr := range callExpr.Args {
+		if keyword, value, isKeywordArgument := getKeywordArgument(argExpr); isKeywordArgument {
+			if keyword.Name == argumentName {
+				return value, true
+			}
+			continue
+		}
+		if unaryExpr, ok := argExpr.(*syntax.UnaryExpr); ok && (unaryExpr.Op == syntax.STAR || unaryExpr.Op == syntax.STARSTAR) {
+			return nil, false
+		}
+		if positionalArgumentIdx < len(builtin.Arguments) && builtin.Arguments[positionalArgumentIdx].Name == argumentName {
+			return argExpr, true
+		}
+		positionalArgumentIdx++
+	}
+	return nil, false
+}
+
+func getArgumentDefinition(builtin *kurtosis_starlark_framework.KurtosisBaseBuiltin, argumentName string) (*builtin_argument.BuiltinArgument, bool) {
+	for _, argument := range builtin.Arguments {
+		if argument.Name == argumentName {
+			return argument, true
+		}
+	}
+	return nil, false
+}
+
+func getArgumentNames(builtin *kurtosis_starlark_framework.KurtosisBaseBuiltin) []string {
+	var argumentNames []string
+	for _, argument := range builtin.Arguments {
+		argumentNames = append(argumentNames, argument.Name)
+	}
+	return argumentNames
+}
+
+// getTypeName returns the name of a type as users write it in Starlark, e.g. 'string' or 'PortSpec'
+func getTypeName(valueType reflect.Type) string {
+	if valueType.PkgPath() == starlarkPackagePath || (valueType.Kind() == reflect.Ptr && valueType.Elem().PkgPath() == starlarkPackagePath) {
+		if zeroValue, ok := reflect.Zero(valueType).Interface().(starlark.Value); ok {
+			return zeroValue.Type()
+		}
+	}
+	if valueType.Kind() == reflect.Ptr {
+		return valueType.Elem().Name()
+	}
+	return valueType.Name()
+}
@@ -0,0 +1,49 @@
+package startosis_linter
+
+import (
+	"fmt"
+	"go.starlark.net/syntax"
+	"sort"
+)
+
+// LintFinding is a problem found by a LintRule at a given position of a Starlark file
+type LintFinding struct {
+	RuleId string
+
+	Severity LintSeverity
+
+	Filepath string
+
+	// Line and Column are 1-based
+	Line   int32
+	Column int32
+
+	Message string
+}
+
+func newLintFinding(rule *LintRule, position syntax.Position, msg string, args ...interface{}) *LintFinding {
+	return &LintFinding{
+		RuleId:   rule.Id,
+		Severity: rule.Severity,
+		Filepath: position.Filename(),
+		Line:     position.Line,
+		Column:   position.Col,
+		Message:  fmt.Sprintf(msg, args...),
+	}
+}
+
+func (finding *LintFinding) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s [%s]", finding.Filepath, finding.Line, finding.Column, finding.Severity, finding.Message, finding.RuleId)
+}
+
+func sortLintFindings(findings []*LintFinding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Filepath != findings[j].Filepath {
+			return findings[i].Filepath < findings[j].Filepath
+		}
+		if findings[i].Line != findings[j].Line {
+			return findings[i].Line < findings[j].Line
+		}
+		return findings[i].Column < findings[j].Column
+	})
+}
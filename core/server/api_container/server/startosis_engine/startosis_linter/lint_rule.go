@@ -0,0 +1,75 @@
+package startosis_linter
+
+type LintSeverity string
+
+const (
+	// the severities are named after the SARIF levels so they can be reported as is
+	LintSeverity_Error   LintSeverity = "error"
+	LintSeverity_Warning LintSeverity = "warning"
+)
+
+// LintRule is a check the linter runs on Starlark files. Every LintFinding references the rule that produced it
+type LintRule struct {
+	Id string
+
+	Severity LintSeverity
+
+	Description string
+}
+
+var (
+	SyntaxErrorRule = &LintRule{
+		Id:          "syntax-error",
+		Severity:    LintSeverity_Error,
+		Description: "The file isn't valid Starlark",
+	}
+
+	UnknownArgumentRule = &LintRule{
+		Id:          "unknown-argument",
+		Severity:    LintSeverity_Error,
+		Description: "A Kurtosis instruction or type is called with a keyword argument it doesn't declare, or with too many positional arguments",
+	}
+
+	WrongArgumentTypeRule = &LintRule{
+		Id:          "wrong-argument-type",
+		Severity:    LintSeverity_Error,
+		Description: "A literal value passed to a Kurtosis instruction or type, like a ServiceConfig or a PortSpec field, doesn't have the expected type or doesn't pass validation",
+	}
+
+	ArgumentValidationFailureRule = &LintRule{
+		Id:          "argument-validation-failure",
+		Severity:    LintSeverity_Error,
+		Description: "The validation of a literal value passed to a Kurtosis instruction or type failed unexpectedly, so the value couldn't be checked",
+	}
+
+	UnusedImportRule = &LintRule{
+		Id:          "unused-import",
+		Severity:    LintSeverity_Warning,
+		Description: "The result of import_module is never used",
+	}
+
+	UndefinedServiceRule = &LintRule{
+		Id:          "undefined-service",
+		Severity:    LintSeverity_Warning,
+		Description: "An instruction references a service that no add_service or add_services instruction of the package adds",
+	}
+
+	UnreachableMainParameterRule = &LintRule{
+		Id:          "unreachable-main-parameter",
+		Severity:    LintSeverity_Error,
+		Description: "A parameter of the main function of the package can never receive the value it's meant to",
+	}
+)
+
+// GetLintRules returns all the rules the linter runs, in the order they are documented
+func GetLintRules() []*LintRule {
+	return []*LintRule{
+		SyntaxErrorRule,
+		UnknownArgumentRule,
+		WrongArgumentTypeRule,
+		ArgumentValidationFailureRule,
+		UnusedImportRule,
+		UndefinedServiceRule,
+		UnreachableMainParameterRule,
+	}
+}
@@ -0,0 +1,115 @@
+package startosis_linter
+
+import (
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+	"math/big"
+)
+
+const (
+	trueIdentifier  = "True"
+	falseIdentifier = "False"
+	noneIdentifier  = "None"
+)
+
+// evaluateLiteral returns the Starlark value of an expression made of literals only, i.e. strings, numbers, booleans,
+// None, and the lists, tuples and dicts of those. The value of any other expression is only known at interpretation time
+func evaluateLiteral(expr syntax.Expr) (starlark.Value, bool) {
+	switch typedExpr := expr.(type) {
+	case *syntax.Literal:
+		switch value := typedExpr.Value.(type) {
+		case string:
+			if typedExpr.Token == syntax.BYTES {
+				return starlark.Bytes(value), true
+			}
+			return starlark.String(value), true
+		case int64:
+			return starlark.MakeInt64(value), true
+		case *big.Int:
+			return starlark.MakeBigInt(value), true
+		case float64:
+			return starlark.Float(value), true
+		}
+	case *syntax.Ident:
+		switch typedExpr.Name {
+		case trueIdentifier:
+			return starlark.True, true
+		case falseIdentifier:
+			return starlark.False, true
+		case noneIdentifier:
+			return starlark.None, true
+		}
+	case *syntax.ParenExpr:
+		return evaluateLiteral(typedExpr.X)
+	case *syntax.UnaryExpr:
+		if typedExpr.Op != syntax.MINUS {
+			return nil, false
+		}
+		value, isLiteral := evaluateLiteral(typedExpr.X)
+		if !isLiteral {
+			return nil, false
+		}
+		switch number := value.(type) {
+		case starlark.Int:
+			return starlark.MakeInt(0).Sub(number), true
+		case starlark.Float:
+			return -number, true
+		}
+	case *syntax.ListExpr:
+		elements, isLiteral := evaluateLiterals(typedExpr.List)
+		if !isLiteral {
+			return nil, false
+		}
+		return starlark.NewList(elements), true
+	case *syntax.TupleExpr:
+		elements, isLiteral := evaluateLiterals(typedExpr.List)
+		if !isLiteral {
+			return nil, false
+		}
+		return starlark.Tuple(elements), true
+	case *syntax.DictExpr:
+		dict := starlark.NewDict(len(typedExpr.List))
+		for _, entryExpr := range typedExpr.List {
+			entry, ok := entryExpr.(*syntax.DictEntry)
+			if !ok {
+				return nil, false
+			}
+			key, isKeyLiteral := evaluateLiteral(entry.Key)
+			value, isValueLiteral := evaluateLiteral(entry.Value)
+			if !isKeyLiteral || !isValueLiteral {
+				return nil, false
+			}
+			if err := dict.SetKey(key, value); err != nil {
+				// unhashable key, interpretation will fail on it
+				return nil, false
+			}
+		}
+		return dict, true
+	}
+	return nil, false
+}
+
+func evaluateLiterals(exprs []syntax.Expr) ([]starlark.Value, bool) {
+	values := make([]starlark.Value, len(exprs))
+	for idx, expr := range exprs {
+		value, isLiteral := evaluateLiteral(expr)
+		if !isLiteral {
+			return nil, false
+		}
+		values[idx] = value
+	}
+	return values, true
+}
+
+// evaluateStringLiteral returns the value of an expression which is a string literal
+func evaluateStringLiteral(expr syntax.Expr) (string, bool) {
+	value, isLiteral := evaluateLiteral(expr)
+	if !isLiteral {
+		return "", false
+	}
+	stringValue, ok := value.(starlark.String)
+	if !ok {
+		return "", false
+	}
+	return stringValue.GoString(), true
+}
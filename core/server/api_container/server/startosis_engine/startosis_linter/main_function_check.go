@@ -0,0 +1,71 @@
+package startosis_linter
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"go.starlark.net/syntax"
+	"path"
+)
+
+const (
+	mainFunctionName        = "run"
+	planParameterName       = planObjectName
+	planParameterIdx        = 0
+	noVariadicParameterName = ""
+)
+
+// checkMainFunction reports the parameters of the main function of a package, `run` in its main file, that can't
+// receive what they are meant to: Kurtosis only passes the plan object as the first positional argument and the package
+// arguments by keyword
+func checkMainFunction(parsedFile *syntax.File) []*LintFinding {
+	if path.Base(parsedFile.Path) != startosis_constants.MainFileName {
+		return nil
+	}
+	for _, stmt := range parsedFile.Stmts {
+		defStmt, ok := stmt.(*syntax.DefStmt)
+		if !ok || defStmt.Name.Name != mainFunctionName {
+			continue
+		}
+		return checkMainFunctionParameters(defStmt)
+	}
+	return nil
+}
+
+func checkMainFunctionParameters(mainFunction *syntax.DefStmt) []*LintFinding {
+	var findings []*LintFinding
+	for paramIdx, paramExpr := range mainFunction.Params {
+		paramName, isVariadic := getParameterName(paramExpr)
+		switch {
+		case isVariadic && paramName != noVariadicParameterName:
+			findings = append(findings, newLintFinding(UnreachableMainParameterRule, syntax.Start(paramExpr), "Parameter '*%s' of '%s' never receives any value as package arguments are only passed by keyword",
+				paramName, mainFunctionName))
+		case paramIdx == planParameterIdx && paramName != planParameterName:
+			findings = append(findings, newLintFinding(UnreachableMainParameterRule, syntax.Start(paramExpr), "The first parameter of '%s' must be named '%s' to receive the plan object, the package will fail to run with '%s'",
+				mainFunctionName, planParameterName, paramName))
+		case paramIdx != planParameterIdx && paramName == planParameterName:
+			findings = append(findings, newLintFinding(UnreachableMainParameterRule, syntax.Start(paramExpr), "Parameter '%s' of '%s' only receives the plan object when it's the first parameter",
+				planParameterName, mainFunctionName))
+		}
+	}
+	return findings
+}
+
+// getParameterName returns the name of a parameter and whether it's the variadic `*args` parameter. The bare `*`
+// separating keyword-only parameters is a variadic parameter without name. The `**kwargs` parameter isn't variadic
+// here as it receives the package arguments
+func getParameterName(paramExpr syntax.Expr) (string, bool) {
+	switch typedParamExpr := paramExpr.(type) {
+	case *syntax.Ident:
+		return typedParamExpr.Name, false
+	case *syntax.BinaryExpr:
+		if paramIdent, ok := typedParamExpr.X.(*syntax.Ident); ok {
+			return paramIdent.Name, false
+		}
+	case *syntax.UnaryExpr:
+		paramIdent, ok := typedParamExpr.X.(*syntax.Ident)
+		if !ok {
+			return noVariadicParameterName, true
+		}
+		return paramIdent.Name, typedParamExpr.Op == syntax.STAR
+	}
+	return "", false
+}
@@ -0,0 +1,84 @@
+package startosis_linter
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"go.starlark.net/syntax"
+	"sort"
+)
+
+const (
+	planObjectName = "plan"
+
+	noParseMode syntax.Mode = 0
+)
+
+// StarlarkLinter checks Starlark files statically, without interpreting them, against the definitions of the builtins
+// declared with the Kurtosis Starlark framework. As nothing is interpreted, values are only checked when they are literals
+type StarlarkLinter struct {
+	// the instructions called on the plan object, by name
+	planInstructions map[string]*kurtosis_starlark_framework.KurtosisBaseBuiltin
+
+	// the helpers and the type constructors, by name
+	globalBuiltins map[string]*kurtosis_starlark_framework.KurtosisBaseBuiltin
+}
+
+// NewStarlarkLinter creates a linter from builtin definitions, which are usually the ones returned by
+// startosis_engine.KurtosisBuiltinDefinitions
+func NewStarlarkLinter(planInstructionDefinitions []*kurtosis_starlark_framework.KurtosisBaseBuiltin, globalBuiltinDefinitions []*kurtosis_starlark_framework.KurtosisBaseBuiltin) *StarlarkLinter {
+	planInstructions := map[string]*kurtosis_starlark_framework.KurtosisBaseBuiltin{}
+	for _, planInstruction := range planInstructionDefinitions {
+		planInstructions[planInstruction.Name] = planInstruction
+	}
+	globalBuiltins := map[string]*kurtosis_starlark_framework.KurtosisBaseBuiltin{}
+	for _, globalBuiltin := range globalBuiltinDefinitions {
+		globalBuiltins[globalBuiltin.Name] = globalBuiltin
+	}
+	return &StarlarkLinter{
+		planInstructions: planInstructions,
+		globalBuiltins:   globalBuiltins,
+	}
+}
+
+// Lint checks the Starlark files, keyed by path, and returns the findings sorted by file and position. The files are
+// checked together as the files of a package, as a service added in one file can be referenced in another one
+func (linter *StarlarkLinter) Lint(starlarkFiles map[string][]byte) []*LintFinding {
+	var filepaths []string
+	for filepath := range starlarkFiles {
+		filepaths = append(filepaths, filepath)
+	}
+	sort.Strings(filepaths)
+
+	findings := []*LintFinding{}
+	var parsedFiles []*syntax.File
+	for _, filepath := range filepaths {
+		parsedFile, err := syntax.Parse(filepath, starlarkFiles[filepath], noParseMode)
+		if err != nil {
+			findings = append(findings, newSyntaxErrorFinding(filepath, err))
+			continue
+		}
+		parsedFiles = append(parsedFiles, parsedFile)
+	}
+
+	for _, parsedFile := range parsedFiles {
+		findings = append(findings, linter.checkBuiltinCalls(parsedFile)...)
+		findings = append(findings, checkMainFunction(parsedFile)...)
+	}
+	findings = append(findings, checkUnusedImports(parsedFiles)...)
+	findings = append(findings, linter.checkUndefinedServices(parsedFiles)...)
+	sortLintFindings(findings)
+	return findings
+}
+
+func newSyntaxErrorFinding(filepath string, err error) *LintFinding {
+	if syntaxErr, ok := err.(syntax.Error); ok {
+		return newLintFinding(SyntaxErrorRule, syntaxErr.Pos, "%s", syntaxErr.Msg)
+	}
+	return &LintFinding{
+		RuleId:   SyntaxErrorRule.Id,
+		Severity: SyntaxErrorRule.Severity,
+		Filepath: filepath,
+		Line:     0,
+		Column:   0,
+		Message:  err.Error(),
+	}
+}
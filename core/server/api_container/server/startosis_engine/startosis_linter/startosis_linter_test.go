@@ -0,0 +1,216 @@
+package startosis_linter
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+	"testing"
+)
+
+const (
+	mainFilepath    = "main.star"
+	libraryFilepath = "lib/lib.star"
+)
+
+func TestLint_ValidPackageHasNoFinding(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `lib = import_module("/lib/lib.star")
+
+def run(plan, image = "nginx"):
+    plan.add_service(name = "web", config = ServiceConfig(image = image, ports = {"http": PortSpec(number = 80, transport_protocol = "TCP")}))
+    plan.exec(service_name = "web", recipe = ExecRecipe(command = ["ls"]))
+    lib.configure(plan)
+`,
+		libraryFilepath: `def configure(plan):
+    plan.wait(service_name = "web", recipe = GetHttpRequestRecipe(port_id = "http", endpoint = "/"), field = "code", assertion = "==", target_value = 200)
+`,
+	})
+	require.Empty(t, findings)
+}
+
+func TestLint_UnknownKeywordArgument(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `def run(plan):
+    plan.add_service(name = "web", config = ServiceConfig(image = "nginx", portz = {}))
+`,
+	})
+	require.Len(t, findings, 1)
+	requireFinding(t, findings[0], UnknownArgumentRule, mainFilepath, 2, 76)
+	require.Contains(t, findings[0].Message, "'ServiceConfig' has no argument named 'portz'")
+}
+
+func TestLint_TooManyPositionalArguments(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `def run(plan):
+    import_module("/lib/lib.star", "/lib/other.star")
+`,
+	})
+	require.Len(t, findings, 1)
+	requireFinding(t, findings[0], UnknownArgumentRule, mainFilepath, 2, 36)
+	require.Contains(t, findings[0].Message, "'import_module' accepts at most 1 positional arguments")
+}
+
+func TestLint_WrongLiteralType(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `def run(plan):
+    PortSpec(number = "80")
+`,
+	})
+	require.Len(t, findings, 1)
+	requireFinding(t, findings[0], WrongArgumentTypeRule, mainFilepath, 2, 23)
+	require.Contains(t, findings[0].Message, "Argument 'number' of 'PortSpec' expects a value of type 'int' but got a value of type 'string'")
+}
+
+func TestLint_LiteralFailingValidation(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `def run(plan):
+    PortSpec(70000)
+`,
+	})
+	require.Len(t, findings, 1)
+	requireFinding(t, findings[0], WrongArgumentTypeRule, mainFilepath, 2, 14)
+	require.Contains(t, findings[0].Message, "Argument 'number' of 'PortSpec' is invalid")
+}
+
+func TestLint_PanickingValidatorIsReported(t *testing.T) {
+	argument := &builtin_argument.BuiltinArgument{
+		Name:              "number",
+		IsOptional:        false,
+		ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+		Validator: func(_ starlark.Value) *startosis_errors.InterpretationError {
+			panic("the validator needs a dependency the linter doesn't provide")
+		},
+	}
+	builtin := &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+		Name:      "test_builtin",
+		Arguments: []*builtin_argument.BuiltinArgument{argument},
+	}
+	valueExpr, err := syntax.ParseExpr(mainFilepath, "80", 0)
+	require.NoError(t, err)
+
+	findings := checkArgumentValue(builtin, argument, valueExpr)
+	require.Len(t, findings, 1)
+	requireFinding(t, findings[0], ArgumentValidationFailureRule, mainFilepath, 1, 1)
+	require.Contains(t, findings[0].Message, "Argument 'number' of 'test_builtin' couldn't be validated, its validator panicked: the validator needs a dependency the linter doesn't provide")
+}
+
+func TestLint_LiteralPassedForKurtosisType(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `def run(plan):
+    plan.add_service(name = "web", config = {"image": "nginx"})
+`,
+	})
+	require.Len(t, findings, 1)
+	requireFinding(t, findings[0], WrongArgumentTypeRule, mainFilepath, 2, 45)
+	require.Contains(t, findings[0].Message, "expects a value of type 'ServiceConfig' but got a value of type 'dict'")
+}
+
+func TestLint_ValuesKnownAtInterpretationTimeAreNotChecked(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `def run(plan, port):
+    PortSpec(number = port)
+    PortSpec(*[port])
+`,
+	})
+	require.Empty(t, findings)
+}
+
+func TestLint_UnusedImport(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `lib = import_module("/lib/lib.star")
+used_lib = import_module("/lib/lib.star")
+
+def run(plan):
+    helper = import_module("/lib/lib.star")
+    used_lib.configure(plan)
+`,
+		libraryFilepath: `def configure(plan):
+    pass
+`,
+	})
+	require.Len(t, findings, 2)
+	requireFinding(t, findings[0], UnusedImportRule, mainFilepath, 1, 1)
+	requireFinding(t, findings[1], UnusedImportRule, mainFilepath, 5, 5)
+}
+
+func TestLint_ImportReadByAnotherFileIsUsed(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `lib = import_module("/lib/lib.star")
+
+def run(plan):
+    lib.shared.configure(plan)
+`,
+		libraryFilepath: `shared = import_module("/lib/shared.star")
+`,
+	})
+	require.Empty(t, findings)
+}
+
+func TestLint_UndefinedService(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `def run(plan):
+    plan.add_services(configs = {"web": ServiceConfig(image = "nginx")})
+    plan.exec("web", ExecRecipe(command = ["ls"]))
+    plan.stop_service(name = "db")
+`,
+	})
+	require.Len(t, findings, 1)
+	requireFinding(t, findings[0], UndefinedServiceRule, mainFilepath, 4, 5)
+	require.Contains(t, findings[0].Message, "Service 'db' is referenced by 'stop_service'")
+}
+
+func TestLint_UndefinedServiceIsNotReportedWhenServicesAreAddedDynamically(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `def run(plan, name):
+    plan.add_service(name = name, config = ServiceConfig(image = "nginx"))
+    plan.stop_service(name = "db")
+`,
+	})
+	require.Empty(t, findings)
+}
+
+func TestLint_UnreachableMainParameters(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `def run(args, plan, *others, **kwargs):
+    pass
+`,
+		libraryFilepath: `def run(args, *others):
+    pass
+`,
+	})
+	require.Len(t, findings, 3)
+	requireFinding(t, findings[0], UnreachableMainParameterRule, mainFilepath, 1, 9)
+	requireFinding(t, findings[1], UnreachableMainParameterRule, mainFilepath, 1, 15)
+	requireFinding(t, findings[2], UnreachableMainParameterRule, mainFilepath, 1, 21)
+}
+
+func TestLint_SyntaxError(t *testing.T) {
+	findings := lintForTest(map[string]string{
+		mainFilepath: `def run(plan)
+    pass
+`,
+	})
+	require.Len(t, findings, 1)
+	requireFinding(t, findings[0], SyntaxErrorRule, mainFilepath, 2, 1)
+}
+
+func lintForTest(starlarkFiles map[string]string) []*LintFinding {
+	linter := NewStarlarkLinter(startosis_engine.KurtosisBuiltinDefinitions())
+	starlarkFilesContent := map[string][]byte{}
+	for filepath, content := range starlarkFiles {
+		starlarkFilesContent[filepath] = []byte(content)
+	}
+	return linter.Lint(starlarkFilesContent)
+}
+
+func requireFinding(t *testing.T, finding *LintFinding, rule *LintRule, filepath string, line int32, column int32) {
+	require.Equal(t, rule.Id, finding.RuleId, finding.String())
+	require.Equal(t, rule.Severity, finding.Severity)
+	require.Equal(t, filepath, finding.Filepath)
+	require.Equal(t, line, finding.Line, finding.String())
+	require.Equal(t, column, finding.Column, finding.String())
+}
@@ -0,0 +1,135 @@
+package startosis_linter
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/exec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/start_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/stop_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/store_service_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/wait"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"go.starlark.net/syntax"
+)
+
+// serviceReferencingInstructions maps the plan instructions operating on an existing service to the name of the
+// argument holding the name of that service
+var serviceReferencingInstructions = map[string]string{
+	exec.ExecBuiltinName:                             exec.ServiceNameArgName,
+	request.RequestBuiltinName:                       request.ServiceNameArgName,
+	wait.WaitBuiltinName:                             wait.ServiceNameArgName,
+	store_service_files.StoreServiceFilesBuiltinName: store_service_files.ServiceNameArgName,
+	start_service.StartServiceBuiltinName:            start_service.ServiceNameArgName,
+	stop_service.StopServiceBuiltinName:              stop_service.ServiceNameArgName,
+	remove_service.RemoveServiceBuiltinName:          remove_service.ServiceNameArgName,
+}
+
+type serviceReference struct {
+	serviceName string
+
+	instructionName string
+
+	position syntax.Position
+}
+
+// checkUndefinedServices reports the services referenced by name by a plan instruction which none of the add_service
+// and add_services instructions of the files adds.
+//
+// Service names are only known statically when they are literals: as soon as one service is added under a name
+// computed at interpretation time, any reference could be to it and nothing is reported
+func (linter *StarlarkLinter) checkUndefinedServices(parsedFiles []*syntax.File) []*LintFinding {
+	addedServices := map[string]bool{}
+	var serviceReferences []*serviceReference
+	hasServicesAddedDynamically := false
+	for _, parsedFile := range parsedFiles {
+		syntax.Walk(parsedFile, func(node syntax.Node) bool {
+			callExpr, ok := node.(*syntax.CallExpr)
+			if !ok {
+				return true
+			}
+			instruction, found := linter.getCalledPlanInstruction(callExpr)
+			if !found {
+				return true
+			}
+			switch instruction.Name {
+			case add_service.AddServiceBuiltinName:
+				serviceName, isLiteral := getStringLiteralArgumentValue(instruction, callExpr, add_service.ServiceNameArgName)
+				if !isLiteral {
+					hasServicesAddedDynamically = true
+					return true
+				}
+				addedServices[serviceName] = true
+			case add_service.AddServicesBuiltinName:
+				serviceNames, isLiteral := getAddedServicesNames(instruction, callExpr)
+				if !isLiteral {
+					hasServicesAddedDynamically = true
+					return true
+				}
+				for _, serviceName := range serviceNames {
+					addedServices[serviceName] = true
+				}
+			default:
+				serviceNameArgName, isReferencingService := serviceReferencingInstructions[instruction.Name]
+				if !isReferencingService {
+					return true
+				}
+				if serviceName, isLiteral := getStringLiteralArgumentValue(instruction, callExpr, serviceNameArgName); isLiteral {
+					serviceReferences = append(serviceReferences, &serviceReference{
+						serviceName:     serviceName,
+						instructionName: instruction.Name,
+						position:        syntax.Start(callExpr),
+					})
+				}
+			}
+			return true
+		})
+	}
+	if hasServicesAddedDynamically {
+		return nil
+	}
+
+	var findings []*LintFinding
+	for _, reference := range serviceReferences {
+		if addedServices[reference.serviceName] {
+			continue
+		}
+		findings = append(findings, newLintFinding(UndefinedServiceRule, reference.position, "Service '%s' is referenced by '%s' but isn't added by any '%s' or '%s' instruction",
+			reference.serviceName, reference.instructionName, add_service.AddServiceBuiltinName, add_service.AddServicesBuiltinName))
+	}
+	return findings
+}
+
+// getAddedServicesNames returns the names of the services added by an add_services instruction, which are the keys
+// of its configs argument
+func getAddedServicesNames(instruction *kurtosis_starlark_framework.KurtosisBaseBuiltin, callExpr *syntax.CallExpr) ([]string, bool) {
+	configsExpr, found := getArgumentValue(instruction, callExpr, add_service.ConfigsArgName)
+	if !found {
+		return nil, false
+	}
+	configsDictExpr, ok := configsExpr.(*syntax.DictExpr)
+	if !ok {
+		return nil, false
+	}
+	var serviceNames []string
+	for _, entryExpr := range configsDictExpr.List {
+		entry, ok := entryExpr.(*syntax.DictEntry)
+		if !ok {
+			return nil, false
+		}
+		serviceName, isLiteral := evaluateStringLiteral(entry.Key)
+		if !isLiteral {
+			return nil, false
+		}
+		serviceNames = append(serviceNames, serviceName)
+	}
+	return serviceNames, true
+}
+
+func getStringLiteralArgumentValue(instruction *kurtosis_starlark_framework.KurtosisBaseBuiltin, callExpr *syntax.CallExpr, argumentName string) (string, bool) {
+	valueExpr, found := getArgumentValue(instruction, callExpr, argumentName)
+	if !found {
+		return "", false
+	}
+	return evaluateStringLiteral(valueExpr)
+}
@@ -0,0 +1,106 @@
+package startosis_linter
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/import_module"
+	"go.starlark.net/syntax"
+)
+
+type importedModule struct {
+	variable *syntax.Ident
+
+	isGlobal bool
+}
+
+// checkUnusedImports reports the variables holding the result of import_module that are never read.
+//
+// As a module can access the globals of the modules it imports, a global variable is considered used as soon as an
+// attribute with the same name is accessed in any of the files
+func checkUnusedImports(parsedFiles []*syntax.File) []*LintFinding {
+	accessedAttributes := map[string]bool{}
+	for _, parsedFile := range parsedFiles {
+		syntax.Walk(parsedFile, func(node syntax.Node) bool {
+			if dotExpr, ok := node.(*syntax.DotExpr); ok {
+				accessedAttributes[dotExpr.Name.Name] = true
+			}
+			return true
+		})
+	}
+
+	var findings []*LintFinding
+	for _, parsedFile := range parsedFiles {
+		readVariables := map[string]bool{}
+		for _, stmt := range parsedFile.Stmts {
+			collectReadVariables(stmt, readVariables)
+		}
+		for _, module := range getImportedModules(parsedFile) {
+			variableName := module.variable.Name
+			if readVariables[variableName] || (module.isGlobal && accessedAttributes[variableName]) {
+				continue
+			}
+			findings = append(findings, newLintFinding(UnusedImportRule, module.variable.NamePos, "Module imported as '%s' is never used", variableName))
+		}
+	}
+	return findings
+}
+
+// getImportedModules returns the `variable = import_module(...)` assignments of the file
+func getImportedModules(parsedFile *syntax.File) []*importedModule {
+	var importedModules []*importedModule
+	globalStmts := map[syntax.Stmt]bool{}
+	for _, stmt := range parsedFile.Stmts {
+		globalStmts[stmt] = true
+	}
+	syntax.Walk(parsedFile, func(node syntax.Node) bool {
+		assignStmt, ok := node.(*syntax.AssignStmt)
+		if !ok || assignStmt.Op != syntax.EQ {
+			return true
+		}
+		variable, ok := assignStmt.LHS.(*syntax.Ident)
+		if !ok {
+			return true
+		}
+		callExpr, ok := assignStmt.RHS.(*syntax.CallExpr)
+		if !ok {
+			return true
+		}
+		if fn, ok := callExpr.Fn.(*syntax.Ident); ok && fn.Name == import_module.ImportModuleBuiltinName {
+			importedModules = append(importedModules, &importedModule{
+				variable: variable,
+				isGlobal: globalStmts[assignStmt],
+			})
+		}
+		return true
+	})
+	return importedModules
+}
+
+// collectReadVariables collects the identifiers read by the node. Identifiers that are only assigned, the attribute
+// names of dot expressions and the keywords of keyword arguments aren't reads
+func collectReadVariables(node syntax.Node, readVariables map[string]bool) {
+	syntax.Walk(node, func(node syntax.Node) bool {
+		switch typedNode := node.(type) {
+		case *syntax.Ident:
+			readVariables[typedNode.Name] = true
+		case *syntax.AssignStmt:
+			if _, isVariable := typedNode.LHS.(*syntax.Ident); !isVariable || typedNode.Op != syntax.EQ {
+				collectReadVariables(typedNode.LHS, readVariables)
+			}
+			collectReadVariables(typedNode.RHS, readVariables)
+			return false
+		case *syntax.DotExpr:
+			collectReadVariables(typedNode.X, readVariables)
+			return false
+		case *syntax.CallExpr:
+			collectReadVariables(typedNode.Fn, readVariables)
+			for _, argExpr := range typedNode.Args {
+				if _, value, isKeywordArgument := getKeywordArgument(argExpr); isKeywordArgument {
+					collectReadVariables(value, readVariables)
+				} else {
+					collectReadVariables(argExpr, readVariables)
+				}
+			}
+			return false
+		}
+		return true
+	})
+}
@@ -0,0 +1,89 @@
+package startosis_packages
+
+import "fmt"
+
+const (
+	defaultHttpsTokenUsername = "git"
+
+	httpsTokenCredentialType = "HTTPS token"
+	sshKeyCredentialType     = "SSH key"
+)
+
+// GitCredential holds the credential used to clone private Git repositories hosted on a given host. It is either an
+// HTTPS token or an SSH private key
+type GitCredential struct {
+	host string
+
+	httpsUsername string
+	httpsToken    string
+
+	sshPrivateKey           []byte
+	sshPrivateKeyPassphrase string
+	sshKnownHosts           []byte
+}
+
+func NewHttpsTokenGitCredential(host string, username string, token string) *GitCredential {
+	if username == "" {
+		username = defaultHttpsTokenUsername
+	}
+	return &GitCredential{
+		host:                    host,
+		httpsUsername:           username,
+		httpsToken:              token,
+		sshPrivateKey:           nil,
+		sshPrivateKeyPassphrase: "",
+		sshKnownHosts:           nil,
+	}
+}
+
+func NewSshKeyGitCredential(host string, privateKey []byte, passphrase string, knownHosts []byte) *GitCredential {
+	return &GitCredential{
+		host:                    host,
+		httpsUsername:           "",
+		httpsToken:              "",
+		sshPrivateKey:           privateKey,
+		sshPrivateKeyPassphrase: passphrase,
+		sshKnownHosts:           knownHosts,
+	}
+}
+
+func (credential *GitCredential) GetHost() string {
+	return credential.host
+}
+
+func (credential *GitCredential) IsSshKey() bool {
+	return credential.sshPrivateKey != nil
+}
+
+func (credential *GitCredential) GetHttpsUsername() string {
+	return credential.httpsUsername
+}
+
+func (credential *GitCredential) GetHttpsToken() string {
+	return credential.httpsToken
+}
+
+func (credential *GitCredential) GetSshPrivateKey() []byte {
+	return credential.sshPrivateKey
+}
+
+func (credential *GitCredential) GetSshPrivateKeyPassphrase() string {
+	return credential.sshPrivateKeyPassphrase
+}
+
+func (credential *GitCredential) GetSshKnownHosts() []byte {
+	return credential.sshKnownHosts
+}
+
+// GetType returns a human-readable name of the kind of credential, for use in error messages
+func (credential *GitCredential) GetType() string {
+	if credential.IsSshKey() {
+		return sshKeyCredentialType
+	}
+	return httpsTokenCredentialType
+}
+
+// String never prints the secret part of the credential, so that it can't leak into the logs
+func (credential *GitCredential) String() string {
+	return fmt.Sprintf("GitCredential{host: %s, type: %s}", credential.host, credential.GetType())
+}
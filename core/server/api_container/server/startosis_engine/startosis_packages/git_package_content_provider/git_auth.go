@@ -0,0 +1,110 @@
+package git_package_content_provider
+
+import (
+	"errors"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/stacktrace"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	sshScheme             = "ssh"
+	sshGitUser            = "git"
+	knownHostsFilename    = "known_hosts"
+	knownHostsPermissions = 0600
+
+	gitAuthDocLink = "https://docs.kurtosis.com/git-auth"
+)
+
+// getCloneUrlAndAuth returns the URL to clone the repository from, and the auth method to use, given the credential
+// configured for the repository host. Without credential, the repository is cloned anonymously over HTTPS. With an SSH
+// key credential, the HTTPS URL is rewritten to its SSH equivalent and the host key is verified against the
+// credential's known hosts, which get written to 'knownHostsDirPath'
+func getCloneUrlAndAuth(gitUrl string, credential *startosis_packages.GitCredential, knownHostsDirPath string) (string, transport.AuthMethod, error) {
+	if credential == nil {
+		return gitUrl, nil, nil
+	}
+	if !credential.IsSshKey() {
+		return gitUrl, &http.BasicAuth{
+			Username: credential.GetHttpsUsername(),
+			Password: credential.GetHttpsToken(),
+		}, nil
+	}
+
+	sshUrl, err := getSshUrlFromHttpsUrl(gitUrl)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred converting Git URL '%s' to an SSH URL", gitUrl)
+	}
+	publicKeys, err := ssh.NewPublicKeys(sshGitUser, credential.GetSshPrivateKey(), credential.GetSshPrivateKeyPassphrase())
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred parsing the SSH private key configured for host '%s'", credential.GetHost())
+	}
+	if len(credential.GetSshKnownHosts()) == 0 {
+		return "", nil, stacktrace.NewError("No known hosts were configured alongside the SSH key for host '%s', so its host key can't be verified", credential.GetHost())
+	}
+	knownHostsFilePath := path.Join(knownHostsDirPath, knownHostsFilename)
+	if err = os.WriteFile(knownHostsFilePath, credential.GetSshKnownHosts(), knownHostsPermissions); err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred writing the known hosts for host '%s' to '%s'", credential.GetHost(), knownHostsFilePath)
+	}
+	hostKeyCallback, err := ssh.NewKnownHostsCallback(knownHostsFilePath)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred parsing the known hosts configured for host '%s'", credential.GetHost())
+	}
+	publicKeys.HostKeyCallback = hostKeyCallback
+	return sshUrl, publicKeys, nil
+}
+
+// getSshUrlFromHttpsUrl turns 'https://github.com/author/repo.git' into 'ssh://git@github.com/author/repo.git'
+func getSshUrlFromHttpsUrl(gitUrl string) (string, error) {
+	parsedUrl, err := url.Parse(gitUrl)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing Git URL '%s'", gitUrl)
+	}
+	sshUrl := &url.URL{ //nolint:exhaustruct
+		Scheme: sshScheme,
+		User:   url.User(sshGitUser),
+		Host:   parsedUrl.Hostname(),
+		Path:   parsedUrl.Path,
+	}
+	return sshUrl.String(), nil
+}
+
+// getGitHost returns the host of a Git URL, which is what credentials are keyed by
+func getGitHost(gitUrl string) string {
+	parsedUrl, err := url.Parse(gitUrl)
+	if err != nil {
+		return ""
+	}
+	return parsedUrl.Host
+}
+
+// newCloneInterpretationError builds an error explaining why the clone failed, pointing the user to the credential
+// configuration when the repository could not be accessed anonymously or with the configured credential
+func newCloneInterpretationError(gitUrl string, credential *startosis_packages.GitCredential, cloneErr error) *startosis_errors.InterpretationError {
+	isAuthErr := isGitAuthError(cloneErr)
+	if credential == nil {
+		if isAuthErr {
+			return startosis_errors.NewInterpretationError("Error in cloning git repository '%s': the repository either doesn't exist or is private. To clone private repositories, configure a credential for host '%s' with 'kurtosis git-auth add'. See %s", gitUrl, getGitHost(gitUrl), gitAuthDocLink)
+		}
+		return startosis_errors.NewInterpretationError("Error in cloning git repository '%s'. Make sure that '%v' exists and is reachable.", gitUrl, gitUrl)
+	}
+	if isAuthErr {
+		return startosis_errors.NewInterpretationError("Error in cloning git repository '%s': authentication failed using the %s configured for host '%s'. Make sure the repository exists and that the credential is valid and has read access to it.", gitUrl, credential.GetType(), credential.GetHost())
+	}
+	return startosis_errors.WrapWithInterpretationError(cloneErr, "Error in cloning git repository '%s' using the %s configured for host '%s'.", gitUrl, credential.GetType(), credential.GetHost())
+}
+
+func isGitAuthError(err error) bool {
+	if errors.Is(err, transport.ErrAuthenticationRequired) || errors.Is(err, transport.ErrAuthorizationFailed) || errors.Is(err, transport.ErrRepositoryNotFound) {
+		return true
+	}
+	// the SSH transport doesn't wrap authentication failures into a transport error
+	return strings.Contains(err.Error(), "unable to authenticate")
+}
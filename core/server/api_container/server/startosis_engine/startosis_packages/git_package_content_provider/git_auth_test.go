@@ -0,0 +1,190 @@
+package git_package_content_provider
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"io"
+	nethttp "net/http"
+	"net/http/cgi"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path"
+	"testing"
+)
+
+const (
+	testGitHost        = "github.com"
+	testGitUrl         = "https://github.com/kurtosis-tech/private-package.git"
+	testSshGitUrl      = "ssh://git@github.com/kurtosis-tech/private-package.git"
+	testHttpsUsername  = "kurtosis-bot"
+	testHttpsToken     = "secret-token"
+	testWrongToken     = "wrong-token"
+	testRepoRelPath    = "kurtosis-tech/private-package.git"
+	testRepoStarFile   = "main.star"
+	testRepoStarString = "def run(plan):\n    pass\n"
+)
+
+func TestGetCloneUrlAndAuth_NoCredentialClonesAnonymously(t *testing.T) {
+	cloneUrl, auth, err := getCloneUrlAndAuth(testGitUrl, nil, t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, testGitUrl, cloneUrl)
+	require.Nil(t, auth)
+}
+
+func TestGetCloneUrlAndAuth_HttpsToken(t *testing.T) {
+	credential := startosis_packages.NewHttpsTokenGitCredential(testGitHost, testHttpsUsername, testHttpsToken)
+	cloneUrl, auth, err := getCloneUrlAndAuth(testGitUrl, credential, t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, testGitUrl, cloneUrl)
+	require.Equal(t, &http.BasicAuth{Username: testHttpsUsername, Password: testHttpsToken}, auth)
+}
+
+func TestGetCloneUrlAndAuth_HttpsTokenWithoutUsernameUsesDefault(t *testing.T) {
+	credential := startosis_packages.NewHttpsTokenGitCredential(testGitHost, "", testHttpsToken)
+	_, auth, err := getCloneUrlAndAuth(testGitUrl, credential, t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, &http.BasicAuth{Username: "git", Password: testHttpsToken}, auth)
+}
+
+func TestGetCloneUrlAndAuth_SshKey(t *testing.T) {
+	privateKey, knownHosts := generateSshKeyAndKnownHostsForTest(t)
+	credential := startosis_packages.NewSshKeyGitCredential(testGitHost, privateKey, "", knownHosts)
+	cloneUrl, auth, err := getCloneUrlAndAuth(testGitUrl, credential, t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, testSshGitUrl, cloneUrl)
+	publicKeys, ok := auth.(*gitssh.PublicKeys)
+	require.True(t, ok)
+	require.Equal(t, sshGitUser, publicKeys.User)
+	require.NotNil(t, publicKeys.HostKeyCallback)
+}
+
+func TestGetCloneUrlAndAuth_SshKeyWithoutKnownHostsFails(t *testing.T) {
+	privateKey, _ := generateSshKeyAndKnownHostsForTest(t)
+	credential := startosis_packages.NewSshKeyGitCredential(testGitHost, privateKey, "", nil)
+	_, _, err := getCloneUrlAndAuth(testGitUrl, credential, t.TempDir())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "No known hosts were configured")
+}
+
+func TestGetCloneUrlAndAuth_InvalidSshKeyFails(t *testing.T) {
+	_, knownHosts := generateSshKeyAndKnownHostsForTest(t)
+	credential := startosis_packages.NewSshKeyGitCredential(testGitHost, []byte("not-a-key"), "", knownHosts)
+	_, _, err := getCloneUrlAndAuth(testGitUrl, credential, t.TempDir())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "parsing the SSH private key")
+}
+
+func TestGitCredential_StringDoesNotLeakSecret(t *testing.T) {
+	credential := startosis_packages.NewHttpsTokenGitCredential(testGitHost, testHttpsUsername, testHttpsToken)
+	require.NotContains(t, credential.String(), testHttpsToken)
+}
+
+func TestClone_LocalGitServerWithHttpsToken(t *testing.T) {
+	serverUrl := startLocalGitServerForTest(t, testHttpsUsername, testHttpsToken)
+	gitUrl := serverUrl + "/" + testRepoRelPath
+	host := getGitHost(gitUrl)
+
+	// anonymous clone is rejected, and the error points to the credential configuration
+	err := cloneForTest(t, gitUrl, nil)
+	require.Error(t, err)
+	interpretationErr := newCloneInterpretationError(gitUrl, nil, err)
+	require.Contains(t, interpretationErr.Error(), "either doesn't exist or is private")
+	require.Contains(t, interpretationErr.Error(), host)
+
+	// wrong token is rejected
+	wrongCredential := startosis_packages.NewHttpsTokenGitCredential(host, testHttpsUsername, testWrongToken)
+	err = cloneForTest(t, gitUrl, wrongCredential)
+	require.Error(t, err)
+	interpretationErr = newCloneInterpretationError(gitUrl, wrongCredential, err)
+	require.Contains(t, interpretationErr.Error(), "authentication failed using the HTTPS token configured for host")
+	require.NotContains(t, interpretationErr.Error(), testWrongToken)
+
+	// valid token clones the repository
+	validCredential := startosis_packages.NewHttpsTokenGitCredential(host, testHttpsUsername, testHttpsToken)
+	require.NoError(t, cloneForTest(t, gitUrl, validCredential))
+}
+
+func cloneForTest(t *testing.T, gitUrl string, credential *startosis_packages.GitCredential) error {
+	cloneDir := t.TempDir()
+	cloneUrl, auth, err := getCloneUrlAndAuth(gitUrl, credential, cloneDir)
+	require.NoError(t, err)
+	_, err = git.PlainClone(path.Join(cloneDir, "repo"), isNotBareClone, &git.CloneOptions{ //nolint:exhaustruct
+		URL:      cloneUrl,
+		Auth:     auth,
+		Depth:    defaultDepth,
+		Progress: io.Discard,
+	})
+	if err != nil {
+		return err
+	}
+	contents, err := os.ReadFile(path.Join(cloneDir, "repo", testRepoStarFile))
+	require.NoError(t, err)
+	require.Equal(t, testRepoStarString, string(contents))
+	return nil
+}
+
+// startLocalGitServerForTest serves a repository over the Git smart HTTP protocol using 'git http-backend', only
+// allowing requests authenticated with the given username and token. It stands in for a private GitHub repository
+func startLocalGitServerForTest(t *testing.T, username string, token string) string {
+	gitBinaryPath, err := exec.LookPath("git")
+	if err != nil {
+		t.Skip("git binary is required to run a local git server")
+	}
+
+	projectRoot := t.TempDir()
+	workDir := t.TempDir()
+	runGitForTest(t, gitBinaryPath, workDir, "init", "--quiet", "--initial-branch=main")
+	require.NoError(t, os.WriteFile(path.Join(workDir, testRepoStarFile), []byte(testRepoStarString), 0644))
+	runGitForTest(t, gitBinaryPath, workDir, "add", testRepoStarFile)
+	runGitForTest(t, gitBinaryPath, workDir, "-c", "user.name=test", "-c", "user.email=test@kurtosis.com", "commit", "--quiet", "-m", "init")
+	runGitForTest(t, gitBinaryPath, workDir, "clone", "--quiet", "--bare", workDir, path.Join(projectRoot, testRepoRelPath))
+
+	gitHttpBackend := &cgi.Handler{ //nolint:exhaustruct
+		Path: gitBinaryPath,
+		Args: []string{"http-backend"},
+		Env:  []string{"GIT_PROJECT_ROOT=" + projectRoot, "GIT_HTTP_EXPORT_ALL=1"},
+	}
+	server := httptest.NewServer(nethttp.HandlerFunc(func(writer nethttp.ResponseWriter, request *nethttp.Request) {
+		requestUsername, requestToken, ok := request.BasicAuth()
+		if !ok {
+			writer.Header().Set("WWW-Authenticate", `Basic realm="git"`)
+			writer.WriteHeader(nethttp.StatusUnauthorized)
+			return
+		}
+		if requestUsername != username || requestToken != token {
+			writer.WriteHeader(nethttp.StatusForbidden)
+			return
+		}
+		gitHttpBackend.ServeHTTP(writer, request)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func runGitForTest(t *testing.T, gitBinaryPath string, dir string, args ...string) {
+	cmd := exec.Command(gitBinaryPath, args...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v failed with output:\n%s", args, output)
+}
+
+func generateSshKeyAndKnownHostsForTest(t *testing.T) ([]byte, []byte) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pemBlock, err := ssh.MarshalPrivateKey(privateKey, "")
+	require.NoError(t, err)
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	require.NoError(t, err)
+	knownHostsLine := knownhosts.Line([]string{testGitHost}, sshPublicKey)
+	return pem.EncodeToMemory(pemBlock), []byte(knownHostsLine + "\n")
+}
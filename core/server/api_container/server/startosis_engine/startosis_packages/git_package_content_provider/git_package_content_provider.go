@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
 	"github.com/mholt/archiver"
 	"github.com/sirupsen/logrus"
@@ -15,6 +16,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 )
 
 const (
@@ -47,14 +49,69 @@ type GitPackageContentProvider struct {
 	packagesTmpDir                  string
 	packagesDir                     string
 	packageReplaceOptionsRepository *packageReplaceOptionsRepository
+
+	// gitCredentials are keyed by Git host. They're never persisted, as they're provided again on every run
+	gitCredentials      map[string]*startosis_packages.GitCredential
+	gitCredentialsMutex *sync.RWMutex
+
+	// The package lock of the package being run, and the commit every package it imported resolved to, keyed by
+	// repository. They're set again on every run
+	rootPackageRepository            string
+	lockedPackageDependencies        map[string]string
+	allowUnlockedPackageDependencies bool
+	resolvedPackageDependencies      map[string]string
+	packageLockMutex                 *sync.Mutex
 }
 
 func NewGitPackageContentProvider(moduleDir string, tmpDir string, enclaveDb *enclave_db.EnclaveDB) *GitPackageContentProvider {
 	return &GitPackageContentProvider{
-		packagesDir:                     moduleDir,
-		packagesTmpDir:                  tmpDir,
-		packageReplaceOptionsRepository: newPackageReplaceOptionsRepository(enclaveDb),
+		packagesDir:                      moduleDir,
+		packagesTmpDir:                   tmpDir,
+		packageReplaceOptionsRepository:  newPackageReplaceOptionsRepository(enclaveDb),
+		gitCredentials:                   map[string]*startosis_packages.GitCredential{},
+		gitCredentialsMutex:              &sync.RWMutex{},
+		rootPackageRepository:            noRootPackageRepository,
+		lockedPackageDependencies:        nil,
+		allowUnlockedPackageDependencies: false,
+		resolvedPackageDependencies:      map[string]string{},
+		packageLockMutex:                 &sync.Mutex{},
+	}
+}
+
+func (provider *GitPackageContentProvider) SetGitCredentials(gitCredentials []*startosis_packages.GitCredential) {
+	provider.gitCredentialsMutex.Lock()
+	defer provider.gitCredentialsMutex.Unlock()
+
+	provider.gitCredentials = map[string]*startosis_packages.GitCredential{}
+	for _, gitCredential := range gitCredentials {
+		provider.gitCredentials[gitCredential.GetHost()] = gitCredential
+	}
+}
+
+func (provider *GitPackageContentProvider) SetPackageLock(rootPackageId string, lockedPackageDependencies map[string]string, allowUnlockedPackageDependencies bool) {
+	provider.packageLockMutex.Lock()
+	defer provider.packageLockMutex.Unlock()
+
+	provider.rootPackageRepository = noRootPackageRepository
+	if parsedRootPackageId, err := shared_utils.ParseGitURL(rootPackageId); err == nil {
+		provider.rootPackageRepository = getPackageRepository(parsedRootPackageId)
+	}
+	provider.lockedPackageDependencies = lockedPackageDependencies
+	provider.allowUnlockedPackageDependencies = allowUnlockedPackageDependencies
+	provider.resolvedPackageDependencies = map[string]string{}
+}
+
+func (provider *GitPackageContentProvider) GetResolvedPackageDependencies() map[string]string {
+	provider.packageLockMutex.Lock()
+	defer provider.packageLockMutex.Unlock()
+
+	resolvedPackageDependencies := map[string]string{}
+	for repository, commit := range provider.resolvedPackageDependencies {
+		if commit != notAGitRepositoryCommit {
+			resolvedPackageDependencies[repository] = commit
+		}
 	}
+	return resolvedPackageDependencies
 }
 
 func (provider *GitPackageContentProvider) ClonePackage(packageId string) (string, *startosis_errors.InterpretationError) {
@@ -63,7 +120,7 @@ func (provider *GitPackageContentProvider) ClonePackage(packageId string) (strin
 		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing Git URL for package ID '%s'", packageId)
 	}
 
-	interpretationError := provider.atomicClone(parsedURL)
+	interpretationError := provider.atomicClone(parsedURL, noLockedCommit)
 	if interpretationError != nil {
 		return "", interpretationError
 	}
@@ -100,6 +157,13 @@ func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(absoluteFil
 	pathToFileOnDisk := path.Join(provider.packagesDir, parsedURL.GetRelativeFilePath())
 	packagePath := path.Join(provider.packagesDir, parsedURL.GetRelativeRepoPath())
 
+	// If the repo was already cloned, make sure it's at the commit of the package lock before using it
+	if _, err := os.Stat(packagePath); err == nil {
+		if interpretationError := provider.resolvePackageDependency(parsedURL); interpretationError != nil {
+			return "", interpretationError
+		}
+	}
+
 	// Return the file path straight if it exists
 	if _, err := os.Stat(pathToFileOnDisk); err == nil {
 		return pathToFileOnDisk, nil
@@ -113,7 +177,7 @@ func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(absoluteFil
 	}
 
 	// Otherwise clone the repo and return the absolute path of the requested file
-	interpretationError := provider.atomicClone(parsedURL)
+	interpretationError := provider.atomicClone(parsedURL, provider.getLockedCommit(parsedURL))
 	if interpretationError != nil {
 		return "", interpretationError
 	}
@@ -132,6 +196,10 @@ func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(absoluteFil
 		return "", interpretationError
 	}
 
+	if interpretationError = provider.resolvePackageDependency(parsedURL); interpretationError != nil {
+		return "", interpretationError
+	}
+
 	return pathToFileOnDisk, nil
 }
 
@@ -282,8 +350,8 @@ func (provider *GitPackageContentProvider) CloneReplacedPackagesIfNeeded(current
 }
 
 // atomicClone This first clones to a temporary directory and then moves it
-// TODO make this support versioning via tags, commit hashes or branches
-func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.ParsedGitURL) *startosis_errors.InterpretationError {
+// The locked commit, if any, is checked out when the URL doesn't specify a version
+func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.ParsedGitURL, lockedCommit string) *startosis_errors.InterpretationError {
 	// First we clone into a temporary directory
 	tempRepoDirPath, err := os.MkdirTemp(provider.packagesTmpDir, temporaryRepoDirPattern)
 	if err != nil {
@@ -292,14 +360,25 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.P
 	defer os.RemoveAll(tempRepoDirPath)
 	gitClonePath := path.Join(tempRepoDirPath, parsedURL.GetRelativeRepoPath())
 
+	tagBranchOrCommit := parsedURL.GetTagBranchOrCommit()
+	if tagBranchOrCommit == emptyTagBranchOrCommit {
+		tagBranchOrCommit = lockedCommit
+	}
+
 	depth := defaultDepth
-	if parsedURL.GetTagBranchOrCommit() != emptyTagBranchOrCommit {
+	if tagBranchOrCommit != emptyTagBranchOrCommit {
 		depth = depthAssumingBranchTagsCommitsAreSpecified
 	}
 
+	gitCredential := provider.getGitCredential(parsedURL.GetGitURL())
+	cloneUrl, auth, err := getCloneUrlAndAuth(parsedURL.GetGitURL(), gitCredential, tempRepoDirPath)
+	if err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "Cloning the module '%s' failed. Error setting up the %s configured for host '%s'", parsedURL.GetGitURL(), gitCredential.GetType(), gitCredential.GetHost())
+	}
+
 	repo, err := git.PlainClone(gitClonePath, isNotBareClone, &git.CloneOptions{
-		URL:               parsedURL.GetGitURL(),
-		Auth:              nil,
+		URL:               cloneUrl,
+		Auth:              auth,
 		RemoteName:        "",
 		ReferenceName:     "",
 		SingleBranch:      false,
@@ -312,15 +391,14 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.P
 		CABundle:          nil,
 	})
 	if err != nil {
-		// TODO remove public repository from error after we support private repositories
 		// We silent the underlying error here as it can be confusing to the user. For example, when there's a typo in
 		// the repo name, pointing to a non existing repo, the underlying error is: "authentication required"
 		logrus.Errorf("Error cloning git repository: '%s' to '%s'. Error was: \n%s", parsedURL.GetGitURL(), gitClonePath, err.Error())
-		return startosis_errors.NewInterpretationError("Error in cloning git repository '%s' to '%s'. Make sure that '%v' exists and is a public repository.", parsedURL.GetGitURL(), gitClonePath, parsedURL.GetGitURL())
+		return newCloneInterpretationError(parsedURL.GetGitURL(), gitCredential, err)
 	}
 
-	if parsedURL.GetTagBranchOrCommit() != emptyTagBranchOrCommit {
-		referenceTagOrBranch, found, referenceErr := getReferenceName(repo, parsedURL)
+	if tagBranchOrCommit != emptyTagBranchOrCommit {
+		referenceTagOrBranch, found, referenceErr := getReferenceName(repo, parsedURL.GetGitURL(), tagBranchOrCommit)
 		if err != nil {
 			return referenceErr
 		}
@@ -336,11 +414,11 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.P
 			// if we have a tag or branch we set it
 			checkoutOptions.Branch = referenceTagOrBranch
 		} else {
-			maybeHash := plumbing.NewHash(parsedURL.GetTagBranchOrCommit())
+			maybeHash := plumbing.NewHash(tagBranchOrCommit)
 			// check whether the hash is a valid hash
 			_, err = repo.CommitObject(maybeHash)
 			if err != nil {
-				return startosis_errors.NewInterpretationError("Tried using the passed version '%v' as commit as we couldn't find a tag/branch for it in the repo '%v' but failed", parsedURL.GetTagBranchOrCommit(), parsedURL.GetGitURL())
+				return startosis_errors.NewInterpretationError("Tried using the passed version '%v' as commit as we couldn't find a tag/branch for it in the repo '%v' but failed", tagBranchOrCommit, parsedURL.GetGitURL())
 			}
 			checkoutOptions.Hash = maybeHash
 		}
@@ -351,7 +429,7 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.P
 		}
 
 		if err := workTree.Checkout(checkoutOptions); err != nil {
-			return startosis_errors.NewInterpretationError("Tried checking out '%v' on repository '%v' but failed", parsedURL.GetTagBranchOrCommit(), parsedURL.GetGitURL())
+			return startosis_errors.NewInterpretationError("Tried checking out '%v' on repository '%v' but failed", tagBranchOrCommit, parsedURL.GetGitURL())
 		}
 	}
 
@@ -379,6 +457,18 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.P
 	return nil
 }
 
+// getGitCredential returns the credential configured for the host of the Git URL, or nil if there's none
+func (provider *GitPackageContentProvider) getGitCredential(gitUrl string) *startosis_packages.GitCredential {
+	provider.gitCredentialsMutex.RLock()
+	defer provider.gitCredentialsMutex.RUnlock()
+
+	gitCredential, found := provider.gitCredentials[getGitHost(gitUrl)]
+	if !found {
+		return nil
+	}
+	return gitCredential
+}
+
 // methods checks whether the root of the package is same as repository root
 // or it is a sub-folder under it
 func getPathToPackageRoot(parsedPackagePath *shared_utils.ParsedGitURL) string {
@@ -389,24 +479,24 @@ func getPathToPackageRoot(parsedPackagePath *shared_utils.ParsedGitURL) string {
 	return packagePathOnDisk
 }
 
-func getReferenceName(repo *git.Repository, parsedURL *shared_utils.ParsedGitURL) (plumbing.ReferenceName, bool, *startosis_errors.InterpretationError) {
-	tag, err := repo.Tag(parsedURL.GetTagBranchOrCommit())
+func getReferenceName(repo *git.Repository, gitUrl string, tagBranchOrCommit string) (plumbing.ReferenceName, bool, *startosis_errors.InterpretationError) {
+	tag, err := repo.Tag(tagBranchOrCommit)
 	if err == nil {
 		return tag.Name(), true, nil
 	}
 	if err != nil && err != git.ErrTagNotFound {
-		return "", false, startosis_errors.NewInterpretationError("An error occurred while checking whether '%v' is a tag and exists in '%v'", parsedURL.GetTagBranchOrCommit(), parsedURL.GetGitURL())
+		return "", false, startosis_errors.NewInterpretationError("An error occurred while checking whether '%v' is a tag and exists in '%v'", tagBranchOrCommit, gitUrl)
 	}
 
 	// for branches there is repo.Branch() but that doesn't work with remote branches
 	refs, err := repo.References()
 	if err != nil {
-		return "", false, startosis_errors.NewInterpretationError("An error occurred while fetching references for repository '%v'", parsedURL.GetGitURL())
+		return "", false, startosis_errors.NewInterpretationError("An error occurred while fetching references for repository '%v'", gitUrl)
 	}
 
 	var branchReference *plumbing.Reference
 	err = refs.ForEach(func(r *plumbing.Reference) error {
-		if r.Name() == plumbing.NewRemoteReferenceName(git.DefaultRemoteName, parsedURL.GetTagBranchOrCommit()) {
+		if r.Name() == plumbing.NewRemoteReferenceName(git.DefaultRemoteName, tagBranchOrCommit) {
 			branchReference = r
 			return nil
 		}
@@ -415,7 +505,7 @@ func getReferenceName(repo *git.Repository, parsedURL *shared_utils.ParsedGitURL
 
 	// checking the error even though the above can't ever error
 	if err != nil {
-		return "", false, startosis_errors.NewInterpretationError("An error occurred while iterating through references in repository '%v'; This is a bug in Kurtosis", parsedURL.GetGitURL())
+		return "", false, startosis_errors.NewInterpretationError("An error occurred while iterating through references in repository '%v'; This is a bug in Kurtosis", gitUrl)
 	}
 
 	if branchReference != nil {
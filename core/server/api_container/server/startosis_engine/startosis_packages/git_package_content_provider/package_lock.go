@@ -0,0 +1,108 @@
+package git_package_content_provider
+
+import (
+	"errors"
+	"github.com/go-git/go-git/v5"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"path"
+)
+
+const (
+	noRootPackageRepository = ""
+	noLockedCommit          = ""
+
+	// packages that weren't cloned, like local packages and local replaces, can't be locked
+	notAGitRepositoryCommit = ""
+
+	packageLockCmd   = "kurtosis package lock"
+	packageUpdateCmd = "kurtosis package update"
+)
+
+// getLockedCommit returns the commit the package lock pins the repository of the URL to, if the URL doesn't specify
+// a version; an explicit version is resolved as usual and checked against the lock afterwards
+func (provider *GitPackageContentProvider) getLockedCommit(parsedURL *shared_utils.ParsedGitURL) string {
+	if parsedURL.GetTagBranchOrCommit() != emptyTagBranchOrCommit {
+		return noLockedCommit
+	}
+
+	provider.packageLockMutex.Lock()
+	defer provider.packageLockMutex.Unlock()
+
+	repository := getPackageRepository(parsedURL)
+	if repository == provider.rootPackageRepository {
+		return noLockedCommit
+	}
+	lockedCommit, found := provider.lockedPackageDependencies[repository]
+	if !found {
+		return noLockedCommit
+	}
+	return lockedCommit
+}
+
+// resolvePackageDependency records the commit the repository of the URL, which must be on disk, resolved to, and
+// enforces the package lock on it. A repository without a version that isn't at its locked commit is cloned again at it
+func (provider *GitPackageContentProvider) resolvePackageDependency(parsedURL *shared_utils.ParsedGitURL) *startosis_errors.InterpretationError {
+	provider.packageLockMutex.Lock()
+	defer provider.packageLockMutex.Unlock()
+
+	repository := getPackageRepository(parsedURL)
+	if repository == provider.rootPackageRepository {
+		return nil
+	}
+	if _, found := provider.resolvedPackageDependencies[repository]; found {
+		return nil
+	}
+
+	commit, err := getHeadCommit(path.Join(provider.packagesDir, parsedURL.GetRelativeRepoPath()))
+	if err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "An error occurred getting the commit package dependency '%s' resolved to", repository)
+	}
+	if commit == notAGitRepositoryCommit || provider.lockedPackageDependencies == nil {
+		provider.resolvedPackageDependencies[repository] = commit
+		return nil
+	}
+
+	lockedCommit, isLocked := provider.lockedPackageDependencies[repository]
+	if !isLocked {
+		if !provider.allowUnlockedPackageDependencies {
+			return startosis_errors.NewInterpretationError("Package dependency '%s' isn't in the %s of the package; run '%s' to add it", repository, startosis_constants.KurtosisLockName, packageLockCmd)
+		}
+	} else if commit != lockedCommit {
+		if parsedURL.GetTagBranchOrCommit() != emptyTagBranchOrCommit {
+			return startosis_errors.NewInterpretationError("Package dependency '%s' resolved to commit '%s' for version '%s' but the %s of the package locks it to commit '%s'; run '%s' to update the lock",
+				repository, commit, parsedURL.GetTagBranchOrCommit(), startosis_constants.KurtosisLockName, lockedCommit, packageUpdateCmd)
+		}
+		if interpretationError := provider.atomicClone(parsedURL, lockedCommit); interpretationError != nil {
+			return startosis_errors.WrapWithInterpretationError(interpretationError, "An error occurred checking out package dependency '%s' at commit '%s' locked in the %s of the package", repository, lockedCommit, startosis_constants.KurtosisLockName)
+		}
+		commit = lockedCommit
+	}
+	provider.resolvedPackageDependencies[repository] = commit
+	return nil
+}
+
+// getPackageRepository returns the repository of the URL, e.g. 'github.com/kurtosis-tech/prometheus-package', which is
+// what the package lock is keyed by
+func getPackageRepository(parsedURL *shared_utils.ParsedGitURL) string {
+	return path.Join(shared_utils.GithubDomainPrefix, parsedURL.GetRelativeRepoPath())
+}
+
+// getHeadCommit returns the commit checked out in the repository, or notAGitRepositoryCommit if the directory isn't a
+// Git repository
+func getHeadCommit(repositoryPath string) (string, error) {
+	repo, err := git.PlainOpen(repositoryPath)
+	if err != nil {
+		if errors.Is(err, git.ErrRepositoryNotExists) {
+			return notAGitRepositoryCommit, nil
+		}
+		return "", stacktrace.Propagate(err, "An error occurred opening the Git repository at '%s'", repositoryPath)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the HEAD of the Git repository at '%s'", repositoryPath)
+	}
+	return head.Hash().String(), nil
+}
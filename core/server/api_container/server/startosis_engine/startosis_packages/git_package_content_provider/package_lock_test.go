@@ -0,0 +1,150 @@
+package git_package_content_provider
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+const (
+	sampleRepository          = "github.com/kurtosis-tech/sample-startosis-load"
+	sampleModule              = sampleRepository + "/sample.star"
+	sampleMainBranchCommit    = "ec9062828e1a687a5db7dfa750f754f88119e4c0"
+	sampleCommitOnOtherBranch = "df88baf51caffbe7e8f66c0e54715f680f4482b2"
+
+	localRepository         = "github.com/test-author/locked-package"
+	localModule             = localRepository + "/lib.star"
+	unknownCommit           = "0000000000000000000000000000000000000001"
+	allowUnlockedForTest    = true
+	disallowUnlockedForTest = false
+)
+
+func TestPackageLock_PinsPackageWithoutVersionToLockedCommit(t *testing.T) {
+	provider := newGitPackageContentProviderForTest(t)
+	provider.SetPackageLock(localRepository, map[string]string{sampleRepository: sampleCommitOnOtherBranch}, disallowUnlockedForTest)
+
+	contents, err := provider.GetModuleContents(sampleModule)
+	require.Nil(t, err)
+	require.Equal(t, "a = \"Maybe!\"\n", contents)
+	require.Equal(t, map[string]string{sampleRepository: sampleCommitOnOtherBranch}, provider.GetResolvedPackageDependencies())
+}
+
+func TestPackageLock_ClonedPackageIsCheckedOutAgainAtLockedCommit(t *testing.T) {
+	provider := newGitPackageContentProviderForTest(t)
+	provider.SetPackageLock(localRepository, noLockedPackageDependenciesForTest, disallowUnlockedForTest)
+	contents, err := provider.GetModuleContents(sampleModule + "@" + sampleMainBranchCommit)
+	require.Nil(t, err)
+	require.Equal(t, "a = \"World!\"\n", contents)
+
+	provider.SetPackageLock(localRepository, map[string]string{sampleRepository: sampleCommitOnOtherBranch}, disallowUnlockedForTest)
+	contents, err = provider.GetModuleContents(sampleModule)
+	require.Nil(t, err)
+	require.Equal(t, "a = \"Maybe!\"\n", contents)
+}
+
+func TestPackageLock_ResolvedCommitsAreRecordedWithoutLock(t *testing.T) {
+	provider := newGitPackageContentProviderForTest(t)
+	commit := commitLocalPackageForTest(t, provider)
+	provider.SetPackageLock(sampleRepository, noLockedPackageDependenciesForTest, disallowUnlockedForTest)
+
+	contents, err := provider.GetModuleContents(localModule)
+	require.Nil(t, err)
+	require.Equal(t, "b = 1\n", contents)
+	require.Equal(t, map[string]string{localRepository: commit}, provider.GetResolvedPackageDependencies())
+}
+
+func TestPackageLock_LockedCommitSucceeds(t *testing.T) {
+	provider := newGitPackageContentProviderForTest(t)
+	commit := commitLocalPackageForTest(t, provider)
+	provider.SetPackageLock(sampleRepository, map[string]string{localRepository: commit}, disallowUnlockedForTest)
+
+	_, err := provider.GetModuleContents(localModule)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{localRepository: commit}, provider.GetResolvedPackageDependencies())
+}
+
+func TestPackageLock_UnlockedPackageFails(t *testing.T) {
+	provider := newGitPackageContentProviderForTest(t)
+	commitLocalPackageForTest(t, provider)
+	provider.SetPackageLock(sampleRepository, map[string]string{}, disallowUnlockedForTest)
+
+	_, err := provider.GetModuleContents(localModule)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Package dependency '"+localRepository+"' isn't in the kurtosis.lock of the package")
+}
+
+func TestPackageLock_UnlockedPackageIsAllowedWhenExtendingTheLock(t *testing.T) {
+	provider := newGitPackageContentProviderForTest(t)
+	commit := commitLocalPackageForTest(t, provider)
+	provider.SetPackageLock(sampleRepository, map[string]string{}, allowUnlockedForTest)
+
+	_, err := provider.GetModuleContents(localModule)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{localRepository: commit}, provider.GetResolvedPackageDependencies())
+}
+
+func TestPackageLock_VersionResolvingToAnotherCommitFails(t *testing.T) {
+	provider := newGitPackageContentProviderForTest(t)
+	commit := commitLocalPackageForTest(t, provider)
+	provider.SetPackageLock(sampleRepository, map[string]string{localRepository: unknownCommit}, disallowUnlockedForTest)
+
+	_, err := provider.GetModuleContents(localModule + "@" + commit)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "resolved to commit '"+commit+"'")
+	require.Contains(t, err.Error(), "locks it to commit '"+unknownCommit+"'")
+}
+
+func TestPackageLock_RootPackageIsNeverLocked(t *testing.T) {
+	provider := newGitPackageContentProviderForTest(t)
+	commitLocalPackageForTest(t, provider)
+	provider.SetPackageLock(localRepository, map[string]string{}, disallowUnlockedForTest)
+
+	_, err := provider.GetModuleContents(localModule)
+	require.Nil(t, err)
+	require.Empty(t, provider.GetResolvedPackageDependencies())
+}
+
+func TestPackageLock_PackagesThatArentGitRepositoriesAreNeverLocked(t *testing.T) {
+	provider := newGitPackageContentProviderForTest(t)
+	packagePath := path.Join(provider.packagesDir, "test-author", "locked-package")
+	require.Nil(t, os.MkdirAll(packagePath, moduleDirPermission))
+	require.Nil(t, os.WriteFile(path.Join(packagePath, "lib.star"), []byte("b = 1\n"), 0644))
+	provider.SetPackageLock(sampleRepository, map[string]string{}, disallowUnlockedForTest)
+
+	_, err := provider.GetModuleContents(localModule)
+	require.Nil(t, err)
+	require.Empty(t, provider.GetResolvedPackageDependencies())
+}
+
+var noLockedPackageDependenciesForTest map[string]string
+
+func newGitPackageContentProviderForTest(t *testing.T) *GitPackageContentProvider {
+	return NewGitPackageContentProvider(t.TempDir(), t.TempDir(), nil)
+}
+
+// commitLocalPackageForTest creates localRepository as if it had been cloned already, returning its only commit
+func commitLocalPackageForTest(t *testing.T, provider *GitPackageContentProvider) string {
+	packagePath := path.Join(provider.packagesDir, "test-author", "locked-package")
+	repo, err := git.PlainInit(packagePath, isNotBareClone)
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(path.Join(packagePath, "kurtosis.yml"), []byte("name: "+localRepository+"\n"), 0644))
+	require.Nil(t, os.WriteFile(path.Join(packagePath, "lib.star"), []byte("b = 1\n"), 0644))
+
+	workTree, err := repo.Worktree()
+	require.Nil(t, err)
+	_, err = workTree.Add(".")
+	require.Nil(t, err)
+	commit, err := workTree.Commit("initial commit", &git.CommitOptions{
+		All:       false,
+		Author:    &object.Signature{Name: "test", Email: "test@kurtosis.com", When: time.Now()},
+		Committer: nil,
+		Parents:   nil,
+		SignKey:   nil,
+	})
+	require.Nil(t, err)
+	return commit.String()
+}
@@ -351,6 +351,49 @@ func (_c *MockPackageContentProvider_GetOnDiskAbsolutePackagePath_Call) RunAndRe
 	return _c
 }
 
+// GetResolvedPackageDependencies provides a mock function with given fields:
+func (_m *MockPackageContentProvider) GetResolvedPackageDependencies() map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// MockPackageContentProvider_GetResolvedPackageDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResolvedPackageDependencies'
+type MockPackageContentProvider_GetResolvedPackageDependencies_Call struct {
+	*mock.Call
+}
+
+// GetResolvedPackageDependencies is a helper method to define mock.On call
+func (_e *MockPackageContentProvider_Expecter) GetResolvedPackageDependencies() *MockPackageContentProvider_GetResolvedPackageDependencies_Call {
+	return &MockPackageContentProvider_GetResolvedPackageDependencies_Call{Call: _e.mock.On("GetResolvedPackageDependencies")}
+}
+
+func (_c *MockPackageContentProvider_GetResolvedPackageDependencies_Call) Run(run func()) *MockPackageContentProvider_GetResolvedPackageDependencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_GetResolvedPackageDependencies_Call) Return(_a0 map[string]string) *MockPackageContentProvider_GetResolvedPackageDependencies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPackageContentProvider_GetResolvedPackageDependencies_Call) RunAndReturn(run func() map[string]string) *MockPackageContentProvider_GetResolvedPackageDependencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshCache provides a mock function with given fields: currentPackageReplaceOptions
 func (_m *MockPackageContentProvider) CloneReplacedPackagesIfNeeded(currentPackageReplaceOptions map[string]string) *startosis_errors.InterpretationError {
 	ret := _m.Called(currentPackageReplaceOptions)
@@ -395,6 +438,74 @@ func (_c *MockPackageContentProvider_RefreshCache_Call) RunAndReturn(run func(ma
 	return _c
 }
 
+// SetGitCredentials provides a mock function with given fields: gitCredentials
+func (_m *MockPackageContentProvider) SetGitCredentials(gitCredentials []*GitCredential) {
+	_m.Called(gitCredentials)
+}
+
+// MockPackageContentProvider_SetGitCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGitCredentials'
+type MockPackageContentProvider_SetGitCredentials_Call struct {
+	*mock.Call
+}
+
+// SetGitCredentials is a helper method to define mock.On call
+//   - gitCredentials []*GitCredential
+func (_e *MockPackageContentProvider_Expecter) SetGitCredentials(gitCredentials interface{}) *MockPackageContentProvider_SetGitCredentials_Call {
+	return &MockPackageContentProvider_SetGitCredentials_Call{Call: _e.mock.On("SetGitCredentials", gitCredentials)}
+}
+
+func (_c *MockPackageContentProvider_SetGitCredentials_Call) Run(run func(gitCredentials []*GitCredential)) *MockPackageContentProvider_SetGitCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*GitCredential))
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_SetGitCredentials_Call) Return() *MockPackageContentProvider_SetGitCredentials_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockPackageContentProvider_SetGitCredentials_Call) RunAndReturn(run func([]*GitCredential)) *MockPackageContentProvider_SetGitCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPackageLock provides a mock function with given fields: rootPackageId, lockedPackageDependencies, allowUnlockedPackageDependencies
+func (_m *MockPackageContentProvider) SetPackageLock(rootPackageId string, lockedPackageDependencies map[string]string, allowUnlockedPackageDependencies bool) {
+	_m.Called(rootPackageId, lockedPackageDependencies, allowUnlockedPackageDependencies)
+}
+
+// MockPackageContentProvider_SetPackageLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPackageLock'
+type MockPackageContentProvider_SetPackageLock_Call struct {
+	*mock.Call
+}
+
+// SetPackageLock is a helper method to define mock.On call
+//   - rootPackageId string
+//   - lockedPackageDependencies map[string]string
+//   - allowUnlockedPackageDependencies bool
+func (_e *MockPackageContentProvider_Expecter) SetPackageLock(rootPackageId interface{}, lockedPackageDependencies interface{}, allowUnlockedPackageDependencies interface{}) *MockPackageContentProvider_SetPackageLock_Call {
+	return &MockPackageContentProvider_SetPackageLock_Call{Call: _e.mock.On("SetPackageLock", rootPackageId, lockedPackageDependencies, allowUnlockedPackageDependencies)}
+}
+
+func (_c *MockPackageContentProvider_SetPackageLock_Call) Run(run func(rootPackageId string, lockedPackageDependencies map[string]string, allowUnlockedPackageDependencies bool)) *MockPackageContentProvider_SetPackageLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[string]string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_SetPackageLock_Call) Return() *MockPackageContentProvider_SetPackageLock_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockPackageContentProvider_SetPackageLock_Call) RunAndReturn(run func(string, map[string]string, bool)) *MockPackageContentProvider_SetPackageLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StorePackageContents provides a mock function with given fields: packageId, packageContent, overwriteExisting
 func (_m *MockPackageContentProvider) StorePackageContents(packageId string, packageContent io.Reader, overwriteExisting bool) (string, *startosis_errors.InterpretationError) {
 	ret := _m.Called(packageId, packageContent, overwriteExisting)
@@ -3,6 +3,7 @@ package mock_package_content_provider
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
 	"github.com/kurtosis-tech/stacktrace"
 	"io"
@@ -64,6 +65,18 @@ func (provider *MockPackageContentProvider) CloneReplacedPackagesIfNeeded(curren
 	return nil
 }
 
+func (provider *MockPackageContentProvider) SetGitCredentials(_ []*startosis_packages.GitCredential) {
+	// no-op as the mock provider never clones anything
+}
+
+func (provider *MockPackageContentProvider) SetPackageLock(_ string, _ map[string]string, _ bool) {
+	// no-op as the mock provider never clones anything
+}
+
+func (provider *MockPackageContentProvider) GetResolvedPackageDependencies() map[string]string {
+	return map[string]string{}
+}
+
 func (provider *MockPackageContentProvider) GetModuleContents(fileInsidePackageUrl string) (string, *startosis_errors.InterpretationError) {
 	absFilePath, found := provider.starlarkPackages[fileInsidePackageUrl]
 	if !found {
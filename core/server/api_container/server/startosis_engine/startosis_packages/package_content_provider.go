@@ -39,4 +39,18 @@ type PackageContentProvider interface {
 	// CloneReplacedPackagesIfIsNeeded will compare the received currentPackageReplaceOptions with the historical replace options (from previous run)
 	// and will clone the packages depending on the comparison result
 	CloneReplacedPackagesIfNeeded(currentPackageReplaceOptions map[string]string) *startosis_errors.InterpretationError
+
+	// SetGitCredentials replaces the credentials used to clone private Git repositories, both for packages and for
+	// everything they import. Credentials are keyed by Git host and are only kept in memory; callers clear them once
+	// the run they were sent with finishes
+	SetGitCredentials(gitCredentials []*GitCredential)
+
+	// SetPackageLock pins the packages imported by the package being run, keyed by repository, to the commits of its
+	// kurtosis.lock, and forgets the package dependencies resolved so far. A nil lockedPackageDependencies disables the
+	// lock; otherwise importing a package that isn't locked fails unless allowUnlockedPackageDependencies is set
+	SetPackageLock(rootPackageId string, lockedPackageDependencies map[string]string, allowUnlockedPackageDependencies bool)
+
+	// GetResolvedPackageDependencies returns the commit every package cloned from Git resolved to, keyed by repository,
+	// since the package lock was last set. The repository of the package being run is never included
+	GetResolvedPackageDependencies() map[string]string
 }
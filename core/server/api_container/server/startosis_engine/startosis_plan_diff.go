@@ -0,0 +1,122 @@
+package startosis_engine
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_files_artifact"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+)
+
+// planDiffComponents accumulates the changes made to one kind of enclave component, keeping the first change made to
+// each of them in the order they happen
+type planDiffComponents struct {
+	existingComponents map[string]bool
+
+	changedComponents map[string]bool
+
+	changes []*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange
+}
+
+func newPlanDiffComponents() *planDiffComponents {
+	return &planDiffComponents{
+		existingComponents: map[string]bool{},
+		changedComponents:  map[string]bool{},
+		changes:            []*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange{},
+	}
+}
+
+func (components *planDiffComponents) addOrUpdate(componentName string) {
+	if components.existingComponents[componentName] {
+		components.change(componentName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_UPDATED)
+		return
+	}
+	components.change(componentName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_ADDED)
+}
+
+func (components *planDiffComponents) change(componentName string, changeType kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType) {
+	if components.changedComponents[componentName] {
+		return
+	}
+	components.changedComponents[componentName] = true
+	components.changes = append(components.changes, binding_constructors.NewStarlarkPlanDiffComponentChange(componentName, changeType))
+}
+
+// generatePlanDiff returns the changes executing the instructions sequence would make to an enclave in which the
+// current enclave plan was executed.
+//
+// The instructions already marked as executed by the interpretation are skipped. Among the others, adding a service
+// or a files artifact that already exists in the enclave updates it, and removing a service or a files artifact removes it
+func generatePlanDiff(
+	currentEnclavePlan *enclave_plan_persistence.EnclavePlan,
+	instructionsSequence []*instructions_plan.ScheduledInstruction,
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
+) (*kurtosis_core_rpc_api_bindings.StarlarkPlanDiff, error) {
+	services := newPlanDiffComponents()
+	filesArtifacts := newPlanDiffComponents()
+	for _, enclavePlanInstruction := range currentEnclavePlan.GeneratePlan() {
+		for _, serviceName := range enclavePlanInstruction.ServiceNames {
+			switch enclavePlanInstruction.Type {
+			case add_service.AddServiceBuiltinName, add_service.AddServicesBuiltinName:
+				services.existingComponents[serviceName] = true
+			case remove_service.RemoveServiceBuiltinName:
+				delete(services.existingComponents, serviceName)
+			}
+		}
+		for filesArtifactName := range enclavePlanInstruction.FilesArtifacts {
+			if enclavePlanInstruction.Type == remove_files_artifact.RemoveFilesArtifactBuiltinName {
+				delete(filesArtifacts.existingComponents, filesArtifactName)
+				continue
+			}
+			filesArtifacts.existingComponents[filesArtifactName] = true
+		}
+	}
+
+	instructionsToExecute := []*kurtosis_core_rpc_api_bindings.StarlarkInstruction{}
+	skippedInstructions := []*kurtosis_core_rpc_api_bindings.StarlarkInstruction{}
+	for _, scheduledInstruction := range instructionsSequence {
+		instruction := scheduledInstruction.GetInstruction()
+		if scheduledInstruction.IsExecuted() {
+			skippedInstructions = append(skippedInstructions, instruction.GetCanonicalInstruction(scheduledInstruction.IsExecuted()))
+			continue
+		}
+		instructionsToExecute = append(instructionsToExecute, instruction.GetCanonicalInstruction(scheduledInstruction.IsExecuted()))
+
+		enclavePlanInstruction, err := instruction.GetPersistableAttributes().SetUuid(
+			string(scheduledInstruction.GetUuid()),
+		).SetReturnedValue(
+			starlarkValueSerde.Serialize(scheduledInstruction.GetReturnedValue()),
+		).Build()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the components changed by instruction '%s'", instruction.String())
+		}
+		serviceNames := enclavePlanInstruction.ServiceNames
+		sort.Strings(serviceNames)
+		for _, serviceName := range serviceNames {
+			switch enclavePlanInstruction.Type {
+			case add_service.AddServiceBuiltinName, add_service.AddServicesBuiltinName:
+				services.addOrUpdate(serviceName)
+			case remove_service.RemoveServiceBuiltinName:
+				services.change(serviceName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_REMOVED)
+			}
+		}
+		filesArtifactNames := []string{}
+		for filesArtifactName := range enclavePlanInstruction.FilesArtifacts {
+			filesArtifactNames = append(filesArtifactNames, filesArtifactName)
+		}
+		sort.Strings(filesArtifactNames)
+		for _, filesArtifactName := range filesArtifactNames {
+			if enclavePlanInstruction.Type == remove_files_artifact.RemoveFilesArtifactBuiltinName {
+				filesArtifacts.change(filesArtifactName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_REMOVED)
+				continue
+			}
+			filesArtifacts.addOrUpdate(filesArtifactName)
+		}
+	}
+	return binding_constructors.NewStarlarkPlanDiff(services.changes, filesArtifacts.changes, instructionsToExecute, skippedInstructions), nil
+}
@@ -0,0 +1,147 @@
+package startosis_engine
+
+import (
+	"github.com/google/uuid"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_files_artifact"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/upload_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	planDiffTestThreadName = "plan-diff-test-thread"
+
+	webServiceName      = "web"
+	databaseServiceName = "database"
+	cacheServiceName    = "cache"
+
+	configFilesArtifactName = "config"
+	scriptFilesArtifactName = "script"
+)
+
+var (
+	noServiceNames   = []service.ServiceName{}
+	noFilesArtifacts = []string{}
+)
+
+func TestGeneratePlanDiff(t *testing.T) {
+	currentEnclavePlan := enclave_plan_persistence.NewEnclavePlan()
+	currentEnclavePlan.AppendInstruction(createEnclavePlanInstruction(t, add_service.AddServicesBuiltinName, []service.ServiceName{webServiceName, databaseServiceName}, noFilesArtifacts))
+	currentEnclavePlan.AppendInstruction(createEnclavePlanInstruction(t, upload_files.UploadFilesBuiltinName, noServiceNames, []string{configFilesArtifactName}))
+
+	uploadConfig := createPlanDiffMockInstruction(t, upload_files.UploadFilesBuiltinName, noServiceNames, []string{configFilesArtifactName})
+	addWeb := createPlanDiffMockInstruction(t, add_service.AddServiceBuiltinName, []service.ServiceName{webServiceName}, noFilesArtifacts)
+	uploadScript := createPlanDiffMockInstruction(t, upload_files.UploadFilesBuiltinName, noServiceNames, []string{scriptFilesArtifactName})
+	addDatabaseAndCache := createPlanDiffMockInstruction(t, add_service.AddServicesBuiltinName, []service.ServiceName{databaseServiceName, cacheServiceName}, noFilesArtifacts)
+	removeWeb := createPlanDiffMockInstruction(t, remove_service.RemoveServiceBuiltinName, []service.ServiceName{webServiceName}, noFilesArtifacts)
+	removeConfig := createPlanDiffMockInstruction(t, remove_files_artifact.RemoveFilesArtifactBuiltinName, noServiceNames, []string{configFilesArtifactName})
+
+	instructionsSequence := []*instructions_plan.ScheduledInstruction{
+		instructions_plan.NewScheduledInstruction("instruction-1", uploadConfig, starlark.None).Executed(true),
+		instructions_plan.NewScheduledInstruction("instruction-2", addWeb, starlark.None).Executed(true),
+		instructions_plan.NewScheduledInstruction("instruction-3", uploadScript, starlark.None),
+		instructions_plan.NewScheduledInstruction("instruction-4", addDatabaseAndCache, starlark.None),
+		instructions_plan.NewScheduledInstruction("instruction-5", removeWeb, starlark.None),
+		instructions_plan.NewScheduledInstruction("instruction-6", removeConfig, starlark.None),
+	}
+
+	planDiff, err := generatePlanDiff(currentEnclavePlan, instructionsSequence, createStarlarkValueSerdeForPlanDiffTest())
+	require.NoError(t, err)
+
+	require.Equal(t, []*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange{
+		binding_constructors.NewStarlarkPlanDiffComponentChange(cacheServiceName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_ADDED),
+		binding_constructors.NewStarlarkPlanDiffComponentChange(databaseServiceName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_UPDATED),
+		binding_constructors.NewStarlarkPlanDiffComponentChange(webServiceName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_REMOVED),
+	}, planDiff.GetServiceChanges())
+	require.Equal(t, []*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange{
+		binding_constructors.NewStarlarkPlanDiffComponentChange(scriptFilesArtifactName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_ADDED),
+		binding_constructors.NewStarlarkPlanDiffComponentChange(configFilesArtifactName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_REMOVED),
+	}, planDiff.GetFilesArtifactChanges())
+	require.Equal(t, []*kurtosis_core_rpc_api_bindings.StarlarkInstruction{
+		uploadConfig.GetCanonicalInstruction(true),
+		addWeb.GetCanonicalInstruction(true),
+	}, planDiff.GetSkippedInstructions())
+	require.Equal(t, []*kurtosis_core_rpc_api_bindings.StarlarkInstruction{
+		uploadScript.GetCanonicalInstruction(false),
+		addDatabaseAndCache.GetCanonicalInstruction(false),
+		removeWeb.GetCanonicalInstruction(false),
+		removeConfig.GetCanonicalInstruction(false),
+	}, planDiff.GetInstructionsToExecute())
+}
+
+func TestGeneratePlanDiff_EmptyEnclave(t *testing.T) {
+	addWeb := createPlanDiffMockInstruction(t, add_service.AddServiceBuiltinName, []service.ServiceName{webServiceName}, noFilesArtifacts)
+	uploadConfig := createPlanDiffMockInstruction(t, upload_files.UploadFilesBuiltinName, noServiceNames, []string{configFilesArtifactName})
+	instructionsSequence := []*instructions_plan.ScheduledInstruction{
+		instructions_plan.NewScheduledInstruction("instruction-1", addWeb, starlark.None),
+		instructions_plan.NewScheduledInstruction("instruction-2", uploadConfig, starlark.None),
+	}
+
+	planDiff, err := generatePlanDiff(enclave_plan_persistence.NewEnclavePlan(), instructionsSequence, createStarlarkValueSerdeForPlanDiffTest())
+	require.NoError(t, err)
+
+	require.Equal(t, []*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange{
+		binding_constructors.NewStarlarkPlanDiffComponentChange(webServiceName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_ADDED),
+	}, planDiff.GetServiceChanges())
+	require.Equal(t, []*kurtosis_core_rpc_api_bindings.StarlarkPlanDiffComponentChange{
+		binding_constructors.NewStarlarkPlanDiffComponentChange(configFilesArtifactName, kurtosis_core_rpc_api_bindings.StarlarkPlanDiffChangeType_COMPONENT_ADDED),
+	}, planDiff.GetFilesArtifactChanges())
+	require.Empty(t, planDiff.GetSkippedInstructions())
+	require.Len(t, planDiff.GetInstructionsToExecute(), 2)
+}
+
+func createPlanDiffMockInstruction(t *testing.T, instructionName string, serviceNames []service.ServiceName, filesArtifactNames []string) *mock_instruction.MockKurtosisInstruction {
+	instruction := mock_instruction.NewMockKurtosisInstruction(t)
+
+	stringifiedInstruction := instructionName + "()"
+	instruction.EXPECT().GetCanonicalInstruction(mock.Anything).RunAndReturn(func(isSkipped bool) *kurtosis_core_rpc_api_bindings.StarlarkInstruction {
+		return binding_constructors.NewStarlarkInstruction(dummyPosition.ToAPIType(), instructionName, stringifiedInstruction, noInstructionArgsForTesting, isSkipped)
+	}).Maybe()
+	instruction.EXPECT().String().Maybe().Return(stringifiedInstruction)
+	instruction.EXPECT().GetPersistableAttributes().RunAndReturn(func() *enclave_plan_persistence.EnclavePlanInstructionBuilder {
+		builder := enclave_plan_persistence.NewEnclavePlanInstructionBuilder().SetType(instructionName).SetStarlarkCode(stringifiedInstruction)
+		for _, serviceName := range serviceNames {
+			builder.AddServiceName(serviceName)
+		}
+		for _, filesArtifactName := range filesArtifactNames {
+			builder.AddFilesArtifact(filesArtifactName, []byte(filesArtifactName))
+		}
+		return builder
+	}).Maybe()
+	return instruction
+}
+
+func createEnclavePlanInstruction(t *testing.T, instructionName string, serviceNames []service.ServiceName, filesArtifactNames []string) *enclave_plan_persistence.EnclavePlanInstruction {
+	builder := enclave_plan_persistence.NewEnclavePlanInstructionBuilder().SetUuid(uuid.New().String()).SetType(instructionName).SetStarlarkCode(instructionName + "()").SetReturnedValue(starlark.None.String())
+	for _, serviceName := range serviceNames {
+		builder.AddServiceName(serviceName)
+	}
+	for _, filesArtifactName := range filesArtifactNames {
+		builder.AddFilesArtifact(filesArtifactName, []byte(filesArtifactName))
+	}
+	enclavePlanInstruction, err := builder.Build()
+	require.NoError(t, err)
+	return enclavePlanInstruction
+}
+
+func createStarlarkValueSerdeForPlanDiffTest() *kurtosis_types.StarlarkValueSerde {
+	thread := &starlark.Thread{
+		Name:       planDiffTestThreadName,
+		Print:      nil,
+		Load:       nil,
+		OnMaxSteps: nil,
+		Steps:      0,
+	}
+	return kurtosis_types.NewStarlarkValueSerde(thread, starlark.StringDict{})
+}
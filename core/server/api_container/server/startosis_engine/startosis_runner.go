@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan/resolver"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_warning"
+	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
 
@@ -31,6 +32,7 @@ const (
 	startingInterpretationMsg = "Interpreting Starlark code - execution will begin shortly"
 	startingValidationMsg     = "Starting validation"
 	startingExecutionMsg      = "Starting execution"
+	noSerializedOutput        = ""
 )
 
 func NewStartosisRunner(interpreter *StartosisInterpreter, validator *StartosisValidator, executor *StartosisExecutor) *StartosisRunner {
@@ -48,6 +50,7 @@ func NewStartosisRunner(interpreter *StartosisInterpreter, validator *StartosisV
 func (runner *StartosisRunner) Run(
 	ctx context.Context,
 	dryRun bool,
+	diff bool,
 	parallelism int,
 	packageId string,
 	packageReplaceOptions map[string]string,
@@ -82,9 +85,10 @@ func (runner *StartosisRunner) Run(
 		for _, experimentalFeature := range experimentalFeatures {
 			experimentalFeaturesStr = append(experimentalFeaturesStr, experimentalFeature.String())
 		}
-		logrus.Infof("Executing Starlark package '%s' with the following parameters: dry-run: '%v', parallelism: '%d', experimental features: '%s', main function name: '%s', params: '%s'",
+		logrus.Infof("Executing Starlark package '%s' with the following parameters: dry-run: '%v', diff: '%v', parallelism: '%d', experimental features: '%s', main function name: '%s', params: '%s'",
 			packageId,
 			dryRun,
+			diff,
 			parallelism,
 			strings.Join(experimentalFeaturesStr, ", "),
 			mainFunctionName,
@@ -155,6 +159,18 @@ func (runner *StartosisRunner) Run(
 		}
 		logrus.Debugf("Successfully validated Starlark script")
 
+		if diff {
+			// the diff is all that's returned, the enclave is left untouched
+			planDiff, err := generatePlanDiff(runner.startosisExecutor.enclavePlan, instructionsSequence, runner.startosisExecutor.starlarkValueSerde)
+			if err != nil {
+				sendErrorAndFail(starlarkRunResponseLines, err, "An error occurred generating the changes running the Starlark script would make to the enclave")
+				return
+			}
+			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromPlanDiff(planDiff)
+			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunSuccessEvent(noSerializedOutput)
+			return
+		}
+
 		// Execution starts > send progress info. This will soon be overridden byt the first instruction execution
 		progressInfo = binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
 			startingExecutionMsg, defaultCurrentStepNumber, totalNumberOfInstructions)
@@ -172,6 +188,17 @@ func (runner *StartosisRunner) Run(
 	return starlarkRunResponseLines
 }
 
+// ReloadEnclavePlan needs to be called when the enclave plan persisted in the enclave database was replaced, such that
+// the next runs are resolved against it
+func (runner *StartosisRunner) ReloadEnclavePlan() error {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+	if err := runner.startosisExecutor.ReloadEnclavePlan(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reloading the enclave plan")
+	}
+	return nil
+}
+
 func forwardKurtosisResponseLineChannelUntilSourceIsClosed(sourceChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, destChan chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) (bool, bool) {
 	isSuccessful := false
 	isStarlarkRunFinished := false
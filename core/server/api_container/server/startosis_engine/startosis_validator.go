@@ -10,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_quotas"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
@@ -36,15 +37,18 @@ type StartosisValidator struct {
 	fileArtifactStore *enclave_data_directory.FilesArtifactStore
 
 	backend *backend_interface.KurtosisBackend
+
+	enclaveQuotas *enclave_quotas.EnclaveQuotas
 }
 
-func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore) *StartosisValidator {
+func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore, enclaveQuotas *enclave_quotas.EnclaveQuotas) *StartosisValidator {
 	dockerImagesValidator := startosis_validator.NewDockerImagesValidator(kurtosisBackend)
 	return &StartosisValidator{
 		dockerImagesValidator,
 		serviceNetwork,
 		fileArtifactStore,
 		kurtosisBackend,
+		enclaveQuotas,
 	}
 }
 
@@ -81,6 +85,14 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			return
 		}
 
+		enclaveQuotasUsage, err := GetEnclaveQuotasUsage(ctx, validator.serviceNetwork, validator.fileArtifactStore)
+		if err != nil {
+			wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors computing the usage of the enclave quotas")
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
+		}
+
 		environment := startosis_validator.NewValidatorEnvironment(
 			serviceNames,
 			validator.fileArtifactStore.ListFiles(),
@@ -88,10 +100,17 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			availableCpuInMilliCores,
 			availableMemoryInMegaBytes,
 			isResourceInformationComplete,
-			imageDownloadMode)
+			imageDownloadMode,
+			validator.enclaveQuotas,
+			enclaveQuotasUsage)
 
 		isValidationFailure = isValidationFailure ||
 			validator.validateAndUpdateEnvironment(instructionsSequence, environment, starlarkRunResponseLineStream)
+
+		if validationErr := environment.HasEnoughFilesArtifactsStorageQuota(); validationErr != nil {
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(validationErr.ToAPIType())
+			isValidationFailure = true
+		}
 		logrus.Debug("Finished validating environment. Validating and downloading container images.")
 
 		isValidationFailure = isValidationFailure ||
@@ -253,6 +272,19 @@ func removeIfPresent(slice []string, valueToRemove string) []string {
 	return append(newSlice, slice[valueToRemoveIndex+1:]...)
 }
 
+// GetEnclaveQuotasUsage returns how much of its quotas the enclave currently uses
+func GetEnclaveQuotasUsage(ctx context.Context, serviceNetwork service_network.ServiceNetwork, filesArtifactStore *enclave_data_directory.FilesArtifactStore) (*enclave_quotas.EnclaveQuotasUsage, error) {
+	services, err := serviceNetwork.GetServices(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services of the enclave")
+	}
+	usedStorage, err := filesArtifactStore.GetUsedStorage()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the storage used by the files artifacts of the enclave")
+	}
+	return enclave_quotas.NewEnclaveQuotasUsage(services, usedStorage), nil
+}
+
 func getServiceNameToPortIDsMap(serviceNames map[service.ServiceName]bool, network service_network.ServiceNetwork) (map[service.ServiceName][]string, error) {
 	serviceToPrivatePortIds := make(map[service.ServiceName][]string, len(serviceNames))
 	ctx := context.Background()
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/sirupsen/logrus"
@@ -22,7 +23,7 @@ func NewDockerImagesValidator(kurtosisBackend *backend_interface.KurtosisBackend
 	}
 }
 
-// Validate validates all container images by downloading them. It is an async function, and it takes as input a
+// Validate validates all container images by downloading them, or building them when they come with a build spec. It is an async function, and it takes as input a
 // WaitGroup that will unblock once the function is complete (as opposed to when the function returns). It allows the
 // consumer to run this function synchronously by calling it and then waiting for wait group to resolve.
 // In addition to the total number of container images to validate, it returns three channels:
@@ -47,6 +48,10 @@ func (validator *DockerImagesValidator) Validate(ctx context.Context, environmen
 		wg.Add(1)
 		go fetchImageFromBackend(ctx, wg, imageCurrentlyDownloading, validator.kurtosisBackend, image, environment.imageDownloadMode, pullErrors, imageDownloadStarted, imageDownloadFinished)
 	}
+	for image, imageBuildSpec := range environment.requiredImagesToBuild {
+		wg.Add(1)
+		go buildImageFromBackend(ctx, wg, imageCurrentlyDownloading, validator.kurtosisBackend, image, imageBuildSpec, pullErrors, imageDownloadStarted, imageDownloadFinished)
+	}
 	wg.Wait()
 	logrus.Debug("All image validation submitted, currently in progress.")
 }
@@ -71,3 +76,24 @@ func fetchImageFromBackend(ctx context.Context, wg *sync.WaitGroup, imageCurrent
 	}
 	logrus.Debugf("Container image '%s' successfully downloaded", imageName)
 }
+
+func buildImageFromBackend(ctx context.Context, wg *sync.WaitGroup, imageCurrentlyDownloading chan bool, backend *backend_interface.KurtosisBackend, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec, buildErrors chan<- error, imageBuildStarted chan<- string, imageBuildFinished chan<- *ValidatedImage) {
+	logrus.Debugf("Requesting the build of image: '%s'", imageName)
+	defer wg.Done()
+	imageCurrentlyDownloading <- true
+	imageBuildStarted <- imageName
+	defer func() {
+		<-imageCurrentlyDownloading
+		// a built image is never pulled from a remote registry
+		imageBuildFinished <- NewValidatedImage(imageName, false)
+	}()
+
+	logrus.Debugf("Starting the build of image: '%s'", imageName)
+	imageWasBuilt, err := (*backend).BuildImage(ctx, imageName, imageBuildSpec)
+	if err != nil {
+		logrus.Warnf("Container image '%s' build failed. Error was: '%s'", imageName, err.Error())
+		buildErrors <- startosis_errors.WrapWithValidationError(err, "Failed building the required image '%v'.", imageName)
+		return
+	}
+	logrus.Debugf("Container image '%s' successfully built (reused existing image: %v)", imageName, !imageWasBuilt)
+}
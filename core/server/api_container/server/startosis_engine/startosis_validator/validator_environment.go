@@ -2,15 +2,20 @@ package startosis_validator
 
 import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_quotas"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/sirupsen/logrus"
+	"sort"
+	"strings"
 )
 
 // ValidatorEnvironment fields are not exported so that only validators can access its fields
 type ValidatorEnvironment struct {
 	requiredDockerImages          map[string]bool
+	requiredImagesToBuild         map[string]*image_build_spec.ImageBuildSpec
 	serviceNames                  map[service.ServiceName]ComponentExistence
 	artifactNames                 map[string]ComponentExistence
 	serviceNameToPrivatePortIDs   map[service.ServiceName][]string
@@ -20,9 +25,11 @@ type ValidatorEnvironment struct {
 	minCPUByServiceName           map[service.ServiceName]compute_resources.CpuMilliCores
 	minMemoryByServiceName        map[service.ServiceName]compute_resources.MemoryInMegaBytes
 	imageDownloadMode             image_download_mode.ImageDownloadMode
+	enclaveQuotas                 *enclave_quotas.EnclaveQuotas
+	enclaveQuotasUsage            *enclave_quotas.EnclaveQuotasUsage
 }
 
-func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode) *ValidatorEnvironment {
+func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode, enclaveQuotas *enclave_quotas.EnclaveQuotas, enclaveQuotasUsage *enclave_quotas.EnclaveQuotasUsage) *ValidatorEnvironment {
 	serviceNamesWithComponentExistence := map[service.ServiceName]ComponentExistence{}
 	for serviceName := range serviceNames {
 		serviceNamesWithComponentExistence[serviceName] = ComponentExistedBeforePackageRun
@@ -33,6 +40,7 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 	}
 	return &ValidatorEnvironment{
 		requiredDockerImages:          map[string]bool{},
+		requiredImagesToBuild:         map[string]*image_build_spec.ImageBuildSpec{},
 		serviceNames:                  serviceNamesWithComponentExistence,
 		artifactNames:                 artifactNamesWithComponentExistence,
 		serviceNameToPrivatePortIDs:   serviceNameToPrivatePortIds,
@@ -42,15 +50,42 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 		minMemoryByServiceName:        map[service.ServiceName]compute_resources.MemoryInMegaBytes{},
 		minCPUByServiceName:           map[service.ServiceName]compute_resources.CpuMilliCores{},
 		imageDownloadMode:             imageDownloadMode,
+		enclaveQuotas:                 enclaveQuotas,
+		enclaveQuotasUsage:            enclaveQuotasUsage,
 	}
 }
 
 func (environment *ValidatorEnvironment) AppendRequiredContainerImage(containerImage string) {
+	// an image built from a build spec is never fetched, even if another service refers to it by name
+	if _, found := environment.requiredImagesToBuild[containerImage]; found {
+		return
+	}
 	environment.requiredDockerImages[containerImage] = true
 }
 
+// AppendRequiredImageBuild registers that the image with the given name is built from the given build spec. As the image
+// is built only once, a validation error is returned if the same image name is already built from a different spec
+func (environment *ValidatorEnvironment) AppendRequiredImageBuild(containerImage string, imageBuildSpec *image_build_spec.ImageBuildSpec) *startosis_errors.ValidationError {
+	if existingImageBuildSpec, found := environment.requiredImagesToBuild[containerImage]; found && !isSameImageBuildSpec(existingImageBuildSpec, imageBuildSpec) {
+		return startosis_errors.NewValidationError(
+			"Image '%s' is built from two different build specs, one with build context '%s', Dockerfile '%s' and target stage '%s' "+
+				"and the other with build context '%s', Dockerfile '%s' and target stage '%s'. Give each build spec its own image name",
+			containerImage,
+			existingImageBuildSpec.GetBuildContextDirpath(),
+			existingImageBuildSpec.GetDockerfileRelativeFilepath(),
+			existingImageBuildSpec.GetTargetStage(),
+			imageBuildSpec.GetBuildContextDirpath(),
+			imageBuildSpec.GetDockerfileRelativeFilepath(),
+			imageBuildSpec.GetTargetStage(),
+		)
+	}
+	delete(environment.requiredDockerImages, containerImage)
+	environment.requiredImagesToBuild[containerImage] = imageBuildSpec
+	return nil
+}
+
 func (environment *ValidatorEnvironment) GetNumberOfContainerImages() uint32 {
-	return uint32(len(environment.requiredDockerImages))
+	return uint32(len(environment.requiredDockerImages) + len(environment.requiredImagesToBuild))
 }
 
 func (environment *ValidatorEnvironment) AddServiceName(serviceName service.ServiceName) {
@@ -153,3 +188,76 @@ func (environment *ValidatorEnvironment) HasEnoughMemory(memoryToConsume uint64,
 	}
 	return startosis_errors.NewValidationError("service '%v' requires '%v' megabytes of memory but based on our calculation we will only have '%v' megabytes available at the time we start the service", serviceNameForLogging, memoryToConsume, environment.availableMemoryInMegaBytes)
 }
+
+// HasEnoughQuota checks that the enclave stays within its quotas once the service with the given config is added,
+// replacing the service with the same name if any
+func (environment *ValidatorEnvironment) HasEnoughQuota(serviceConfig *service.ServiceConfig, serviceName service.ServiceName) *startosis_errors.ValidationError {
+	quotas := environment.enclaveQuotas
+	usage := environment.enclaveQuotasUsage
+
+	if serviceCountQuota := quotas.GetServiceCount(); serviceCountQuota != enclave_quotas.NoQuota && !usage.HasService(serviceName) && usage.GetServiceCount() >= serviceCountQuota {
+		return startosis_errors.NewValidationError("service '%v' can't be added as the enclave would then have more than its quota of '%v' services", serviceName, serviceCountQuota)
+	}
+
+	if cpuQuota := quotas.GetCpuMillicpus(); cpuQuota != enclave_quotas.NoQuota {
+		cpuToAllocate := serviceConfig.GetCPUAllocationMillicpus()
+		if cpuToAllocate == 0 {
+			return startosis_errors.NewValidationError("service '%v' must set 'max_cpu' as the enclave has a cpu quota of '%v' millicores", serviceName, cpuQuota)
+		}
+		cpuAllocated := usage.GetCpuMillicpus() - usage.GetServiceCpuMillicpus(serviceName) + cpuToAllocate
+		if cpuAllocated > cpuQuota {
+			return startosis_errors.NewValidationError("service '%v' requires '%v' millicores of cpu but based on our calculation the services of the enclave would then be allocated '%v' millicores, exceeding its cpu quota of '%v' millicores", serviceName, cpuToAllocate, cpuAllocated, cpuQuota)
+		}
+	}
+
+	if memoryQuota := quotas.GetMemoryMegabytes(); memoryQuota != enclave_quotas.NoQuota {
+		memoryToAllocate := serviceConfig.GetMemoryAllocationMegabytes()
+		if memoryToAllocate == 0 {
+			return startosis_errors.NewValidationError("service '%v' must set 'max_memory' as the enclave has a memory quota of '%v' megabytes", serviceName, memoryQuota)
+		}
+		memoryAllocated := usage.GetMemoryMegabytes() - usage.GetServiceMemoryMegabytes(serviceName) + memoryToAllocate
+		if memoryAllocated > memoryQuota {
+			return startosis_errors.NewValidationError("service '%v' requires '%v' megabytes of memory but based on our calculation the services of the enclave would then be allocated '%v' megabytes, exceeding its memory quota of '%v' megabytes", serviceName, memoryToAllocate, memoryAllocated, memoryQuota)
+		}
+	}
+	return nil
+}
+
+func (environment *ValidatorEnvironment) ConsumeQuota(serviceConfig *service.ServiceConfig, serviceName service.ServiceName) {
+	environment.enclaveQuotasUsage.SetService(serviceName, serviceConfig)
+}
+
+func (environment *ValidatorEnvironment) FreeQuota(serviceName service.ServiceName) {
+	environment.enclaveQuotasUsage.RemoveService(serviceName)
+}
+
+// HasEnoughFilesArtifactsStorageQuota checks that the files artifacts storage quota isn't already used up if files
+// artifacts are created or updated during the run. Their size isn't known before they are stored, so a run still
+// fails during execution if they don't fit in what's left
+func (environment *ValidatorEnvironment) HasEnoughFilesArtifactsStorageQuota() *startosis_errors.ValidationError {
+	storageQuota := environment.enclaveQuotas.GetFilesArtifactsStorageBytes()
+	if storageQuota == enclave_quotas.NoQuota {
+		return nil
+	}
+	usedStorage := environment.enclaveQuotasUsage.GetFilesArtifactsStorageBytes()
+	if usedStorage < storageQuota {
+		return nil
+	}
+	artifactNamesToStore := []string{}
+	for artifactName, artifactExistence := range environment.artifactNames {
+		if artifactExistence == ComponentCreatedOrUpdatedDuringPackageRun {
+			artifactNamesToStore = append(artifactNamesToStore, artifactName)
+		}
+	}
+	if len(artifactNamesToStore) == 0 {
+		return nil
+	}
+	sort.Strings(artifactNamesToStore)
+	return startosis_errors.NewValidationError("files artifacts '%v' can't be stored as the files artifacts of the enclave already use '%v' bytes, which is all of its storage quota of '%v' bytes. Remove the files artifacts that are no longer needed to free up storage", strings.Join(artifactNamesToStore, "', '"), usedStorage, storageQuota)
+}
+
+func isSameImageBuildSpec(imageBuildSpec *image_build_spec.ImageBuildSpec, otherImageBuildSpec *image_build_spec.ImageBuildSpec) bool {
+	return imageBuildSpec.GetBuildContextDirpath() == otherImageBuildSpec.GetBuildContextDirpath() &&
+		imageBuildSpec.GetDockerfileRelativeFilepath() == otherImageBuildSpec.GetDockerfileRelativeFilepath() &&
+		imageBuildSpec.GetTargetStage() == otherImageBuildSpec.GetTargetStage()
+}
@@ -3,8 +3,10 @@ package startosis_validator
 import (
 	"testing"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_quotas"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,11 +20,25 @@ const (
 	isResourceInformationComplete = true
 	tooMuchMemory                 = 120000
 	tooMuchCpu                    = 5000
+	testBuiltImageName            = "my-app:latest"
+	testFetchedImageName          = "postgres:alpine"
+
+	testFooService         = service.ServiceName("foo")
+	testBazService         = service.ServiceName("baz")
+	testArtifactName       = "artifact"
+	cpuQuotaMillicpus      = 2000
+	memoryQuotaMegabytes   = 1024
+	serviceCountQuota      = 2
+	storageQuotaBytes      = 4096
+	serviceCpuMillicpus    = 1000
+	serviceMemoryMegabytes = 512
 )
 
+var noEnclaveQuotas = enclave_quotas.NewEnclaveQuotas(enclave_quotas.NoQuota, enclave_quotas.NoQuota, enclave_quotas.NoQuota, enclave_quotas.NoQuota)
+
 func TestMultiplePortIdsForValidation(t *testing.T) {
 	emptyInitialMapping := map[service.ServiceName][]string{}
-	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing)
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, noEnclaveQuotas, emptyEnclaveQuotasUsage())
 	portIds := []string{
 		fooPortId,
 		fizzPortId,
@@ -37,3 +53,80 @@ func TestMultiplePortIdsForValidation(t *testing.T) {
 	require.Error(t, validatorEnvironment.HasEnoughCPU(tooMuchCpu, testBarService))
 	require.Error(t, validatorEnvironment.HasEnoughMemory(tooMuchMemory, testBarService))
 }
+
+func TestBuiltImageIsNotFetched(t *testing.T) {
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, noEnclaveQuotas, emptyEnclaveQuotasUsage())
+	imageBuildSpec := image_build_spec.NewImageBuildSpec("/kurtosis-data/repositories/my-package/app", "Dockerfile", "")
+
+	validatorEnvironment.AppendRequiredContainerImage(testBuiltImageName)
+	validatorEnvironment.AppendRequiredContainerImage(testFetchedImageName)
+	require.Nil(t, validatorEnvironment.AppendRequiredImageBuild(testBuiltImageName, imageBuildSpec))
+	validatorEnvironment.AppendRequiredContainerImage(testBuiltImageName)
+
+	require.Equal(t, uint32(2), validatorEnvironment.GetNumberOfContainerImages())
+	require.Equal(t, map[string]bool{testFetchedImageName: true}, validatorEnvironment.requiredDockerImages)
+	require.Equal(t, imageBuildSpec, validatorEnvironment.requiredImagesToBuild[testBuiltImageName])
+}
+
+func TestSameImageBuiltFromDifferentSpecsFailsValidation(t *testing.T) {
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, noEnclaveQuotas, emptyEnclaveQuotasUsage())
+	imageBuildSpec := image_build_spec.NewImageBuildSpec("/kurtosis-data/repositories/my-package/app", "Dockerfile", "")
+
+	require.Nil(t, validatorEnvironment.AppendRequiredImageBuild(testBuiltImageName, imageBuildSpec))
+	// the same spec can be used by several services
+	require.Nil(t, validatorEnvironment.AppendRequiredImageBuild(testBuiltImageName, image_build_spec.NewImageBuildSpec("/kurtosis-data/repositories/my-package/app", "Dockerfile", "")))
+
+	require.NotNil(t, validatorEnvironment.AppendRequiredImageBuild(testBuiltImageName, image_build_spec.NewImageBuildSpec("/kurtosis-data/repositories/my-package/other-app", "Dockerfile", "")))
+	require.NotNil(t, validatorEnvironment.AppendRequiredImageBuild(testBuiltImageName, image_build_spec.NewImageBuildSpec("/kurtosis-data/repositories/my-package/app", "Dockerfile", "builder")))
+	require.Equal(t, imageBuildSpec, validatorEnvironment.requiredImagesToBuild[testBuiltImageName])
+}
+
+func TestServiceQuotas(t *testing.T) {
+	enclaveQuotas := enclave_quotas.NewEnclaveQuotas(cpuQuotaMillicpus, memoryQuotaMegabytes, serviceCountQuota, enclave_quotas.NoQuota)
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, enclaveQuotas, emptyEnclaveQuotasUsage())
+
+	require.Error(t, validatorEnvironment.HasEnoughQuota(newTestServiceConfig(t, 0, serviceMemoryMegabytes), testBarService))
+	require.Error(t, validatorEnvironment.HasEnoughQuota(newTestServiceConfig(t, serviceCpuMillicpus, 0), testBarService))
+	require.Error(t, validatorEnvironment.HasEnoughQuota(newTestServiceConfig(t, cpuQuotaMillicpus+1, serviceMemoryMegabytes), testBarService))
+
+	serviceConfig := newTestServiceConfig(t, serviceCpuMillicpus, serviceMemoryMegabytes)
+	require.Nil(t, validatorEnvironment.HasEnoughQuota(serviceConfig, testBarService))
+	validatorEnvironment.ConsumeQuota(serviceConfig, testBarService)
+	require.Nil(t, validatorEnvironment.HasEnoughQuota(serviceConfig, testFooService))
+	validatorEnvironment.ConsumeQuota(serviceConfig, testFooService)
+
+	// replacing a service only counts the difference
+	require.Nil(t, validatorEnvironment.HasEnoughQuota(serviceConfig, testFooService))
+	require.Error(t, validatorEnvironment.HasEnoughQuota(newTestServiceConfig(t, serviceCpuMillicpus+1, serviceMemoryMegabytes), testFooService))
+	require.Error(t, validatorEnvironment.HasEnoughQuota(newTestServiceConfig(t, serviceCpuMillicpus, serviceMemoryMegabytes+1), testFooService))
+
+	require.Error(t, validatorEnvironment.HasEnoughQuota(newTestServiceConfig(t, 1, 1), testBazService))
+	validatorEnvironment.FreeQuota(testFooService)
+	require.Nil(t, validatorEnvironment.HasEnoughQuota(serviceConfig, testBazService))
+}
+
+func TestFilesArtifactsStorageQuota(t *testing.T) {
+	enclaveQuotas := enclave_quotas.NewEnclaveQuotas(enclave_quotas.NoQuota, enclave_quotas.NoQuota, enclave_quotas.NoQuota, storageQuotaBytes)
+	existingArtifactNames := map[string]bool{testArtifactName: true}
+
+	usedUpStorage := enclave_quotas.NewEnclaveQuotasUsage(nil, storageQuotaBytes)
+	validatorEnvironment := NewValidatorEnvironment(nil, existingArtifactNames, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, enclaveQuotas, usedUpStorage)
+	require.Nil(t, validatorEnvironment.HasEnoughFilesArtifactsStorageQuota())
+	validatorEnvironment.AddArtifactName(testArtifactName)
+	require.Error(t, validatorEnvironment.HasEnoughFilesArtifactsStorageQuota())
+
+	storageLeft := enclave_quotas.NewEnclaveQuotasUsage(nil, storageQuotaBytes-1)
+	validatorEnvironment = NewValidatorEnvironment(nil, existingArtifactNames, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, enclaveQuotas, storageLeft)
+	validatorEnvironment.AddArtifactName(testArtifactName)
+	require.Nil(t, validatorEnvironment.HasEnoughFilesArtifactsStorageQuota())
+}
+
+func emptyEnclaveQuotasUsage() *enclave_quotas.EnclaveQuotasUsage {
+	return enclave_quotas.NewEnclaveQuotasUsage(nil, 0)
+}
+
+func newTestServiceConfig(t *testing.T, cpuAllocationMillicpus uint64, memoryAllocationMegabytes uint64) *service.ServiceConfig {
+	serviceConfig, err := service.CreateServiceConfig(testFetchedImageName, nil, nil, nil, nil, nil, nil, nil, nil, cpuAllocationMillicpus, memoryAllocationMegabytes, "", 0, 0, nil)
+	require.NoError(t, err)
+	return serviceConfig
+}
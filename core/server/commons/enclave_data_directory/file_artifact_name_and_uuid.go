@@ -3,6 +3,8 @@ package enclave_data_directory
 type FileNameAndUuid struct {
 	uuid FilesArtifactUUID
 	name string
+	// size of the stored archive, in bytes
+	size uint64
 }
 
 func (nameAndUuid FileNameAndUuid) GetName() string {
@@ -12,3 +14,7 @@ func (nameAndUuid FileNameAndUuid) GetName() string {
 func (nameAndUuid FileNameAndUuid) GetUuid() FilesArtifactUUID {
 	return nameAndUuid.uuid
 }
+
+func (nameAndUuid FileNameAndUuid) GetSize() uint64 {
+	return nameAndUuid.size
+}
@@ -99,6 +99,36 @@ func (cache *FileCache) RemoveFile(key string) error {
 	return nil
 }
 
+// MoveFile replaces the file with the destination key, if any, by the file with the source key
+func (cache *FileCache) MoveFile(srcKey string, destKey string) error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	srcFileObj := cache.getFileObjFromKey(srcKey)
+	if _, err := os.Stat(srcFileObj.absoluteFilepath); os.IsNotExist(err) {
+		return stacktrace.NewError("No file with key '%v' exists in the cache", srcKey)
+	}
+
+	destFileObj := cache.getFileObjFromKey(destKey)
+	if err := os.Rename(srcFileObj.absoluteFilepath, destFileObj.absoluteFilepath); err != nil {
+		return stacktrace.Propagate(err, "There was an error in moving file with key '%v' to key '%v' in the cache", srcKey, destKey)
+	}
+	return nil
+}
+
+// GetFileSize returns the size in bytes of the file with the given key
+func (cache *FileCache) GetFileSize(key string) (uint64, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	fileObj := cache.getFileObjFromKey(key)
+	fileInfo, err := os.Stat(fileObj.absoluteFilepath)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting the size of file with key '%v' in the cache", key)
+	}
+	return uint64(fileInfo.Size()), nil
+}
+
 func (cache *FileCache) getFileObjFromKey(key string) *EnclaveDataDirFile {
 	absoluteFilepath := path.Join(cache.absoluteDirpath, key)
 	relativeFilepath := path.Join(cache.dirpathRelativeToDataDirRoot, key)
@@ -15,6 +15,7 @@ import (
 	"io"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -23,6 +24,17 @@ const (
 	// TODO: this is something we can take a look in detail
 	// but we with random numbers as suffix, we should always be able to have some unique name available
 	maxFileArtifactNameRetriesDefault = 5
+
+	// NoStorageQuota is the storage quota of a store whose files artifacts can use as much storage as they need
+	NoStorageQuota uint64 = 0
+
+	// suffix of the file the new content of a files artifact is written to, before replacing its current content
+	updatedArtifactFilenameSuffix = ".update"
+
+	firstFilesArtifactVersionNumber uint32 = 1
+	// number of versions of a files artifact kept, its current content included. The oldest previous versions are
+	// dropped when a files artifact is updated past that number
+	maxFilesArtifactVersions = 10
 )
 
 type FilesArtifactStore struct {
@@ -31,6 +43,8 @@ type FilesArtifactStore struct {
 	fileArtifactDb                  *file_artifacts_db.FileArtifactPersisted
 	maxRetriesToGetFileArtifactName int
 	generateNatureThemeName         func() string
+	// maximum total size of the stored files artifacts, in bytes
+	storageQuota uint64
 }
 
 func newFilesArtifactStoreFromDb(absoluteDirpath string, dirpathRelativeToDataDirRoot string, db *file_artifacts_db.FileArtifactPersisted) *FilesArtifactStore {
@@ -40,6 +54,7 @@ func newFilesArtifactStoreFromDb(absoluteDirpath string, dirpathRelativeToDataDi
 		maxRetriesToGetFileArtifactName: maxFileArtifactNameRetriesDefault,
 		generateNatureThemeName:         name_generator.GenerateNatureThemeNameForFileArtifacts,
 		fileArtifactDb:                  db,
+		storageQuota:                    NoStorageQuota,
 	}
 }
 
@@ -57,7 +72,34 @@ func NewFilesArtifactStoreForTesting(
 		fileArtifactDb:                  fileArtifactDb,
 		maxRetriesToGetFileArtifactName: maxRetry,
 		generateNatureThemeName:         nameGeneratorMock,
+		storageQuota:                    NoStorageQuota,
+	}
+}
+
+// SetStorageQuota limits the total size of the stored files artifacts, their previous versions included, to the given
+// number of bytes, NoStorageQuota lifting the limit. Files artifacts already stored are kept even if they exceed it, only
+// storing more content fails. Updating a files artifact drops its oldest versions if its new content doesn't fit otherwise
+func (store *FilesArtifactStore) SetStorageQuota(storageQuota uint64) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.storageQuota = storageQuota
+}
+
+func (store FilesArtifactStore) GetStorageQuota() uint64 {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	return store.storageQuota
+}
+
+// GetUsedStorage returns the total size, in bytes, of the stored files artifacts, their previous versions included
+func (store FilesArtifactStore) GetUsedStorage() (uint64, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	usedStorage, err := store.getUsedStorageUnlocked()
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred computing the storage used by the files artifacts")
 	}
+	return usedStorage, nil
 }
 
 // StoreFile Saves file to disk.
@@ -84,17 +126,66 @@ func (store FilesArtifactStore) StoreFile(reader io.Reader, contentMd5 []byte, a
 	return filesArtifactUuid, nil
 }
 
+// UpdateFile replaces the content of the files artifact, keeping its current content as a previous version. The new
+// content is written next to the current one first, so that the files artifact is left untouched if it can't be stored.
+// The oldest versions of the files artifact are dropped when the new content doesn't fit in the storage quota otherwise
 func (store FilesArtifactStore) UpdateFile(filesArtifactUuid FilesArtifactUUID, reader io.Reader, contentMd5 []byte) error {
 	store.mutex.Lock()
 	defer store.mutex.Unlock()
 
-	artifactName, err := store.removeFileUnlocked(filesArtifactUuid)
+	if _, found := store.fileArtifactDb.GetContentMd5(string(filesArtifactUuid)); !found {
+		return stacktrace.NewError("Could not update files artifact '%s' as it doesn't exist in the store", filesArtifactUuid)
+	}
+
+	filename := getFilesArtifactFilename(filesArtifactUuid)
+	updatedFilename := filename + updatedArtifactFilenameSuffix
+	if _, err := store.fileCache.AddFile(updatedFilename, reader); err != nil {
+		return stacktrace.Propagate(err, "Error persisting updated content for files artifact '%s'", filesArtifactUuid)
+	}
+	shouldRemoveUpdatedFile := true
+	defer func() {
+		if shouldRemoveUpdatedFile {
+			if err := store.fileCache.RemoveFile(updatedFilename); err != nil {
+				logrus.Warnf("Error removing the updated content of files artifact '%s' that couldn't be stored. It will keep using storage. Error was:\n%v", filesArtifactUuid, err)
+			}
+		}
+	}()
+	versions := store.getVersionsUnlocked(filesArtifactUuid)
+	numVersionsToDropForStorageQuota, err := store.getNumVersionsToDropForStorageQuotaUnlocked(filesArtifactUuid, versions, updatedFilename)
 	if err != nil {
-		return stacktrace.Propagate(err, "Error removing the files artifact '%s' prior to persisting its new content", filesArtifactUuid)
+		return stacktrace.Propagate(err, "Error storing the updated content of files artifact '%s'", filesArtifactUuid)
 	}
-	if err = store.storeFilesToArtifactUuidUnlocked(artifactName, filesArtifactUuid, reader, contentMd5); err != nil {
+
+	currentVersion := versions[len(versions)-1]
+	currentVersionFilename := getFilesArtifactVersionFilename(filesArtifactUuid, currentVersion.Number)
+	if err := store.fileCache.MoveFile(filename, currentVersionFilename); err != nil {
+		return stacktrace.Propagate(err, "Error keeping the current content of files artifact '%s' as a previous version", filesArtifactUuid)
+	}
+	if err := store.fileCache.MoveFile(updatedFilename, filename); err != nil {
+		if restoreErr := store.fileCache.MoveFile(currentVersionFilename, filename); restoreErr != nil {
+			logrus.Errorf("Error restoring the current content of files artifact '%s' after failing to update it. Its content is lost. Error was:\n%v", filesArtifactUuid, restoreErr)
+		}
 		return stacktrace.Propagate(err, "Error persisting updated content for files artifact '%s'", filesArtifactUuid)
 	}
+	shouldRemoveUpdatedFile = false
+
+	versions = append(versions, &file_artifacts_db.FileArtifactVersion{
+		Number:       currentVersion.Number + 1,
+		ContentMd5:   contentMd5,
+		CreationTime: time.Now(),
+	})
+	for numDroppedVersions := 0; numDroppedVersions < numVersionsToDropForStorageQuota || len(versions) > maxFilesArtifactVersions; numDroppedVersions++ {
+		droppedVersionFilename := getFilesArtifactVersionFilename(filesArtifactUuid, versions[0].Number)
+		if err := store.fileCache.RemoveFile(droppedVersionFilename); err != nil {
+			logrus.Warnf("Error removing version '%d' of files artifact '%s' that is no longer kept. It will keep using storage. Error was:\n%v", versions[0].Number, filesArtifactUuid, err)
+		}
+		versions = versions[1:]
+	}
+	store.fileArtifactDb.SetVersions(string(filesArtifactUuid), versions)
+	store.fileArtifactDb.SetContentMd5(string(filesArtifactUuid), contentMd5)
+	if err := store.fileArtifactDb.Persist(); err != nil {
+		return stacktrace.Propagate(err, "Failed persisting data on file artifacts db")
+	}
 	return nil
 }
 
@@ -129,6 +220,67 @@ func (store FilesArtifactStore) GetFile(artifactIdentifier string) (FilesArtifac
 	return "", nil, nil, false, nil
 }
 
+// GetFileVersion Get the given version of the file by uuid, then by shortened uuid and finally by name
+func (store FilesArtifactStore) GetFileVersion(artifactIdentifier string, versionNumber uint32) (FilesArtifactUUID, *EnclaveDataDirFile, []byte, bool, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	filesArtifactUuid, found, err := store.getFilesArtifactUuidUnlocked(artifactIdentifier)
+	if err != nil {
+		return "", nil, nil, false, stacktrace.Propagate(err, "An error occurred getting the UUID of files artifact '%v'", artifactIdentifier)
+	}
+	if !found {
+		return "", nil, nil, false, nil
+	}
+
+	versions := store.getVersionsUnlocked(filesArtifactUuid)
+	for versionIdx, version := range versions {
+		if version.Number != versionNumber {
+			continue
+		}
+		filename := getStoredVersionFilename(filesArtifactUuid, versions, versionIdx)
+		enclaveDataDirFile, err := store.fileCache.GetFile(filename)
+		if err != nil {
+			return "", nil, nil, false, stacktrace.Propagate(err, "Could not retrieve file with filename '%s' from the file cache", filename)
+		}
+		return filesArtifactUuid, enclaveDataDirFile, version.ContentMd5, true, nil
+	}
+	return "", nil, nil, false, stacktrace.NewError(
+		"Files artifact '%v' has no version '%d', the versions kept being %d to %d",
+		artifactIdentifier,
+		versionNumber,
+		versions[0].Number,
+		versions[len(versions)-1].Number,
+	)
+}
+
+// GetFileVersions Get the versions of the file by uuid, then by shortened uuid and finally by name, from the oldest
+// kept to the current one
+func (store FilesArtifactStore) GetFileVersions(artifactIdentifier string) (FilesArtifactUUID, []FilesArtifactVersion, bool, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	filesArtifactUuid, found, err := store.getFilesArtifactUuidUnlocked(artifactIdentifier)
+	if err != nil {
+		return "", nil, false, stacktrace.Propagate(err, "An error occurred getting the UUID of files artifact '%v'", artifactIdentifier)
+	}
+	if !found {
+		return "", nil, false, nil
+	}
+
+	persistedVersions := store.getVersionsUnlocked(filesArtifactUuid)
+	versions := []FilesArtifactVersion{}
+	for idx, persistedVersion := range persistedVersions {
+		versions = append(versions, FilesArtifactVersion{
+			number:       persistedVersion.Number,
+			contentMd5:   persistedVersion.ContentMd5,
+			creationTime: persistedVersion.CreationTime,
+			isCurrent:    idx == len(persistedVersions)-1,
+		})
+	}
+	return filesArtifactUuid, versions, true, nil
+}
+
 // RemoveFile Remove the file by uuid, then by shortened uuid and then by name
 func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedErr error) {
 	store.mutex.Lock()
@@ -138,7 +290,7 @@ func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedEr
 	filesArtifactUuid = FilesArtifactUUID(artifactIdentifier)
 	_, err := store.removeFileUnlocked(filesArtifactUuid)
 	if err == nil {
-		return nil
+		return store.persistRemovalUnlocked()
 	}
 
 	filesArtifactUuids, found := store.fileArtifactDb.GetFullUuid(artifactIdentifier)
@@ -151,7 +303,7 @@ func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedEr
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing files artifact with UUID '%s'", filesArtifactUuid)
 		}
-		return nil
+		return store.persistRemovalUnlocked()
 	}
 
 	filesArtifactUuidGet, found := store.fileArtifactDb.GetArtifactUuid(artifactIdentifier)
@@ -160,7 +312,7 @@ func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedEr
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing files artifact with UUID '%s'", filesArtifactUuid)
 		}
-		return nil
+		return store.persistRemovalUnlocked()
 	}
 
 	if err := store.fileArtifactDb.Persist(); err != nil {
@@ -180,9 +332,14 @@ func (store FilesArtifactStore) GetFileNamesAndUuids() []FileNameAndUuid {
 	defer store.mutex.RUnlock()
 	var nameAndUuids []FileNameAndUuid
 	for artifactName, artifactUuid := range store.fileArtifactDb.GetArtifactUuidMap() {
+		size, err := store.fileCache.GetFileSize(getFilesArtifactFilename(FilesArtifactUUID(artifactUuid)))
+		if err != nil {
+			logrus.Warnf("Couldn't get the size of files artifact '%s', it will be reported as empty. Error was:\n%v", artifactName, err)
+		}
 		nameAndUuid := FileNameAndUuid{
 			uuid: FilesArtifactUUID(artifactUuid),
 			name: artifactName,
+			size: size,
 		}
 		nameAndUuids = append(nameAndUuids, nameAndUuid)
 	}
@@ -228,18 +385,131 @@ func (store FilesArtifactStore) GenerateUniqueNameForFileArtifact() string {
 // storeFilesToArtifactUuidUnlocked this is an non thread method to be used from thread safe contexts
 func (store FilesArtifactStore) storeFilesToArtifactUuidUnlocked(filesArtifactName string, filesArtifactUuid FilesArtifactUUID, reader io.Reader, contentMd5 []byte) error {
 
-	filename := strings.Join(
-		[]string{string(filesArtifactUuid), artifactExtension},
-		".",
-	)
+	filename := getFilesArtifactFilename(filesArtifactUuid)
 	_, err := store.fileCache.AddFile(filename, reader)
 	if err != nil {
 		return stacktrace.Propagate(err, "Could not store file '%s' to the file cache", filename)
 	}
+	if err = store.checkStorageQuotaUnlocked(filename); err != nil {
+		if removeErr := store.fileCache.RemoveFile(filename); removeErr != nil {
+			logrus.Warnf("Error removing file '%s' that exceeds the storage quota from the file cache. It will keep using storage. Error was:\n%v", filename, removeErr)
+		}
+		return stacktrace.Propagate(err, "Could not store file '%s' to the file cache", filename)
+	}
+	store.registerFilesArtifactUnlocked(filesArtifactName, filesArtifactUuid, contentMd5)
+	return nil
+}
+
+// registerFilesArtifactUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) registerFilesArtifactUnlocked(filesArtifactName string, filesArtifactUuid FilesArtifactUUID, contentMd5 []byte) {
 	shortenedUuidSlice, _ := store.fileArtifactDb.GetFullUuid(uuid_generator.ShortenedUUIDString(string(filesArtifactUuid)))
 	store.fileArtifactDb.SetFullUuid(uuid_generator.ShortenedUUIDString(string(filesArtifactUuid)), append(shortenedUuidSlice, string(filesArtifactUuid)))
 	store.fileArtifactDb.SetContentMd5(string(filesArtifactUuid), contentMd5)
 	store.fileArtifactDb.SetArtifactUuid(filesArtifactName, string(filesArtifactUuid))
+	store.fileArtifactDb.SetVersions(string(filesArtifactUuid), []*file_artifacts_db.FileArtifactVersion{
+		{
+			Number:       firstFilesArtifactVersionNumber,
+			ContentMd5:   contentMd5,
+			CreationTime: time.Now(),
+		},
+	})
+}
+
+// checkStorageQuotaUnlocked returns an error if adding the file with the given filename to the store exceeds the storage
+// quota. This is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) checkStorageQuotaUnlocked(filename string) error {
+	if store.storageQuota == NoStorageQuota {
+		return nil
+	}
+	fileSize, err := store.fileCache.GetFileSize(filename)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the size of file '%s'", filename)
+	}
+	usedStorage, err := store.getUsedStorageUnlocked()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred computing the storage used by the files artifacts")
+	}
+	if usedStorage+fileSize > store.storageQuota {
+		return newStorageQuotaExceededError(fileSize, store.storageQuota, usedStorage)
+	}
+	return nil
+}
+
+// getNumVersionsToDropForStorageQuotaUnlocked returns how many of the oldest of the given versions of the files
+// artifact, its current content included, must be dropped for its updated content, written to the file with the given
+// filename, to fit in the storage quota. An error is returned if it doesn't fit even once all of them are dropped. This
+// is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getNumVersionsToDropForStorageQuotaUnlocked(
+	filesArtifactUuid FilesArtifactUUID,
+	versions []*file_artifacts_db.FileArtifactVersion,
+	updatedFilename string,
+) (int, error) {
+	if store.storageQuota == NoStorageQuota {
+		return 0, nil
+	}
+	updatedFileSize, err := store.fileCache.GetFileSize(updatedFilename)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting the size of file '%s'", updatedFilename)
+	}
+	usedStorage, err := store.getUsedStorageUnlocked()
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred computing the storage used by the files artifacts")
+	}
+
+	usedStorageOnceDropped := usedStorage
+	numVersionsToDrop := 0
+	for usedStorageOnceDropped+updatedFileSize > store.storageQuota {
+		if numVersionsToDrop == len(versions) {
+			return 0, newStorageQuotaExceededError(updatedFileSize, store.storageQuota, usedStorage)
+		}
+		versionFilename := getStoredVersionFilename(filesArtifactUuid, versions, numVersionsToDrop)
+		versionSize, err := store.fileCache.GetFileSize(versionFilename)
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred getting the size of version '%d' of files artifact '%s'", versions[numVersionsToDrop].Number, filesArtifactUuid)
+		}
+		usedStorageOnceDropped -= versionSize
+		numVersionsToDrop++
+	}
+	if numVersionsToDrop > 0 {
+		logrus.Infof("Dropping the %d oldest version(s) of files artifact '%s' for its updated content to fit in the files artifacts storage quota", numVersionsToDrop, filesArtifactUuid)
+	}
+	return numVersionsToDrop, nil
+}
+
+// getUsedStorageUnlocked returns the total size of the stored files artifacts, their previous versions included. This
+// is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getUsedStorageUnlocked() (uint64, error) {
+	usedStorage := uint64(0)
+	for _, artifactUuid := range store.fileArtifactDb.GetArtifactUuidMap() {
+		filesArtifactUuid := FilesArtifactUUID(artifactUuid)
+		versions := store.getVersionsUnlocked(filesArtifactUuid)
+		for versionIdx, version := range versions {
+			versionSize, err := store.fileCache.GetFileSize(getStoredVersionFilename(filesArtifactUuid, versions, versionIdx))
+			if err != nil {
+				return 0, stacktrace.Propagate(err, "An error occurred getting the size of version '%d' of files artifact '%s'", version.Number, artifactUuid)
+			}
+			usedStorage += versionSize
+		}
+	}
+	return usedStorage, nil
+}
+
+func newStorageQuotaExceededError(fileSize uint64, storageQuota uint64, usedStorage uint64) error {
+	return stacktrace.NewError(
+		"Storing %d more bytes of files artifacts would exceed the files artifacts storage quota of %d bytes, as "+
+			"%d bytes are already used, previous versions of the files artifacts included. Remove the files artifacts "+
+			"that are no longer needed to free up storage",
+		fileSize,
+		storageQuota,
+		usedStorage,
+	)
+}
+
+// persistRemovalUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) persistRemovalUnlocked() error {
+	if err := store.fileArtifactDb.Persist(); err != nil {
+		return stacktrace.Propagate(err, "Failed persisting data on file artifacts db")
+	}
 	return nil
 }
 
@@ -249,10 +519,7 @@ func (store FilesArtifactStore) getFileUnlocked(filesArtifactUuid FilesArtifactU
 	if !found {
 		return "", nil, nil, false, stacktrace.NewError("Could not retrieve file with ID '%s' as it doesn't exist in the store", filesArtifactUuid)
 	}
-	filename := strings.Join(
-		[]string{string(filesArtifactUuid), artifactExtension},
-		".",
-	)
+	filename := getFilesArtifactFilename(filesArtifactUuid)
 	enclaveDataDirFile, err := store.fileCache.GetFile(filename)
 	if err != nil {
 		return "", nil, nil, false, stacktrace.Propagate(err, "Could not retrieve file with filename '%s' from the file cache", filename)
@@ -262,15 +529,20 @@ func (store FilesArtifactStore) getFileUnlocked(filesArtifactUuid FilesArtifactU
 
 // removeFileUnlocked this is not thread safe, must be used from a thread safe context
 func (store FilesArtifactStore) removeFileUnlocked(filesArtifactUuid FilesArtifactUUID) (string, error) {
-	filename := strings.Join(
-		[]string{string(filesArtifactUuid), artifactExtension},
-		".",
-	)
+	filename := getFilesArtifactFilename(filesArtifactUuid)
 
 	var artifactName string
 	if err := store.fileCache.RemoveFile(filename); err != nil {
 		return "", stacktrace.Propagate(err, "There was an error in removing '%v' from the file store", filename)
 	}
+	versions := store.getVersionsUnlocked(filesArtifactUuid)
+	for _, previousVersion := range versions[:len(versions)-1] {
+		previousVersionFilename := getFilesArtifactVersionFilename(filesArtifactUuid, previousVersion.Number)
+		if err := store.fileCache.RemoveFile(previousVersionFilename); err != nil {
+			logrus.Warnf("Error removing version '%d' of removed files artifact '%s'. It will keep using storage. Error was:\n%v", previousVersion.Number, filesArtifactUuid, err)
+		}
+	}
+	store.fileArtifactDb.DeleteVersions(string(filesArtifactUuid))
 	for name, artifactUuid := range store.fileArtifactDb.GetArtifactUuidMap() {
 		if artifactUuid == string(filesArtifactUuid) {
 			artifactName = name
@@ -298,3 +570,62 @@ func (store FilesArtifactStore) removeFileUnlocked(filesArtifactUuid FilesArtifa
 
 	return artifactName, nil
 }
+
+// getFilesArtifactUuidUnlocked resolves the identifier as a uuid, then as a shortened uuid and finally as a name. This is
+// not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getFilesArtifactUuidUnlocked(artifactIdentifier string) (FilesArtifactUUID, bool, error) {
+	if _, found := store.fileArtifactDb.GetContentMd5(artifactIdentifier); found {
+		return FilesArtifactUUID(artifactIdentifier), true, nil
+	}
+	if filesArtifactUuids, found := store.fileArtifactDb.GetFullUuid(artifactIdentifier); found {
+		if len(filesArtifactUuids) > maxAllowedMatchesAgainstShortenedUuid {
+			return "", false, stacktrace.NewError("Tried using the shortened uuid '%v' to get file but found multiple matches '%v'. Use a complete uuid to be specific about what to get.", artifactIdentifier, filesArtifactUuids)
+		}
+		return FilesArtifactUUID(filesArtifactUuids[0]), true, nil
+	}
+	if filesArtifactUuid, found := store.fileArtifactDb.GetArtifactUuid(artifactIdentifier); found {
+		return FilesArtifactUUID(filesArtifactUuid), true, nil
+	}
+	return "", false, nil
+}
+
+// getVersionsUnlocked returns the versions of the files artifact, which has a single version with an unknown creation
+// time if it was stored before versions were tracked. This is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getVersionsUnlocked(filesArtifactUuid FilesArtifactUUID) []*file_artifacts_db.FileArtifactVersion {
+	versions, found := store.fileArtifactDb.GetVersions(string(filesArtifactUuid))
+	if found && len(versions) > 0 {
+		return versions
+	}
+	contentMd5, _ := store.fileArtifactDb.GetContentMd5(string(filesArtifactUuid))
+	return []*file_artifacts_db.FileArtifactVersion{
+		{
+			Number:       firstFilesArtifactVersionNumber,
+			ContentMd5:   contentMd5,
+			CreationTime: time.Time{},
+		},
+	}
+}
+
+func getFilesArtifactFilename(filesArtifactUuid FilesArtifactUUID) string {
+	return strings.Join(
+		[]string{string(filesArtifactUuid), artifactExtension},
+		".",
+	)
+}
+
+// getStoredVersionFilename returns the name of the file the version of the files artifact at the given index of its
+// versions is stored in, the current version being stored as the files artifact itself
+func getStoredVersionFilename(filesArtifactUuid FilesArtifactUUID, versions []*file_artifacts_db.FileArtifactVersion, versionIdx int) string {
+	if versionIdx == len(versions)-1 {
+		return getFilesArtifactFilename(filesArtifactUuid)
+	}
+	return getFilesArtifactVersionFilename(filesArtifactUuid, versions[versionIdx].Number)
+}
+
+// getFilesArtifactVersionFilename returns the name of the file a previous version of the files artifact is kept in
+func getFilesArtifactVersionFilename(filesArtifactUuid FilesArtifactUUID, versionNumber uint32) string {
+	return strings.Join(
+		[]string{string(filesArtifactUuid), fmt.Sprintf("v%d", versionNumber), artifactExtension},
+		".",
+	)
+}
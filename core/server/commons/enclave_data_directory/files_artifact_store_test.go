@@ -6,6 +6,7 @@
 package enclave_data_directory
 
 import (
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/file_artifacts_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
 	"github.com/stretchr/testify/require"
@@ -179,8 +180,225 @@ func TestFilesArtifactStore_GetFileNamesAndUuids(t *testing.T) {
 
 	fileNameAndUuids := fileStore.GetFileNamesAndUuids()
 	require.Len(t, fileNameAndUuids, 2)
-	require.Contains(t, fileNameAndUuids, FileNameAndUuid{uuid: uuid, name: testArtifact1})
-	require.Contains(t, fileNameAndUuids, FileNameAndUuid{uuid: anotherUUID, name: testArtifact2})
+	require.Contains(t, fileNameAndUuids, FileNameAndUuid{uuid: uuid, name: testArtifact1, size: uint64(len(testContent))})
+	require.Contains(t, fileNameAndUuids, FileNameAndUuid{uuid: anotherUUID, name: testArtifact2, size: uint64(len(otherTestContent))})
+}
+
+func TestFileStore_UpdateFileReplacesContent(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	testArtifactName := "test-artifact"
+	uuid, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), []byte("blah"), testArtifactName)
+	require.Nil(t, err)
+
+	updatedTestContent := "Long Live Kurtosis, Updated!"
+	updatedFakeMd5 := []byte("blah2")
+	err = fileStore.UpdateFile(uuid, strings.NewReader(updatedTestContent), updatedFakeMd5)
+	require.Nil(t, err)
+
+	returnedUuid, enclaveDataFile, returnedMd5, found, err := fileStore.GetFile(testArtifactName)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, uuid, returnedUuid)
+	require.Equal(t, updatedFakeMd5, returnedMd5)
+	file, readErr := os.ReadFile(enclaveDataFile.absoluteFilepath)
+	require.Nil(t, readErr)
+	require.Equal(t, []byte(updatedTestContent), file)
+
+	// the replaced content is kept as the previous version
+	cacheContent, err := os.ReadDir(fileStore.fileCache.absoluteDirpath)
+	require.Nil(t, err)
+	require.Len(t, cacheContent, 2)
+}
+
+func TestFileStore_UpdateFileKeepsPreviousVersions(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	testArtifactName := "test-artifact"
+	testContent := "Long Live Kurtosis!"
+	fakeMd5 := []byte("blah")
+	uuid, err := fileStore.StoreFile(strings.NewReader(testContent), fakeMd5, testArtifactName)
+	require.Nil(t, err)
+
+	updatedTestContent := "Long Live Kurtosis, Updated!"
+	updatedFakeMd5 := []byte("blah2")
+	err = fileStore.UpdateFile(uuid, strings.NewReader(updatedTestContent), updatedFakeMd5)
+	require.Nil(t, err)
+
+	returnedUuid, versions, found, err := fileStore.GetFileVersions(testArtifactName)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, uuid, returnedUuid)
+	require.Len(t, versions, 2)
+	require.Equal(t, uint32(1), versions[0].GetNumber())
+	require.Equal(t, fakeMd5, versions[0].GetContentMd5())
+	require.False(t, versions[0].IsCurrent())
+	require.Equal(t, uint32(2), versions[1].GetNumber())
+	require.Equal(t, updatedFakeMd5, versions[1].GetContentMd5())
+	require.True(t, versions[1].IsCurrent())
+
+	_, enclaveDataFile, returnedMd5, found, err := fileStore.GetFileVersion(testArtifactName, 1)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, fakeMd5, returnedMd5)
+	file, readErr := os.ReadFile(enclaveDataFile.absoluteFilepath)
+	require.Nil(t, readErr)
+	require.Equal(t, []byte(testContent), file)
+
+	_, enclaveDataFile, returnedMd5, found, err = fileStore.GetFileVersion(testArtifactName, 2)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, updatedFakeMd5, returnedMd5)
+	file, readErr = os.ReadFile(enclaveDataFile.absoluteFilepath)
+	require.Nil(t, readErr)
+	require.Equal(t, []byte(updatedTestContent), file)
+
+	_, _, _, _, err = fileStore.GetFileVersion(testArtifactName, 3)
+	require.NotNil(t, err)
+
+	// removing the files artifact removes its previous versions too
+	err = fileStore.RemoveFile(testArtifactName)
+	require.Nil(t, err)
+	cacheContent, err := os.ReadDir(fileStore.fileCache.absoluteDirpath)
+	require.Nil(t, err)
+	require.Empty(t, cacheContent)
+}
+
+func TestFileStore_UpdateFileDropsOldestVersions(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	testArtifactName := "test-artifact"
+	uuid, err := fileStore.StoreFile(strings.NewReader("version 1"), []byte("1"), testArtifactName)
+	require.Nil(t, err)
+
+	numberOfUpdates := maxFilesArtifactVersions + 2
+	for versionNumber := 2; versionNumber <= numberOfUpdates+1; versionNumber++ {
+		err = fileStore.UpdateFile(uuid, strings.NewReader(fmt.Sprintf("version %d", versionNumber)), []byte(fmt.Sprint(versionNumber)))
+		require.Nil(t, err)
+	}
+
+	_, versions, found, err := fileStore.GetFileVersions(testArtifactName)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Len(t, versions, maxFilesArtifactVersions)
+	require.Equal(t, uint32(numberOfUpdates+1-maxFilesArtifactVersions+1), versions[0].GetNumber())
+	require.Equal(t, uint32(numberOfUpdates+1), versions[len(versions)-1].GetNumber())
+
+	_, _, _, _, err = fileStore.GetFileVersion(testArtifactName, 1)
+	require.NotNil(t, err)
+	cacheContent, err := os.ReadDir(fileStore.fileCache.absoluteDirpath)
+	require.Nil(t, err)
+	require.Len(t, cacheContent, maxFilesArtifactVersions)
+}
+
+func TestFileStore_StoreFileFailsWhenExceedingStorageQuota(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	fileStore.SetStorageQuota(30)
+	fakeMd5 := []byte("blah")
+
+	_, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), fakeMd5, "test-artifact-1")
+	require.Nil(t, err)
+	usedStorage, err := fileStore.GetUsedStorage()
+	require.Nil(t, err)
+	require.NotZero(t, usedStorage)
+
+	_, err = fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), fakeMd5, "test-artifact-2")
+	require.NotNil(t, err)
+	require.False(t, fileStore.CheckIfArtifactNameExists("test-artifact-2"))
+	cacheContent, err := os.ReadDir(fileStore.fileCache.absoluteDirpath)
+	require.Nil(t, err)
+	require.Len(t, cacheContent, 1)
+
+	// removing a files artifact frees up storage
+	err = fileStore.RemoveFile("test-artifact-1")
+	require.Nil(t, err)
+	_, err = fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), fakeMd5, "test-artifact-2")
+	require.Nil(t, err)
+}
+
+func TestFileStore_UpdateFileKeepsContentWhenExceedingStorageQuota(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	fileStore.SetStorageQuota(30)
+	testContent := "Long Live Kurtosis!"
+	testArtifactName := "test-artifact"
+	fakeMd5 := []byte("blah")
+	uuid, err := fileStore.StoreFile(strings.NewReader(testContent), fakeMd5, testArtifactName)
+	require.Nil(t, err)
+
+	// the replaced content is dropped for the updated content to fit in the quota
+	updatedTestContent := "Long Live Kurtosis, Updated!"
+	err = fileStore.UpdateFile(uuid, strings.NewReader(updatedTestContent), fakeMd5)
+	require.Nil(t, err)
+	_, versions, found, err := fileStore.GetFileVersions(testArtifactName)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Len(t, versions, 1)
+
+	err = fileStore.UpdateFile(uuid, strings.NewReader("Long Live Kurtosis, Updated Beyond The Quota!"), []byte("blah2"))
+	require.NotNil(t, err)
+
+	_, enclaveDataFile, returnedMd5, found, err := fileStore.GetFile(testArtifactName)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, fakeMd5, returnedMd5)
+	file, readErr := os.ReadFile(enclaveDataFile.absoluteFilepath)
+	require.Nil(t, readErr)
+	require.Equal(t, []byte(updatedTestContent), file)
+}
+
+func TestFileStore_PreviousVersionsCountTowardsStorageQuota(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	fileStore.SetStorageQuota(40)
+	testArtifactName := "test-artifact"
+	uuid, err := fileStore.StoreFile(strings.NewReader("version 1"), []byte("1"), testArtifactName)
+	require.Nil(t, err)
+	err = fileStore.UpdateFile(uuid, strings.NewReader("version 2"), []byte("2"))
+	require.Nil(t, err)
+	err = fileStore.UpdateFile(uuid, strings.NewReader("version 3"), []byte("3"))
+	require.Nil(t, err)
+
+	usedStorage, err := fileStore.GetUsedStorage()
+	require.Nil(t, err)
+	require.Equal(t, uint64(3*len("version 1")), usedStorage)
+
+	_, err = fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), []byte("blah"), "other-test-artifact")
+	require.NotNil(t, err)
+	require.False(t, fileStore.CheckIfArtifactNameExists("other-test-artifact"))
+}
+
+func TestFileStore_UpdateFileDropsOldestVersionsToFitStorageQuota(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	fileStore.SetStorageQuota(40)
+	testArtifactName := "test-artifact"
+	uuid, err := fileStore.StoreFile(strings.NewReader("version 1"), []byte("1"), testArtifactName)
+	require.Nil(t, err)
+
+	// 4 versions of 9 bytes fit in the quota, the 5th one only does once the oldest version is dropped
+	numberOfUpdates := 4
+	for versionNumber := 2; versionNumber <= numberOfUpdates+1; versionNumber++ {
+		err = fileStore.UpdateFile(uuid, strings.NewReader(fmt.Sprintf("version %d", versionNumber)), []byte(fmt.Sprint(versionNumber)))
+		require.Nil(t, err)
+	}
+
+	_, versions, found, err := fileStore.GetFileVersions(testArtifactName)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Len(t, versions, 4)
+	require.Equal(t, uint32(2), versions[0].GetNumber())
+	require.Equal(t, uint32(numberOfUpdates+1), versions[len(versions)-1].GetNumber())
+
+	_, _, _, _, err = fileStore.GetFileVersion(testArtifactName, 1)
+	require.NotNil(t, err)
+	cacheContent, err := os.ReadDir(fileStore.fileCache.absoluteDirpath)
+	require.Nil(t, err)
+	require.Len(t, cacheContent, 4)
+	usedStorage, err := fileStore.GetUsedStorage()
+	require.Nil(t, err)
+	require.Equal(t, uint64(4*len("version 1")), usedStorage)
 }
 
 func getTestFileStore(t *testing.T) (*FilesArtifactStore, func()) {
@@ -0,0 +1,27 @@
+package enclave_data_directory
+
+import "time"
+
+type FilesArtifactVersion struct {
+	number     uint32
+	contentMd5 []byte
+	// time the version was stored, zero for the first version of files artifacts stored before versions were tracked
+	creationTime time.Time
+	isCurrent    bool
+}
+
+func (version FilesArtifactVersion) GetNumber() uint32 {
+	return version.number
+}
+
+func (version FilesArtifactVersion) GetContentMd5() []byte {
+	return version.contentMd5
+}
+
+func (version FilesArtifactVersion) GetCreationTime() time.Time {
+	return version.creationTime
+}
+
+func (version FilesArtifactVersion) IsCurrent() bool {
+	return version.isCurrent
+}
@@ -0,0 +1,48 @@
+package yaml_parser
+
+import (
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+)
+
+// KurtosisLock pins the packages a package imports, keyed by repository, to a commit
+type KurtosisLock struct {
+	Dependencies map[string]string `yaml:"dependencies"`
+}
+
+// GetDependencies returns the locked commit of every repository. It never returns nil for a parsed lock
+func (lock *KurtosisLock) GetDependencies() map[string]string {
+	if lock == nil {
+		return nil
+	}
+	if lock.Dependencies == nil {
+		return map[string]string{}
+	}
+	return lock.Dependencies
+}
+
+// This method assumes that the kurtosis.lock exists in the path provided.
+func parseKurtosisLockInternal(absPathToKurtosisLock string, read func(filename string) ([]byte, error)) (*KurtosisLock, error) {
+	kurtosisLockContent, err := read(absPathToKurtosisLock)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error occurred while reading the contents of '%v'", absPathToKurtosisLock)
+	}
+
+	var kurtosisLock KurtosisLock
+	if err = yaml.UnmarshalStrict(kurtosisLockContent, &kurtosisLock); err != nil {
+		return nil, stacktrace.Propagate(err, "Error occurred while analyzing the contents of '%v'", absPathToKurtosisLock)
+	}
+	for repository, commit := range kurtosisLock.Dependencies {
+		if commit == "" {
+			return nil, stacktrace.NewError("Package dependency '%v' has no commit in '%v'", repository, absPathToKurtosisLock)
+		}
+	}
+	logrus.Debugf("parsed kurtosis.lock '%+v'", kurtosisLock)
+	return &kurtosisLock, nil
+}
+
+func ParseKurtosisLock(absPathToKurtosisLock string) (*KurtosisLock, error) {
+	return parseKurtosisLockInternal(absPathToKurtosisLock, os.ReadFile)
+}
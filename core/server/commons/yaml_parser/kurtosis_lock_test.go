@@ -0,0 +1,69 @@
+package yaml_parser
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	kurtosisLockPath      = "/root/kurtosis.lock"
+	sampleCorrectLockYaml = []byte(`
+# This file is generated by 'kurtosis package lock'
+dependencies:
+  github.com/kurtosis-tech/prometheus-package: 6e5a5d4e8cdc6a3c4d4e1a4c0ef0e26e5a0b1e2d
+  github.com/kurtosis-tech/postgres-package: 1f2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d
+`)
+	sampleEmptyLockYaml     = []byte(`dependencies: {}`)
+	sampleMissingCommitYaml = []byte(`
+dependencies:
+  github.com/kurtosis-tech/prometheus-package: ""
+`)
+	sampleIncorrectKeyLockYaml = []byte(`dependency: {}`)
+)
+
+func Test_parseKurtosisLockInternal_Success(t *testing.T) {
+	mockRead := func(filename string) ([]byte, error) {
+		return sampleCorrectLockYaml, nil
+	}
+
+	actual, err := parseKurtosisLockInternal(kurtosisLockPath, mockRead)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		"github.com/kurtosis-tech/prometheus-package": "6e5a5d4e8cdc6a3c4d4e1a4c0ef0e26e5a0b1e2d",
+		"github.com/kurtosis-tech/postgres-package":   "1f2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d",
+	}, actual.GetDependencies())
+}
+
+func Test_parseKurtosisLockInternal_EmptyDependencies(t *testing.T) {
+	mockRead := func(filename string) ([]byte, error) {
+		return sampleEmptyLockYaml, nil
+	}
+
+	actual, err := parseKurtosisLockInternal(kurtosisLockPath, mockRead)
+	require.Nil(t, err)
+	require.NotNil(t, actual.GetDependencies())
+	require.Empty(t, actual.GetDependencies())
+}
+
+func Test_parseKurtosisLockInternal_MissingCommit(t *testing.T) {
+	mockRead := func(filename string) ([]byte, error) {
+		return sampleMissingCommitYaml, nil
+	}
+
+	_, err := parseKurtosisLockInternal(kurtosisLockPath, mockRead)
+	require.ErrorContains(t, err, "Package dependency 'github.com/kurtosis-tech/prometheus-package' has no commit")
+}
+
+func Test_parseKurtosisLockInternal_IncorrectKey(t *testing.T) {
+	mockRead := func(filename string) ([]byte, error) {
+		return sampleIncorrectKeyLockYaml, nil
+	}
+
+	_, err := parseKurtosisLockInternal(kurtosisLockPath, mockRead)
+	require.NotNil(t, err)
+}
+
+func Test_KurtosisLockGetDependencies_NilLock(t *testing.T) {
+	var lock *KurtosisLock
+	require.Nil(t, lock.GetDependencies())
+}
@@ -14,6 +14,7 @@ import (
 	"io"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -21,6 +22,9 @@ const (
 	newlineRune    = '\n'
 )
 
+// the container logs returned by the backend don't carry the time they were emitted at
+var logLineWithoutTimestamp = time.Time{}
+
 type kurtosisBackendLogsDatabaseClient struct {
 	kurtosisBackend backend_interface.KurtosisBackend
 }
@@ -36,6 +40,8 @@ func (client *kurtosisBackendLogsDatabaseClient) StreamUserServiceLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	userServiceUuids map[service.ServiceUUID]bool,
 	conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
+	conjunctiveLogLineFieldFilters logline.ConjunctiveLogLineFieldFilters,
+	timeRange *logline.LogLineTimeRange, // unimplemented for kurtosis backend logs db client, the lines don't have timestamps
 	shouldFollowLogs bool,
 	shouldReturnAllLogs bool, // unimplemented for kurtosis backend logs db
 	numLogLines uint32, // unimplemented for kurtosis backend logs db client
@@ -60,6 +66,12 @@ func (client *kurtosisBackendLogsDatabaseClient) StreamUserServiceLogs(
 		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating conjunctive log line filter with regex from filters '%+v'", conjunctiveLogLineFilters)
 	}
 
+	conjunctiveLogFieldFiltersWithPath, err := logline.NewConjunctiveLogFieldFiltersWithPath(conjunctiveLogLineFieldFilters)
+	if err != nil {
+		cancelCtxFunc()
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating conjunctive log line field filters with path from field filters '%+v'", conjunctiveLogLineFieldFilters)
+	}
+
 	successfulUserServiceLogs, erroredUserServiceUuids, err := client.kurtosisBackend.GetUserServiceLogs(ctx, enclaveUuid, userServiceFilters, shouldFollowLogs)
 	if err != nil {
 		cancelCtxFunc()
@@ -105,6 +117,7 @@ func (client *kurtosisBackendLogsDatabaseClient) StreamUserServiceLogs(
 			serviceUuid,
 			serviceReadCloser,
 			conjunctiveLogFiltersWithRegex,
+			conjunctiveLogFieldFiltersWithPath,
 		)
 	}
 
@@ -168,6 +181,7 @@ func streamServiceLogLines(
 	serviceUuid service.ServiceUUID,
 	userServiceReadCloserLog io.ReadCloser,
 	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	conjunctiveLogLineFieldFiltersWithPath []logline.LogLineFieldFilterWithPath,
 ) {
 	defer wgSenders.Done()
 
@@ -192,7 +206,7 @@ func streamServiceLogLines(
 				return
 			}
 
-			logLine := logline.NewLogLine(logLineStr)
+			logLine := logline.NewLogLine(logLineStr, logLineWithoutTimestamp)
 
 			//filtering it
 			shouldReturnLogLine, err := logLine.IsValidLogLineBaseOnFilters(conjunctiveLogLinesFiltersWithRegex)
@@ -204,6 +218,15 @@ func streamServiceLogLines(
 				break
 			}
 
+			shouldReturnLogLine, err = logLine.IsValidLogLineBasedOnFieldFilters(conjunctiveLogLineFieldFiltersWithPath)
+			if err != nil {
+				streamErrChan <- stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using field filters '%+v'", logLine, conjunctiveLogLineFieldFiltersWithPath)
+				break
+			}
+			if !shouldReturnLogLine {
+				break
+			}
+
 			//send the log line
 			logLines := []logline.LogLine{*logLine}
 			userServicesLogLinesMap := map[service.ServiceUUID][]logline.LogLine{
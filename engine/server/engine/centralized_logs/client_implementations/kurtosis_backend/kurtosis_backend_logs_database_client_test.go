@@ -43,6 +43,8 @@ const (
 	doNotFollowLogs = false
 )
 
+var noLogLineFieldFilters logline.ConjunctiveLogLineFieldFilters
+
 // We created this buffer type just to implement io.ReaderCloser
 type closingBuffer struct {
 	*bytes.Buffer
@@ -408,6 +410,8 @@ func executeStreamCallAndGetReceivedServiceLogLines(
 		enclaveUuid,
 		userServiceUuids,
 		logLinesFilters,
+		noLogLineFieldFilters,
+		logline.NewUnboundedLogLineTimeRange(),
 		shouldFollowLogs,
 		true,
 		0)
@@ -156,10 +156,40 @@ func (manager *LogFileManager) RemoveEnclaveLogs(enclaveUuid string) error {
 	return nil
 }
 
+// GetLastServiceLogTime returns the last time a service of the enclave wrote logs, looking at the log files of this week
+// and the previous one. It returns the zero time if no service of the enclave wrote logs in that period
+func (manager *LogFileManager) GetLastServiceLogTime(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (time.Time, error) {
+	lastServiceLogTime := time.Time{}
+
+	enclaveServices, err := manager.kurtosisBackend.GetUserServices(ctx, enclaveUuid, &service.ServiceFilters{Names: nil, UUIDs: nil, Statuses: nil})
+	if err != nil {
+		return lastServiceLogTime, stacktrace.Propagate(err, "An error occurred while trying to get user services for enclave '%v' from kurtosis backend.", enclaveUuid)
+	}
+
+	currentTime := manager.time.Now()
+	for _, weekTime := range []time.Time{currentTime, currentTime.Add(-oneWeek)} {
+		year, week := weekTime.ISOWeek()
+		for serviceUuid := range enclaveServices {
+			logFilePath := getFilepathStr(year, week, string(enclaveUuid), string(serviceUuid))
+			logFileInfo, err := manager.filesystem.Stat(logFilePath)
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return lastServiceLogTime, stacktrace.Propagate(err, "An error occurred checking log file path at '%v'.", logFilePath)
+			}
+			if logFileInfo.ModTime().After(lastServiceLogTime) {
+				lastServiceLogTime = logFileInfo.ModTime()
+			}
+		}
+	}
+	return lastServiceLogTime, nil
+}
+
 func (manager *LogFileManager) getEnclaveAndServiceInfo(ctx context.Context) (map[enclave.EnclaveUUID][]*service.ServiceRegistration, error) {
 	enclaveToServicesMap := map[enclave.EnclaveUUID][]*service.ServiceRegistration{}
 
-	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil})
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil, Labels: nil})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to get all enclaves from kurtosis backend.")
 	}
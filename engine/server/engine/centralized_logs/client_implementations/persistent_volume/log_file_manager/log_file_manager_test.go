@@ -142,12 +142,12 @@ func TestCreateLogFiles(t *testing.T) {
 	enclaveStatus := enclave.EnclaveStatus_Running
 	enclaveCreationTime := mockTime.Now() // time doesn't matter
 	enclaveMap := map[enclave.EnclaveUUID]*enclave.Enclave{
-		enclaveUuid: enclave.NewEnclave(enclaveUuid, testEnclaveUuid, enclaveStatus, &enclaveCreationTime, false),
+		enclaveUuid: enclave.NewEnclave(enclaveUuid, testEnclaveUuid, enclaveStatus, &enclaveCreationTime, false, nil),
 	}
 
 	mockKurtosisBackend.
 		EXPECT().
-		GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil}).
+		GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil, Labels: nil}).
 		Return(enclaveMap, nil)
 
 	serviceUuid := service.ServiceUUID(testUserService1Uuid)
@@ -178,3 +178,37 @@ func TestCreateLogFiles(t *testing.T) {
 	_, err = mockFs.Stat(expectedServiceShortUuidFilePath)
 	require.NoError(t, err)
 }
+
+func TestGetLastServiceLogTime(t *testing.T) {
+	mockTime := logs_clock.NewMockLogsClock(2023, 1, defaultDay)
+	mockFs := volume_filesystem.NewMockedVolumeFilesystem()
+
+	ctx := context.Background()
+	mockKurtosisBackend := backend_interface.NewMockKurtosisBackend(t)
+
+	enclaveUuid := enclave.EnclaveUUID(testEnclaveUuid)
+	serviceUuid := service.ServiceUUID(testUserService1Uuid)
+	serviceRegistration := service.NewServiceRegistration(testUserService1Name, serviceUuid, enclaveUuid, net.IP{}, "")
+	serviceContainer := container.NewContainer(container.ContainerStatus_Running, "", []string{}, []string{}, map[string]string{})
+	servicesMap := map[service.ServiceUUID]*service.Service{
+		serviceUuid: service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, net.IP{}, map[string]*port_spec.PortSpec{}, serviceContainer),
+	}
+	mockKurtosisBackend.
+		EXPECT().
+		GetUserServices(ctx, enclaveUuid, &service.ServiceFilters{Names: nil, UUIDs: nil, Statuses: nil}).
+		Return(servicesMap, nil).
+		Times(2)
+
+	logFileManager := NewLogFileManager(mockKurtosisBackend, mockFs, mockTime)
+	lastServiceLogTime, err := logFileManager.GetLastServiceLogTime(ctx, enclaveUuid)
+	require.NoError(t, err)
+	require.True(t, lastServiceLogTime.IsZero())
+
+	// the service only logged last week
+	previousWeekFilePath := getFilepathStr(2022, 52, testEnclaveUuid, testUserService1Uuid)
+	_, err = mockFs.Create(previousWeekFilePath)
+	require.NoError(t, err)
+	lastServiceLogTime, err = logFileManager.GetLastServiceLogTime(ctx, enclaveUuid)
+	require.NoError(t, err)
+	require.False(t, lastServiceLogTime.IsZero())
+}
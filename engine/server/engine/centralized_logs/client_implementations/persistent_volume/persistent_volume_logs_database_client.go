@@ -42,6 +42,8 @@ func (client *persistentVolumeLogsDatabaseClient) StreamUserServiceLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	userServiceUuids map[service.ServiceUUID]bool,
 	conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
+	conjunctiveLogLineFieldFilters logline.ConjunctiveLogLineFieldFilters,
+	timeRange *logline.LogLineTimeRange,
 	shouldFollowLogs bool,
 	shouldReturnAllLogs bool,
 	numLogLines uint32,
@@ -59,6 +61,12 @@ func (client *persistentVolumeLogsDatabaseClient) StreamUserServiceLogs(
 		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating conjunctive log line filter with regex from filters '%+v'", conjunctiveLogLineFilters)
 	}
 
+	conjunctiveLogFieldFiltersWithPath, err := logline.NewConjunctiveLogFieldFiltersWithPath(conjunctiveLogLineFieldFilters)
+	if err != nil {
+		cancelCtxFunc()
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating conjunctive log line field filters with path from field filters '%+v'", conjunctiveLogLineFieldFilters)
+	}
+
 	// this channel return an error if the stream fails at some point
 	streamErrChan := make(chan error)
 
@@ -76,6 +84,8 @@ func (client *persistentVolumeLogsDatabaseClient) StreamUserServiceLogs(
 			enclaveUuid,
 			serviceUuid,
 			conjunctiveLogFiltersWithRegex,
+			conjunctiveLogFieldFiltersWithPath,
+			timeRange,
 			shouldFollowLogs,
 			shouldReturnAllLogs,
 			numLogLines,
@@ -135,6 +145,8 @@ func (client *persistentVolumeLogsDatabaseClient) streamServiceLogLines(
 	enclaveUuid enclave.EnclaveUUID,
 	serviceUuid service.ServiceUUID,
 	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	conjunctiveLogLineFieldFiltersWithPath []logline.LogLineFieldFilterWithPath,
+	timeRange *logline.LogLineTimeRange,
 	shouldFollowLogs bool,
 	shouldReturnAllLogs bool,
 	numLogLines uint32,
@@ -148,6 +160,8 @@ func (client *persistentVolumeLogsDatabaseClient) streamServiceLogLines(
 		enclaveUuid,
 		serviceUuid,
 		conjunctiveLogLinesFiltersWithRegex,
+		conjunctiveLogLineFieldFiltersWithPath,
+		timeRange,
 		shouldFollowLogs,
 		shouldReturnAllLogs,
 		numLogLines)
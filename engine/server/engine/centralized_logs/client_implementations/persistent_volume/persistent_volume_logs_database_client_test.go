@@ -52,6 +52,8 @@ const (
 	defaultNumLogLines         = 0
 )
 
+var noLogLineFieldFilters logline.ConjunctiveLogLineFieldFilters
+
 func TestStreamUserServiceLogs_WithFilters(t *testing.T) {
 	expectedServiceAmountLogLinesByServiceUuid := map[service.ServiceUUID]int{
 		testUserService1Uuid: 2,
@@ -569,7 +571,7 @@ func executeStreamCallAndGetReceivedServiceLogLines(
 
 	logsDatabaseClient := NewPersistentVolumeLogsDatabaseClient(kurtosisBackend, underlyingFs, streamStrategy)
 
-	userServiceLogsByUuidChan, errChan, receivedCancelCtxFunc, err := logsDatabaseClient.StreamUserServiceLogs(ctx, enclaveUuid, userServiceUuids, logLinesFilters, shouldFollowLogs, defaultShouldReturnAllLogs, defaultNumLogLines)
+	userServiceLogsByUuidChan, errChan, receivedCancelCtxFunc, err := logsDatabaseClient.StreamUserServiceLogs(ctx, enclaveUuid, userServiceUuids, logLinesFilters, noLogLineFieldFilters, logline.NewUnboundedLogLineTimeRange(), shouldFollowLogs, defaultShouldReturnAllLogs, defaultNumLogLines)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting user service logs for UUIDs '%+v' using log line filters '%v' in enclave '%v'", userServiceUuids, logLinesFilters, enclaveUuid)
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"io"
 	"strings"
+	"time"
 )
 
 // This strategy pulls logs from filesytsem where there is a log file per enclave, per service
@@ -35,6 +36,8 @@ func (strategy *PerFileStreamLogsStrategy) StreamLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	serviceUuid service.ServiceUUID,
 	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	conjunctiveLogLineFieldFiltersWithPath []logline.LogLineFieldFilterWithPath,
+	timeRange *logline.LogLineTimeRange,
 	shouldFollowLogs bool,
 	shouldReturnAllLogs bool,
 	numLogLines uint32,
@@ -103,7 +106,12 @@ func (strategy *PerFileStreamLogsStrategy) StreamLogs(
 				streamErrChan <- stacktrace.NewError("An error retrieving the log field from logs json file. This is a bug in Kurtosis.")
 				return
 			}
-			logLine := logline.NewLogLine(logLineStr)
+			timestamp, err := getTimestampFromJsonLog(jsonLog)
+			if err != nil {
+				streamErrChan <- stacktrace.Propagate(err, "An error occurred retrieving the timestamp of json log '%v' for service '%v' in enclave '%v'", jsonLogStr, serviceUuid, enclaveUuid)
+				return
+			}
+			logLine := logline.NewLogLine(logLineStr, timestamp)
 
 			// Then we filter by checking if the log message is valid based on requested filters
 			shouldReturnLogLine, err := logLine.IsValidLogLineBaseOnFilters(conjunctiveLogLinesFiltersWithRegex)
@@ -115,6 +123,15 @@ func (strategy *PerFileStreamLogsStrategy) StreamLogs(
 				break
 			}
 
+			shouldReturnLogLine, err = logLine.IsValidLogLineBasedOnFieldFilters(conjunctiveLogLineFieldFiltersWithPath)
+			if err != nil {
+				streamErrChan <- stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using field filters '%+v'", logLine, conjunctiveLogLineFieldFiltersWithPath)
+				break
+			}
+			if !shouldReturnLogLine || !logLine.IsWithinTimeRange(timeRange) {
+				break
+			}
+
 			// send the log line
 			logLines := []logline.LogLine{*logLine}
 			userServicesLogLinesMap := map[service.ServiceUUID][]logline.LogLine{
@@ -124,3 +141,16 @@ func (strategy *PerFileStreamLogsStrategy) StreamLogs(
 		}
 	}
 }
+
+// Returns the zero time if [jsonLog] has no timestamp
+func getTimestampFromJsonLog(jsonLog JsonLog) (time.Time, error) {
+	timestampStr, found := jsonLog[volume_consts.TimestampLabel]
+	if !found {
+		return time.Time{}, nil
+	}
+	timestamp, err := time.Parse(time.RFC3339, timestampStr)
+	if err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred parsing timestamp '%v' of the json log; this is a bug in Kurtosis.", timestampStr)
+	}
+	return timestamp, nil
+}
@@ -24,7 +24,8 @@ import (
 )
 
 const (
-	oneWeek = 7 * 24 * time.Hour
+	daysInWeek = 7
+	oneWeek    = daysInWeek * 24 * time.Hour
 )
 
 // PerWeekStreamLogsStrategy pulls logs from filesystem where there is a log file per year, per week, per enclave, per service
@@ -50,15 +51,21 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	serviceUuid service.ServiceUUID,
 	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	conjunctiveLogLineFieldFiltersWithPath []logline.LogLineFieldFilterWithPath,
+	timeRange *logline.LogLineTimeRange,
 	shouldFollowLogs bool,
 	shouldReturnAllLogs bool,
 	numLogLines uint32,
 ) {
-	paths, err := strategy.getLogFilePaths(fs, volume_consts.LogRetentionPeriodInWeeks, string(enclaveUuid), string(serviceUuid))
+	paths, err := strategy.getLogFilePaths(fs, volume_consts.LogRetentionPeriodInWeeks, string(enclaveUuid), string(serviceUuid), timeRange)
 	if err != nil {
 		streamErrChan <- stacktrace.Propagate(err, "An error occurred retrieving log file paths for service '%v' in enclave '%v'.", serviceUuid, enclaveUuid)
 		return
 	}
+	if len(paths) == 0 && !timeRange.IsUnbounded() {
+		logrus.Debugf("No logs files for service '%v' in enclave '%v' cover the requested time range '%+v'", serviceUuid, enclaveUuid, timeRange)
+		return
+	}
 	if len(paths) == 0 {
 		streamErrChan <- stacktrace.NewError(
 			`No logs file paths for service '%v' in enclave '%v' were found. This means either:
@@ -86,12 +93,12 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 	}()
 
 	if shouldReturnAllLogs {
-		if err := strategy.streamAllLogs(ctx, logsReader, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex); err != nil {
+		if err := strategy.streamAllLogs(ctx, logsReader, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex, conjunctiveLogLineFieldFiltersWithPath, timeRange); err != nil {
 			streamErrChan <- stacktrace.Propagate(err, "An error occurred streaming all logs for service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
 			return
 		}
 	} else {
-		if err := strategy.streamTailLogs(ctx, logsReader, numLogLines, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex); err != nil {
+		if err := strategy.streamTailLogs(ctx, logsReader, numLogLines, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex, conjunctiveLogLineFieldFiltersWithPath, timeRange); err != nil {
 			streamErrChan <- stacktrace.Propagate(err, "An error occurred streaming '%v' logs for service '%v' in enclave '%v'", numLogLines, serviceUuid, enclaveUuid)
 			return
 		}
@@ -99,7 +106,7 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 
 	if shouldFollowLogs {
 		latestLogFile := paths[len(paths)-1]
-		if err := strategy.followLogs(latestLogFile, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex); err != nil {
+		if err := strategy.followLogs(latestLogFile, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex, conjunctiveLogLineFieldFiltersWithPath, timeRange); err != nil {
 			streamErrChan <- stacktrace.Propagate(err, "An error occurred creating following logs for service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
 			return
 		}
@@ -113,17 +120,21 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 // - File paths are of the format '/week/enclave uuid/service uuid.json' where 'week' is %W strftime specifier
 // - The list of file paths is returned in order of oldest logs to most recent logs e.g. [ 3/80124/1234.json, /4/801234/1234.json, ...]
 // - If a file path does not exist, the function with exits and returns whatever file paths were found
-func (strategy *PerWeekStreamLogsStrategy) getLogFilePaths(filesystem volume_filesystem.VolumeFilesystem, retentionPeriodInWeeks int, enclaveUuid, serviceUuid string) ([]string, error) {
+// - The files of the weeks outside [timeRange] are left out, so that their logs are never read
+func (strategy *PerWeekStreamLogsStrategy) getLogFilePaths(filesystem volume_filesystem.VolumeFilesystem, retentionPeriodInWeeks int, enclaveUuid, serviceUuid string, timeRange *logline.LogLineTimeRange) ([]string, error) {
 	var paths []string
 	currentTime := strategy.time.Now()
 
 	// scan for first existing log file
 	firstWeekWithLogs := 0
 	for i := 0; i < retentionPeriodInWeeks; i++ {
-		year, week := currentTime.Add(time.Duration(-i) * oneWeek).ISOWeek()
+		weekTime := currentTime.Add(time.Duration(-i) * oneWeek)
+		year, week := weekTime.ISOWeek()
 		filePathStr := fmt.Sprintf(volume_consts.PerWeekFilePathFmtStr, volume_consts.LogsStorageDirpath, strconv.Itoa(year), strconv.Itoa(week), enclaveUuid, serviceUuid, volume_consts.Filetype)
 		if _, err := filesystem.Stat(filePathStr); err == nil {
-			paths = append(paths, filePathStr)
+			if isWeekWithinTimeRange(weekTime, timeRange) {
+				paths = append(paths, filePathStr)
+			}
 			firstWeekWithLogs = i
 			break
 		} else {
@@ -136,12 +147,15 @@ func (strategy *PerWeekStreamLogsStrategy) getLogFilePaths(filesystem volume_fil
 
 	// scan for remaining files as far back as they exist
 	for i := firstWeekWithLogs + 1; i < retentionPeriodInWeeks; i++ {
-		year, week := currentTime.Add(time.Duration(-i) * oneWeek).ISOWeek()
+		weekTime := currentTime.Add(time.Duration(-i) * oneWeek)
+		year, week := weekTime.ISOWeek()
 		filePathStr := fmt.Sprintf(volume_consts.PerWeekFilePathFmtStr, volume_consts.LogsStorageDirpath, strconv.Itoa(year), strconv.Itoa(week), enclaveUuid, serviceUuid, volume_consts.Filetype)
 		if _, err := filesystem.Stat(filePathStr); err != nil {
 			break
 		}
-		paths = append(paths, filePathStr)
+		if isWeekWithinTimeRange(weekTime, timeRange) {
+			paths = append(paths, filePathStr)
+		}
 	}
 
 	// reverse for oldest to most recent
@@ -150,6 +164,15 @@ func (strategy *PerWeekStreamLogsStrategy) getLogFilePaths(filesystem volume_fil
 	return paths, nil
 }
 
+// Returns true if any point in time of the ISO week [weekTime] is in, which the logs aggregator computes in UTC, is inside [timeRange]
+func isWeekWithinTimeRange(weekTime time.Time, timeRange *logline.LogLineTimeRange) bool {
+	utcWeekTime := weekTime.UTC()
+	daysSinceMonday := (int(utcWeekTime.Weekday()) + daysInWeek - 1) % daysInWeek
+	year, month, day := utcWeekTime.Date()
+	weekStart := time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	return timeRange.Overlaps(weekStart, weekStart.Add(oneWeek))
+}
+
 // Returns a Reader over all logs in [logFilePaths] and the open file descriptors of the associated [logFilePaths]
 func getLogsReader(filesystem volume_filesystem.VolumeFilesystem, logFilePaths []string) (*bufio.Reader, []volume_filesystem.VolumeFile, error) {
 	var fileReaders []io.Reader
@@ -176,7 +199,9 @@ func (strategy *PerWeekStreamLogsStrategy) streamAllLogs(
 	logsReader *bufio.Reader,
 	logsByKurtosisUserServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
 	serviceUuid service.ServiceUUID,
-	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex) error {
+	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	conjunctiveLogLineFieldFiltersWithPath []logline.LogLineFieldFilterWithPath,
+	timeRange *logline.LogLineTimeRange) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -185,7 +210,7 @@ func (strategy *PerWeekStreamLogsStrategy) streamAllLogs(
 		default:
 			jsonLogStr, err := getCompleteJsonLogString(logsReader)
 			if isValidJsonEnding(jsonLogStr) {
-				if err = strategy.sendJsonLogLine(jsonLogStr, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex); err != nil {
+				if err = strategy.sendJsonLogLine(jsonLogStr, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex, conjunctiveLogLineFieldFiltersWithPath, timeRange); err != nil {
 					return err
 				}
 			}
@@ -202,15 +227,17 @@ func (strategy *PerWeekStreamLogsStrategy) streamAllLogs(
 	}
 }
 
-// tail -n X
+// tail -n X, where the X log lines are the last ones that pass the filters
 func (strategy *PerWeekStreamLogsStrategy) streamTailLogs(
 	ctx context.Context,
 	logsReader *bufio.Reader,
 	numLogLines uint32,
 	logsByKurtosisUserServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
 	serviceUuid service.ServiceUUID,
-	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex) error {
-	tailLogLines := make([]string, 0, numLogLines)
+	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	conjunctiveLogLineFieldFiltersWithPath []logline.LogLineFieldFilterWithPath,
+	timeRange *logline.LogLineTimeRange) error {
+	tailLogLines := make([]logline.LogLine, 0, numLogLines)
 
 	for {
 		select {
@@ -220,10 +247,16 @@ func (strategy *PerWeekStreamLogsStrategy) streamTailLogs(
 		default:
 			jsonLogStr, err := getCompleteJsonLogString(logsReader)
 			if isValidJsonEnding(jsonLogStr) {
-				// collect all log lines in tail log lines
-				tailLogLines = append(tailLogLines, jsonLogStr)
-				if len(tailLogLines) > int(numLogLines) {
-					tailLogLines = tailLogLines[1:]
+				logLine, err := strategy.getValidLogLine(jsonLogStr, conjunctiveLogLinesFiltersWithRegex, conjunctiveLogLineFieldFiltersWithPath, timeRange)
+				if err != nil {
+					return err
+				}
+				// collect all valid log lines in tail log lines
+				if logLine != nil {
+					tailLogLines = append(tailLogLines, *logLine)
+					if len(tailLogLines) > int(numLogLines) {
+						tailLogLines = tailLogLines[1:]
+					}
 				}
 				continue
 			}
@@ -240,10 +273,8 @@ func (strategy *PerWeekStreamLogsStrategy) streamTailLogs(
 		break
 	}
 
-	for _, jsonLogStr := range tailLogLines {
-		if err := strategy.sendJsonLogLine(jsonLogStr, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex); err != nil {
-			return err
-		}
+	for _, logLine := range tailLogLines {
+		sendLogLine(logLine, logsByKurtosisUserServiceUuidChan, serviceUuid)
 	}
 
 	return nil
@@ -288,7 +319,26 @@ func (strategy *PerWeekStreamLogsStrategy) sendJsonLogLine(
 	jsonLogLineStr string,
 	logsByKurtosisUserServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
 	serviceUuid service.ServiceUUID,
-	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex) error {
+	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	conjunctiveLogLineFieldFiltersWithPath []logline.LogLineFieldFilterWithPath,
+	timeRange *logline.LogLineTimeRange) error {
+	logLine, err := strategy.getValidLogLine(jsonLogLineStr, conjunctiveLogLinesFiltersWithRegex, conjunctiveLogLineFieldFiltersWithPath, timeRange)
+	if err != nil {
+		return err
+	}
+	if logLine != nil {
+		sendLogLine(*logLine, logsByKurtosisUserServiceUuidChan, serviceUuid)
+	}
+	return nil
+}
+
+// Returns the log line of [jsonLogLineStr] or nil if it should not be returned, based on the filters, the time range
+// and the retention period
+func (strategy *PerWeekStreamLogsStrategy) getValidLogLine(
+	jsonLogLineStr string,
+	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	conjunctiveLogLineFieldFiltersWithPath []logline.LogLineFieldFilterWithPath,
+	timeRange *logline.LogLineTimeRange) (*logline.LogLine, error) {
 	// each logLineStr is of the following structure: {"enclave_uuid": "...", "service_uuid":"...", "log": "...",.. "timestamp":"..."}
 	// eg. {"container_type":"api-container", "container_id":"8f8558ba", "container_name":"/kurtosis-api--ffd",
 	// "log":"hi","timestamp":"2023-08-14T14:57:49Z"}
@@ -297,41 +347,57 @@ func (strategy *PerWeekStreamLogsStrategy) sendJsonLogLine(
 	var jsonLog JsonLog
 	if err := json.Unmarshal([]byte(jsonLogLineStr), &jsonLog); err != nil {
 		logrus.Warnf("An error occurred parsing the json log string: %v. Skipping sending this log line.", jsonLogLineStr)
-		return nil
+		return nil, nil
 	}
 
 	// Then extract the actual log message using the "log" field
 	logLineStr, found := jsonLog[volume_consts.LogLabel]
 	if !found {
-		return stacktrace.NewError("An error retrieving the log field from json log string: %v\n", jsonLogLineStr)
+		return nil, stacktrace.NewError("An error retrieving the log field from json log string: %v\n", jsonLogLineStr)
+	}
+	timestamp, err := getTimestampFromJsonLog(jsonLog)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving the timestamp from json log string: %v", jsonLogLineStr)
 	}
-	logLine := logline.NewLogLine(logLineStr)
+	logLine := logline.NewLogLine(logLineStr, timestamp)
 
 	// Then filter by checking if the log message is valid based on requested filters
 	validLogLine, err := logLine.IsValidLogLineBaseOnFilters(conjunctiveLogLinesFiltersWithRegex)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using filters '%+v'", logLine, conjunctiveLogLinesFiltersWithRegex)
+		return nil, stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using filters '%+v'", logLine, conjunctiveLogLinesFiltersWithRegex)
 	}
 	if !validLogLine {
-		return nil
+		return nil, nil
+	}
+
+	validLogLine, err = logLine.IsValidLogLineBasedOnFieldFilters(conjunctiveLogLineFieldFiltersWithPath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using field filters '%+v'", logLine, conjunctiveLogLineFieldFiltersWithPath)
+	}
+	if !validLogLine || !logLine.IsWithinTimeRange(timeRange) {
+		return nil, nil
 	}
 
 	// ensure this log line is within the retention period if it has a timestamp
 	withinRetentionPeriod, err := strategy.isWithinRetentionPeriod(jsonLog)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using filters '%+v'", logLine, conjunctiveLogLinesFiltersWithRegex)
+		return nil, stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using filters '%+v'", logLine, conjunctiveLogLinesFiltersWithRegex)
 	}
 	if !withinRetentionPeriod {
-		return nil
+		return nil, nil
 	}
+	return logLine, nil
+}
 
-	// send the log line
-	logLines := []logline.LogLine{*logLine}
+func sendLogLine(
+	logLine logline.LogLine,
+	logsByKurtosisUserServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
+	serviceUuid service.ServiceUUID) {
+	logLines := []logline.LogLine{logLine}
 	userServicesLogLinesMap := map[service.ServiceUUID][]logline.LogLine{
 		serviceUuid: logLines,
 	}
 	logsByKurtosisUserServiceUuidChan <- userServicesLogLinesMap
-	return nil
 }
 
 // Returns true if [logLine] has no timestamp
@@ -355,6 +421,8 @@ func (strategy *PerWeekStreamLogsStrategy) followLogs(
 	logsByKurtosisUserServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
 	serviceUuid service.ServiceUUID,
 	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	conjunctiveLogLineFieldFiltersWithPath []logline.LogLineFieldFilterWithPath,
+	timeRange *logline.LogLineTimeRange,
 ) error {
 	logTail, err := tail.TailFile(filepath, tail.Config{
 		Location: &tail.SeekInfo{
@@ -374,7 +442,7 @@ func (strategy *PerWeekStreamLogsStrategy) followLogs(
 	}
 
 	for logLine := range logTail.Lines {
-		err = strategy.sendJsonLogLine(logLine.Text, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex)
+		err = strategy.sendJsonLogLine(logLine.Text, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex, conjunctiveLogLineFieldFiltersWithPath, timeRange)
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred sending json log line '%v'.", logLine.Text)
 		}
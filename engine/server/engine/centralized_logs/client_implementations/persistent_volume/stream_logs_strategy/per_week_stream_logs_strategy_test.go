@@ -2,15 +2,19 @@ package stream_logs_strategy
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/logs_clock"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_filesystem"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
 	"github.com/stretchr/testify/require"
 	"io"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 const (
@@ -53,7 +57,7 @@ func TestGetLogFilePaths(t *testing.T) {
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
 	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid)
+	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, logline.NewUnboundedLogLineTimeRange())
 
 	require.NoError(t, err)
 	require.Equal(t, len(expectedLogFilePaths), len(logFilePaths))
@@ -90,7 +94,7 @@ func TestGetLogFilePathsAcrossNewYear(t *testing.T) {
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
 	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid)
+	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, logline.NewUnboundedLogLineTimeRange())
 
 	require.NoError(t, err)
 	require.Equal(t, len(expectedLogFilePaths), len(logFilePaths))
@@ -127,7 +131,7 @@ func TestGetLogFilePathsAcrossNewYearWith53Weeks(t *testing.T) {
 
 	mockTime := logs_clock.NewMockLogsClock(2016, currentWeek, 1)
 	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid)
+	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, logline.NewUnboundedLogLineTimeRange())
 
 	require.NoError(t, err)
 	require.Equal(t, len(expectedLogFilePaths), len(logFilePaths))
@@ -159,7 +163,7 @@ func TestGetLogFilePathsWithDiffRetentionPeriod(t *testing.T) {
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
 	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths, err := strategy.getLogFilePaths(filesystem, retentionPeriod, testEnclaveUuid, testUserService1Uuid)
+	logFilePaths, err := strategy.getLogFilePaths(filesystem, retentionPeriod, testEnclaveUuid, testUserService1Uuid, logline.NewUnboundedLogLineTimeRange())
 
 	require.NoError(t, err)
 	require.Equal(t, len(expectedLogFilePaths), len(logFilePaths))
@@ -191,7 +195,7 @@ func TestGetLogFilePathsReturnsAllAvailableWeeks(t *testing.T) {
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
 	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid)
+	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, logline.NewUnboundedLogLineTimeRange())
 
 	require.NoError(t, err)
 	require.Less(t, len(logFilePaths), defaultRetentionPeriodInWeeks)
@@ -216,7 +220,7 @@ func TestGetLogFilePathsReturnsCorrectPathsIfWeeksMissingInBetween(t *testing.T)
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
 	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid)
+	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, logline.NewUnboundedLogLineTimeRange())
 
 	require.NoError(t, err)
 	require.Len(t, logFilePaths, 1)
@@ -245,7 +249,7 @@ func TestGetLogFilePathsReturnsCorrectPathsIfCurrentWeekHasNoLogsYet(t *testing.
 	}
 
 	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid)
+	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, logline.NewUnboundedLogLineTimeRange())
 
 	require.NoError(t, err)
 	require.Equal(t, len(expectedLogFilePaths), len(logFilePaths))
@@ -254,6 +258,88 @@ func TestGetLogFilePathsReturnsCorrectPathsIfCurrentWeekHasNoLogsYet(t *testing.
 	}
 }
 
+func TestGetLogFilePathsSkipsWeeksOutsideTimeRange(t *testing.T) {
+	filesystem := volume_filesystem.NewMockedVolumeFilesystem()
+
+	week14filepath := getWeekFilepathStr(defaultYear, 14)
+	week15filepath := getWeekFilepathStr(defaultYear, 15)
+	week16filepath := getWeekFilepathStr(defaultYear, 16)
+	week17filepath := getWeekFilepathStr(defaultYear, 17)
+
+	_, _ = filesystem.Create(week14filepath)
+	_, _ = filesystem.Create(week15filepath)
+	_, _ = filesystem.Create(week16filepath)
+	_, _ = filesystem.Create(week17filepath)
+
+	currentWeek := 17
+
+	// week 15 goes from 2023-04-10 to 2023-04-16 and week 16 from 2023-04-17 to 2023-04-23
+	timeRange := logline.NewLogLineTimeRange(
+		time.Date(defaultYear, time.April, 12, 10, 0, 0, 0, time.UTC),
+		time.Date(defaultYear, time.April, 20, 10, 0, 0, 0, time.UTC),
+	)
+
+	expectedLogFilePaths := []string{
+		week15filepath,
+		week16filepath,
+	}
+
+	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime)
+	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, timeRange)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedLogFilePaths, logFilePaths)
+}
+
+func TestGetLogFilePathsReturnsNoPathsIfNoWeekIsWithinTimeRange(t *testing.T) {
+	filesystem := volume_filesystem.NewMockedVolumeFilesystem()
+
+	_, _ = filesystem.Create(getWeekFilepathStr(defaultYear, 16))
+	_, _ = filesystem.Create(getWeekFilepathStr(defaultYear, 17))
+
+	currentWeek := 17
+
+	timeRange := logline.NewLogLineTimeRange(time.Time{}, time.Date(defaultYear, time.April, 1, 0, 0, 0, 0, time.UTC))
+
+	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime)
+	logFilePaths, err := strategy.getLogFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, timeRange)
+
+	require.NoError(t, err)
+	require.Empty(t, logFilePaths)
+}
+
+func TestStreamTailLogsReturnsLastLogLinesWithinTimeRange(t *testing.T) {
+	logLines := []string{
+		`{"log":"one","timestamp":"2023-04-30T10:00:00Z"}`,
+		`{"log":"two","timestamp":"2023-04-30T11:00:00Z"}`,
+		`{"log":"three","timestamp":"2023-04-30T12:00:00Z"}`,
+		`{"log":"four","timestamp":"2023-04-30T13:00:00Z"}`,
+		`{"log":"five","timestamp":"2023-04-30T14:00:00Z"}`,
+	}
+	logsReader := bufio.NewReader(strings.NewReader(strings.Join(logLines, "\n") + "\n"))
+
+	timeRange := logline.NewLogLineTimeRange(time.Time{}, time.Date(defaultYear, time.April, 30, 12, 0, 0, 0, time.UTC))
+	numLogLines := uint32(2)
+
+	mockTime := logs_clock.NewMockLogsClock(defaultYear, 17, defaultDay)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime)
+	logsByKurtosisUserServiceUuidChan := make(chan map[service.ServiceUUID][]logline.LogLine, len(logLines))
+
+	err := strategy.streamTailLogs(context.Background(), logsReader, numLogLines, logsByKurtosisUserServiceUuidChan, testUserService1Uuid, nil, nil, timeRange)
+	require.NoError(t, err)
+	close(logsByKurtosisUserServiceUuidChan)
+
+	receivedLogLines := []string{}
+	for logLinesByServiceUuid := range logsByKurtosisUserServiceUuidChan {
+		for _, logLine := range logLinesByServiceUuid[testUserService1Uuid] {
+			receivedLogLines = append(receivedLogLines, logLine.GetContent())
+		}
+	}
+	require.Equal(t, []string{"two", "three"}, receivedLogLines)
+}
+
 func TestIsWithinRetentionPeriod(t *testing.T) {
 	// this is the 36th week of the year
 	jsonLogLine := map[string]string{
@@ -20,6 +20,8 @@ type StreamLogsStrategy interface {
 		enclaveUuid enclave.EnclaveUUID,
 		serviceUuid service.ServiceUUID,
 		conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+		conjunctiveLogLineFieldFiltersWithPath []logline.LogLineFieldFilterWithPath,
+		timeRange *logline.LogLineTimeRange,
 		shouldFollowLogs bool,
 		shouldReturnAllLogs bool,
 		numLogLines uint32,
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/afero"
 	"io"
 	"os"
+	"time"
 )
 
 // VolumeFilesystem interface is an abstraction of the disk filesystem
@@ -25,6 +26,7 @@ type VolumeFile interface {
 
 type VolumeFileInfo interface {
 	Mode() os.FileMode
+	ModTime() time.Time
 }
 
 // OsVolumeFilesystem is an implementation of the filesystem using disk
@@ -1,8 +1,10 @@
 package logline
 
 import (
+	"encoding/json"
 	"github.com/kurtosis-tech/stacktrace"
 	"strings"
+	"time"
 )
 
 const (
@@ -10,19 +12,39 @@ const (
 )
 
 type LogLine struct {
-	//lineTime time.Time //TODO add the time from loki logs result
 	content string
+
+	// the zero time if the logs database doesn't store the time the line was emitted at
+	timestamp time.Time
 }
 
-func NewLogLine(content string) *LogLine {
+func NewLogLine(content string, timestamp time.Time) *LogLine {
 	contentWithoutNewLine := strings.TrimSuffix(content, newlineChar)
-	return &LogLine{content: contentWithoutNewLine}
+	return &LogLine{content: contentWithoutNewLine, timestamp: timestamp}
 }
 
 func (logLine LogLine) GetContent() string {
 	return logLine.content
 }
 
+func (logLine LogLine) GetTimestamp() time.Time {
+	return logLine.timestamp
+}
+
+// GetLevel returns the level of the log line extracted from its content, or an empty string if it has none
+func (logLine LogLine) GetLevel() LogLineLevel {
+	return extractLogLineLevel(logLine.content)
+}
+
+// IsWithinTimeRange returns true if the log line was emitted inside [timeRange]; log lines without timestamp are always
+// considered inside the range
+func (logLine LogLine) IsWithinTimeRange(timeRange *LogLineTimeRange) bool {
+	if logLine.timestamp.IsZero() {
+		return true
+	}
+	return timeRange.Contains(logLine.timestamp)
+}
+
 func (logLine LogLine) IsValidLogLineBaseOnFilters(
 	conjunctiveLogLinesFiltersWithRegex []LogLineFilterWithRegex,
 ) (bool, error) {
@@ -63,3 +85,38 @@ func (logLine LogLine) IsValidLogLineBaseOnFilters(
 
 	return shouldReturnIt, nil
 }
+
+func (logLine LogLine) IsValidLogLineBasedOnFieldFilters(
+	conjunctiveLogLineFieldFiltersWithPath []LogLineFieldFilterWithPath,
+) (bool, error) {
+	if len(conjunctiveLogLineFieldFiltersWithPath) == 0 {
+		return true, nil
+	}
+
+	jsonObject, isJsonObject := logLine.getContentAsJsonObject()
+	if !isJsonObject {
+		return false, nil
+	}
+
+	for _, fieldFilter := range conjunctiveLogLineFieldFiltersWithPath {
+		matches, err := fieldFilter.matches(jsonObject)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "An error occurred evaluating field filter '%+v' over log line '%v'", fieldFilter, logLine.GetContent())
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (logLine LogLine) getContentAsJsonObject() (map[string]interface{}, bool) {
+	decoder := json.NewDecoder(strings.NewReader(logLine.content))
+	// keeps the numbers as they were written, so that big integers don't lose precision before being compared
+	decoder.UseNumber()
+	var jsonObject map[string]interface{}
+	if err := decoder.Decode(&jsonObject); err != nil || jsonObject == nil {
+		return nil, false
+	}
+	return jsonObject, true
+}
@@ -0,0 +1,28 @@
+package logline
+
+type ConjunctiveLogLineFieldFilters []LogLineFieldFilter
+
+// LogLineFieldFilter is a predicate over a field of the log lines emitted as JSON objects, e.g. `$.request.status >= 500`;
+// log lines that aren't JSON objects never match it
+type LogLineFieldFilter struct {
+	// JSON path of the field, e.g. "$.request.status", "request.status" or "$.items[0].name"
+	fieldPath string
+	operator  logLineFieldOperator
+	value     string
+}
+
+func NewLogLineFieldFilter(fieldPath string, operator logLineFieldOperator, value string) *LogLineFieldFilter {
+	return &LogLineFieldFilter{fieldPath: fieldPath, operator: operator, value: value}
+}
+
+func (fieldFilter *LogLineFieldFilter) GetFieldPath() string {
+	return fieldFilter.fieldPath
+}
+
+func (fieldFilter *LogLineFieldFilter) GetOperator() logLineFieldOperator {
+	return fieldFilter.operator
+}
+
+func (fieldFilter *LogLineFieldFilter) GetValue() string {
+	return fieldFilter.value
+}
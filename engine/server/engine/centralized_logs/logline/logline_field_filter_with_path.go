@@ -0,0 +1,215 @@
+package logline
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	fieldPathRootChar          = "$"
+	fieldPathKeySeparatorChar  = '.'
+	fieldPathIndexOpeningChar  = '['
+	fieldPathIndexClosingChar  = ']'
+	fieldPathQuotedKeyCharsSet = `"'`
+
+	jsonNullStr = "null"
+)
+
+type fieldPathSegment struct {
+	// set for object keys
+	key string
+
+	// set for array indexes; -1 for object keys
+	index int
+}
+
+// LogLineFieldFilterWithPath is a field filter ready to be evaluated, with its field path parsed and its regex compiled
+type LogLineFieldFilterWithPath struct {
+	LogLineFieldFilter
+	pathSegments         []fieldPathSegment
+	compiledRegexPattern *regexp.Regexp
+}
+
+func NewConjunctiveLogFieldFiltersWithPath(conjunctiveLogLineFieldFilters ConjunctiveLogLineFieldFilters) ([]LogLineFieldFilterWithPath, error) {
+	conjunctiveLogFieldFiltersWithPath := []LogLineFieldFilterWithPath{}
+	for _, fieldFilter := range conjunctiveLogLineFieldFilters {
+		pathSegments, err := parseFieldPath(fieldFilter.GetFieldPath())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the field path of log line field filter '%+v'", fieldFilter)
+		}
+		fieldFilterWithPath := LogLineFieldFilterWithPath{
+			LogLineFieldFilter:   fieldFilter,
+			pathSegments:         pathSegments,
+			compiledRegexPattern: nil,
+		}
+
+		if fieldFilter.GetOperator() == LogLineFieldOperator_MatchesRegex {
+			compiledRegexPattern, err := regexp.Compile(fieldFilter.GetValue())
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred compiling regex string '%v' for log line field filter '%+v'", fieldFilter.GetValue(), fieldFilter)
+			}
+			fieldFilterWithPath.compiledRegexPattern = compiledRegexPattern
+		}
+		conjunctiveLogFieldFiltersWithPath = append(conjunctiveLogFieldFiltersWithPath, fieldFilterWithPath)
+	}
+	return conjunctiveLogFieldFiltersWithPath, nil
+}
+
+func (fieldFilter LogLineFieldFilterWithPath) matches(jsonObject map[string]interface{}) (bool, error) {
+	fieldValue, found := fieldFilter.getFieldValue(jsonObject)
+	filterValue := fieldFilter.GetValue()
+
+	switch fieldFilter.GetOperator() {
+	case LogLineFieldOperator_Equals:
+		return found && areFieldValuesEqual(fieldValue, filterValue), nil
+	case LogLineFieldOperator_NotEquals:
+		return !found || !areFieldValuesEqual(fieldValue, filterValue), nil
+	case LogLineFieldOperator_MatchesRegex:
+		return found && fieldFilter.compiledRegexPattern.MatchString(fieldValueToString(fieldValue)), nil
+	case LogLineFieldOperator_GreaterThan:
+		return found && compareFieldValues(fieldValue, filterValue) > 0, nil
+	case LogLineFieldOperator_GreaterThanOrEqual:
+		return found && compareFieldValues(fieldValue, filterValue) >= 0, nil
+	case LogLineFieldOperator_LessThan:
+		return found && compareFieldValues(fieldValue, filterValue) < 0, nil
+	case LogLineFieldOperator_LessThanOrEqual:
+		return found && compareFieldValues(fieldValue, filterValue) <= 0, nil
+	default:
+		return false, stacktrace.NewError("Unrecognized log line field filter operator '%v' in filter '%+v'; this is a bug in Kurtosis", fieldFilter.GetOperator(), fieldFilter)
+	}
+}
+
+func (fieldFilter LogLineFieldFilterWithPath) getFieldValue(jsonObject map[string]interface{}) (interface{}, bool) {
+	var currentValue interface{} = jsonObject
+	for _, segment := range fieldFilter.pathSegments {
+		switch value := currentValue.(type) {
+		case map[string]interface{}:
+			if segment.index >= 0 {
+				return nil, false
+			}
+			nextValue, found := value[segment.key]
+			if !found {
+				return nil, false
+			}
+			currentValue = nextValue
+		case []interface{}:
+			if segment.index < 0 || segment.index >= len(value) {
+				return nil, false
+			}
+			currentValue = value[segment.index]
+		default:
+			return nil, false
+		}
+	}
+	return currentValue, true
+}
+
+// parseFieldPath parses JSON paths made of keys and array indexes, with or without the leading root, e.g.
+// `$.request.status`, `request.status`, `$.items[0].name` or `$["key.with.dots"]`
+func parseFieldPath(fieldPath string) ([]fieldPathSegment, error) {
+	remainingPath := strings.TrimPrefix(fieldPath, fieldPathRootChar)
+	if remainingPath == fieldPath && !strings.HasPrefix(fieldPath, string(fieldPathIndexOpeningChar)) {
+		remainingPath = string(fieldPathKeySeparatorChar) + remainingPath
+	}
+
+	segments := []fieldPathSegment{}
+	for remainingPath != "" {
+		switch remainingPath[0] {
+		case fieldPathKeySeparatorChar:
+			remainingPath = remainingPath[1:]
+			keyEnd := strings.IndexAny(remainingPath, string([]byte{fieldPathKeySeparatorChar, fieldPathIndexOpeningChar}))
+			if keyEnd < 0 {
+				keyEnd = len(remainingPath)
+			}
+			key := remainingPath[:keyEnd]
+			if key == "" {
+				return nil, stacktrace.NewError("Field path '%v' contains an empty key", fieldPath)
+			}
+			segments = append(segments, fieldPathSegment{key: key, index: -1})
+			remainingPath = remainingPath[keyEnd:]
+		case fieldPathIndexOpeningChar:
+			closingCharIdx := strings.IndexByte(remainingPath, fieldPathIndexClosingChar)
+			if closingCharIdx < 0 {
+				return nil, stacktrace.NewError("Field path '%v' contains an unclosed '%c'", fieldPath, fieldPathIndexOpeningChar)
+			}
+			bracketContent := remainingPath[1:closingCharIdx]
+			remainingPath = remainingPath[closingCharIdx+1:]
+			if len(bracketContent) >= 2 && strings.ContainsRune(fieldPathQuotedKeyCharsSet, rune(bracketContent[0])) && bracketContent[len(bracketContent)-1] == bracketContent[0] {
+				segments = append(segments, fieldPathSegment{key: bracketContent[1 : len(bracketContent)-1], index: -1})
+				continue
+			}
+			index, err := strconv.Atoi(bracketContent)
+			if err != nil || index < 0 {
+				return nil, stacktrace.NewError("Field path '%v' contains '%v' between brackets, which is neither a quoted key nor an array index", fieldPath, bracketContent)
+			}
+			segments = append(segments, fieldPathSegment{key: "", index: index})
+		default:
+			return nil, stacktrace.NewError("Field path '%v' is invalid; keys should be separated by '%c' and array indexes be enclosed in '%c%c'", fieldPath, fieldPathKeySeparatorChar, fieldPathIndexOpeningChar, fieldPathIndexClosingChar)
+		}
+	}
+	if len(segments) == 0 {
+		return nil, stacktrace.NewError("Field path '%v' doesn't reference any field", fieldPath)
+	}
+	return segments, nil
+}
+
+// Numbers are compared by value, so that "5" matches 5.0, and everything else by its string representation
+func areFieldValuesEqual(fieldValue interface{}, filterValue string) bool {
+	if fieldNumber, filterNumber, areNumbers := getFieldValuesAsNumbers(fieldValue, filterValue); areNumbers {
+		return fieldNumber == filterNumber
+	}
+	return fieldValueToString(fieldValue) == filterValue
+}
+
+// Numbers are compared by value and everything else lexicographically, which also orders RFC3339 timestamps correctly
+func compareFieldValues(fieldValue interface{}, filterValue string) int {
+	if fieldNumber, filterNumber, areNumbers := getFieldValuesAsNumbers(fieldValue, filterValue); areNumbers {
+		switch {
+		case fieldNumber < filterNumber:
+			return -1
+		case fieldNumber > filterNumber:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(fieldValueToString(fieldValue), filterValue)
+}
+
+func getFieldValuesAsNumbers(fieldValue interface{}, filterValue string) (float64, float64, bool) {
+	fieldNumber, isNumber := fieldValue.(json.Number)
+	if !isNumber {
+		return 0, 0, false
+	}
+	fieldFloat, err := fieldNumber.Float64()
+	if err != nil {
+		return 0, 0, false
+	}
+	filterFloat, err := strconv.ParseFloat(filterValue, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return fieldFloat, filterFloat, true
+}
+
+func fieldValueToString(fieldValue interface{}) string {
+	switch value := fieldValue.(type) {
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	case nil:
+		return jsonNullStr
+	default:
+		valueBytes, err := json.Marshal(value)
+		if err != nil {
+			return ""
+		}
+		return string(valueBytes)
+	}
+}
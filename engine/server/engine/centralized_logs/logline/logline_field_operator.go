@@ -0,0 +1,13 @@
+package logline
+
+type logLineFieldOperator uint8
+
+const (
+	LogLineFieldOperator_Equals logLineFieldOperator = iota
+	LogLineFieldOperator_NotEquals
+	LogLineFieldOperator_MatchesRegex
+	LogLineFieldOperator_GreaterThan
+	LogLineFieldOperator_GreaterThanOrEqual
+	LogLineFieldOperator_LessThan
+	LogLineFieldOperator_LessThanOrEqual
+)
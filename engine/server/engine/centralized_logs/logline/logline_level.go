@@ -0,0 +1,109 @@
+package logline
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type LogLineLevel string
+
+const (
+	LogLineLevel_Unknown LogLineLevel = ""
+	LogLineLevel_Trace   LogLineLevel = "trace"
+	LogLineLevel_Debug   LogLineLevel = "debug"
+	LogLineLevel_Info    LogLineLevel = "info"
+	LogLineLevel_Warn    LogLineLevel = "warn"
+	LogLineLevel_Error   LogLineLevel = "error"
+	LogLineLevel_Fatal   LogLineLevel = "fatal"
+)
+
+// Names of the JSON fields that structured loggers (logrus, zap, zerolog, bunyan, pino, ...) use for the level
+var jsonLevelFieldNames = []string{"level", "lvl", "severity", "loglevel", "log.level"}
+
+// The level aliases emitted by the most common loggers, lower cased
+var levelsByAlias = map[string]LogLineLevel{
+	"trace":    LogLineLevel_Trace,
+	"trc":      LogLineLevel_Trace,
+	"debug":    LogLineLevel_Debug,
+	"dbg":      LogLineLevel_Debug,
+	"info":     LogLineLevel_Info,
+	"inf":      LogLineLevel_Info,
+	"notice":   LogLineLevel_Info,
+	"warn":     LogLineLevel_Warn,
+	"wrn":      LogLineLevel_Warn,
+	"warning":  LogLineLevel_Warn,
+	"error":    LogLineLevel_Error,
+	"err":      LogLineLevel_Error,
+	"eror":     LogLineLevel_Error,
+	"fatal":    LogLineLevel_Fatal,
+	"ftl":      LogLineLevel_Fatal,
+	"crit":     LogLineLevel_Fatal,
+	"critical": LogLineLevel_Fatal,
+	"panic":    LogLineLevel_Fatal,
+}
+
+// Bunyan and pino emit the level as a number
+var levelsByBunyanNumber = map[string]LogLineLevel{
+	"10": LogLineLevel_Trace,
+	"20": LogLineLevel_Debug,
+	"30": LogLineLevel_Info,
+	"40": LogLineLevel_Warn,
+	"50": LogLineLevel_Error,
+	"60": LogLineLevel_Fatal,
+}
+
+var (
+	// logfmt lines, e.g. time="..." level=info msg="..."
+	logfmtLevelRegex = regexp.MustCompile(`(?i)\b(?:level|lvl)="?([a-z]+)`)
+	// plain text lines, e.g. "2023-08-14 14:57:49 [WARN] ..." or "ERROR: ..."; only upper case and bracketed words are
+	// considered to not match the level in the middle of a sentence
+	plainTextLevelRegex = regexp.MustCompile(`(?:\[([A-Za-z]+)\]|\b([A-Z]{3,8})\b)`)
+)
+
+func extractLogLineLevel(content string) LogLineLevel {
+	trimmedContent := strings.TrimSpace(content)
+
+	if strings.HasPrefix(trimmedContent, "{") {
+		var fields map[string]interface{}
+		if err := json.Unmarshal([]byte(trimmedContent), &fields); err == nil {
+			return getLevelFromJsonFields(fields)
+		}
+	}
+
+	if match := logfmtLevelRegex.FindStringSubmatch(trimmedContent); match != nil {
+		if level, found := levelsByAlias[strings.ToLower(match[1])]; found {
+			return level
+		}
+	}
+
+	for _, match := range plainTextLevelRegex.FindAllStringSubmatch(trimmedContent, -1) {
+		word := match[1] + match[2]
+		if level, found := levelsByAlias[strings.ToLower(word)]; found {
+			return level
+		}
+	}
+	return LogLineLevel_Unknown
+}
+
+func getLevelFromJsonFields(fields map[string]interface{}) LogLineLevel {
+	for _, levelFieldName := range jsonLevelFieldNames {
+		for fieldName, fieldValue := range fields {
+			if !strings.EqualFold(fieldName, levelFieldName) {
+				continue
+			}
+			switch value := fieldValue.(type) {
+			case string:
+				if level, found := levelsByAlias[strings.ToLower(value)]; found {
+					return level
+				}
+			case float64:
+				if level, found := levelsByBunyanNumber[strconv.FormatFloat(value, 'f', -1, 64)]; found {
+					return level
+				}
+			}
+		}
+	}
+	return LogLineLevel_Unknown
+}
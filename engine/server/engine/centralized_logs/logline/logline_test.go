@@ -0,0 +1,98 @@
+package logline
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	jsonLogLineContent = `{"level":"warn","msg":"slow request","request":{"method":"GET","status":503,"duration_ms":1250.5},"tags":["api","v2"],"user.id":"1234"}`
+)
+
+var noTimestamp = time.Time{}
+
+func TestIsValidLogLineBasedOnFieldFilters(t *testing.T) {
+	logLine := NewLogLine(jsonLogLineContent, noTimestamp)
+
+	testCases := []struct {
+		fieldFilter   *LogLineFieldFilter
+		expectedValid bool
+	}{
+		{NewLogLineFieldFilter("$.level", LogLineFieldOperator_Equals, "warn"), true},
+		{NewLogLineFieldFilter("level", LogLineFieldOperator_Equals, "error"), false},
+		{NewLogLineFieldFilter("$.request.status", LogLineFieldOperator_Equals, "503.0"), true},
+		{NewLogLineFieldFilter("$.request.status", LogLineFieldOperator_GreaterThanOrEqual, "500"), true},
+		{NewLogLineFieldFilter("$.request.status", LogLineFieldOperator_LessThan, "500"), false},
+		{NewLogLineFieldFilter("request.duration_ms", LogLineFieldOperator_GreaterThan, "1000"), true},
+		{NewLogLineFieldFilter("$.request.method", LogLineFieldOperator_NotEquals, "POST"), true},
+		{NewLogLineFieldFilter("$.request.missing", LogLineFieldOperator_NotEquals, "anything"), true},
+		{NewLogLineFieldFilter("$.request.missing", LogLineFieldOperator_Equals, "anything"), false},
+		{NewLogLineFieldFilter("$.msg", LogLineFieldOperator_MatchesRegex, "^slow"), true},
+		{NewLogLineFieldFilter("$.tags[1]", LogLineFieldOperator_Equals, "v2"), true},
+		{NewLogLineFieldFilter("$.tags[2]", LogLineFieldOperator_Equals, "v2"), false},
+		{NewLogLineFieldFilter(`$["user.id"]`, LogLineFieldOperator_Equals, "1234"), true},
+	}
+
+	for _, testCase := range testCases {
+		fieldFiltersWithPath, err := NewConjunctiveLogFieldFiltersWithPath(ConjunctiveLogLineFieldFilters{*testCase.fieldFilter})
+		require.NoError(t, err)
+
+		isValid, err := logLine.IsValidLogLineBasedOnFieldFilters(fieldFiltersWithPath)
+		require.NoError(t, err)
+		require.Equal(t, testCase.expectedValid, isValid, "Unexpected result for field filter '%+v'", testCase.fieldFilter)
+	}
+}
+
+func TestIsValidLogLineBasedOnFieldFilters_NonJsonLogLinesNeverMatch(t *testing.T) {
+	logLine := NewLogLine("level=warn msg=\"slow request\"", noTimestamp)
+
+	fieldFiltersWithPath, err := NewConjunctiveLogFieldFiltersWithPath(ConjunctiveLogLineFieldFilters{
+		*NewLogLineFieldFilter("$.level", LogLineFieldOperator_NotEquals, "error"),
+	})
+	require.NoError(t, err)
+
+	isValid, err := logLine.IsValidLogLineBasedOnFieldFilters(fieldFiltersWithPath)
+	require.NoError(t, err)
+	require.False(t, isValid)
+}
+
+func TestNewConjunctiveLogFieldFiltersWithPath_InvalidFieldPaths(t *testing.T) {
+	invalidFieldPaths := []string{"", "$", "$.", "a..b", "$.tags[", "$.tags[-1]", "$.tags[first]", "$tags"}
+
+	for _, invalidFieldPath := range invalidFieldPaths {
+		_, err := NewConjunctiveLogFieldFiltersWithPath(ConjunctiveLogLineFieldFilters{
+			*NewLogLineFieldFilter(invalidFieldPath, LogLineFieldOperator_Equals, "value"),
+		})
+		require.Error(t, err, "Expected field path '%v' to be invalid", invalidFieldPath)
+	}
+}
+
+func TestGetLevel(t *testing.T) {
+	levelsByContent := map[string]LogLineLevel{
+		jsonLogLineContent:                                          LogLineLevel_Warn,
+		`{"severity":"ERROR","message":"boom"}`:                     LogLineLevel_Error,
+		`{"level":30,"msg":"bunyan"}`:                               LogLineLevel_Info,
+		`time="2023-08-14T14:57:49Z" level=debug msg="logrus"`:      LogLineLevel_Debug,
+		"2023-08-14 14:57:49 [WARNING] disk almost full":            LogLineLevel_Warn,
+		"FATAL: could not connect to the database":                  LogLineLevel_Fatal,
+		"the information in this line doesn't carry an error level": LogLineLevel_Unknown,
+	}
+
+	for content, expectedLevel := range levelsByContent {
+		require.Equal(t, expectedLevel, NewLogLine(content, noTimestamp).GetLevel(), "Unexpected level for log line '%v'", content)
+	}
+}
+
+func TestIsWithinTimeRange(t *testing.T) {
+	since := time.Date(2023, time.August, 14, 10, 0, 0, 0, time.UTC)
+	until := time.Date(2023, time.August, 14, 12, 0, 0, 0, time.UTC)
+	timeRange := NewLogLineTimeRange(since, until)
+
+	require.True(t, NewLogLine("", since).IsWithinTimeRange(timeRange))
+	require.True(t, NewLogLine("", until).IsWithinTimeRange(timeRange))
+	require.False(t, NewLogLine("", since.Add(-time.Second)).IsWithinTimeRange(timeRange))
+	require.False(t, NewLogLine("", until.Add(time.Second)).IsWithinTimeRange(timeRange))
+	require.True(t, NewLogLine("", noTimestamp).IsWithinTimeRange(timeRange))
+	require.True(t, NewLogLine("", since.Add(-time.Hour)).IsWithinTimeRange(NewLogLineTimeRange(time.Time{}, until)))
+}
@@ -0,0 +1,54 @@
+package logline
+
+import (
+	"time"
+)
+
+// LogLineTimeRange is the [since, until] interval the requested log lines must have been emitted in; a zero bound leaves
+// the interval open on that side
+type LogLineTimeRange struct {
+	since time.Time
+	until time.Time
+}
+
+func NewLogLineTimeRange(since time.Time, until time.Time) *LogLineTimeRange {
+	return &LogLineTimeRange{since: since, until: until}
+}
+
+// NewUnboundedLogLineTimeRange returns a time range that contains every point in time
+func NewUnboundedLogLineTimeRange() *LogLineTimeRange {
+	return NewLogLineTimeRange(time.Time{}, time.Time{})
+}
+
+func (timeRange *LogLineTimeRange) GetSince() time.Time {
+	return timeRange.since
+}
+
+func (timeRange *LogLineTimeRange) GetUntil() time.Time {
+	return timeRange.until
+}
+
+func (timeRange *LogLineTimeRange) IsUnbounded() bool {
+	return timeRange.since.IsZero() && timeRange.until.IsZero()
+}
+
+func (timeRange *LogLineTimeRange) Contains(timestamp time.Time) bool {
+	if !timeRange.since.IsZero() && timestamp.Before(timeRange.since) {
+		return false
+	}
+	if !timeRange.until.IsZero() && timestamp.After(timeRange.until) {
+		return false
+	}
+	return true
+}
+
+// Overlaps returns true if any point in time of the [start, end) interval is inside the range
+func (timeRange *LogLineTimeRange) Overlaps(start time.Time, end time.Time) bool {
+	if !timeRange.since.IsZero() && !end.After(timeRange.since) {
+		return false
+	}
+	if !timeRange.until.IsZero() && start.After(timeRange.until) {
+		return false
+	}
+	return true
+}
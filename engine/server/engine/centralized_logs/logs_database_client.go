@@ -13,6 +13,8 @@ type LogsDatabaseClient interface {
 		enclaveUuid enclave.EnclaveUUID,
 		userServiceUuids map[service.ServiceUUID]bool,
 		conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
+		conjunctiveLogLineFieldFilters logline.ConjunctiveLogLineFieldFilters, // applied over the log lines emitted as JSON objects
+		timeRange *logline.LogLineTimeRange, // only the log lines emitted inside it are streamed, [numLogLines] included
 		shouldFollowLogs bool,
 		shouldReturnAllLogs bool, // if true, stream all log lines
 		numLogLines uint32, // if [shouldReturnAllLogs] is false, stream that only the last [numLogLines]
@@ -39,6 +39,11 @@ func (creator *EnclaveCreator) CreateEnclave(
 	isProduction bool,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	filesArtifactsStorageQuota uint64,
+	cpuQuotaMillicpus uint64,
+	memoryQuotaMegabytes uint64,
+	serviceCountQuota uint32,
+	labels map[string]string,
 ) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
 
 	uuid, err := uuid_generator.GenerateUUIDString()
@@ -50,7 +55,7 @@ func (creator *EnclaveCreator) CreateEnclave(
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 	// Create Enclave with kurtosisBackend
 
-	newEnclave, err := creator.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName)
+	newEnclave, err := creator.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName, labels)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with name `%v` and uuid '%v'", enclaveName, enclaveUuid)
 	}
@@ -80,6 +85,10 @@ func (creator *EnclaveCreator) CreateEnclave(
 		isProduction,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
+		filesArtifactsStorageQuota,
+		cpuQuotaMillicpus,
+		memoryQuotaMegabytes,
+		serviceCountQuota,
 	)
 
 	if err != nil {
@@ -145,6 +154,9 @@ func (creator *EnclaveCreator) CreateEnclave(
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                creationTimestamp,
 		Mode:                        mode,
+		ExpirationTime:              nil,
+		ExpiryAction:                kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY,
+		Labels:                      newEnclave.GetLabels(),
 	}
 
 	// Everything started successfully, so the responsibility of deleting the enclave is now transferred to the caller
@@ -163,6 +175,10 @@ func (creator *EnclaveCreator) launchApiContainer(
 	isProduction bool,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	filesArtifactsStorageQuota uint64,
+	cpuQuotaMillicpus uint64,
+	memoryQuotaMegabytes uint64,
+	serviceCountQuota uint32,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -182,6 +198,10 @@ func (creator *EnclaveCreator) launchApiContainer(
 			isProduction,
 			metricsUserID,
 			didUserAcceptSendingMetrics,
+			filesArtifactsStorageQuota,
+			cpuQuotaMillicpus,
+			memoryQuotaMegabytes,
+			serviceCountQuota,
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with custom version '%v', but an error occurred", enclaveUuid, apiContainerImageVersionTag)
@@ -198,6 +218,10 @@ func (creator *EnclaveCreator) launchApiContainer(
 		isProduction,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
+		filesArtifactsStorageQuota,
+		cpuQuotaMillicpus,
+		memoryQuotaMegabytes,
+		serviceCountQuota,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with the default version, but an error occurred", enclaveUuid)
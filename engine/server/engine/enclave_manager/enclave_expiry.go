@@ -0,0 +1,400 @@
+package enclave_manager
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+const (
+	// NoEnclaveTimeToLive and NoEnclaveIdleTimeout let the enclave live until the user destroys it
+	NoEnclaveTimeToLive  = time.Duration(0)
+	NoEnclaveIdleTimeout = time.Duration(0)
+
+	enclaveExpiryReapingInterval = time.Minute
+
+	// a reaping cycle polls all the API containers, it shouldn't hang on an unresponsive one
+	enclaveExpiryReapingTimeout = 30 * time.Second
+)
+
+// enclaveExpiry is the engine copy of the expiry stored by the API container of the enclave. The API container is the
+// source of truth while it runs; the engine persists its copy for the enclaves whose API container is stopped
+type enclaveExpiry struct {
+	// nil when the enclave has no time-to-live
+	expirationTime *time.Time
+
+	// zero when the enclave has no idle timeout
+	idleTimeout time.Duration
+
+	expiryAction kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction
+
+	// last API call received by the API container or last log written by a service, whichever is the latest
+	lastActivityTime time.Time
+}
+
+func newEnclaveExpiryFromApiContainerExpiry(apiContainerExpiry *kurtosis_core_rpc_api_bindings.EnclaveExpiry) *enclaveExpiry {
+	if apiContainerExpiry.ExpirationTime == nil && apiContainerExpiry.GetIdleTimeoutSeconds() == 0 {
+		return nil
+	}
+	var expirationTime *time.Time
+	if apiContainerExpiry.ExpirationTime != nil {
+		expirationTimeValue := apiContainerExpiry.GetExpirationTime().AsTime()
+		expirationTime = &expirationTimeValue
+	}
+	expiryAction := kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP
+	if apiContainerExpiry.GetShouldDestroy() {
+		expiryAction = kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY
+	}
+	return &enclaveExpiry{
+		expirationTime:   expirationTime,
+		idleTimeout:      time.Duration(apiContainerExpiry.GetIdleTimeoutSeconds()) * time.Second,
+		expiryAction:     expiryAction,
+		lastActivityTime: apiContainerExpiry.GetLastActivityTime().AsTime(),
+	}
+}
+
+// getEffectiveExpirationTime returns the earliest of the time-to-live expiration and the idle timeout expiration
+func (expiry *enclaveExpiry) getEffectiveExpirationTime() time.Time {
+	var effectiveExpirationTime time.Time
+	if expiry.expirationTime != nil {
+		effectiveExpirationTime = *expiry.expirationTime
+	}
+	if expiry.idleTimeout > 0 {
+		idleExpirationTime := expiry.lastActivityTime.Add(expiry.idleTimeout)
+		if effectiveExpirationTime.IsZero() || idleExpirationTime.Before(effectiveExpirationTime) {
+			effectiveExpirationTime = idleExpirationTime
+		}
+	}
+	return effectiveExpirationTime
+}
+
+func (expiry *enclaveExpiry) isExpired(now time.Time) bool {
+	return !now.Before(expiry.getEffectiveExpirationTime())
+}
+
+// ExtendEnclave pushes back the expiration of the enclave by the given duration, counted from its current expiration
+// time or from now if it's already past. It also resets the idle timeout of the enclave. An enclave without expiry
+// gets a time-to-live of the given duration. It returns the new expiration time of the enclave
+func (manager *EnclaveManager) ExtendEnclave(ctx context.Context, enclaveIdentifier string, extension time.Duration) (*timestamppb.Timestamp, error) {
+	if extension <= 0 {
+		return nil, stacktrace.NewError("The enclave expiration can only be extended by a positive duration but got '%v'", extension)
+	}
+
+	enclaveInfo, err := manager.getEnclaveInfoForIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclave '%v'", enclaveIdentifier)
+	}
+	if enclaveInfo.GetApiContainerStatus() != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+		return nil, stacktrace.NewError("The expiration of enclave '%v' can't be extended because its API container isn't running; start the enclave first", enclaveIdentifier)
+	}
+
+	// like for the reaping, the API container is called without holding the mutex, which is only taken to store the result
+	expiry, err := manager.fetchEnclaveExpiry(ctx, enclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the current expiry of enclave '%v'", enclaveIdentifier)
+	}
+
+	now := time.Now()
+	newExpirationTime := now.Add(extension)
+	newExpiry := &enclaveExpiry{
+		expirationTime:   &newExpirationTime,
+		idleTimeout:      NoEnclaveIdleTimeout,
+		expiryAction:     kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY,
+		lastActivityTime: now,
+	}
+	if expiry != nil {
+		newExpiry.idleTimeout = expiry.idleTimeout
+		newExpiry.expiryAction = expiry.expiryAction
+		if expiry.expirationTime == nil {
+			// the enclave only had an idle timeout, extending it doesn't add a time-to-live
+			newExpiry.expirationTime = nil
+		} else if expiry.expirationTime.After(now) {
+			extendedExpirationTime := expiry.expirationTime.Add(extension)
+			newExpiry.expirationTime = &extendedExpirationTime
+		}
+	}
+
+	if err := sendEnclaveExpiryToApiContainer(ctx, enclaveInfo, newExpiry); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending the new expiry to the API container of enclave '%v'", enclaveIdentifier)
+	}
+	if err := manager.storeEnclaveExpiry(enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid()), newExpiry); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred storing the new expiry of enclave '%v'", enclaveIdentifier)
+	}
+	return timestamppb.New(newExpiry.getEffectiveExpirationTime()), nil
+}
+
+// this should be called from a thread safe context
+func (manager *EnclaveManager) setNewEnclaveExpiryWithoutMutex(
+	ctx context.Context,
+	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
+	timeToLive time.Duration,
+	idleTimeout time.Duration,
+	expiryAction kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction,
+) error {
+	now := time.Now()
+	expiry := &enclaveExpiry{
+		expirationTime:   nil,
+		idleTimeout:      idleTimeout,
+		expiryAction:     expiryAction,
+		lastActivityTime: now,
+	}
+	if timeToLive != NoEnclaveTimeToLive {
+		expirationTime := now.Add(timeToLive)
+		expiry.expirationTime = &expirationTime
+	}
+	if err := manager.setEnclaveExpiryWithoutMutex(ctx, enclaveInfo, expiry); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting time-to-live '%v' and idle timeout '%v' on enclave '%v'", timeToLive, idleTimeout, enclaveInfo.GetName())
+	}
+	return nil
+}
+
+// this should be called from a thread safe context
+func (manager *EnclaveManager) setEnclaveExpiryWithoutMutex(
+	ctx context.Context,
+	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
+	expiry *enclaveExpiry,
+) error {
+	if err := sendEnclaveExpiryToApiContainer(ctx, enclaveInfo, expiry); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the expiry to the API container of enclave '%v'", enclaveInfo.GetName())
+	}
+	if err := manager.storeEnclaveExpiryWithoutMutex(enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid()), expiry); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the expiry of enclave '%v'", enclaveInfo.GetName())
+	}
+	return nil
+}
+
+// storeEnclaveExpiryWithoutMutex caches the expiry and persists it in the engine database, so the engine still knows
+// the expiry of the enclaves whose API container is stopped after a restart. A nil expiry means the enclave never expires
+// this should be called from a thread safe context
+func (manager *EnclaveManager) storeEnclaveExpiryWithoutMutex(enclaveUuid enclave.EnclaveUUID, expiry *enclaveExpiry) error {
+	if expiry == nil {
+		if err := manager.enclaveExpiryRepository.Delete(enclaveUuid); err != nil {
+			return stacktrace.Propagate(err, "An error occurred deleting the persisted expiry of enclave '%v'", enclaveUuid)
+		}
+		delete(manager.enclaveExpiries, enclaveUuid)
+		return nil
+	}
+	if err := manager.enclaveExpiryRepository.Save(enclaveUuid, expiry); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the expiry of enclave '%v'", enclaveUuid)
+	}
+	manager.enclaveExpiries[enclaveUuid] = expiry
+	return nil
+}
+
+// fetchEnclaveExpiry gets the expiry from the API container of the enclave, which must be running, without storing it.
+// Returns nil if the enclave doesn't expire
+func (manager *EnclaveManager) fetchEnclaveExpiry(
+	ctx context.Context,
+	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
+) (*enclaveExpiry, error) {
+	apiContainerConnection, apiContainerClient, err := createApiContainerClient(enclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", enclaveInfo.GetName())
+	}
+	defer apiContainerConnection.Close()
+
+	apiContainerExpiry, err := apiContainerClient.GetEnclaveExpiry(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the expiry from the API container of enclave '%v'", enclaveInfo.GetName())
+	}
+	expiry := newEnclaveExpiryFromApiContainerExpiry(apiContainerExpiry)
+	if expiry == nil {
+		return nil, nil
+	}
+
+	// services that keep logging count as activity, even if nobody calls the API container
+	lastServiceLogTime, err := manager.enclaveLogFileManager.GetLastServiceLogTime(ctx, enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid()))
+	if err != nil {
+		logrus.Warnf("An error occurred getting the last time a service of enclave '%v' wrote logs, only the API calls will count as activity:\n%v", enclaveInfo.GetName(), err)
+	} else if lastServiceLogTime.After(expiry.lastActivityTime) {
+		expiry.lastActivityTime = lastServiceLogTime
+	}
+	return expiry, nil
+}
+
+func sendEnclaveExpiryToApiContainer(
+	ctx context.Context,
+	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
+	expiry *enclaveExpiry,
+) error {
+	apiContainerConnection, apiContainerClient, err := createApiContainerClient(enclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", enclaveInfo.GetName())
+	}
+	defer apiContainerConnection.Close()
+
+	apiContainerExpiry := &kurtosis_core_rpc_api_bindings.EnclaveExpiry{
+		ExpirationTime:     nil,
+		IdleTimeoutSeconds: 0,
+		ShouldDestroy:      false,
+		LastActivityTime:   nil,
+	}
+	if expiry != nil {
+		if expiry.expirationTime != nil {
+			apiContainerExpiry.ExpirationTime = timestamppb.New(*expiry.expirationTime)
+		}
+		apiContainerExpiry.IdleTimeoutSeconds = uint32(expiry.idleTimeout.Seconds())
+		apiContainerExpiry.ShouldDestroy = expiry.expiryAction == kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY
+	}
+	if _, err := apiContainerClient.SetEnclaveExpiry(ctx, apiContainerExpiry); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the expiry on the API container of enclave '%v'", enclaveInfo.GetName())
+	}
+	return nil
+}
+
+// runEnclaveExpiryReaper reaps the expired enclaves right away, as the engine may have been down when some expired,
+// and then on every reaping interval until the context is cancelled
+func (manager *EnclaveManager) runEnclaveExpiryReaper(ctx context.Context) {
+	logrus.Debugf("Scheduling the reaping of expired enclaves every '%v'...", enclaveExpiryReapingInterval)
+	reapingTicker := time.NewTicker(enclaveExpiryReapingInterval)
+	defer reapingTicker.Stop()
+	for {
+		manager.reapExpiredEnclaves(ctx)
+		select {
+		case <-reapingTicker.C:
+		case <-ctx.Done():
+			logrus.Debug("Enclave expiry reaper stopped")
+			return
+		}
+	}
+}
+
+// reapExpiredEnclaves only holds the mutex while it reads or changes the state of the enclaves, never while it calls
+// the API containers, so an unresponsive API container doesn't block the engine
+func (manager *EnclaveManager) reapExpiredEnclaves(ctx context.Context) {
+	enclaves, err := manager.getEnclavesForReaping(ctx)
+	if err != nil {
+		logrus.Errorf("An error occurred getting the enclaves to reap the expired ones:\n%v", err)
+		return
+	}
+	for enclaveUuid, enclaveInfo := range enclaves {
+		// each enclave gets its own timeout, so an unresponsive one doesn't prevent reaping the others
+		enclaveCtx, cancel := context.WithTimeout(ctx, enclaveExpiryReapingTimeout)
+		manager.reapEnclaveIfExpired(enclaveCtx, enclaveUuid, enclaveInfo)
+		cancel()
+	}
+}
+
+func (manager *EnclaveManager) getEnclavesForReaping(ctx context.Context) (map[enclave.EnclaveUUID]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, enclaveExpiryReapingTimeout)
+	defer cancel()
+
+	enclaves, err := manager.getEnclavesWithoutMutex(ctxWithTimeout)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+
+	// forget about the enclaves that were destroyed
+	for enclaveUuid := range manager.enclaveExpiries {
+		if _, found := enclaves[enclaveUuid]; found {
+			continue
+		}
+		if err := manager.storeEnclaveExpiryWithoutMutex(enclaveUuid, nil); err != nil {
+			logrus.Warnf("An error occurred forgetting the expiry of destroyed enclave '%v':\n%v", enclaveUuid, err)
+		}
+	}
+	return enclaves, nil
+}
+
+func (manager *EnclaveManager) reapEnclaveIfExpired(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
+) {
+	// the stored expiry is used for the enclaves whose API container is stopped, as they can't be asked for it
+	isApiContainerRunning := enclaveInfo.GetApiContainerStatus() == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING
+	if isApiContainerRunning {
+		apiContainerExpiry, err := manager.fetchEnclaveExpiry(ctx, enclaveInfo)
+		if err != nil {
+			logrus.Warnf("An error occurred refreshing the expiry of enclave '%v', it won't be reaped in this cycle:\n%v", enclaveInfo.GetName(), err)
+			return
+		}
+		if err := manager.storeEnclaveExpiry(enclaveUuid, apiContainerExpiry); err != nil {
+			logrus.Warnf("An error occurred storing the expiry of enclave '%v', it won't be reaped in this cycle:\n%v", enclaveInfo.GetName(), err)
+			return
+		}
+	}
+	expiry := manager.getStoredEnclaveExpiry(enclaveUuid)
+	if expiry == nil || !expiry.isExpired(time.Now()) {
+		return
+	}
+
+	switch expiry.expiryAction {
+	case kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY:
+		logrus.Infof("Enclave '%v' expired at '%v', destroying it...", enclaveInfo.GetName(), expiry.getEffectiveExpirationTime())
+		if err := manager.destroyExpiredEnclave(ctx, enclaveUuid); err != nil {
+			logrus.Errorf("An error occurred destroying expired enclave '%v':\n%v", enclaveInfo.GetName(), err)
+		}
+	case kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP:
+		if !isApiContainerRunning {
+			return
+		}
+		logrus.Infof("Enclave '%v' expired at '%v', stopping it...", enclaveInfo.GetName(), expiry.getEffectiveExpirationTime())
+		// the expiry is cleared first, otherwise the enclave would be stopped again as soon as the user starts it
+		if err := sendEnclaveExpiryToApiContainer(ctx, enclaveInfo, nil); err != nil {
+			logrus.Errorf("An error occurred clearing the expiry of expired enclave '%v', it won't be stopped:\n%v", enclaveInfo.GetName(), err)
+			return
+		}
+		if err := manager.stopExpiredEnclave(ctx, enclaveUuid); err != nil {
+			logrus.Errorf("An error occurred stopping expired enclave '%v':\n%v", enclaveInfo.GetName(), err)
+		}
+	default:
+		logrus.Errorf("Unrecognized expiry action '%v' for enclave '%v'; this is a bug in Kurtosis", expiry.expiryAction, enclaveInfo.GetName())
+	}
+}
+
+func (manager *EnclaveManager) storeEnclaveExpiry(enclaveUuid enclave.EnclaveUUID, expiry *enclaveExpiry) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	return manager.storeEnclaveExpiryWithoutMutex(enclaveUuid, expiry)
+}
+
+// getStoredEnclaveExpiry returns nil if the enclave doesn't expire
+func (manager *EnclaveManager) getStoredEnclaveExpiry(enclaveUuid enclave.EnclaveUUID) *enclaveExpiry {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	return manager.enclaveExpiries[enclaveUuid]
+}
+
+func (manager *EnclaveManager) destroyExpiredEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	if err := manager.destroyEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the enclave")
+	}
+	if err := manager.storeEnclaveExpiryWithoutMutex(enclaveUuid, nil); err != nil {
+		return stacktrace.Propagate(err, "The enclave was destroyed but an error occurred forgetting its expiry")
+	}
+	return nil
+}
+
+func (manager *EnclaveManager) stopExpiredEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	if err := manager.storeEnclaveExpiryWithoutMutex(enclaveUuid, nil); err != nil {
+		return stacktrace.Propagate(err, "An error occurred forgetting the expiry of the enclave")
+	}
+	if err := manager.stopEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred stopping the enclave")
+	}
+	return nil
+}
+
+// this should be called from a thread safe context
+func (manager *EnclaveManager) addEnclaveExpiryInfoWithoutMutex(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) {
+	expiry, found := manager.enclaveExpiries[enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid())]
+	if !found {
+		return
+	}
+	enclaveInfo.ExpirationTime = timestamppb.New(expiry.getEffectiveExpirationTime())
+	enclaveInfo.ExpiryAction = expiry.expiryAction
+}
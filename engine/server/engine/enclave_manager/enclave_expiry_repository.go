@@ -0,0 +1,104 @@
+package enclave_manager
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+	"time"
+)
+
+var (
+	enclaveExpiryBucketName = []byte("enclave-expiry-repository")
+)
+
+// storedEnclaveExpiry is the serialized form of enclaveExpiry
+type storedEnclaveExpiry struct {
+	ExpirationTime *time.Time `json:"expirationTime"`
+
+	IdleTimeout time.Duration `json:"idleTimeout"`
+
+	ExpiryAction kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction `json:"expiryAction"`
+
+	LastActivityTime time.Time `json:"lastActivityTime"`
+}
+
+// enclaveExpiryRepository persists the last expiry the engine knows of for each enclave, so the enclaves that can't be
+// asked for their expiry because their API container is stopped still expire after an engine restart
+type enclaveExpiryRepository struct {
+	engineDb *engine_db.EngineDB
+}
+
+func newEnclaveExpiryRepository(engineDb *engine_db.EngineDB) (*enclaveExpiryRepository, error) {
+	if err := engineDb.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(enclaveExpiryBucketName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the enclave expiry bucket")
+		}
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the enclave expiry repository")
+	}
+	return &enclaveExpiryRepository{
+		engineDb: engineDb,
+	}, nil
+}
+
+func (repository *enclaveExpiryRepository) Save(enclaveUuid enclave.EnclaveUUID, expiry *enclaveExpiry) error {
+	if expiry == nil {
+		return stacktrace.NewError("Expected to receive an expiry for enclave '%v' but a nil value was received instead, this is a bug in Kurtosis", enclaveUuid)
+	}
+	serializedExpiry, err := json.Marshal(&storedEnclaveExpiry{
+		ExpirationTime:   expiry.expirationTime,
+		IdleTimeout:      expiry.idleTimeout,
+		ExpiryAction:     expiry.expiryAction,
+		LastActivityTime: expiry.lastActivityTime,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the expiry of enclave '%v'", enclaveUuid)
+	}
+	if err := repository.engineDb.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(enclaveExpiryBucketName).Put([]byte(enclaveUuid), serializedExpiry); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while saving the expiry into the engine db bucket")
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while saving the expiry of enclave '%v' into the engine db", enclaveUuid)
+	}
+	return nil
+}
+
+func (repository *enclaveExpiryRepository) Delete(enclaveUuid enclave.EnclaveUUID) error {
+	if err := repository.engineDb.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(enclaveExpiryBucketName).Delete([]byte(enclaveUuid)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while deleting the expiry from the engine db bucket")
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while deleting the expiry of enclave '%v' from the engine db", enclaveUuid)
+	}
+	return nil
+}
+
+func (repository *enclaveExpiryRepository) GetAll() (map[enclave.EnclaveUUID]*enclaveExpiry, error) {
+	expiries := map[enclave.EnclaveUUID]*enclaveExpiry{}
+	if err := repository.engineDb.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(enclaveExpiryBucketName).ForEach(func(enclaveUuidBytes, serializedExpiry []byte) error {
+			stored := new(storedEnclaveExpiry)
+			if err := json.Unmarshal(serializedExpiry, stored); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deserializing the expiry of enclave '%v'", string(enclaveUuidBytes))
+			}
+			expiries[enclave.EnclaveUUID(enclaveUuidBytes)] = &enclaveExpiry{
+				expirationTime:   stored.ExpirationTime,
+				idleTimeout:      stored.IdleTimeout,
+				expiryAction:     stored.ExpiryAction,
+				lastActivityTime: stored.LastActivityTime,
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the enclave expiries from the engine db")
+	}
+	return expiries, nil
+}
@@ -0,0 +1,106 @@
+package enclave_manager
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_db"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestGetEffectiveExpirationTime_EarliestOfTimeToLiveAndIdleTimeout(t *testing.T) {
+	now := time.Now()
+	expirationTime := now.Add(time.Hour)
+	expiry := &enclaveExpiry{
+		expirationTime:   &expirationTime,
+		idleTimeout:      10 * time.Minute,
+		expiryAction:     kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY,
+		lastActivityTime: now,
+	}
+	require.Equal(t, now.Add(10*time.Minute), expiry.getEffectiveExpirationTime())
+	require.False(t, expiry.isExpired(now))
+	require.True(t, expiry.isExpired(now.Add(10*time.Minute)))
+
+	expiry.lastActivityTime = now.Add(55 * time.Minute)
+	require.Equal(t, expirationTime, expiry.getEffectiveExpirationTime())
+}
+
+func TestGetEffectiveExpirationTime_IdleTimeoutOnly(t *testing.T) {
+	now := time.Now()
+	expiry := &enclaveExpiry{
+		expirationTime:   nil,
+		idleTimeout:      10 * time.Minute,
+		expiryAction:     kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP,
+		lastActivityTime: now,
+	}
+	require.Equal(t, now.Add(10*time.Minute), expiry.getEffectiveExpirationTime())
+}
+
+func TestNewEnclaveExpiryFromApiContainerExpiry(t *testing.T) {
+	lastActivityTime := timestamppb.Now()
+	noExpiry := &kurtosis_core_rpc_api_bindings.EnclaveExpiry{
+		ExpirationTime:     nil,
+		IdleTimeoutSeconds: 0,
+		ShouldDestroy:      false,
+		LastActivityTime:   lastActivityTime,
+	}
+	require.Nil(t, newEnclaveExpiryFromApiContainerExpiry(noExpiry))
+
+	idleExpiry := &kurtosis_core_rpc_api_bindings.EnclaveExpiry{
+		ExpirationTime:     nil,
+		IdleTimeoutSeconds: 600,
+		ShouldDestroy:      false,
+		LastActivityTime:   lastActivityTime,
+	}
+	expiry := newEnclaveExpiryFromApiContainerExpiry(idleExpiry)
+	require.NotNil(t, expiry)
+	require.Nil(t, expiry.expirationTime)
+	require.Equal(t, 10*time.Minute, expiry.idleTimeout)
+	require.Equal(t, kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP, expiry.expiryAction)
+	require.True(t, lastActivityTime.AsTime().Equal(expiry.lastActivityTime))
+}
+
+func TestEnclaveExpiryRepository_ExpiriesSurviveReopeningTheDatabase(t *testing.T) {
+	databaseFilepath := path.Join(t.TempDir(), "engine.db")
+	engineDb, err := engine_db.OpenEngineDatabase(databaseFilepath)
+	require.NoError(t, err)
+	repository, err := newEnclaveExpiryRepository(engineDb)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	expirationTime := now.Add(time.Hour)
+	stoppedEnclaveUuid := enclave.EnclaveUUID("stopped-enclave")
+	stoppedEnclaveExpiry := &enclaveExpiry{
+		expirationTime:   &expirationTime,
+		idleTimeout:      10 * time.Minute,
+		expiryAction:     kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY,
+		lastActivityTime: now,
+	}
+	destroyedEnclaveUuid := enclave.EnclaveUUID("destroyed-enclave")
+	require.NoError(t, repository.Save(stoppedEnclaveUuid, stoppedEnclaveExpiry))
+	require.NoError(t, repository.Save(destroyedEnclaveUuid, stoppedEnclaveExpiry))
+	require.NoError(t, repository.Delete(destroyedEnclaveUuid))
+	require.NoError(t, engineDb.Close())
+
+	// the engine restarts
+	engineDb, err = engine_db.OpenEngineDatabase(databaseFilepath)
+	require.NoError(t, err)
+	defer engineDb.Close()
+	repository, err = newEnclaveExpiryRepository(engineDb)
+	require.NoError(t, err)
+
+	expiries, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Len(t, expiries, 1)
+	require.Contains(t, expiries, stoppedEnclaveUuid)
+	loadedExpiry := expiries[stoppedEnclaveUuid]
+	require.NotNil(t, loadedExpiry.expirationTime)
+	require.True(t, expirationTime.Equal(*loadedExpiry.expirationTime))
+	require.Equal(t, stoppedEnclaveExpiry.idleTimeout, loadedExpiry.idleTimeout)
+	require.Equal(t, stoppedEnclaveExpiry.expiryAction, loadedExpiry.expiryAction)
+	require.True(t, now.Equal(loadedExpiry.lastActivityTime))
+}
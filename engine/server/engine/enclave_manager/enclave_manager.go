@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
@@ -17,9 +18,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_db"
 	"github.com/kurtosis-tech/kurtosis/name_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -33,6 +36,15 @@ const (
 	errorDelimiter = ", "
 
 	enclaveNameNotFound = "Name Not Found"
+
+	// NoFilesArtifactsStorageQuota lets the files artifacts of an enclave use as much storage as they need
+	NoFilesArtifactsStorageQuota uint64 = 0
+	// NoCpuQuota lets the services of an enclave be allocated as much CPU as they need
+	NoCpuQuota uint64 = 0
+	// NoMemoryQuota lets the services of an enclave be allocated as much memory as they need
+	NoMemoryQuota uint64 = 0
+	// NoServiceCountQuota lets an enclave have as many services as it needs
+	NoServiceCountQuota uint32 = 0
 )
 
 // TODO Move this to the KurtosisBackend to calculate!!
@@ -47,6 +59,9 @@ var isContainerRunningDeterminer = map[types.ContainerStatus]bool{
 	types.ContainerStatus_Exited:     false,
 }
 
+// The idle enclaves of the pool, and the enclaves created without labels, have none
+var noEnclaveLabels map[string]string
+
 // Manages Kurtosis enclaves, and creates new ones in response to running tasks
 type EnclaveManager struct {
 	// We use Docker as our backing datastore, but it has tons of race conditions so we use this mutex to ensure
@@ -68,6 +83,12 @@ type EnclaveManager struct {
 
 	metricsUserID               string
 	didUserAcceptSendingMetrics bool
+
+	// expiry of the enclaves that have one, loaded from their API containers by the reaper and persisted in the
+	// engine database for when their API containers are stopped
+	enclaveExpiries         map[enclave.EnclaveUUID]*enclaveExpiry
+	enclaveExpiryRepository *enclaveExpiryRepository
+	cancelExpiryReaperFunc  context.CancelFunc
 }
 
 func CreateEnclaveManager(
@@ -80,6 +101,7 @@ func CreateEnclaveManager(
 	enclaveLogFileManager *log_file_manager.LogFileManager,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	engineDb *engine_db.EngineDB,
 ) (*EnclaveManager, error) {
 	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier)
 
@@ -96,6 +118,17 @@ func CreateEnclaveManager(
 		}
 	}
 
+	enclaveExpiryRepository, err := newEnclaveExpiryRepository(engineDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave expiry repository")
+	}
+	enclaveExpiries, err := enclaveExpiryRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the persisted enclave expiries")
+	}
+
+	expiryReaperCtx, cancelExpiryReaperFunc := context.WithCancel(context.Background())
+
 	enclaveManager := &EnclaveManager{
 		mutex:           &sync.Mutex{},
 		kurtosisBackend: kurtosisBackend,
@@ -107,8 +140,13 @@ func CreateEnclaveManager(
 		enclaveLogFileManager:                     enclaveLogFileManager,
 		metricsUserID:                             metricsUserID,
 		didUserAcceptSendingMetrics:               didUserAcceptSendingMetrics,
+		enclaveExpiries:                           enclaveExpiries,
+		enclaveExpiryRepository:                   enclaveExpiryRepository,
+		cancelExpiryReaperFunc:                    cancelExpiryReaperFunc,
 	}
 
+	go enclaveManager.runEnclaveExpiryReaper(expiryReaperCtx)
+
 	return enclaveManager, nil
 }
 
@@ -124,6 +162,18 @@ func (manager *EnclaveManager) CreateEnclave(
 	//If blank, will use a random one
 	enclaveName string,
 	isProduction bool,
+	filesArtifactsStorageQuota uint64,
+	// Bound the sum of the max_cpu and max_memory of the services of the enclave
+	cpuQuotaMillicpus uint64,
+	memoryQuotaMegabytes uint64,
+	serviceCountQuota uint32,
+	// The enclave expires once the time-to-live passes, or once it received no API call and its services wrote no
+	// logs for the idle timeout, whichever comes first
+	timeToLive time.Duration,
+	idleTimeout time.Duration,
+	expiryAction kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction,
+	// Stored as labels of the enclave's objects in the backend, so they can later be used to select enclaves
+	labels map[string]string,
 ) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -152,8 +202,17 @@ func (manager *EnclaveManager) CreateEnclave(
 		return nil, stacktrace.Propagate(err, "An error occurred validating enclave name '%v'", enclaveName)
 	}
 
+	if err := enclave.ValidateEnclaveLabels(labels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the labels of enclave '%v'", enclaveName)
+	}
+
 	// TODO(victor.colombo): Extend enclave pool to have warm production enclaves
-	if !isProduction && manager.enclavePool != nil {
+	// The idle enclaves of the pool have no quota nor labels, an enclave with either is always created from scratch
+	hasQuota := filesArtifactsStorageQuota != NoFilesArtifactsStorageQuota ||
+		cpuQuotaMillicpus != NoCpuQuota ||
+		memoryQuotaMegabytes != NoMemoryQuota ||
+		serviceCountQuota != NoServiceCountQuota
+	if !isProduction && !hasQuota && len(labels) == 0 && manager.enclavePool != nil {
 		enclaveInfo, err = manager.enclavePool.GetEnclave(
 			setupCtx,
 			enclaveName,
@@ -176,6 +235,11 @@ func (manager *EnclaveManager) CreateEnclave(
 			isProduction,
 			manager.metricsUserID,
 			manager.didUserAcceptSendingMetrics,
+			filesArtifactsStorageQuota,
+			cpuQuotaMillicpus,
+			memoryQuotaMegabytes,
+			serviceCountQuota,
+			labels,
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(
@@ -188,6 +252,17 @@ func (manager *EnclaveManager) CreateEnclave(
 		}
 	}
 
+	if timeToLive != NoEnclaveTimeToLive || idleTimeout != NoEnclaveIdleTimeout {
+		if err := manager.setNewEnclaveExpiryWithoutMutex(setupCtx, enclaveInfo, timeToLive, idleTimeout, expiryAction); err != nil {
+			if destroyErr := manager.destroyEnclaveWithoutMutex(setupCtx, enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid())); destroyErr != nil {
+				logrus.Errorf("Setting the expiry of the new enclave failed so we tried to destroy enclave '%v', but doing so threw an error:\n%v", enclaveInfo.GetName(), destroyErr)
+				logrus.Errorf("ACTION REQUIRED: You'll need to destroy enclave '%v' manually!!!!", enclaveInfo.GetName())
+			}
+			return nil, stacktrace.Propagate(err, "An error occurred setting the expiry of new enclave '%v'", enclaveInfo.GetName())
+		}
+		manager.addEnclaveExpiryInfoWithoutMutex(enclaveInfo)
+	}
+
 	enclaveIdentifier := &kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers{
 		EnclaveUuid:   enclaveInfo.EnclaveUuid,
 		Name:          enclaveInfo.Name,
@@ -201,13 +276,16 @@ func (manager *EnclaveManager) CreateEnclave(
 // It's a liiiitle weird that we return an EnclaveInfo object (which is a Protobuf object), but as of 2021-10-21 this class
 //
 //	is only used by the EngineServerService so we might as well return the object that EngineServerService wants
+//
+// If the label selector isn't empty, only the enclaves having all of its labels are returned
 func (manager *EnclaveManager) GetEnclaves(
 	ctx context.Context,
+	labelSelector map[string]string,
 ) (map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 
-	enclaves, err := manager.getEnclavesWithoutMutex(ctx)
+	enclaves, err := manager.getEnclavesMatchingLabelsWithoutMutex(ctx, labelSelector)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves without the mutex")
 	}
@@ -234,23 +312,117 @@ func (manager *EnclaveManager) StopEnclave(ctx context.Context, enclaveIdentifie
 	return manager.stopEnclaveWithoutMutex(ctx, enclaveUuid)
 }
 
+// StartEnclave starts a stopped enclave again: its API container is restarted first, then it resumes the user services
+// that were running when the enclave stopped. It returns the names of the resumed services
+func (manager *EnclaveManager) StartEnclave(ctx context.Context, enclaveIdentifier string) ([]string, error) {
+	enclaveInfo, err := manager.startEnclaveContainers(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the containers of enclave '%v'", enclaveIdentifier)
+	}
+
+	// the services are resumed without holding the engine mutex, as the API container waits for each of them to be
+	// ready, which would block every other enclave operation in the meantime
+	apiContainerConnection, apiContainerClient, err := createApiContainerClient(enclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", enclaveIdentifier)
+	}
+	defer apiContainerConnection.Close()
+
+	resumeServicesResponse, err := apiContainerClient.ResumeServices(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The API container of enclave '%v' was started but an error occurred resuming its services", enclaveIdentifier)
+	}
+	return resumeServicesResponse.GetResumedServiceNames(), nil
+}
+
+// startEnclaveContainers starts the containers of the stopped enclave and returns its info once its API container is
+// running again
+func (manager *EnclaveManager) startEnclaveContainers(ctx context.Context, enclaveIdentifier string) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveInfo, err := manager.getEnclaveInfoForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclave '%v'", enclaveIdentifier)
+	}
+	if enclaveInfo.GetApiContainerStatus() == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING &&
+		enclaveInfo.GetContainersStatus() == kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING {
+		return nil, stacktrace.NewError("Enclave '%v' is already running", enclaveIdentifier)
+	}
+
+	if err := manager.startEnclaveWithoutMutex(ctx, enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid())); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting enclave '%v'", enclaveIdentifier)
+	}
+
+	// the API container info, like its bridge network IP address, is only known once it's running again
+	enclaveInfo, err = manager.getEnclaveInfoForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclave '%v' once it was started", enclaveIdentifier)
+	}
+	return enclaveInfo, nil
+}
+
 // DestroyEnclave
 // TODO remove these notes - this should be working on active enclaves as well
 // Destroys an enclave, deleting all objects associated with it in the container engine (containers, volumes, networks, etc.)
-func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
+// If the label selector isn't empty the enclave must have all of its labels; if the enclave identifier is empty, every
+// enclave having them is destroyed
+func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdentifier string, labelSelector map[string]string) error {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 
+	if enclaveIdentifier == "" {
+		if len(labelSelector) == 0 {
+			return stacktrace.NewError("Either an enclave identifier or a label selector is required to destroy enclaves")
+		}
+		return manager.destroyEnclavesMatchingLabelsWithoutMutex(ctx, labelSelector)
+	}
+
 	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
 
+	if len(labelSelector) > 0 {
+		matchingEnclaves, err := manager.getEnclavesMatchingLabelsWithoutMutex(ctx, labelSelector)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the enclaves matching label selector '%+v'", labelSelector)
+		}
+		if _, found := matchingEnclaves[enclaveUuid]; !found {
+			return stacktrace.NewError("Enclave '%v' doesn't match label selector '%+v' so it won't be destroyed", enclaveIdentifier, labelSelector)
+		}
+	}
+
+	return manager.destroyEnclaveWithoutMutex(ctx, enclaveUuid)
+}
+
+// this should be called from a thread safe context
+func (manager *EnclaveManager) destroyEnclavesMatchingLabelsWithoutMutex(ctx context.Context, labelSelector map[string]string) error {
+	matchingEnclaves, err := manager.getEnclavesMatchingLabelsWithoutMutex(ctx, labelSelector)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves matching label selector '%+v'", labelSelector)
+	}
+
+	var destructionErrorStrings []string
+	for enclaveUuid, enclaveInfo := range matchingEnclaves {
+		if err := manager.destroyEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+			destructionErrorStrings = append(destructionErrorStrings, fmt.Sprintf("Enclave '%v': %v", enclaveInfo.GetName(), err.Error()))
+		}
+	}
+	if len(destructionErrorStrings) > 0 {
+		return stacktrace.NewError("The following errors occurred destroying the enclaves matching label selector '%+v':\n%v", labelSelector, strings.Join(destructionErrorStrings, errorDelimiter))
+	}
+	return nil
+}
+
+// this should be called from a thread safe context
+func (manager *EnclaveManager) destroyEnclaveWithoutMutex(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
 	enclaveDestroyFilter := &enclave.EnclaveFilters{
 		UUIDs: map[enclave.EnclaveUUID]bool{
 			enclaveUuid: true,
 		},
 		Statuses: nil,
+		Labels:   nil,
 	}
 	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, enclaveDestroyFilter)
 	if err != nil {
@@ -258,25 +430,27 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 	}
 	if _, found := successfullyDestroyedEnclaves[enclaveUuid]; found {
 		if err = manager.enclaveLogFileManager.RemoveEnclaveLogs(string(enclaveUuid)); err != nil {
-			return stacktrace.Propagate(err, "An error occurred attempting to remove enclave '%v' logs after it was destroyed.", enclaveIdentifier)
+			return stacktrace.Propagate(err, "An error occurred attempting to remove enclave '%v' logs after it was destroyed.", enclaveUuid)
 		}
 		return nil
 	}
 	destructionErr, found := erroredEnclaves[enclaveUuid]
 	if !found {
-		return stacktrace.NewError("The requested enclave UUD '%v' for identifier '%v' wasn't found in the successfully-destroyed enclaves map, nor in the errors map; this is a bug in Kurtosis!", enclaveUuid, enclaveIdentifier)
+		return stacktrace.NewError("The requested enclave UUD '%v' wasn't found in the successfully-destroyed enclaves map, nor in the errors map; this is a bug in Kurtosis!", enclaveUuid)
 	}
 	return destructionErr
 }
 
-func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) ([]*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid, error) {
+// Clean destroys the stopped enclaves, or all of them if shouldCleanAll is true
+// If the label selector isn't empty, only the enclaves having all of its labels are destroyed
+func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool, labelSelector map[string]string) ([]*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 	// TODO: Refactor with kurtosis backend
 	var resultEnclaveNameAndUuids []*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid
 
 	// we prefetch the enclaves before deletion so that we have metadata
-	enclavesForUuidNameMapping, err := manager.getEnclavesWithoutMutex(ctx)
+	enclavesForUuidNameMapping, err := manager.getEnclavesMatchingLabelsWithoutMutex(ctx, labelSelector)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Tried retrieving existing enclaves but failed")
 	}
@@ -286,7 +460,7 @@ func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) (
 		enclaveUUIDsToClean[enclaveUUID] = true
 	}
 
-	successfullyRemovedEnclaveUuidStrs, removalErrors, err := manager.cleanEnclaves(ctx, enclaveUUIDsToClean, shouldCleanAll)
+	successfullyRemovedEnclaveUuidStrs, removalErrors, err := manager.cleanEnclaves(ctx, enclaveUUIDsToClean, shouldCleanAll, labelSelector)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while cleaning enclaves with UUIDs '%+v' and shouldCleanAll set to '%v'", enclaveUUIDsToClean, shouldCleanAll)
 	}
@@ -464,10 +638,22 @@ func (manager *EnclaveManager) stopEnclaveWithoutMutex(ctx context.Context, encl
 	return nil
 }
 
+func (manager *EnclaveManager) startEnclaveWithoutMutex(ctx context.Context, enclaveId enclave.EnclaveUUID) error {
+	_, enclaveStartErrs, err := manager.kurtosisBackend.StartEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveId))
+	if err != nil {
+		return stacktrace.Propagate(err, "Attempted to start enclave '%v' but the backend threw an error", enclaveId)
+	}
+	if enclaveStartErr, found := enclaveStartErrs[enclaveId]; found {
+		return stacktrace.Propagate(enclaveStartErr, "An error occurred starting enclave '%v'", enclaveId)
+	}
+	return nil
+}
+
 func (manager *EnclaveManager) cleanEnclaves(
 	ctx context.Context,
 	enclaveUUIDs map[enclave.EnclaveUUID]bool,
 	shouldCleanAll bool,
+	labelSelector map[string]string,
 ) ([]string, []error, error) {
 	enclaveStatusFilters := map[enclave.EnclaveStatus]bool{
 		enclave.EnclaveStatus_Stopped: true,
@@ -480,6 +666,7 @@ func (manager *EnclaveManager) cleanEnclaves(
 	destroyEnclaveFilters := &enclave.EnclaveFilters{
 		UUIDs:    enclaveUUIDs,
 		Statuses: enclaveStatusFilters,
+		Labels:   labelSelector,
 	}
 	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, destroyEnclaveFilters)
 	if err != nil {
@@ -507,7 +694,15 @@ func (manager *EnclaveManager) cleanEnclaves(
 func (manager *EnclaveManager) getEnclavesWithoutMutex(
 	ctx context.Context,
 ) (map[enclave.EnclaveUUID]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
-	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getAllEnclavesFilter())
+	return manager.getEnclavesMatchingLabelsWithoutMutex(ctx, noEnclaveLabels)
+}
+
+// this should be called from a thread safe context
+func (manager *EnclaveManager) getEnclavesMatchingLabelsWithoutMutex(
+	ctx context.Context,
+	labelSelector map[string]string,
+) (map[enclave.EnclaveUUID]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclavesByLabelsFilter(labelSelector))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error thrown retrieving enclaves")
 	}
@@ -523,6 +718,7 @@ func (manager *EnclaveManager) getEnclavesWithoutMutex(
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred getting information about enclave '%v'", enclaveId)
 		}
+		manager.addEnclaveExpiryInfoWithoutMutex(enclaveInfo)
 		result[enclaveId] = enclaveInfo
 	}
 	return result, nil
@@ -530,6 +726,7 @@ func (manager *EnclaveManager) getEnclavesWithoutMutex(
 }
 
 func (manager *EnclaveManager) Close() error {
+	manager.cancelExpiryReaperFunc()
 	if err := manager.enclavePool.Close(); err != nil {
 		return stacktrace.Propagate(err, "An error occurred closing the enclave pool")
 	}
@@ -543,13 +740,15 @@ func getEnclaveByEnclaveIdFilter(enclaveUuid enclave.EnclaveUUID) *enclave.Encla
 			enclaveUuid: true,
 		},
 		Statuses: nil,
+		Labels:   nil,
 	}
 }
 
-func getAllEnclavesFilter() *enclave.EnclaveFilters {
+func getEnclavesByLabelsFilter(labels map[string]string) *enclave.EnclaveFilters {
 	return &enclave.EnclaveFilters{
 		UUIDs:    map[enclave.EnclaveUUID]bool{},
 		Statuses: nil,
+		Labels:   labels,
 	}
 }
 
@@ -596,6 +795,9 @@ func getEnclaveInfoForEnclave(ctx context.Context, kurtosisBackend backend_inter
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                creationTimestamp,
 		Mode:                        mode,
+		ExpirationTime:              nil,
+		ExpiryAction:                kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY,
+		Labels:                      enclave.GetLabels(),
 	}, nil
 }
 
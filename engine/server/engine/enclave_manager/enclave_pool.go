@@ -276,6 +276,11 @@ func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*kurtosis_en
 		createTestEnclave,
 		pool.metricsUserID,
 		pool.didUserAcceptSendingMetrics,
+		NoFilesArtifactsStorageQuota,
+		NoCpuQuota,
+		NoMemoryQuota,
+		NoServiceCountQuota,
+		noEnclaveLabels,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -299,6 +304,7 @@ func (pool *EnclavePool) getRunningEnclave(ctx context.Context, enclaveUUID encl
 		Statuses: map[enclave.EnclaveStatus]bool{
 			enclave.EnclaveStatus_Running: true,
 		},
+		Labels: nil,
 	}
 
 	enclaves, err := pool.kurtosisBackend.GetEnclaves(ctx, filters)
@@ -355,6 +361,7 @@ func destroyIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend) erro
 	filters := &enclave.EnclaveFilters{
 		UUIDs:    nil,
 		Statuses: nil,
+		Labels:   nil,
 	}
 
 	enclaves, err := kurtosisBackend.GetEnclaves(ctx, filters)
@@ -410,6 +417,7 @@ func destroyOldIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend, b
 	filters := &enclave.EnclaveFilters{
 		UUIDs:    nil,
 		Statuses: nil,
+		Labels:   nil,
 	}
 
 	idleEnclavesToRemove := map[enclave.EnclaveUUID]bool{}
@@ -448,6 +456,7 @@ func destroyEnclavesByUUID(
 	destroyEnclaveFilters := &enclave.EnclaveFilters{
 		UUIDs:    enclavesToRemove,
 		Statuses: map[enclave.EnclaveStatus]bool{},
+		Labels:   nil,
 	}
 
 	logrus.Debugf("Destroying enclaves '%+v'", enclavesToRemove)
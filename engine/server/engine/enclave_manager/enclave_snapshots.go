@@ -0,0 +1,153 @@
+package enclave_manager
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
+)
+
+const (
+	enclaveSnapshotContentName = "enclave snapshot"
+)
+
+// SnapshotEnclave asks the API container of the enclave to export its content and writes the resulting snapshot archive
+// to the output as it is received. The engine lock is only held while looking up the enclave, not during the export.
+func (manager *EnclaveManager) SnapshotEnclave(ctx context.Context, enclaveIdentifier string, includePersistentDirectories bool, includeContainerFilesystems bool, output io.Writer) error {
+	enclaveInfo, err := manager.getEnclaveInfoForIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting info for enclave '%v'", enclaveIdentifier)
+	}
+	if enclaveInfo.ApiContainerStatus != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+		return stacktrace.NewError("Enclave '%v' can't be snapshotted because its API container isn't running", enclaveIdentifier)
+	}
+
+	apiContainerConnection, apiContainerClient, err := createApiContainerClient(enclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", enclaveIdentifier)
+	}
+	defer apiContainerConnection.Close()
+
+	exportArgs := &kurtosis_core_rpc_api_bindings.ExportEnclaveSnapshotArgs{
+		IncludePersistentDirectories: includePersistentDirectories,
+		IncludeContainerFilesystems:  includeContainerFilesystems,
+	}
+	exportClient, err := apiContainerClient.ExportEnclaveSnapshot(ctx, exportArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred initiating the export of enclave '%v'", enclaveIdentifier)
+	}
+	clientStream := grpc_file_streaming.NewClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](exportClient)
+	if err := clientStream.ReceiveDataToWriter(
+		enclaveSnapshotContentName,
+		func(dataChunk *kurtosis_core_rpc_api_bindings.StreamedDataChunk) ([]byte, string, error) {
+			return dataChunk.Data, dataChunk.PreviousChunkHash, nil
+		},
+		output,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the snapshot of enclave '%v'", enclaveIdentifier)
+	}
+	return nil
+}
+
+// RestoreEnclave creates a new enclave and loads the content of the snapshot into it. The new enclave is destroyed if
+// the snapshot can't be loaded
+func (manager *EnclaveManager) RestoreEnclave(
+	ctx context.Context,
+	engineVersion string,
+	apiContainerImageVersionTag string,
+	apiContainerLogLevel logrus.Level,
+	enclaveName string,
+	isProduction bool,
+	snapshot io.Reader,
+	snapshotSizeInBytes uint64,
+) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	enclaveInfo, err := manager.CreateEnclave(ctx, engineVersion, apiContainerImageVersionTag, apiContainerLogLevel, enclaveName, isProduction, NoFilesArtifactsStorageQuota, NoCpuQuota, NoMemoryQuota, NoServiceCountQuota, NoEnclaveTimeToLive, NoEnclaveIdleTimeout, kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY, noEnclaveLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave to restore the snapshot into")
+	}
+	shouldDestroyEnclave := true
+	defer func() {
+		if shouldDestroyEnclave {
+			if err := manager.DestroyEnclave(context.Background(), enclaveInfo.GetEnclaveUuid(), noEnclaveLabels); err != nil {
+				logrus.Errorf("Restoring the snapshot failed so we tried to destroy the enclave '%v' that we created, but doing so threw an error:\n%v", enclaveInfo.GetName(), err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to destroy enclave '%v' manually!!!!", enclaveInfo.GetName())
+			}
+		}
+	}()
+
+	apiContainerConnection, apiContainerClient, err := createApiContainerClient(enclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", enclaveInfo.GetName())
+	}
+	defer apiContainerConnection.Close()
+
+	importClient, err := apiContainerClient.ImportEnclaveSnapshot(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred initiating the import of the snapshot into enclave '%v'", enclaveInfo.GetName())
+	}
+	clientStream := grpc_file_streaming.NewClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, emptypb.Empty](importClient)
+	_, err = clientStream.SendData(
+		enclaveSnapshotContentName,
+		snapshot,
+		snapshotSizeInBytes,
+		func(previousChunkHash string, contentChunk []byte) (*kurtosis_core_rpc_api_bindings.StreamedDataChunk, error) {
+			return &kurtosis_core_rpc_api_bindings.StreamedDataChunk{
+				Data:              contentChunk,
+				PreviousChunkHash: previousChunkHash,
+				Metadata: &kurtosis_core_rpc_api_bindings.DataChunkMetadata{
+					Name: enclaveSnapshotContentName,
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred restoring the snapshot into enclave '%v'", enclaveInfo.GetName())
+	}
+
+	shouldDestroyEnclave = false
+	return enclaveInfo, nil
+}
+
+func (manager *EnclaveManager) getEnclaveInfoForIdentifier(ctx context.Context, enclaveIdentifier string) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	return manager.getEnclaveInfoForIdentifierUnlocked(ctx, enclaveIdentifier)
+}
+
+// this should be called from a thread safe context
+func (manager *EnclaveManager) getEnclaveInfoForIdentifierUnlocked(ctx context.Context, enclaveIdentifier string) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+	enclaves, err := manager.getEnclavesWithoutMutex(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	enclaveInfo, found := enclaves[enclaveUuid]
+	if !found {
+		return nil, stacktrace.NewError("Enclave '%v' disappeared while it was being looked up", enclaveUuid)
+	}
+	return enclaveInfo, nil
+}
+
+// The engine and the API containers are all attached to the bridge network, so we can reach the API container directly
+func createApiContainerClient(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (*grpc.ClientConn, kurtosis_core_rpc_api_bindings.ApiContainerServiceClient, error) {
+	apiContainerInfo := enclaveInfo.GetApiContainerInfo()
+	if apiContainerInfo == nil {
+		return nil, nil, stacktrace.NewError("Enclave '%v' has no API container info", enclaveInfo.GetName())
+	}
+	grpcUrl := fmt.Sprintf("%v:%v", apiContainerInfo.GetBridgeIpAddress(), apiContainerInfo.GetGrpcPortInsideEnclave())
+	apiContainerConnection, err := grpc.Dial(grpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Expected to be able to create a client connection to API container at address '%v', instead a non-nil error was returned", grpcUrl)
+	}
+	return apiContainerConnection, kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(apiContainerConnection), nil
+}
@@ -0,0 +1,44 @@
+package engine_db
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+	"os"
+	"path"
+	"time"
+)
+
+const (
+	readWritePermissionToDatabase = 0666
+	databaseDirPermissions        = 0755
+	timeOut                       = 10 * time.Second
+)
+
+// EngineDB stores the state the engine has to keep across restarts, so it must live on a volume that outlives the
+// engine container
+type EngineDB struct {
+	*bolt.DB
+}
+
+func OpenEngineDatabase(databaseFilepath string) (*EngineDB, error) {
+	if err := os.MkdirAll(path.Dir(databaseFilepath), databaseDirPermissions); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the directory of the engine database '%v'", databaseFilepath)
+	}
+	db, err := bolt.Open(databaseFilepath, readWritePermissionToDatabase, &bolt.Options{
+		Timeout:         timeOut, //to fail if any other process is locking the file
+		NoGrowSync:      false,
+		NoFreelistSync:  false,
+		FreelistType:    "",
+		ReadOnly:        false,
+		MmapFlags:       0,
+		InitialMmapSize: 0,
+		PageSize:        0,
+		NoSync:          false,
+		OpenFile:        nil,
+		Mlock:           false,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while opening the engine database '%v'", databaseFilepath)
+	}
+	return &EngineDB{db}, nil
+}
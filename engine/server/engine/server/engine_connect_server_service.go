@@ -3,6 +3,7 @@ package server
 import (
 	"connectrpc.com/connect"
 	"context"
+	"errors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
@@ -13,9 +14,19 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"os"
 	"time"
 )
 
+const (
+	// Same chunk size as the one used to stream data in between the CLI and the API container
+	enclaveSnapshotChunkSizeInBytes = 3 * 1024 * 1024
+
+	enclaveSnapshotTempFilePattern = "enclave-snapshot-*.tgz"
+)
+
 var (
 	logRetentionFeatureReleaseTime = time.Date(2023, 9, 7, 13, 0, 0, 0, time.UTC)
 )
@@ -91,6 +102,14 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 		apiContainerLogLevel,
 		args.EnclaveName,
 		isProduction,
+		args.GetFilesArtifactsStorageQuota(),
+		args.GetCpuQuotaMillicpus(),
+		args.GetMemoryQuotaMegabytes(),
+		args.GetServiceCountQuota(),
+		time.Duration(args.GetTtlSeconds())*time.Second,
+		time.Duration(args.GetIdleTimeoutSeconds())*time.Second,
+		args.GetExpiryAction(),
+		args.GetLabels(),
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating new enclave with name '%v'", args.EnclaveName)
@@ -103,8 +122,9 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 	return connect.NewResponse(response), nil
 }
 
-func (service *EngineConnectServerService) GetEnclaves(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
-	infoForEnclaves, err := service.enclaveManager.GetEnclaves(ctx)
+func (service *EngineConnectServerService) GetEnclaves(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+	args := connectArgs.Msg
+	infoForEnclaves, err := service.enclaveManager.GetEnclaves(ctx, args.GetLabelSelector())
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclaves")
 	}
@@ -132,11 +152,39 @@ func (service *EngineConnectServerService) StopEnclave(ctx context.Context, conn
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (service *EngineConnectServerService) StartEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.StartEnclaveResponse], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.EnclaveIdentifier
+
+	resumedServiceNames, err := service.enclaveManager.StartEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting enclave '%v'", enclaveIdentifier)
+	}
+
+	return connect.NewResponse(&kurtosis_engine_rpc_api_bindings.StartEnclaveResponse{
+		ResumedServiceNames: resumedServiceNames,
+	}), nil
+}
+
+func (service *EngineConnectServerService) ExtendEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.EnclaveIdentifier
+
+	expirationTime, err := service.enclaveManager.ExtendEnclave(ctx, enclaveIdentifier, time.Duration(args.GetExtensionSeconds())*time.Second)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred extending the expiration of enclave '%v'", enclaveIdentifier)
+	}
+
+	return connect.NewResponse(&kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse{
+		ExpirationTime: expirationTime,
+	}), nil
+}
+
 func (service *EngineConnectServerService) DestroyEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	args := connectArgs.Msg
 	enclaveIdentifier := args.EnclaveIdentifier
 
-	if err := service.enclaveManager.DestroyEnclave(ctx, enclaveIdentifier); err != nil {
+	if err := service.enclaveManager.DestroyEnclave(ctx, enclaveIdentifier, args.GetLabelSelector()); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred destroying enclave with identifier '%v':", args.EnclaveIdentifier)
 	}
 	return connect.NewResponse(&emptypb.Empty{}), nil
@@ -144,11 +192,12 @@ func (service *EngineConnectServerService) DestroyEnclave(ctx context.Context, c
 
 func (service *EngineConnectServerService) Clean(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	args := connectArgs.Msg
-	removedEnclaveUuidsAndNames, err := service.enclaveManager.Clean(ctx, args.ShouldCleanAll)
+	removedEnclaveUuidsAndNames, err := service.enclaveManager.Clean(ctx, args.ShouldCleanAll, args.GetLabelSelector())
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while cleaning enclaves")
 	}
-	if args.ShouldCleanAll {
+	// the logs of the enclaves that didn't match the label selector must be kept
+	if args.ShouldCleanAll && len(args.GetLabelSelector()) == 0 {
 		if err = service.logFileManager.RemoveAllLogs(); err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred removing all logs.")
 		}
@@ -201,6 +250,16 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 		return stacktrace.Propagate(err, "An error occurred creating the conjunctive log line filters from the GRPC's conjunctive log line filters '%+v'", args.GetConjunctiveFilters())
 	}
 
+	conjunctiveLogLineFieldFilters, err := newConjunctiveLogLineFieldFiltersFromGRPCLogLineFieldFilters(args.GetConjunctiveFieldFilters())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the conjunctive log line field filters from the GRPC's conjunctive log line field filters '%+v'", args.GetConjunctiveFieldFilters())
+	}
+
+	timeRange, err := newLogLineTimeRangeFromGRPCTimestamps(args.GetSince(), args.GetUntil())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the log line time range from the GRPC's since '%v' and until '%v' timestamps", args.GetSince(), args.GetUntil())
+	}
+
 	// get enclave creation time to determine strategy to pull logs
 	enclaveCreationTime, err := service.getEnclaveCreationTime(ctx, enclaveUuid)
 	if err != nil {
@@ -213,6 +272,8 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 		enclaveUuid,
 		requestedServiceUuids,
 		conjunctiveLogLineFilters,
+		conjunctiveLogLineFieldFilters,
+		timeRange,
 		shouldFollowLogs,
 		shouldReturnAllLogs,
 		numLogLines)
@@ -263,6 +324,109 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 	}
 }
 
+func (service *EngineConnectServerService) SnapshotEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.SnapshotEnclaveArgs], stream *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EnclaveSnapshotChunk]) error {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.GetEnclaveIdentifier()
+
+	// the snapshot can be larger than the engine memory, so it is buffered on disk. It is fully exported before anything
+	// is sent, such that a failed export doesn't leave the client with a truncated archive
+	snapshotFile, err := os.CreateTemp("", enclaveSnapshotTempFilePattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the temporary file to hold the snapshot of enclave '%v'", enclaveIdentifier)
+	}
+	defer func() {
+		snapshotFile.Close()
+		os.Remove(snapshotFile.Name())
+	}()
+	if err := service.enclaveManager.SnapshotEnclave(ctx, enclaveIdentifier, args.GetIncludePersistentDirectories(), args.GetIncludeContainerFilesystems(), snapshotFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred snapshotting enclave '%v'", enclaveIdentifier)
+	}
+	if _, err := snapshotFile.Seek(0, io.SeekStart); err != nil {
+		return stacktrace.Propagate(err, "An error occurred rewinding the snapshot of enclave '%v'", enclaveIdentifier)
+	}
+
+	chunkBuffer := make([]byte, enclaveSnapshotChunkSizeInBytes)
+	for {
+		bytesRead, err := io.ReadFull(snapshotFile, chunkBuffer)
+		if bytesRead > 0 {
+			chunk := &kurtosis_engine_rpc_api_bindings.EnclaveSnapshotChunk{
+				Data: chunkBuffer[:bytesRead],
+			}
+			if err := stream.Send(chunk); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending the snapshot of enclave '%v'", enclaveIdentifier)
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the snapshot of enclave '%v'", enclaveIdentifier)
+		}
+	}
+}
+
+func (service *EngineConnectServerService) RestoreEnclave(ctx context.Context, stream *connect.ClientStream[kurtosis_engine_rpc_api_bindings.RestoreEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.RestoreEnclaveResponse], error) {
+	// the snapshot can be larger than the engine memory, so it is buffered on disk
+	snapshotFile, err := os.CreateTemp("", enclaveSnapshotTempFilePattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the temporary file to hold the enclave snapshot to restore")
+	}
+	defer func() {
+		snapshotFile.Close()
+		os.Remove(snapshotFile.Name())
+	}()
+
+	var createEnclaveArgs *kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs
+	snapshotSizeInBytes := uint64(0)
+	for stream.Receive() {
+		args := stream.Msg()
+		if createEnclaveArgs == nil {
+			createEnclaveArgs = args.GetCreateEnclaveArgs()
+		}
+		if _, err := snapshotFile.Write(args.GetData()); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred writing the enclave snapshot to restore to a temporary file")
+		}
+		snapshotSizeInBytes += uint64(len(args.GetData()))
+	}
+	if err := stream.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred receiving the enclave snapshot to restore")
+	}
+	if createEnclaveArgs == nil {
+		return nil, stacktrace.NewError("The arguments to create the enclave to restore the snapshot into are expected in the first message of the stream, but none were received")
+	}
+
+	if _, err := snapshotFile.Seek(0, io.SeekStart); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred rewinding the enclave snapshot to restore")
+	}
+
+	apiContainerLogLevel, err := logrus.ParseLevel(createEnclaveArgs.ApiContainerLogLevel)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the log level string '%v':", createEnclaveArgs.ApiContainerLogLevel)
+	}
+	isProduction := false
+	if createEnclaveArgs.Mode == kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION {
+		isProduction = true
+	}
+	enclaveInfo, err := service.enclaveManager.RestoreEnclave(
+		ctx,
+		service.imageVersionTag,
+		createEnclaveArgs.ApiContainerVersionTag,
+		apiContainerLogLevel,
+		createEnclaveArgs.EnclaveName,
+		isProduction,
+		snapshotFile,
+		snapshotSizeInBytes,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred restoring the enclave snapshot into new enclave with name '%v'", createEnclaveArgs.EnclaveName)
+	}
+
+	response := &kurtosis_engine_rpc_api_bindings.RestoreEnclaveResponse{
+		EnclaveInfo: enclaveInfo,
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (service *EngineConnectServerService) Close() error {
 	if err := service.enclaveManager.Close(); err != nil {
 		return stacktrace.Propagate(err, "An error occurred closing the enclave manager")
@@ -344,12 +508,23 @@ func newLogsResponse(
 func newRPCBindingsLogLineFromLogLines(logLines []logline.LogLine) *kurtosis_engine_rpc_api_bindings.LogLine {
 
 	logLinesStr := make([]string, len(logLines))
+	logLinesTimestamp := make([]*timestamppb.Timestamp, len(logLines))
+	logLinesLevel := make([]string, len(logLines))
 
 	for logLineIndex, logLine := range logLines {
 		logLinesStr[logLineIndex] = logLine.GetContent()
+		logLinesTimestamp[logLineIndex] = &timestamppb.Timestamp{}
+		if !logLine.GetTimestamp().IsZero() {
+			logLinesTimestamp[logLineIndex] = timestamppb.New(logLine.GetTimestamp())
+		}
+		logLinesLevel[logLineIndex] = string(logLine.GetLevel())
 	}
 
-	rpcBindingsLogLines := &kurtosis_engine_rpc_api_bindings.LogLine{Line: logLinesStr}
+	rpcBindingsLogLines := &kurtosis_engine_rpc_api_bindings.LogLine{
+		Line:      logLinesStr,
+		Timestamp: logLinesTimestamp,
+		Level:     logLinesLevel,
+	}
 
 	return rpcBindingsLogLines
 }
@@ -397,6 +572,54 @@ func newConjunctiveLogLineFiltersFromGRPCLogLineFilters(
 	return conjunctiveLogLineFilters, nil
 }
 
+func newConjunctiveLogLineFieldFiltersFromGRPCLogLineFieldFilters(
+	grpcLogLineFieldFilters []*kurtosis_engine_rpc_api_bindings.LogLineFieldFilter,
+) (logline.ConjunctiveLogLineFieldFilters, error) {
+	var conjunctiveLogLineFieldFilters logline.ConjunctiveLogLineFieldFilters
+
+	for _, grpcLogLineFieldFilter := range grpcLogLineFieldFilters {
+		var logLineFieldFilter *logline.LogLineFieldFilter
+		fieldPath := grpcLogLineFieldFilter.GetFieldPath()
+		value := grpcLogLineFieldFilter.GetValue()
+		switch grpcLogLineFieldFilter.GetOperator() {
+		case kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_EQUALS:
+			logLineFieldFilter = logline.NewLogLineFieldFilter(fieldPath, logline.LogLineFieldOperator_Equals, value)
+		case kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_NOT_EQUALS:
+			logLineFieldFilter = logline.NewLogLineFieldFilter(fieldPath, logline.LogLineFieldOperator_NotEquals, value)
+		case kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_MATCHES_REGEX:
+			logLineFieldFilter = logline.NewLogLineFieldFilter(fieldPath, logline.LogLineFieldOperator_MatchesRegex, value)
+		case kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_GREATER_THAN:
+			logLineFieldFilter = logline.NewLogLineFieldFilter(fieldPath, logline.LogLineFieldOperator_GreaterThan, value)
+		case kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_GREATER_THAN_OR_EQUAL:
+			logLineFieldFilter = logline.NewLogLineFieldFilter(fieldPath, logline.LogLineFieldOperator_GreaterThanOrEqual, value)
+		case kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_LESS_THAN:
+			logLineFieldFilter = logline.NewLogLineFieldFilter(fieldPath, logline.LogLineFieldOperator_LessThan, value)
+		case kurtosis_engine_rpc_api_bindings.LogLineFieldOperator_LogLineFieldOperator_LESS_THAN_OR_EQUAL:
+			logLineFieldFilter = logline.NewLogLineFieldFilter(fieldPath, logline.LogLineFieldOperator_LessThanOrEqual, value)
+		default:
+			return nil, stacktrace.NewError("Unrecognized log line field filter operator '%v' in GRPC field filter '%v'; this is a bug in Kurtosis", grpcLogLineFieldFilter.GetOperator(), grpcLogLineFieldFilter)
+		}
+		conjunctiveLogLineFieldFilters = append(conjunctiveLogLineFieldFilters, *logLineFieldFilter)
+	}
+
+	return conjunctiveLogLineFieldFilters, nil
+}
+
+// An unset timestamp leaves the time range open on that side
+func newLogLineTimeRangeFromGRPCTimestamps(grpcSince *timestamppb.Timestamp, grpcUntil *timestamppb.Timestamp) (*logline.LogLineTimeRange, error) {
+	var since, until time.Time
+	if grpcSince != nil {
+		since = grpcSince.AsTime()
+	}
+	if grpcUntil != nil {
+		until = grpcUntil.AsTime()
+	}
+	if !since.IsZero() && !until.IsZero() && since.After(until) {
+		return nil, stacktrace.NewError("The since time '%v' of the log lines is after their until time '%v'", since, until)
+	}
+	return logline.NewLogLineTimeRange(since, until), nil
+}
+
 // If the enclave was created prior to log retention, return the per file logs client
 func (service *EngineConnectServerService) getLogsDatabaseClient(enclaveCreationTime time.Time) centralized_logs.LogsDatabaseClient {
 	if enclaveCreationTime.After(logRetentionFeatureReleaseTime) {
@@ -407,7 +630,7 @@ func (service *EngineConnectServerService) getLogsDatabaseClient(enclaveCreation
 }
 
 func (service *EngineConnectServerService) getEnclaveCreationTime(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (time.Time, error) {
-	enclaves, err := service.enclaveManager.GetEnclaves(ctx)
+	enclaves, err := service.enclaveManager.GetEnclaves(ctx, nil)
 	if err != nil {
 		return time.Time{}, err
 	}
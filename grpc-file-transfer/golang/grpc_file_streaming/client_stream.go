@@ -74,3 +74,22 @@ func (clientStream *ClientStream[DataChunkMessageType, ServerResponseType]) Rece
 	}
 	return assembledContent, nil
 }
+
+// ReceiveDataToWriter is the same as ReceiveData, except that the chunks are written to the output as they're
+// received instead of being assembled in memory. It is meant for content too large to be held in memory.
+func (clientStream *ClientStream[DataChunkMessageType, ServerResponseType]) ReceiveDataToWriter(
+	contentNameForLogging string,
+	grpcMsgExtractor func(dataChunk *DataChunkMessageType) ([]byte, string, error),
+	output io.Writer,
+) error {
+	if err := writeMessagesFromStream[DataChunkMessageType](
+		contentNameForLogging,
+		clientStream.grpcStream.RecvMsg,
+		grpcMsgExtractor,
+		output,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the data chunks for '%s' through the stream",
+			contentNameForLogging)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package grpc_file_streaming
 
 import (
+	"bytes"
 	"crypto/sha1"
 	"encoding/hex"
 	"errors"
@@ -77,7 +78,21 @@ func readMessagesFromStream[DataChunkMessageType any](
 	readMsgFromStream func(msg any) error,
 	grpcMsgExtractor func(dataChunk *DataChunkMessageType) ([]byte, string, error),
 ) ([]byte, error) {
-	var assembledContent []byte
+	assembledContent := &bytes.Buffer{}
+	if err := writeMessagesFromStream[DataChunkMessageType](payloadNameForLogging, readMsgFromStream, grpcMsgExtractor, assembledContent); err != nil {
+		return nil, err
+	}
+	return assembledContent.Bytes(), nil
+}
+
+// writeMessagesFromStream is the same as readMessagesFromStream, except that the chunks are written to the output as
+// they're received instead of being assembled in memory
+func writeMessagesFromStream[DataChunkMessageType any](
+	payloadNameForLogging string,
+	readMsgFromStream func(msg any) error,
+	grpcMsgExtractor func(dataChunk *DataChunkMessageType) ([]byte, string, error),
+	output io.Writer,
+) error {
 	var blockIdx int
 	var computedPreviousBlockHash string
 	hasher := sha1.New()
@@ -87,18 +102,20 @@ func readMessagesFromStream[DataChunkMessageType any](
 	for errorReceivingChunk == nil {
 		chunkContent, previousChunkHashFromChunk, err := grpcMsgExtractor(dataChunk)
 		if err != nil {
-			return nil, stacktrace.NewError("An unexpected error occurred extracting data from the streamed GRPC "+
+			return stacktrace.NewError("An unexpected error occurred extracting data from the streamed GRPC "+
 				"message for '%s'", payloadNameForLogging)
 		}
 		logrus.Debugf("Receiving content for '%s'. Block number %d", payloadNameForLogging, blockIdx)
 
 		if previousChunkHashFromChunk != computedPreviousBlockHash {
-			return nil, stacktrace.NewError("An unexpected error occurred receiving data chunk for '%s'. Hash "+
+			return stacktrace.NewError("An unexpected error occurred receiving data chunk for '%s'. Hash "+
 				"validation did not pass: was '%s' - wanted '%s'. Maybe a block failed to be sent (networking issues) "+
 				"and now entire chain is broken. Retrying the operation might fix this issue.",
 				payloadNameForLogging, previousChunkHashFromChunk, computedPreviousBlockHash)
 		}
-		assembledContent = append(assembledContent, chunkContent...)
+		if _, err := output.Write(chunkContent); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing data chunk number %d of '%s'", blockIdx, payloadNameForLogging)
+		}
 
 		hasher.Reset()
 		hasher.Write(chunkContent)
@@ -109,8 +126,8 @@ func readMessagesFromStream[DataChunkMessageType any](
 		blockIdx += 1
 	}
 	if errorReceivingChunk != io.EOF {
-		return nil, stacktrace.Propagate(errorReceivingChunk, "An unexpected error occurred receiving '%s'",
+		return stacktrace.Propagate(errorReceivingChunk, "An unexpected error occurred receiving '%s'",
 			payloadNameForLogging)
 	}
-	return assembledContent, nil
+	return nil
 }
@@ -114,6 +114,8 @@ var (
 	emptyEntrypointArgs          = []string{}
 	emptyCmdArgs                 = []string{}
 	emptyEnvVars                 = map[string]string{}
+	noLogLineFieldFilters        []*kurtosis_context.LogLineFieldFilter
+	noLogLinesTimeBound          = time.Time{}
 )
 
 var fileServerPortSpec = &kurtosis_core_rpc_api_bindings.Port{
@@ -457,7 +459,7 @@ func GetLogsResponse(
 	receivedNotFoundServiceGuids := map[services.ServiceUUID]bool{}
 	var testEvaluationErr error
 
-	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, enclaveIdentifier, serviceUuids, shouldFollowLogs, defaultShouldReturnAllLogs, defaultNumLogLines, logLineFilter)
+	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, enclaveIdentifier, serviceUuids, shouldFollowLogs, defaultShouldReturnAllLogs, defaultNumLogLines, logLineFilter, noLogLineFieldFilters, noLogLinesTimeBound, noLogLinesTimeBound)
 	defer cancelStreamUserServiceLogsFunc()
 	require.NoError(t, err, "An error occurred getting user service logs from user services with UUIDs '%+v' in enclave '%v' and with follow logs value '%v'", serviceUuids, enclaveIdentifier, shouldFollowLogs)
 